@@ -0,0 +1,225 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package action_catalog holds an embedded catalog of IAM actions, used to expand
+// wildcards to concrete actions and to detect actions IAM does not know about. The
+// catalog is a curated subset of the Service Authorization Reference, only the services
+// marked complete list every action of the service.
+package action_catalog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	AccessLevelList                  = "List"
+	AccessLevelRead                  = "Read"
+	AccessLevelWrite                 = "Write"
+	AccessLevelPermissionsManagement = "Permissions management"
+	AccessLevelTagging               = "Tagging"
+)
+
+//go:embed actions.json
+var catalogJSON []byte
+
+type Action struct {
+	// Access level of the action as listed in the Service Authorization Reference.
+	AccessLevel string `json:"accessLevel"`
+
+	// Resource types the action can be scoped to.
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+
+	// Condition keys supported by the action.
+	ConditionKeys []string `json:"conditionKeys,omitempty"`
+
+	// Whether the action reads data, secrets or credentials rather than metadata.
+	Sensitive bool `json:"sensitive,omitempty"`
+}
+
+type Service struct {
+	// Display name of the service.
+	Name string `json:"name"`
+
+	// Actions of the service keyed by action name without the service prefix.
+	Actions map[string]Action `json:"actions"`
+
+	// Whether Actions lists every action of the service rather than a curated subset.
+	Complete bool `json:"complete,omitempty"`
+}
+
+type Catalog struct {
+	// Version of the catalog, the date it was last curated.
+	Version string `json:"version"`
+
+	// Services keyed by IAM service prefix.
+	Services map[string]Service `json:"services"`
+}
+
+var (
+	loadOnce sync.Once
+	catalog  *Catalog
+	loadErr  error
+)
+
+// Load returns the embedded catalog.
+func Load() (*Catalog, error) {
+	loadOnce.Do(func() {
+		var c Catalog
+		if err := json.Unmarshal(catalogJSON, &c); err != nil {
+			loadErr = fmt.Errorf("parsing embedded action catalog: %w", err)
+			return
+		}
+		catalog = &c
+	})
+	return catalog, loadErr
+}
+
+func splitAction(action string) (string, string, error) {
+	parts := strings.SplitN(action, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("action [%s] is not in the form service:action", action)
+	}
+	return strings.ToLower(parts[0]), parts[1], nil
+}
+
+// HasService reports whether the catalog contains the given service prefix.
+func (c *Catalog) HasService(service string) bool {
+	_, ok := c.Services[strings.ToLower(service)]
+	return ok
+}
+
+// IsComplete reports whether the catalog lists every action of the given service prefix.
+func (c *Catalog) IsComplete(service string) bool {
+	svc, ok := c.Services[strings.ToLower(service)]
+	return ok && svc.Complete
+}
+
+// Lookup returns the catalog entry for a fully qualified action such as `s3:GetObject`.
+// Action names are matched case-insensitively like IAM does.
+func (c *Catalog) Lookup(action string) (Action, bool) {
+	service, name, err := splitAction(action)
+	if err != nil {
+		return Action{}, false
+	}
+
+	svc, ok := c.Services[service]
+	if !ok {
+		return Action{}, false
+	}
+
+	for actionName, entry := range svc.Actions {
+		if strings.EqualFold(actionName, name) {
+			return entry, true
+		}
+	}
+	return Action{}, false
+}
+
+// Expand returns the fully qualified actions matching a pattern which may contain the
+// `*` and `?` wildcards, e.g. `ec2:Describe*`. The result is sorted.
+func (c *Catalog) Expand(pattern string) ([]string, error) {
+	service, name, err := splitAction(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, ok := c.Services[service]
+	if !ok {
+		return nil, fmt.Errorf("service [%s] is not in the action catalog", service)
+	}
+
+	var result []string
+	for actionName := range svc.Actions {
+		matched, err := path.Match(strings.ToLower(name), strings.ToLower(actionName))
+		if err != nil {
+			return nil, fmt.Errorf("invalid action pattern [%s]: %w", pattern, err)
+		}
+		if matched {
+			result = append(result, fmt.Sprintf("%s:%s", service, actionName))
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// MetadataActions returns the List and non-sensitive Read actions of a service, sorted.
+// Services which are not complete get the `List*` and `Describe*` wildcards in place of
+// the cataloged actions they cover, so that actions missing from the catalog are kept.
+func (c *Catalog) MetadataActions(service string) ([]string, error) {
+	service = strings.ToLower(service)
+	svc, ok := c.Services[service]
+	if !ok {
+		return nil, fmt.Errorf("service [%s] is not in the action catalog", service)
+	}
+
+	var result []string
+	if !svc.Complete {
+		result = append(result, fmt.Sprintf("%s:Describe*", service), fmt.Sprintf("%s:List*", service))
+	}
+
+	for actionName, entry := range svc.Actions {
+		switch {
+		case !svc.Complete && (strings.HasPrefix(actionName, "List") || strings.HasPrefix(actionName, "Describe")):
+			continue
+		case entry.AccessLevel == AccessLevelList:
+		case entry.AccessLevel == AccessLevelRead && !entry.Sensitive:
+		default:
+			continue
+		}
+		result = append(result, fmt.Sprintf("%s:%s", service, actionName))
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// UnknownActions returns the actions which do not exist in the catalog. Wildcard patterns
+// are unknown when they match no action. Actions of services missing from the catalog or
+// not complete in it are not reported since the catalog cannot tell whether they exist.
+func (c *Catalog) UnknownActions(actions []string) []string {
+	var unknown []string
+	for _, action := range actions {
+		if action == "*" {
+			continue
+		}
+
+		service, _, err := splitAction(action)
+		if err != nil {
+			unknown = append(unknown, action)
+			continue
+		}
+
+		if !c.IsComplete(service) {
+			continue
+		}
+
+		if strings.ContainsAny(action, "*?") {
+			matches, err := c.Expand(action)
+			if err != nil || len(matches) == 0 {
+				unknown = append(unknown, action)
+			}
+			continue
+		}
+
+		if _, ok := c.Lookup(action); !ok {
+			unknown = append(unknown, action)
+		}
+	}
+	return unknown
+}
@@ -0,0 +1,137 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action_catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCatalog() *Catalog {
+	actions := map[string]Action{
+		"DescribeWidgets": {AccessLevel: AccessLevelList},
+		"ListWidgets":     {AccessLevel: AccessLevelList},
+		"GetWidgetPolicy": {AccessLevel: AccessLevelRead},
+		"GetWidgetData":   {AccessLevel: AccessLevelRead, Sensitive: true},
+		"PutWidget":       {AccessLevel: AccessLevelWrite},
+		"TagWidget":       {AccessLevel: AccessLevelTagging},
+	}
+
+	return &Catalog{
+		Version: "2024-01-01",
+		Services: map[string]Service{
+			"complete": {Name: "Complete", Actions: actions, Complete: true},
+			"partial":  {Name: "Partial", Actions: actions},
+		},
+	}
+}
+
+func TestEmbeddedCatalog(t *testing.T) {
+	catalog, err := Load()
+	require.NoError(t, err)
+	assert.NotEmpty(t, catalog.Version)
+
+	for prefix, service := range catalog.Services {
+		for name, action := range service.Actions {
+			assert.Contains(t, []string{AccessLevelList, AccessLevelRead, AccessLevelWrite, AccessLevelPermissionsManagement, AccessLevelTagging},
+				action.AccessLevel, "%s:%s", prefix, name)
+		}
+	}
+}
+
+func TestMetadataActions(t *testing.T) {
+	catalog := testCatalog()
+
+	actions, err := catalog.MetadataActions("complete")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"complete:DescribeWidgets", "complete:GetWidgetPolicy", "complete:ListWidgets"}, actions)
+
+	actions, err = catalog.MetadataActions("Partial")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"partial:Describe*", "partial:GetWidgetPolicy", "partial:List*"}, actions)
+
+	_, err = catalog.MetadataActions("missing")
+	assert.EqualError(t, err, "service [missing] is not in the action catalog")
+}
+
+func TestMetadataActionsExcludeSensitiveReads(t *testing.T) {
+	catalog, err := Load()
+	require.NoError(t, err)
+
+	tests := map[string][]string{
+		"s3":             {"s3:GetObject", "s3:GetObjectVersion"},
+		"secretsmanager": {"secretsmanager:GetSecretValue", "secretsmanager:BatchGetSecretValue"},
+		"ssm":            {"ssm:GetParameter", "ssm:GetParameters", "ssm:GetParametersByPath"},
+		"dynamodb":       {"dynamodb:GetItem", "dynamodb:Query", "dynamodb:Scan"},
+		"kms":            {"kms:Decrypt"},
+	}
+
+	for service, excluded := range tests {
+		actions, err := catalog.MetadataActions(service)
+		require.NoError(t, err)
+		assert.NotEmpty(t, actions, service)
+		for _, action := range excluded {
+			assert.NotContains(t, actions, action)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	catalog := testCatalog()
+
+	tests := []struct {
+		pattern  string
+		expected []string
+		err      string
+	}{
+		{pattern: "complete:Get*", expected: []string{"complete:GetWidgetData", "complete:GetWidgetPolicy"}},
+		{pattern: "complete:list*", expected: []string{"complete:ListWidgets"}},
+		{pattern: "COMPLETE:?utWidget", expected: []string{"complete:PutWidget"}},
+		{pattern: "complete:*Widgets", expected: []string{"complete:DescribeWidgets", "complete:ListWidgets"}},
+		{pattern: "complete:Delete*"},
+		{pattern: "missing:Get*", err: "service [missing] is not in the action catalog"},
+		{pattern: "complete:[", err: "invalid action pattern [complete:[]: syntax error in pattern"},
+		{pattern: "Get*", err: "action [Get*] is not in the form service:action"},
+	}
+
+	for _, tt := range tests {
+		actions, err := catalog.Expand(tt.pattern)
+		if tt.err != "" {
+			assert.EqualError(t, err, tt.err, tt.pattern)
+			continue
+		}
+		require.NoError(t, err, tt.pattern)
+		assert.Equal(t, tt.expected, actions, tt.pattern)
+	}
+}
+
+func TestUnknownActions(t *testing.T) {
+	catalog := testCatalog()
+
+	unknown := catalog.UnknownActions([]string{
+		"*",
+		"complete:ListWidgets",
+		"complete:listwidgets",
+		"complete:Get*",
+		"complete:DeleteWidget",
+		"complete:Delete*",
+		"partial:DeleteWidget",
+		"missing:DeleteWidget",
+		"DeleteWidget",
+	})
+	assert.Equal(t, []string{"complete:DeleteWidget", "complete:Delete*", "DeleteWidget"}, unknown)
+}
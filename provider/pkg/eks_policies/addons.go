@@ -0,0 +1,425 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Addon is an entry of the add-on registry. Every registered add-on is offered by
+// RoleForServiceAccountsEks, which passes it the arguments given under its name.
+type Addon struct {
+	// Name of the add-on, used as the key of its arguments in the role's policies.
+	Name string
+
+	// Short description of the permissions granted by the add-on.
+	Description string
+
+	// Type of the arguments the add-on expects.
+	ArgsType reflect.Type
+
+	attach func(policyBuilder *EKSRoleBuilder, args interface{}) error
+}
+
+// Attach creates the add-on's policies and attaches them to the builder's role. Args must be
+// of the add-on's ArgsType, a pointer to it or a map of its `pulumi` tags to values, as passed
+// by the SDKs of other languages. Add-ons skip attaching when their arguments
+// do not ask for it.
+func (a Addon) Attach(policyBuilder *EKSRoleBuilder, args interface{}) error {
+	return a.attach(policyBuilder, args)
+}
+
+// Selected returns args with the `attach` field of the add-on's arguments set, for callers selecting
+// the add-on by name. Arguments without an `attach` field, e.g. those choosing between several
+// policies of the add-on, are returned unchanged.
+func (a Addon) Selected(args interface{}) interface{} {
+	field, ok := addonAttachField(a.ArgsType)
+	if !ok {
+		return args
+	}
+
+	if raw, ok := args.(map[string]interface{}); ok {
+		selected := map[string]interface{}{}
+		for key, value := range raw {
+			selected[key] = value
+		}
+		selected["attach"] = true
+		return selected
+	}
+
+	selected := reflect.New(a.ArgsType).Elem()
+	if args != nil {
+		value := reflect.ValueOf(args)
+		if value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				value = reflect.Zero(a.ArgsType)
+			} else {
+				value = value.Elem()
+			}
+		}
+
+		if value.Type() != a.ArgsType {
+			return args
+		}
+		selected.Set(value)
+	}
+
+	selected.Field(field).SetBool(true)
+	return selected.Interface()
+}
+
+// addonAttachField returns the index of the boolean field tagged `attach` of add-on arguments.
+func addonAttachField(argsType reflect.Type) (int, bool) {
+	if argsType.Kind() != reflect.Struct {
+		return 0, false
+	}
+
+	for i := 0; i < argsType.NumField(); i++ {
+		field := argsType.Field(i)
+		if field.Tag.Get("pulumi") == "attach" && field.Type.Kind() == reflect.Bool {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+var (
+	addonsMu sync.RWMutex
+	addons   []Addon
+)
+
+// RegisterAddon adds an add-on to the registry. The attach function receives the builder of the
+// role, whose Ctx, AWSAccountID, AWSCurrentPartition and DNSSuffix are set, and the arguments
+// given for the add-on. Programs importing this package can register organization specific
+// add-ons, typically from an init function, before creating roles.
+func RegisterAddon[T any](name, description string, attach func(policyBuilder *EKSRoleBuilder, args T) error) error {
+	if name == "" {
+		return fmt.Errorf("Add-on name must not be empty.")
+	}
+
+	addonsMu.Lock()
+	defer addonsMu.Unlock()
+
+	for _, addon := range addons {
+		if addon.Name == name {
+			return fmt.Errorf("Add-on [%s] is already registered.", name)
+		}
+	}
+
+	addons = append(addons, Addon{
+		Name:        name,
+		Description: description,
+		ArgsType:    reflect.TypeOf((*T)(nil)).Elem(),
+		attach: func(policyBuilder *EKSRoleBuilder, args interface{}) error {
+			switch typed := args.(type) {
+			case T:
+				return attach(policyBuilder, typed)
+			case *T:
+				if typed == nil {
+					return nil
+				}
+				return attach(policyBuilder, *typed)
+			case map[string]interface{}:
+				var decoded T
+				if err := decodeAddonArgs(typed, &decoded); err != nil {
+					return fmt.Errorf("Add-on [%s] has invalid arguments: %w.", name, err)
+				}
+				return attach(policyBuilder, decoded)
+			default:
+				var zero T
+				return fmt.Errorf("Add-on [%s] expects arguments of type %T, got %T.", name, zero, args)
+			}
+		},
+	})
+	return nil
+}
+
+// decodeAddonArgs decodes add-on arguments given as a map of their `pulumi` tags to plain values
+// into result, wrapping the values of input fields into the matching pulumi input types.
+func decodeAddonArgs(args map[string]interface{}, result interface{}) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName:     "pulumi",
+		ErrorUnused: true,
+		Result:      result,
+		DecodeHook:  decodeAddonInput,
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(args)
+}
+
+var (
+	stringInputType      = reflect.TypeOf((*pulumi.StringInput)(nil)).Elem()
+	stringPtrInputType   = reflect.TypeOf((*pulumi.StringPtrInput)(nil)).Elem()
+	intInputType         = reflect.TypeOf((*pulumi.IntInput)(nil)).Elem()
+	boolInputType        = reflect.TypeOf((*pulumi.BoolInput)(nil)).Elem()
+	stringArrayInputType = reflect.TypeOf((*pulumi.StringArrayInput)(nil)).Elem()
+	stringMapInputType   = reflect.TypeOf((*pulumi.StringMapInput)(nil)).Elem()
+)
+
+// decodeAddonInput wraps plain values decoded into pulumi input fields. Values that already are
+// inputs, e.g. outputs of other resources, are kept as is.
+func decodeAddonInput(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	if to.Kind() != reflect.Interface || data == nil || from.AssignableTo(to) {
+		return data, nil
+	}
+
+	switch to {
+	case stringInputType, stringPtrInputType:
+		value, ok := data.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %T", data)
+		}
+		if to == stringPtrInputType {
+			return pulumi.StringPtr(value), nil
+		}
+		return pulumi.String(value), nil
+	case intInputType:
+		switch value := data.(type) {
+		case int:
+			return pulumi.Int(value), nil
+		case float64:
+			return pulumi.Int(int(value)), nil
+		}
+		return nil, fmt.Errorf("expected a number, got %T", data)
+	case boolInputType:
+		value, ok := data.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool, got %T", data)
+		}
+		return pulumi.Bool(value), nil
+	case stringArrayInputType:
+		values, ok := data.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a list of strings, got %T", data)
+		}
+		result := pulumi.StringArray{}
+		for _, value := range values {
+			input, err := decodeAddonInput(reflect.TypeOf(value), stringInputType, value)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, input.(pulumi.StringInput))
+		}
+		return result, nil
+	case stringMapInputType:
+		values, ok := data.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a map of strings, got %T", data)
+		}
+		result := pulumi.StringMap{}
+		for key, value := range values {
+			input, err := decodeAddonInput(reflect.TypeOf(value), stringInputType, value)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = input.(pulumi.StringInput)
+		}
+		return result, nil
+	}
+
+	return data, nil
+}
+
+// MustRegisterAddon is like RegisterAddon but panics when the add-on cannot be registered.
+func MustRegisterAddon[T any](name, description string, attach func(policyBuilder *EKSRoleBuilder, args T) error) {
+	if err := RegisterAddon(name, description, attach); err != nil {
+		panic(err)
+	}
+}
+
+// Addons returns the registered add-ons, built-in ones first, in registration order.
+func Addons() []Addon {
+	addonsMu.RLock()
+	defer addonsMu.RUnlock()
+
+	result := make([]Addon, len(addons))
+	copy(result, addons)
+	return result
+}
+
+// LookupAddon returns the registered add-on with the given name.
+func LookupAddon(name string) (Addon, bool) {
+	addonsMu.RLock()
+	defer addonsMu.RUnlock()
+
+	for _, addon := range addons {
+		if addon.Name == name {
+			return addon, true
+		}
+	}
+	return Addon{}, false
+}
+
+func init() {
+	MustRegisterAddon("certManager", eksPolicyDescription, func(b *EKSRoleBuilder, args CertManagerPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachCertManagerPolicy(b.Ctx, b, b.AWSCurrentPartition, args)
+	})
+
+	MustRegisterAddon("clusterAutoScaling", clusterAutoscalerDescription, func(b *EKSRoleBuilder, args ClusterAutoScalingPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachClusterAutoscalerPolicy(b.Ctx, b, args)
+	})
+
+	MustRegisterAddon("ebsCsi", ebsCSIDescription, func(b *EKSRoleBuilder, args EBSCSIPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachEBSCSIPolicy(b.Ctx, b, b.AWSCurrentPartition, args)
+	})
+
+	MustRegisterAddon("efsCsi", efsCSIDescription, func(b *EKSRoleBuilder, args EFSCSIPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachEFSCSIPolicy(b)
+	})
+
+	MustRegisterAddon("externalDns", externalDNSDescription, func(b *EKSRoleBuilder, args ExternalDNSPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachExternalDNSPolicy(b.Ctx, b, args)
+	})
+
+	MustRegisterAddon("externalSecrets", externalSecretsDescription, func(b *EKSRoleBuilder, args ExternalSecretsPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachExternalSecretsPolicy(b.Ctx, b, args)
+	})
+
+	MustRegisterAddon("fsxLustreCsi", fsxLustreCSIDescription, func(b *EKSRoleBuilder, args FSXLustreCSIPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachFSXLustreCSIPolicy(b.Ctx, b, b.DNSSuffix, args)
+	})
+
+	MustRegisterAddon("karpenterController", karpenterControllerDescription, func(b *EKSRoleBuilder, args KarpenterControllerPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachKarpenterControllerPolicy(b.Ctx, b, b.AWSCurrentPartition, b.AWSAccountID, args)
+	})
+
+	MustRegisterAddon("loadBalancer", loadBalancerControllerDescription, func(b *EKSRoleBuilder, args LoadBalancerPolicyArgs) error {
+		if args.Controller {
+			if err := AttachLoadBalancerControllerPolicy(b.Ctx, b, b.AWSCurrentPartition, b.DNSSuffix, args); err != nil {
+				return err
+			}
+		}
+
+		if args.TargetGroupBindingOnly {
+			return AttachLoadBalancerTargetGroupBindingOnlyPolicy(b)
+		}
+		return nil
+	})
+
+	MustRegisterAddon("appmesh", appmeshControllerDescription, func(b *EKSRoleBuilder, args AppmeshPolicyArgs) error {
+		if args.Controller {
+			if err := AttachAppmeshControllerPolicy(b, b.AWSCurrentPartition, b.DNSSuffix); err != nil {
+				return err
+			}
+		}
+
+		if args.EnvoyProxy {
+			return AttachAppmeshEnvoyProxyPolicy(b)
+		}
+		return nil
+	})
+
+	MustRegisterAddon("amazonManagedServicePrometheus", amazonManagedServicePrometheusDescription, func(b *EKSRoleBuilder, args AmazonManagedServicePrometheusPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachAmazonManagedServicePrometheusPolicy(b.Ctx, b, args)
+	})
+
+	MustRegisterAddon("velero", veleroDescription, func(b *EKSRoleBuilder, args VeleroPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachVeleroPolicy(b.Ctx, b, args)
+	})
+
+	MustRegisterAddon("vpnCni", vpnCNIDescription, func(b *EKSRoleBuilder, args VPNCNIPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachVPNCNIPolicy(b, b.AWSCurrentPartition, args)
+	})
+
+	MustRegisterAddon("nodeTerminationHandler", nodeTerminationHandlerDescription, func(b *EKSRoleBuilder, args NodeTerminationHandlerPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachNodeTerminationPolicy(b.Ctx, b, args)
+	})
+
+	MustRegisterAddon("mountpointS3Csi", mountpointS3CSIDescription, func(b *EKSRoleBuilder, args MountpointS3CSIPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachMountpointS3CSIPolicy(b.Ctx, b, args)
+	})
+
+	MustRegisterAddon("cloudwatchObservability", cloudwatchObservabilityDescription, func(b *EKSRoleBuilder, args CloudWatchObservabilityPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachCloudWatchObservabilityPolicy(b, b.AWSCurrentPartition)
+	})
+
+	MustRegisterAddon("adot", adotDescription, func(b *EKSRoleBuilder, args ADOTPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachADOTPolicy(b.Ctx, b, args)
+	})
+
+	MustRegisterAddon("secretsStoreCsi", secretsStoreCSIDescription, func(b *EKSRoleBuilder, args SecretsStoreCSIPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachSecretsStoreCSIPolicy(b.Ctx, b, args)
+	})
+
+	MustRegisterAddon("gatewayApiController", gatewayAPIControllerDescription, func(b *EKSRoleBuilder, args GatewayAPIControllerPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachGatewayAPIControllerPolicy(b, b.AWSCurrentPartition)
+	})
+
+	MustRegisterAddon("keda", kedaDescription, func(b *EKSRoleBuilder, args KEDAPolicyArgs) error {
+		if !args.Attach {
+			return nil
+		}
+		return AttachKEDAPolicy(b.Ctx, b, args)
+	})
+}
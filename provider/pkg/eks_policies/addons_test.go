@@ -0,0 +1,138 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testAddonArgs struct {
+	Attach      bool                    `pulumi:"attach"`
+	Prefix      string                  `pulumi:"prefix"`
+	Count       int                     `pulumi:"count"`
+	BucketARN   pulumi.StringInput      `pulumi:"bucketArn"`
+	Namespaces  pulumi.StringArrayInput `pulumi:"namespaces"`
+	Labels      pulumi.StringMapInput   `pulumi:"labels"`
+	Descriptors []string                `pulumi:"descriptors"`
+}
+
+func registerTestAddon(t *testing.T, name string) *testAddonArgs {
+	var received testAddonArgs
+	require.NoError(t, RegisterAddon(name, "Test add-on.", func(_ *EKSRoleBuilder, args testAddonArgs) error {
+		received = args
+		return nil
+	}))
+
+	t.Cleanup(func() {
+		addonsMu.Lock()
+		defer addonsMu.Unlock()
+		for i, addon := range addons {
+			if addon.Name == name {
+				addons = append(addons[:i], addons[i+1:]...)
+				break
+			}
+		}
+	})
+
+	return &received
+}
+
+func TestAddonAttachFromRawMap(t *testing.T) {
+	received := registerTestAddon(t, "testRawMap")
+
+	addon, ok := LookupAddon("testRawMap")
+	require.True(t, ok)
+
+	err := addon.Attach(nil, map[string]interface{}{
+		"attach":      true,
+		"prefix":      "acme-",
+		"count":       float64(3),
+		"bucketArn":   "arn:aws:s3:::acme",
+		"namespaces":  []interface{}{"kube-system", "karpenter"},
+		"labels":      map[string]interface{}{"team": "platform"},
+		"descriptors": []interface{}{"a", "b"},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, received.Attach)
+	assert.Equal(t, "acme-", received.Prefix)
+	assert.Equal(t, 3, received.Count)
+	assert.Equal(t, pulumi.String("arn:aws:s3:::acme"), received.BucketARN)
+	assert.Equal(t, pulumi.StringArray{pulumi.String("kube-system"), pulumi.String("karpenter")}, received.Namespaces)
+	assert.Equal(t, pulumi.StringMap{"team": pulumi.String("platform")}, received.Labels)
+	assert.Equal(t, []string{"a", "b"}, received.Descriptors)
+}
+
+func TestAddonAttachFromTypedArgs(t *testing.T) {
+	received := registerTestAddon(t, "testTyped")
+
+	addon, ok := LookupAddon("testTyped")
+	require.True(t, ok)
+
+	require.NoError(t, addon.Attach(nil, testAddonArgs{Attach: true, Prefix: "typed-"}))
+	assert.Equal(t, "typed-", received.Prefix)
+
+	require.NoError(t, addon.Attach(nil, &testAddonArgs{Prefix: "pointer-"}))
+	assert.Equal(t, "pointer-", received.Prefix)
+}
+
+func TestAddonAttachFromRawMapErrors(t *testing.T) {
+	registerTestAddon(t, "testInvalid")
+
+	addon, ok := LookupAddon("testInvalid")
+	require.True(t, ok)
+
+	err := addon.Attach(nil, map[string]interface{}{"attach": true, "unknown": "x"})
+	assert.ErrorContains(t, err, "Add-on [testInvalid] has invalid arguments")
+
+	err = addon.Attach(nil, map[string]interface{}{"namespaces": "kube-system"})
+	assert.ErrorContains(t, err, "expected a list of strings")
+
+	err = addon.Attach(nil, 42)
+	assert.ErrorContains(t, err, "expects arguments of type")
+}
+
+func TestAddonSelected(t *testing.T) {
+	received := registerTestAddon(t, "testSelected")
+
+	addon, ok := LookupAddon("testSelected")
+	require.True(t, ok)
+
+	for _, args := range []interface{}{
+		nil,
+		testAddonArgs{Prefix: "typed-"},
+		&testAddonArgs{Prefix: "pointer-"},
+		(*testAddonArgs)(nil),
+		map[string]interface{}{"prefix": "raw-"},
+	} {
+		*received = testAddonArgs{}
+		require.NoError(t, addon.Attach(nil, addon.Selected(args)))
+		assert.True(t, received.Attach, "%#v", args)
+	}
+
+	assert.Equal(t, "raw-", received.Prefix)
+}
+
+func TestAddonSelectedWithoutAttachField(t *testing.T) {
+	addon, ok := LookupAddon("loadBalancer")
+	require.True(t, ok)
+
+	args := LoadBalancerPolicyArgs{Controller: true}
+	assert.Equal(t, args, addon.Selected(args))
+}
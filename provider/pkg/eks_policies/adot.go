@@ -0,0 +1,108 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	adotNamePrefix  = "ADOT_Collector_Policy-"
+	adotDescription = "Provides permissions for the AWS Distro for OpenTelemetry collector to export telemetry"
+
+	adotDefaultWorkspaceARN = "*"
+)
+
+type ADOTPolicyArgs struct {
+	// Determines whether to attach the AWS Distro for OpenTelemetry IAM policy to the role.
+	Attach bool `pulumi:"attach"`
+
+	// Allows exporting traces to AWS X-Ray.
+	EnableXray bool `pulumi:"enableXray"`
+
+	// Allows exporting metrics to Amazon Managed Service for Prometheus.
+	EnableAmp bool `pulumi:"enableAmp"`
+
+	// List of AMP Workspace ARNs to write metrics to.
+	AmpWorkspaceARNs pulumi.StringArrayInput `pulumi:"ampWorkspaceArns"`
+
+	// Allows exporting metrics and logs to CloudWatch.
+	EnableCloudwatch bool `pulumi:"enableCloudwatch"`
+}
+
+func AttachADOTPolicy(ctx *pulumi.Context, policyBuilder *EKSRoleBuilder, args ADOTPolicyArgs) error {
+	if !args.EnableXray && !args.EnableAmp && !args.EnableCloudwatch {
+		return fmt.Errorf("At least one of EnableXray, EnableAmp or EnableCloudwatch must be set for the ADOT policy.")
+	}
+
+	policyJSON := args.AmpWorkspaceARNs.ToStringArrayOutput().ApplyT(func(arns []string) (string, error) {
+		if len(arns) == 0 {
+			arns = append(arns, adotDefaultWorkspaceARN)
+		}
+
+		var policyStatements []iam.GetPolicyDocumentStatement
+		if args.EnableXray {
+			policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
+				Sid:       pulumi.StringRef("XRay"),
+				Resources: []string{"*"},
+				Actions: []string{
+					"xray:PutTraceSegments",
+					"xray:PutTelemetryRecords",
+					"xray:GetSamplingRules",
+					"xray:GetSamplingTargets",
+					"xray:GetSamplingStatisticSummaries",
+				},
+			})
+		}
+
+		if args.EnableAmp {
+			policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
+				Sid:       pulumi.StringRef("AMPRemoteWrite"),
+				Resources: arns,
+				Actions:   []string{"aps:RemoteWrite"},
+			})
+		}
+
+		if args.EnableCloudwatch {
+			policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
+				Sid:       pulumi.StringRef("CloudWatch"),
+				Resources: []string{"*"},
+				Actions: []string{
+					"cloudwatch:PutMetricData",
+					"logs:PutLogEvents",
+					"logs:PutRetentionPolicy",
+					"logs:DescribeLogStreams",
+					"logs:DescribeLogGroups",
+					"logs:CreateLogStream",
+					"logs:CreateLogGroup",
+				},
+			})
+		}
+
+		policyDoc, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
+			Statements: policyStatements,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return policyDoc.Json, err
+	}).(pulumi.StringOutput)
+
+	return policyBuilder.CreatePolicyWithAttachment(adotNamePrefix, adotDescription, policyJSON)
+}
@@ -0,0 +1,70 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	cloudwatchObservabilityNamePrefix  = "CloudWatch_Observability_Policy-"
+	cloudwatchObservabilityDescription = "Provides permissions for the CloudWatch agent to publish metrics, logs and traces"
+)
+
+type CloudWatchObservabilityPolicyArgs struct {
+	// Determines whether to attach the CloudWatch Observability IAM policy to the role.
+	Attach bool `pulumi:"attach"`
+}
+
+func AttachCloudWatchObservabilityPolicy(policyBuilder *EKSRoleBuilder, partition string) error {
+	policyStatements := []iam.GetPolicyDocumentStatement{
+		{
+			Sid:       pulumi.StringRef("CloudWatchAgent"),
+			Resources: []string{"*"},
+			Actions: []string{
+				"cloudwatch:PutMetricData",
+				"ec2:DescribeVolumes",
+				"ec2:DescribeTags",
+				"logs:PutLogEvents",
+				"logs:PutRetentionPolicy",
+				"logs:DescribeLogStreams",
+				"logs:DescribeLogGroups",
+				"logs:CreateLogStream",
+				"logs:CreateLogGroup",
+			},
+		},
+		{
+			Sid:       pulumi.StringRef("XRay"),
+			Resources: []string{"*"},
+			Actions: []string{
+				"xray:PutTraceSegments",
+				"xray:PutTelemetryRecords",
+				"xray:GetSamplingRules",
+				"xray:GetSamplingTargets",
+				"xray:GetSamplingStatisticSummaries",
+			},
+		},
+		{
+			Sid:       pulumi.StringRef("AgentConfig"),
+			Resources: []string{fmt.Sprintf("arn:%s:ssm:*:*:parameter/AmazonCloudWatch-*", partition)},
+			Actions:   []string{"ssm:GetParameter"},
+		},
+	}
+
+	return policyBuilder.CreatePolicyWithAttachmentGet(cloudwatchObservabilityNamePrefix, cloudwatchObservabilityDescription, policyStatements)
+}
@@ -32,40 +32,124 @@ type ClusterAutoScalingPolicyArgs struct {
 
 	// List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
 	ClusterIDs pulumi.StringArrayInput `pulumi:"clusterIds"`
+
+	// List of Auto Scaling group ARNs the Cluster Autoscaler can scale, e.g. the groups of specific node groups.
+	AutoScalingGroupARNs pulumi.StringArrayInput `pulumi:"autoScalingGroupArns"`
+
+	// List of tag keys which must all be present on the Auto Scaling groups the Cluster Autoscaler can scale,
+	// e.g. `k8s.io/cluster-autoscaler/enabled`.
+	TagKeys pulumi.StringArrayInput `pulumi:"tagKeys"`
+
+	// Allows eks:DescribeNodegroup, used to discover the labels and taints of managed node groups scaled from zero.
+	EnableDescribeNodegroup bool `pulumi:"enableDescribeNodegroup"`
+
+	// Only grants the read actions, e.g. for dashboards showing the autoscaler status. Without ClusterIDs,
+	// AutoScalingGroupARNs or TagKeys the policy is read only as well, with a warning.
+	ReadOnly bool `pulumi:"readOnly"`
 }
 
-func AttachClusterAutoscalerPolicy(ctx *pulumi.Context, policyBuilder *EKSRoleBuilder, args ClusterAutoScalingPolicyArgs) error {
-	policyJSON := args.ClusterIDs.ToStringArrayOutput().ApplyT(func(ids []string) (string, error) {
-		var policyStatements []iam.GetPolicyDocumentStatement
-		for _, id := range ids {
+var clusterAutoscalerScalingActions = []string{
+	"autoscaling:SetDesiredCapacity",
+	"autoscaling:TerminateInstanceInAutoScalingGroup",
+	"autoscaling:UpdateAutoScalingGroup",
+}
+
+func newClusterAutoscalerPolicyStatements(partition string, clusterIDs, asgARNs, tagKeys []string, args ClusterAutoScalingPolicyArgs) []iam.GetPolicyDocumentStatement {
+	var policyStatements []iam.GetPolicyDocumentStatement
+	if !args.ReadOnly {
+		var tagConditions []iam.GetPolicyDocumentStatementCondition
+		for _, key := range tagKeys {
+			tagConditions = append(tagConditions, NewPolicyDocCondition("Null", fmt.Sprintf("autoscaling:ResourceTag/%s", key), "false"))
+		}
+
+		for _, id := range clusterIDs {
 			policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
-				Actions: []string{
-					"autoscaling:SetDesiredCapacity",
-					"autoscaling:TerminateInstanceInAutoScalingGroup",
-					"autoscaling:UpdateAutoScalingGroup",
-				},
+				Actions:   clusterAutoscalerScalingActions,
 				Resources: []string{"*"},
-				Conditions: []iam.GetPolicyDocumentStatementCondition{
-					{
-						Test:     "StringEquals",
-						Variable: fmt.Sprintf("autoscaling:ResourceTag/kubernetes.io/cluster/%s", id),
-						Values:   []string{"owned"},
-					},
-				},
+				Conditions: append([]iam.GetPolicyDocumentStatementCondition{
+					NewPolicyDocCondition("StringEquals", fmt.Sprintf("autoscaling:ResourceTag/kubernetes.io/cluster/%s", id), "owned"),
+				}, tagConditions...),
+			})
+		}
+
+		if len(asgARNs) > 0 {
+			policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
+				Actions:    clusterAutoscalerScalingActions,
+				Resources:  asgARNs,
+				Conditions: tagConditions,
+			})
+		}
+
+		if len(clusterIDs) == 0 && len(asgARNs) == 0 && len(tagConditions) > 0 {
+			policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
+				Actions:    clusterAutoscalerScalingActions,
+				Resources:  []string{"*"},
+				Conditions: tagConditions,
 			})
 		}
+	}
+
+	policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
+		Actions: []string{
+			"autoscaling:DescribeAutoScalingGroups",
+			"autoscaling:DescribeAutoScalingInstances",
+			"autoscaling:DescribeLaunchConfigurations",
+			"autoscaling:DescribeScalingActivities",
+			"autoscaling:DescribeTags",
+			"ec2:DescribeImages",
+			"ec2:DescribeInstanceTypes",
+			"ec2:DescribeLaunchTemplateVersions",
+			"ec2:GetInstanceTypesFromInstanceRequirements",
+		},
+		Resources: []string{"*"},
+	})
+
+	if args.EnableDescribeNodegroup {
+		nodegroupARNs := []string{fmt.Sprintf("arn:%s:eks:*:*:nodegroup/*", partition)}
+		if len(clusterIDs) > 0 {
+			nodegroupARNs = nil
+			for _, id := range clusterIDs {
+				nodegroupARNs = append(nodegroupARNs, fmt.Sprintf("arn:%s:eks:*:*:nodegroup/%s/*/*", partition, id))
+			}
+		}
 
 		policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
-			Actions: []string{
-				"autoscaling:DescribeAutoScalingGroups",
-				"autoscaling:DescribeAutoScalingInstances",
-				"autoscaling:DescribeLaunchConfigurations",
-				"autoscaling:DescribeTags",
-				"ec2:DescribeLaunchTemplateVersions",
-				"ec2:DescribeInstanceTypes",
-			},
-			Resources: []string{"*"},
+			Actions:   []string{"eks:DescribeNodegroup"},
+			Resources: nodegroupARNs,
 		})
+	}
+
+	return policyStatements
+}
+
+func AttachClusterAutoscalerPolicy(ctx *pulumi.Context, policyBuilder *EKSRoleBuilder, args ClusterAutoScalingPolicyArgs) error {
+	if args.ClusterIDs == nil {
+		args.ClusterIDs = pulumi.ToStringArray(nil)
+	}
+
+	if args.AutoScalingGroupARNs == nil {
+		args.AutoScalingGroupARNs = pulumi.ToStringArray(nil)
+	}
+
+	if args.TagKeys == nil {
+		args.TagKeys = pulumi.ToStringArray(nil)
+	}
+
+	partition := policyBuilder.AWSCurrentPartition
+	if partition == "" {
+		partition = "aws"
+	}
+
+	policyJSON := pulumi.All(args.ClusterIDs, args.AutoScalingGroupARNs, args.TagKeys).ApplyT(func(x []interface{}) (string, error) {
+		clusterIDs, asgARNs, tagKeys := x[0].([]string), x[1].([]string), x[2].([]string)
+		if !args.ReadOnly && len(clusterIDs) == 0 && len(asgARNs) == 0 && len(tagKeys) == 0 {
+			msg := "None of ClusterIDs, AutoScalingGroupARNs or TagKeys is set, the Cluster Autoscaler policy only grants the read actions. Set ReadOnly to silence this warning."
+			if err := ctx.Log.Warn(msg, nil); err != nil {
+				return "", err
+			}
+		}
+
+		policyStatements := newClusterAutoscalerPolicyStatements(partition, clusterIDs, asgARNs, tagKeys, args)
 
 		policyDoc, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
 			Statements: policyStatements,
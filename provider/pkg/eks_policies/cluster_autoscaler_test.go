@@ -0,0 +1,70 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterAutoscalerPolicyStatements(t *testing.T) {
+	tests := []struct {
+		name       string
+		clusterIDs []string
+		asgARNs    []string
+		tagKeys    []string
+		args       ClusterAutoScalingPolicyArgs
+	}{
+		{
+			name:       "cluster_autoscaler_clusters",
+			clusterIDs: []string{"production", "staging"},
+			args:       ClusterAutoScalingPolicyArgs{EnableDescribeNodegroup: true},
+		},
+		{
+			name:       "cluster_autoscaler_clusters_and_tags",
+			clusterIDs: []string{"production"},
+			tagKeys:    []string{"k8s.io/cluster-autoscaler/enabled"},
+		},
+		{
+			name:    "cluster_autoscaler_asgs",
+			asgARNs: []string{"arn:aws:autoscaling:eu-west-1:123456789012:autoScalingGroup:*:autoScalingGroupName/workers"},
+			tagKeys: []string{"k8s.io/cluster-autoscaler/enabled", "k8s.io/cluster-autoscaler/production"},
+		},
+		{
+			name:    "cluster_autoscaler_tags",
+			tagKeys: []string{"k8s.io/cluster-autoscaler/enabled"},
+			args:    ClusterAutoScalingPolicyArgs{EnableDescribeNodegroup: true},
+		},
+		{
+			name: "cluster_autoscaler_read_only",
+			args: ClusterAutoScalingPolicyArgs{ReadOnly: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertGoldenPolicyDocument(t, tt.name, newClusterAutoscalerPolicyStatements("aws", tt.clusterIDs, tt.asgARNs, tt.tagKeys, tt.args))
+		})
+	}
+}
+
+func TestClusterAutoscalerPolicyWithoutScope(t *testing.T) {
+	statements := attachTestAddonPolicy(t, func(ctx *pulumi.Context, builder *EKSRoleBuilder) error {
+		return AttachClusterAutoscalerPolicy(ctx, builder, ClusterAutoScalingPolicyArgs{ClusterIDs: pulumi.StringArray{}})
+	})
+	assert.Equal(t, newClusterAutoscalerPolicyStatements("aws", nil, nil, nil, ClusterAutoScalingPolicyArgs{ReadOnly: true}), statements)
+}
@@ -15,6 +15,9 @@
 package eks_policies
 
 import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws-iam/pkg/utils"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
@@ -39,6 +42,68 @@ type EKSRoleBuilder struct {
 	BaseNamePrefix string
 	Path           pulumi.StringInput
 	Tags           pulumi.StringMapInput
+
+	// Policies created by the builder, in creation order.
+	Policies []*iam.Policy
+
+	// Name prefixes of the add-ons of the created policies.
+	policyNamePrefixes []string
+}
+
+// resourceName returns the name of the policy and attachment of an add-on, keyed by its policy name prefix.
+func (r *EKSRoleBuilder) resourceName(namePrefix string) string {
+	return fmt.Sprintf("%s-%s", r.Name, utils.HashKey(namePrefix))
+}
+
+// resourceOpts returns the options of the policy and attachment of the add-on policy at the index.
+// A single add-on policy used to be named after the builder, the alias of the first one keeps it on migration.
+func (r *EKSRoleBuilder) resourceOpts(index int, namePrefix string) []pulumi.ResourceOption {
+	if index > 0 {
+		return r.ResourceOpts
+	}
+
+	return utils.WithAliases(r.ResourceOpts, r.resourceName(namePrefix), r.Name)
+}
+
+// attach records a created policy and attaches it to the role. Builders without a role only
+// create policies, e.g. for standalone add-on policies or policies attached once the role is created.
+func (r *EKSRoleBuilder) attach(namePrefix string, policy *iam.Policy) error {
+	r.Policies = append(r.Policies, policy)
+	r.policyNamePrefixes = append(r.policyNamePrefixes, namePrefix)
+	if r.Role == nil {
+		return nil
+	}
+
+	return r.attachPolicy(len(r.Policies) - 1)
+}
+
+func (r *EKSRoleBuilder) attachPolicy(index int) error {
+	namePrefix := r.policyNamePrefixes[index]
+	_, err := iam.NewRolePolicyAttachment(r.Ctx, r.resourceName(namePrefix), &iam.RolePolicyAttachmentArgs{
+		Role:      r.Role.Name,
+		PolicyArn: r.Policies[index].Arn,
+	}, r.resourceOpts(index, namePrefix)...)
+	return err
+}
+
+// AttachPolicies attaches the policies created so far to a role created after them.
+func (r *EKSRoleBuilder) AttachPolicies(role *iam.Role) error {
+	r.Role = role
+	for i := range r.Policies {
+		if err := r.attachPolicy(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PolicyARNs returns the ARNs of the policies created so far.
+func (r *EKSRoleBuilder) PolicyARNs() []pulumi.StringInput {
+	var arns []pulumi.StringInput
+	for _, policy := range r.Policies {
+		arns = append(arns, policy.Arn)
+	}
+	return arns
 }
 
 func CreateNewRoleBuilder(ctx *pulumi.Context, role *iam.Role, name, baseNamePrefix string,
@@ -62,39 +127,31 @@ func (r *EKSRoleBuilder) CreatePolicyWithAttachmentGet(namePrefix, description s
 		return err
 	}
 
-	policy, err := iam.NewPolicy(r.Ctx, r.Name, &iam.PolicyArgs{
+	policy, err := iam.NewPolicy(r.Ctx, r.resourceName(namePrefix), &iam.PolicyArgs{
 		NamePrefix:  pulumi.Sprintf("%s%s", r.BaseNamePrefix, namePrefix),
 		Path:        r.Path,
 		Description: pulumi.String(description),
 		Policy:      pulumi.String(policyDoc.Json),
 		Tags:        r.Tags,
-	}, r.ResourceOpts...)
+	}, r.resourceOpts(len(r.Policies), namePrefix)...)
 	if err != nil {
 		return err
 	}
 
-	_, err = iam.NewRolePolicyAttachment(r.Ctx, r.Name, &iam.RolePolicyAttachmentArgs{
-		Role:      r.Role.Name,
-		PolicyArn: policy.Arn,
-	}, r.ResourceOpts...)
-	return err
+	return r.attach(namePrefix, policy)
 }
 
 func (r *EKSRoleBuilder) CreatePolicyWithAttachment(namePrefix, description string, policyDocJSON pulumi.StringOutput) error {
-	policy, err := iam.NewPolicy(r.Ctx, r.Name, &iam.PolicyArgs{
+	policy, err := iam.NewPolicy(r.Ctx, r.resourceName(namePrefix), &iam.PolicyArgs{
 		NamePrefix:  pulumi.Sprintf("%s%s", r.BaseNamePrefix, namePrefix),
 		Path:        r.Path,
 		Description: pulumi.String(description),
 		Policy:      policyDocJSON,
 		Tags:        r.Tags,
-	}, r.ResourceOpts...)
+	}, r.resourceOpts(len(r.Policies), namePrefix)...)
 	if err != nil {
 		return err
 	}
 
-	_, err = iam.NewRolePolicyAttachment(r.Ctx, r.Name, &iam.RolePolicyAttachmentArgs{
-		Role:      r.Role.Name,
-		PolicyArn: policy.Arn,
-	}, r.ResourceOpts...)
-	return err
+	return r.attach(namePrefix, policy)
 }
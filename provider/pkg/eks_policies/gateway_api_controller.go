@@ -0,0 +1,78 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	gatewayAPIControllerNamePrefix  = "Gateway_API_Controller_Policy-"
+	gatewayAPIControllerDescription = "Provides permissions for the AWS Gateway API Controller to manage Amazon VPC Lattice resources"
+)
+
+type GatewayAPIControllerPolicyArgs struct {
+	// Determines whether to attach the VPC Lattice Gateway API Controller IAM policy to the role.
+	Attach bool `pulumi:"attach"`
+}
+
+func AttachGatewayAPIControllerPolicy(policyBuilder *EKSRoleBuilder, partition string) error {
+	policyStatements := []iam.GetPolicyDocumentStatement{
+		{
+			Sid:       pulumi.StringRef("VPCLattice"),
+			Resources: []string{"*"},
+			Actions: []string{
+				"vpc-lattice:*",
+				"ec2:DescribeVpcs",
+				"ec2:DescribeSubnets",
+				"ec2:DescribeTags",
+				"ec2:DescribeSecurityGroups",
+				"logs:CreateLogDelivery",
+				"logs:GetLogDelivery",
+				"logs:DescribeLogGroups",
+				"logs:PutResourcePolicy",
+				"logs:DescribeResourcePolicies",
+				"logs:UpdateLogDelivery",
+				"logs:DeleteLogDelivery",
+				"logs:ListLogDeliveries",
+				"tag:GetResources",
+				"firehose:TagDeliveryStream",
+				"s3:GetBucketPolicy",
+				"s3:PutBucketPolicy",
+			},
+		},
+		{
+			Sid:       pulumi.StringRef("VPCLatticeServiceLinkedRole"),
+			Resources: []string{fmt.Sprintf("arn:%s:iam::*:role/aws-service-role/vpc-lattice.amazonaws.com/AWSServiceRoleForVpcLattice", partition)},
+			Actions:   []string{"iam:CreateServiceLinkedRole"},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringLike", "iam:AWSServiceName", "vpc-lattice.amazonaws.com"),
+			},
+		},
+		{
+			Sid:       pulumi.StringRef("LogDeliveryServiceLinkedRole"),
+			Resources: []string{fmt.Sprintf("arn:%s:iam::*:role/aws-service-role/delivery.logs.amazonaws.com/AWSServiceRoleForLogDelivery", partition)},
+			Actions:   []string{"iam:CreateServiceLinkedRole"},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringLike", "iam:AWSServiceName", "delivery.logs.amazonaws.com"),
+			},
+		},
+	}
+
+	return policyBuilder.CreatePolicyWithAttachmentGet(gatewayAPIControllerNamePrefix, gatewayAPIControllerDescription, policyStatements)
+}
@@ -27,6 +27,12 @@ const (
 
 	karpenterControllerDefaultSSMParameterARN = "arn:aws:ssm:*:*:parameter/aws/service/*"
 	karpenterControllerDefaultNodeIAMRoleARN  = "*"
+
+	// KarpenterControllerPolicyV0 is the policy of Karpenter releases before v1, using a single discovery tag.
+	KarpenterControllerPolicyV0 = "v0"
+
+	// KarpenterControllerPolicyV1 is the policy of the Karpenter v1 controller, scoped by the cluster and node pool tags.
+	KarpenterControllerPolicyV1 = "v1"
 )
 
 type KarpenterControllerPolicyArgs struct {
@@ -42,14 +48,32 @@ type KarpenterControllerPolicyArgs struct {
 	// List of SSM Parameter ARNs that contain AMI IDs launched by Karpenter.
 	SSMParameterARNs pulumi.StringArrayInput `pulumi:"ssmParameterArns"`
 
-	// List of node IAM role ARNs Karpenter can use to launch nodes.
+	// List of node IAM role ARNs Karpenter can use to launch nodes. Required by the `v1` policy.
 	NodeIAMRoleARNS pulumi.StringArrayInput `pulumi:"nodeIamRoleArns"`
 
 	// Account ID of where the subnets Karpenter will utilize resides. Used when subnets are shared from another account.
 	SubnetAccountID pulumi.StringInput `pulumi:"subnetAccountId"`
+
+	// Version of the Karpenter controller policy, either `v0` or `v1`. Defaults to `v0`.
+	Version string `pulumi:"version"`
+
+	// ARN of the SQS queue Karpenter reads interruption events from. Only used by the `v1` policy.
+	InterruptionQueueARN pulumi.StringInput `pulumi:"interruptionQueueArn"`
+
+	// Region of the cluster. Only used by the `v1` policy, defaults to the region of the provider.
+	Region pulumi.StringInput `pulumi:"region"`
 }
 
 func AttachKarpenterControllerPolicy(ctx *pulumi.Context, policyBuilder *EKSRoleBuilder, partition, awsAccountID string, args KarpenterControllerPolicyArgs) error {
+	switch args.Version {
+	case "", KarpenterControllerPolicyV0:
+	case KarpenterControllerPolicyV1:
+		return attachKarpenterControllerV1Policy(ctx, policyBuilder, partition, awsAccountID, args)
+	default:
+		return fmt.Errorf("Unsupported Karpenter controller policy version [%s], expected %s or %s.",
+			args.Version, KarpenterControllerPolicyV0, KarpenterControllerPolicyV1)
+	}
+
 	if args.ClusterID == nil {
 		args.ClusterID = pulumi.String("*")
 	}
@@ -73,11 +97,12 @@ func AttachKarpenterControllerPolicy(ctx *pulumi.Context, policyBuilder *EKSRole
 		nodeIAMRoleARNS = pulumi.ToStringArray([]string{"*"})
 	}
 
-	policyJSON := pulumi.All(karpenterSubnetId, args.ClusterID, ssmParameterARNs, nodeIAMRoleARNS).ApplyT(func(x []interface{}) (string, error) {
+	policyJSON := pulumi.All(karpenterSubnetId, args.ClusterID, ssmParameterARNs, nodeIAMRoleARNS, args.TagKey).ApplyT(func(x []interface{}) (string, error) {
 		kId := x[0].(string)
 		cId := x[1].(string)
 		ssm := x[2].([]string)
 		node := x[3].([]string)
+		tagKey := x[4].(string)
 
 		if len(ssm) == 0 {
 			ssm = append(ssm, karpenterControllerDefaultSSMParameterARN)
@@ -109,7 +134,7 @@ func AttachKarpenterControllerPolicy(ctx *pulumi.Context, policyBuilder *EKSRole
 				},
 				Resources: []string{"*"},
 				Conditions: []iam.GetPolicyDocumentStatementCondition{
-					NewPolicyDocCondition("StringEquals", fmt.Sprintf("ec2:ResourceTag/%s", args.TagKey), cId),
+					NewPolicyDocCondition("StringEquals", fmt.Sprintf("ec2:ResourceTag/%s", tagKey), cId),
 				},
 			},
 			{
@@ -120,7 +145,7 @@ func AttachKarpenterControllerPolicy(ctx *pulumi.Context, policyBuilder *EKSRole
 					fmt.Sprintf("arn:%s:ec2:*:%s:subnet/*", partition, kId),
 				},
 				Conditions: []iam.GetPolicyDocumentStatementCondition{
-					NewPolicyDocCondition("StringEquals", fmt.Sprintf("ec2:ResourceTag/%s", args.TagKey), cId),
+					NewPolicyDocCondition("StringEquals", fmt.Sprintf("ec2:ResourceTag/%s", tagKey), cId),
 				},
 			},
 			{
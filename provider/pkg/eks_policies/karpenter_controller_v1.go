@@ -0,0 +1,277 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type karpenterControllerV1PolicyArgs struct {
+	partition            string
+	accountID            string
+	dnsSuffix            string
+	region               string
+	clusterName          string
+	ssmParameterARNs     []string
+	nodeIAMRoleARNs      []string
+	interruptionQueueARN string
+}
+
+func newKarpenterControllerV1PolicyStatements(args karpenterControllerV1PolicyArgs) []iam.GetPolicyDocumentStatement {
+	arn := func(service, accountID, resource string) string {
+		return fmt.Sprintf("arn:%s:%s:%s:%s:%s", args.partition, service, args.region, accountID, resource)
+	}
+
+	clusterTag := fmt.Sprintf("kubernetes.io/cluster/%s", args.clusterName)
+	nodeResources := []string{
+		arn("ec2", "*", "fleet/*"),
+		arn("ec2", "*", "instance/*"),
+		arn("ec2", "*", "volume/*"),
+		arn("ec2", "*", "network-interface/*"),
+		arn("ec2", "*", "launch-template/*"),
+		arn("ec2", "*", "spot-instances-request/*"),
+	}
+	instanceProfiles := fmt.Sprintf("arn:%s:iam::%s:instance-profile/*", args.partition, args.accountID)
+
+	policyStatements := []iam.GetPolicyDocumentStatement{
+		{
+			Sid:     pulumi.StringRef("AllowScopedEC2InstanceAccessActions"),
+			Actions: []string{"ec2:RunInstances", "ec2:CreateFleet"},
+			Resources: []string{
+				arn("ec2", "", "image/*"),
+				arn("ec2", "", "snapshot/*"),
+				arn("ec2", "*", "security-group/*"),
+				arn("ec2", "*", "subnet/*"),
+				arn("ec2", "*", "capacity-reservation/*"),
+			},
+		},
+		{
+			Sid:       pulumi.StringRef("AllowScopedEC2LaunchTemplateAccessActions"),
+			Actions:   []string{"ec2:RunInstances", "ec2:CreateFleet"},
+			Resources: []string{arn("ec2", "*", "launch-template/*")},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringEquals", fmt.Sprintf("aws:ResourceTag/%s", clusterTag), "owned"),
+				NewPolicyDocCondition("StringLike", "aws:ResourceTag/karpenter.sh/nodepool", "*"),
+			},
+		},
+		{
+			Sid:       pulumi.StringRef("AllowScopedEC2InstanceActionsWithTags"),
+			Actions:   []string{"ec2:RunInstances", "ec2:CreateFleet", "ec2:CreateLaunchTemplate"},
+			Resources: nodeResources,
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringEquals", fmt.Sprintf("aws:RequestTag/%s", clusterTag), "owned"),
+				NewPolicyDocCondition("StringEquals", "aws:RequestTag/eks:eks-cluster-name", args.clusterName),
+				NewPolicyDocCondition("StringLike", "aws:RequestTag/karpenter.sh/nodepool", "*"),
+			},
+		},
+		{
+			Sid:       pulumi.StringRef("AllowScopedResourceCreationTagging"),
+			Actions:   []string{"ec2:CreateTags"},
+			Resources: nodeResources,
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringEquals", fmt.Sprintf("aws:RequestTag/%s", clusterTag), "owned"),
+				NewPolicyDocCondition("StringEquals", "aws:RequestTag/eks:eks-cluster-name", args.clusterName),
+				NewPolicyDocCondition("StringEquals", "ec2:CreateAction", "RunInstances", "CreateFleet", "CreateLaunchTemplate"),
+				NewPolicyDocCondition("StringLike", "aws:RequestTag/karpenter.sh/nodepool", "*"),
+			},
+		},
+		{
+			Sid:       pulumi.StringRef("AllowScopedResourceTagging"),
+			Actions:   []string{"ec2:CreateTags"},
+			Resources: []string{arn("ec2", "*", "instance/*")},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringEquals", fmt.Sprintf("aws:ResourceTag/%s", clusterTag), "owned"),
+				NewPolicyDocCondition("StringLike", "aws:ResourceTag/karpenter.sh/nodepool", "*"),
+				NewPolicyDocCondition("StringEqualsIfExists", "aws:RequestTag/eks:eks-cluster-name", args.clusterName),
+				NewPolicyDocCondition("ForAllValues:StringEquals", "aws:TagKeys", "eks:eks-cluster-name", "karpenter.sh/nodeclaim", "Name"),
+			},
+		},
+		{
+			Sid:       pulumi.StringRef("AllowScopedDeletion"),
+			Actions:   []string{"ec2:TerminateInstances", "ec2:DeleteLaunchTemplate"},
+			Resources: []string{arn("ec2", "*", "instance/*"), arn("ec2", "*", "launch-template/*")},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringEquals", fmt.Sprintf("aws:ResourceTag/%s", clusterTag), "owned"),
+				NewPolicyDocCondition("StringLike", "aws:ResourceTag/karpenter.sh/nodepool", "*"),
+			},
+		},
+		{
+			Sid: pulumi.StringRef("AllowRegionalReadActions"),
+			Actions: []string{
+				"ec2:DescribeAvailabilityZones",
+				"ec2:DescribeImages",
+				"ec2:DescribeInstances",
+				"ec2:DescribeInstanceTypeOfferings",
+				"ec2:DescribeInstanceTypes",
+				"ec2:DescribeLaunchTemplates",
+				"ec2:DescribeSecurityGroups",
+				"ec2:DescribeSpotPriceHistory",
+				"ec2:DescribeSubnets",
+			},
+			Resources: []string{"*"},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringEquals", "aws:RequestedRegion", args.region),
+			},
+		},
+		{
+			Sid:       pulumi.StringRef("AllowSSMReadActions"),
+			Actions:   []string{"ssm:GetParameter"},
+			Resources: args.ssmParameterARNs,
+		},
+		{
+			Sid:       pulumi.StringRef("AllowPricingReadActions"),
+			Actions:   []string{"pricing:GetProducts"},
+			Resources: []string{"*"},
+		},
+	}
+
+	if args.interruptionQueueARN != "" {
+		policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
+			Sid:       pulumi.StringRef("AllowInterruptionQueueActions"),
+			Actions:   []string{"sqs:DeleteMessage", "sqs:GetQueueUrl", "sqs:ReceiveMessage"},
+			Resources: []string{args.interruptionQueueARN},
+		})
+	}
+
+	policyStatements = append(policyStatements,
+		iam.GetPolicyDocumentStatement{
+			Sid:       pulumi.StringRef("AllowPassingInstanceRole"),
+			Actions:   []string{"iam:PassRole"},
+			Resources: args.nodeIAMRoleARNs,
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringEquals", "iam:PassedToService", fmt.Sprintf("ec2.%s", args.dnsSuffix)),
+			},
+		},
+		iam.GetPolicyDocumentStatement{
+			Sid:       pulumi.StringRef("AllowScopedInstanceProfileCreationActions"),
+			Actions:   []string{"iam:CreateInstanceProfile"},
+			Resources: []string{instanceProfiles},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringEquals", fmt.Sprintf("aws:RequestTag/%s", clusterTag), "owned"),
+				NewPolicyDocCondition("StringEquals", "aws:RequestTag/eks:eks-cluster-name", args.clusterName),
+				NewPolicyDocCondition("StringEquals", "aws:RequestTag/topology.kubernetes.io/region", args.region),
+				NewPolicyDocCondition("StringLike", "aws:RequestTag/karpenter.k8s.aws/ec2nodeclass", "*"),
+			},
+		},
+		iam.GetPolicyDocumentStatement{
+			Sid:       pulumi.StringRef("AllowScopedInstanceProfileTagActions"),
+			Actions:   []string{"iam:TagInstanceProfile"},
+			Resources: []string{instanceProfiles},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringEquals", fmt.Sprintf("aws:ResourceTag/%s", clusterTag), "owned"),
+				NewPolicyDocCondition("StringEquals", "aws:ResourceTag/topology.kubernetes.io/region", args.region),
+				NewPolicyDocCondition("StringEquals", fmt.Sprintf("aws:RequestTag/%s", clusterTag), "owned"),
+				NewPolicyDocCondition("StringEquals", "aws:RequestTag/eks:eks-cluster-name", args.clusterName),
+				NewPolicyDocCondition("StringEquals", "aws:RequestTag/topology.kubernetes.io/region", args.region),
+				NewPolicyDocCondition("StringLike", "aws:ResourceTag/karpenter.k8s.aws/ec2nodeclass", "*"),
+				NewPolicyDocCondition("StringLike", "aws:RequestTag/karpenter.k8s.aws/ec2nodeclass", "*"),
+			},
+		},
+		iam.GetPolicyDocumentStatement{
+			Sid:       pulumi.StringRef("AllowScopedInstanceProfileActions"),
+			Actions:   []string{"iam:AddRoleToInstanceProfile", "iam:RemoveRoleFromInstanceProfile", "iam:DeleteInstanceProfile"},
+			Resources: []string{instanceProfiles},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringEquals", fmt.Sprintf("aws:ResourceTag/%s", clusterTag), "owned"),
+				NewPolicyDocCondition("StringEquals", "aws:ResourceTag/topology.kubernetes.io/region", args.region),
+				NewPolicyDocCondition("StringLike", "aws:ResourceTag/karpenter.k8s.aws/ec2nodeclass", "*"),
+			},
+		},
+		iam.GetPolicyDocumentStatement{
+			Sid:       pulumi.StringRef("AllowInstanceProfileReadActions"),
+			Actions:   []string{"iam:GetInstanceProfile"},
+			Resources: []string{instanceProfiles},
+		},
+		iam.GetPolicyDocumentStatement{
+			Sid:       pulumi.StringRef("AllowAPIServerEndpointDiscovery"),
+			Actions:   []string{"eks:DescribeCluster"},
+			Resources: []string{arn("eks", args.accountID, fmt.Sprintf("cluster/%s", args.clusterName))},
+		},
+	)
+
+	return policyStatements
+}
+
+func attachKarpenterControllerV1Policy(ctx *pulumi.Context, policyBuilder *EKSRoleBuilder, partition, awsAccountID string, args KarpenterControllerPolicyArgs) error {
+	if args.ClusterID == nil {
+		return fmt.Errorf("ClusterID is required for the %s Karpenter controller policy.", KarpenterControllerPolicyV1)
+	}
+
+	if args.Region == nil {
+		region, err := aws.GetRegion(ctx, nil)
+		if err != nil {
+			return err
+		}
+		args.Region = pulumi.String(region.Name)
+	}
+
+	if args.SSMParameterARNs == nil {
+		args.SSMParameterARNs = pulumi.ToStringArray(nil)
+	}
+
+	if args.NodeIAMRoleARNS == nil {
+		return fmt.Errorf("NodeIAMRoleARNs is required for the %s Karpenter controller policy.", KarpenterControllerPolicyV1)
+	}
+
+	if args.InterruptionQueueARN == nil {
+		args.InterruptionQueueARN = pulumi.String("")
+	}
+
+	dnsSuffix := policyBuilder.DNSSuffix
+	if dnsSuffix == "" {
+		dnsSuffix = "amazonaws.com"
+	}
+
+	policyJSON := pulumi.All(args.ClusterID, args.Region, args.SSMParameterARNs, args.NodeIAMRoleARNS, args.InterruptionQueueARN).ApplyT(func(x []interface{}) (string, error) {
+		v1Args := karpenterControllerV1PolicyArgs{
+			partition:            partition,
+			accountID:            awsAccountID,
+			dnsSuffix:            dnsSuffix,
+			clusterName:          x[0].(string),
+			region:               x[1].(string),
+			ssmParameterARNs:     x[2].([]string),
+			nodeIAMRoleARNs:      x[3].([]string),
+			interruptionQueueARN: x[4].(string),
+		}
+
+		if v1Args.clusterName == "" || v1Args.clusterName == "*" {
+			return "", fmt.Errorf("The %s Karpenter controller policy is scoped to a cluster, ClusterID must be a cluster name.", KarpenterControllerPolicyV1)
+		}
+
+		if len(v1Args.ssmParameterARNs) == 0 {
+			v1Args.ssmParameterARNs = []string{fmt.Sprintf("arn:%s:ssm:%s::parameter/aws/service/*", partition, v1Args.region)}
+		}
+
+		// Passing any role would let the controller escalate to it through the instances it launches.
+		if len(v1Args.nodeIAMRoleARNs) == 0 {
+			return "", fmt.Errorf("NodeIAMRoleARNs is required for the %s Karpenter controller policy.", KarpenterControllerPolicyV1)
+		}
+
+		policyDoc, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
+			Statements: newKarpenterControllerV1PolicyStatements(v1Args),
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return policyDoc.Json, err
+	}).(pulumi.StringOutput)
+
+	return policyBuilder.CreatePolicyWithAttachment(karpenterControllerNamePrefix, karpenterControllerDescription, policyJSON)
+}
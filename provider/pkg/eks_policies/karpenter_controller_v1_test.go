@@ -0,0 +1,76 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKarpenterControllerV1PolicyStatements(t *testing.T) {
+	tests := []struct {
+		name string
+		args karpenterControllerV1PolicyArgs
+	}{
+		{
+			name: "karpenter_controller_v1",
+			args: karpenterControllerV1PolicyArgs{
+				partition:        "aws",
+				accountID:        "123456789012",
+				dnsSuffix:        "amazonaws.com",
+				region:           "eu-west-1",
+				clusterName:      "production",
+				ssmParameterARNs: []string{"arn:aws:ssm:eu-west-1::parameter/aws/service/*"},
+				nodeIAMRoleARNs:  []string{"arn:aws:iam::123456789012:role/KarpenterNodeRole"},
+			},
+		},
+		{
+			name: "karpenter_controller_v1_interruption_queue",
+			args: karpenterControllerV1PolicyArgs{
+				partition:            "aws-cn",
+				accountID:            "123456789012",
+				dnsSuffix:            "amazonaws.com.cn",
+				region:               "cn-north-1",
+				clusterName:          "production",
+				ssmParameterARNs:     []string{"arn:aws-cn:ssm:cn-north-1::parameter/aws/service/eks/*"},
+				nodeIAMRoleARNs:      []string{"arn:aws-cn:iam::123456789012:role/KarpenterNodeRole", "arn:aws-cn:iam::123456789012:role/KarpenterGpuNodeRole"},
+				interruptionQueueARN: "arn:aws-cn:sqs:cn-north-1:123456789012:production",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertGoldenPolicyDocument(t, tt.name, newKarpenterControllerV1PolicyStatements(tt.args))
+		})
+	}
+}
+
+func TestKarpenterControllerV1PolicyRequiresNodeIAMRoles(t *testing.T) {
+	for _, nodeIAMRoleARNs := range []pulumi.StringArrayInput{nil, pulumi.StringArray{}} {
+		err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+			builder := CreateNewRoleBuilder(ctx, nil, "test", "test-", pulumi.String("/"), nil)
+			return AttachKarpenterControllerPolicy(ctx, builder, "aws", "123456789012", KarpenterControllerPolicyArgs{
+				Version:         KarpenterControllerPolicyV1,
+				ClusterID:       pulumi.String("production"),
+				Region:          pulumi.String("eu-west-1"),
+				NodeIAMRoleARNS: nodeIAMRoleARNs,
+			})
+		}, pulumi.WithMocks("project", "stack", &policyDocumentMocks{}))
+		assert.ErrorContains(t, err, "NodeIAMRoleARNs is required for the v1 Karpenter controller policy.")
+	}
+}
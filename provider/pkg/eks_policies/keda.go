@@ -0,0 +1,90 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	kedaNamePrefix  = "KEDA_Policy-"
+	kedaDescription = "Provides permissions for KEDA to read the DynamoDB tables and SQS queues it scales workloads on"
+)
+
+type KEDAPolicyArgs struct {
+	// Determines whether to attach the KEDA IAM policy to the role.
+	Attach bool `pulumi:"attach"`
+
+	// List of DynamoDB table ARNs queried by the aws-dynamodb scaler.
+	DynamoDBTableARNs pulumi.StringArrayInput `pulumi:"dynamodbTableArns"`
+
+	// List of SQS queue ARNs read by the aws-sqs-queue scaler.
+	SQSQueueARNs pulumi.StringArrayInput `pulumi:"sqsQueueArns"`
+}
+
+func AttachKEDAPolicy(ctx *pulumi.Context, policyBuilder *EKSRoleBuilder, args KEDAPolicyArgs) error {
+	if args.DynamoDBTableARNs == nil {
+		args.DynamoDBTableARNs = pulumi.ToStringArray(nil)
+	}
+
+	if args.SQSQueueARNs == nil {
+		args.SQSQueueARNs = pulumi.ToStringArray(nil)
+	}
+
+	policyJSON := pulumi.All(args.DynamoDBTableARNs, args.SQSQueueARNs).ApplyT(func(x []interface{}) (string, error) {
+		tableARNs := x[0].([]string)
+		queueARNs := x[1].([]string)
+
+		if len(tableARNs) == 0 && len(queueARNs) == 0 {
+			return "", fmt.Errorf("At least one DynamoDB table ARN or SQS queue ARN is required for the KEDA policy.")
+		}
+
+		var policyStatements []iam.GetPolicyDocumentStatement
+		if len(tableARNs) > 0 {
+			var resources []string
+			for _, table := range tableARNs {
+				resources = append(resources, table, fmt.Sprintf("%s/index/*", table))
+			}
+
+			policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
+				Sid:       pulumi.StringRef("DynamoDBScaler"),
+				Resources: resources,
+				Actions:   []string{"dynamodb:DescribeTable", "dynamodb:Query"},
+			})
+		}
+
+		if len(queueARNs) > 0 {
+			policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
+				Sid:       pulumi.StringRef("SQSScaler"),
+				Resources: queueARNs,
+				Actions:   []string{"sqs:GetQueueAttributes"},
+			})
+		}
+
+		policyDoc, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
+			Statements: policyStatements,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return policyDoc.Json, err
+	}).(pulumi.StringOutput)
+
+	return policyBuilder.CreatePolicyWithAttachment(kedaNamePrefix, kedaDescription, policyJSON)
+}
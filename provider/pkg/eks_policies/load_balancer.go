@@ -16,8 +16,11 @@ package eks_policies
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
 const (
@@ -26,6 +29,9 @@ const (
 
 	loadBalancerTargetGroupBindingOnlyNamePrefix  = "AWS_Load_Balancer_Controller_TargetGroup_Only-"
 	loadBalancerTargetGroupBindingOnlyDescription = "Provides permissions for AWS Load Balancer Controller addon in TargetGroup binding only scenario"
+
+	loadBalancerControllerDefaultVersion       = "v2.4"
+	loadBalancerControllerDefaultClusterTagKey = "elbv2.k8s.aws/cluster"
 )
 
 type LoadBalancerPolicyArgs struct {
@@ -34,89 +40,183 @@ type LoadBalancerPolicyArgs struct {
 
 	// Determines whether to attach the Load Balancer Controller policy for the TargetGroupBinding only.
 	TargetGroupBindingOnly bool `pulumi:"targetGroupBindingOnly"`
+
+	// Version of the Load Balancer Controller the policy is for, e.g. `v2.7`. Defaults to `v2.4`.
+	ControllerVersion string `pulumi:"controllerVersion"`
+
+	// Leaves out the WAF, WAFv2 and Shield permissions, for controllers running with these integrations disabled.
+	RestrictWafShield bool `pulumi:"restrictWafShield"`
+
+	// Tag key the controller adds to the resources it manages. Defaults to `elbv2.k8s.aws/cluster`.
+	ClusterTagKey string `pulumi:"clusterTagKey"`
+
+	// Name of the cluster. When set, the controller can only manage resources whose cluster tag has this value.
+	ClusterName pulumi.StringInput `pulumi:"clusterName"`
+
+	// List of security group ARNs the controller can change the ingress rules of. Defaults to all security groups.
+	RestrictedSecurityGroupARNs pulumi.StringArrayInput `pulumi:"restrictedSecurityGroupArns"`
 }
 
-func AttachLoadBalancerControllerPolicy(policyBuilder *EKSRoleBuilder, partition, dnsSuffix string) error {
+// parseLoadBalancerControllerVersion returns the minor version of a v2 Load Balancer Controller release.
+func parseLoadBalancerControllerVersion(version string) (int, error) {
+	if version == "" {
+		version = loadBalancerControllerDefaultVersion
+	}
+
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if len(parts) < 2 || parts[0] != "2" {
+		return 0, fmt.Errorf("Unsupported Load Balancer Controller version [%s], expected a v2 release such as v2.7.", version)
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("Unsupported Load Balancer Controller version [%s], expected a v2 release such as v2.7.", version)
+	}
+	return minor, nil
+}
+
+type loadBalancerControllerPolicyArgs struct {
+	partition         string
+	dnsSuffix         string
+	minorVersion      int
+	restrictWafShield bool
+	clusterTagKey     string
+	clusterName       string
+	securityGroupARNs []string
+}
+
+func newLoadBalancerControllerPolicyStatements(args loadBalancerControllerPolicyArgs) []iam.GetPolicyDocumentStatement {
+	requestTag := fmt.Sprintf("aws:RequestTag/%s", args.clusterTagKey)
+	resourceTag := fmt.Sprintf("aws:ResourceTag/%s", args.clusterTagKey)
+
+	// Conditions requiring the cluster tag, scoped to the cluster when its name is known.
+	hasRequestTag := NewPolicyDocCondition("Null", requestTag, "false")
+	hasResourceTag := NewPolicyDocCondition("Null", resourceTag, "false")
+	if args.clusterName != "" {
+		hasRequestTag = NewPolicyDocCondition("StringEquals", requestTag, args.clusterName)
+		hasResourceTag = NewPolicyDocCondition("StringEquals", resourceTag, args.clusterName)
+	}
+
+	describeActions := []string{
+		"ec2:DescribeAccountAttributes",
+		"ec2:DescribeAddresses",
+		"ec2:DescribeAvailabilityZones",
+		"ec2:DescribeInternetGateways",
+		"ec2:DescribeVpcs",
+		"ec2:DescribeVpcPeeringConnections",
+		"ec2:DescribeSubnets",
+		"ec2:DescribeSecurityGroups",
+		"ec2:DescribeInstances",
+		"ec2:DescribeNetworkInterfaces",
+		"ec2:DescribeTags",
+		"ec2:GetCoipPoolUsage",
+		"ec2:DescribeCoipPools",
+		"elasticloadbalancing:DescribeLoadBalancers",
+		"elasticloadbalancing:DescribeLoadBalancerAttributes",
+		"elasticloadbalancing:DescribeListeners",
+		"elasticloadbalancing:DescribeListenerCertificates",
+		"elasticloadbalancing:DescribeSSLPolicies",
+		"elasticloadbalancing:DescribeRules",
+		"elasticloadbalancing:DescribeTargetGroups",
+		"elasticloadbalancing:DescribeTargetGroupAttributes",
+		"elasticloadbalancing:DescribeTargetHealth",
+		"elasticloadbalancing:DescribeTags",
+	}
+	if args.minorVersion >= 7 {
+		describeActions = append(describeActions, "ec2:GetSecurityGroupsForVpc", "elasticloadbalancing:DescribeTrustStores")
+	}
+	if args.minorVersion >= 9 {
+		describeActions = append(describeActions, "elasticloadbalancing:DescribeListenerAttributes")
+	}
+
+	integrationActions := []string{
+		"cognito-idp:DescribeUserPoolClient",
+		"acm:ListCertificates",
+		"acm:DescribeCertificate",
+		"iam:ListServerCertificates",
+		"iam:GetServerCertificate",
+	}
+	if !args.restrictWafShield {
+		integrationActions = append(integrationActions,
+			"waf-regional:GetWebACL",
+			"waf-regional:GetWebACLForResource",
+			"waf-regional:AssociateWebACL",
+			"waf-regional:DisassociateWebACL",
+			"wafv2:GetWebACL",
+			"wafv2:GetWebACLForResource",
+			"wafv2:AssociateWebACL",
+			"wafv2:DisassociateWebACL",
+			"shield:GetSubscriptionState",
+			"shield:DescribeProtection",
+			"shield:CreateProtection",
+			"shield:DeleteProtection",
+		)
+	}
+
+	loadBalancerResources := []string{
+		fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:targetgroup/*/*", args.partition),
+		fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:loadbalancer/net/*/*", args.partition),
+		fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:loadbalancer/app/*/*", args.partition),
+	}
+
 	policyStatements := []iam.GetPolicyDocumentStatement{
 		{
 			Actions:   []string{"iam:CreateServiceLinkedRole"},
 			Resources: []string{"*"},
 			Conditions: []iam.GetPolicyDocumentStatementCondition{
-				NewPolicyDocCondition("StringEquals", "iam:AWSServiceName", fmt.Sprintf("elasticloadbalancing.%s", dnsSuffix)),
+				NewPolicyDocCondition("StringEquals", "iam:AWSServiceName", fmt.Sprintf("elasticloadbalancing.%s", args.dnsSuffix)),
 			},
 		},
 		{
 			Resources: []string{"*"},
-			Actions: []string{
-				"ec2:DescribeAccountAttributes",
-				"ec2:DescribeAddresses",
-				"ec2:DescribeAvailabilityZones",
-				"ec2:DescribeInternetGateways",
-				"ec2:DescribeVpcs",
-				"ec2:DescribeVpcPeeringConnections",
-				"ec2:DescribeSubnets",
-				"ec2:DescribeSecurityGroups",
-				"ec2:DescribeInstances",
-				"ec2:DescribeNetworkInterfaces",
-				"ec2:DescribeTags",
-				"ec2:GetCoipPoolUsage",
-				"ec2:DescribeCoipPools",
-				"elasticloadbalancing:DescribeLoadBalancers",
-				"elasticloadbalancing:DescribeLoadBalancerAttributes",
-				"elasticloadbalancing:DescribeListeners",
-				"elasticloadbalancing:DescribeListenerCertificates",
-				"elasticloadbalancing:DescribeSSLPolicies",
-				"elasticloadbalancing:DescribeRules",
-				"elasticloadbalancing:DescribeTargetGroups",
-				"elasticloadbalancing:DescribeTargetGroupAttributes",
-				"elasticloadbalancing:DescribeTargetHealth",
-				"elasticloadbalancing:DescribeTags",
-			},
+			Actions:   describeActions,
 		},
 		{
 			Resources: []string{"*"},
-			Actions: []string{
-				"cognito-idp:DescribeUserPoolClient",
-				"acm:ListCertificates",
-				"acm:DescribeCertificate",
-				"iam:ListServerCertificates",
-				"iam:GetServerCertificate",
-				"waf-regional:GetWebACL",
-				"waf-regional:GetWebACLForResource",
-				"waf-regional:AssociateWebACL",
-				"waf-regional:DisassociateWebACL",
-				"wafv2:GetWebACL",
-				"wafv2:GetWebACLForResource",
-				"wafv2:AssociateWebACL",
-				"wafv2:DisassociateWebACL",
-				"shield:GetSubscriptionState",
-				"shield:DescribeProtection",
-				"shield:CreateProtection",
-				"shield:DeleteProtection",
-			},
+			Actions:   integrationActions,
 		},
-		{
+	}
+
+	if len(args.securityGroupARNs) == 0 {
+		policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
 			Resources: []string{"*"},
 			Actions: []string{
 				"ec2:AuthorizeSecurityGroupIngress",
 				"ec2:RevokeSecurityGroupIngress",
 				"ec2:CreateSecurityGroup",
 			},
-		},
+		})
+	} else {
+		policyStatements = append(policyStatements,
+			iam.GetPolicyDocumentStatement{
+				Resources: args.securityGroupARNs,
+				Actions: []string{
+					"ec2:AuthorizeSecurityGroupIngress",
+					"ec2:RevokeSecurityGroupIngress",
+				},
+			},
+			iam.GetPolicyDocumentStatement{
+				Resources: []string{"*"},
+				Actions:   []string{"ec2:CreateSecurityGroup"},
+			},
+		)
+	}
+
+	policyStatements = append(policyStatements, []iam.GetPolicyDocumentStatement{
 		{
-			Resources: []string{fmt.Sprintf("arn:%s:ec2:*:*:security-group/*", partition)},
+			Resources: []string{fmt.Sprintf("arn:%s:ec2:*:*:security-group/*", args.partition)},
 			Actions:   []string{"ec2:CreateTags"},
 			Conditions: []iam.GetPolicyDocumentStatementCondition{
 				NewPolicyDocCondition("StringEquals", "ec2:CreateAction", "CreateSecurityGroup"),
-				NewPolicyDocCondition("Null", "aws:RequestTag/elbv2.k8s.aws/cluster", "false"),
+				hasRequestTag,
 			},
 		},
 		{
 			Actions:   []string{"ec2:CreateTags", "ec2:DeleteTags"},
-			Resources: []string{fmt.Sprintf("arn:%s:ec2:*:*:security-group/*", partition)},
+			Resources: []string{fmt.Sprintf("arn:%s:ec2:*:*:security-group/*", args.partition)},
 			Conditions: []iam.GetPolicyDocumentStatementCondition{
-				NewPolicyDocCondition("Null", "aws:RequestTag/elbv2.k8s.aws/cluster", "true"),
-				NewPolicyDocCondition("Null", "aws:ResourceTag/elbv2.k8s.aws/cluster", "false"),
+				NewPolicyDocCondition("Null", requestTag, "true"),
+				hasResourceTag,
 			},
 		},
 		{
@@ -126,9 +226,7 @@ func AttachLoadBalancerControllerPolicy(policyBuilder *EKSRoleBuilder, partition
 				"ec2:RevokeSecurityGroupIngress",
 				"ec2:DeleteSecurityGroup",
 			},
-			Conditions: []iam.GetPolicyDocumentStatementCondition{
-				NewPolicyDocCondition("Null", "aws:ResourceTag/elbv2.k8s.aws/cluster", "false"),
-			},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{hasResourceTag},
 		},
 		{
 			Resources: []string{"*"},
@@ -136,9 +234,7 @@ func AttachLoadBalancerControllerPolicy(policyBuilder *EKSRoleBuilder, partition
 				"elasticloadbalancing:CreateLoadBalancer",
 				"elasticloadbalancing:CreateTargetGroup",
 			},
-			Conditions: []iam.GetPolicyDocumentStatementCondition{
-				NewPolicyDocCondition("Null", "aws:RequestTag/elbv2.k8s.aws/cluster", "false"),
-			},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{hasRequestTag},
 		},
 		{
 			Resources: []string{"*"},
@@ -154,14 +250,10 @@ func AttachLoadBalancerControllerPolicy(policyBuilder *EKSRoleBuilder, partition
 				"elasticloadbalancing:AddTags",
 				"elasticloadbalancing:RemoveTags",
 			},
-			Resources: []string{
-				fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:targetgroup/*/*", partition),
-				fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:loadbalancer/net/*/*", partition),
-				fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:loadbalancer/app/*/*", partition),
-			},
+			Resources: loadBalancerResources,
 			Conditions: []iam.GetPolicyDocumentStatementCondition{
-				NewPolicyDocCondition("Null", "aws:RequestTag/elbv2.k8s.aws/cluster", "true"),
-				NewPolicyDocCondition("Null", "aws:ResourceTag/elbv2.k8s.aws/cluster", "false"),
+				NewPolicyDocCondition("Null", requestTag, "true"),
+				hasResourceTag,
 			},
 		},
 		{
@@ -170,10 +262,10 @@ func AttachLoadBalancerControllerPolicy(policyBuilder *EKSRoleBuilder, partition
 				"elasticloadbalancing:RemoveTags",
 			},
 			Resources: []string{
-				fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:listener/net/*/*/*", partition),
-				fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:listener/app/*/*/*", partition),
-				fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:listener-rule/net/*/*/*", partition),
-				fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:listener-rule/app/*/*/*", partition),
+				fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:listener/net/*/*/*", args.partition),
+				fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:listener/app/*/*/*", args.partition),
+				fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:listener-rule/net/*/*/*", args.partition),
+				fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:listener-rule/app/*/*/*", args.partition),
 			},
 		},
 		{
@@ -188,30 +280,91 @@ func AttachLoadBalancerControllerPolicy(policyBuilder *EKSRoleBuilder, partition
 				"elasticloadbalancing:ModifyTargetGroupAttributes",
 				"elasticloadbalancing:DeleteTargetGroup",
 			},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{hasResourceTag},
+		},
+	}...)
+
+	if args.minorVersion >= 5 {
+		policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
+			Actions:   []string{"elasticloadbalancing:AddTags"},
+			Resources: loadBalancerResources,
 			Conditions: []iam.GetPolicyDocumentStatementCondition{
-				NewPolicyDocCondition("Null", "aws:ResourceTag/elbv2.k8s.aws/cluster", "false"),
+				NewPolicyDocCondition("StringEquals", "elasticloadbalancing:CreateAction", "CreateTargetGroup", "CreateLoadBalancer"),
+				hasRequestTag,
 			},
-		},
-		{
+		})
+	}
+
+	listenerActions := []string{
+		"elasticloadbalancing:ModifyListener",
+		"elasticloadbalancing:AddListenerCertificates",
+		"elasticloadbalancing:RemoveListenerCertificates",
+		"elasticloadbalancing:ModifyRule",
+	}
+	if !args.restrictWafShield {
+		listenerActions = append([]string{"elasticloadbalancing:SetWebAcl"}, listenerActions...)
+	}
+	if args.minorVersion >= 9 {
+		listenerActions = append(listenerActions, "elasticloadbalancing:ModifyListenerAttributes")
+	}
+
+	policyStatements = append(policyStatements,
+		iam.GetPolicyDocumentStatement{
 			Actions: []string{
 				"elasticloadbalancing:RegisterTargets",
 				"elasticloadbalancing:DeregisterTargets",
 			},
-			Resources: []string{fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:targetgroup/*/*", partition)},
+			Resources: []string{fmt.Sprintf("arn:%s:elasticloadbalancing:*:*:targetgroup/*/*", args.partition)},
 		},
-		{
+		iam.GetPolicyDocumentStatement{
 			Resources: []string{"*"},
-			Actions: []string{
-				"elasticloadbalancing:SetWebAcl",
-				"elasticloadbalancing:ModifyListener",
-				"elasticloadbalancing:AddListenerCertificates",
-				"elasticloadbalancing:RemoveListenerCertificates",
-				"elasticloadbalancing:ModifyRule",
-			},
+			Actions:   listenerActions,
 		},
+	)
+
+	return policyStatements
+}
+
+func AttachLoadBalancerControllerPolicy(ctx *pulumi.Context, policyBuilder *EKSRoleBuilder, partition, dnsSuffix string, args LoadBalancerPolicyArgs) error {
+	minorVersion, err := parseLoadBalancerControllerVersion(args.ControllerVersion)
+	if err != nil {
+		return err
 	}
 
-	return policyBuilder.CreatePolicyWithAttachmentGet(loadBalancerControllerNamePrefix, loadBalancerControllerDescription, policyStatements)
+	if args.ClusterTagKey == "" {
+		args.ClusterTagKey = loadBalancerControllerDefaultClusterTagKey
+	}
+
+	if args.ClusterName == nil {
+		args.ClusterName = pulumi.String("")
+	}
+
+	if args.RestrictedSecurityGroupARNs == nil {
+		args.RestrictedSecurityGroupARNs = pulumi.ToStringArray(nil)
+	}
+
+	policyJSON := pulumi.All(args.ClusterName, args.RestrictedSecurityGroupARNs).ApplyT(func(x []interface{}) (string, error) {
+		policyStatements := newLoadBalancerControllerPolicyStatements(loadBalancerControllerPolicyArgs{
+			partition:         partition,
+			dnsSuffix:         dnsSuffix,
+			minorVersion:      minorVersion,
+			restrictWafShield: args.RestrictWafShield,
+			clusterTagKey:     args.ClusterTagKey,
+			clusterName:       x[0].(string),
+			securityGroupARNs: x[1].([]string),
+		})
+
+		policyDoc, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
+			Statements: policyStatements,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return policyDoc.Json, err
+	}).(pulumi.StringOutput)
+
+	return policyBuilder.CreatePolicyWithAttachment(loadBalancerControllerNamePrefix, loadBalancerControllerDescription, policyJSON)
 }
 
 func AttachLoadBalancerTargetGroupBindingOnlyPolicy(policyBuilder *EKSRoleBuilder) error {
@@ -0,0 +1,82 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadBalancerControllerPolicyStatements(t *testing.T) {
+	tests := []struct {
+		name              string
+		version           string
+		restrictWafShield bool
+		clusterName       string
+		securityGroupARNs []string
+	}{
+		{
+			name: "load_balancer_controller_v2_4",
+		},
+		{
+			name:    "load_balancer_controller_v2_5",
+			version: "v2.5.4",
+		},
+		{
+			name:        "load_balancer_controller_v2_7",
+			version:     "v2.7",
+			clusterName: "production",
+		},
+		{
+			name:              "load_balancer_controller_v2_9",
+			version:           "2.9.0",
+			securityGroupARNs: []string{"arn:aws:ec2:eu-west-1:123456789012:security-group/sg-0123456789abcdef0"},
+		},
+		{
+			name:              "load_balancer_controller_v2_9_restrict_waf_shield",
+			version:           "v2.9",
+			restrictWafShield: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minorVersion, err := parseLoadBalancerControllerVersion(tt.version)
+			require.NoError(t, err)
+
+			statements := newLoadBalancerControllerPolicyStatements(loadBalancerControllerPolicyArgs{
+				partition:         "aws",
+				dnsSuffix:         "amazonaws.com",
+				minorVersion:      minorVersion,
+				restrictWafShield: tt.restrictWafShield,
+				clusterTagKey:     loadBalancerControllerDefaultClusterTagKey,
+				clusterName:       tt.clusterName,
+				securityGroupARNs: tt.securityGroupARNs,
+			})
+			assertGoldenPolicyDocument(t, tt.name, statements)
+		})
+	}
+}
+
+func TestParseLoadBalancerControllerVersion(t *testing.T) {
+	for _, version := range []string{"v1.1", "v3.0", "latest", "v2", "v2.x"} {
+		t.Run(version, func(t *testing.T) {
+			_, err := parseLoadBalancerControllerVersion(version)
+			assert.EqualError(t, err, "Unsupported Load Balancer Controller version ["+version+"], expected a v2 release such as v2.7.")
+		})
+	}
+}
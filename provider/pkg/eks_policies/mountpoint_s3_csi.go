@@ -0,0 +1,124 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	mountpointS3CSINamePrefix  = "Mountpoint_S3_CSI_Policy-"
+	mountpointS3CSIDescription = "Provides permissions to mount S3 buckets via the Mountpoint for Amazon S3 container storage interface driver"
+)
+
+type MountpointS3CSIPolicyArgs struct {
+	// Determines whether to attach the Mountpoint for Amazon S3 CSI IAM policy to the role.
+	Attach bool `pulumi:"attach"`
+
+	// List of S3 Bucket ARNs the driver is allowed to mount.
+	S3BucketARNs pulumi.StringArrayInput `pulumi:"s3BucketArns"`
+
+	// List of key prefixes within the buckets the driver is allowed to access. Defaults to the whole bucket.
+	S3PathPrefixes pulumi.StringArrayInput `pulumi:"s3PathPrefixes"`
+
+	// List of KMS key ARNs used to encrypt the objects in the buckets.
+	KMSKeyARNs pulumi.StringArrayInput `pulumi:"kmsKeyArns"`
+}
+
+func AttachMountpointS3CSIPolicy(ctx *pulumi.Context, policyBuilder *EKSRoleBuilder, args MountpointS3CSIPolicyArgs) error {
+	if args.S3BucketARNs == nil {
+		args.S3BucketARNs = pulumi.ToStringArray(nil)
+	}
+
+	if args.S3PathPrefixes == nil {
+		args.S3PathPrefixes = pulumi.ToStringArray(nil)
+	}
+
+	if args.KMSKeyARNs == nil {
+		args.KMSKeyARNs = pulumi.ToStringArray(nil)
+	}
+
+	policyJSON := pulumi.All(args.S3BucketARNs, args.S3PathPrefixes, args.KMSKeyARNs).ApplyT(func(x []interface{}) (string, error) {
+		bucketARNs := x[0].([]string)
+		pathPrefixes := x[1].([]string)
+		kmsKeyARNs := x[2].([]string)
+
+		if len(bucketARNs) == 0 {
+			return "", fmt.Errorf("At least one S3 bucket ARN is required for the Mountpoint S3 CSI policy.")
+		}
+
+		listBucket := iam.GetPolicyDocumentStatement{
+			Sid:       pulumi.StringRef("MountpointListBucket"),
+			Actions:   []string{"s3:ListBucket"},
+			Resources: bucketARNs,
+		}
+
+		var objectARNs []string
+		if len(pathPrefixes) == 0 {
+			for _, bucket := range bucketARNs {
+				objectARNs = append(objectARNs, fmt.Sprintf("%s/*", bucket))
+			}
+		} else {
+			var listPrefixes []string
+			for _, prefix := range pathPrefixes {
+				prefix = strings.Trim(prefix, "/")
+				listPrefixes = append(listPrefixes, prefix, fmt.Sprintf("%s/*", prefix))
+				for _, bucket := range bucketARNs {
+					objectARNs = append(objectARNs, fmt.Sprintf("%s/%s/*", bucket, prefix))
+				}
+			}
+			listBucket.Conditions = []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("StringLike", "s3:prefix", listPrefixes...),
+			}
+		}
+
+		policyStatements := []iam.GetPolicyDocumentStatement{
+			listBucket,
+			{
+				Sid:       pulumi.StringRef("MountpointObjectAccess"),
+				Resources: objectARNs,
+				Actions: []string{
+					"s3:GetObject",
+					"s3:PutObject",
+					"s3:AbortMultipartUpload",
+					"s3:DeleteObject",
+				},
+			},
+		}
+
+		if len(kmsKeyARNs) > 0 {
+			policyStatements = append(policyStatements, iam.GetPolicyDocumentStatement{
+				Sid:       pulumi.StringRef("MountpointKMS"),
+				Resources: kmsKeyARNs,
+				Actions:   []string{"kms:GenerateDataKey", "kms:Decrypt"},
+			})
+		}
+
+		policyDoc, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
+			Statements: policyStatements,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return policyDoc.Json, err
+	}).(pulumi.StringOutput)
+
+	return policyBuilder.CreatePolicyWithAttachment(mountpointS3CSINamePrefix, mountpointS3CSIDescription, policyJSON)
+}
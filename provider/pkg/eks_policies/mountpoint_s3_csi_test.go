@@ -0,0 +1,52 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func TestMountpointS3CSIPolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		args MountpointS3CSIPolicyArgs
+	}{
+		{
+			name: "mountpoint_s3_csi",
+			args: MountpointS3CSIPolicyArgs{
+				S3BucketARNs: pulumi.ToStringArray([]string{"arn:aws:s3:::datasets"}),
+			},
+		},
+		{
+			name: "mountpoint_s3_csi_prefixes_kms",
+			args: MountpointS3CSIPolicyArgs{
+				S3BucketARNs:   pulumi.ToStringArray([]string{"arn:aws:s3:::datasets"}),
+				S3PathPrefixes: pulumi.ToStringArray([]string{"/training/"}),
+				KMSKeyARNs:     pulumi.ToStringArray([]string{"arn:aws:kms:eu-west-1:123456789012:key/datasets"}),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statements := attachTestAddonPolicy(t, func(ctx *pulumi.Context, builder *EKSRoleBuilder) error {
+				return AttachMountpointS3CSIPolicy(ctx, builder, tt.args)
+			})
+			assertGoldenPolicyDocument(t, tt.name, statements)
+		})
+	}
+}
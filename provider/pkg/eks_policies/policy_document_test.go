@@ -0,0 +1,164 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden policy documents in testdata")
+
+type testPolicyDocumentStatement struct {
+	Sid       string                            `json:"Sid,omitempty"`
+	Effect    string                            `json:"Effect"`
+	Action    interface{}                       `json:"Action"`
+	Resource  interface{}                       `json:"Resource,omitempty"`
+	Condition map[string]map[string]interface{} `json:"Condition,omitempty"`
+}
+
+// renderPolicyDocument renders the statements the way iam.GetPolicyDocument does, lists with a single
+// value are collapsed to the value.
+func renderPolicyDocument(t *testing.T, statements []iam.GetPolicyDocumentStatement) string {
+	collapse := func(values []string) interface{} {
+		switch len(values) {
+		case 0:
+			return nil
+		case 1:
+			return values[0]
+		default:
+			return values
+		}
+	}
+
+	rendered := make([]testPolicyDocumentStatement, 0, len(statements))
+	for _, statement := range statements {
+		s := testPolicyDocumentStatement{
+			Effect:   "Allow",
+			Action:   collapse(statement.Actions),
+			Resource: collapse(statement.Resources),
+		}
+		if statement.Sid != nil {
+			s.Sid = *statement.Sid
+		}
+		if statement.Effect != nil {
+			s.Effect = *statement.Effect
+		}
+
+		for _, condition := range statement.Conditions {
+			if s.Condition == nil {
+				s.Condition = map[string]map[string]interface{}{}
+			}
+			if s.Condition[condition.Test] == nil {
+				s.Condition[condition.Test] = map[string]interface{}{}
+			}
+			_, duplicate := s.Condition[condition.Test][condition.Variable]
+			require.False(t, duplicate, "statement %d repeats the condition %s %s", len(rendered), condition.Test, condition.Variable)
+			s.Condition[condition.Test][condition.Variable] = collapse(condition.Values)
+		}
+
+		rendered = append(rendered, s)
+	}
+
+	document, err := json.MarshalIndent(struct {
+		Version   string
+		Statement []testPolicyDocumentStatement
+	}{"2012-10-17", rendered}, "", "  ")
+	require.NoError(t, err)
+
+	return string(document) + "\n"
+}
+
+// assertGoldenPolicyDocument compares the rendered statements with testdata/<name>.json, run the tests with
+// -update to rewrite the golden documents.
+func assertGoldenPolicyDocument(t *testing.T, name string, statements []iam.GetPolicyDocumentStatement) {
+	t.Helper()
+
+	document := renderPolicyDocument(t, statements)
+	path := filepath.Join("testdata", name+".json")
+	if *updateGolden {
+		require.NoError(t, os.MkdirAll("testdata", 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(document), 0o644))
+	}
+
+	golden, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(golden), document)
+}
+
+// policyDocumentMocks records the statements of the getPolicyDocument invokes and the created policies.
+type policyDocumentMocks struct {
+	mu         sync.Mutex
+	statements [][]iam.GetPolicyDocumentStatement
+	policies   int
+}
+
+func (m *policyDocumentMocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	if args.TypeToken == "aws:iam/policy:Policy" {
+		m.mu.Lock()
+		m.policies++
+		m.mu.Unlock()
+	}
+	return args.Name + "_id", args.Inputs, nil
+}
+
+func (m *policyDocumentMocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	if args.Token != "aws:iam/getPolicyDocument:getPolicyDocument" {
+		return resource.PropertyMap{}, nil
+	}
+
+	// The field names of the invoke arguments match the SDK types, which JSON decodes case-insensitively.
+	raw, err := json.Marshal(args.Args.Mappable())
+	if err != nil {
+		return nil, err
+	}
+
+	var policyDocArgs iam.GetPolicyDocumentArgs
+	if err := json.Unmarshal(raw, &policyDocArgs); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	m.statements = append(m.statements, policyDocArgs.Statements)
+	m.mu.Unlock()
+
+	return resource.NewPropertyMapFromMap(map[string]interface{}{"json": "{}"}), nil
+}
+
+// attachTestAddonPolicy runs attach with a role builder on mocks and returns the statements of the single
+// policy it creates.
+func attachTestAddonPolicy(t *testing.T, attach func(ctx *pulumi.Context, builder *EKSRoleBuilder) error) []iam.GetPolicyDocumentStatement {
+	t.Helper()
+
+	mocks := &policyDocumentMocks{}
+	err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+		return attach(ctx, CreateNewRoleBuilder(ctx, nil, "test", "test-", pulumi.String("/"), nil))
+	}, pulumi.WithMocks("project", "stack", mocks))
+	require.NoError(t, err)
+
+	require.Equal(t, 1, mocks.policies)
+	require.Len(t, mocks.statements, 1)
+	return mocks.statements[0]
+}
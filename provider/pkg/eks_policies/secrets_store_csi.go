@@ -0,0 +1,84 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	secretsStoreCSINamePrefix  = "Secrets_Store_CSI_Policy-"
+	secretsStoreCSIDescription = "Provides permissions for the AWS provider of the Secrets Store CSI driver to mount secrets and parameters"
+
+	secretsStoreCSIDefaultSSMParameterARN   = "arn:aws:ssm:*:*:parameter/*"
+	secretsStoreCSIDefaultSecretsManagerARN = "arn:aws:secretsmanager:*:*:secret:*"
+)
+
+type SecretsStoreCSIPolicyArgs struct {
+	// Determines whether to attach the Secrets Store CSI driver IAM policy to the role.
+	Attach bool `pulumi:"attach"`
+
+	// List of Systems Manager Parameter ARNs that contain secrets to mount using the Secrets Store CSI driver.
+	SSMParameterARNs pulumi.StringArrayInput `pulumi:"ssmParameterArns"`
+
+	// List of Secrets Manager ARNs that contain secrets to mount using the Secrets Store CSI driver.
+	SecretsManagerARNs pulumi.StringArrayInput `pulumi:"secretsManagerArns"`
+}
+
+func AttachSecretsStoreCSIPolicy(ctx *pulumi.Context, policyBuilder *EKSRoleBuilder, args SecretsStoreCSIPolicyArgs) error {
+	if args.SSMParameterARNs == nil {
+		args.SSMParameterARNs = pulumi.ToStringArray(nil)
+	}
+
+	if args.SecretsManagerARNs == nil {
+		args.SecretsManagerARNs = pulumi.ToStringArray(nil)
+	}
+
+	policyJSON := pulumi.All(args.SSMParameterARNs, args.SecretsManagerARNs).ApplyT(func(x []interface{}) (string, error) {
+		ssmParameterARNs := x[0].([]string)
+		secretsManagerARNs := x[1].([]string)
+
+		if len(ssmParameterARNs) == 0 {
+			ssmParameterARNs = append(ssmParameterARNs, secretsStoreCSIDefaultSSMParameterARN)
+		}
+
+		if len(secretsManagerARNs) == 0 {
+			secretsManagerARNs = append(secretsManagerARNs, secretsStoreCSIDefaultSecretsManagerARN)
+		}
+
+		policyStatements := []iam.GetPolicyDocumentStatement{
+			{
+				Actions:   []string{"ssm:GetParameters"},
+				Resources: ssmParameterARNs,
+			},
+			{
+				Actions:   []string{"secretsmanager:GetSecretValue", "secretsmanager:DescribeSecret"},
+				Resources: secretsManagerARNs,
+			},
+		}
+
+		policyDoc, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
+			Statements: policyStatements,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return policyDoc.Json, err
+	}).(pulumi.StringOutput)
+
+	return policyBuilder.CreatePolicyWithAttachment(secretsStoreCSINamePrefix, secretsStoreCSIDescription, policyJSON)
+}
@@ -0,0 +1,47 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eks_policies
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func TestSecretsStoreCSIPolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		args SecretsStoreCSIPolicyArgs
+	}{
+		{
+			name: "secrets_store_csi",
+		},
+		{
+			name: "secrets_store_csi_secrets_manager",
+			args: SecretsStoreCSIPolicyArgs{
+				SecretsManagerARNs: pulumi.ToStringArray([]string{"arn:aws:secretsmanager:eu-west-1:123456789012:secret:app-*"}),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statements := attachTestAddonPolicy(t, func(ctx *pulumi.Context, builder *EKSRoleBuilder) error {
+				return AttachSecretsStoreCSIPolicy(ctx, builder, tt.args)
+			})
+			assertGoldenPolicyDocument(t, tt.name, statements)
+		})
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi-aws-iam/pkg/action_catalog"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const AbacPolicyIdentifier = "aws-iam:index:AbacPolicy"
+
+type AbacActionGroupArgs struct {
+	// Name of the group, used as the statement ID and unique across the groups. Defaults to the service, suffixed with
+	// the position of the group when the service is used by more than one unnamed group.
+	Name string `pulumi:"name"`
+
+	// IAM prefix of the service, e.g. `ec2`.
+	Service string `pulumi:"service"`
+
+	// Actions allowed on resources whose tags match the principal tags. Actions without a
+	// service prefix are prefixed with the service.
+	Actions []string `pulumi:"actions"`
+
+	// Actions creating resources, only allowed when the request tags match the principal tags.
+	CreateActions []string `pulumi:"createActions"`
+
+	// Resources the actions apply to. Defaults to `*`.
+	Resources []string `pulumi:"resources"`
+}
+
+type AbacPolicyArgs struct {
+	// The name of the policy.
+	Name string `pulumi:"name"`
+
+	// The path of the policy in IAM.
+	Path string `pulumi:"path"`
+
+	// The description of the policy.
+	Description string `pulumi:"description"`
+
+	// A map of tags to add.
+	Tags map[string]string `pulumi:"tags"`
+
+	// Tag keys that must match between the principal and the resources.
+	TagKeys []string `pulumi:"tagKeys"`
+
+	// Groups of actions to allow for each service.
+	ActionGroups []AbacActionGroupArgs `pulumi:"actionGroups"`
+
+	// Whether to leave out the statements which prevent principals from changing the ABAC tags of resources.
+	AllowTagModification bool `pulumi:"allowTagModification"`
+}
+
+type AbacPolicy struct {
+	pulumi.ResourceState
+
+	// Policy document as json.
+	PolicyJSON pulumi.StringOutput `pulumi:"policyJson"`
+
+	// The policy's ID.
+	ID pulumi.StringOutput `pulumi:"id"`
+
+	// The name of the policy.
+	Name pulumi.StringOutput `pulumi:"name"`
+
+	// The ARN assigned by AWS to this policy.
+	ARN pulumi.StringOutput `pulumi:"arn"`
+
+	// The description of the policy.
+	Description pulumi.StringPtrOutput `pulumi:"description"`
+
+	// The path of the policy in IAM.
+	Path pulumi.StringPtrOutput `pulumi:"path"`
+}
+
+func qualifyActions(service string, actions []string) []string {
+	var result []string
+	for _, action := range actions {
+		if !strings.Contains(action, ":") {
+			action = fmt.Sprintf("%s:%s", service, action)
+		}
+		result = append(result, action)
+	}
+	return result
+}
+
+// abacTagActions returns the actions a service uses to add and remove tags.
+func abacTagActions(service string) (tag []string, untag []string) {
+	if service == "ec2" {
+		return []string{"ec2:CreateTags"}, []string{"ec2:DeleteTags"}
+	}
+	return []string{fmt.Sprintf("%s:Tag*", service)}, []string{fmt.Sprintf("%s:Untag*", service)}
+}
+
+// abacStatementSid returns a name stripped of every character not allowed in an IAM statement Sid.
+func abacStatementSid(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+func newAbacPolicyStatements(args *AbacPolicyArgs) ([]iam.GetPolicyDocumentStatement, error) {
+	if len(args.TagKeys) == 0 {
+		return nil, fmt.Errorf("at least one tag key is required")
+	}
+
+	var resourceTagConditions, requestTagConditions []iam.GetPolicyDocumentStatementCondition
+	for _, key := range args.TagKeys {
+		principalTag := fmt.Sprintf("${aws:PrincipalTag/%s}", key)
+		resourceTagConditions = append(resourceTagConditions, NewPolicyDocCondition("StringEquals", fmt.Sprintf("aws:ResourceTag/%s", key), principalTag))
+		requestTagConditions = append(requestTagConditions, NewPolicyDocCondition("StringEquals", fmt.Sprintf("aws:RequestTag/%s", key), principalTag))
+	}
+
+	var statements []iam.GetPolicyDocumentStatement
+	var tagActions, untagActions []string
+	sids := map[string]bool{}
+	for i, group := range args.ActionGroups {
+		if group.Service == "" {
+			return nil, fmt.Errorf("action group [%s] is missing a service", group.Name)
+		}
+
+		resources := group.Resources
+		if len(resources) == 0 {
+			resources = []string{"*"}
+		}
+
+		sid := abacStatementSid(group.Name)
+		if sid == "" {
+			sid = abacStatementSid(group.Service)
+			// Unnamed groups for the same service are told apart by their position.
+			if sids[sid] {
+				sid = fmt.Sprintf("%s%d", sid, i)
+			}
+		}
+
+		if sids[sid] {
+			return nil, fmt.Errorf("action group [%s] results in the statement ID [%s] of another action group, the names of action groups must be unique", group.Name, sid)
+		}
+		sids[sid] = true
+
+		if len(group.Actions) > 0 {
+			statements = append(statements, iam.GetPolicyDocumentStatement{
+				Sid:        pulumi.StringRef(fmt.Sprintf("%sMatchingTags", sid)),
+				Actions:    qualifyActions(group.Service, group.Actions),
+				Resources:  resources,
+				Conditions: resourceTagConditions,
+			})
+		}
+
+		if len(group.CreateActions) > 0 {
+			statements = append(statements, iam.GetPolicyDocumentStatement{
+				Sid:        pulumi.StringRef(fmt.Sprintf("%sCreateWithTags", sid)),
+				Actions:    qualifyActions(group.Service, group.CreateActions),
+				Resources:  resources,
+				Conditions: requestTagConditions,
+			})
+		}
+
+		tag, untag := abacTagActions(group.Service)
+		tagActions = append(tagActions, tag...)
+		untagActions = append(untagActions, untag...)
+	}
+
+	if args.AllowTagModification || len(tagActions) == 0 {
+		return statements, nil
+	}
+
+	statements = append(statements, iam.GetPolicyDocumentStatement{
+		Sid:       pulumi.StringRef("DenyRemovingAbacTags"),
+		Effect:    pulumi.StringRef("Deny"),
+		Actions:   untagActions,
+		Resources: []string{"*"},
+		Conditions: []iam.GetPolicyDocumentStatementCondition{
+			NewPolicyDocCondition("ForAnyValue:StringEquals", "aws:TagKeys", args.TagKeys...),
+		},
+	})
+
+	for i, key := range args.TagKeys {
+		statements = append(statements, iam.GetPolicyDocumentStatement{
+			Sid:       pulumi.StringRef(fmt.Sprintf("DenyChangingAbacTag%d", i)),
+			Effect:    pulumi.StringRef("Deny"),
+			Actions:   tagActions,
+			Resources: []string{"*"},
+			Conditions: []iam.GetPolicyDocumentStatementCondition{
+				NewPolicyDocCondition("Null", fmt.Sprintf("aws:RequestTag/%s", key), "false"),
+				NewPolicyDocCondition("StringNotEquals", fmt.Sprintf("aws:RequestTag/%s", key), fmt.Sprintf("${aws:PrincipalTag/%s}", key)),
+			},
+		})
+	}
+
+	return statements, nil
+}
+
+func NewAbacPolicy(ctx *pulumi.Context, name string, args *AbacPolicyArgs, opts ...pulumi.ResourceOption) (*AbacPolicy, error) {
+	if args == nil {
+		args = &AbacPolicyArgs{}
+	}
+
+	component := &AbacPolicy{}
+	err := ctx.RegisterComponentResource(AbacPolicyIdentifier, name, component, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts, pulumi.Parent(component))
+
+	policyDocStatements, err := newAbacPolicyStatements(args)
+	if err != nil {
+		return nil, fmt.Errorf("resource with name [%s]: %w", name, err)
+	}
+
+	catalog, err := action_catalog.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []string
+	for _, group := range args.ActionGroups {
+		actions = append(actions, qualifyActions(group.Service, group.Actions)...)
+		actions = append(actions, qualifyActions(group.Service, group.CreateActions)...)
+	}
+
+	if unknown := catalog.UnknownActions(actions); len(unknown) > 0 {
+		msg := fmt.Sprintf("Actions %v are not in the action catalog (version %s), check them for typos.", unknown, catalog.Version)
+		if err := ctx.Log.Warn(msg, &pulumi.LogArgs{Resource: component}); err != nil {
+			return nil, err
+		}
+	}
+
+	policyDoc, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
+		Statements: policyDocStatements,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := iam.NewPolicy(ctx, name, &iam.PolicyArgs{
+		Name:        pulumi.String(args.Name),
+		Path:        pulumi.String(args.Path),
+		Description: pulumi.String(args.Description),
+		Policy:      pulumi.String(policyDoc.Json),
+		Tags:        pulumi.ToStringMap(args.Tags),
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	component.PolicyJSON = pulumi.Sprintf("%s", policyDoc.Json)
+	component.ID = policy.ID().ToStringOutput()
+	component.Name = policy.Name
+	component.ARN = policy.Arn
+	component.Description = policy.Description
+	component.Path = policy.Path
+
+	return component, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbacPolicyStatementSids(t *testing.T) {
+	tests := []struct {
+		name     string
+		groups   []AbacActionGroupArgs
+		expected []string
+		err      string
+	}{
+		{
+			name: "named and unnamed groups",
+			groups: []AbacActionGroupArgs{
+				{Name: "Read-Buckets", Service: "s3", Actions: []string{"GetObject"}},
+				{Service: "ec2", Actions: []string{"StartInstances"}, CreateActions: []string{"RunInstances"}},
+			},
+			expected: []string{"ReadBucketsMatchingTags", "ec2MatchingTags", "ec2CreateWithTags"},
+		},
+		{
+			name: "unnamed groups for the same service",
+			groups: []AbacActionGroupArgs{
+				{Service: "s3", Actions: []string{"GetObject"}, Resources: []string{"arn:aws:s3:::a/*"}},
+				{Service: "s3", Actions: []string{"PutObject"}, Resources: []string{"arn:aws:s3:::b/*"}},
+			},
+			expected: []string{"s3MatchingTags", "s31MatchingTags"},
+		},
+		{
+			name: "duplicate names",
+			groups: []AbacActionGroupArgs{
+				{Name: "objects", Service: "s3", Actions: []string{"GetObject"}},
+				{Name: "objects!", Service: "s3", Actions: []string{"PutObject"}},
+			},
+			err: "action group [objects!] results in the statement ID [objects] of another action group, the names of action groups must be unique",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			statements, err := newAbacPolicyStatements(&AbacPolicyArgs{
+				TagKeys:              []string{"team"},
+				ActionGroups:         tt.groups,
+				AllowTagModification: true,
+			})
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			var sids []string
+			for _, statement := range statements {
+				sids = append(sids, *statement.Sid)
+			}
+			assert.Equal(t, tt.expected, sids)
+		})
+	}
+}
@@ -22,7 +22,33 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
-const AccountIdentifier = "aws-iam:index:Account"
+const (
+	AccountIdentifier = "aws-iam:index:Account"
+
+	// AccountPasswordPolicyPresetCIS applies the password policy recommended by the
+	// CIS AWS Foundations Benchmark.
+	AccountPasswordPolicyPresetCIS = "cis"
+
+	// The ID used by AWS for the account password policy, there is only one per account.
+	accountPasswordPolicyImportID = "iam-account-password-policy"
+
+	accountPasswordPolicyDefaultMinimumLength = 8
+)
+
+// accountPasswordPolicyPresets are the password policies that can be selected through
+// the `passwordPolicyPreset` input.
+var accountPasswordPolicyPresets = map[string]AccountPasswordPolicyArgs{
+	AccountPasswordPolicyPresetCIS: {
+		MaxAge:                     90,
+		MinimumLength:              14,
+		ReusePrevention:            24,
+		AllowUsersToChange:         pulumi.BoolRef(true),
+		RequireLowercaseCharacters: pulumi.BoolRef(true),
+		RequireUppercaseCharacters: pulumi.BoolRef(true),
+		RequireNumbers:             pulumi.BoolRef(true),
+		RequireSymbols:             pulumi.BoolRef(true),
+	},
+}
 
 type AccountPasswordPolicyArgs struct {
 	// The number of days that an user password is valid.
@@ -35,39 +61,103 @@ type AccountPasswordPolicyArgs struct {
 	ReusePrevention int `pulumi:"reusePrevention"`
 
 	// Whether to allow users to change their own password.
-	AllowUsersToChange bool `pulumi:"allowUsersToChange"`
+	AllowUsersToChange *bool `pulumi:"allowUsersToChange"`
 
 	// Whether users are prevented from setting a new password after their password
 	// has expired (i.e. require administrator reset).
-	HardExpiry bool `pulumi:"hardExpiry"`
+	HardExpiry *bool `pulumi:"hardExpiry"`
 
 	// Whether to require lowercase characters for user passwords.
-	RequireLowercaseCharacters bool `pulumi:"requireLowercaseCharacters"`
+	RequireLowercaseCharacters *bool `pulumi:"requireLowercaseCharacters"`
 
 	// Whether to require uppercase characters for user passwords.
-	RequireUppercaseCharacters bool `pulumi:"requireUppercaseCharacters"`
+	RequireUppercaseCharacters *bool `pulumi:"requireUppercaseCharacters"`
 
 	// Whether to require numbers for user passwords.
-	RequireNumbers bool `pulumi:"requireNumbers"`
+	RequireNumbers *bool `pulumi:"requireNumbers"`
 
 	// Whether to require symbols for user passwords.
-	RequireSymbols bool `pulumi:"requireSymbols"`
+	RequireSymbols *bool `pulumi:"requireSymbols"`
 }
 
 type AccountArgs struct {
-	// AWS IAM account alias for this account.
+	// AWS IAM account alias for this account. If left empty the account alias is not managed.
 	AccountAlias string `pulumi:"accountAlias"`
 
+	// Whether to adopt an account alias that already exists with the same name instead of creating it.
+	ImportAccountAlias bool `pulumi:"importAccountAlias"`
+
 	// Options to specify complexity requirements and mandatory rotation periods for
 	// your IAM users' passwords. If left empty the default AWS password policy will be applied.
-	PasswordPolicy AccountPasswordPolicyArgs `pulumi:"passwordPolicy"`
+	PasswordPolicy *AccountPasswordPolicyArgs `pulumi:"passwordPolicy"`
+
+	// Name of a predefined password policy to apply. Settings provided in `passwordPolicy` take
+	// precedence over the preset.
+	PasswordPolicyPreset string `pulumi:"passwordPolicyPreset"`
+
+	// Whether to adopt the password policy that already exists in the account instead of creating it.
+	ImportPasswordPolicy bool `pulumi:"importPasswordPolicy"`
 }
 
 func (this *AccountArgs) Defaults() error {
+	if this.PasswordPolicyPreset == "" {
+		if this.PasswordPolicy != nil && this.PasswordPolicy.MinimumLength == 0 {
+			this.PasswordPolicy.MinimumLength = accountPasswordPolicyDefaultMinimumLength
+		}
+		return nil
+	}
+
+	preset, ok := accountPasswordPolicyPresets[this.PasswordPolicyPreset]
+	if !ok {
+		return fmt.Errorf("Unknown PasswordPolicyPreset [%s]. Valid values are: %s.", this.PasswordPolicyPreset, AccountPasswordPolicyPresetCIS)
+	}
+
+	if this.PasswordPolicy == nil {
+		this.PasswordPolicy = &preset
+		return nil
+	}
+
+	policy := this.PasswordPolicy
+	if policy.MaxAge == 0 {
+		policy.MaxAge = preset.MaxAge
+	}
+	if policy.MinimumLength == 0 {
+		policy.MinimumLength = preset.MinimumLength
+	}
+	if policy.ReusePrevention == 0 {
+		policy.ReusePrevention = preset.ReusePrevention
+	}
+	if policy.AllowUsersToChange == nil {
+		policy.AllowUsersToChange = preset.AllowUsersToChange
+	}
+	if policy.HardExpiry == nil {
+		policy.HardExpiry = preset.HardExpiry
+	}
+	if policy.RequireLowercaseCharacters == nil {
+		policy.RequireLowercaseCharacters = preset.RequireLowercaseCharacters
+	}
+	if policy.RequireUppercaseCharacters == nil {
+		policy.RequireUppercaseCharacters = preset.RequireUppercaseCharacters
+	}
+	if policy.RequireNumbers == nil {
+		policy.RequireNumbers = preset.RequireNumbers
+	}
+	if policy.RequireSymbols == nil {
+		policy.RequireSymbols = preset.RequireSymbols
+	}
+
 	return nil
 }
 
 func (this *AccountArgs) Validate() error {
+	if this.PasswordPolicy == nil {
+		return nil
+	}
+
+	if this.PasswordPolicy.MinimumLength < 6 || this.PasswordPolicy.MinimumLength > 128 {
+		return fmt.Errorf("Invalid MinimumLength for PasswordPolicy provided. Valid values are between 6 and 128.")
+	}
+
 	return nil
 }
 
@@ -92,9 +182,12 @@ func NewIAMAccount(ctx *pulumi.Context, name string, args *AccountArgs, opts ...
 		args = &AccountArgs{}
 	}
 
-	// Return an error if MinimumLength is not valid.
-	if args.PasswordPolicy.MinimumLength < 6 || args.PasswordPolicy.MinimumLength > 128 {
-		return nil, fmt.Errorf("Invalid MinimumLength for PasswordPolicy provided for resource with name [%s]. Valid values are between 6 and 128.", name)
+	if err := args.Defaults(); err != nil {
+		return nil, fmt.Errorf("resource with name [%s]: %w", name, err)
+	}
+
+	if err := args.Validate(); err != nil {
+		return nil, fmt.Errorf("resource with name [%s]: %w", name, err)
 	}
 
 	component := &Account{}
@@ -110,34 +203,50 @@ func NewIAMAccount(ctx *pulumi.Context, name string, args *AccountArgs, opts ...
 		return nil, err
 	}
 
-	aliasName := fmt.Sprintf("%s-account-alias", name)
-	_, err = iam.NewAccountAlias(ctx, aliasName, &iam.AccountAliasArgs{
-		AccountAlias: pulumi.String(args.AccountAlias),
-	}, opts...)
-	if err != nil {
-		return nil, err
+	if args.AccountAlias != "" {
+		aliasOpts := opts
+		if args.ImportAccountAlias {
+			aliasOpts = append(aliasOpts, pulumi.Import(pulumi.ID(args.AccountAlias)))
+		}
+
+		aliasName := fmt.Sprintf("%s-account-alias", name)
+		_, err = iam.NewAccountAlias(ctx, aliasName, &iam.AccountAliasArgs{
+			AccountAlias: pulumi.String(args.AccountAlias),
+		}, aliasOpts...)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	passwordPolicyName := fmt.Sprintf("%s-password-policy", name)
-	passwordPolicy, err := iam.NewAccountPasswordPolicy(ctx, passwordPolicyName, &iam.AccountPasswordPolicyArgs{
-		MaxPasswordAge:             pulumi.Int(args.PasswordPolicy.MaxAge),
-		MinimumPasswordLength:      pulumi.Int(args.PasswordPolicy.MinimumLength),
-		AllowUsersToChangePassword: pulumi.Bool(args.PasswordPolicy.AllowUsersToChange),
-		HardExpiry:                 pulumi.Bool(args.PasswordPolicy.HardExpiry),
-		PasswordReusePrevention:    pulumi.Int(args.PasswordPolicy.ReusePrevention),
-		RequireLowercaseCharacters: pulumi.Bool(args.PasswordPolicy.RequireLowercaseCharacters),
-		RequireUppercaseCharacters: pulumi.Bool(args.PasswordPolicy.RequireUppercaseCharacters),
-		RequireNumbers:             pulumi.Bool(args.PasswordPolicy.RequireNumbers),
-		RequireSymbols:             pulumi.Bool(args.PasswordPolicy.RequireSymbols),
-	}, opts...)
-	if err != nil {
-		return nil, err
+	component.PasswordPolicyExpirePasswords = pulumi.Bool(false).ToBoolOutput()
+	if args.PasswordPolicy != nil {
+		passwordPolicyOpts := opts
+		if args.ImportPasswordPolicy {
+			passwordPolicyOpts = append(passwordPolicyOpts, pulumi.Import(pulumi.ID(accountPasswordPolicyImportID)))
+		}
+
+		passwordPolicyName := fmt.Sprintf("%s-password-policy", name)
+		passwordPolicy, err := iam.NewAccountPasswordPolicy(ctx, passwordPolicyName, &iam.AccountPasswordPolicyArgs{
+			MaxPasswordAge:             pulumi.Int(args.PasswordPolicy.MaxAge),
+			MinimumPasswordLength:      pulumi.Int(args.PasswordPolicy.MinimumLength),
+			AllowUsersToChangePassword: pulumi.BoolPtrFromPtr(args.PasswordPolicy.AllowUsersToChange),
+			HardExpiry:                 pulumi.BoolPtrFromPtr(args.PasswordPolicy.HardExpiry),
+			PasswordReusePrevention:    pulumi.Int(args.PasswordPolicy.ReusePrevention),
+			RequireLowercaseCharacters: pulumi.BoolPtrFromPtr(args.PasswordPolicy.RequireLowercaseCharacters),
+			RequireUppercaseCharacters: pulumi.BoolPtrFromPtr(args.PasswordPolicy.RequireUppercaseCharacters),
+			RequireNumbers:             pulumi.BoolPtrFromPtr(args.PasswordPolicy.RequireNumbers),
+			RequireSymbols:             pulumi.BoolPtrFromPtr(args.PasswordPolicy.RequireSymbols),
+		}, passwordPolicyOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		component.PasswordPolicyExpirePasswords = passwordPolicy.ExpirePasswords
 	}
 
 	component.Id = account.AccountId
 	component.Arn = account.Arn
 	component.UserId = account.UserId
-	component.PasswordPolicyExpirePasswords = passwordPolicy.ExpirePasswords
 
 	return component, nil
 }
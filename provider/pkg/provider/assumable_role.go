@@ -16,6 +16,7 @@ package provider
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/pulumi/pulumi-aws-iam/pkg/utils"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
@@ -28,6 +29,8 @@ const (
 	AdminRolePolicyARN     = "arn:aws:iam::aws:policy/AdministratorAccess"
 	PoweruserRolePolicyARN = "arn:aws:iam::aws:policy/PowerUserAccess"
 	ReadonlyRolePolicyARN  = "arn:aws:iam::aws:policy/ReadOnlyAccess"
+
+	assumableRoleDefaultMFAAge = 86400
 )
 
 type AssumableRoleArgs struct {
@@ -37,7 +40,7 @@ type AssumableRoleArgs struct {
 	// ARNs of AWS entities who can assume these roles.
 	TrustedRoleArns pulumi.StringArrayInput `pulumi:"trustedRoleArns"`
 
-	// AWS Services that can assume these roles.
+	// AWS Services that can assume these roles. They are trusted without the MFA and ExternalId conditions.
 	TrustedRoleServices []string `pulumi:"trustedRoleServices"`
 
 	// Max age of valid MFA (in seconds) for roles which require MFA.
@@ -67,8 +70,29 @@ type AssumableRoleArgs struct {
 	// Whether policies should be detached from this role when destroying.
 	ForceDetachPolicies pulumi.BoolInput `pulumi:"forceDetachPolicies"`
 
-	// STS ExternalId condition values to use with a role (when MFA is not required).
+	// STS ExternalId condition values to use with a role. Combined with the MFA conditions when MFA is required.
 	RoleSTSExternalIDs []string `pulumi:"roleStsExternalIds"`
+
+	// Whether trusted entities are allowed to pass session tags (sts:TagSession) when assuming the role.
+	AllowSessionTags bool `pulumi:"allowSessionTags"`
+
+	// Tag keys that trusted entities are allowed to pass as session tags.
+	SessionTagKeys []string `pulumi:"sessionTagKeys"`
+
+	// Additional conditions to add to the role trust policy.
+	TrustConditions []PolicyConditionArgs `pulumi:"trustConditions"`
+
+	// Whether to create an IAM instance profile for the role. Defaults to true.
+	CreateInstanceProfile *bool `pulumi:"createInstanceProfile"`
+
+	// IAM instance profile created when createInstanceProfile is set.
+	InstanceProfile InstanceProfileSettingsArgs `pulumi:"instanceProfile"`
+
+	// Settings of the session helpers rendered for the role.
+	Session RoleSessionArgs `pulumi:"session"`
+
+	// Credentials assuming the role in the rendered AWS CLI config.
+	AWSConfigSource AWSConfigSourceArgs `pulumi:"awsConfigSource"`
 }
 
 type AssumableRoleRoleOutput struct {
@@ -113,6 +137,45 @@ type AssumableRole struct {
 
 	// IAM instance profile.
 	InstanceProfile AssumableRoleInstanceProfileOutput `pulumi:"instanceProfile"`
+
+	// Session policy and AWS CLI / SDK snippets assuming the role.
+	Session RoleSessionOutput `pulumi:"session"`
+
+	// AWS CLI config with a profile for the role, in INI format.
+	AWSConfig pulumi.StringOutput `pulumi:"awsConfig"`
+
+	// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+	AWSVaultConfig pulumi.StringOutput `pulumi:"awsVaultConfig"`
+}
+
+// newAssumableRoleServiceTrustConditions returns the conditions of the AssumableRole trust policy which
+// also apply to the trusted services.
+func newAssumableRoleServiceTrustConditions(args *AssumableRoleArgs) []iam.GetPolicyDocumentStatementCondition {
+	var conditions []iam.GetPolicyDocumentStatementCondition
+	if len(args.SessionTagKeys) > 0 {
+		conditions = append(conditions, NewPolicyDocCondition("ForAllValues:StringEquals", "aws:TagKeys", args.SessionTagKeys...))
+	}
+
+	return append(conditions, newPolicyDocConditions(args.TrustConditions)...)
+}
+
+// newAssumableRoleTrustConditions returns the conditions of the AssumableRole trust policy for the trusted
+// AWS principals. The MFA conditions are added when MFA is required and the ExternalId condition when external
+// IDs are given, services can provide neither so they are trusted in a separate statement without them.
+func newAssumableRoleTrustConditions(requiresMFA bool, mfaAge int, args *AssumableRoleArgs) []iam.GetPolicyDocumentStatementCondition {
+	var conditions []iam.GetPolicyDocumentStatementCondition
+	if requiresMFA {
+		conditions = append(conditions,
+			NewPolicyDocCondition("Bool", "aws:MultiFactorAuthPresent", "true"),
+			NewPolicyDocCondition("NumericLessThan", "aws:MultiFactorAuthAge", strconv.Itoa(mfaAge)),
+		)
+	}
+
+	if len(args.RoleSTSExternalIDs) > 0 {
+		conditions = append(conditions, NewPolicyDocCondition("StringEquals", "sts:ExternalId", args.RoleSTSExternalIDs...))
+	}
+
+	return append(conditions, newAssumableRoleServiceTrustConditions(args)...)
 }
 
 func NewAssumableRole(ctx *pulumi.Context, name string, args *AssumableRoleArgs, opts ...pulumi.ResourceOption) (*AssumableRole, error) {
@@ -128,52 +191,68 @@ func NewAssumableRole(ctx *pulumi.Context, name string, args *AssumableRoleArgs,
 
 	opts = append(opts, pulumi.Parent(component))
 
+	if err := args.AWSConfigSource.validate(); err != nil {
+		return nil, fmt.Errorf("Invalid AWS config source for resource with name [%s]: %w.", name, err)
+	}
+
+	if err := args.Session.validate(false); err != nil {
+		return nil, fmt.Errorf("Invalid session for resource with name [%s]: %w.", name, err)
+	}
+
 	if len(args.TrustedRoleActions) == 0 {
 		args.TrustedRoleActions = append(args.TrustedRoleActions, "sts:AssumeRole")
 	}
 
-	policyDocumentStatementConditions := []iam.GetPolicyDocumentStatementCondition{
-		NewPolicyDocCondition("StringEquals", "sts:ExternalId", args.RoleSTSExternalIDs...),
+	if args.AllowSessionTags {
+		args.TrustedRoleActions = append(args.TrustedRoleActions, "sts:TagSession")
 	}
 
-	var mfaAge pulumi.IntInput
-	args.Role.RequiresMFA.ToBoolOutput().ApplyT(func(mfa bool) error {
-		if mfa {
-			if args.MFAAge == nil {
-				args.MFAAge = pulumi.Int(86400)
-			}
+	if args.Role.RequiresMFA == nil {
+		args.Role.RequiresMFA = pulumi.Bool(false)
+	}
 
-			policyDocumentStatementConditions = []iam.GetPolicyDocumentStatementCondition{
-				NewPolicyDocCondition("Bool", "aws:MultiFactorAuthPresent", "true"),
-				NewPolicyDocCondition("NumericLessThan", "aws:MultiFactorAuthAge", fmt.Sprintf("%v", mfaAge)),
-			}
-		}
-		return nil
-	})
+	if args.MFAAge == nil {
+		args.MFAAge = pulumi.Int(assumableRoleDefaultMFAAge)
+	}
+
+	if args.TrustedRoleArns == nil {
+		args.TrustedRoleArns = pulumi.ToStringArray(nil)
+	}
 
-	rolePolicy := args.TrustedRoleArns.ToStringArrayOutput().ApplyT(func(arns []string) (string, error) {
+	rolePolicy := pulumi.All(args.TrustedRoleArns.ToStringArrayOutput(), args.Role.RequiresMFA.ToBoolOutput(), args.MFAAge.ToIntOutput()).ApplyT(func(x []interface{}) (string, error) {
 		if args.CustomRoleTrustPolicy != "" {
 			return args.CustomRoleTrustPolicy, nil
 		}
 
-		policyArgs := &iam.GetPolicyDocumentArgs{
-			Statements: []iam.GetPolicyDocumentStatement{
-				{
-					Effect:     pulumi.StringRef("Allow"),
-					Actions:    args.TrustedRoleActions,
-					Conditions: policyDocumentStatementConditions,
-					Principals: []iam.GetPolicyDocumentStatementPrincipal{
-						{
-							Type:        "AWS",
-							Identifiers: arns,
-						},
-						{
-							Type:        "Service",
-							Identifiers: args.TrustedRoleServices,
-						},
+		arns := x[0].([]string)
+
+		policyArgs := &iam.GetPolicyDocumentArgs{}
+		if len(arns) > 0 || len(args.TrustedRoleServices) == 0 {
+			policyArgs.Statements = append(policyArgs.Statements, iam.GetPolicyDocumentStatement{
+				Effect:     pulumi.StringRef("Allow"),
+				Actions:    args.TrustedRoleActions,
+				Conditions: newAssumableRoleTrustConditions(x[1].(bool), x[2].(int), args),
+				Principals: []iam.GetPolicyDocumentStatementPrincipal{
+					{
+						Type:        "AWS",
+						Identifiers: arns,
 					},
 				},
-			},
+			})
+		}
+
+		if len(args.TrustedRoleServices) > 0 {
+			policyArgs.Statements = append(policyArgs.Statements, iam.GetPolicyDocumentStatement{
+				Effect:     pulumi.StringRef("Allow"),
+				Actions:    args.TrustedRoleActions,
+				Conditions: newAssumableRoleServiceTrustConditions(args),
+				Principals: []iam.GetPolicyDocumentStatementPrincipal{
+					{
+						Type:        "Service",
+						Identifiers: args.TrustedRoleServices,
+					},
+				},
+			})
 		}
 
 		assumeRolePolicy, err := utils.GetIAMPolicyDocument(ctx, policyArgs)
@@ -207,15 +286,31 @@ func NewAssumableRole(ctx *pulumi.Context, name string, args *AssumableRoleArgs,
 		return nil, err
 	}
 
-	instanceProfileName := fmt.Sprintf("%s-instance-profile", name)
-	instanceProfile, err := iam.NewInstanceProfile(ctx, instanceProfileName, &iam.InstanceProfileArgs{
-		Name: args.Role.Name,
-		Path: args.Role.Path,
-		Role: role.Name,
-		Tags: args.Tags,
-	}, opts...)
-	if err != nil {
-		return nil, err
+	component.InstanceProfile = newEmptyInstanceProfileOutput()
+	if args.CreateInstanceProfile == nil || *args.CreateInstanceProfile {
+		if args.InstanceProfile.Name == nil {
+			args.InstanceProfile.Name = args.Role.Name
+		}
+
+		if args.InstanceProfile.Path == nil {
+			args.InstanceProfile.Path = args.Role.Path
+		}
+
+		if args.InstanceProfile.Tags == nil {
+			args.InstanceProfile.Tags = args.Tags
+		}
+
+		instanceProfile, err := utils.NewIAMInstanceProfile(ctx, name, &utils.IAMInstanceProfileArgs{
+			Name: args.InstanceProfile.Name,
+			Path: args.InstanceProfile.Path,
+			Role: role.Name,
+			Tags: args.InstanceProfile.Tags,
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		component.InstanceProfile = newInstanceProfileOutput(instanceProfile)
 	}
 
 	component.Role.Arn = role.Arn
@@ -224,10 +319,13 @@ func NewAssumableRole(ctx *pulumi.Context, name string, args *AssumableRoleArgs,
 	component.Role.UniqueID = role.UniqueId
 	component.Role.RequiresMFA = args.Role.RequiresMFA.ToBoolOutput()
 	component.Role.STSExternalIDs = args.RoleSTSExternalIDs
-	component.InstanceProfile.Arn = instanceProfile.Arn
-	component.InstanceProfile.ID = instanceProfile.UniqueId
-	component.InstanceProfile.Name = instanceProfile.Name
-	component.InstanceProfile.Path = instanceProfile.Path
+	component.Session = newRoleSession(role, false, args.Session)
+
+	awsConfigRole := newAWSConfigRole(role, args.Role.RequiresMFA)
+	if len(args.RoleSTSExternalIDs) > 0 {
+		awsConfigRole.ExternalID = pulumi.String(args.RoleSTSExternalIDs[0])
+	}
+	component.AWSConfig, component.AWSVaultConfig = newAWSConfig(args.AWSConfigSource, []AWSConfigRoleArgs{awsConfigRole})
 
 	return component, nil
 }
@@ -0,0 +1,319 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// trustPolicyMocks records the types and inputs of the created resources and the statements of the trust
+// policies rendered with iam.GetPolicyDocument.
+type trustPolicyMocks struct {
+	mu         sync.Mutex
+	types      []string
+	inputs     map[string]resource.PropertyMap
+	statements []map[string]interface{}
+}
+
+func (m *trustPolicyMocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	m.mu.Lock()
+	m.types = append(m.types, args.TypeToken)
+	if m.inputs == nil {
+		m.inputs = map[string]resource.PropertyMap{}
+	}
+	m.inputs[args.Name] = args.Inputs
+	m.mu.Unlock()
+
+	outputs := args.Inputs.Copy()
+	outputs["arn"] = resource.NewStringProperty(fmt.Sprintf("arn:aws:iam::123456789012:%s", args.Name))
+	outputs["name"] = resource.NewStringProperty(args.Name)
+	return args.Name + "-id", outputs, nil
+}
+
+func (m *trustPolicyMocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	if args.Token == "aws:iam/getPolicyDocument:getPolicyDocument" {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for _, statement := range args.Args.Mappable()["statements"].([]interface{}) {
+			statement := statement.(map[string]interface{})
+			for _, action := range statement["actions"].([]interface{}) {
+				if action == "sts:AssumeRole" {
+					m.statements = append(m.statements, statement)
+					break
+				}
+			}
+		}
+		return resource.NewPropertyMapFromMap(map[string]interface{}{"json": "{}"}), nil
+	}
+
+	return resource.PropertyMap{}, nil
+}
+
+func trustCondition(test, variable string, values ...interface{}) map[string]interface{} {
+	return map[string]interface{}{"test": test, "variable": variable, "values": values}
+}
+
+func TestAssumableRoleTrustPolicy(t *testing.T) {
+	mfaCases := []struct {
+		name        string
+		requiresMFA bool
+		mfaAge      pulumi.IntInput
+		conditions  []interface{}
+	}{
+		{
+			name: "without_mfa",
+		},
+		{
+			name:   "without_mfa_with_mfa_age",
+			mfaAge: pulumi.Int(3600),
+		},
+		{
+			name:        "with_mfa",
+			requiresMFA: true,
+			conditions: []interface{}{
+				trustCondition("Bool", "aws:MultiFactorAuthPresent", "true"),
+				trustCondition("NumericLessThan", "aws:MultiFactorAuthAge", "86400"),
+			},
+		},
+		{
+			name:        "with_mfa_with_mfa_age",
+			requiresMFA: true,
+			mfaAge:      pulumi.Int(3600),
+			conditions: []interface{}{
+				trustCondition("Bool", "aws:MultiFactorAuthPresent", "true"),
+				trustCondition("NumericLessThan", "aws:MultiFactorAuthAge", "3600"),
+			},
+		},
+	}
+
+	externalIDCases := []struct {
+		name        string
+		externalIDs []string
+		conditions  []interface{}
+	}{
+		{
+			name: "without_external_id",
+		},
+		{
+			name:        "with_external_ids",
+			externalIDs: []string{"first", "second"},
+			conditions: []interface{}{
+				trustCondition("StringEquals", "sts:ExternalId", "first", "second"),
+			},
+		},
+	}
+
+	trustedRoleARNCases := []struct {
+		name       string
+		arns       []string
+		principals []interface{}
+	}{
+		{
+			name: "without_trusted_role_arns",
+		},
+		{
+			name: "with_trusted_role_arns",
+			arns: []string{"arn:aws:iam::111111111111:root", "arn:aws:iam::222222222222:role/admin"},
+			principals: []interface{}{
+				map[string]interface{}{
+					"type":        "AWS",
+					"identifiers": []interface{}{"arn:aws:iam::111111111111:root", "arn:aws:iam::222222222222:role/admin"},
+				},
+			},
+		},
+	}
+
+	for _, mfa := range mfaCases {
+		for _, externalID := range externalIDCases {
+			for _, trustedRoleARNs := range trustedRoleARNCases {
+				mfa, externalID, trustedRoleARNs := mfa, externalID, trustedRoleARNs
+				t.Run(fmt.Sprintf("%s/%s/%s", mfa.name, externalID.name, trustedRoleARNs.name), func(t *testing.T) {
+					mocks := &trustPolicyMocks{}
+					err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+						args := &AssumableRoleArgs{
+							TrustedRoleArns:    pulumi.ToStringArray(trustedRoleARNs.arns),
+							MFAAge:             mfa.mfaAge,
+							RoleSTSExternalIDs: externalID.externalIDs,
+						}
+						args.Role.RequiresMFA = pulumi.Bool(mfa.requiresMFA)
+
+						_, err := NewAssumableRole(ctx, "assumable-role", args)
+						return err
+					}, pulumi.WithMocks("project", "stack", mocks))
+					require.NoError(t, err)
+
+					require.Len(t, mocks.statements, 1)
+					statement := mocks.statements[0]
+
+					expectedConditions := append(append([]interface{}{}, mfa.conditions...), externalID.conditions...)
+					if len(expectedConditions) == 0 {
+						assert.Nil(t, statement["conditions"])
+					} else {
+						assert.Equal(t, expectedConditions, statement["conditions"])
+					}
+
+					if trustedRoleARNs.principals == nil {
+						assert.Nil(t, statement["principals"])
+					} else {
+						assert.Equal(t, trustedRoleARNs.principals, statement["principals"])
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestAssumableRoleTrustPolicyWithServices(t *testing.T) {
+	awsPrincipal := map[string]interface{}{
+		"type":        "AWS",
+		"identifiers": []interface{}{"arn:aws:iam::111111111111:root"},
+	}
+	servicePrincipal := map[string]interface{}{
+		"type":        "Service",
+		"identifiers": []interface{}{"ec2.amazonaws.com"},
+	}
+	sourceAccountCondition := trustCondition("StringEquals", "aws:SourceAccount", "123456789012")
+
+	tests := []struct {
+		name       string
+		arns       []string
+		statements []map[string]interface{}
+	}{
+		{
+			name: "with_trusted_role_arns",
+			arns: []string{"arn:aws:iam::111111111111:root"},
+			statements: []map[string]interface{}{
+				{
+					"principals": []interface{}{awsPrincipal},
+					"conditions": []interface{}{
+						trustCondition("Bool", "aws:MultiFactorAuthPresent", "true"),
+						trustCondition("NumericLessThan", "aws:MultiFactorAuthAge", "86400"),
+						trustCondition("StringEquals", "sts:ExternalId", "first"),
+						sourceAccountCondition,
+					},
+				},
+				{
+					"principals": []interface{}{servicePrincipal},
+					"conditions": []interface{}{sourceAccountCondition},
+				},
+			},
+		},
+		{
+			name: "without_trusted_role_arns",
+			statements: []map[string]interface{}{
+				{
+					"principals": []interface{}{servicePrincipal},
+					"conditions": []interface{}{sourceAccountCondition},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mocks := &trustPolicyMocks{}
+			err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+				args := &AssumableRoleArgs{
+					TrustedRoleArns:     pulumi.ToStringArray(tt.arns),
+					TrustedRoleServices: []string{"ec2.amazonaws.com"},
+					RoleSTSExternalIDs:  []string{"first"},
+					TrustConditions: []PolicyConditionArgs{
+						{Test: "StringEquals", Variable: "aws:SourceAccount", Values: []string{"123456789012"}},
+					},
+				}
+				args.Role.RequiresMFA = pulumi.Bool(true)
+
+				_, err := NewAssumableRole(ctx, "assumable-role", args)
+				return err
+			}, pulumi.WithMocks("project", "stack", mocks))
+			require.NoError(t, err)
+
+			require.Len(t, mocks.statements, len(tt.statements))
+			for i, expected := range tt.statements {
+				assert.Equal(t, expected["principals"], mocks.statements[i]["principals"])
+				assert.Equal(t, expected["conditions"], mocks.statements[i]["conditions"])
+			}
+		})
+	}
+}
+
+func TestAssumableRoleInstanceProfile(t *testing.T) {
+	tests := []struct {
+		name                  string
+		createInstanceProfile *bool
+		expected              bool
+	}{
+		{name: "default", expected: true},
+		{name: "enabled", createInstanceProfile: pulumi.BoolRef(true), expected: true},
+		{name: "disabled", createInstanceProfile: pulumi.BoolRef(false), expected: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mocks := &trustPolicyMocks{}
+			err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+				_, err := NewAssumableRole(ctx, "assumable-role", &AssumableRoleArgs{
+					CreateInstanceProfile: tt.createInstanceProfile,
+				})
+				return err
+			}, pulumi.WithMocks("project", "stack", mocks))
+			require.NoError(t, err)
+
+			if tt.expected {
+				assert.Contains(t, mocks.types, "aws:iam/instanceProfile:InstanceProfile")
+			} else {
+				assert.NotContains(t, mocks.types, "aws:iam/instanceProfile:InstanceProfile")
+			}
+		})
+	}
+}
+
+func TestAssumableRoleExclusivePolicyAttachments(t *testing.T) {
+	for _, exclusive := range []bool{false, true} {
+		exclusive := exclusive
+		t.Run(fmt.Sprintf("exclusive_%t", exclusive), func(t *testing.T) {
+			mocks := &trustPolicyMocks{}
+			err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+				args := &AssumableRoleArgs{
+					AttachReadonlyPolicy: true,
+				}
+				args.Role.ExclusivePolicyAttachments = exclusive
+
+				_, err := NewAssumableRole(ctx, "assumable-role", args)
+				return err
+			}, pulumi.WithMocks("project", "stack", mocks))
+			require.NoError(t, err)
+
+			managedPolicyArns, ok := mocks.inputs["assumable-role-role"]["managedPolicyArns"]
+			if exclusive {
+				require.True(t, ok)
+				assert.Equal(t, []interface{}{ReadonlyRolePolicyARN}, managedPolicyArns.Mappable())
+				assert.NotContains(t, mocks.types, "aws:iam/rolePolicyAttachment:RolePolicyAttachment")
+			} else {
+				assert.False(t, ok)
+				assert.Contains(t, mocks.types, "aws:iam/rolePolicyAttachment:RolePolicyAttachment")
+			}
+		})
+	}
+}
@@ -26,10 +26,30 @@ import (
 
 const AssumableRoleWithOIDCIdentifier = "aws-iam:index:AssumableRoleWithOIDC"
 
+type AssumableRoleWithOIDCProvider struct {
+	// URL of the OIDC Provider.
+	URL pulumi.StringInput `pulumi:"url"`
+
+	// The AWS account ID where the OIDC provider lives, defaults to `awsAccountId`.
+	AWSAccountID string `pulumi:"awsAccountId"`
+
+	// The fully qualified OIDC subjects of this provider, replacing `oidcFullyQualifiedSubjects` when set.
+	OIDCFullyQualifiedSubjects []string `pulumi:"oidcFullyQualifiedSubjects"`
+
+	// The OIDC subjects using wildcards of this provider, replacing `oidcSubjectsWithWildcards` when set.
+	OIDCSubjectsWithWildcards []string `pulumi:"oidcSubjectsWithWildcards"`
+
+	// The audiences of this provider, replacing `oidcFullyQualifiedAudiences` when set.
+	OIDCFullyQualifiedAudiences []string `pulumi:"oidcFullyQualifiedAudiences"`
+}
+
 type AssumableRoleWithOIDCArgs struct {
 	// List of URLs of the OIDC Providers.
 	ProviderURLs pulumi.StringArrayInput `pulumi:"providerUrls"`
 
+	// OIDC Providers with their own account, subjects and audiences. Used together with `providerUrls`.
+	Providers []AssumableRoleWithOIDCProvider `pulumi:"providers"`
+
 	// The AWS account ID where the OIDC provider lives, leave empty to use the account for the AWS provider.
 	AWSAccountID string `pulumi:"awsAccountId"`
 
@@ -53,6 +73,15 @@ type AssumableRoleWithOIDCArgs struct {
 
 	// Whether policies should be detached from this role when destroying.
 	ForceDetachPolicies pulumi.BoolInput `pulumi:"forceDetachPolicies"`
+
+	// Whether the OIDC provider is allowed to pass session tags (sts:TagSession).
+	AllowSessionTags bool `pulumi:"allowSessionTags"`
+
+	// Tag keys that the OIDC provider is allowed to pass as session tags.
+	SessionTagKeys []string `pulumi:"sessionTagKeys"`
+
+	// Settings of the session helpers rendered for the role.
+	Session RoleSessionArgs `pulumi:"session"`
 }
 
 type AssumableRoleWithOIDC struct {
@@ -69,6 +98,9 @@ type AssumableRoleWithOIDC struct {
 
 	// Unique ID of IAM role.
 	UniqueID pulumi.StringOutput `pulumi:"uniqueId"`
+
+	// Session policy and AWS CLI / SDK snippets assuming the role.
+	Session RoleSessionOutput `pulumi:"session"`
 }
 
 func NewIAMAssumableRoleWithOIDC(ctx *pulumi.Context, name string, args *AssumableRoleWithOIDCArgs, opts ...pulumi.ResourceOption) (*AssumableRoleWithOIDC, error) {
@@ -84,6 +116,10 @@ func NewIAMAssumableRoleWithOIDC(ctx *pulumi.Context, name string, args *Assumab
 
 	opts = append(opts, pulumi.Parent(component))
 
+	if err := args.Session.validate(true); err != nil {
+		return nil, fmt.Errorf("Invalid session for resource with name [%s]: %w.", name, err)
+	}
+
 	if args.AWSAccountID == "" {
 		account, err := aws.GetCallerIdentity(ctx)
 		if err != nil {
@@ -97,50 +133,35 @@ func NewIAMAssumableRoleWithOIDC(ctx *pulumi.Context, name string, args *Assumab
 		return nil, err
 	}
 
-	policyJSON := args.ProviderURLs.ToStringArrayOutput().ApplyT(func(urls []string) (string, error) {
-		var result []string
-		for _, u := range urls {
-			url := strings.ReplaceAll(u, "https://", "")
-
-			effect := "Allow"
-			principalIdentifier := fmt.Sprintf("arn:%s:iam::%s:oidc-provider/%s", currentPartition.Partition, args.AWSAccountID, url)
-
-			var policyConditions []iam.GetPolicyDocumentStatementCondition
-			if len(args.OIDCFullyQualifiedSubjects) > 0 {
-				policyConditions = append(policyConditions, NewPolicyDocCondition("StringEquals", fmt.Sprintf("%s:sub", url), args.OIDCFullyQualifiedSubjects...))
-			}
-
-			if len(args.OIDCSubjectsWithWildcards) > 0 {
-				policyConditions = append(policyConditions, NewPolicyDocCondition("StringLike", fmt.Sprintf("%s:sub", url), args.OIDCSubjectsWithWildcards...))
-			}
-
-			if len(args.OIDCFullyQualifiedAudiences) > 0 {
-				policyConditions = append(policyConditions, NewPolicyDocCondition("StringLike", fmt.Sprintf("%s:aud", url), args.OIDCFullyQualifiedAudiences...))
-			}
-
-			policyDoc, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
-				Statements: []iam.GetPolicyDocumentStatement{
-					{
-						Effect:  &effect,
-						Actions: []string{"sts:AssumeRoleWithWebIdentity"},
-						Principals: []iam.GetPolicyDocumentStatementPrincipal{
-							{
-								Type:        "Federated",
-								Identifiers: []string{principalIdentifier},
-							},
-						},
-						Conditions: policyConditions,
-					},
-				},
-			})
-			if err != nil {
-				return "", err
-			}
+	if args.ProviderURLs == nil {
+		args.ProviderURLs = pulumi.ToStringArray(nil)
+	}
+
+	providerURLs := []interface{}{args.ProviderURLs.ToStringArrayOutput()}
+	for i, provider := range args.Providers {
+		if provider.URL == nil {
+			return nil, fmt.Errorf("URL of OIDC provider [%d] is required for resource with name [%s].", i, name)
+		}
+		providerURLs = append(providerURLs, provider.URL)
+	}
 
-			result = append(result, policyDoc.Json)
+	policyJSON := pulumi.All(providerURLs...).ApplyT(func(x []interface{}) (string, error) {
+		// Providers given by URL only use the settings of the role.
+		urls := x[0].([]string)
+		providers := make([]AssumableRoleWithOIDCProvider, len(urls))
+		for i, provider := range args.Providers {
+			providers = append(providers, provider)
+			urls = append(urls, x[i+1].(string))
 		}
 
-		return strings.Join(result, ""), nil
+		policyDoc, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
+			Statements: newAssumableRoleWithOIDCStatements(currentPartition.Partition, args, providers, urls),
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return policyDoc.Json, nil
 	}).(pulumi.StringOutput)
 
 	role, err := utils.NewIAMRole(ctx, name, &utils.IAMRoleArgs{
@@ -158,6 +179,70 @@ func NewIAMAssumableRoleWithOIDC(ctx *pulumi.Context, name string, args *Assumab
 	component.Name = role.Name
 	component.Path = role.Path
 	component.UniqueID = role.UniqueId
+	component.Session = newRoleSession(role, true, args.Session)
 
 	return component, nil
 }
+
+// newAssumableRoleWithOIDCStatements returns one trust statement per OIDC provider, all of them going into
+// the same policy document. urls holds the resolved URL of each provider.
+func newAssumableRoleWithOIDCStatements(partition string, args *AssumableRoleWithOIDCArgs, providers []AssumableRoleWithOIDCProvider, urls []string) []iam.GetPolicyDocumentStatement {
+	actions := []string{"sts:AssumeRoleWithWebIdentity"}
+	if args.AllowSessionTags {
+		actions = append(actions, "sts:TagSession")
+	}
+
+	var statements []iam.GetPolicyDocumentStatement
+	for i, provider := range providers {
+		url := strings.ReplaceAll(urls[i], "https://", "")
+
+		accountID := provider.AWSAccountID
+		if accountID == "" {
+			accountID = args.AWSAccountID
+		}
+
+		subjects := args.OIDCFullyQualifiedSubjects
+		subjectsWithWildcards := args.OIDCSubjectsWithWildcards
+		if len(provider.OIDCFullyQualifiedSubjects) > 0 || len(provider.OIDCSubjectsWithWildcards) > 0 {
+			subjects = provider.OIDCFullyQualifiedSubjects
+			subjectsWithWildcards = provider.OIDCSubjectsWithWildcards
+		}
+
+		audiences := args.OIDCFullyQualifiedAudiences
+		if len(provider.OIDCFullyQualifiedAudiences) > 0 {
+			audiences = provider.OIDCFullyQualifiedAudiences
+		}
+
+		var policyConditions []iam.GetPolicyDocumentStatementCondition
+		if len(subjects) > 0 {
+			policyConditions = append(policyConditions, NewPolicyDocCondition("StringEquals", fmt.Sprintf("%s:sub", url), subjects...))
+		}
+
+		if len(subjectsWithWildcards) > 0 {
+			policyConditions = append(policyConditions, NewPolicyDocCondition("StringLike", fmt.Sprintf("%s:sub", url), subjectsWithWildcards...))
+		}
+
+		if len(audiences) > 0 {
+			policyConditions = append(policyConditions, NewPolicyDocCondition("StringLike", fmt.Sprintf("%s:aud", url), audiences...))
+		}
+
+		if len(args.SessionTagKeys) > 0 {
+			policyConditions = append(policyConditions, NewPolicyDocCondition("ForAllValues:StringEquals", "aws:TagKeys", args.SessionTagKeys...))
+		}
+
+		effect := "Allow"
+		statements = append(statements, iam.GetPolicyDocumentStatement{
+			Effect:  &effect,
+			Actions: actions,
+			Principals: []iam.GetPolicyDocumentStatementPrincipal{
+				{
+					Type:        "Federated",
+					Identifiers: []string{fmt.Sprintf("arn:%s:iam::%s:oidc-provider/%s", partition, accountID, url)},
+				},
+			},
+			Conditions: policyConditions,
+		})
+	}
+
+	return statements
+}
@@ -15,12 +15,19 @@
 package provider
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/pulumi/pulumi-aws-iam/pkg/utils"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
-const AssumableRoleWithSAMLIdentifier = "aws-iam:index:AssumableRoleWithSAML"
+const (
+	AssumableRoleWithSAMLIdentifier = "aws-iam:index:AssumableRoleWithSAML"
+
+	defaultAWSSAMLEndpoint = "https://signin.aws.amazon.com/saml"
+)
 
 type AssumableRoleWithSAMLArgs struct {
 	// List of SAML Provider IDs.
@@ -29,6 +36,34 @@ type AssumableRoleWithSAMLArgs struct {
 	// AWS SAML Endpoint.
 	AWSSAMLEndpoint string `pulumi:"awsSamlEndpoint"`
 
+	// List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints. Takes precedence over AWSSAMLEndpoint.
+	AWSSAMLEndpoints []string `pulumi:"awsSamlEndpoints"`
+
+	// Whether the IdP is allowed to pass session tags (sts:TagSession).
+	AllowSessionTags bool `pulumi:"allowSessionTags"`
+
+	// Tag keys that the IdP is allowed to pass as session tags.
+	SessionTagKeys []string `pulumi:"sessionTagKeys"`
+
+	// Whether the IdP is allowed to set a source identity (sts:SetSourceIdentity).
+	AllowSourceIdentity bool `pulumi:"allowSourceIdentity"`
+
+	// Values of SAML:sub allowed to assume the role.
+	SAMLSubjects []string `pulumi:"samlSubjects"`
+
+	// Values of SAML:iss allowed to assume the role.
+	SAMLIssuers []string `pulumi:"samlIssuers"`
+
+	// Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the role.
+	SAMLAttributes map[string][]string `pulumi:"samlAttributes"`
+
+	// Map of session tag keys passed by the IdP to the values allowed to assume the role, matched as
+	// `aws:RequestTag/<key>`. Implies allowSessionTags.
+	PrincipalTags map[string][]string `pulumi:"principalTags"`
+
+	// Additional conditions to add to the role trust policy.
+	TrustConditions []PolicyConditionArgs `pulumi:"trustConditions"`
+
 	// A map of tags to add.
 	Tags pulumi.StringMapInput `pulumi:"tags"`
 
@@ -58,6 +93,74 @@ type AssumableRoleWithSAML struct {
 	UniqueID pulumi.StringOutput `pulumi:"uniqueId"`
 }
 
+// samlTrustPolicyArgs holds the settings shared by the SAML components to build a role trust policy.
+type samlTrustPolicyArgs struct {
+	Endpoint            string
+	Endpoints           []string
+	AllowSessionTags    bool
+	SessionTagKeys      []string
+	AllowSourceIdentity bool
+	Subjects            []string
+	Issuers             []string
+	Attributes          map[string][]string
+	PrincipalTags       map[string][]string
+	Conditions          []PolicyConditionArgs
+}
+
+func newSAMLTrustPolicyDocumentArgs(providerIDs []string, args samlTrustPolicyArgs) *iam.GetPolicyDocumentArgs {
+	actions := []string{"sts:AssumeRoleWithSAML"}
+	// Matching the session tags passed by the IdP requires them to be allowed.
+	if args.AllowSessionTags || len(args.PrincipalTags) > 0 {
+		actions = append(actions, "sts:TagSession")
+	}
+
+	if args.AllowSourceIdentity {
+		actions = append(actions, "sts:SetSourceIdentity")
+	}
+
+	endpoints := args.Endpoints
+	if len(endpoints) == 0 {
+		endpoint := args.Endpoint
+		if endpoint == "" {
+			endpoint = defaultAWSSAMLEndpoint
+		}
+		endpoints = []string{endpoint}
+	}
+
+	statement := newIAMPolicyDocumentStatementConstructor("Allow", actions).
+		AddFederatedPrincipal(providerIDs).
+		AddCondition("StringEquals", "SAML:aud", endpoints)
+
+	if len(args.Subjects) > 0 {
+		statement.AddCondition("StringEquals", "SAML:sub", args.Subjects)
+	}
+
+	if len(args.Issuers) > 0 {
+		statement.AddCondition("StringEquals", "SAML:iss", args.Issuers)
+	}
+
+	for _, attribute := range sortedKeys(args.Attributes) {
+		variable := attribute
+		if !strings.HasPrefix(variable, "SAML:") {
+			variable = fmt.Sprintf("SAML:%s", attribute)
+		}
+
+		// SAML attributes can be multivalued, so a match on any of the values is enough.
+		statement.AddCondition("ForAnyValue:StringLike", variable, args.Attributes[attribute])
+	}
+
+	if len(args.SessionTagKeys) > 0 {
+		statement.AddCondition("ForAllValues:StringEquals", "aws:TagKeys", args.SessionTagKeys)
+	}
+
+	// SAML principals have no principal tags, the session tags passed by the IdP are request tags.
+	for _, key := range sortedKeys(args.PrincipalTags) {
+		statement.AddCondition("StringEquals", fmt.Sprintf("aws:RequestTag/%s", key), args.PrincipalTags[key])
+	}
+
+	return statement.AddConditions(newPolicyDocConditions(args.Conditions)).Build()
+}
+
 func NewAssumableRoleWithSAML(ctx *pulumi.Context, name string, args *AssumableRoleWithSAMLArgs, opts ...pulumi.ResourceOption) (*AssumableRoleWithSAML, error) {
 	if args == nil {
 		args = &AssumableRoleWithSAMLArgs{}
@@ -72,10 +175,18 @@ func NewAssumableRoleWithSAML(ctx *pulumi.Context, name string, args *AssumableR
 	opts = append(opts, pulumi.Parent(component))
 
 	policyJSON := args.ProviderIDs.ToStringArrayOutput().ApplyT(func(ids []string) (string, error) {
-		policyDocArgs := newIAMPolicyDocumentStatementConstructor("Allow", []string{"sts:AssumeRoleWithSAML"}).
-			AddFederatedPrincipal(ids).
-			AddCondition("StringEquals", "SAML:aud", []string{args.AWSSAMLEndpoint}).
-			Build()
+		policyDocArgs := newSAMLTrustPolicyDocumentArgs(ids, samlTrustPolicyArgs{
+			Endpoint:            args.AWSSAMLEndpoint,
+			Endpoints:           args.AWSSAMLEndpoints,
+			AllowSessionTags:    args.AllowSessionTags,
+			SessionTagKeys:      args.SessionTagKeys,
+			AllowSourceIdentity: args.AllowSourceIdentity,
+			Subjects:            args.SAMLSubjects,
+			Issuers:             args.SAMLIssuers,
+			Attributes:          args.SAMLAttributes,
+			PrincipalTags:       args.PrincipalTags,
+			Conditions:          args.TrustConditions,
+		})
 
 		policyDoc, err := iam.GetPolicyDocument(ctx, policyDocArgs)
 		if err != nil {
@@ -0,0 +1,108 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSAMLTrustPolicyDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     samlTrustPolicyArgs
+		expected string
+	}{
+		{
+			name: "defaults",
+			expected: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Federated": "arn:aws:iam::111111111111:saml-provider/idp"},
+      "Action": "sts:AssumeRoleWithSAML",
+      "Condition": {
+        "StringEquals": {"SAML:aud": "https://signin.aws.amazon.com/saml"}
+      }
+    }
+  ]
+}`,
+		},
+		{
+			name: "principal tags",
+			args: samlTrustPolicyArgs{
+				Endpoints:     []string{"https://eu-west-1.signin.aws.amazon.com/saml"},
+				Subjects:      []string{"alice"},
+				Attributes:    map[string][]string{"edupersonaffiliation": {"staff"}},
+				PrincipalTags: map[string][]string{"team": {"platform", "security"}, "department": {"engineering"}},
+			},
+			expected: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Federated": "arn:aws:iam::111111111111:saml-provider/idp"},
+      "Action": ["sts:AssumeRoleWithSAML", "sts:TagSession"],
+      "Condition": {
+        "ForAnyValue:StringLike": {"SAML:edupersonaffiliation": "staff"},
+        "StringEquals": {
+          "SAML:aud": "https://eu-west-1.signin.aws.amazon.com/saml",
+          "SAML:sub": "alice",
+          "aws:RequestTag/department": "engineering",
+          "aws:RequestTag/team": ["platform", "security"]
+        }
+      }
+    }
+  ]
+}`,
+		},
+		{
+			name: "session tags and source identity",
+			args: samlTrustPolicyArgs{
+				AllowSessionTags:    true,
+				SessionTagKeys:      []string{"team"},
+				AllowSourceIdentity: true,
+				PrincipalTags:       map[string][]string{"team": {"platform"}},
+			},
+			expected: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Federated": "arn:aws:iam::111111111111:saml-provider/idp"},
+      "Action": ["sts:AssumeRoleWithSAML", "sts:TagSession", "sts:SetSourceIdentity"],
+      "Condition": {
+        "ForAllValues:StringEquals": {"aws:TagKeys": "team"},
+        "StringEquals": {
+          "SAML:aud": "https://signin.aws.amazon.com/saml",
+          "aws:RequestTag/team": "platform"
+        }
+      }
+    }
+  ]
+}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			document := renderPolicyDocument(t, newSAMLTrustPolicyDocumentArgs([]string{"arn:aws:iam::111111111111:saml-provider/idp"}, tt.args))
+			assert.JSONEq(t, tt.expected, document)
+		})
+	}
+}
@@ -50,6 +50,12 @@ type AssumableRolesArgs struct {
 
 	// IAM role with readonly access.
 	Readonly utils.RoleArgs `pulumi:"readonly"`
+
+	// Credentials assuming the roles in the rendered AWS CLI config.
+	AWSConfigSource AWSConfigSourceArgs `pulumi:"awsConfigSource"`
+
+	// Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+	AWSAuth AWSAuthArgs `pulumi:"awsAuth"`
 }
 
 type AssumableRoleOutput struct {
@@ -80,6 +86,15 @@ type AssumableRoles struct {
 
 	// Readonly role.
 	Readonly AssumableRoleOutput `pulumi:"readonly"`
+
+	// AWS CLI config with a profile per role, in INI format.
+	AWSConfig pulumi.StringOutput `pulumi:"awsConfig"`
+
+	// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+	AWSVaultConfig pulumi.StringOutput `pulumi:"awsVaultConfig"`
+
+	// The `mapRoles` entries of the aws-auth ConfigMap granting the roles access to EKS clusters, as YAML.
+	AWSAuthMapRoles pulumi.StringOutput `pulumi:"awsAuthMapRoles"`
 }
 
 func newAssumableRolePolicyDocumentArgs(trustedRoleARNs []string, trustedRoleServices []string, requiresMFA bool, mfaAge int) *iam.GetPolicyDocumentArgs {
@@ -129,6 +144,10 @@ func NewAssumableRoles(ctx *pulumi.Context, name string, args *AssumableRolesArg
 
 	opts = append(opts, pulumi.Parent(component))
 
+	if err := args.AWSConfigSource.validate(); err != nil {
+		return nil, fmt.Errorf("Invalid AWS config source for resource with name [%s]: %w.", name, err)
+	}
+
 	assumeRoleJSON := args.TrustedRoleArns.ToStringArrayOutput().ApplyT(func(arns []string) (string, error) {
 		assumeRoleArgs := newAssumableRolePolicyDocumentArgs(arns, args.TrustedRoleServices, false, 0)
 
@@ -183,5 +202,13 @@ func NewAssumableRoles(ctx *pulumi.Context, name string, args *AssumableRolesArg
 	component.Poweruser = createAssumableRoleOutput(roleOutput[utils.PoweruserRoleType], args.Poweruser.RequiresMFA)
 	component.Readonly = createAssumableRoleOutput(roleOutput[utils.ReadonlyRoleType], args.Readonly.RequiresMFA)
 
+	component.AWSConfig, component.AWSVaultConfig = newAWSConfig(args.AWSConfigSource, []AWSConfigRoleArgs{
+		newAWSConfigRole(roleOutput[utils.AdminRoleType], args.Admin.RequiresMFA),
+		newAWSConfigRole(roleOutput[utils.PoweruserRoleType], args.Poweruser.RequiresMFA),
+		newAWSConfigRole(roleOutput[utils.ReadonlyRoleType], args.Readonly.RequiresMFA),
+	})
+
+	component.AWSAuthMapRoles = newAWSAuthMapRoles(args.AWSAuth, roleOutput)
+
 	return component, nil
 }
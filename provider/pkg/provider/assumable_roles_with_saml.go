@@ -15,6 +15,9 @@
 package provider
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/pulumi/pulumi-aws-iam/pkg/utils"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
@@ -28,6 +31,34 @@ type AssumableRolesWithSAMLArgs struct {
 	// AWS SAML Endpoint.
 	AWSSAMLEndpoint string `pulumi:"awsSamlEndpoint"`
 
+	// List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints. Takes precedence over AWSSAMLEndpoint.
+	AWSSAMLEndpoints []string `pulumi:"awsSamlEndpoints"`
+
+	// Whether the IdP is allowed to pass session tags (sts:TagSession).
+	AllowSessionTags bool `pulumi:"allowSessionTags"`
+
+	// Tag keys that the IdP is allowed to pass as session tags.
+	SessionTagKeys []string `pulumi:"sessionTagKeys"`
+
+	// Whether the IdP is allowed to set a source identity (sts:SetSourceIdentity).
+	AllowSourceIdentity bool `pulumi:"allowSourceIdentity"`
+
+	// Values of SAML:sub allowed to assume the roles.
+	SAMLSubjects []string `pulumi:"samlSubjects"`
+
+	// Values of SAML:iss allowed to assume the roles.
+	SAMLIssuers []string `pulumi:"samlIssuers"`
+
+	// Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the roles.
+	SAMLAttributes map[string][]string `pulumi:"samlAttributes"`
+
+	// Map of session tag keys passed by the IdP to the values allowed to assume the roles, matched as
+	// `aws:RequestTag/<key>`. Implies allowSessionTags.
+	PrincipalTags map[string][]string `pulumi:"principalTags"`
+
+	// Additional conditions to add to the roles trust policy.
+	TrustConditions []PolicyConditionArgs `pulumi:"trustConditions"`
+
 	// Maximum CLI/API session duration in seconds between 3600 and 43200.
 	MaxSessionDuration pulumi.IntInput `pulumi:"maxSessionDuration"`
 
@@ -42,6 +73,13 @@ type AssumableRolesWithSAMLArgs struct {
 
 	// IAM role with readonly access.
 	Readonly utils.RoleArgs `pulumi:"readonly"`
+
+	// Credentials assuming the roles in the rendered AWS CLI config. Only a credentialProcess with a `{role_arn}`
+	// placeholder can sign in to the roles, the AWS CLI config is empty without it.
+	AWSConfigSource AWSConfigSourceArgs `pulumi:"awsConfigSource"`
+
+	// Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+	AWSAuth AWSAuthArgs `pulumi:"awsAuth"`
 }
 
 type AssumableRolesWithSAML struct {
@@ -55,6 +93,15 @@ type AssumableRolesWithSAML struct {
 
 	// Readonly role.
 	Readonly AssumableRoleOutput `pulumi:"readonly"`
+
+	// AWS CLI config with a profile per role, in INI format.
+	AWSConfig pulumi.StringOutput `pulumi:"awsConfig"`
+
+	// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+	AWSVaultConfig pulumi.StringOutput `pulumi:"awsVaultConfig"`
+
+	// The `mapRoles` entries of the aws-auth ConfigMap granting the roles access to EKS clusters, as YAML.
+	AWSAuthMapRoles pulumi.StringOutput `pulumi:"awsAuthMapRoles"`
 }
 
 func NewAssumableRolesWithSAML(ctx *pulumi.Context, name string, args *AssumableRolesWithSAMLArgs, opts ...pulumi.ResourceOption) (*AssumableRolesWithSAML, error) {
@@ -70,11 +117,30 @@ func NewAssumableRolesWithSAML(ctx *pulumi.Context, name string, args *Assumable
 
 	opts = append(opts, pulumi.Parent(component))
 
+	if err := args.AWSConfigSource.validate(); err != nil {
+		return nil, fmt.Errorf("Invalid AWS config source for resource with name [%s]: %w.", name, err)
+	}
+
+	// The roles only trust the IdP, a source profile or IAM Identity Center session cannot assume them.
+	perRoleProcess := strings.Contains(args.AWSConfigSource.CredentialProcess, awsConfigRoleARNPlaceholder)
+	if args.AWSConfigSource != (AWSConfigSourceArgs{}) && !perRoleProcess {
+		return nil, fmt.Errorf("Invalid AWS config source for resource with name [%s]: SAML roles can only be assumed by a credential process signing in with the IdP, set credentialProcess with a %s placeholder.",
+			name, awsConfigRoleARNPlaceholder)
+	}
+
 	assumeRoleJSON := args.ProviderIDs.ToStringArrayOutput().ApplyT(func(ids []string) (string, error) {
-		assumableRoleWithSAMLArgs := newIAMPolicyDocumentStatementConstructor("Allow", []string{"sts:AssumeRoleWithSAML"}).
-			AddFederatedPrincipal(ids).
-			AddCondition("StringEquals", "SAML:aud", []string{args.AWSSAMLEndpoint}).
-			Build()
+		assumableRoleWithSAMLArgs := newSAMLTrustPolicyDocumentArgs(ids, samlTrustPolicyArgs{
+			Endpoint:            args.AWSSAMLEndpoint,
+			Endpoints:           args.AWSSAMLEndpoints,
+			AllowSessionTags:    args.AllowSessionTags,
+			SessionTagKeys:      args.SessionTagKeys,
+			AllowSourceIdentity: args.AllowSourceIdentity,
+			Subjects:            args.SAMLSubjects,
+			Issuers:             args.SAMLIssuers,
+			Attributes:          args.SAMLAttributes,
+			PrincipalTags:       args.PrincipalTags,
+			Conditions:          args.TrustConditions,
+		})
 
 		assumeRole, err := utils.GetIAMPolicyDocument(ctx, assumableRoleWithSAMLArgs)
 		if err != nil {
@@ -102,7 +168,20 @@ func NewAssumableRolesWithSAML(ctx *pulumi.Context, name string, args *Assumable
 
 	component.Admin = createAssumableRoleOutput(roleOutput[utils.AdminRoleType], pulumi.Bool(false))
 	component.Poweruser = createAssumableRoleOutput(roleOutput[utils.PoweruserRoleType], pulumi.Bool(false))
-	component.Readonly = createAssumableRoleOutput(roleOutput[utils.PoweruserRoleType], pulumi.Bool(false))
+	component.Readonly = createAssumableRoleOutput(roleOutput[utils.ReadonlyRoleType], pulumi.Bool(false))
+
+	if perRoleProcess {
+		component.AWSConfig, component.AWSVaultConfig = newAWSConfig(args.AWSConfigSource, []AWSConfigRoleArgs{
+			newAWSConfigRole(roleOutput[utils.AdminRoleType], pulumi.Bool(false)),
+			newAWSConfigRole(roleOutput[utils.PoweruserRoleType], pulumi.Bool(false)),
+			newAWSConfigRole(roleOutput[utils.ReadonlyRoleType], pulumi.Bool(false)),
+		})
+	} else {
+		component.AWSConfig = pulumi.String("").ToStringOutput()
+		component.AWSVaultConfig = pulumi.String("").ToStringOutput()
+	}
+
+	component.AWSAuthMapRoles = newAWSAuthMapRoles(args.AWSAuth, roleOutput)
 
 	return component, nil
 }
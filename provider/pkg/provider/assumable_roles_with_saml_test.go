@@ -0,0 +1,86 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/internals"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssumableRolesWithSAMLAWSConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   AWSConfigSourceArgs
+		expected []string
+		err      string
+	}{
+		{
+			name: "no source",
+		},
+		{
+			name:   "per-role credential process",
+			source: AWSConfigSourceArgs{CredentialProcess: "saml2aws login --role {role_arn} --credential-process"},
+			expected: []string{
+				"[profile saml-admin-role]\ncredential_process = saml2aws login --role arn:aws:iam::123456789012:saml-admin-role --credential-process\n",
+				"[profile saml-readonly-role]\ncredential_process = saml2aws login --role arn:aws:iam::123456789012:saml-readonly-role --credential-process\n",
+			},
+		},
+		{
+			name:   "source profile",
+			source: AWSConfigSourceArgs{SourceProfile: "default"},
+			err:    "Invalid AWS config source for resource with name [saml]: SAML roles can only be assumed by a credential process signing in with the IdP, set credentialProcess with a {role_arn} placeholder.",
+		},
+		{
+			name:   "shared credential process",
+			source: AWSConfigSourceArgs{CredentialProcess: "saml2aws login --credential-process"},
+			err:    "Invalid AWS config source for resource with name [saml]: SAML roles can only be assumed by a credential process signing in with the IdP, set credentialProcess with a {role_arn} placeholder.",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+				roles, err := NewAssumableRolesWithSAML(ctx, "saml", &AssumableRolesWithSAMLArgs{
+					ProviderIDs:     pulumi.ToStringArray([]string{"arn:aws:iam::123456789012:saml-provider/idp"}),
+					AWSConfigSource: tt.source,
+				})
+				if err != nil {
+					return err
+				}
+
+				awsConfig, err := internals.UnsafeAwaitOutput(ctx.Context(), roles.AWSConfig)
+				require.NoError(t, err)
+				if len(tt.expected) == 0 {
+					assert.Equal(t, "", awsConfig.Value)
+				}
+				for _, profile := range tt.expected {
+					assert.Contains(t, awsConfig.Value, profile)
+				}
+				return nil
+			}, pulumi.WithMocks("project", "stack", &trustPolicyMocks{}))
+
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
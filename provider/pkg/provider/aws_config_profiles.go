@@ -0,0 +1,329 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	AWSConfigProfilesIdentifier = "aws-iam:index:AWSConfigProfiles"
+
+	// awsConfigRoleARNPlaceholder is replaced by the role ARN in per-role credential processes.
+	awsConfigRoleARNPlaceholder = "{role_arn}"
+)
+
+type AWSConfigSourceArgs struct {
+	// Prefix added to the name of each role profile.
+	ProfilePrefix string `pulumi:"profilePrefix"`
+
+	// Profile whose credentials assume the roles. Defaults to `default` when no other source is set.
+	SourceProfile string `pulumi:"sourceProfile"`
+
+	// Command printing the credentials which assume the roles. When it contains `{role_arn}` it is run for
+	// each role with its ARN instead, e.g. to sign in to SAML roles with saml2aws.
+	CredentialProcess string `pulumi:"credentialProcess"`
+
+	// Name of the IAM Identity Center session whose credentials assume the roles.
+	SSOSession string `pulumi:"ssoSession"`
+
+	// Start URL of the IAM Identity Center session.
+	SSOStartURL string `pulumi:"ssoStartUrl"`
+
+	// Region of the IAM Identity Center session.
+	SSORegion string `pulumi:"ssoRegion"`
+
+	// Account ID of the IAM Identity Center permission set assuming the roles.
+	SSOAccountID string `pulumi:"ssoAccountId"`
+
+	// Name of the IAM Identity Center permission set assuming the roles.
+	SSORoleName string `pulumi:"ssoRoleName"`
+
+	// ARN of the MFA device used for roles requiring MFA.
+	MFASerial string `pulumi:"mfaSerial"`
+
+	// Default region of the profiles.
+	Region string `pulumi:"region"`
+}
+
+type AWSConfigRoleArgs struct {
+	// Name of the profile, without the profile prefix.
+	ProfileName pulumi.StringInput `pulumi:"profileName"`
+
+	// ARN of the IAM role.
+	RoleARN pulumi.StringInput `pulumi:"roleArn"`
+
+	// Whether the role requires MFA.
+	RequiresMFA pulumi.BoolInput `pulumi:"requiresMfa"`
+
+	// Session duration in seconds.
+	DurationSeconds pulumi.IntPtrInput `pulumi:"durationSeconds"`
+
+	// STS ExternalId to pass when assuming the role.
+	ExternalID pulumi.StringInput `pulumi:"externalId"`
+}
+
+type AWSConfigProfilesArgs struct {
+	// Credentials assuming the roles.
+	Source AWSConfigSourceArgs `pulumi:"source"`
+
+	// Roles to render a profile for.
+	Roles []AWSConfigRoleArgs `pulumi:"roles"`
+}
+
+type AWSConfigProfiles struct {
+	pulumi.ResourceState
+
+	// AWS CLI config with a profile per role, in INI format.
+	Config pulumi.StringOutput `pulumi:"config"`
+
+	// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+	AWSVaultConfig pulumi.StringOutput `pulumi:"awsVaultConfig"`
+}
+
+type awsConfigRole struct {
+	ProfileName     string
+	RoleARN         string
+	RequiresMFA     bool
+	DurationSeconds int
+	ExternalID      string
+}
+
+func (s AWSConfigSourceArgs) validate() error {
+	sources := 0
+	for _, source := range []string{s.SourceProfile, s.CredentialProcess, s.SSOSession} {
+		if source != "" {
+			sources++
+		}
+	}
+
+	if sources > 1 {
+		return fmt.Errorf("only one of sourceProfile, credentialProcess or ssoSession can be set")
+	}
+
+	if s.SSOSession != "" && (s.SSOStartURL == "" || s.SSORegion == "" || s.SSOAccountID == "" || s.SSORoleName == "") {
+		return fmt.Errorf("ssoSession requires ssoStartUrl, ssoRegion, ssoAccountId and ssoRoleName")
+	}
+
+	return nil
+}
+
+// awsConfigProfile is a profile of the AWS CLI config, settings left empty are not written.
+type awsConfigProfile struct {
+	Name                 string
+	RoleARN              string
+	WebIdentityTokenFile string
+	SourceProfile        string
+	CredentialProcess    string
+	SSOSession           string
+	SSOStartURL          string
+	SSORegion            string
+	SSOAccountID         string
+	SSORoleName          string
+	RequiresMFA          bool
+	MFASerial            string
+	ExternalID           string
+	RoleSessionName      string
+	DurationSeconds      int
+	Region               string
+}
+
+// render returns the profile section, with a placeholder for the MFA device of roles requiring MFA when
+// its ARN is unknown.
+func (p awsConfigProfile) render() string {
+	lines := []string{fmt.Sprintf("[profile %s]", p.Name)}
+	setting := func(key, value string) {
+		if value != "" {
+			lines = append(lines, fmt.Sprintf("%s = %s", key, value))
+		}
+	}
+
+	setting("role_arn", p.RoleARN)
+	setting("web_identity_token_file", p.WebIdentityTokenFile)
+	setting("source_profile", p.SourceProfile)
+	setting("credential_process", p.CredentialProcess)
+	setting("sso_session", p.SSOSession)
+	setting("sso_start_url", p.SSOStartURL)
+	setting("sso_region", p.SSORegion)
+	setting("sso_account_id", p.SSOAccountID)
+	setting("sso_role_name", p.SSORoleName)
+
+	if p.RequiresMFA {
+		if p.MFASerial != "" {
+			setting("mfa_serial", p.MFASerial)
+		} else {
+			lines = append(lines, "# mfa_serial = <ARN of your MFA device>")
+		}
+	}
+
+	setting("external_id", p.ExternalID)
+	setting("role_session_name", p.RoleSessionName)
+	if p.DurationSeconds != 0 {
+		setting("duration_seconds", fmt.Sprint(p.DurationSeconds))
+	}
+	setting("region", p.Region)
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// renderAWSConfig renders the AWS CLI config of the roles. With legacySSO the IAM Identity Center settings
+// are written into the source profile instead of an sso-session section, as granted and aws-vault expect.
+func renderAWSConfig(source AWSConfigSourceArgs, roles []awsConfigRole, legacySSO bool) string {
+	var sections []string
+
+	perRoleProcess := strings.Contains(source.CredentialProcess, awsConfigRoleARNPlaceholder)
+	sourceProfile := source.SourceProfile
+	switch {
+	case source.SSOSession != "":
+		sourceProfile = fmt.Sprintf("%s%s", source.ProfilePrefix, source.SSOSession)
+		profile := awsConfigProfile{
+			Name:         sourceProfile,
+			SSOAccountID: source.SSOAccountID,
+			SSORoleName:  source.SSORoleName,
+			Region:       source.Region,
+		}
+
+		if legacySSO {
+			profile.SSOStartURL = source.SSOStartURL
+			profile.SSORegion = source.SSORegion
+		} else {
+			sections = append(sections, strings.Join([]string{
+				fmt.Sprintf("[sso-session %s]", source.SSOSession),
+				fmt.Sprintf("sso_start_url = %s", source.SSOStartURL),
+				fmt.Sprintf("sso_region = %s", source.SSORegion),
+				"sso_registration_scopes = sso:account:access",
+			}, "\n")+"\n")
+			profile.SSOSession = source.SSOSession
+		}
+
+		sections = append(sections, profile.render())
+	case source.CredentialProcess != "" && !perRoleProcess:
+		sourceProfile = fmt.Sprintf("%ssource", source.ProfilePrefix)
+		sections = append(sections, awsConfigProfile{
+			Name:              sourceProfile,
+			CredentialProcess: source.CredentialProcess,
+			Region:            source.Region,
+		}.render())
+	case sourceProfile == "":
+		sourceProfile = "default"
+	}
+
+	for _, role := range roles {
+		profile := awsConfigProfile{
+			Name:   fmt.Sprintf("%s%s", source.ProfilePrefix, role.ProfileName),
+			Region: source.Region,
+		}
+
+		if perRoleProcess {
+			profile.CredentialProcess = strings.ReplaceAll(source.CredentialProcess, awsConfigRoleARNPlaceholder, role.RoleARN)
+		} else {
+			profile.RoleARN = role.RoleARN
+			profile.SourceProfile = sourceProfile
+			profile.RequiresMFA = role.RequiresMFA
+			profile.MFASerial = source.MFASerial
+			profile.ExternalID = role.ExternalID
+			profile.DurationSeconds = role.DurationSeconds
+		}
+
+		sections = append(sections, profile.render())
+	}
+
+	return strings.Join(sections, "\n")
+}
+
+// newAWSConfig renders the standard and the granted/aws-vault AWS CLI config of the roles once they are known.
+func newAWSConfig(source AWSConfigSourceArgs, roles []AWSConfigRoleArgs) (pulumi.StringOutput, pulumi.StringOutput) {
+	var inputs []interface{}
+	for _, role := range roles {
+		requiresMFA := role.RequiresMFA
+		if requiresMFA == nil {
+			requiresMFA = pulumi.Bool(false)
+		}
+
+		durationSeconds := role.DurationSeconds
+		if durationSeconds == nil {
+			durationSeconds = pulumi.IntPtrFromPtr(nil)
+		}
+
+		externalID := role.ExternalID
+		if externalID == nil {
+			externalID = pulumi.String("")
+		}
+
+		inputs = append(inputs, role.ProfileName, role.RoleARN, requiresMFA, durationSeconds, externalID)
+	}
+
+	rendered := pulumi.All(inputs...).ApplyT(func(x []interface{}) []string {
+		var resolved []awsConfigRole
+		for i := 0; i < len(x); i += 5 {
+			role := awsConfigRole{
+				ProfileName: x[i].(string),
+				RoleARN:     x[i+1].(string),
+				RequiresMFA: x[i+2].(bool),
+				ExternalID:  x[i+4].(string),
+			}
+
+			if d, ok := x[i+3].(*int); ok && d != nil {
+				role.DurationSeconds = *d
+			}
+
+			resolved = append(resolved, role)
+		}
+
+		return []string{renderAWSConfig(source, resolved, false), renderAWSConfig(source, resolved, true)}
+	}).(pulumi.StringArrayOutput)
+
+	return rendered.Index(pulumi.Int(0)), rendered.Index(pulumi.Int(1))
+}
+
+// newAWSConfigRole returns the profile of a role, assumed for up to its maximum session duration.
+func newAWSConfigRole(role *iam.Role, requiresMFA pulumi.BoolInput) AWSConfigRoleArgs {
+	return AWSConfigRoleArgs{
+		ProfileName:     role.Name,
+		RoleARN:         role.Arn,
+		RequiresMFA:     requiresMFA,
+		DurationSeconds: role.MaxSessionDuration,
+	}
+}
+
+func NewAWSConfigProfiles(ctx *pulumi.Context, name string, args *AWSConfigProfilesArgs, opts ...pulumi.ResourceOption) (*AWSConfigProfiles, error) {
+	if args == nil {
+		args = &AWSConfigProfilesArgs{}
+	}
+
+	component := &AWSConfigProfiles{}
+	err := ctx.RegisterComponentResource(AWSConfigProfilesIdentifier, name, component, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := args.Source.validate(); err != nil {
+		return nil, fmt.Errorf("Invalid source for resource with name [%s]: %w.", name, err)
+	}
+
+	for i, role := range args.Roles {
+		if role.ProfileName == nil || role.RoleARN == nil {
+			return nil, fmt.Errorf("Profile name and role ARN of role [%d] are required for resource with name [%s].", i, name)
+		}
+	}
+
+	component.Config, component.AWSVaultConfig = newAWSConfig(args.Source, args.Roles)
+
+	return component, nil
+}
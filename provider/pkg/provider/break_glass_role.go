@@ -0,0 +1,374 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws-iam/pkg/utils"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/cloudwatch"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/sns"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	BreakGlassRoleIdentifier = "aws-iam:index:BreakGlassRole"
+
+	breakGlassRoleDefaultName   = "break-glass"
+	breakGlassRoleDefaultMFAAge = 900
+	breakGlassRoleTagKey        = "BreakGlass"
+
+	// breakGlassGlobalEventRegion is the region CloudTrail delivers the calls to the global STS endpoint in.
+	breakGlassGlobalEventRegion = "us-east-1"
+)
+
+type BreakGlassRoleArgs struct {
+	// ARNs of the principals allowed to assume the role.
+	TrustedRoleArns pulumi.StringArrayInput `pulumi:"trustedRoleArns"`
+
+	// Source IP ranges the role can be assumed from. Any source IP is allowed when empty.
+	SourceIPs []string `pulumi:"sourceIps"`
+
+	// Max age of valid MFA (in seconds) when assuming the role.
+	MFAAge int `pulumi:"mfaAge"`
+
+	// Maximum CLI/API session duration in seconds between 3600 and 43200.
+	MaxSessionDuration pulumi.IntInput `pulumi:"maxSessionDuration"`
+
+	// IAM role.
+	Role utils.RoleArgs `pulumi:"role"`
+
+	// A map of tags to add. The role is also tagged with `BreakGlass: true`.
+	Tags pulumi.StringMapInput `pulumi:"tags"`
+
+	// ARN of an existing SNS topic to send alerts to. A topic is created when not set.
+	AlertTopicARN pulumi.StringInput `pulumi:"alertTopicArn"`
+
+	// Email addresses subscribed to the alert topic.
+	AlertEmails []string `pulumi:"alertEmails"`
+
+	// Explicit `aws` provider for us-east-1, used to forward the calls to the global STS endpoint to the
+	// region of the role. Required outside of us-east-1, unless only the event pattern is output.
+	GlobalEventsProvider pulumi.ProviderResource `pulumi:"globalEventsProvider"`
+
+	// Whether to only output the EventBridge event pattern instead of creating the rule and SNS topic.
+	EventPatternOnly bool `pulumi:"eventPatternOnly"`
+}
+
+type BreakGlassRole struct {
+	pulumi.ResourceState
+
+	// IAM Role
+	Role AssumableRoleRoleOutput `pulumi:"role"`
+
+	// EventBridge event pattern matching AssumeRole calls into the role.
+	EventPattern pulumi.StringOutput `pulumi:"eventPattern"`
+
+	// ARN of the EventBridge rule alerting on AssumeRole calls into the role. Empty when only the event pattern is output.
+	// Outside of us-east-1 a second rule forwards the calls to the global STS endpoint from us-east-1 to this rule.
+	EventRuleARN pulumi.StringOutput `pulumi:"eventRuleArn"`
+
+	// ARN of the SNS topic receiving the alerts.
+	AlertTopicARN pulumi.StringOutput `pulumi:"alertTopicArn"`
+}
+
+// newBreakGlassGlobalEventForwarding forwards the events matching the event pattern from us-east-1, where
+// CloudTrail delivers the calls to the global STS endpoint, to the default event bus of the current region.
+// The rule in us-east-1 is created with the given global events provider.
+func newBreakGlassGlobalEventForwarding(ctx *pulumi.Context, name string, eventPattern pulumi.StringOutput, tags pulumi.StringMapOutput,
+	globalProvider pulumi.ProviderResource, opts ...pulumi.ResourceOption) error {
+	region, err := aws.GetRegion(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if region.Name == breakGlassGlobalEventRegion {
+		return nil
+	}
+
+	if globalProvider == nil {
+		return fmt.Errorf("Global events provider for %s is required in %s for resource with name [%s].",
+			breakGlassGlobalEventRegion, region.Name, name)
+	}
+
+	globalRegion, err := aws.GetRegion(ctx, nil, pulumi.Provider(globalProvider))
+	if err != nil {
+		return err
+	}
+
+	if globalRegion.Name != breakGlassGlobalEventRegion {
+		return fmt.Errorf("Global events provider is configured for %s instead of %s for resource with name [%s].",
+			globalRegion.Name, breakGlassGlobalEventRegion, name)
+	}
+
+	account, err := aws.GetCallerIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	partition, err := aws.GetPartition(ctx)
+	if err != nil {
+		return err
+	}
+
+	eventBusARN := fmt.Sprintf("arn:%s:events:%s:%s:event-bus/default", partition.Partition, region.Name, account.AccountId)
+
+	assumeRolePolicy, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
+		Statements: []iam.GetPolicyDocumentStatement{
+			{
+				Effect:  pulumi.StringRef("Allow"),
+				Actions: []string{"sts:AssumeRole"},
+				Principals: []iam.GetPolicyDocumentStatementPrincipal{
+					{
+						Type:        "Service",
+						Identifiers: []string{fmt.Sprintf("events.%s", partition.DnsSuffix)},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	forwardingPolicy, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
+		Statements: []iam.GetPolicyDocumentStatement{
+			{
+				Effect:    pulumi.StringRef("Allow"),
+				Actions:   []string{"events:PutEvents"},
+				Resources: []string{eventBusARN},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	forwardingRole, err := iam.NewRole(ctx, fmt.Sprintf("%s-global-events", name), &iam.RoleArgs{
+		Description:      pulumi.String("Forwards the AssumeRole calls to the global STS endpoint of a break-glass role."),
+		AssumeRolePolicy: pulumi.String(assumeRolePolicy.Json),
+		InlinePolicies: iam.RoleInlinePolicyArray{
+			iam.RoleInlinePolicyArgs{
+				Name:   pulumi.String("forward-global-events"),
+				Policy: pulumi.String(forwardingPolicy.Json),
+			},
+		},
+		Tags: tags,
+	}, opts...)
+	if err != nil {
+		return err
+	}
+
+	globalOpts := append(append([]pulumi.ResourceOption{}, opts...), pulumi.Provider(globalProvider))
+
+	rule, err := cloudwatch.NewEventRule(ctx, fmt.Sprintf("%s-assume-role-global", name), &cloudwatch.EventRuleArgs{
+		Description:  pulumi.String("Forwards the AssumeRole calls to the global STS endpoint of a break-glass role."),
+		EventPattern: eventPattern,
+		Tags:         tags,
+	}, globalOpts...)
+	if err != nil {
+		return err
+	}
+
+	_, err = cloudwatch.NewEventTarget(ctx, fmt.Sprintf("%s-assume-role-global", name), &cloudwatch.EventTargetArgs{
+		Rule:    rule.Name,
+		Arn:     pulumi.String(eventBusARN),
+		RoleArn: forwardingRole.Arn,
+	}, globalOpts...)
+
+	return err
+}
+
+// newBreakGlassEventPattern returns the EventBridge event pattern of AssumeRole calls into a role, as
+// recorded by CloudTrail.
+func newBreakGlassEventPattern(roleARN string) (string, error) {
+	pattern, err := json.Marshal(map[string]interface{}{
+		"source":      []string{"aws.sts"},
+		"detail-type": []string{"AWS API Call via CloudTrail"},
+		"detail": map[string]interface{}{
+			"eventSource": []string{"sts.amazonaws.com"},
+			"eventName":   []string{"AssumeRole"},
+			"requestParameters": map[string]interface{}{
+				"roleArn": []string{roleARN},
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(pattern), nil
+}
+
+func NewBreakGlassRole(ctx *pulumi.Context, name string, args *BreakGlassRoleArgs, opts ...pulumi.ResourceOption) (*BreakGlassRole, error) {
+	if args == nil {
+		args = &BreakGlassRoleArgs{}
+	}
+
+	component := &BreakGlassRole{}
+	err := ctx.RegisterComponentResource(BreakGlassRoleIdentifier, name, component, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts, pulumi.Parent(component))
+
+	if args.TrustedRoleArns == nil {
+		return nil, fmt.Errorf("Trusted role ARNs are required for resource with name [%s].", name)
+	}
+
+	alertEmails := map[string]bool{}
+	for _, email := range args.AlertEmails {
+		if alertEmails[email] {
+			return nil, fmt.Errorf("Alert email [%s] is listed more than once for resource with name [%s].", email, name)
+		}
+		alertEmails[email] = true
+	}
+
+	if args.MFAAge == 0 {
+		args.MFAAge = breakGlassRoleDefaultMFAAge
+	}
+
+	if args.Tags == nil {
+		args.Tags = pulumi.StringMap{}
+	}
+
+	tags := args.Tags.ToStringMapOutput().ApplyT(func(tags map[string]string) map[string]string {
+		result := map[string]string{breakGlassRoleTagKey: "true"}
+		for k, v := range tags {
+			result[k] = v
+		}
+		return result
+	}).(pulumi.StringMapOutput)
+
+	var trustConditions []PolicyConditionArgs
+	if len(args.SourceIPs) > 0 {
+		trustConditions = append(trustConditions, PolicyConditionArgs{Test: "IpAddress", Variable: "aws:SourceIp", Values: args.SourceIPs})
+	}
+
+	args.Role.Name = setDefaultStringPtr(args.Role.Name, breakGlassRoleDefaultName)
+	args.Role.RequiresMFA = pulumi.Bool(true)
+
+	role, err := NewAssumableRole(ctx, name, &AssumableRoleArgs{
+		TrustedRoleArns:       args.TrustedRoleArns,
+		MFAAge:                pulumi.Int(args.MFAAge),
+		MaxSessionDuration:    args.MaxSessionDuration,
+		Role:                  args.Role,
+		Tags:                  tags,
+		AttachAdminPolicy:     true,
+		TrustConditions:       trustConditions,
+		CreateInstanceProfile: pulumi.BoolRef(false),
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	component.Role = role.Role
+
+	component.EventPattern = role.Role.Arn.ApplyT(newBreakGlassEventPattern).(pulumi.StringOutput)
+
+	if args.EventPatternOnly {
+		component.EventRuleARN = pulumi.String("").ToStringOutput()
+		component.AlertTopicARN = pulumi.String("").ToStringOutput()
+		if args.AlertTopicARN != nil {
+			component.AlertTopicARN = args.AlertTopicARN.ToStringOutput()
+		}
+		return component, nil
+	}
+
+	rule, err := cloudwatch.NewEventRule(ctx, fmt.Sprintf("%s-assume-role", name), &cloudwatch.EventRuleArgs{
+		Description:  pulumi.Sprintf("Alerts when the break-glass role %s is assumed.", role.Role.Name),
+		EventPattern: component.EventPattern,
+		Tags:         tags,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	alertTopicARN := args.AlertTopicARN
+	if alertTopicARN == nil {
+		topic, err := sns.NewTopic(ctx, fmt.Sprintf("%s-alerts", name), &sns.TopicArgs{
+			Tags: tags,
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		topicPolicy := iam.GetPolicyDocumentOutput(ctx, iam.GetPolicyDocumentOutputArgs{
+			Statements: iam.GetPolicyDocumentStatementArray{
+				iam.GetPolicyDocumentStatementArgs{
+					Effect:    pulumi.String("Allow"),
+					Actions:   pulumi.ToStringArray([]string{"sns:Publish"}),
+					Resources: pulumi.StringArray{topic.Arn},
+					Principals: iam.GetPolicyDocumentStatementPrincipalArray{
+						iam.GetPolicyDocumentStatementPrincipalArgs{
+							Type:        pulumi.String("Service"),
+							Identifiers: pulumi.ToStringArray([]string{"events.amazonaws.com"}),
+						},
+					},
+					Conditions: iam.GetPolicyDocumentStatementConditionArray{
+						iam.GetPolicyDocumentStatementConditionArgs{
+							Test:     pulumi.String("ArnEquals"),
+							Variable: pulumi.String("aws:SourceArn"),
+							Values:   pulumi.StringArray{rule.Arn},
+						},
+					},
+				},
+			},
+		})
+
+		_, err = sns.NewTopicPolicy(ctx, fmt.Sprintf("%s-alerts", name), &sns.TopicPolicyArgs{
+			Arn:    topic.Arn,
+			Policy: topicPolicy.Json(),
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		alertTopicARN = topic.Arn
+	}
+
+	for _, email := range args.AlertEmails {
+		_, err = sns.NewTopicSubscription(ctx, fmt.Sprintf("%s-alerts-%s", name, utils.HashKey(email)), &sns.TopicSubscriptionArgs{
+			Topic:    alertTopicARN,
+			Protocol: pulumi.String("email"),
+			Endpoint: pulumi.String(email),
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = cloudwatch.NewEventTarget(ctx, fmt.Sprintf("%s-assume-role", name), &cloudwatch.EventTargetArgs{
+		Rule: rule.Name,
+		Arn:  alertTopicARN,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = newBreakGlassGlobalEventForwarding(ctx, name, component.EventPattern, tags, args.GlobalEventsProvider, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	component.EventRuleARN = rule.Arn
+	component.AlertTopicARN = alertTopicARN.ToStringOutput()
+
+	return component, nil
+}
@@ -0,0 +1,188 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pulumi/pulumi-aws-iam/pkg/utils"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// breakGlassMocks deploys to region, except for calls through providers whose name contains another region,
+// and records the created resources by name.
+type breakGlassMocks struct {
+	region string
+
+	mu        sync.Mutex
+	resources map[string]pulumi.MockResourceArgs
+}
+
+func (m *breakGlassMocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	m.mu.Lock()
+	if m.resources == nil {
+		m.resources = map[string]pulumi.MockResourceArgs{}
+	}
+	m.resources[args.Name] = args
+	m.mu.Unlock()
+
+	outputs := args.Inputs.Copy()
+	outputs["arn"] = resource.NewStringProperty(fmt.Sprintf("arn:aws:iam::123456789012:%s", args.Name))
+	outputs["name"] = resource.NewStringProperty(args.Name)
+	return args.Name + "-id", outputs, nil
+}
+
+func (m *breakGlassMocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	switch args.Token {
+	case "aws:index/getRegion:getRegion":
+		region := m.region
+		for _, r := range []string{"us-east-1", "eu-central-1"} {
+			if strings.Contains(args.Provider, r) {
+				region = r
+			}
+		}
+		return resource.NewPropertyMapFromMap(map[string]interface{}{"name": region}), nil
+	case "aws:index/getCallerIdentity:getCallerIdentity":
+		return resource.NewPropertyMapFromMap(map[string]interface{}{"accountId": "123456789012"}), nil
+	case "aws:index/getPartition:getPartition":
+		return resource.NewPropertyMapFromMap(map[string]interface{}{"partition": "aws", "dnsSuffix": "amazonaws.com"}), nil
+	case "aws:iam/getPolicyDocument:getPolicyDocument":
+		return resource.NewPropertyMapFromMap(map[string]interface{}{"json": "{}"}), nil
+	}
+
+	return resource.PropertyMap{}, nil
+}
+
+func TestBreakGlassRoleGlobalEvents(t *testing.T) {
+	tests := []struct {
+		name           string
+		region         string
+		providerRegion string
+		forwarded      bool
+		err            string
+	}{
+		{
+			name:   "us_east_1",
+			region: "us-east-1",
+		},
+		{
+			name:   "without_provider",
+			region: "eu-west-1",
+			err:    "Global events provider for us-east-1 is required in eu-west-1 for resource with name [break-glass].",
+		},
+		{
+			name:           "with_provider",
+			region:         "eu-west-1",
+			providerRegion: "us-east-1",
+			forwarded:      true,
+		},
+		{
+			name:           "with_provider_in_other_region",
+			region:         "eu-west-1",
+			providerRegion: "eu-central-1",
+			err:            "Global events provider is configured for eu-central-1 instead of us-east-1 for resource with name [break-glass].",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mocks := &breakGlassMocks{region: tt.region}
+			err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+				args := &BreakGlassRoleArgs{
+					TrustedRoleArns: pulumi.ToStringArray([]string{"arn:aws:iam::111111111111:user/oncall"}),
+				}
+
+				if tt.providerRegion != "" {
+					provider, err := aws.NewProvider(ctx, tt.providerRegion, &aws.ProviderArgs{
+						Region: pulumi.String(tt.providerRegion),
+					})
+					if err != nil {
+						return err
+					}
+					args.GlobalEventsProvider = provider
+				}
+
+				_, err := NewBreakGlassRole(ctx, "break-glass", args)
+				return err
+			}, pulumi.WithMocks("project", "stack", mocks))
+
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			rule, forwarded := mocks.resources["break-glass-assume-role-global"]
+			assert.Equal(t, tt.forwarded, forwarded)
+			if tt.forwarded {
+				assert.Contains(t, rule.Provider, tt.providerRegion)
+			}
+		})
+	}
+}
+
+func TestBreakGlassRoleAlertEmails(t *testing.T) {
+	emails := []string{"oncall@example.com", "security@example.com"}
+
+	tests := []struct {
+		name          string
+		alertTopicARN pulumi.StringInput
+		topicARN      string
+	}{
+		{
+			name:     "created_topic",
+			topicARN: "arn:aws:iam::123456789012:break-glass-alerts",
+		},
+		{
+			name:          "existing_topic",
+			alertTopicARN: pulumi.String("arn:aws:sns:us-east-1:123456789012:alerts"),
+			topicARN:      "arn:aws:sns:us-east-1:123456789012:alerts",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mocks := &breakGlassMocks{region: "us-east-1"}
+			err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+				_, err := NewBreakGlassRole(ctx, "break-glass", &BreakGlassRoleArgs{
+					TrustedRoleArns: pulumi.ToStringArray([]string{"arn:aws:iam::111111111111:user/oncall"}),
+					AlertTopicARN:   tt.alertTopicARN,
+					AlertEmails:     emails,
+				})
+				return err
+			}, pulumi.WithMocks("project", "stack", mocks))
+			require.NoError(t, err)
+
+			_, created := mocks.resources["break-glass-alerts"]
+			assert.Equal(t, tt.alertTopicARN == nil, created)
+
+			for _, email := range emails {
+				subscription, ok := mocks.resources[fmt.Sprintf("break-glass-alerts-%s", utils.HashKey(email))]
+				require.True(t, ok, email)
+				assert.Equal(t, tt.topicARN, subscription.Inputs["topic"].StringValue())
+				assert.Equal(t, email, subscription.Inputs["endpoint"].StringValue())
+			}
+		})
+	}
+}
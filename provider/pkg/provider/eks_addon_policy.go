@@ -0,0 +1,128 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws-iam/pkg/eks_policies"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const EKSAddonPolicyIdentifier = "aws-iam:index:EKSAddonPolicy"
+
+type EKSAddonPolicyArgs struct {
+	// Name of the add-on to create the policy for, e.g. `velero` or `karpenterController`.
+	Addon string `pulumi:"addon"`
+
+	// Arguments of the add-ons. Only the arguments of the selected add-on are used, its `attach`
+	// flag is implied. Add-ons with several policies, e.g. `loadBalancer`, must select exactly one.
+	Policies EKSServiceAccountPolicies `pulumi:"policies"`
+
+	// IAM policy name prefix.
+	PolicyNamePrefix string `pulumi:"policyNamePrefix"`
+
+	// The path of the policy in IAM.
+	Path pulumi.StringInput `pulumi:"path"`
+
+	// A map of tags to add.
+	Tags pulumi.StringMapInput `pulumi:"tags"`
+}
+
+type EKSAddonPolicy struct {
+	pulumi.ResourceState
+
+	// Policy document as json.
+	PolicyJSON pulumi.StringOutput `pulumi:"policyJson"`
+
+	// The policy's ID.
+	ID pulumi.StringOutput `pulumi:"id"`
+
+	// The name of the policy.
+	Name pulumi.StringOutput `pulumi:"name"`
+
+	// The ARN assigned by AWS to this policy.
+	ARN pulumi.StringOutput `pulumi:"arn"`
+
+	// The description of the policy.
+	Description pulumi.StringPtrOutput `pulumi:"description"`
+
+	// The path of the policy in IAM.
+	Path pulumi.StringPtrOutput `pulumi:"path"`
+}
+
+func NewEKSAddonPolicy(ctx *pulumi.Context, name string, args *EKSAddonPolicyArgs, opts ...pulumi.ResourceOption) (*EKSAddonPolicy, error) {
+	if args == nil {
+		args = &EKSAddonPolicyArgs{}
+	}
+
+	component := &EKSAddonPolicy{}
+	err := ctx.RegisterComponentResource(EKSAddonPolicyIdentifier, name, component, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts, pulumi.Parent(component))
+
+	addon, ok := eks_policies.LookupAddon(args.Addon)
+	if !ok {
+		return nil, fmt.Errorf("Unknown add-on [%s] for resource with name [%s].", args.Addon, name)
+	}
+
+	addonArgs, err := args.Policies.addonArgs()
+	if err != nil {
+		return nil, fmt.Errorf("resource with name [%s]: %w", name, err)
+	}
+
+	account, err := aws.GetCallerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currentPartition, err := aws.GetPartition(ctx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Path == nil {
+		args.Path = pulumi.String("/")
+	}
+
+	policyBuilder := eks_policies.CreateNewRoleBuilder(ctx, nil, name, args.PolicyNamePrefix, args.Path, args.Tags, opts...)
+	policyBuilder.AWSAccountID = account.AccountId
+	policyBuilder.AWSCurrentPartition = currentPartition.Partition
+	policyBuilder.DNSSuffix = currentPartition.DnsSuffix
+
+	err = addon.Attach(policyBuilder, addon.Selected(addonArgs[addon.Name]))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policyBuilder.Policies) != 1 {
+		return nil, fmt.Errorf("The arguments of add-on [%s] select %d policies, exactly one is required for resource with name [%s].",
+			addon.Name, len(policyBuilder.Policies), name)
+	}
+
+	policy := policyBuilder.Policies[0]
+	component.PolicyJSON = policy.Policy
+	component.ID = policy.ID().ToStringOutput()
+	component.Name = policy.Name
+	component.ARN = policy.Arn
+	component.Description = policy.Description
+	component.Path = policy.Path
+
+	return component, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pulumi/pulumi-aws-iam/pkg/utils"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type AWSAuthRoleArgs struct {
+	// Kubernetes username of the role. Defaults to `<role name>:{{SessionName}}`.
+	Username string `pulumi:"username"`
+
+	// Kubernetes groups of the role, e.g. `system:masters`.
+	Groups []string `pulumi:"groups"`
+}
+
+type AWSAuthArgs struct {
+	// Mapping of the admin role.
+	Admin AWSAuthRoleArgs `pulumi:"admin"`
+
+	// Mapping of the poweruser role.
+	Poweruser AWSAuthRoleArgs `pulumi:"poweruser"`
+
+	// Mapping of the readonly role.
+	Readonly AWSAuthRoleArgs `pulumi:"readonly"`
+}
+
+type awsAuthMapRole struct {
+	AWSAuthRoleArgs
+
+	RoleARN string
+}
+
+// awsAuthRoleARN strips the path from a role ARN since the aws-auth ConfigMap does not match role ARNs with paths.
+func awsAuthRoleARN(roleARN string) (string, string) {
+	prefix, resource, ok := strings.Cut(roleARN, ":role/")
+	if !ok {
+		return roleARN, roleARN
+	}
+
+	parts := strings.Split(resource, "/")
+	roleName := parts[len(parts)-1]
+	return fmt.Sprintf("%s:role/%s", prefix, roleName), roleName
+}
+
+// renderAWSAuthMapRoles returns the `mapRoles` entries of the aws-auth ConfigMap for the roles as YAML.
+func renderAWSAuthMapRoles(roles []awsAuthMapRole) (string, error) {
+	var mapRoles []interface{}
+	for _, role := range roles {
+		roleARN, roleName := awsAuthRoleARN(role.RoleARN)
+
+		username := role.Username
+		if username == "" {
+			username = fmt.Sprintf("%s:{{SessionName}}", roleName)
+		}
+
+		mapRole := map[string]interface{}{
+			"rolearn":  roleARN,
+			"username": username,
+		}
+
+		if len(role.Groups) > 0 {
+			mapRole["groups"] = role.Groups
+		}
+
+		mapRoles = append(mapRoles, mapRole)
+	}
+
+	mapRolesYAML, err := yaml.Marshal(mapRoles)
+	if err != nil {
+		return "", err
+	}
+
+	return string(mapRolesYAML), nil
+}
+
+// newAWSAuthMapRoles returns the aws-auth `mapRoles` entries of the admin, poweruser and readonly roles.
+func newAWSAuthMapRoles(args AWSAuthArgs, roles map[utils.RoleTypeIdentifier]*iam.Role) pulumi.StringOutput {
+	return pulumi.All(roles[utils.AdminRoleType].Arn, roles[utils.PoweruserRoleType].Arn, roles[utils.ReadonlyRoleType].Arn).ApplyT(func(x []interface{}) (string, error) {
+		return renderAWSAuthMapRoles([]awsAuthMapRole{
+			{AWSAuthRoleArgs: args.Admin, RoleARN: x[0].(string)},
+			{AWSAuthRoleArgs: args.Poweruser, RoleARN: x[1].(string)},
+			{AWSAuthRoleArgs: args.Readonly, RoleARN: x[2].(string)},
+		})
+	}).(pulumi.StringOutput)
+}
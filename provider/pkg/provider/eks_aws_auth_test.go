@@ -0,0 +1,52 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderAWSAuthMapRoles(t *testing.T) {
+	mapRoles, err := renderAWSAuthMapRoles([]awsAuthMapRole{
+		{
+			AWSAuthRoleArgs: AWSAuthRoleArgs{Groups: []string{"system:masters"}},
+			RoleARN:         "arn:aws:iam::123456789012:role/admin",
+		},
+		{
+			AWSAuthRoleArgs: AWSAuthRoleArgs{Username: "developer", Groups: []string{"developers", "viewers"}},
+			RoleARN:         "arn:aws:iam::123456789012:role/teams/platform/poweruser",
+		},
+		{
+			RoleARN: "arn:aws-cn:iam::123456789012:role/readonly",
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `- groups:
+  - system:masters
+  rolearn: arn:aws:iam::123456789012:role/admin
+  username: admin:{{SessionName}}
+- groups:
+  - developers
+  - viewers
+  rolearn: arn:aws:iam::123456789012:role/poweruser
+  username: developer
+- rolearn: arn:aws-cn:iam::123456789012:role/readonly
+  username: readonly:{{SessionName}}
+`, mapRoles)
+}
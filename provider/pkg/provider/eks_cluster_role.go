@@ -0,0 +1,133 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws-iam/pkg/utils"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const EKSClusterRoleIdentifier = "aws-iam:index:EKSClusterRole"
+
+type EKSClusterRoleArgs struct {
+	// A map of tags to add.
+	Tags pulumi.StringMapInput `pulumi:"tags"`
+
+	// IAM role.
+	Role utils.RoleArgs `pulumi:"role"`
+
+	// Maximum CLI/API session duration in seconds between 3600 and 43200.
+	MaxSessionDuration pulumi.IntInput `pulumi:"maxSessionDuration"`
+
+	// Whether policies should be detached from this role when destroying.
+	ForceDetachPolicies pulumi.BoolInput `pulumi:"forceDetachPolicies"`
+
+	// Whether to attach the AmazonEKSVPCResourceController policy, needed for security groups for pods.
+	AttachVPCResourceControllerPolicy bool `pulumi:"attachVpcResourceControllerPolicy"`
+
+	// Whether to attach the policies needed by EKS Auto Mode and allow EKS to tag its sessions.
+	EnableAutoMode bool `pulumi:"enableAutoMode"`
+}
+
+type EKSClusterRole struct {
+	pulumi.ResourceState
+
+	// ARN of IAM role.
+	Arn pulumi.StringOutput `pulumi:"arn"`
+
+	// Name of IAM role.
+	Name pulumi.StringOutput `pulumi:"name"`
+
+	// Path of IAM role.
+	Path pulumi.StringPtrOutput `pulumi:"path"`
+
+	// Unique ID of IAM role.
+	UniqueID pulumi.StringOutput `pulumi:"uniqueId"`
+}
+
+// awsManagedPolicyARN returns the ARN of an AWS managed policy in the given partition.
+func awsManagedPolicyARN(partition, policyName string) pulumi.StringInput {
+	return pulumi.String(fmt.Sprintf("arn:%s:iam::aws:policy/%s", partition, policyName))
+}
+
+func NewEKSClusterRole(ctx *pulumi.Context, name string, args *EKSClusterRoleArgs, opts ...pulumi.ResourceOption) (*EKSClusterRole, error) {
+	if args == nil {
+		args = &EKSClusterRoleArgs{}
+	}
+
+	component := &EKSClusterRole{}
+	err := ctx.RegisterComponentResource(EKSClusterRoleIdentifier, name, component, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts, pulumi.Parent(component))
+
+	currentPartition, err := aws.GetPartition(ctx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := []string{"sts:AssumeRole"}
+	if args.EnableAutoMode {
+		actions = append(actions, "sts:TagSession")
+	}
+
+	assumeRolePolicy, err := iam.GetPolicyDocument(ctx, newIAMPolicyDocumentStatementConstructor("Allow", actions).
+		AddServicePrincipal([]string{"eks.amazonaws.com"}).
+		Build())
+	if err != nil {
+		return nil, err
+	}
+
+	args.Role.PolicyArns = append(args.Role.PolicyArns, awsManagedPolicyARN(currentPartition.Partition, "AmazonEKSClusterPolicy"))
+
+	if args.AttachVPCResourceControllerPolicy {
+		args.Role.PolicyArns = append(args.Role.PolicyArns, awsManagedPolicyARN(currentPartition.Partition, "AmazonEKSVPCResourceController"))
+	}
+
+	if args.EnableAutoMode {
+		for _, policyName := range []string{
+			"AmazonEKSComputePolicy",
+			"AmazonEKSBlockStoragePolicy",
+			"AmazonEKSLoadBalancingPolicy",
+			"AmazonEKSNetworkingPolicy",
+		} {
+			args.Role.PolicyArns = append(args.Role.PolicyArns, awsManagedPolicyARN(currentPartition.Partition, policyName))
+		}
+	}
+
+	role, err := utils.NewIAMRole(ctx, name, &utils.IAMRoleArgs{
+		Role:                args.Role,
+		AssumeRolePolicy:    pulumi.String(assumeRolePolicy.Json),
+		ForceDetachPolicies: args.ForceDetachPolicies,
+		MaxSessionDuration:  args.MaxSessionDuration,
+		Tags:                args.Tags,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	component.Arn = role.Arn
+	component.Name = role.Name
+	component.Path = role.Path
+	component.UniqueID = role.UniqueId
+
+	return component, nil
+}
@@ -0,0 +1,185 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws-iam/pkg/utils"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	EKSNodeRoleIdentifier = "aws-iam:index:EKSNodeRole"
+
+	// EKSNodeRoleTypeNode is the role of managed and self-managed node groups, with an instance profile.
+	EKSNodeRoleTypeNode = "node"
+
+	// EKSNodeRoleTypeKarpenter is the role of nodes launched by Karpenter, which creates the instance profiles itself.
+	EKSNodeRoleTypeKarpenter = "karpenter"
+
+	// EKSNodeRoleTypeFargate is the pod execution role of Fargate profiles.
+	EKSNodeRoleTypeFargate = "fargate"
+)
+
+type EKSNodeRoleArgs struct {
+	// A map of tags to add.
+	Tags pulumi.StringMapInput `pulumi:"tags"`
+
+	// IAM role.
+	Role utils.RoleArgs `pulumi:"role"`
+
+	// Maximum CLI/API session duration in seconds between 3600 and 43200.
+	MaxSessionDuration pulumi.IntInput `pulumi:"maxSessionDuration"`
+
+	// Whether policies should be detached from this role when destroying.
+	ForceDetachPolicies pulumi.BoolInput `pulumi:"forceDetachPolicies"`
+
+	// Type of the role, one of `node`, `karpenter` or `fargate`. Defaults to `node`.
+	Type string `pulumi:"type"`
+
+	// Whether to leave out the AmazonEKS_CNI_Policy, e.g. when the VPC CNI uses its own IRSA role.
+	ExcludeCNIPolicy bool `pulumi:"excludeCniPolicy"`
+
+	// Whether to attach the AmazonSSMManagedInstanceCore policy. Always attached for `karpenter` roles.
+	AttachSSMPolicy bool `pulumi:"attachSsmPolicy"`
+
+	// Name of the cluster whose Fargate profiles can assume a `fargate` role. Defaults to all clusters of the account.
+	ClusterName string `pulumi:"clusterName"`
+}
+
+type EKSNodeRole struct {
+	pulumi.ResourceState
+
+	// ARN of IAM role.
+	Arn pulumi.StringOutput `pulumi:"arn"`
+
+	// Name of IAM role.
+	Name pulumi.StringOutput `pulumi:"name"`
+
+	// Path of IAM role.
+	Path pulumi.StringPtrOutput `pulumi:"path"`
+
+	// Unique ID of IAM role.
+	UniqueID pulumi.StringOutput `pulumi:"uniqueId"`
+
+	// IAM instance profile, only created for `node` roles.
+	InstanceProfile AssumableRoleInstanceProfileOutput `pulumi:"instanceProfile"`
+}
+
+func NewEKSNodeRole(ctx *pulumi.Context, name string, args *EKSNodeRoleArgs, opts ...pulumi.ResourceOption) (*EKSNodeRole, error) {
+	if args == nil {
+		args = &EKSNodeRoleArgs{}
+	}
+
+	component := &EKSNodeRole{}
+	err := ctx.RegisterComponentResource(EKSNodeRoleIdentifier, name, component, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts, pulumi.Parent(component))
+
+	if args.Type == "" {
+		args.Type = EKSNodeRoleTypeNode
+	}
+
+	currentPartition, err := aws.GetPartition(ctx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	partition := currentPartition.Partition
+	var assumeRoleStatement *IAMPolicyDocumentStatementConstructor
+	switch args.Type {
+	case EKSNodeRoleTypeNode, EKSNodeRoleTypeKarpenter:
+		assumeRoleStatement = newIAMPolicyDocumentStatementConstructor("Allow", []string{"sts:AssumeRole"}).
+			AddServicePrincipal([]string{fmt.Sprintf("ec2.%s", currentPartition.DnsSuffix)})
+
+		args.Role.PolicyArns = append(args.Role.PolicyArns,
+			awsManagedPolicyARN(partition, "AmazonEKSWorkerNodePolicy"),
+			awsManagedPolicyARN(partition, "AmazonEC2ContainerRegistryReadOnly"),
+		)
+
+		if !args.ExcludeCNIPolicy {
+			args.Role.PolicyArns = append(args.Role.PolicyArns, awsManagedPolicyARN(partition, "AmazonEKS_CNI_Policy"))
+		}
+
+		if args.AttachSSMPolicy || args.Type == EKSNodeRoleTypeKarpenter {
+			args.Role.PolicyArns = append(args.Role.PolicyArns, awsManagedPolicyARN(partition, "AmazonSSMManagedInstanceCore"))
+		}
+	case EKSNodeRoleTypeFargate:
+		account, err := aws.GetCallerIdentity(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		clusterName := args.ClusterName
+		if clusterName == "" {
+			clusterName = "*"
+		}
+
+		assumeRoleStatement = newIAMPolicyDocumentStatementConstructor("Allow", []string{"sts:AssumeRole"}).
+			AddServicePrincipal([]string{"eks-fargate-pods.amazonaws.com"}).
+			AddCondition("ArnLike", "aws:SourceArn", []string{fmt.Sprintf("arn:%s:eks:*:%s:fargateprofile/%s/*", partition, account.AccountId, clusterName)})
+
+		args.Role.PolicyArns = append(args.Role.PolicyArns, awsManagedPolicyARN(partition, "AmazonEKSFargatePodExecutionRolePolicy"))
+	default:
+		return nil, fmt.Errorf("Unknown EKS node role type [%s] for resource with name [%s], expected one of %s, %s or %s.",
+			args.Type, name, EKSNodeRoleTypeNode, EKSNodeRoleTypeKarpenter, EKSNodeRoleTypeFargate)
+	}
+
+	assumeRolePolicy, err := iam.GetPolicyDocument(ctx, assumeRoleStatement.Build())
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := utils.NewIAMRole(ctx, name, &utils.IAMRoleArgs{
+		Role:                args.Role,
+		AssumeRolePolicy:    pulumi.String(assumeRolePolicy.Json),
+		ForceDetachPolicies: args.ForceDetachPolicies,
+		MaxSessionDuration:  args.MaxSessionDuration,
+		Tags:                args.Tags,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	component.Arn = role.Arn
+	component.Name = role.Name
+	component.Path = role.Path
+	component.UniqueID = role.UniqueId
+
+	if args.Type != EKSNodeRoleTypeNode {
+		component.InstanceProfile = newEmptyInstanceProfileOutput()
+		return component, nil
+	}
+
+	instanceProfile, err := utils.NewIAMInstanceProfile(ctx, name, &utils.IAMInstanceProfileArgs{
+		Name: args.Role.Name,
+		Path: args.Role.Path,
+		Role: role.Name,
+		Tags: args.Tags,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	component.InstanceProfile = newInstanceProfileOutput(instanceProfile)
+
+	return component, nil
+}
@@ -46,10 +46,17 @@ type EKSRoleArgs struct {
 	ForceDetachPolicies pulumi.BoolInput `pulumi:"forceDetachPolicies"`
 
 	// EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount. See README for details.
+	// ServiceAccounts are `namespace:name` and may use wildcards, e.g. `team-a:*`, which are matched with StringLike.
 	ClusterServiceAccounts []EKSClusterServiceAccount `pulumi:"clusterServiceAccounts"`
 
+	// Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+	AllowAllNamespaces bool `pulumi:"allowAllNamespaces"`
+
 	// ARNs of any policies to attach to the IAM role
 	RolePolicyARNs []pulumi.StringInput `pulumi:"rolePolicyArns"`
+
+	// Annotations added to the rendered ServiceAccount manifests.
+	ServiceAccountManifest EKSServiceAccountManifestArgs `pulumi:"serviceAccountManifest"`
 }
 
 type EKSRole struct {
@@ -66,6 +73,9 @@ type EKSRole struct {
 
 	// Unique ID of IAM role.
 	UniqueID pulumi.StringOutput `pulumi:"uniqueId"`
+
+	// Annotations and ServiceAccount manifests binding each ServiceAccount to the role.
+	ServiceAccounts pulumi.ArrayOutput `pulumi:"serviceAccounts"`
 }
 
 func NewEKSRole(ctx *pulumi.Context, name string, args *EKSRoleArgs, opts ...pulumi.ResourceOption) (*EKSRole, error) {
@@ -91,7 +101,7 @@ func NewEKSRole(ctx *pulumi.Context, name string, args *EKSRoleArgs, opts ...pul
 		return nil, err
 	}
 
-	var policyDocStatements iam.GetPolicyDocumentStatementArray
+	var clusterStatements []interface{}
 	for _, sAccount := range args.ClusterServiceAccounts {
 		cluster := eks.LookupClusterOutput(ctx, eks.LookupClusterOutputArgs{
 			Name: sAccount.Name,
@@ -101,60 +111,81 @@ func NewEKSRole(ctx *pulumi.Context, name string, args *EKSRoleArgs, opts ...pul
 			return strings.ReplaceAll(identities[0].Oidcs[0].Issuer, "https://", "")
 		}).(pulumi.StringOutput)
 
-		serviceAccounts := sAccount.ServiceAccounts.ToStringArrayOutput().ApplyT(func(accts []string) []string {
-			var result []string
-			for _, acct := range accts {
-				result = append(result, fmt.Sprintf("system:serviceaccount:%s", acct))
+		serviceAccounts := sAccount.ServiceAccounts
+		if serviceAccounts == nil {
+			serviceAccounts = pulumi.ToStringArray(nil)
+		}
+
+		statements := pulumi.All(issuer, serviceAccounts.ToStringArrayOutput()).ApplyT(func(x []interface{}) ([]iam.GetPolicyDocumentStatement, error) {
+			issuer := x[0].(string)
+			principalIdentifier := fmt.Sprintf("arn:%s:iam::%s:oidc-provider/%s", currentPartition.Partition, account.Id, issuer)
+
+			statements, err := newEKSServiceAccountStatements(principalIdentifier, issuer, x[1].([]string), eksServiceAccountTrustOptions{
+				AllowAllNamespaces: args.AllowAllNamespaces,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("resource with name [%s]: %w", name, err)
 			}
-			return result
-		}).(pulumi.StringArrayInput)
-
-		principalIdentifier := pulumi.Sprintf("arn:%s:iam::%s:oidc-provider/%s", currentPartition.Partition, account.Id, issuer)
-
-		policyDocStatements = append(policyDocStatements, iam.GetPolicyDocumentStatementArgs{
-			Effect:  pulumi.StringPtr("Allow"),
-			Actions: pulumi.ToStringArray([]string{"sts:AssumeRoleWithWebIdentity"}),
-			Principals: iam.GetPolicyDocumentStatementPrincipalArray{
-				iam.GetPolicyDocumentStatementPrincipalArgs{
-					Type:        pulumi.String("Federated"),
-					Identifiers: pulumi.ToStringArrayOutput([]pulumi.StringOutput{principalIdentifier}),
-				},
-			},
-			Conditions: iam.GetPolicyDocumentStatementConditionArray{
-				iam.GetPolicyDocumentStatementConditionArgs{
-					Test:     pulumi.String("StringEquals"),
-					Variable: pulumi.Sprintf("%s:sub", issuer),
-					Values:   serviceAccounts,
-				},
-			},
-		})
+
+			return statements, nil
+		}).(iam.GetPolicyDocumentStatementArrayOutput)
+
+		clusterStatements = append(clusterStatements, statements)
 	}
 
-	assumeRoleWithOIDC := iam.GetPolicyDocumentOutput(ctx, iam.GetPolicyDocumentOutputArgs{
-		Statements: policyDocStatements,
-	})
+	assumeRoleWithOIDC := pulumi.All(clusterStatements...).ApplyT(func(x []interface{}) (string, error) {
+		var statements []iam.GetPolicyDocumentStatement
+		for _, v := range x {
+			statements = append(statements, v.([]iam.GetPolicyDocumentStatement)...)
+		}
 
-	assumeRoleWithOIDC.Json().ApplyT(func(data string) error {
-		fmt.Println(data)
-		return nil
-	})
+		policyDoc, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
+			Statements: statements,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return policyDoc.Json, nil
+	}).(pulumi.StringOutput)
 
 	role, err := utils.NewIAMRole(ctx, name, &utils.IAMRoleArgs{
 		Role:                args.Role,
-		AssumeRolePolicy:    assumeRoleWithOIDC.Json(),
+		AssumeRolePolicy:    assumeRoleWithOIDC,
 		ForceDetachPolicies: args.ForceDetachPolicies,
 		MaxSessionDuration:  args.MaxSessionDuration,
 		Tags:                args.Tags,
+		AttachedPolicyArns:  args.RolePolicyARNs,
 	}, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, arn := range args.RolePolicyARNs {
-		_, err := iam.NewRolePolicyAttachment(ctx, fmt.Sprintf("%s-custom", name), &iam.RolePolicyAttachmentArgs{
+	// Exclusive policy attachments of the custom policies are set on the role itself.
+	customPolicyARNs := args.RolePolicyARNs
+	if args.Role.ExclusivePolicyAttachments {
+		customPolicyARNs = nil
+	}
+
+	customAttachments := map[string]bool{}
+	for i, arn := range customPolicyARNs {
+		key := utils.PolicyAttachmentKey(arn, i)
+		if customAttachments[key] {
+			continue
+		}
+		customAttachments[key] = true
+
+		// A single custom attachment used to be named after the component, the alias keeps it on migration.
+		attachmentName := fmt.Sprintf("%s-custom-%s", name, key)
+		attachmentOpts := opts
+		if i == 0 {
+			attachmentOpts = utils.WithAliases(opts, attachmentName, fmt.Sprintf("%s-custom", name))
+		}
+
+		_, err := iam.NewRolePolicyAttachment(ctx, attachmentName, &iam.RolePolicyAttachmentArgs{
 			Role:      role.Name,
 			PolicyArn: arn,
-		}, opts...)
+		}, attachmentOpts...)
 		if err != nil {
 			return nil, err
 		}
@@ -165,11 +196,18 @@ func NewEKSRole(ctx *pulumi.Context, name string, args *EKSRoleArgs, opts ...pul
 	component.UniqueID = role.UniqueId
 	component.Path = role.Path
 
+	var serviceAccounts []pulumi.StringArrayInput
+	for _, sAccount := range args.ClusterServiceAccounts {
+		serviceAccounts = append(serviceAccounts, sAccount.ServiceAccounts)
+	}
+	component.ServiceAccounts = newEKSServiceAccountManifests(role.Arn, serviceAccounts, args.ServiceAccountManifest)
+
 	if err := ctx.RegisterResourceOutputs(component, pulumi.Map{
-		"arn":      role.Arn,
-		"name":     role.Name,
-		"uniqueId": role.UniqueId,
-		"path":     role.Path,
+		"arn":             role.Arn,
+		"name":            role.Name,
+		"uniqueId":        role.UniqueId,
+		"path":            role.Path,
+		"serviceAccounts": component.ServiceAccounts,
 	}); err != nil {
 		return nil, err
 	}
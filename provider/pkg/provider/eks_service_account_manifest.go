@@ -0,0 +1,130 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	eksRoleARNAnnotation              = "eks.amazonaws.com/role-arn"
+	eksSTSRegionalEndpointsAnnotation = "eks.amazonaws.com/sts-regional-endpoints"
+	eksTokenExpirationAnnotation      = "eks.amazonaws.com/token-expiration"
+)
+
+type EKSServiceAccountManifestArgs struct {
+	// Whether to annotate the ServiceAccounts to use the regional STS endpoint.
+	STSRegionalEndpoints bool `pulumi:"stsRegionalEndpoints"`
+
+	// Expiration of the projected ServiceAccount token in seconds. The annotation is left out when 0.
+	TokenExpiration int `pulumi:"tokenExpiration"`
+}
+
+// renderEKSServiceAccountManifests returns, for each distinct `namespace:name` entry, the annotations
+// binding the ServiceAccount to the role and a ServiceAccount manifest as YAML and JSON. Entries with
+// wildcards do not name a single ServiceAccount and are skipped.
+func renderEKSServiceAccountManifests(roleARN string, namespaceServiceAccounts []string, args EKSServiceAccountManifestArgs) ([]interface{}, error) {
+	annotations := map[string]interface{}{
+		eksRoleARNAnnotation: roleARN,
+	}
+
+	if args.STSRegionalEndpoints {
+		annotations[eksSTSRegionalEndpointsAnnotation] = "true"
+	}
+
+	if args.TokenExpiration != 0 {
+		annotations[eksTokenExpirationAnnotation] = strconv.Itoa(args.TokenExpiration)
+	}
+
+	seen := map[string]bool{}
+	var keys []string
+	for _, entry := range namespaceServiceAccounts {
+		if strings.ContainsAny(entry, "*?") || seen[entry] {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("ServiceAccount [%s] is not in the form namespace:name", entry)
+		}
+
+		seen[entry] = true
+		keys = append(keys, entry)
+	}
+	sort.Strings(keys)
+
+	var result []interface{}
+	for _, entry := range keys {
+		parts := strings.SplitN(entry, ":", 2)
+		namespace, name := parts[0], parts[1]
+
+		manifest := map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"name":        name,
+				"namespace":   namespace,
+				"annotations": annotations,
+			},
+		}
+
+		manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+
+		manifestYAML, err := yaml.JSONToYAML(manifestJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, map[string]interface{}{
+			"namespace":   namespace,
+			"name":        name,
+			"annotations": annotations,
+			"yaml":        string(manifestYAML),
+			"json":        string(manifestJSON),
+		})
+	}
+
+	return result, nil
+}
+
+// newEKSServiceAccountManifests renders the ServiceAccount annotations and manifests of an IRSA role
+// once its ARN and all of its `namespace:name` entries are known.
+func newEKSServiceAccountManifests(roleARN pulumi.StringOutput, namespaceServiceAccounts []pulumi.StringArrayInput, args EKSServiceAccountManifestArgs) pulumi.ArrayOutput {
+	inputs := []interface{}{roleARN}
+	for _, serviceAccounts := range namespaceServiceAccounts {
+		if serviceAccounts != nil {
+			inputs = append(inputs, serviceAccounts.ToStringArrayOutput())
+		}
+	}
+
+	return pulumi.All(inputs...).ApplyT(func(x []interface{}) ([]interface{}, error) {
+		var entries []string
+		for _, v := range x[1:] {
+			entries = append(entries, v.([]string)...)
+		}
+
+		return renderEKSServiceAccountManifests(x[0].(string), entries, args)
+	}).(pulumi.ArrayOutput)
+}
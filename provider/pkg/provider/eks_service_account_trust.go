@@ -0,0 +1,122 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+)
+
+type eksServiceAccountTrustOptions struct {
+	// Condition operator for exact ServiceAccounts, defaults to StringEquals. With StringLike all entries
+	// are matched in a single statement.
+	ConditionTest string
+
+	// Audiences required in the web identity token. Left unchecked when empty.
+	Audiences []string
+
+	// Whether entries may use wildcards in the namespace, matching the ServiceAccounts of several namespaces.
+	AllowAllNamespaces bool
+}
+
+// splitEKSServiceAccounts splits `namespace:name` entries into the exact ServiceAccounts and the patterns using
+// wildcards, e.g. `team-a:*` or `team-a:app-*`. Patterns with wildcards in the namespace, e.g. `*:app` or
+// `team-?:app`, are rejected unless allowed since any namespace matching them could claim the role.
+func splitEKSServiceAccounts(entries []string, allowAllNamespaces bool) ([]string, []string, error) {
+	var exact, patterns []string
+	for _, entry := range entries {
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, nil, fmt.Errorf("ServiceAccount [%s] is not in the form namespace:name", entry)
+		}
+
+		if strings.ContainsAny(parts[0], "*?") && !allowAllNamespaces {
+			return nil, nil, fmt.Errorf("ServiceAccount [%s] uses a wildcard in the namespace, set allowAllNamespaces to allow it", entry)
+		}
+
+		subject := fmt.Sprintf("system:serviceaccount:%s", entry)
+		if strings.ContainsAny(entry, "*?") {
+			patterns = append(patterns, subject)
+		} else {
+			exact = append(exact, subject)
+		}
+	}
+
+	return exact, patterns, nil
+}
+
+// newEKSServiceAccountStatements returns the statements letting the ServiceAccounts of an OIDC provider assume
+// a role. Exact ServiceAccounts are matched with StringEquals and patterns with StringLike, each in its own
+// statement since all conditions of a statement must match.
+func newEKSServiceAccountStatements(providerARN, issuer string, namespaceServiceAccounts []string, opts eksServiceAccountTrustOptions) ([]iam.GetPolicyDocumentStatement, error) {
+	exact, patterns, err := splitEKSServiceAccounts(namespaceServiceAccounts, opts.AllowAllNamespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	conditionTest := opts.ConditionTest
+	if conditionTest == "" {
+		conditionTest = "StringEquals"
+	}
+
+	if conditionTest == "StringLike" {
+		exact = append(exact, patterns...)
+		patterns = nil
+	}
+
+	newStatement := func(test string, subjects []string) iam.GetPolicyDocumentStatement {
+		effect := "Allow"
+		conditions := []iam.GetPolicyDocumentStatementCondition{
+			{
+				Test:     test,
+				Variable: fmt.Sprintf("%s:sub", issuer),
+				Values:   subjects,
+			},
+		}
+
+		if len(opts.Audiences) > 0 {
+			conditions = append(conditions, iam.GetPolicyDocumentStatementCondition{
+				Test:     conditionTest,
+				Variable: fmt.Sprintf("%s:aud", issuer),
+				Values:   opts.Audiences,
+			})
+		}
+
+		return iam.GetPolicyDocumentStatement{
+			Effect:  &effect,
+			Actions: []string{"sts:AssumeRoleWithWebIdentity"},
+			Principals: []iam.GetPolicyDocumentStatementPrincipal{
+				{
+					Type:        "Federated",
+					Identifiers: []string{providerARN},
+				},
+			},
+			Conditions: conditions,
+		}
+	}
+
+	var statements []iam.GetPolicyDocumentStatement
+	if len(exact) > 0 || len(patterns) == 0 {
+		statements = append(statements, newStatement(conditionTest, exact))
+	}
+
+	if len(patterns) > 0 {
+		statements = append(statements, newStatement("StringLike", patterns))
+	}
+
+	return statements, nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitEKSServiceAccounts(t *testing.T) {
+	tests := []struct {
+		name               string
+		entries            []string
+		allowAllNamespaces bool
+		exact              []string
+		patterns           []string
+		err                string
+	}{
+		{
+			name:    "exact",
+			entries: []string{"kube-system:aws-node", "karpenter:karpenter"},
+			exact:   []string{"system:serviceaccount:kube-system:aws-node", "system:serviceaccount:karpenter:karpenter"},
+		},
+		{
+			name:     "mixed",
+			entries:  []string{"kube-system:aws-node", "team-a:*", "team-b:app-?"},
+			exact:    []string{"system:serviceaccount:kube-system:aws-node"},
+			patterns: []string{"system:serviceaccount:team-a:*", "system:serviceaccount:team-b:app-?"},
+		},
+		{
+			name:               "allowed namespace wildcards",
+			entries:            []string{"*:my-app", "team-*:my-app"},
+			allowAllNamespaces: true,
+			patterns:           []string{"system:serviceaccount:*:my-app", "system:serviceaccount:team-*:my-app"},
+		},
+		{
+			name:    "all namespaces",
+			entries: []string{"*:my-app"},
+			err:     "ServiceAccount [*:my-app] uses a wildcard in the namespace, set allowAllNamespaces to allow it",
+		},
+		{
+			name:    "namespace prefix",
+			entries: []string{"kube-system:aws-node", "team-*:my-app"},
+			err:     "ServiceAccount [team-*:my-app] uses a wildcard in the namespace, set allowAllNamespaces to allow it",
+		},
+		{
+			name:    "namespace single character wildcard",
+			entries: []string{"team-?:my-app"},
+			err:     "ServiceAccount [team-?:my-app] uses a wildcard in the namespace, set allowAllNamespaces to allow it",
+		},
+		{
+			name:    "missing name",
+			entries: []string{"kube-system"},
+			err:     "ServiceAccount [kube-system] is not in the form namespace:name",
+		},
+		{
+			name:    "empty namespace",
+			entries: []string{":aws-node"},
+			err:     "ServiceAccount [:aws-node] is not in the form namespace:name",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			exact, patterns, err := splitEKSServiceAccounts(tt.entries, tt.allowAllNamespaces)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.exact, exact)
+			assert.Equal(t, tt.patterns, patterns)
+		})
+	}
+}
+
+func TestEKSServiceAccountStatements(t *testing.T) {
+	const (
+		providerARN = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE"
+		issuer      = "oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE"
+	)
+
+	tests := []struct {
+		name     string
+		entries  []string
+		opts     eksServiceAccountTrustOptions
+		expected string
+	}{
+		{
+			name:    "exact",
+			entries: []string{"kube-system:aws-node"},
+			opts:    eksServiceAccountTrustOptions{Audiences: []string{"sts.amazonaws.com"}},
+			expected: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Federated": "` + providerARN + `"},
+      "Action": "sts:AssumeRoleWithWebIdentity",
+      "Condition": {
+        "StringEquals": {
+          "oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE:sub": "system:serviceaccount:kube-system:aws-node",
+          "oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE:aud": "sts.amazonaws.com"
+        }
+      }
+    }
+  ]
+}`,
+		},
+		{
+			name:    "mixed",
+			entries: []string{"kube-system:aws-node", "team-a:*"},
+			expected: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Federated": "` + providerARN + `"},
+      "Action": "sts:AssumeRoleWithWebIdentity",
+      "Condition": {
+        "StringEquals": {"oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE:sub": "system:serviceaccount:kube-system:aws-node"}
+      }
+    },
+    {
+      "Effect": "Allow",
+      "Principal": {"Federated": "` + providerARN + `"},
+      "Action": "sts:AssumeRoleWithWebIdentity",
+      "Condition": {
+        "StringLike": {"oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE:sub": "system:serviceaccount:team-a:*"}
+      }
+    }
+  ]
+}`,
+		},
+		{
+			name:    "StringLike",
+			entries: []string{"kube-system:aws-node", "team-a:*"},
+			opts:    eksServiceAccountTrustOptions{ConditionTest: "StringLike"},
+			expected: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Federated": "` + providerARN + `"},
+      "Action": "sts:AssumeRoleWithWebIdentity",
+      "Condition": {
+        "StringLike": {
+          "oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE:sub": ["system:serviceaccount:kube-system:aws-node", "system:serviceaccount:team-a:*"]
+        }
+      }
+    }
+  ]
+}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			statements, err := newEKSServiceAccountStatements(providerARN, issuer, tt.entries, tt.opts)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, renderPolicyDocument(t, &iam.GetPolicyDocumentArgs{Statements: statements}))
+		})
+	}
+
+	_, err := newEKSServiceAccountStatements(providerARN, issuer, []string{"team-*:my-app"}, eksServiceAccountTrustOptions{})
+	assert.EqualError(t, err, "ServiceAccount [team-*:my-app] uses a wildcard in the namespace, set allowAllNamespaces to allow it")
+}
@@ -48,6 +48,10 @@ type GroupWithPoliciesArgs struct {
 
 	// A map of tags to add to all resources.
 	Tags map[string]string `pulumi:"tags"`
+
+	// Whether managed policies not declared for the group are detached from it. Not supported for groups
+	// by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
+	ExclusivePolicyAttachments bool `pulumi:"exclusivePolicyAttachments"`
 }
 
 type GroupWithPolicies struct {
@@ -79,6 +83,10 @@ func NewGroupWithPolicies(ctx *pulumi.Context, name string, args *GroupWithPolic
 
 	opts = append(opts, pulumi.Parent(component))
 
+	if args.ExclusivePolicyAttachments {
+		return nil, fmt.Errorf("Exclusive policy attachments are not supported for groups by the AWS provider v5 for resource with name [%s].", name)
+	}
+
 	awsAccountID := args.AWSAccountID
 	if awsAccountID == "" {
 		account, err := aws.GetCallerIdentity(ctx)
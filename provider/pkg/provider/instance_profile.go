@@ -0,0 +1,124 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi-aws-iam/pkg/utils"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const InstanceProfileIdentifier = "aws-iam:index:InstanceProfile"
+
+// InstanceProfileSettingsArgs are the settings of an IAM instance profile created along with a role.
+type InstanceProfileSettingsArgs struct {
+	// Name of IAM instance profile. Defaults to the name of the role.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+
+	// Path of IAM instance profile. Defaults to the path of the role.
+	Path pulumi.StringInput `pulumi:"path"`
+
+	// A map of tags to add to the instance profile. Defaults to the tags of the role.
+	Tags pulumi.StringMapInput `pulumi:"tags"`
+}
+
+type InstanceProfileArgs struct {
+	// Name or ARN of the IAM role to add to the instance profile.
+	Role pulumi.StringInput `pulumi:"role"`
+
+	// Name of IAM instance profile.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+
+	// Path of IAM instance profile.
+	Path pulumi.StringInput `pulumi:"path"`
+
+	// A map of tags to add.
+	Tags pulumi.StringMapInput `pulumi:"tags"`
+}
+
+type InstanceProfile struct {
+	pulumi.ResourceState
+
+	// IAM instance profile.
+	InstanceProfile AssumableRoleInstanceProfileOutput `pulumi:"instanceProfile"`
+
+	// Name of the IAM role in the instance profile.
+	RoleName pulumi.StringOutput `pulumi:"roleName"`
+}
+
+// roleNameFromARN returns the name of a role given its name or ARN, dropping the role path.
+func roleNameFromARN(role string) string {
+	if !strings.HasPrefix(role, "arn:") {
+		return role
+	}
+
+	return role[strings.LastIndex(role, "/")+1:]
+}
+
+func newInstanceProfileOutput(instanceProfile *iam.InstanceProfile) AssumableRoleInstanceProfileOutput {
+	return AssumableRoleInstanceProfileOutput{
+		Arn:  instanceProfile.Arn,
+		ID:   instanceProfile.UniqueId,
+		Name: instanceProfile.Name,
+		Path: instanceProfile.Path,
+	}
+}
+
+func newEmptyInstanceProfileOutput() AssumableRoleInstanceProfileOutput {
+	return AssumableRoleInstanceProfileOutput{
+		Arn:  pulumi.String("").ToStringOutput(),
+		ID:   pulumi.String("").ToStringOutput(),
+		Name: pulumi.String("").ToStringOutput(),
+		Path: pulumi.StringPtr("").ToStringPtrOutput(),
+	}
+}
+
+func NewInstanceProfile(ctx *pulumi.Context, name string, args *InstanceProfileArgs, opts ...pulumi.ResourceOption) (*InstanceProfile, error) {
+	if args == nil {
+		args = &InstanceProfileArgs{}
+	}
+
+	component := &InstanceProfile{}
+	err := ctx.RegisterComponentResource(InstanceProfileIdentifier, name, component, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts, pulumi.Parent(component))
+
+	if args.Role == nil {
+		return nil, fmt.Errorf("Role is required for resource with name [%s].", name)
+	}
+
+	roleName := args.Role.ToStringOutput().ApplyT(roleNameFromARN).(pulumi.StringOutput)
+
+	instanceProfile, err := utils.NewIAMInstanceProfile(ctx, name, &utils.IAMInstanceProfileArgs{
+		Name: args.Name,
+		Path: args.Path,
+		Role: roleName,
+		Tags: args.Tags,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	component.InstanceProfile = newInstanceProfileOutput(instanceProfile)
+	component.RoleName = roleName
+
+	return component, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/stretchr/testify/require"
+)
+
+type testPolicyDocumentStatement struct {
+	Sid       string                            `json:"Sid,omitempty"`
+	Effect    string                            `json:"Effect"`
+	Principal map[string]interface{}            `json:"Principal,omitempty"`
+	Action    interface{}                       `json:"Action"`
+	Resource  interface{}                       `json:"Resource,omitempty"`
+	Condition map[string]map[string]interface{} `json:"Condition,omitempty"`
+}
+
+// renderPolicyDocument renders the policy document the way iam.GetPolicyDocument does, lists with a single
+// value are collapsed to the value.
+func renderPolicyDocument(t *testing.T, args *iam.GetPolicyDocumentArgs) string {
+	collapse := func(values []string) interface{} {
+		switch len(values) {
+		case 0:
+			return nil
+		case 1:
+			return values[0]
+		default:
+			return values
+		}
+	}
+
+	rendered := make([]testPolicyDocumentStatement, 0, len(args.Statements))
+	for _, statement := range args.Statements {
+		s := testPolicyDocumentStatement{
+			Effect:   "Allow",
+			Action:   collapse(statement.Actions),
+			Resource: collapse(statement.Resources),
+		}
+		if statement.Sid != nil {
+			s.Sid = *statement.Sid
+		}
+		if statement.Effect != nil {
+			s.Effect = *statement.Effect
+		}
+
+		for _, principal := range statement.Principals {
+			if len(principal.Identifiers) == 0 {
+				continue
+			}
+			if s.Principal == nil {
+				s.Principal = map[string]interface{}{}
+			}
+			s.Principal[principal.Type] = collapse(principal.Identifiers)
+		}
+
+		for _, condition := range statement.Conditions {
+			if s.Condition == nil {
+				s.Condition = map[string]map[string]interface{}{}
+			}
+			if s.Condition[condition.Test] == nil {
+				s.Condition[condition.Test] = map[string]interface{}{}
+			}
+			_, duplicate := s.Condition[condition.Test][condition.Variable]
+			require.False(t, duplicate, "statement %d repeats the condition %s %s", len(rendered), condition.Test, condition.Variable)
+			s.Condition[condition.Test][condition.Variable] = collapse(condition.Values)
+		}
+
+		rendered = append(rendered, s)
+	}
+
+	document, err := json.MarshalIndent(struct {
+		Version   string
+		Statement []testPolicyDocumentStatement
+	}{"2012-10-17", rendered}, "", "  ")
+	require.NoError(t, err)
+
+	return string(document)
+}
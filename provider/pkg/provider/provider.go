@@ -22,6 +22,7 @@ import (
 )
 
 var resourceConstructorMap = map[string]ResourceConstructor{
+	AbacPolicyIdentifier:                    createNewResourceConstructor(NewAbacPolicy),
 	AccountIdentifier:                       createNewResourceConstructor(NewIAMAccount),
 	PolicyIdentifier:                        createNewResourceConstructor(NewPolicy),
 	AssumableRoleWithOIDCIdentifier:         createNewResourceConstructor(NewIAMAssumableRoleWithOIDC),
@@ -29,11 +30,18 @@ var resourceConstructorMap = map[string]ResourceConstructor{
 	AssumableRoleIdentifier:                 createNewResourceConstructor(NewAssumableRole),
 	AssumableRolesWithSAMLIdentifier:        createNewResourceConstructor(NewAssumableRolesWithSAML),
 	AssumableRolesIdentifier:                createNewResourceConstructor(NewAssumableRoles),
+	AWSConfigProfilesIdentifier:             createNewResourceConstructor(NewAWSConfigProfiles),
+	BreakGlassRoleIdentifier:                createNewResourceConstructor(NewBreakGlassRole),
+	EKSAddonPolicyIdentifier:                createNewResourceConstructor(NewEKSAddonPolicy),
+	EKSClusterRoleIdentifier:                createNewResourceConstructor(NewEKSClusterRole),
+	EKSNodeRoleIdentifier:                   createNewResourceConstructor(NewEKSNodeRole),
 	EKSRoleIdentifier:                       createNewResourceConstructor(NewEKSRole),
 	GroupWithAssumableRolesPolicyIdentifier: createNewResourceConstructor(NewGroupWithAssumableRolesPolicy),
 	GroupWithPoliciesIdentifier:             createNewResourceConstructor(NewGroupWithPolicies),
+	InstanceProfileIdentifier:               createNewResourceConstructor(NewInstanceProfile),
 	ReadOnlyPolicyIdentifier:                createNewResourceConstructor(NewReadOnlyPolicy),
 	RoleForServiceAccountsEksIdentifier:     createNewResourceConstructor(NewRoleForServiceAccountsEks),
+	SAMLProviderIdentifier:                  createNewResourceConstructor(NewSAMLProvider),
 	UserIdentifier:                          createNewResourceConstructor(NewUser),
 }
 
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/pulumi/pulumi-aws-iam/pkg/action_catalog"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
@@ -56,6 +57,13 @@ type ReadOnlyPolicyArgs struct {
 
 	// List of web console services to allow.
 	WebConsoleServices []string `pulumi:"webConsoleServices"`
+
+	// Allow only List, Describe and metadata Read actions instead of the List*, Get*, Describe* and View*
+	// wildcards, leaving out reads of data and secrets such as s3:GetObject or secretsmanager:GetSecretValue.
+	// Since the embedded action catalog is a curated subset, services it does not list completely keep
+	// List* and Describe* next to their other cataloged metadata actions, and services missing from it fall
+	// back to List* and Describe*.
+	MetadataOnly bool `pulumi:"metadataOnly"`
 }
 
 type ReadOnlyPolicy struct {
@@ -82,6 +90,31 @@ type ReadOnlyPolicy struct {
 
 	// The policy document.
 	Policy pulumi.StringOutput `pulumi:"policy"`
+
+	// Version of the action catalog used to expand actions when `metadataOnly` is set.
+	CatalogVersion pulumi.StringOutput `pulumi:"catalogVersion"`
+}
+
+// readOnlyServiceActions returns the actions to allow for a service. The returned bool is
+// false when metadata only actions were requested but the service is not in the catalog.
+func readOnlyServiceActions(catalog *action_catalog.Catalog, service string, metadataOnly bool) ([]string, bool) {
+	if !metadataOnly {
+		return []string{
+			fmt.Sprintf("%s:List*", service),
+			fmt.Sprintf("%s:Get*", service),
+			fmt.Sprintf("%s:Describe*", service),
+			fmt.Sprintf("%s:View*", service),
+		}, true
+	}
+
+	actions, err := catalog.MetadataActions(service)
+	if err != nil {
+		return []string{
+			fmt.Sprintf("%s:List*", service),
+			fmt.Sprintf("%s:Describe*", service),
+		}, false
+	}
+	return actions, true
 }
 
 func NewReadOnlyPolicy(ctx *pulumi.Context, name string, args *ReadOnlyPolicyArgs, opts ...pulumi.ResourceOption) (*ReadOnlyPolicy, error) {
@@ -97,38 +130,41 @@ func NewReadOnlyPolicy(ctx *pulumi.Context, name string, args *ReadOnlyPolicyArg
 
 	opts = append(opts, pulumi.Parent(component))
 
+	catalog, err := action_catalog.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	services := args.AllowedServices
+	if args.AllowWebConsoleServices {
+		if len(args.WebConsoleServices) == 0 {
+			args.WebConsoleServices = append(args.WebConsoleServices, "resource-groups", "tag", "health", "ce")
+		}
+
+		services = append(services, args.WebConsoleServices...)
+	}
+
 	var policyDocStatements []iam.GetPolicyDocumentStatement
-	for _, service := range args.AllowedServices {
+	var uncatalogedServices []string
+	for _, service := range services {
+		actions, ok := readOnlyServiceActions(catalog, service, args.MetadataOnly)
+		if !ok {
+			uncatalogedServices = append(uncatalogedServices, service)
+		}
+
 		sid := strings.ReplaceAll(service, "-", "")
 		policyDocStatements = append(policyDocStatements, iam.GetPolicyDocumentStatement{
 			Sid:       &sid,
 			Resources: []string{"*"},
-			Actions: []string{
-				fmt.Sprintf("%s:List*", service),
-				fmt.Sprintf("%s:Get*", service),
-				fmt.Sprintf("%s:Describe*", service),
-				fmt.Sprintf("%s:View*", service),
-			},
+			Actions:   actions,
 		})
 	}
 
-	if args.AllowWebConsoleServices {
-		if len(args.WebConsoleServices) == 0 {
-			args.WebConsoleServices = append(args.WebConsoleServices, "resource-groups", "tag", "health", "ce")
-		}
-
-		for _, service := range args.WebConsoleServices {
-			sid := strings.ReplaceAll(service, "-", "")
-			policyDocStatements = append(policyDocStatements, iam.GetPolicyDocumentStatement{
-				Sid:       &sid,
-				Resources: []string{"*"},
-				Actions: []string{
-					fmt.Sprintf("%s:List*", service),
-					fmt.Sprintf("%s:Get*", service),
-					fmt.Sprintf("%s:Describe*", service),
-					fmt.Sprintf("%s:View*", service),
-				},
-			})
+	if len(uncatalogedServices) > 0 {
+		msg := fmt.Sprintf("Services %v are not in the action catalog (version %s), allowing List* and Describe* for them instead of concrete actions.",
+			uncatalogedServices, catalog.Version)
+		if err := ctx.Log.Warn(msg, &pulumi.LogArgs{Resource: component}); err != nil {
+			return nil, err
 		}
 	}
 
@@ -172,6 +208,7 @@ func NewReadOnlyPolicy(ctx *pulumi.Context, name string, args *ReadOnlyPolicyArg
 	component.Description = policy.Description
 	component.Path = policy.Path
 	component.Policy = policy.Policy
+	component.CatalogVersion = pulumi.String(catalog.Version).ToStringOutput()
 
 	return component, nil
 }
@@ -16,22 +16,81 @@ package provider
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/pulumi/pulumi-aws-iam/pkg/eks_policies"
 	"github.com/pulumi/pulumi-aws-iam/pkg/utils"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/eks"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
-const RoleForServiceAccountsEksIdentifier = "aws-iam:index:RoleForServiceAccountsEks"
+const (
+	RoleForServiceAccountsEksIdentifier = "aws-iam:index:RoleForServiceAccountsEks"
+
+	// eksWebIdentityTokenFile is where EKS mounts the web identity token of IRSA ServiceAccounts.
+	eksWebIdentityTokenFile = "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"
+)
 
 type OIDCServiceProviderEKS struct {
-	ProviderARN              pulumi.StringInput      `pulumi:"providerArn"`
+	// ARN of the IAM OIDC provider.
+	ProviderARN pulumi.StringInput `pulumi:"providerArn"`
+
+	// Name of an EKS cluster in the current region whose OIDC issuer is looked up. Used when `providerArn` is not set.
+	ClusterName pulumi.StringInput `pulumi:"clusterName"`
+
+	// URL of the OIDC issuer. Used when neither `providerArn` nor `clusterName` is set.
+	IssuerURL pulumi.StringInput `pulumi:"issuerUrl"`
+
+	// The AWS account ID where the IAM OIDC provider of `clusterName` or `issuerUrl` lives, defaults to the current account.
+	AWSAccountID string `pulumi:"awsAccountId"`
+
 	NamespaceServiceAccounts pulumi.StringArrayInput `pulumi:"namespaceServiceAccounts"`
 }
 
+// oidcProviderIssuer returns the issuer host of an IAM OIDC provider ARN, the prefix of its condition keys.
+func oidcProviderIssuer(providerARN string) string {
+	if i := strings.Index(providerARN, ":oidc-provider/"); i >= 0 {
+		return providerARN[i+len(":oidc-provider/"):]
+	}
+	return strings.TrimPrefix(providerARN, "https://")
+}
+
+// resolve returns the IAM OIDC provider ARN of the provider, given directly or derived from its cluster or issuer URL.
+func (p OIDCServiceProviderEKS) resolve(ctx *pulumi.Context, partition, accountID string) (pulumi.StringOutput, error) {
+	if p.AWSAccountID != "" {
+		accountID = p.AWSAccountID
+	}
+
+	switch {
+	case p.ProviderARN != nil:
+		return p.ProviderARN.ToStringOutput(), nil
+	case p.ClusterName != nil:
+		cluster := eks.LookupClusterOutput(ctx, eks.LookupClusterOutputArgs{
+			Name: p.ClusterName,
+		})
+
+		return cluster.ApplyT(func(cluster eks.LookupClusterResult) (string, error) {
+			if len(cluster.Identities) == 0 || len(cluster.Identities[0].Oidcs) == 0 || cluster.Identities[0].Oidcs[0].Issuer == "" {
+				return "", fmt.Errorf("EKS cluster [%s] has no OIDC issuer, pass the providerArn or issuerUrl of its OIDC provider instead", cluster.Name)
+			}
+
+			issuer := strings.TrimPrefix(cluster.Identities[0].Oidcs[0].Issuer, "https://")
+			return fmt.Sprintf("arn:%s:iam::%s:oidc-provider/%s", partition, accountID, issuer), nil
+		}).(pulumi.StringOutput), nil
+	case p.IssuerURL != nil:
+		return p.IssuerURL.ToStringOutput().ApplyT(func(issuerURL string) string {
+			issuer := strings.TrimSuffix(strings.TrimPrefix(issuerURL, "https://"), "/")
+			return fmt.Sprintf("arn:%s:iam::%s:oidc-provider/%s", partition, accountID, issuer)
+		}).(pulumi.StringOutput), nil
+	default:
+		return pulumi.StringOutput{}, fmt.Errorf("one of providerArn, clusterName or issuerUrl is required")
+	}
+}
+
 type EKSServiceAccountPolicies struct {
 	// The Cert Manager IAM policy to attach to the role.
 	CertManager eks_policies.CertManagerPolicyArgs `pulumi:"certManager"`
@@ -74,6 +133,60 @@ type EKSServiceAccountPolicies struct {
 
 	// The Node Termination Handler policy to the role.
 	NodeTerminationHandler eks_policies.NodeTerminationHandlerPolicyArgs `pulumi:"nodeTerminationHandler"`
+
+	// The Mountpoint for Amazon S3 CSI IAM policy to the role.
+	MountpointS3CSI eks_policies.MountpointS3CSIPolicyArgs `pulumi:"mountpointS3Csi"`
+
+	// The CloudWatch Observability IAM policy to the role.
+	CloudWatchObservability eks_policies.CloudWatchObservabilityPolicyArgs `pulumi:"cloudwatchObservability"`
+
+	// The AWS Distro for OpenTelemetry IAM policy to the role.
+	ADOT eks_policies.ADOTPolicyArgs `pulumi:"adot"`
+
+	// The Secrets Store CSI driver IAM policy to the role.
+	SecretsStoreCSI eks_policies.SecretsStoreCSIPolicyArgs `pulumi:"secretsStoreCsi"`
+
+	// The VPC Lattice Gateway API Controller IAM policy to the role.
+	GatewayAPIController eks_policies.GatewayAPIControllerPolicyArgs `pulumi:"gatewayApiController"`
+
+	// The KEDA IAM policy to the role.
+	KEDA eks_policies.KEDAPolicyArgs `pulumi:"keda"`
+
+	// Arguments of add-ons registered with eks_policies.RegisterAddon, keyed by add-on name.
+	Custom map[string]interface{} `pulumi:"custom"`
+}
+
+// addonArgs returns the arguments of each add-on keyed by the name it is registered with. Built-in add-ons
+// are the fields of the policies, registered under the name of their `pulumi` tag.
+func (p EKSServiceAccountPolicies) addonArgs() (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	value := reflect.ValueOf(p)
+	for i := 0; i < value.NumField(); i++ {
+		name := value.Type().Field(i).Tag.Get("pulumi")
+		if name == "custom" {
+			continue
+		}
+
+		if _, ok := eks_policies.LookupAddon(name); !ok {
+			return nil, fmt.Errorf("Built-in add-on [%s] is not registered.", name)
+		}
+
+		args[name] = value.Field(i).Interface()
+	}
+
+	for name, custom := range p.Custom {
+		if _, ok := args[name]; ok {
+			return nil, fmt.Errorf("Custom add-on [%s] conflicts with a built-in add-on.", name)
+		}
+
+		if _, ok := eks_policies.LookupAddon(name); !ok {
+			return nil, fmt.Errorf("Custom add-on [%s] is not registered.", name)
+		}
+
+		args[name] = custom
+	}
+
+	return args, nil
 }
 
 type RoleForServiceAccountsEksArgs struct {
@@ -95,11 +208,22 @@ type RoleForServiceAccountsEksArgs struct {
 	// Map of OIDC providers.
 	OIDCProviders map[string]OIDCServiceProviderEKS `pulumi:"oidcProviders"`
 
-	// Name of the IAM condition operator to evaluate when assuming the role.
+	// Name of the IAM condition operator to evaluate when assuming the role. ServiceAccounts with wildcards
+	// are matched with StringLike in a statement of their own.
 	AssumeRoleConditionTest string `pulumi:"assumeRoleConditionTest"`
 
+	// Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+	AllowAllNamespaces bool `pulumi:"allowAllNamespaces"`
+
 	// The different policies to attach to the role.
 	Policies EKSServiceAccountPolicies `pulumi:"policies"`
+
+	// Annotations added to the rendered ServiceAccount manifests.
+	ServiceAccountManifest EKSServiceAccountManifestArgs `pulumi:"serviceAccountManifest"`
+
+	// Settings of the session helpers rendered for the role. The web identity token file defaults to the
+	// token EKS mounts into pods.
+	Session RoleSessionArgs `pulumi:"session"`
 }
 
 type RoleForServiceAccountsEks struct {
@@ -118,6 +242,12 @@ type RoleForServiceAccountsEks struct {
 		// Unique ID of IAM role.
 		UniqueID pulumi.StringOutput `pulumi:"uniqueId"`
 	} `pulumi:"role"`
+
+	// Annotations and ServiceAccount manifests binding each ServiceAccount to the role.
+	ServiceAccounts pulumi.ArrayOutput `pulumi:"serviceAccounts"`
+
+	// Session policy and AWS CLI / SDK snippets assuming the role.
+	Session RoleSessionOutput `pulumi:"session"`
 }
 
 func NewRoleForServiceAccountsEks(ctx *pulumi.Context, name string, args *RoleForServiceAccountsEksArgs, opts ...pulumi.ResourceOption) (*RoleForServiceAccountsEks, error) {
@@ -133,6 +263,10 @@ func NewRoleForServiceAccountsEks(ctx *pulumi.Context, name string, args *RoleFo
 
 	opts = append(opts, pulumi.Parent(component))
 
+	if err := args.Session.validate(true); err != nil {
+		return nil, fmt.Errorf("Invalid session for resource with name [%s]: %w.", name, err)
+	}
+
 	account, err := aws.GetCallerIdentity(ctx)
 	if err != nil {
 		return nil, err
@@ -143,50 +277,47 @@ func NewRoleForServiceAccountsEks(ctx *pulumi.Context, name string, args *RoleFo
 		return nil, err
 	}
 
+	// Sort the providers so the statements keep their order between updates.
+	var providerKeys []string
+	for key := range args.OIDCProviders {
+		providerKeys = append(providerKeys, key)
+	}
+	sort.Strings(providerKeys)
+
 	var oidcProviderOutputs []interface{}
-	for _, provider := range args.OIDCProviders {
-		providerOutput := pulumi.All(provider.NamespaceServiceAccounts.ToStringArrayOutput(), provider.ProviderARN).ApplyT(func(x []interface{}) iam.GetPolicyDocumentStatement {
+	for _, key := range providerKeys {
+		provider := args.OIDCProviders[key]
+		providerARN, err := provider.resolve(ctx, currentPartition.Partition, account.AccountId)
+		if err != nil {
+			return nil, fmt.Errorf("OIDC provider [%s] of resource with name [%s]: %w", key, name, err)
+		}
+
+		if provider.NamespaceServiceAccounts == nil {
+			provider.NamespaceServiceAccounts = pulumi.ToStringArray(nil)
+		}
+
+		providerOutput := pulumi.All(provider.NamespaceServiceAccounts.ToStringArrayOutput(), providerARN).ApplyT(func(x []interface{}) ([]iam.GetPolicyDocumentStatement, error) {
 			namespaceServiceAccounts := x[0].([]string)
 			providerARN := x[1].(string)
 
-			effect := "Allow"
-
-			var serviceAccounts []string
-			for _, sa := range namespaceServiceAccounts {
-				serviceAccounts = append(serviceAccounts, fmt.Sprintf("system:serviceaccount:%s", sa))
+			statements, err := newEKSServiceAccountStatements(providerARN, oidcProviderIssuer(providerARN), namespaceServiceAccounts, eksServiceAccountTrustOptions{
+				ConditionTest:      args.AssumeRoleConditionTest,
+				Audiences:          []string{"sts.amazonaws.com"},
+				AllowAllNamespaces: args.AllowAllNamespaces,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("OIDC provider [%s] of resource with name [%s]: %w", key, name, err)
 			}
 
-			return iam.GetPolicyDocumentStatement{
-				Effect:  &effect,
-				Actions: []string{"sts:AssumeRoleWithWebIdentity"},
-				Principals: []iam.GetPolicyDocumentStatementPrincipal{
-					{
-						Type:        "Federated",
-						Identifiers: []string{providerARN},
-					},
-				},
-				Conditions: []iam.GetPolicyDocumentStatementCondition{
-					{
-						Test:     args.AssumeRoleConditionTest,
-						Variable: fmt.Sprintf("%s:sub", strings.ReplaceAll(providerARN, "/^(.*provider/)/", "")),
-						Values:   serviceAccounts,
-					},
-					{
-						Test:     args.AssumeRoleConditionTest,
-						Variable: fmt.Sprintf("%s:aud", strings.ReplaceAll(providerARN, "/^(.*provider/)/", "")),
-						Values:   []string{"sts.amazonaws.com"},
-					},
-				},
-			}
-		}).(iam.GetPolicyDocumentStatementOutput)
+			return statements, nil
+		}).(iam.GetPolicyDocumentStatementArrayOutput)
 		oidcProviderOutputs = append(oidcProviderOutputs, providerOutput)
 	}
 
 	policyDocJSON := pulumi.All(oidcProviderOutputs...).ApplyT(func(x []interface{}) (string, error) {
 		var statements []iam.GetPolicyDocumentStatement
 		for _, v := range x {
-			s := v.(iam.GetPolicyDocumentStatement)
-			statements = append(statements, s)
+			statements = append(statements, v.([]iam.GetPolicyDocumentStatement)...)
 		}
 
 		policyDoc, err := iam.GetPolicyDocument(ctx, &iam.GetPolicyDocumentArgs{
@@ -199,143 +330,74 @@ func NewRoleForServiceAccountsEks(ctx *pulumi.Context, name string, args *RoleFo
 		return policyDoc.Json, nil
 	}).(pulumi.StringOutput)
 
-	eksRole, err := utils.NewIAMRole(ctx, name, &utils.IAMRoleArgs{
-		Role:                args.Role,
-		MaxSessionDuration:  args.MaxSessionDuration,
-		ForceDetachPolicies: args.ForceDetachPolicies,
-		AssumeRolePolicy:    policyDocJSON,
-		Tags:                args.Tags,
-	}, opts...)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, policyARN := range args.Role.PolicyArns {
-		_, err := iam.NewRolePolicyAttachment(ctx, fmt.Sprintf("%s-custom", name), &iam.RolePolicyAttachmentArgs{
-			Role:      eksRole.Name,
-			PolicyArn: policyARN,
-		}, opts...)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	policyBuilder := eks_policies.CreateNewRoleBuilder(ctx, eksRole, name, args.PolicyNamePrefix, args.Role.Path, args.Tags, opts...)
-
-	// Cert Manager
-	if args.Policies.CertManager.Attach {
-		err = eks_policies.AttachCertManagerPolicy(ctx, policyBuilder, currentPartition.Partition, args.Policies.CertManager)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// Cluster Autoscaler
-	if args.Policies.ClusterAutoScaling.Attach {
-		err = eks_policies.AttachClusterAutoscalerPolicy(ctx, policyBuilder, args.Policies.ClusterAutoScaling)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// EBS CSI
-	if args.Policies.EBSCSI.Attach {
-		err = eks_policies.AttachEBSCSIPolicy(ctx, policyBuilder, currentPartition.Partition, args.Policies.EBSCSI)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// EFS CSI
-	if args.Policies.EFSCSI.Attach {
-		err = eks_policies.AttachEFSCSIPolicy(policyBuilder)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// External DNS
-	if args.Policies.ExternalDNS.Attach {
-		err = eks_policies.AttachExternalDNSPolicy(ctx, policyBuilder, args.Policies.ExternalDNS)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// External Secrets
-	if args.Policies.ExternalSecrets.Attach {
-		err = eks_policies.AttachExternalSecretsPolicy(ctx, policyBuilder, args.Policies.ExternalSecrets)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// FSx Lustre CSI
-	if args.Policies.FSxLustreCSI.Attach {
-		err = eks_policies.AttachFSXLustreCSIPolicy(ctx, policyBuilder, currentPartition.DnsSuffix, args.Policies.FSxLustreCSI)
-		if err != nil {
-			return nil, err
-		}
-	}
+	// Add-on policies are created before the role and attached once it is created, so that they are kept
+	// when policy attachments are exclusive.
+	policyBuilder := eks_policies.CreateNewRoleBuilder(ctx, nil, name, args.PolicyNamePrefix, args.Role.Path, args.Tags, opts...)
+	policyBuilder.AWSAccountID = account.AccountId
+	policyBuilder.AWSCurrentPartition = currentPartition.Partition
+	policyBuilder.DNSSuffix = currentPartition.DnsSuffix
 
-	if args.Policies.KarpenterController.Attach {
-		err = eks_policies.AttachKarpenterControllerPolicy(ctx, policyBuilder, currentPartition.Partition, account.AccountId, args.Policies.KarpenterController)
-		if err != nil {
-			return nil, err
-		}
+	addonArgs, err := args.Policies.addonArgs()
+	if err != nil {
+		return nil, fmt.Errorf("resource with name [%s]: %w", name, err)
 	}
 
-	if args.Policies.LoadBalancer.Controller {
-		err = eks_policies.AttachLoadBalancerControllerPolicy(policyBuilder, currentPartition.Partition, currentPartition.DnsSuffix)
-		if err != nil {
-			return nil, err
+	for _, addon := range eks_policies.Addons() {
+		addonArg, ok := addonArgs[addon.Name]
+		if !ok {
+			continue
 		}
-	}
 
-	if args.Policies.LoadBalancer.TargetGroupBindingOnly {
-		err = eks_policies.AttachLoadBalancerTargetGroupBindingOnlyPolicy(policyBuilder)
+		err = addon.Attach(policyBuilder, addonArg)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if args.Policies.Appmesh.Controller {
-		err = eks_policies.AttachAppmeshControllerPolicy(policyBuilder, currentPartition.Partition, currentPartition.DnsSuffix)
-		if err != nil {
-			return nil, err
-		}
+	eksRole, err := utils.NewIAMRole(ctx, name, &utils.IAMRoleArgs{
+		Role:                args.Role,
+		MaxSessionDuration:  args.MaxSessionDuration,
+		ForceDetachPolicies: args.ForceDetachPolicies,
+		AssumeRolePolicy:    policyDocJSON,
+		Tags:                args.Tags,
+		AttachedPolicyArns:  policyBuilder.PolicyARNs(),
+	}, opts...)
+	if err != nil {
+		return nil, err
 	}
 
-	if args.Policies.Appmesh.EnvoyProxy {
-		err = eks_policies.AttachAppmeshEnvoyProxyPolicy(policyBuilder)
-		if err != nil {
-			return nil, err
-		}
+	// Exclusive policy attachments of the custom and add-on policies are set on the role itself.
+	customPolicyARNs := args.Role.PolicyArns
+	if args.Role.ExclusivePolicyAttachments {
+		customPolicyARNs = nil
 	}
 
-	if args.Policies.AmazonManagedServicePrometheus.Attach {
-		err = eks_policies.AttachAmazonManagedServicePrometheusPolicy(ctx, policyBuilder, args.Policies.AmazonManagedServicePrometheus)
-		if err != nil {
-			return nil, err
+	customAttachments := map[string]bool{}
+	for i, policyARN := range customPolicyARNs {
+		key := utils.PolicyAttachmentKey(policyARN, i)
+		if customAttachments[key] {
+			continue
 		}
-	}
+		customAttachments[key] = true
 
-	if args.Policies.Velero.Attach {
-		err = eks_policies.AttachVeleroPolicy(ctx, policyBuilder, args.Policies.Velero)
-		if err != nil {
-			return nil, err
+		// A single custom attachment used to be named after the component, the alias keeps it on migration.
+		attachmentName := fmt.Sprintf("%s-custom-%s", name, key)
+		attachmentOpts := opts
+		if i == 0 {
+			attachmentOpts = utils.WithAliases(opts, attachmentName, fmt.Sprintf("%s-custom", name))
 		}
-	}
 
-	if args.Policies.VPNCNI.Attach {
-		err = eks_policies.AttachVPNCNIPolicy(policyBuilder, currentPartition.Partition, args.Policies.VPNCNI)
+		_, err := iam.NewRolePolicyAttachment(ctx, attachmentName, &iam.RolePolicyAttachmentArgs{
+			Role:      eksRole.Name,
+			PolicyArn: policyARN,
+		}, attachmentOpts...)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if args.Policies.NodeTerminationHandler.Attach {
-		err = eks_policies.AttachNodeTerminationPolicy(ctx, policyBuilder, args.Policies.NodeTerminationHandler)
+	if !args.Role.ExclusivePolicyAttachments {
+		err = policyBuilder.AttachPolicies(eksRole)
 		if err != nil {
 			return nil, err
 		}
@@ -346,5 +408,16 @@ func NewRoleForServiceAccountsEks(ctx *pulumi.Context, name string, args *RoleFo
 	component.Role.Path = eksRole.Path
 	component.Role.UniqueID = eksRole.UniqueId
 
+	var serviceAccounts []pulumi.StringArrayInput
+	for _, provider := range args.OIDCProviders {
+		serviceAccounts = append(serviceAccounts, provider.NamespaceServiceAccounts)
+	}
+	component.ServiceAccounts = newEKSServiceAccountManifests(eksRole.Arn, serviceAccounts, args.ServiceAccountManifest)
+
+	if args.Session.WebIdentityTokenFile == "" {
+		args.Session.WebIdentityTokenFile = eksWebIdentityTokenFile
+	}
+	component.Session = newRoleSession(eksRole, true, args.Session)
+
 	return component, nil
 }
@@ -0,0 +1,152 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi-aws-iam/pkg/eks_policies"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/internals"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEKSServiceAccountPoliciesAddonArgs(t *testing.T) {
+	policies := EKSServiceAccountPolicies{
+		KEDA: eks_policies.KEDAPolicyArgs{Attach: true},
+	}
+
+	args, err := policies.addonArgs()
+	require.NoError(t, err)
+	assert.Len(t, args, 20)
+	assert.Equal(t, policies.KEDA, args["keda"])
+	assert.Equal(t, policies.LoadBalancer, args["loadBalancer"])
+	assert.NotContains(t, args, "custom")
+
+	for name := range args {
+		_, ok := eks_policies.LookupAddon(name)
+		assert.True(t, ok, name)
+	}
+
+	_, err = EKSServiceAccountPolicies{Custom: map[string]interface{}{"keda": nil}}.addonArgs()
+	assert.EqualError(t, err, "Custom add-on [keda] conflicts with a built-in add-on.")
+
+	_, err = EKSServiceAccountPolicies{Custom: map[string]interface{}{"unknown": nil}}.addonArgs()
+	assert.EqualError(t, err, "Custom add-on [unknown] is not registered.")
+}
+
+func TestOIDCProviderIssuer(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:iam::123456789012:oidc-provider/oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE":        "oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE",
+		"arn:aws-cn:iam::123456789012:oidc-provider/oidc.eks.cn-north-1.amazonaws.com.cn/id/EXAMPLE": "oidc.eks.cn-north-1.amazonaws.com.cn/id/EXAMPLE",
+		"https://oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE":                                        "oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE",
+		"oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE":                                                "oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE",
+	}
+
+	for providerARN, expected := range tests {
+		assert.Equal(t, expected, oidcProviderIssuer(providerARN), providerARN)
+	}
+}
+
+// eksClusterMocks answers the EKS cluster lookups with the OIDC issuers of the clusters, clusters without an
+// issuer have no identities.
+type eksClusterMocks map[string]string
+
+func (m eksClusterMocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	return args.Name + "_id", args.Inputs, nil
+}
+
+func (m eksClusterMocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	if args.Token != "aws:eks/getCluster:getCluster" {
+		return resource.PropertyMap{}, nil
+	}
+
+	name := args.Args["name"].StringValue()
+	result := map[string]interface{}{"name": name}
+	if issuer := m[name]; issuer != "" {
+		result["identities"] = []interface{}{
+			map[string]interface{}{"oidcs": []interface{}{map[string]interface{}{"issuer": issuer}}},
+		}
+	}
+	return resource.NewPropertyMapFromMap(result), nil
+}
+
+func TestOIDCServiceProviderEKSResolve(t *testing.T) {
+	mocks := eksClusterMocks{
+		"production": "https://oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE",
+		"legacy":     "",
+	}
+
+	tests := []struct {
+		name     string
+		provider OIDCServiceProviderEKS
+		expected string
+		err      string
+	}{
+		{
+			name:     "provider ARN",
+			provider: OIDCServiceProviderEKS{ProviderARN: pulumi.String("arn:aws:iam::111111111111:oidc-provider/oidc.example.com")},
+			expected: "arn:aws:iam::111111111111:oidc-provider/oidc.example.com",
+		},
+		{
+			name:     "cluster name",
+			provider: OIDCServiceProviderEKS{ClusterName: pulumi.String("production")},
+			expected: "arn:aws:iam::123456789012:oidc-provider/oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE",
+		},
+		{
+			name:     "cluster name in another account",
+			provider: OIDCServiceProviderEKS{ClusterName: pulumi.String("production"), AWSAccountID: "210987654321"},
+			expected: "arn:aws:iam::210987654321:oidc-provider/oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE",
+		},
+		{
+			name:     "cluster without OIDC issuer",
+			provider: OIDCServiceProviderEKS{ClusterName: pulumi.String("legacy")},
+			err:      "EKS cluster [legacy] has no OIDC issuer, pass the providerArn or issuerUrl of its OIDC provider instead",
+		},
+		{
+			name:     "issuer URL",
+			provider: OIDCServiceProviderEKS{IssuerURL: pulumi.String("https://token.actions.example.com/")},
+			expected: "arn:aws:iam::123456789012:oidc-provider/token.actions.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+				providerARN, err := tt.provider.resolve(ctx, "aws", "123456789012")
+				require.NoError(t, err)
+
+				result, err := internals.UnsafeAwaitOutput(ctx.Context(), providerARN)
+				if tt.err != "" {
+					assert.EqualError(t, err, tt.err)
+					return nil
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result.Value)
+				return nil
+			}, pulumi.WithMocks("project", "stack", mocks))
+			require.NoError(t, err)
+		})
+	}
+
+	err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+		_, err := OIDCServiceProviderEKS{}.resolve(ctx, "aws", "123456789012")
+		return err
+	}, pulumi.WithMocks("project", "stack", mocks))
+	assert.ErrorContains(t, err, "one of providerArn, clusterName or issuerUrl is required")
+}
@@ -0,0 +1,284 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	// roleSessionMinDuration is the shortest session STS issues.
+	roleSessionMinDuration = 900
+
+	// roleChainingMaxDuration is the longest session STS issues when a role session assumes another role.
+	roleChainingMaxDuration = 3600
+)
+
+type RoleSessionArgs struct {
+	// Name of the AWS CLI profile. Defaults to the role name.
+	ProfileName string `pulumi:"profileName"`
+
+	// Name of the AWS CLI profile whose credentials assume the role. Defaults to `default`.
+	SourceProfile string `pulumi:"sourceProfile"`
+
+	// Name of the role session. Defaults to the profile name.
+	SessionName string `pulumi:"sessionName"`
+
+	// Source identity to set on the session, e.g. the name of the CI pipeline.
+	SourceIdentity string `pulumi:"sourceIdentity"`
+
+	// Session tags to pass when assuming the role.
+	SessionTags map[string]string `pulumi:"sessionTags"`
+
+	// Session tag keys which persist when the session assumes another role.
+	TransitiveTagKeys []string `pulumi:"transitiveTagKeys"`
+
+	// Requested session duration in seconds. Capped by the maximum session duration of the role and
+	// defaults to it.
+	DurationSeconds int `pulumi:"durationSeconds"`
+
+	// Whether the role is assumed from another role session, which STS limits to one hour.
+	RoleChaining bool `pulumi:"roleChaining"`
+
+	// Actions the session is limited to by a session policy. No session policy is rendered when empty.
+	PolicyActions []string `pulumi:"policyActions"`
+
+	// Resources the session policy limits the actions to. Defaults to all resources.
+	PolicyResources []string `pulumi:"policyResources"`
+
+	// Path of the web identity token for roles assumed with AssumeRoleWithWebIdentity. Required for the AWS CLI
+	// config profile of these roles.
+	WebIdentityTokenFile string `pulumi:"webIdentityTokenFile"`
+}
+
+type RoleSessionOutput struct {
+	// Session policy limiting what the session can do, as JSON. Empty when no policy actions are given.
+	SessionPolicy pulumi.StringOutput `pulumi:"sessionPolicy"`
+
+	// Session duration in seconds, capped by the maximum session duration of the role.
+	DurationSeconds pulumi.IntOutput `pulumi:"durationSeconds"`
+
+	// AWS CLI config profile assuming the role. Empty for web identity roles without a web identity token file.
+	CLIConfig pulumi.StringOutput `pulumi:"cliConfig"`
+
+	// AWS CLI command assuming the role with the source identity, session tags and session policy.
+	CLICommand pulumi.StringOutput `pulumi:"cliCommand"`
+
+	// Parameters of the STS request assuming the role as JSON, usable with any AWS SDK.
+	SDKParameters pulumi.StringOutput `pulumi:"sdkParameters"`
+}
+
+type roleSession struct {
+	SessionPolicy   string
+	DurationSeconds int
+	CLIConfig       string
+	CLICommand      string
+	SDKParameters   string
+}
+
+// shellQuote quotes a value for POSIX shells.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+func (a RoleSessionArgs) validate(webIdentity bool) error {
+	if webIdentity && (a.SourceIdentity != "" || len(a.SessionTags) > 0 || len(a.TransitiveTagKeys) > 0) {
+		return fmt.Errorf("the source identity and session tags of web identity sessions are taken from the token")
+	}
+
+	return nil
+}
+
+// renderRoleSession renders the session policy and the snippets assuming a role with AssumeRole or, for
+// webIdentity roles, AssumeRoleWithWebIdentity.
+func renderRoleSession(roleARN, roleName string, maxSessionDuration int, webIdentity bool, args RoleSessionArgs) (roleSession, error) {
+	if err := args.validate(webIdentity); err != nil {
+		return roleSession{}, err
+	}
+
+	profileName := args.ProfileName
+	if profileName == "" {
+		profileName = roleName
+	}
+
+	sourceProfile := args.SourceProfile
+	if sourceProfile == "" {
+		sourceProfile = "default"
+	}
+
+	sessionName := args.SessionName
+	if sessionName == "" {
+		sessionName = profileName
+	}
+
+	maxDuration := maxSessionDuration
+	if args.RoleChaining && maxDuration > roleChainingMaxDuration {
+		maxDuration = roleChainingMaxDuration
+	}
+
+	duration := args.DurationSeconds
+	if duration == 0 || duration > maxDuration {
+		duration = maxDuration
+	}
+	if duration < roleSessionMinDuration {
+		duration = roleSessionMinDuration
+	}
+
+	var session roleSession
+	session.DurationSeconds = duration
+
+	if len(args.PolicyActions) > 0 {
+		resources := args.PolicyResources
+		if len(resources) == 0 {
+			resources = []string{"*"}
+		}
+
+		policy, err := json.Marshal(map[string]interface{}{
+			"Version": "2012-10-17",
+			"Statement": []interface{}{
+				map[string]interface{}{
+					"Effect":   "Allow",
+					"Action":   args.PolicyActions,
+					"Resource": resources,
+				},
+			},
+		})
+		if err != nil {
+			return roleSession{}, err
+		}
+		session.SessionPolicy = string(policy)
+	}
+
+	var tagKeys []string
+	for key := range args.SessionTags {
+		tagKeys = append(tagKeys, key)
+	}
+	sort.Strings(tagKeys)
+
+	profile := awsConfigProfile{
+		Name:            profileName,
+		RoleARN:         roleARN,
+		RoleSessionName: sessionName,
+		DurationSeconds: duration,
+	}
+
+	command := []string{"aws sts"}
+	parameters := map[string]interface{}{
+		"RoleArn":         roleARN,
+		"RoleSessionName": sessionName,
+		"DurationSeconds": duration,
+	}
+
+	if webIdentity {
+		profile.WebIdentityTokenFile = args.WebIdentityTokenFile
+
+		tokenFile := args.WebIdentityTokenFile
+		if tokenFile == "" {
+			tokenFile = "$AWS_WEB_IDENTITY_TOKEN_FILE"
+		}
+
+		command = append(command, "assume-role-with-web-identity",
+			"--role-arn", roleARN,
+			"--role-session-name", shellQuote(sessionName),
+			"--duration-seconds", fmt.Sprint(duration),
+			"--web-identity-token", fmt.Sprintf(`"$(cat %s)"`, tokenFile))
+	} else {
+		profile.SourceProfile = sourceProfile
+
+		command = append(command, "assume-role",
+			"--profile", shellQuote(sourceProfile),
+			"--role-arn", roleARN,
+			"--role-session-name", shellQuote(sessionName),
+			"--duration-seconds", fmt.Sprint(duration))
+
+		if args.SourceIdentity != "" {
+			command = append(command, "--source-identity", shellQuote(args.SourceIdentity))
+			parameters["SourceIdentity"] = args.SourceIdentity
+		}
+
+		if len(tagKeys) > 0 {
+			var tags []interface{}
+			command = append(command, "--tags")
+			for _, key := range tagKeys {
+				command = append(command, shellQuote(fmt.Sprintf("Key=%s,Value=%s", key, args.SessionTags[key])))
+				tags = append(tags, map[string]string{"Key": key, "Value": args.SessionTags[key]})
+			}
+			parameters["Tags"] = tags
+		}
+
+		if len(args.TransitiveTagKeys) > 0 {
+			command = append(command, "--transitive-tag-keys")
+			for _, key := range args.TransitiveTagKeys {
+				command = append(command, shellQuote(key))
+			}
+			parameters["TransitiveTagKeys"] = args.TransitiveTagKeys
+		}
+	}
+
+	if session.SessionPolicy != "" {
+		command = append(command, "--policy", shellQuote(session.SessionPolicy))
+		parameters["Policy"] = session.SessionPolicy
+	}
+
+	sdkParameters, err := json.MarshalIndent(parameters, "", "  ")
+	if err != nil {
+		return roleSession{}, err
+	}
+
+	// The AWS CLI cannot assume a web identity role without the token file, such profiles are left out.
+	if !webIdentity || profile.WebIdentityTokenFile != "" {
+		session.CLIConfig = profile.render()
+	}
+	session.CLICommand = strings.Join(command, " ")
+	session.SDKParameters = string(sdkParameters)
+
+	return session, nil
+}
+
+// newRoleSession renders the session helpers of a role once its ARN, name and maximum session duration are known.
+func newRoleSession(role *iam.Role, webIdentity bool, args RoleSessionArgs) RoleSessionOutput {
+	rendered := pulumi.All(role.Arn, role.Name, role.MaxSessionDuration).ApplyT(func(x []interface{}) (roleSession, error) {
+		maxSessionDuration := roleChainingMaxDuration
+		if d, ok := x[2].(*int); ok && d != nil {
+			maxSessionDuration = *d
+		}
+
+		return renderRoleSession(x[0].(string), x[1].(string), maxSessionDuration, webIdentity, args)
+	})
+
+	return RoleSessionOutput{
+		SessionPolicy: rendered.ApplyT(func(v interface{}) string {
+			return v.(roleSession).SessionPolicy
+		}).(pulumi.StringOutput),
+		DurationSeconds: rendered.ApplyT(func(v interface{}) int {
+			return v.(roleSession).DurationSeconds
+		}).(pulumi.IntOutput),
+		CLIConfig: rendered.ApplyT(func(v interface{}) string {
+			return v.(roleSession).CLIConfig
+		}).(pulumi.StringOutput),
+		CLICommand: rendered.ApplyT(func(v interface{}) string {
+			return v.(roleSession).CLICommand
+		}).(pulumi.StringOutput),
+		SDKParameters: rendered.ApplyT(func(v interface{}) string {
+			return v.(roleSession).SDKParameters
+		}).(pulumi.StringOutput),
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleSessionValidation(t *testing.T) {
+	webIdentitySessions := []RoleSessionArgs{
+		{SourceIdentity: "pipeline"},
+		{SessionTags: map[string]string{"team": "platform"}},
+		{TransitiveTagKeys: []string{"team"}},
+	}
+
+	for _, session := range webIdentitySessions {
+		assert.NoError(t, session.validate(false))
+
+		mocks := &trustPolicyMocks{}
+		err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+			_, err := NewIAMAssumableRoleWithOIDC(ctx, "ci", &AssumableRoleWithOIDCArgs{
+				ProviderURLs: pulumi.ToStringArray([]string{"token.actions.githubusercontent.com"}),
+				Session:      session,
+			})
+			return err
+		}, pulumi.WithMocks("project", "stack", mocks))
+		assert.ErrorContains(t, err, "Invalid session for resource with name [ci]: the source identity and session tags of web identity sessions are taken from the token.")
+		assert.NotContains(t, mocks.types, "aws:iam/role:Role")
+	}
+
+	assert.NoError(t, RoleSessionArgs{WebIdentityTokenFile: "/var/run/token"}.validate(true))
+}
@@ -0,0 +1,276 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	SAMLProviderIdentifier = "aws-iam:index:SAMLProvider"
+
+	samlProviderDefaultCertificateExpiryWarningDays = 30
+)
+
+type SAMLProviderArgs struct {
+	// The name of the SAML provider.
+	Name string `pulumi:"name"`
+
+	// The IdP metadata XML document.
+	SAMLMetadataDocument string `pulumi:"samlMetadataDocument"`
+
+	// Path to a local file containing the IdP metadata XML document. Used when `samlMetadataDocument` is empty.
+	SAMLMetadataFile string `pulumi:"samlMetadataFile"`
+
+	// Number of days before a signing certificate expires at which a warning is reported. Defaults to 30, 0 disables the warning.
+	CertificateExpiryWarningDays *int `pulumi:"certificateExpiryWarningDays"`
+
+	// A map of tags to add.
+	Tags map[string]string `pulumi:"tags"`
+}
+
+type SAMLProvider struct {
+	pulumi.ResourceState
+
+	// The ARN assigned by AWS for this provider.
+	Arn pulumi.StringOutput `pulumi:"arn"`
+
+	// The name of the provider.
+	Name pulumi.StringOutput `pulumi:"name"`
+
+	// The entity ID of the IdP found in the metadata document.
+	EntityID pulumi.StringOutput `pulumi:"entityId"`
+
+	// The expiration date and time for the SAML provider in RFC1123 format.
+	ValidUntil pulumi.StringOutput `pulumi:"validUntil"`
+
+	// The expiration date of the valid signing certificate that expires first, in RFC3339 format.
+	CertificateExpiry pulumi.StringOutput `pulumi:"certificateExpiry"`
+
+	// The number of days left until the valid signing certificate that expires first is no longer valid.
+	DaysUntilCertificateExpiry pulumi.IntOutput `pulumi:"daysUntilCertificateExpiry"`
+}
+
+// samlEntityDescriptor is the subset of a SAML 2.0 metadata document needed to validate an IdP.
+type samlEntityDescriptor struct {
+	XMLName    xml.Name `xml:"EntityDescriptor"`
+	EntityID   string   `xml:"entityID,attr"`
+	ValidUntil string   `xml:"validUntil,attr"`
+
+	IDPSSODescriptors []struct {
+		KeyDescriptors []struct {
+			Use              string   `xml:"use,attr"`
+			X509Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+		} `xml:"KeyDescriptor"`
+	} `xml:"IDPSSODescriptor"`
+}
+
+type samlMetadata struct {
+	EntityID            string
+	SigningCertificates []*x509.Certificate
+}
+
+// earliestCertificateExpiry returns the expiry of the signing certificate still valid at now that expires first.
+func (m *samlMetadata) earliestCertificateExpiry(now time.Time) time.Time {
+	var expiry time.Time
+	for _, cert := range m.SigningCertificates {
+		if !cert.NotAfter.After(now) {
+			continue
+		}
+
+		if expiry.IsZero() || cert.NotAfter.Before(expiry) {
+			expiry = cert.NotAfter
+		}
+	}
+	return expiry
+}
+
+// expiredCertificates returns the expiry of the signing certificates no longer valid at now.
+func (m *samlMetadata) expiredCertificates(now time.Time) []string {
+	var expired []string
+	for _, cert := range m.SigningCertificates {
+		if !cert.NotAfter.After(now) {
+			expired = append(expired, cert.NotAfter.Format(time.RFC3339))
+		}
+	}
+	return expired
+}
+
+// certificateExpiryWarning returns the warning for a valid signing certificate expiring within warningDays of
+// now, or an empty string. A warningDays of 0 disables the warning.
+func (m *samlMetadata) certificateExpiryWarning(now time.Time, warningDays int) string {
+	certificateExpiry := m.earliestCertificateExpiry(now)
+	daysUntilCertificateExpiry := int(certificateExpiry.Sub(now).Hours() / 24)
+	if warningDays <= 0 || daysUntilCertificateExpiry >= warningDays {
+		return ""
+	}
+
+	return fmt.Sprintf("A signing certificate of SAML provider [%s] expires on %s (in %d days). Update the IdP metadata before it is rotated to avoid breaking sign-in.",
+		m.EntityID, certificateExpiry.Format(time.RFC3339), daysUntilCertificateExpiry)
+}
+
+func parseSAMLMetadata(document string, now time.Time) (*samlMetadata, error) {
+	var descriptor samlEntityDescriptor
+	if err := xml.Unmarshal([]byte(document), &descriptor); err != nil {
+		return nil, fmt.Errorf("parsing SAML metadata: %w", err)
+	}
+
+	if descriptor.EntityID == "" {
+		return nil, fmt.Errorf("SAML metadata does not contain an entityID")
+	}
+
+	if len(descriptor.IDPSSODescriptors) == 0 {
+		return nil, fmt.Errorf("SAML metadata for [%s] does not contain an IDPSSODescriptor", descriptor.EntityID)
+	}
+
+	if descriptor.ValidUntil != "" {
+		validUntil, err := time.Parse(time.RFC3339, descriptor.ValidUntil)
+		if err != nil {
+			return nil, fmt.Errorf("parsing validUntil of SAML metadata for [%s]: %w", descriptor.EntityID, err)
+		}
+
+		if validUntil.Before(now) {
+			return nil, fmt.Errorf("SAML metadata for [%s] expired on %s", descriptor.EntityID, validUntil.Format(time.RFC3339))
+		}
+	}
+
+	metadata := &samlMetadata{EntityID: descriptor.EntityID}
+	for _, idp := range descriptor.IDPSSODescriptors {
+		for _, key := range idp.KeyDescriptors {
+			// Keys without a `use` attribute are valid for both signing and encryption.
+			if key.Use != "" && key.Use != "signing" {
+				continue
+			}
+
+			for _, encoded := range key.X509Certificates {
+				der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+				if err != nil {
+					return nil, fmt.Errorf("decoding signing certificate of SAML metadata for [%s]: %w", descriptor.EntityID, err)
+				}
+
+				cert, err := x509.ParseCertificate(der)
+				if err != nil {
+					return nil, fmt.Errorf("parsing signing certificate of SAML metadata for [%s]: %w", descriptor.EntityID, err)
+				}
+
+				metadata.SigningCertificates = append(metadata.SigningCertificates, cert)
+			}
+		}
+	}
+
+	if len(metadata.SigningCertificates) == 0 {
+		return nil, fmt.Errorf("SAML metadata for [%s] does not contain a signing certificate", descriptor.EntityID)
+	}
+
+	hasValidCertificate := false
+	for _, cert := range metadata.SigningCertificates {
+		if cert.NotAfter.After(now) {
+			hasValidCertificate = true
+		}
+	}
+
+	if !hasValidCertificate {
+		return nil, fmt.Errorf("all signing certificates in SAML metadata for [%s] have expired", descriptor.EntityID)
+	}
+
+	return metadata, nil
+}
+
+func NewSAMLProvider(ctx *pulumi.Context, name string, args *SAMLProviderArgs, opts ...pulumi.ResourceOption) (*SAMLProvider, error) {
+	if args == nil {
+		args = &SAMLProviderArgs{}
+	}
+
+	component := &SAMLProvider{}
+	err := ctx.RegisterComponentResource(SAMLProviderIdentifier, name, component, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts, pulumi.Parent(component))
+
+	document := args.SAMLMetadataDocument
+	if document == "" {
+		if args.SAMLMetadataFile == "" {
+			return nil, fmt.Errorf("Either SAMLMetadataDocument or SAMLMetadataFile must be provided for resource with name [%s].", name)
+		}
+
+		contents, err := os.ReadFile(args.SAMLMetadataFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading SAML metadata file for resource with name [%s]: %w", name, err)
+		}
+
+		document = string(contents)
+	}
+
+	now := time.Now()
+	metadata, err := parseSAMLMetadata(document, now)
+	if err != nil {
+		return nil, err
+	}
+
+	certificateExpiryWarningDays := samlProviderDefaultCertificateExpiryWarningDays
+	if args.CertificateExpiryWarningDays != nil {
+		certificateExpiryWarningDays = *args.CertificateExpiryWarningDays
+	}
+
+	if expired := metadata.expiredCertificates(now); len(expired) > 0 {
+		msg := fmt.Sprintf("Signing certificates of SAML provider [%s] expired on %s. Remove them from the IdP metadata.",
+			metadata.EntityID, strings.Join(expired, ", "))
+		if err := ctx.Log.Warn(msg, &pulumi.LogArgs{Resource: component}); err != nil {
+			return nil, err
+		}
+	}
+
+	certificateExpiry := metadata.earliestCertificateExpiry(now)
+	daysUntilCertificateExpiry := int(certificateExpiry.Sub(now).Hours() / 24)
+	if msg := metadata.certificateExpiryWarning(now, certificateExpiryWarningDays); msg != "" {
+		if err := ctx.Log.Warn(msg, &pulumi.LogArgs{Resource: component}); err != nil {
+			return nil, err
+		}
+	}
+
+	providerName := args.Name
+	if providerName == "" {
+		providerName = name
+	}
+
+	samlProvider, err := iam.NewSamlProvider(ctx, name, &iam.SamlProviderArgs{
+		Name:                 pulumi.String(providerName),
+		SamlMetadataDocument: pulumi.String(document),
+		Tags:                 pulumi.ToStringMap(args.Tags),
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	component.Arn = samlProvider.Arn
+	component.Name = samlProvider.Name
+	component.ValidUntil = samlProvider.ValidUntil
+	component.EntityID = pulumi.String(metadata.EntityID).ToStringOutput()
+	component.CertificateExpiry = pulumi.String(certificateExpiry.Format(time.RFC3339)).ToStringOutput()
+	component.DaysUntilCertificateExpiry = pulumi.Int(daysUntilCertificateExpiry).ToIntOutput()
+
+	return component, nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var samlTestNow = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// testSAMLCertificate returns a base64 encoded self-signed certificate valid until notAfter.
+func testSAMLCertificate(t *testing.T, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+// testSAMLMetadata returns an IdP metadata document with a key descriptor per certificate, keyed by its use.
+func testSAMLMetadata(validUntil string, keys ...[2]string) string {
+	var keyDescriptors []string
+	for _, key := range keys {
+		use := ""
+		if key[0] != "" {
+			use = fmt.Sprintf(` use="%s"`, key[0])
+		}
+		keyDescriptors = append(keyDescriptors, fmt.Sprintf(`
+    <md:KeyDescriptor%s>
+      <ds:KeyInfo>
+        <ds:X509Data>
+          <ds:X509Certificate>%s</ds:X509Certificate>
+        </ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>`, use, key[1]))
+	}
+
+	validUntilAttr := ""
+	if validUntil != "" {
+		validUntilAttr = fmt.Sprintf(` validUntil="%s"`, validUntil)
+	}
+
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="https://idp.example.com/saml"%s>
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">%s
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, validUntilAttr, strings.Join(keyDescriptors, ""))
+}
+
+func TestParseSAMLMetadata(t *testing.T) {
+	valid := testSAMLCertificate(t, samlTestNow.AddDate(1, 0, 0))
+	expiringSoon := testSAMLCertificate(t, samlTestNow.AddDate(0, 0, 10))
+	expired := testSAMLCertificate(t, samlTestNow.AddDate(0, 0, -10))
+
+	t.Run("valid", func(t *testing.T) {
+		metadata, err := parseSAMLMetadata(testSAMLMetadata("2025-01-01T00:00:00Z", [2]string{"signing", valid}, [2]string{"", expiringSoon}), samlTestNow)
+		require.NoError(t, err)
+
+		assert.Equal(t, "https://idp.example.com/saml", metadata.EntityID)
+		assert.Len(t, metadata.SigningCertificates, 2)
+		assert.Equal(t, samlTestNow.AddDate(0, 0, 10), metadata.earliestCertificateExpiry(samlTestNow))
+		assert.Empty(t, metadata.expiredCertificates(samlTestNow))
+	})
+
+	t.Run("encryption certificates are ignored", func(t *testing.T) {
+		metadata, err := parseSAMLMetadata(testSAMLMetadata("", [2]string{"signing", valid}, [2]string{"encryption", expired}), samlTestNow)
+		require.NoError(t, err)
+
+		assert.Len(t, metadata.SigningCertificates, 1)
+	})
+
+	t.Run("expired certificate next to a valid one", func(t *testing.T) {
+		metadata, err := parseSAMLMetadata(testSAMLMetadata("", [2]string{"signing", expired}, [2]string{"signing", valid}), samlTestNow)
+		require.NoError(t, err)
+
+		assert.Equal(t, samlTestNow.AddDate(1, 0, 0), metadata.earliestCertificateExpiry(samlTestNow))
+		assert.Equal(t, []string{samlTestNow.AddDate(0, 0, -10).Format(time.RFC3339)}, metadata.expiredCertificates(samlTestNow))
+	})
+
+	errorTests := []struct {
+		name     string
+		document string
+		err      string
+	}{
+		{
+			name:     "malformed XML",
+			document: `<md:EntityDescriptor entityID="https://idp.example.com/saml">`,
+			err:      "parsing SAML metadata: XML syntax error on line 1: unexpected EOF",
+		},
+		{
+			name:     "missing entity ID",
+			document: strings.Replace(testSAMLMetadata("", [2]string{"signing", valid}), ` entityID="https://idp.example.com/saml"`, "", 1),
+			err:      "SAML metadata does not contain an entityID",
+		},
+		{
+			name:     "expired metadata",
+			document: testSAMLMetadata("2023-06-01T00:00:00Z", [2]string{"signing", valid}),
+			err:      "SAML metadata for [https://idp.example.com/saml] expired on 2023-06-01T00:00:00Z",
+		},
+		{
+			name:     "missing certificate",
+			document: testSAMLMetadata(""),
+			err:      "SAML metadata for [https://idp.example.com/saml] does not contain a signing certificate",
+		},
+		{
+			name:     "only encryption certificates",
+			document: testSAMLMetadata("", [2]string{"encryption", valid}),
+			err:      "SAML metadata for [https://idp.example.com/saml] does not contain a signing certificate",
+		},
+		{
+			name:     "malformed certificate",
+			document: testSAMLMetadata("", [2]string{"signing", base64.StdEncoding.EncodeToString([]byte("not a certificate"))}),
+			err:      "parsing signing certificate of SAML metadata for [https://idp.example.com/saml]",
+		},
+		{
+			name:     "all certificates expired",
+			document: testSAMLMetadata("", [2]string{"signing", expired}),
+			err:      "all signing certificates in SAML metadata for [https://idp.example.com/saml] have expired",
+		},
+	}
+
+	for _, tt := range errorTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseSAMLMetadata(tt.document, samlTestNow)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestSAMLCertificateExpiryWarning(t *testing.T) {
+	metadata, err := parseSAMLMetadata(testSAMLMetadata("",
+		[2]string{"signing", testSAMLCertificate(t, samlTestNow.AddDate(0, 0, 20))},
+		[2]string{"signing", testSAMLCertificate(t, samlTestNow.AddDate(0, 0, -5))},
+	), samlTestNow)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		warningDays int
+		warns       bool
+	}{
+		{name: "within the threshold", warningDays: 30, warns: true},
+		{name: "outside the threshold", warningDays: 20},
+		{name: "disabled", warningDays: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			msg := metadata.certificateExpiryWarning(samlTestNow, tt.warningDays)
+			if tt.warns {
+				assert.Equal(t, "A signing certificate of SAML provider [https://idp.example.com/saml] expires on 2024-01-21T00:00:00Z (in 20 days). Update the IdP metadata before it is rotated to avoid breaking sign-in.", msg)
+			} else {
+				assert.Empty(t, msg)
+			}
+		})
+	}
+}
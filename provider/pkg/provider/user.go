@@ -61,6 +61,10 @@ type UserArgs struct {
 
 	// A map of tags to add.
 	Tags map[string]string `pulumi:"tags"`
+
+	// Whether managed policies not declared for the user are detached from it. Not supported for users
+	// by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
+	ExclusivePolicyAttachments bool `pulumi:"exclusivePolicyAttachments"`
 }
 
 type UserInfo struct {
@@ -174,6 +178,10 @@ func NewUser(ctx *pulumi.Context, name string, args *UserArgs, opts ...pulumi.Re
 
 	opts = append(opts, pulumi.Parent(component))
 
+	if args.ExclusivePolicyAttachments {
+		return nil, fmt.Errorf("Exclusive policy attachments are not supported for users by the AWS provider v5 for resource with name [%s].", name)
+	}
+
 	userPath := "/"
 	if args.Path != "" {
 		userPath = args.Path
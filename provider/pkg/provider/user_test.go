@@ -0,0 +1,33 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserExclusivePolicyAttachments(t *testing.T) {
+	err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+		_, err := NewUser(ctx, "user", &UserArgs{
+			Name:                       "user",
+			ExclusivePolicyAttachments: true,
+		})
+		return err
+	}, pulumi.WithMocks("project", "stack", &trustPolicyMocks{}))
+	require.ErrorContains(t, err, "Exclusive policy attachments are not supported for users by the AWS provider v5 for resource with name [user].")
+}
@@ -15,6 +15,8 @@
 package provider
 
 import (
+	"sort"
+
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
@@ -42,6 +44,35 @@ func createAssumableRoleOutput(role *iam.Role, requiresMFA pulumi.BoolInput) Ass
 	}
 }
 
+// PolicyConditionArgs is a condition block of an IAM policy statement.
+type PolicyConditionArgs struct {
+	// Name of the IAM condition operator to evaluate.
+	Test string `pulumi:"test"`
+
+	// Name of a Context Variable to apply the condition to.
+	Variable string `pulumi:"variable"`
+
+	// Values to evaluate the condition against.
+	Values []string `pulumi:"values"`
+}
+
+func newPolicyDocConditions(conditions []PolicyConditionArgs) []iam.GetPolicyDocumentStatementCondition {
+	var result []iam.GetPolicyDocumentStatementCondition
+	for _, condition := range conditions {
+		result = append(result, NewPolicyDocCondition(condition.Test, condition.Variable, condition.Values...))
+	}
+	return result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // IAM Policy Document
 type IAMPolicyDocumentEffect string
 type IAMPolicyDocumentPrincipalType string
@@ -124,6 +155,11 @@ func (i *IAMPolicyDocumentStatementConstructor) AddCondition(test, variable stri
 	return i
 }
 
+func (i *IAMPolicyDocumentStatementConstructor) AddConditions(conditions []iam.GetPolicyDocumentStatementCondition) *IAMPolicyDocumentStatementConstructor {
+	i.Conditions = append(i.Conditions, conditions...)
+	return i
+}
+
 func (i *IAMPolicyDocumentStatementConstructor) AddResources(resources []string) *IAMPolicyDocumentStatementConstructor {
 	i.Resources = append(i.Resources, resources...)
 	return i
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type IAMInstanceProfileArgs struct {
+	Name pulumi.StringPtrInput
+	Path pulumi.StringInput
+	Role pulumi.StringInput
+	Tags pulumi.StringMapInput
+}
+
+func NewIAMInstanceProfile(ctx *pulumi.Context, name string, args *IAMInstanceProfileArgs, opts ...pulumi.ResourceOption) (*iam.InstanceProfile, error) {
+	instanceProfileName := fmt.Sprintf("%s-instance-profile", name)
+	return iam.NewInstanceProfile(ctx, instanceProfileName, &iam.InstanceProfileArgs{
+		Name: args.Name,
+		Path: args.Path,
+		Role: args.Role,
+		Tags: args.Tags,
+	}, opts...)
+}
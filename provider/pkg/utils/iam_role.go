@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
@@ -26,6 +28,17 @@ type RoleArgs struct {
 	// List of ARNs of IAM policies to attach to IAM role.
 	PolicyArns []pulumi.StringInput `pulumi:"policyArns"`
 
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies map[string]pulumi.StringInput `pulumi:"inlinePolicies"`
+
+	// Whether inline policies not declared in inlinePolicies are removed from the role.
+	ExclusiveInlinePolicies bool `pulumi:"exclusiveInlinePolicies"`
+
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the policies.
+	ExclusivePolicyAttachments bool `pulumi:"exclusivePolicyAttachments"`
+
 	// Whether role requires MFA.
 	RequiresMFA pulumi.BoolInput `pulumi:"requiresMfa"`
 
@@ -39,6 +52,70 @@ type IAMRoleArgs struct {
 	MaxSessionDuration  pulumi.IntInput
 	Tags                pulumi.StringMapInput
 	Role                RoleArgs
+
+	// ARNs of policies the component attaches to the role itself. When policy attachments are exclusive the
+	// role manages them instead, and the component must not attach them.
+	AttachedPolicyArns []pulumi.StringInput
+}
+
+// newInlinePolicyDocument returns the policy document of an inline policy given as a policy document,
+// a list of statements or a single statement in JSON.
+func newInlinePolicyDocument(policy string) (string, error) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return "", fmt.Errorf("Invalid inline policy JSON: %w.", err)
+	}
+
+	switch document := document.(type) {
+	case []interface{}:
+		return marshalPolicyDocument(document)
+	case map[string]interface{}:
+		if _, ok := document["Statement"]; ok {
+			return policy, nil
+		}
+		return marshalPolicyDocument([]interface{}{document})
+	default:
+		return "", fmt.Errorf("Inline policy must be a policy document or statements, got %s.", policy)
+	}
+}
+
+func marshalPolicyDocument(statements []interface{}) (string, error) {
+	document, err := json.Marshal(map[string]interface{}{
+		"Version":   "2012-10-17",
+		"Statement": statements,
+	})
+	return string(document), err
+}
+
+func newInlinePolicies(policies map[string]pulumi.StringInput) map[string]pulumi.StringOutput {
+	result := make(map[string]pulumi.StringOutput, len(policies))
+	for name, policy := range policies {
+		result[name] = policy.ToStringOutput().ApplyT(newInlinePolicyDocument).(pulumi.StringOutput)
+	}
+	return result
+}
+
+func sortedInlinePolicyNames(policies map[string]pulumi.StringOutput) []string {
+	names := make([]string, 0, len(policies))
+	for name := range policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// warnDetachedPolicies warns during previews of an existing role that exclusive policy attachments detach the
+// managed policies which are not declared for it. New roles have none.
+func warnDetachedPolicies(ctx *pulumi.Context, role *iam.Role) {
+	role.ID().ApplyT(func(id pulumi.ID) (string, error) {
+		current, err := iam.LookupRole(ctx, &iam.LookupRoleArgs{Name: string(id)}, pulumi.Parent(role))
+		if err != nil {
+			return "", err
+		}
+
+		msg := fmt.Sprintf("Policy attachments of role [%s] are exclusive, managed policies attached to it that are not declared are detached. The managedPolicyArns diff of the role lists the declared ones.", current.Arn)
+		return msg, ctx.Log.Warn(msg, &pulumi.LogArgs{Resource: role})
+	})
 }
 
 func NewIAMRole(ctx *pulumi.Context, name string, args *IAMRoleArgs, opts ...pulumi.ResourceOption) (*iam.Role, error) {
@@ -64,6 +141,35 @@ func NewIAMRole(ctx *pulumi.Context, name string, args *IAMRoleArgs, opts ...pul
 		Tags:                args.Tags,
 	}
 
+	inlinePolicies := newInlinePolicies(args.Role.InlinePolicies)
+	inlinePolicyNames := sortedInlinePolicyNames(inlinePolicies)
+
+	// Inline policies declared on the role itself are exclusive, any other inline policy is removed.
+	if args.Role.ExclusiveInlinePolicies {
+		rolePolicies := iam.RoleInlinePolicyArray{}
+		for _, policyName := range inlinePolicyNames {
+			rolePolicies = append(rolePolicies, &iam.RoleInlinePolicyArgs{
+				Name:   pulumi.String(policyName),
+				Policy: inlinePolicies[policyName],
+			})
+		}
+
+		// An empty inline policy block removes every inline policy from the role.
+		if len(rolePolicies) == 0 {
+			rolePolicies = append(rolePolicies, &iam.RoleInlinePolicyArgs{})
+		}
+
+		roleArgs.InlinePolicies = rolePolicies
+	}
+
+	// Exclusive policy attachments are managed by the role itself, any other managed policy is detached.
+	if args.Role.ExclusivePolicyAttachments {
+		managedPolicyArns := pulumi.StringArray{}
+		managedPolicyArns = append(managedPolicyArns, args.Role.PolicyArns...)
+		managedPolicyArns = append(managedPolicyArns, args.AttachedPolicyArns...)
+		roleArgs.ManagedPolicyArns = managedPolicyArns
+	}
+
 	if args.Role.NamePrefix != nil {
 		roleArgs.NamePrefix = args.Role.NamePrefix.ToStringPtrOutput().ApplyT(func(prefix *string) *string {
 			if *prefix == "" {
@@ -80,12 +186,46 @@ func NewIAMRole(ctx *pulumi.Context, name string, args *IAMRoleArgs, opts ...pul
 		return nil, err
 	}
 
+	if args.Role.ExclusivePolicyAttachments && ctx.DryRun() {
+		warnDetachedPolicies(ctx, role)
+	}
+
+	if !args.Role.ExclusiveInlinePolicies {
+		for _, policyName := range inlinePolicyNames {
+			_, err = iam.NewRolePolicy(ctx, fmt.Sprintf("%s-inline-policy-%s", roleResourceName, policyName), &iam.RolePolicyArgs{
+				Name:   pulumi.String(policyName),
+				Role:   role.Name,
+				Policy: inlinePolicies[policyName],
+			}, opts...)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Exclusive policy attachments are set on the role itself.
+	if args.Role.ExclusivePolicyAttachments {
+		return role, nil
+	}
+
+	attachments := map[string]bool{}
 	for i, policyARN := range args.Role.PolicyArns {
-		policyAttachmentName := fmt.Sprintf("%s-policy-attachment-%v", roleResourceName, i)
+		key := PolicyAttachmentKey(policyARN, i)
+		if attachments[key] {
+			continue
+		}
+		attachments[key] = true
+
+		// Attachments used to be named by index, the alias keeps existing attachments on migration.
+		policyAttachmentName := fmt.Sprintf("%s-policy-attachment-%s", roleResourceName, key)
+		legacyPolicyAttachmentName := fmt.Sprintf("%s-policy-attachment-%v", roleResourceName, i)
 		_, err = iam.NewRolePolicyAttachment(ctx, policyAttachmentName, &iam.RolePolicyAttachmentArgs{
 			Role:      role.Name,
 			PolicyArn: policyARN,
-		}, opts...)
+		}, WithAliases(opts, policyAttachmentName, legacyPolicyAttachmentName)...)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return role, nil
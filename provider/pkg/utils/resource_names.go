@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// HashKey returns a short stable key of a value, used to name child resources after it.
+func HashKey(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])[:8]
+}
+
+// PolicyAttachmentKey returns the key naming the attachment of a policy ARN. ARNs known when the
+// component is constructed are keyed by their hash, so the attachments are unaffected by the order
+// of the list. ARNs only known once other resources are created fall back to their index.
+func PolicyAttachmentKey(policyARN pulumi.StringInput, index int) string {
+	if arn, ok := policyARN.(pulumi.String); ok {
+		return HashKey(string(arn))
+	}
+
+	return strconv.Itoa(index)
+}
+
+// WithAliases returns the resource options with aliases to the previous names of a resource, skipping
+// names equal to its current name.
+func WithAliases(opts []pulumi.ResourceOption, name string, previousNames ...string) []pulumi.ResourceOption {
+	var aliases []pulumi.Alias
+	for _, previousName := range previousNames {
+		if previousName != name {
+			aliases = append(aliases, pulumi.Alias{Name: pulumi.String(previousName)})
+		}
+	}
+
+	if len(aliases) == 0 {
+		return opts
+	}
+
+	result := make([]pulumi.ResourceOption, 0, len(opts)+1)
+	result = append(result, opts...)
+	return append(result, pulumi.Aliases(aliases))
+}
@@ -0,0 +1,125 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam
+{
+    /// <summary>
+    /// This resource renders an AWS CLI config with a profile per IAM role, e.g. the roles of `AssumableRoles` or
+    /// `AssumableRolesWithSAML`, ready to be published to engineers. The roles are assumed with the credentials
+    /// of a source profile, a credential process or an IAM Identity Center session. A second variant of the
+    /// config uses the legacy IAM Identity Center settings supported by granted and aws-vault.
+    /// 
+    /// ## Example Usage
+    /// ## AWS Config Profiles
+    /// 
+    /// ```csharp
+    /// using Pulumi;
+    /// using Pulumi.AwsIam;
+    /// using Pulumi.AwsIam.Inputs;
+    /// 
+    /// class MyStack : Stack
+    /// {
+    ///     public MyStack()
+    ///     {
+    ///         var profiles = new AWSConfigProfiles("aws-config-profiles", new AWSConfigProfilesArgs
+    ///         {
+    ///             Source = new AWSConfigSourceArgs
+    ///             {
+    ///                 ProfilePrefix = "acme-",
+    ///                 SourceProfile = "acme-identity",
+    ///                 MfaSerial = "arn:aws:iam::111111111111:mfa/jane",
+    ///             },
+    ///             Roles =
+    ///             {
+    ///                 new AWSConfigRoleArgs
+    ///                 {
+    ///                     ProfileName = "admin",
+    ///                     RoleArn = "arn:aws:iam::222222222222:role/admin",
+    ///                     RequiresMfa = true,
+    ///                     DurationSeconds = 3600,
+    ///                 },
+    ///             },
+    ///         });
+    /// 
+    ///         this.Profiles = Output.Create&lt;AWSConfigProfiles&gt;(profiles);
+    ///     }
+    /// 
+    ///     [Output]
+    ///     public Output&lt;AWSConfigProfiles&gt; Profiles { get; set; }
+    /// }
+    /// ```
+    /// {{ /example }}
+    /// </summary>
+    [AwsIamResourceType("aws-iam:index:AWSConfigProfiles")]
+    public partial class AWSConfigProfiles : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+        /// </summary>
+        [Output("awsVaultConfig")]
+        public Output<string> AwsVaultConfig { get; private set; } = null!;
+
+        /// <summary>
+        /// AWS CLI config with a profile per role, in INI format.
+        /// </summary>
+        [Output("config")]
+        public Output<string> Config { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a AWSConfigProfiles resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public AWSConfigProfiles(string name, AWSConfigProfilesArgs args, ComponentResourceOptions? options = null)
+            : base("aws-iam:index:AWSConfigProfiles", name, args ?? new AWSConfigProfilesArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class AWSConfigProfilesArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("roles", required: true)]
+        private InputList<Inputs.AWSConfigRoleArgs>? _roles;
+
+        /// <summary>
+        /// Roles to render a profile for.
+        /// </summary>
+        public InputList<Inputs.AWSConfigRoleArgs> Roles
+        {
+            get => _roles ?? (_roles = new InputList<Inputs.AWSConfigRoleArgs>());
+            set => _roles = value;
+        }
+
+        /// <summary>
+        /// Credentials assuming the roles.
+        /// </summary>
+        [Input("source")]
+        public Input<Inputs.AWSConfigSourceArgs>? Source { get; set; }
+
+        public AWSConfigProfilesArgs()
+        {
+        }
+        public static new AWSConfigProfilesArgs Empty => new AWSConfigProfilesArgs();
+    }
+}
@@ -0,0 +1,190 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam
+{
+    /// <summary>
+    /// This resource helps you create an attribute-based access control (ABAC) IAM policy. Actions are only allowed
+    /// on resources whose tags match the tags of the principal (`aws:ResourceTag` against `aws:PrincipalTag`),
+    /// resources can only be created with matching tags (`aws:RequestTag`) and principals are prevented from
+    /// changing or removing the tags used for access control.
+    /// 
+    /// ## Example Usage
+    /// ## ABAC Policy
+    /// 
+    /// ```csharp
+    /// using Pulumi;
+    /// using Pulumi.AwsIam;
+    /// using Pulumi.AwsIam.Inputs;
+    /// 
+    /// class MyStack : Stack
+    /// {
+    ///     public MyStack()
+    ///     {
+    ///         var abacPolicy = new AbacPolicy("abac-policy", new AbacPolicyArgs
+    ///         {
+    ///             Name = "team-abac",
+    ///             TagKeys = {"team", "project"},
+    ///             ActionGroups =
+    ///             {
+    ///                 new AbacActionGroupArgs
+    ///                 {
+    ///                     Service = "ec2",
+    ///                     Actions = {"StartInstances", "StopInstances", "RebootInstances"},
+    ///                     CreateActions = {"RunInstances"},
+    ///                 },
+    ///             },
+    ///         });
+    /// 
+    ///         this.AbacPolicy = Output.Create&lt;AbacPolicy&gt;(abacPolicy);
+    ///     }
+    /// 
+    ///     [Output]
+    ///     public Output&lt;AbacPolicy&gt; AbacPolicy { get; set; }
+    /// }
+    /// ```
+    /// {{ /example }}
+    /// </summary>
+    [AwsIamResourceType("aws-iam:index:AbacPolicy")]
+    public partial class AbacPolicy : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// The ARN assigned by AWS to this policy.
+        /// </summary>
+        [Output("arn")]
+        public Output<string> Arn { get; private set; } = null!;
+
+        /// <summary>
+        /// The description of the policy.
+        /// </summary>
+        [Output("description")]
+        public Output<string> Description { get; private set; } = null!;
+
+        /// <summary>
+        /// The policy's ID.
+        /// </summary>
+        [Output("id")]
+        public Output<string> Id { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the policy.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The path of the policy in IAM.
+        /// </summary>
+        [Output("path")]
+        public Output<string> Path { get; private set; } = null!;
+
+        /// <summary>
+        /// Policy document as json.
+        /// </summary>
+        [Output("policyJson")]
+        public Output<string> PolicyJson { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a AbacPolicy resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public AbacPolicy(string name, AbacPolicyArgs args, ComponentResourceOptions? options = null)
+            : base("aws-iam:index:AbacPolicy", name, args ?? new AbacPolicyArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class AbacPolicyArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("actionGroups", required: true)]
+        private InputList<Inputs.AbacActionGroupArgs>? _actionGroups;
+
+        /// <summary>
+        /// Groups of actions to allow for each service.
+        /// </summary>
+        public InputList<Inputs.AbacActionGroupArgs> ActionGroups
+        {
+            get => _actionGroups ?? (_actionGroups = new InputList<Inputs.AbacActionGroupArgs>());
+            set => _actionGroups = value;
+        }
+
+        /// <summary>
+        /// Whether to leave out the statements which prevent principals from changing or removing the tags
+        /// used for access control.
+        /// </summary>
+        [Input("allowTagModification")]
+        public Input<bool>? AllowTagModification { get; set; }
+
+        /// <summary>
+        /// The description of the policy.
+        /// </summary>
+        [Input("description")]
+        public Input<string>? Description { get; set; }
+
+        /// <summary>
+        /// The name of the policy.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// The path of the policy in IAM.
+        /// </summary>
+        [Input("path")]
+        public Input<string>? Path { get; set; }
+
+        [Input("tagKeys", required: true)]
+        private InputList<string>? _tagKeys;
+
+        /// <summary>
+        /// Tag keys that must match between the principal and the resources.
+        /// </summary>
+        public InputList<string> TagKeys
+        {
+            get => _tagKeys ?? (_tagKeys = new InputList<string>());
+            set => _tagKeys = value;
+        }
+
+        [Input("tags")]
+        private InputMap<string>? _tags;
+
+        /// <summary>
+        /// A map of tags to add.
+        /// </summary>
+        public InputMap<string> Tags
+        {
+            get => _tags ?? (_tags = new InputMap<string>());
+            set => _tags = value;
+        }
+
+        public AbacPolicyArgs()
+        {
+            AllowTagModification = false;
+            Description = "Attribute-based access control policy";
+            Path = "/";
+        }
+        public static new AbacPolicyArgs Empty => new AbacPolicyArgs();
+    }
+}
@@ -10,10 +10,12 @@ using Pulumi.Serialization;
 namespace Pulumi.AwsIam
 {
     /// <summary>
-    /// This resource helps you manage an Iam Account's Alias and Password Policy. If your IAM Account Alias was previously
-    /// set (either via the AWS console or when AWS created your Account) you will see an error like the below:
+    /// This resource helps you manage an Iam Account's Alias and Password Policy. Both are optional, only the ones
+    /// provided will be managed. If your IAM Account Alias was previously set (either via the AWS console or when
+    /// AWS created your Account) you will see an error like the below:
     /// 
-    /// If you want to manage you Alias using Pulumi you will need to import this resource.
+    /// If you want to manage you Alias using Pulumi you will need to import this resource, which can be done by
+    /// setting `importAccountAlias`. An existing password policy can be adopted the same way with `importPasswordPolicy`.
     /// 
     /// ## Example Usage
     /// ## Account
@@ -88,7 +90,7 @@ namespace Pulumi.AwsIam
         /// <param name="name">The unique name of the resource</param>
         /// <param name="args">The arguments used to populate this resource's properties</param>
         /// <param name="options">A bag of options that control this resource's behavior</param>
-        public Account(string name, AccountArgs args, ComponentResourceOptions? options = null)
+        public Account(string name, AccountArgs? args = null, ComponentResourceOptions? options = null)
             : base("aws-iam:index:Account", name, args ?? new AccountArgs(), MakeResourceOptions(options, ""), remote: true)
         {
         }
@@ -109,20 +111,43 @@ namespace Pulumi.AwsIam
     public sealed class AccountArgs : global::Pulumi.ResourceArgs
     {
         /// <summary>
-        /// AWS IAM account alias for this account.
+        /// AWS IAM account alias for this account. If not set, the account alias is not managed.
         /// </summary>
-        [Input("accountAlias", required: true)]
-        public Input<string> AccountAlias { get; set; } = null!;
+        [Input("accountAlias")]
+        public Input<string>? AccountAlias { get; set; }
+
+        /// <summary>
+        /// Whether to adopt an account alias that already exists with the same name instead of creating it.
+        /// </summary>
+        [Input("importAccountAlias")]
+        public Input<bool>? ImportAccountAlias { get; set; }
+
+        /// <summary>
+        /// Whether to adopt the password policy that already exists in the account instead of creating it. The
+        /// provided settings must match the existing policy for the import to succeed.
+        /// </summary>
+        [Input("importPasswordPolicy")]
+        public Input<bool>? ImportPasswordPolicy { get; set; }
 
         /// <summary>
         /// Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords. If
         /// left empty the default AWS password policy will be applied.
         /// </summary>
-        [Input("passwordPolicy", required: true)]
-        public Input<Inputs.AccountPasswordPolicyArgs> PasswordPolicy { get; set; } = null!;
+        [Input("passwordPolicy")]
+        public Input<Inputs.AccountPasswordPolicyArgs>? PasswordPolicy { get; set; }
+
+        /// <summary>
+        /// Name of a predefined password policy to apply. Valid values are `cis`, which follows the CIS AWS Foundations
+        /// Benchmark (minimum length of 14, 24 passwords remembered, passwords expire after 90 days). Settings
+        /// provided in `passwordPolicy` take precedence over the preset, e.g. `requireSymbols: false`.
+        /// </summary>
+        [Input("passwordPolicyPreset")]
+        public Input<string>? PasswordPolicyPreset { get; set; }
 
         public AccountArgs()
         {
+            ImportAccountAlias = false;
+            ImportPasswordPolicy = false;
         }
         public static new AccountArgs Empty => new AccountArgs();
     }
@@ -48,12 +48,30 @@ namespace Pulumi.AwsIam
     [AwsIamResourceType("aws-iam:index:AssumableRole")]
     public partial class AssumableRole : global::Pulumi.ComponentResource
     {
+        /// <summary>
+        /// AWS CLI config with a profile per role, in INI format.
+        /// </summary>
+        [Output("awsConfig")]
+        public Output<string> AwsConfig { get; private set; } = null!;
+
+        /// <summary>
+        /// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+        /// </summary>
+        [Output("awsVaultConfig")]
+        public Output<string> AwsVaultConfig { get; private set; } = null!;
+
         [Output("instanceProfile")]
         public Output<ImmutableDictionary<string, string>> InstanceProfile { get; private set; } = null!;
 
         [Output("role")]
         public Output<ImmutableDictionary<string, string>> Role { get; private set; } = null!;
 
+        /// <summary>
+        /// Session policy and AWS CLI / SDK snippets assuming the role.
+        /// </summary>
+        [Output("session")]
+        public Output<Outputs.RoleSessionHelpers> Session { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a AssumableRole resource with the given unique name, arguments, and options.
@@ -82,6 +100,12 @@ namespace Pulumi.AwsIam
 
     public sealed class AssumableRoleArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// Whether trusted entities are allowed to pass session tags (`sts:TagSession`) when assuming the role.
+        /// </summary>
+        [Input("allowSessionTags")]
+        public Input<bool>? AllowSessionTags { get; set; }
+
         /// <summary>
         /// Whether to attach an admin policy to a role.
         /// </summary>
@@ -100,6 +124,18 @@ namespace Pulumi.AwsIam
         [Input("attachReadonlyPolicy")]
         public Input<bool>? AttachReadonlyPolicy { get; set; }
 
+        /// <summary>
+        /// Credentials assuming the roles in the rendered AWS CLI config.
+        /// </summary>
+        [Input("awsConfigSource")]
+        public Input<Inputs.AWSConfigSourceArgs>? AwsConfigSource { get; set; }
+
+        /// <summary>
+        /// Whether to create an IAM instance profile for the role.
+        /// </summary>
+        [Input("createInstanceProfile")]
+        public Input<bool>? CreateInstanceProfile { get; set; }
+
         /// <summary>
         /// A custom role trust policy.
         /// </summary>
@@ -112,6 +148,12 @@ namespace Pulumi.AwsIam
         [Input("forceDetachPolicies")]
         public Input<bool>? ForceDetachPolicies { get; set; }
 
+        /// <summary>
+        /// IAM instance profile created when `createInstanceProfile` is set.
+        /// </summary>
+        [Input("instanceProfile")]
+        public Input<Inputs.InstanceProfileSettingsArgs>? InstanceProfile { get; set; }
+
         /// <summary>
         /// Maximum CLI/API session duration in seconds between 3600 and 43200.
         /// </summary>
@@ -134,7 +176,7 @@ namespace Pulumi.AwsIam
         private InputList<string>? _roleStsExternalIds;
 
         /// <summary>
-        /// STS ExternalId condition values to use with a role (when MFA is not required).
+        /// STS ExternalId condition values to use with a role. Combined with the MFA conditions when MFA is required.
         /// </summary>
         public InputList<string> RoleStsExternalIds
         {
@@ -142,6 +184,24 @@ namespace Pulumi.AwsIam
             set => _roleStsExternalIds = value;
         }
 
+        /// <summary>
+        /// Settings of the session helpers rendered for the role.
+        /// </summary>
+        [Input("session")]
+        public Input<Inputs.RoleSessionArgs>? Session { get; set; }
+
+        [Input("sessionTagKeys")]
+        private InputList<string>? _sessionTagKeys;
+
+        /// <summary>
+        /// Tag keys that trusted entities are allowed to pass as session tags.
+        /// </summary>
+        public InputList<string> SessionTagKeys
+        {
+            get => _sessionTagKeys ?? (_sessionTagKeys = new InputList<string>());
+            set => _sessionTagKeys = value;
+        }
+
         [Input("tags")]
         private InputMap<string>? _tags;
 
@@ -154,6 +214,18 @@ namespace Pulumi.AwsIam
             set => _tags = value;
         }
 
+        [Input("trustConditions")]
+        private InputList<Inputs.PolicyConditionArgs>? _trustConditions;
+
+        /// <summary>
+        /// Additional conditions to add to the role trust policy.
+        /// </summary>
+        public InputList<Inputs.PolicyConditionArgs> TrustConditions
+        {
+            get => _trustConditions ?? (_trustConditions = new InputList<Inputs.PolicyConditionArgs>());
+            set => _trustConditions = value;
+        }
+
         [Input("trustedRoleActions")]
         private InputList<string>? _trustedRoleActions;
 
@@ -182,7 +254,7 @@ namespace Pulumi.AwsIam
         private InputList<string>? _trustedRoleServices;
 
         /// <summary>
-        /// AWS Services that can assume these roles.
+        /// AWS Services that can assume these roles. They are trusted without the MFA and ExternalId conditions.
         /// </summary>
         public InputList<string> TrustedRoleServices
         {
@@ -192,9 +264,11 @@ namespace Pulumi.AwsIam
 
         public AssumableRoleArgs()
         {
+            AllowSessionTags = false;
             AttachAdminPolicy = false;
             AttachPoweruserPolicy = false;
             AttachReadonlyPolicy = false;
+            CreateInstanceProfile = true;
             CustomRoleTrustPolicy = "";
             ForceDetachPolicies = false;
             MaxSessionDuration = 3600;
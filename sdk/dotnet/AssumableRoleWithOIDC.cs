@@ -69,6 +69,12 @@ namespace Pulumi.AwsIam
         [Output("path")]
         public Output<string> Path { get; private set; } = null!;
 
+        /// <summary>
+        /// Session policy and AWS CLI / SDK snippets assuming the role.
+        /// </summary>
+        [Output("session")]
+        public Output<Outputs.RoleSessionHelpers> Session { get; private set; } = null!;
+
         /// <summary>
         /// Unique ID of IAM role.
         /// </summary>
@@ -103,6 +109,12 @@ namespace Pulumi.AwsIam
 
     public sealed class AssumableRoleWithOIDCArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// Whether the OIDC provider is allowed to pass session tags (`sts:TagSession`).
+        /// </summary>
+        [Input("allowSessionTags")]
+        public Input<bool>? AllowSessionTags { get; set; }
+
         /// <summary>
         /// The AWS account ID where the OIDC provider lives, leave empty to use the account for the AWS provider.
         /// </summary>
@@ -169,12 +181,43 @@ namespace Pulumi.AwsIam
             set => _providerUrls = value;
         }
 
+        [Input("providers")]
+        private InputList<Inputs.AssumableRoleWithOIDCProviderArgs>? _providers;
+
+        /// <summary>
+        /// OIDC Providers with their own account, subjects and audiences. Used together with `providerUrls`,
+        /// all providers become statements of the same trust policy.
+        /// </summary>
+        public InputList<Inputs.AssumableRoleWithOIDCProviderArgs> Providers
+        {
+            get => _providers ?? (_providers = new InputList<Inputs.AssumableRoleWithOIDCProviderArgs>());
+            set => _providers = value;
+        }
+
         /// <summary>
         /// The IAM role.
         /// </summary>
         [Input("role")]
         public Input<Inputs.RoleArgs>? Role { get; set; }
 
+        /// <summary>
+        /// Settings of the session helpers rendered for the role.
+        /// </summary>
+        [Input("session")]
+        public Input<Inputs.RoleSessionArgs>? Session { get; set; }
+
+        [Input("sessionTagKeys")]
+        private InputList<string>? _sessionTagKeys;
+
+        /// <summary>
+        /// Tag keys that the OIDC provider is allowed to pass as session tags.
+        /// </summary>
+        public InputList<string> SessionTagKeys
+        {
+            get => _sessionTagKeys ?? (_sessionTagKeys = new InputList<string>());
+            set => _sessionTagKeys = value;
+        }
+
         [Input("tags")]
         private InputMap<string>? _tags;
 
@@ -189,6 +232,7 @@ namespace Pulumi.AwsIam
 
         public AssumableRoleWithOIDCArgs()
         {
+            AllowSessionTags = false;
             AwsAccountId = "";
             ForceDetachPolicies = false;
             MaxSessionDuration = 3600;
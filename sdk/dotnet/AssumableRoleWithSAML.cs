@@ -103,12 +103,37 @@ namespace Pulumi.AwsIam
 
     public sealed class AssumableRoleWithSAMLArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+        /// </summary>
+        [Input("allowSessionTags")]
+        public Input<bool>? AllowSessionTags { get; set; }
+
+        /// <summary>
+        /// Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
+        /// </summary>
+        [Input("allowSourceIdentity")]
+        public Input<bool>? AllowSourceIdentity { get; set; }
+
         /// <summary>
         /// AWS SAML Endpoint.
         /// </summary>
         [Input("awsSamlEndpoint")]
         public Input<string>? AwsSamlEndpoint { get; set; }
 
+        [Input("awsSamlEndpoints")]
+        private InputList<string>? _awsSamlEndpoints;
+
+        /// <summary>
+        /// List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+        /// `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
+        /// </summary>
+        public InputList<string> AwsSamlEndpoints
+        {
+            get => _awsSamlEndpoints ?? (_awsSamlEndpoints = new InputList<string>());
+            set => _awsSamlEndpoints = value;
+        }
+
         /// <summary>
         /// Whether policies should be detached from this role when destroying.
         /// </summary>
@@ -121,6 +146,19 @@ namespace Pulumi.AwsIam
         [Input("maxSessionDuration")]
         public Input<int>? MaxSessionDuration { get; set; }
 
+        [Input("principalTags")]
+        private InputMap<ImmutableArray<string>>? _principalTags;
+
+        /// <summary>
+        /// Map of session tag keys passed by the IdP to the values allowed to assume the role, matched as
+        /// `aws:RequestTag/&lt;key&gt;`. Implies `allowSessionTags`.
+        /// </summary>
+        public InputMap<ImmutableArray<string>> PrincipalTags
+        {
+            get => _principalTags ?? (_principalTags = new InputMap<ImmutableArray<string>>());
+            set => _principalTags = value;
+        }
+
         [Input("providerIds")]
         private InputList<string>? _providerIds;
 
@@ -136,6 +174,55 @@ namespace Pulumi.AwsIam
         [Input("role")]
         public Input<Inputs.RoleArgs>? Role { get; set; }
 
+        [Input("samlAttributes")]
+        private InputMap<ImmutableArray<string>>? _samlAttributes;
+
+        /// <summary>
+        /// Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the role.
+        /// Attributes can be multivalued, a match on any of the values is enough.
+        /// </summary>
+        public InputMap<ImmutableArray<string>> SamlAttributes
+        {
+            get => _samlAttributes ?? (_samlAttributes = new InputMap<ImmutableArray<string>>());
+            set => _samlAttributes = value;
+        }
+
+        [Input("samlIssuers")]
+        private InputList<string>? _samlIssuers;
+
+        /// <summary>
+        /// Values of `SAML:iss` allowed to assume the role.
+        /// </summary>
+        public InputList<string> SamlIssuers
+        {
+            get => _samlIssuers ?? (_samlIssuers = new InputList<string>());
+            set => _samlIssuers = value;
+        }
+
+        [Input("samlSubjects")]
+        private InputList<string>? _samlSubjects;
+
+        /// <summary>
+        /// Values of `SAML:sub` allowed to assume the role.
+        /// </summary>
+        public InputList<string> SamlSubjects
+        {
+            get => _samlSubjects ?? (_samlSubjects = new InputList<string>());
+            set => _samlSubjects = value;
+        }
+
+        [Input("sessionTagKeys")]
+        private InputList<string>? _sessionTagKeys;
+
+        /// <summary>
+        /// Tag keys that the IdP is allowed to pass as session tags.
+        /// </summary>
+        public InputList<string> SessionTagKeys
+        {
+            get => _sessionTagKeys ?? (_sessionTagKeys = new InputList<string>());
+            set => _sessionTagKeys = value;
+        }
+
         [Input("tags")]
         private InputMap<string>? _tags;
 
@@ -148,8 +235,22 @@ namespace Pulumi.AwsIam
             set => _tags = value;
         }
 
+        [Input("trustConditions")]
+        private InputList<Inputs.PolicyConditionArgs>? _trustConditions;
+
+        /// <summary>
+        /// Additional conditions to add to the trust policy.
+        /// </summary>
+        public InputList<Inputs.PolicyConditionArgs> TrustConditions
+        {
+            get => _trustConditions ?? (_trustConditions = new InputList<Inputs.PolicyConditionArgs>());
+            set => _trustConditions = value;
+        }
+
         public AssumableRoleWithSAMLArgs()
         {
+            AllowSessionTags = false;
+            AllowSourceIdentity = false;
             AwsSamlEndpoint = "https://signin.aws.amazon.com/saml";
             ForceDetachPolicies = false;
             MaxSessionDuration = 3600;
@@ -55,6 +55,24 @@ namespace Pulumi.AwsIam
         [Output("admin")]
         public Output<ImmutableDictionary<string, string>> Admin { get; private set; } = null!;
 
+        /// <summary>
+        /// The `mapRoles` entries of the aws-auth ConfigMap granting the roles access to EKS clusters, as YAML.
+        /// </summary>
+        [Output("awsAuthMapRoles")]
+        public Output<string> AwsAuthMapRoles { get; private set; } = null!;
+
+        /// <summary>
+        /// AWS CLI config with a profile per role, in INI format.
+        /// </summary>
+        [Output("awsConfig")]
+        public Output<string> AwsConfig { get; private set; } = null!;
+
+        /// <summary>
+        /// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+        /// </summary>
+        [Output("awsVaultConfig")]
+        public Output<string> AwsVaultConfig { get; private set; } = null!;
+
         [Output("poweruser")]
         public Output<ImmutableDictionary<string, string>?> Poweruser { get; private set; } = null!;
 
@@ -92,6 +110,18 @@ namespace Pulumi.AwsIam
         [Input("admin", required: true)]
         public Input<Inputs.AdminRoleWithMFAArgs> Admin { get; set; } = null!;
 
+        /// <summary>
+        /// Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+        /// </summary>
+        [Input("awsAuth")]
+        public Input<Inputs.AWSAuthArgs>? AwsAuth { get; set; }
+
+        /// <summary>
+        /// Credentials assuming the roles in the rendered AWS CLI config.
+        /// </summary>
+        [Input("awsConfigSource")]
+        public Input<Inputs.AWSConfigSourceArgs>? AwsConfigSource { get; set; }
+
         /// <summary>
         /// Whether policies should be detached from this role when destroying.
         /// </summary>
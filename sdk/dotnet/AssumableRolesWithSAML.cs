@@ -51,6 +51,24 @@ namespace Pulumi.AwsIam
         [Output("admin")]
         public Output<ImmutableDictionary<string, string>> Admin { get; private set; } = null!;
 
+        /// <summary>
+        /// The `mapRoles` entries of the aws-auth ConfigMap granting the roles access to EKS clusters, as YAML.
+        /// </summary>
+        [Output("awsAuthMapRoles")]
+        public Output<string> AwsAuthMapRoles { get; private set; } = null!;
+
+        /// <summary>
+        /// AWS CLI config with a profile per role, in INI format.
+        /// </summary>
+        [Output("awsConfig")]
+        public Output<string> AwsConfig { get; private set; } = null!;
+
+        /// <summary>
+        /// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+        /// </summary>
+        [Output("awsVaultConfig")]
+        public Output<string> AwsVaultConfig { get; private set; } = null!;
+
         [Output("poweruser")]
         public Output<ImmutableDictionary<string, string>?> Poweruser { get; private set; } = null!;
 
@@ -88,12 +106,50 @@ namespace Pulumi.AwsIam
         [Input("admin")]
         public Input<Inputs.AdminRoleArgs>? Admin { get; set; }
 
+        /// <summary>
+        /// Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+        /// </summary>
+        [Input("allowSessionTags")]
+        public Input<bool>? AllowSessionTags { get; set; }
+
+        /// <summary>
+        /// Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
+        /// </summary>
+        [Input("allowSourceIdentity")]
+        public Input<bool>? AllowSourceIdentity { get; set; }
+
+        /// <summary>
+        /// Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+        /// </summary>
+        [Input("awsAuth")]
+        public Input<Inputs.AWSAuthArgs>? AwsAuth { get; set; }
+
+        /// <summary>
+        /// Credentials assuming the roles in the rendered AWS CLI config. Only a `credentialProcess` with a `{role_arn}`
+        /// placeholder can sign in to the roles, the AWS CLI config is empty without it.
+        /// </summary>
+        [Input("awsConfigSource")]
+        public Input<Inputs.AWSConfigSourceArgs>? AwsConfigSource { get; set; }
+
         /// <summary>
         /// AWS SAML Endpoint.
         /// </summary>
         [Input("awsSamlEndpoint")]
         public Input<string>? AwsSamlEndpoint { get; set; }
 
+        [Input("awsSamlEndpoints")]
+        private InputList<string>? _awsSamlEndpoints;
+
+        /// <summary>
+        /// List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+        /// `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
+        /// </summary>
+        public InputList<string> AwsSamlEndpoints
+        {
+            get => _awsSamlEndpoints ?? (_awsSamlEndpoints = new InputList<string>());
+            set => _awsSamlEndpoints = value;
+        }
+
         /// <summary>
         /// Whether policies should be detached from this role when destroying.
         /// </summary>
@@ -109,6 +165,19 @@ namespace Pulumi.AwsIam
         [Input("poweruser")]
         public Input<Inputs.PoweruserRoleArgs>? Poweruser { get; set; }
 
+        [Input("principalTags")]
+        private InputMap<ImmutableArray<string>>? _principalTags;
+
+        /// <summary>
+        /// Map of session tag keys passed by the IdP to the values allowed to assume the roles, matched as
+        /// `aws:RequestTag/&lt;key&gt;`. Implies `allowSessionTags`.
+        /// </summary>
+        public InputMap<ImmutableArray<string>> PrincipalTags
+        {
+            get => _principalTags ?? (_principalTags = new InputMap<ImmutableArray<string>>());
+            set => _principalTags = value;
+        }
+
         [Input("providerIds")]
         private InputList<string>? _providerIds;
 
@@ -124,8 +193,71 @@ namespace Pulumi.AwsIam
         [Input("readonly")]
         public Input<Inputs.ReadonlyRoleArgs>? Readonly { get; set; }
 
+        [Input("samlAttributes")]
+        private InputMap<ImmutableArray<string>>? _samlAttributes;
+
+        /// <summary>
+        /// Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the roles.
+        /// Attributes can be multivalued, a match on any of the values is enough.
+        /// </summary>
+        public InputMap<ImmutableArray<string>> SamlAttributes
+        {
+            get => _samlAttributes ?? (_samlAttributes = new InputMap<ImmutableArray<string>>());
+            set => _samlAttributes = value;
+        }
+
+        [Input("samlIssuers")]
+        private InputList<string>? _samlIssuers;
+
+        /// <summary>
+        /// Values of `SAML:iss` allowed to assume the roles.
+        /// </summary>
+        public InputList<string> SamlIssuers
+        {
+            get => _samlIssuers ?? (_samlIssuers = new InputList<string>());
+            set => _samlIssuers = value;
+        }
+
+        [Input("samlSubjects")]
+        private InputList<string>? _samlSubjects;
+
+        /// <summary>
+        /// Values of `SAML:sub` allowed to assume the roles.
+        /// </summary>
+        public InputList<string> SamlSubjects
+        {
+            get => _samlSubjects ?? (_samlSubjects = new InputList<string>());
+            set => _samlSubjects = value;
+        }
+
+        [Input("sessionTagKeys")]
+        private InputList<string>? _sessionTagKeys;
+
+        /// <summary>
+        /// Tag keys that the IdP is allowed to pass as session tags.
+        /// </summary>
+        public InputList<string> SessionTagKeys
+        {
+            get => _sessionTagKeys ?? (_sessionTagKeys = new InputList<string>());
+            set => _sessionTagKeys = value;
+        }
+
+        [Input("trustConditions")]
+        private InputList<Inputs.PolicyConditionArgs>? _trustConditions;
+
+        /// <summary>
+        /// Additional conditions to add to the trust policy.
+        /// </summary>
+        public InputList<Inputs.PolicyConditionArgs> TrustConditions
+        {
+            get => _trustConditions ?? (_trustConditions = new InputList<Inputs.PolicyConditionArgs>());
+            set => _trustConditions = value;
+        }
+
         public AssumableRolesWithSAMLArgs()
         {
+            AllowSessionTags = false;
+            AllowSourceIdentity = false;
             AwsSamlEndpoint = "https://signin.aws.amazon.com/saml";
             ForceDetachPolicies = false;
             MaxSessionDuration = 3600;
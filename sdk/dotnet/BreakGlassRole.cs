@@ -0,0 +1,197 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam
+{
+    /// <summary>
+    /// This resource helps you create a break-glass role for emergency access. The role has AdministratorAccess,
+    /// can only be assumed by the trusted principals with a recent MFA sign-in and optionally from specific source
+    /// IPs, and is tagged with `BreakGlass: true`. An EventBridge rule sends an alert to an SNS topic whenever
+    /// the role is assumed, so its usage is always noticed.
+    /// 
+    /// The rule matches the AssumeRole events CloudTrail delivers to EventBridge in the region the resource is
+    /// deployed to. AssumeRole calls made to the global STS endpoint, including console role switches, are
+    /// delivered in us-east-1. Outside of us-east-1 a second rule forwards them from us-east-1 to the default
+    /// event bus of the region. It is created with the `aws` provider for us-east-1 passed as
+    /// `globalEventsProvider`, which is required outside of us-east-1.
+    /// 
+    /// ## Example Usage
+    /// ## Break Glass Role
+    /// 
+    /// ```csharp
+    /// using Pulumi;
+    /// using Pulumi.AwsIam;
+    /// 
+    /// class MyStack : Stack
+    /// {
+    ///     public MyStack()
+    ///     {
+    ///         var breakGlassRole = new BreakGlassRole("break-glass-role", new BreakGlassRoleArgs
+    ///         {
+    ///             TrustedRoleArns = {"arn:aws:iam::111111111111:user/oncall"},
+    ///             SourceIps = {"203.0.113.0/24"},
+    ///             AlertEmails = {"security@example.com"},
+    ///         });
+    /// 
+    ///         this.BreakGlassRole = Output.Create&lt;BreakGlassRole&gt;(breakGlassRole);
+    ///     }
+    /// 
+    ///     [Output]
+    ///     public Output&lt;BreakGlassRole&gt; BreakGlassRole { get; set; }
+    /// }
+    /// ```
+    /// {{ /example }}
+    /// </summary>
+    [AwsIamResourceType("aws-iam:index:BreakGlassRole")]
+    public partial class BreakGlassRole : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// ARN of the SNS topic receiving the alerts.
+        /// </summary>
+        [Output("alertTopicArn")]
+        public Output<string> AlertTopicArn { get; private set; } = null!;
+
+        /// <summary>
+        /// EventBridge event pattern matching AssumeRole calls into the role.
+        /// </summary>
+        [Output("eventPattern")]
+        public Output<string> EventPattern { get; private set; } = null!;
+
+        /// <summary>
+        /// ARN of the EventBridge rule alerting on AssumeRole calls into the role. Empty when only the event pattern is output.
+        /// Outside of us-east-1 a second rule forwards the calls to the global STS endpoint from us-east-1 to this rule.
+        /// </summary>
+        [Output("eventRuleArn")]
+        public Output<string> EventRuleArn { get; private set; } = null!;
+
+        [Output("role")]
+        public Output<ImmutableDictionary<string, string>> Role { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a BreakGlassRole resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public BreakGlassRole(string name, BreakGlassRoleArgs args, ComponentResourceOptions? options = null)
+            : base("aws-iam:index:BreakGlassRole", name, args ?? new BreakGlassRoleArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class BreakGlassRoleArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("alertEmails")]
+        private InputList<string>? _alertEmails;
+
+        /// <summary>
+        /// Email addresses subscribed to the alert topic.
+        /// </summary>
+        public InputList<string> AlertEmails
+        {
+            get => _alertEmails ?? (_alertEmails = new InputList<string>());
+            set => _alertEmails = value;
+        }
+
+        /// <summary>
+        /// ARN of an existing SNS topic to send alerts to. A topic is created when not set.
+        /// </summary>
+        [Input("alertTopicArn")]
+        public Input<string>? AlertTopicArn { get; set; }
+
+        /// <summary>
+        /// Whether to only output the EventBridge event pattern instead of creating the rule and SNS topic.
+        /// </summary>
+        [Input("eventPatternOnly")]
+        public Input<bool>? EventPatternOnly { get; set; }
+
+        /// <summary>
+        /// Explicit `aws` provider for us-east-1, used to forward the calls to the global STS endpoint to the
+        /// region of the role. Required outside of us-east-1, unless only the event pattern is output.
+        /// </summary>
+        [Input("globalEventsProvider")]
+        public Input<object>? GlobalEventsProvider { get; set; }
+
+        /// <summary>
+        /// Maximum CLI/API session duration in seconds between 3600 and 43200.
+        /// </summary>
+        [Input("maxSessionDuration")]
+        public Input<int>? MaxSessionDuration { get; set; }
+
+        /// <summary>
+        /// Max age of valid MFA (in seconds) when assuming the role.
+        /// </summary>
+        [Input("mfaAge")]
+        public Input<int>? MfaAge { get; set; }
+
+        /// <summary>
+        /// The IAM role. Its name defaults to `break-glass`.
+        /// </summary>
+        [Input("role")]
+        public Input<Inputs.RoleArgs>? Role { get; set; }
+
+        [Input("sourceIps")]
+        private InputList<string>? _sourceIps;
+
+        /// <summary>
+        /// Source IP ranges the role can be assumed from. Any source IP is allowed when empty.
+        /// </summary>
+        public InputList<string> SourceIps
+        {
+            get => _sourceIps ?? (_sourceIps = new InputList<string>());
+            set => _sourceIps = value;
+        }
+
+        [Input("tags")]
+        private InputMap<string>? _tags;
+
+        /// <summary>
+        /// A map of tags to add. The role is also tagged with `BreakGlass` set to `true`.
+        /// </summary>
+        public InputMap<string> Tags
+        {
+            get => _tags ?? (_tags = new InputMap<string>());
+            set => _tags = value;
+        }
+
+        [Input("trustedRoleArns", required: true)]
+        private InputList<string>? _trustedRoleArns;
+
+        /// <summary>
+        /// ARNs of the principals allowed to assume the role.
+        /// </summary>
+        public InputList<string> TrustedRoleArns
+        {
+            get => _trustedRoleArns ?? (_trustedRoleArns = new InputList<string>());
+            set => _trustedRoleArns = value;
+        }
+
+        public BreakGlassRoleArgs()
+        {
+            EventPatternOnly = false;
+            MaxSessionDuration = 3600;
+            MfaAge = 900;
+        }
+        public static new BreakGlassRoleArgs Empty => new BreakGlassRoleArgs();
+    }
+}
@@ -0,0 +1,162 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam
+{
+    /// <summary>
+    /// This resource helps you create the IAM policy of a single EKS add-on, the same policy `RoleForServiceAccountsEks`
+    /// attaches for it, without creating a role. The policy can then be attached to node roles, EKS Pod Identity
+    /// roles or roles managed elsewhere.
+    /// 
+    /// ## Example Usage
+    /// ## EKS Add-on Policy
+    /// 
+    /// ```csharp
+    /// using Pulumi;
+    /// using Pulumi.AwsIam;
+    /// using Pulumi.AwsIam.Inputs;
+    /// 
+    /// class MyStack : Stack
+    /// {
+    ///     public MyStack()
+    ///     {
+    ///         var veleroPolicy = new EKSAddonPolicy("velero-policy", new EKSAddonPolicyArgs
+    ///         {
+    ///             Addon = "velero",
+    ///             Policies = new EKSRolePoliciesArgs
+    ///             {
+    ///                 Velero = new EKSVeleroPolicyArgs
+    ///                 {
+    ///                     S3BucketArns = {"arn:aws:s3:::velero-backups"},
+    ///                 },
+    ///             },
+    ///         });
+    /// 
+    ///         this.VeleroPolicy = Output.Create&lt;EKSAddonPolicy&gt;(veleroPolicy);
+    ///     }
+    /// 
+    ///     [Output]
+    ///     public Output&lt;EKSAddonPolicy&gt; VeleroPolicy { get; set; }
+    /// }
+    /// ```
+    /// {{ /example }}
+    /// </summary>
+    [AwsIamResourceType("aws-iam:index:EKSAddonPolicy")]
+    public partial class EKSAddonPolicy : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// The ARN assigned by AWS to this policy.
+        /// </summary>
+        [Output("arn")]
+        public Output<string> Arn { get; private set; } = null!;
+
+        /// <summary>
+        /// The description of the policy.
+        /// </summary>
+        [Output("description")]
+        public Output<string> Description { get; private set; } = null!;
+
+        /// <summary>
+        /// The policy's ID.
+        /// </summary>
+        [Output("id")]
+        public Output<string> Id { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the policy.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The path of the policy in IAM.
+        /// </summary>
+        [Output("path")]
+        public Output<string> Path { get; private set; } = null!;
+
+        /// <summary>
+        /// Policy document as json.
+        /// </summary>
+        [Output("policyJson")]
+        public Output<string> PolicyJson { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a EKSAddonPolicy resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public EKSAddonPolicy(string name, EKSAddonPolicyArgs args, ComponentResourceOptions? options = null)
+            : base("aws-iam:index:EKSAddonPolicy", name, args ?? new EKSAddonPolicyArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class EKSAddonPolicyArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Name of the add-on to create the policy for, matching its key in `policies`, e.g. `velero` or
+        /// `karpenterController`.
+        /// </summary>
+        [Input("addon", required: true)]
+        public Input<string> Addon { get; set; } = null!;
+
+        /// <summary>
+        /// The path of the policy in IAM.
+        /// </summary>
+        [Input("path")]
+        public Input<string>? Path { get; set; }
+
+        /// <summary>
+        /// Arguments of the add-ons. Only the arguments of the selected add-on are used, its `attach` flag is
+        /// implied. Add-ons with several policies, e.g. `loadBalancer`, must select exactly one of them.
+        /// </summary>
+        [Input("policies", required: true)]
+        public Input<Inputs.EKSRolePoliciesArgs> Policies { get; set; } = null!;
+
+        /// <summary>
+        /// IAM policy name prefix.
+        /// </summary>
+        [Input("policyNamePrefix")]
+        public Input<string>? PolicyNamePrefix { get; set; }
+
+        [Input("tags")]
+        private InputMap<string>? _tags;
+
+        /// <summary>
+        /// A map of tags to add.
+        /// </summary>
+        public InputMap<string> Tags
+        {
+            get => _tags ?? (_tags = new InputMap<string>());
+            set => _tags = value;
+        }
+
+        public EKSAddonPolicyArgs()
+        {
+            Path = "/";
+            PolicyNamePrefix = "AmazonEKS_";
+        }
+        public static new EKSAddonPolicyArgs Empty => new EKSAddonPolicyArgs();
+    }
+}
@@ -0,0 +1,151 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam
+{
+    /// <summary>
+    /// This resource helps you create the IAM role an EKS cluster uses to manage AWS resources on your behalf.
+    /// The role trusts `eks.amazonaws.com` and has the `AmazonEKSClusterPolicy` attached, optionally together
+    /// with the `AmazonEKSVPCResourceController` policy and the policies needed by EKS Auto Mode.
+    /// 
+    /// ## Example Usage
+    /// ## EKS Cluster Role
+    /// 
+    /// ```csharp
+    /// using Pulumi;
+    /// using Pulumi.AwsIam;
+    /// using Pulumi.AwsIam.Inputs;
+    /// 
+    /// class MyStack : Stack
+    /// {
+    ///     public MyStack()
+    ///     {
+    ///         var clusterRole = new EKSClusterRole("eks-cluster-role", new EKSClusterRoleArgs
+    ///         {
+    ///             Role = new RoleArgs
+    ///             {
+    ///                 Name = "eks-cluster",
+    ///             },
+    ///             AttachVpcResourceControllerPolicy = true,
+    ///             EnableAutoMode = true,
+    ///         });
+    /// 
+    ///         this.ClusterRole = Output.Create&lt;EKSClusterRole&gt;(clusterRole);
+    ///     }
+    /// 
+    ///     [Output]
+    ///     public Output&lt;EKSClusterRole&gt; ClusterRole { get; set; }
+    /// }
+    /// ```
+    /// {{ /example }}
+    /// </summary>
+    [AwsIamResourceType("aws-iam:index:EKSClusterRole")]
+    public partial class EKSClusterRole : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// ARN of IAM role.
+        /// </summary>
+        [Output("arn")]
+        public Output<string> Arn { get; private set; } = null!;
+
+        /// <summary>
+        /// Name of IAM role.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// Path of IAM role.
+        /// </summary>
+        [Output("path")]
+        public Output<string> Path { get; private set; } = null!;
+
+        /// <summary>
+        /// Unique ID of IAM role.
+        /// </summary>
+        [Output("uniqueId")]
+        public Output<string> UniqueId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a EKSClusterRole resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public EKSClusterRole(string name, EKSClusterRoleArgs? args = null, ComponentResourceOptions? options = null)
+            : base("aws-iam:index:EKSClusterRole", name, args ?? new EKSClusterRoleArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class EKSClusterRoleArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether to attach the AmazonEKSVPCResourceController policy, needed for security groups for pods.
+        /// </summary>
+        [Input("attachVpcResourceControllerPolicy")]
+        public Input<bool>? AttachVpcResourceControllerPolicy { get; set; }
+
+        /// <summary>
+        /// Whether to attach the policies needed by EKS Auto Mode and allow EKS to tag its sessions.
+        /// </summary>
+        [Input("enableAutoMode")]
+        public Input<bool>? EnableAutoMode { get; set; }
+
+        /// <summary>
+        /// Whether policies should be detached from this role when destroying.
+        /// </summary>
+        [Input("forceDetachPolicies")]
+        public Input<bool>? ForceDetachPolicies { get; set; }
+
+        /// <summary>
+        /// Maximum CLI/API session duration in seconds between 3600 and 43200.
+        /// </summary>
+        [Input("maxSessionDuration")]
+        public Input<int>? MaxSessionDuration { get; set; }
+
+        [Input("role")]
+        public Input<Inputs.RoleArgs>? Role { get; set; }
+
+        [Input("tags")]
+        private InputMap<string>? _tags;
+
+        /// <summary>
+        /// A map of tags to add.
+        /// </summary>
+        public InputMap<string> Tags
+        {
+            get => _tags ?? (_tags = new InputMap<string>());
+            set => _tags = value;
+        }
+
+        public EKSClusterRoleArgs()
+        {
+            AttachVpcResourceControllerPolicy = false;
+            EnableAutoMode = false;
+            ForceDetachPolicies = false;
+            MaxSessionDuration = 3600;
+        }
+        public static new EKSClusterRoleArgs Empty => new EKSClusterRoleArgs();
+    }
+}
@@ -0,0 +1,173 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam
+{
+    /// <summary>
+    /// This resource helps you create the IAM role of EKS nodes. Depending on `type` the role is for:
+    /// 
+    /// - `node`: managed and self-managed node groups. The role trusts EC2, has the worker node, CNI and ECR
+    ///   read only policies attached and gets an instance profile.
+    /// - `karpenter`: nodes launched by Karpenter. Same as `node` plus the SSM policy, without an instance
+    ///   profile since Karpenter creates those itself.
+    /// - `fargate`: the pod execution role of Fargate profiles, optionally restricted to the profiles of one cluster.
+    /// 
+    /// ## Example Usage
+    /// ## EKS Node Role
+    /// 
+    /// ```csharp
+    /// using Pulumi;
+    /// using Pulumi.AwsIam;
+    /// using Pulumi.AwsIam.Inputs;
+    /// 
+    /// class MyStack : Stack
+    /// {
+    ///     public MyStack()
+    ///     {
+    ///         var nodeRole = new EKSNodeRole("eks-node-role", new EKSNodeRoleArgs
+    ///         {
+    ///             Role = new RoleArgs
+    ///             {
+    ///                 Name = "eks-node",
+    ///             },
+    ///             AttachSsmPolicy = true,
+    ///         });
+    /// 
+    ///         this.NodeRole = Output.Create&lt;EKSNodeRole&gt;(nodeRole);
+    ///     }
+    /// 
+    ///     [Output]
+    ///     public Output&lt;EKSNodeRole&gt; NodeRole { get; set; }
+    /// }
+    /// ```
+    /// {{ /example }}
+    /// </summary>
+    [AwsIamResourceType("aws-iam:index:EKSNodeRole")]
+    public partial class EKSNodeRole : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// ARN of IAM role.
+        /// </summary>
+        [Output("arn")]
+        public Output<string> Arn { get; private set; } = null!;
+
+        /// <summary>
+        /// IAM instance profile, only created for `node` roles.
+        /// </summary>
+        [Output("instanceProfile")]
+        public Output<ImmutableDictionary<string, string>> InstanceProfile { get; private set; } = null!;
+
+        /// <summary>
+        /// Name of IAM role.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// Path of IAM role.
+        /// </summary>
+        [Output("path")]
+        public Output<string> Path { get; private set; } = null!;
+
+        /// <summary>
+        /// Unique ID of IAM role.
+        /// </summary>
+        [Output("uniqueId")]
+        public Output<string> UniqueId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a EKSNodeRole resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public EKSNodeRole(string name, EKSNodeRoleArgs? args = null, ComponentResourceOptions? options = null)
+            : base("aws-iam:index:EKSNodeRole", name, args ?? new EKSNodeRoleArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class EKSNodeRoleArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether to attach the AmazonSSMManagedInstanceCore policy. Always attached for `karpenter` roles.
+        /// </summary>
+        [Input("attachSsmPolicy")]
+        public Input<bool>? AttachSsmPolicy { get; set; }
+
+        /// <summary>
+        /// Name of the cluster whose Fargate profiles can assume a `fargate` role. Defaults to all clusters of the account.
+        /// </summary>
+        [Input("clusterName")]
+        public Input<string>? ClusterName { get; set; }
+
+        /// <summary>
+        /// Whether to leave out the AmazonEKS_CNI_Policy, e.g. when the VPC CNI uses its own IRSA role.
+        /// </summary>
+        [Input("excludeCniPolicy")]
+        public Input<bool>? ExcludeCniPolicy { get; set; }
+
+        /// <summary>
+        /// Whether policies should be detached from this role when destroying.
+        /// </summary>
+        [Input("forceDetachPolicies")]
+        public Input<bool>? ForceDetachPolicies { get; set; }
+
+        /// <summary>
+        /// Maximum CLI/API session duration in seconds between 3600 and 43200.
+        /// </summary>
+        [Input("maxSessionDuration")]
+        public Input<int>? MaxSessionDuration { get; set; }
+
+        [Input("role")]
+        public Input<Inputs.RoleArgs>? Role { get; set; }
+
+        [Input("tags")]
+        private InputMap<string>? _tags;
+
+        /// <summary>
+        /// A map of tags to add.
+        /// </summary>
+        public InputMap<string> Tags
+        {
+            get => _tags ?? (_tags = new InputMap<string>());
+            set => _tags = value;
+        }
+
+        /// <summary>
+        /// Type of the role, one of `node`, `karpenter` or `fargate`.
+        /// </summary>
+        [Input("type")]
+        public Input<string>? Type { get; set; }
+
+        public EKSNodeRoleArgs()
+        {
+            AttachSsmPolicy = false;
+            ExcludeCniPolicy = false;
+            ForceDetachPolicies = false;
+            MaxSessionDuration = 3600;
+            Type = "node";
+        }
+        public static new EKSNodeRoleArgs Empty => new EKSNodeRoleArgs();
+    }
+}
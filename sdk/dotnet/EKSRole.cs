@@ -88,6 +88,12 @@ namespace Pulumi.AwsIam
         [Output("path")]
         public Output<string> Path { get; private set; } = null!;
 
+        /// <summary>
+        /// Annotations and ServiceAccount manifests binding each ServiceAccount to the role.
+        /// </summary>
+        [Output("serviceAccounts")]
+        public Output<ImmutableArray<Outputs.EKSServiceAccountManifest>> ServiceAccounts { get; private set; } = null!;
+
         /// <summary>
         /// Unique ID of IAM role.
         /// </summary>
@@ -122,6 +128,12 @@ namespace Pulumi.AwsIam
 
     public sealed class EKSRoleArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+        /// </summary>
+        [Input("allowAllNamespaces")]
+        public Input<bool>? AllowAllNamespaces { get; set; }
+
         [Input("clusterServiceAccounts")]
         private InputList<Inputs.EKSServiceAccountArgs>? _clusterServiceAccounts;
 
@@ -173,6 +185,12 @@ namespace Pulumi.AwsIam
             set => _rolePolicyArns = value;
         }
 
+        /// <summary>
+        /// Annotations added to the rendered ServiceAccount manifests.
+        /// </summary>
+        [Input("serviceAccountManifest")]
+        public Input<Inputs.EKSServiceAccountManifestOptionsArgs>? ServiceAccountManifest { get; set; }
+
         [Input("tags")]
         private InputMap<string>? _tags;
 
@@ -187,6 +205,7 @@ namespace Pulumi.AwsIam
 
         public EKSRoleArgs()
         {
+            AllowAllNamespaces = false;
             ForceDetachPolicies = false;
             MaxSessionDuration = 3600;
         }
@@ -139,6 +139,13 @@ namespace Pulumi.AwsIam
             set => _customGroupPolicyArns = value;
         }
 
+        /// <summary>
+        /// Whether managed policies not declared for the group are detached from it. Not supported for groups
+        /// by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
+        /// </summary>
+        [Input("exclusivePolicyAttachments")]
+        public Input<bool>? ExclusivePolicyAttachments { get; set; }
+
         [Input("groupUsers", required: true)]
         private InputList<string>? _groupUsers;
 
@@ -179,6 +186,7 @@ namespace Pulumi.AwsIam
         {
             AttachIamSelfManagementPolicy = true;
             AwsAccountId = "";
+            ExclusivePolicyAttachments = false;
             IamSelfManagementPolicyNamePrefix = "IAMSelfManagement-";
             Name = "";
         }
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    public sealed class AWSAuthArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Mapping of the admin role.
+        /// </summary>
+        [Input("admin")]
+        public Input<Inputs.AWSAuthRoleArgs>? Admin { get; set; }
+
+        /// <summary>
+        /// Mapping of the poweruser role.
+        /// </summary>
+        [Input("poweruser")]
+        public Input<Inputs.AWSAuthRoleArgs>? Poweruser { get; set; }
+
+        /// <summary>
+        /// Mapping of the readonly role.
+        /// </summary>
+        [Input("readonly")]
+        public Input<Inputs.AWSAuthRoleArgs>? Readonly { get; set; }
+
+        public AWSAuthArgs()
+        {
+        }
+        public static new AWSAuthArgs Empty => new AWSAuthArgs();
+    }
+}
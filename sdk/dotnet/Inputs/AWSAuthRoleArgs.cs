@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    public sealed class AWSAuthRoleArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("groups")]
+        private InputList<string>? _groups;
+
+        /// <summary>
+        /// Kubernetes groups of the role, e.g. `system:masters`.
+        /// </summary>
+        public InputList<string> Groups
+        {
+            get => _groups ?? (_groups = new InputList<string>());
+            set => _groups = value;
+        }
+
+        /// <summary>
+        /// Kubernetes username of the role. Defaults to `&lt;role name&gt;:{{SessionName}}`.
+        /// </summary>
+        [Input("username")]
+        public Input<string>? Username { get; set; }
+
+        public AWSAuthRoleArgs()
+        {
+        }
+        public static new AWSAuthRoleArgs Empty => new AWSAuthRoleArgs();
+    }
+}
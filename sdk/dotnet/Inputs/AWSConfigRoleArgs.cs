@@ -0,0 +1,51 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    public sealed class AWSConfigRoleArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Session duration in seconds.
+        /// </summary>
+        [Input("durationSeconds")]
+        public Input<int>? DurationSeconds { get; set; }
+
+        /// <summary>
+        /// STS ExternalId to pass when assuming the role.
+        /// </summary>
+        [Input("externalId")]
+        public Input<string>? ExternalId { get; set; }
+
+        /// <summary>
+        /// Name of the profile, without the profile prefix.
+        /// </summary>
+        [Input("profileName", required: true)]
+        public Input<string> ProfileName { get; set; } = null!;
+
+        /// <summary>
+        /// Whether the role requires MFA.
+        /// </summary>
+        [Input("requiresMfa")]
+        public Input<bool>? RequiresMfa { get; set; }
+
+        /// <summary>
+        /// ARN of the IAM role.
+        /// </summary>
+        [Input("roleArn", required: true)]
+        public Input<string> RoleArn { get; set; } = null!;
+
+        public AWSConfigRoleArgs()
+        {
+            RequiresMfa = false;
+        }
+        public static new AWSConfigRoleArgs Empty => new AWSConfigRoleArgs();
+    }
+}
@@ -0,0 +1,81 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    public sealed class AWSConfigSourceArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Command printing the credentials which assume the roles. When it contains `{role_arn}` it is run for
+        /// each role with its ARN instead, e.g. to sign in to SAML roles with saml2aws.
+        /// </summary>
+        [Input("credentialProcess")]
+        public Input<string>? CredentialProcess { get; set; }
+
+        /// <summary>
+        /// ARN of the MFA device used for roles requiring MFA.
+        /// </summary>
+        [Input("mfaSerial")]
+        public Input<string>? MfaSerial { get; set; }
+
+        /// <summary>
+        /// Prefix added to the name of each role profile.
+        /// </summary>
+        [Input("profilePrefix")]
+        public Input<string>? ProfilePrefix { get; set; }
+
+        /// <summary>
+        /// Default region of the profiles.
+        /// </summary>
+        [Input("region")]
+        public Input<string>? Region { get; set; }
+
+        /// <summary>
+        /// Profile whose credentials assume the roles. Defaults to `default` when no other source is set.
+        /// </summary>
+        [Input("sourceProfile")]
+        public Input<string>? SourceProfile { get; set; }
+
+        /// <summary>
+        /// Account ID of the IAM Identity Center permission set assuming the roles.
+        /// </summary>
+        [Input("ssoAccountId")]
+        public Input<string>? SsoAccountId { get; set; }
+
+        /// <summary>
+        /// Region of the IAM Identity Center session.
+        /// </summary>
+        [Input("ssoRegion")]
+        public Input<string>? SsoRegion { get; set; }
+
+        /// <summary>
+        /// Name of the IAM Identity Center permission set assuming the roles.
+        /// </summary>
+        [Input("ssoRoleName")]
+        public Input<string>? SsoRoleName { get; set; }
+
+        /// <summary>
+        /// Name of the IAM Identity Center session whose credentials assume the roles.
+        /// </summary>
+        [Input("ssoSession")]
+        public Input<string>? SsoSession { get; set; }
+
+        /// <summary>
+        /// Start URL of the IAM Identity Center session.
+        /// </summary>
+        [Input("ssoStartUrl")]
+        public Input<string>? SsoStartUrl { get; set; }
+
+        public AWSConfigSourceArgs()
+        {
+        }
+        public static new AWSConfigSourceArgs Empty => new AWSConfigSourceArgs();
+    }
+}
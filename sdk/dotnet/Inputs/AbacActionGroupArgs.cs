@@ -0,0 +1,72 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    /// <summary>
+    /// A group of actions of a service to allow based on matching tags.
+    /// </summary>
+    public sealed class AbacActionGroupArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("actions")]
+        private InputList<string>? _actions;
+
+        /// <summary>
+        /// Actions allowed on resources whose tags match the principal tags. Actions without a service
+        /// prefix are prefixed with the service.
+        /// </summary>
+        public InputList<string> Actions
+        {
+            get => _actions ?? (_actions = new InputList<string>());
+            set => _actions = value;
+        }
+
+        [Input("createActions")]
+        private InputList<string>? _createActions;
+
+        /// <summary>
+        /// Actions creating resources, only allowed when the request tags match the principal tags.
+        /// </summary>
+        public InputList<string> CreateActions
+        {
+            get => _createActions ?? (_createActions = new InputList<string>());
+            set => _createActions = value;
+        }
+
+        /// <summary>
+        /// Name of the group, used as the statement ID and unique across the groups. Defaults to the service, suffixed with the position of the group when the service is used by more than one unnamed group.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        [Input("resources")]
+        private InputList<string>? _resources;
+
+        /// <summary>
+        /// Resources the actions apply to. Defaults to `*`.
+        /// </summary>
+        public InputList<string> Resources
+        {
+            get => _resources ?? (_resources = new InputList<string>());
+            set => _resources = value;
+        }
+
+        /// <summary>
+        /// IAM prefix of the service, e.g. `ec2`.
+        /// </summary>
+        [Input("service", required: true)]
+        public Input<string> Service { get; set; } = null!;
+
+        public AbacActionGroupArgs()
+        {
+        }
+        public static new AbacActionGroupArgs Empty => new AbacActionGroupArgs();
+    }
+}
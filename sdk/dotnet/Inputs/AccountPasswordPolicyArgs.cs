@@ -18,15 +18,15 @@ namespace Pulumi.AwsIam.Inputs
         /// <summary>
         /// Whether to allow users to change their own password.
         /// </summary>
-        [Input("allowUsersToChange", required: true)]
-        public Input<bool> AllowUsersToChange { get; set; } = null!;
+        [Input("allowUsersToChange")]
+        public Input<bool>? AllowUsersToChange { get; set; }
 
         /// <summary>
         /// Whether users are prevented from setting a new password after their password has
         /// expired (i.e. require administrator reset).
         /// </summary>
-        [Input("hardExpiry", required: true)]
-        public Input<bool> HardExpiry { get; set; } = null!;
+        [Input("hardExpiry")]
+        public Input<bool>? HardExpiry { get; set; }
 
         /// <summary>
         /// The number of days that an user password is valid. If not set or a value of `0` is provided, then
@@ -45,26 +45,26 @@ namespace Pulumi.AwsIam.Inputs
         /// <summary>
         /// Whether to require lowercase characters for user passwords.
         /// </summary>
-        [Input("requireLowercaseCharacters", required: true)]
-        public Input<bool> RequireLowercaseCharacters { get; set; } = null!;
+        [Input("requireLowercaseCharacters")]
+        public Input<bool>? RequireLowercaseCharacters { get; set; }
 
         /// <summary>
         /// Whether to require numbers for user passwords.
         /// </summary>
-        [Input("requireNumbers", required: true)]
-        public Input<bool> RequireNumbers { get; set; } = null!;
+        [Input("requireNumbers")]
+        public Input<bool>? RequireNumbers { get; set; }
 
         /// <summary>
         /// Whether to require symbols for user passwords.
         /// </summary>
-        [Input("requireSymbols", required: true)]
-        public Input<bool> RequireSymbols { get; set; } = null!;
+        [Input("requireSymbols")]
+        public Input<bool>? RequireSymbols { get; set; }
 
         /// <summary>
         /// Whether to require uppercase characters for user passwords.
         /// </summary>
-        [Input("requireUppercaseCharacters", required: true)]
-        public Input<bool> RequireUppercaseCharacters { get; set; } = null!;
+        [Input("requireUppercaseCharacters")]
+        public Input<bool>? RequireUppercaseCharacters { get; set; }
 
         /// <summary>
         /// The number of previous passwords that users are prevented from reusing. If not set or a
@@ -0,0 +1,68 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    public sealed class AssumableRoleWithOIDCProviderArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The AWS account ID where the OIDC provider lives, defaults to `awsAccountId`.
+        /// </summary>
+        [Input("awsAccountId")]
+        public Input<string>? AwsAccountId { get; set; }
+
+        [Input("oidcFullyQualifiedAudiences")]
+        private InputList<string>? _oidcFullyQualifiedAudiences;
+
+        /// <summary>
+        /// The audiences of this provider, replacing `oidcFullyQualifiedAudiences` when set.
+        /// </summary>
+        public InputList<string> OidcFullyQualifiedAudiences
+        {
+            get => _oidcFullyQualifiedAudiences ?? (_oidcFullyQualifiedAudiences = new InputList<string>());
+            set => _oidcFullyQualifiedAudiences = value;
+        }
+
+        [Input("oidcFullyQualifiedSubjects")]
+        private InputList<string>? _oidcFullyQualifiedSubjects;
+
+        /// <summary>
+        /// The fully qualified OIDC subjects of this provider, replacing `oidcFullyQualifiedSubjects` when set.
+        /// </summary>
+        public InputList<string> OidcFullyQualifiedSubjects
+        {
+            get => _oidcFullyQualifiedSubjects ?? (_oidcFullyQualifiedSubjects = new InputList<string>());
+            set => _oidcFullyQualifiedSubjects = value;
+        }
+
+        [Input("oidcSubjectsWithWildcards")]
+        private InputList<string>? _oidcSubjectsWithWildcards;
+
+        /// <summary>
+        /// The OIDC subjects using wildcards of this provider, replacing `oidcSubjectsWithWildcards` when set.
+        /// </summary>
+        public InputList<string> OidcSubjectsWithWildcards
+        {
+            get => _oidcSubjectsWithWildcards ?? (_oidcSubjectsWithWildcards = new InputList<string>());
+            set => _oidcSubjectsWithWildcards = value;
+        }
+
+        /// <summary>
+        /// URL of the OIDC Provider.
+        /// </summary>
+        [Input("url", required: true)]
+        public Input<string> Url { get; set; } = null!;
+
+        public AssumableRoleWithOIDCProviderArgs()
+        {
+        }
+        public static new AssumableRoleWithOIDCProviderArgs Empty => new AssumableRoleWithOIDCProviderArgs();
+    }
+}
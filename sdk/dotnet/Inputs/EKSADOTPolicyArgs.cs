@@ -0,0 +1,60 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    /// <summary>
+    /// The AWS Distro for OpenTelemetry IAM policy to the role.
+    /// </summary>
+    public sealed class EKSADOTPolicyArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("ampWorkspaceArns")]
+        private InputList<string>? _ampWorkspaceArns;
+
+        /// <summary>
+        /// List of AMP Workspace ARNs to write metrics to. If not provided, a default ARN of "*"
+        /// will be provided.
+        /// </summary>
+        public InputList<string> AmpWorkspaceArns
+        {
+            get => _ampWorkspaceArns ?? (_ampWorkspaceArns = new InputList<string>());
+            set => _ampWorkspaceArns = value;
+        }
+
+        /// <summary>
+        /// Determines whether to attach the AWS Distro for OpenTelemetry IAM policy to the role.
+        /// </summary>
+        [Input("attach")]
+        public Input<bool>? Attach { get; set; }
+
+        /// <summary>
+        /// Allows exporting metrics to Amazon Managed Service for Prometheus.
+        /// </summary>
+        [Input("enableAmp")]
+        public Input<bool>? EnableAmp { get; set; }
+
+        /// <summary>
+        /// Allows exporting metrics and logs to CloudWatch.
+        /// </summary>
+        [Input("enableCloudwatch")]
+        public Input<bool>? EnableCloudwatch { get; set; }
+
+        /// <summary>
+        /// Allows exporting traces to AWS X-Ray.
+        /// </summary>
+        [Input("enableXray")]
+        public Input<bool>? EnableXray { get; set; }
+
+        public EKSADOTPolicyArgs()
+        {
+        }
+        public static new EKSADOTPolicyArgs Empty => new EKSADOTPolicyArgs();
+    }
+}
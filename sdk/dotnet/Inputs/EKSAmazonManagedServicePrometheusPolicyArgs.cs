@@ -18,8 +18,8 @@ namespace Pulumi.AwsIam.Inputs
         /// <summary>
         /// Determines whether to attach the Amazon Managed Service for Prometheus IAM policy to the role.
         /// </summary>
-        [Input("attach", required: true)]
-        public Input<bool> Attach { get; set; } = null!;
+        [Input("attach")]
+        public Input<bool>? Attach { get; set; }
 
         [Input("workspaceArns")]
         private InputList<string>? _workspaceArns;
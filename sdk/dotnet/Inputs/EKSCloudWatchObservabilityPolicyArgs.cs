@@ -0,0 +1,29 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    /// <summary>
+    /// The CloudWatch Observability IAM policy to the role.
+    /// </summary>
+    public sealed class EKSCloudWatchObservabilityPolicyArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Determines whether to attach the CloudWatch Observability IAM policy to the role.
+        /// </summary>
+        [Input("attach")]
+        public Input<bool>? Attach { get; set; }
+
+        public EKSCloudWatchObservabilityPolicyArgs()
+        {
+        }
+        public static new EKSCloudWatchObservabilityPolicyArgs Empty => new EKSCloudWatchObservabilityPolicyArgs();
+    }
+}
@@ -18,10 +18,22 @@ namespace Pulumi.AwsIam.Inputs
         /// <summary>
         /// Determines whether to attach the Cluster Autoscaler IAM policy to the role.
         /// </summary>
-        [Input("attach", required: true)]
-        public Input<bool> Attach { get; set; } = null!;
+        [Input("attach")]
+        public Input<bool>? Attach { get; set; }
 
-        [Input("clusterIds", required: true)]
+        [Input("autoScalingGroupArns")]
+        private InputList<string>? _autoScalingGroupArns;
+
+        /// <summary>
+        /// List of Auto Scaling group ARNs the Cluster Autoscaler can scale, e.g. the groups of specific node groups.
+        /// </summary>
+        public InputList<string> AutoScalingGroupArns
+        {
+            get => _autoScalingGroupArns ?? (_autoScalingGroupArns = new InputList<string>());
+            set => _autoScalingGroupArns = value;
+        }
+
+        [Input("clusterIds")]
         private InputList<string>? _clusterIds;
 
         /// <summary>
@@ -33,6 +45,32 @@ namespace Pulumi.AwsIam.Inputs
             set => _clusterIds = value;
         }
 
+        /// <summary>
+        /// Allows eks:DescribeNodegroup, used to discover the labels and taints of managed node groups scaled from zero.
+        /// </summary>
+        [Input("enableDescribeNodegroup")]
+        public Input<bool>? EnableDescribeNodegroup { get; set; }
+
+        /// <summary>
+        /// Only grants the read actions, e.g. for dashboards showing the autoscaler status. Without `clusterIds`,
+        /// `autoScalingGroupArns` or `tagKeys` the policy is read only as well, with a warning.
+        /// </summary>
+        [Input("readOnly")]
+        public Input<bool>? ReadOnly { get; set; }
+
+        [Input("tagKeys")]
+        private InputList<string>? _tagKeys;
+
+        /// <summary>
+        /// List of tag keys which must all be present on the Auto Scaling groups the Cluster Autoscaler can scale,
+        /// e.g. `k8s.io/cluster-autoscaler/enabled`. Combined with `clusterIds` and `autoScalingGroupArns` when given.
+        /// </summary>
+        public InputList<string> TagKeys
+        {
+            get => _tagKeys ?? (_tagKeys = new InputList<string>());
+            set => _tagKeys = value;
+        }
+
         public EKSClusterAutoscalerPolicyArgs()
         {
         }
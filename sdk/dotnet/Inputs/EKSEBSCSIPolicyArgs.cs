@@ -18,8 +18,8 @@ namespace Pulumi.AwsIam.Inputs
         /// <summary>
         /// Determines whether to attach the EBS CSI IAM policy to the role.
         /// </summary>
-        [Input("attach", required: true)]
-        public Input<bool> Attach { get; set; } = null!;
+        [Input("attach")]
+        public Input<bool>? Attach { get; set; }
 
         [Input("kmsCmkIds", required: true)]
         private InputList<string>? _kmsCmkIds;
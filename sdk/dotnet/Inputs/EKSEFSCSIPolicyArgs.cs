@@ -18,8 +18,8 @@ namespace Pulumi.AwsIam.Inputs
         /// <summary>
         /// Determines whether to attach the EFS CSI IAM policy to the role.
         /// </summary>
-        [Input("attach", required: true)]
-        public Input<bool> Attach { get; set; } = null!;
+        [Input("attach")]
+        public Input<bool>? Attach { get; set; }
 
         public EKSEFSCSIPolicyArgs()
         {
@@ -0,0 +1,29 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    /// <summary>
+    /// The VPC Lattice Gateway API Controller IAM policy to the role.
+    /// </summary>
+    public sealed class EKSGatewayAPIControllerPolicyArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Determines whether to attach the VPC Lattice Gateway API Controller IAM policy to the role.
+        /// </summary>
+        [Input("attach")]
+        public Input<bool>? Attach { get; set; }
+
+        public EKSGatewayAPIControllerPolicyArgs()
+        {
+        }
+        public static new EKSGatewayAPIControllerPolicyArgs Empty => new EKSGatewayAPIControllerPolicyArgs();
+    }
+}
@@ -0,0 +1,53 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    /// <summary>
+    /// The KEDA IAM policy to the role.
+    /// </summary>
+    public sealed class EKSKEDAPolicyArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Determines whether to attach the KEDA IAM policy to the role.
+        /// </summary>
+        [Input("attach")]
+        public Input<bool>? Attach { get; set; }
+
+        [Input("dynamodbTableArns")]
+        private InputList<string>? _dynamodbTableArns;
+
+        /// <summary>
+        /// List of DynamoDB table ARNs queried by the aws-dynamodb scaler.
+        /// </summary>
+        public InputList<string> DynamodbTableArns
+        {
+            get => _dynamodbTableArns ?? (_dynamodbTableArns = new InputList<string>());
+            set => _dynamodbTableArns = value;
+        }
+
+        [Input("sqsQueueArns")]
+        private InputList<string>? _sqsQueueArns;
+
+        /// <summary>
+        /// List of SQS queue ARNs read by the aws-sqs-queue scaler.
+        /// </summary>
+        public InputList<string> SqsQueueArns
+        {
+            get => _sqsQueueArns ?? (_sqsQueueArns = new InputList<string>());
+            set => _sqsQueueArns = value;
+        }
+
+        public EKSKEDAPolicyArgs()
+        {
+        }
+        public static new EKSKEDAPolicyArgs Empty => new EKSKEDAPolicyArgs();
+    }
+}
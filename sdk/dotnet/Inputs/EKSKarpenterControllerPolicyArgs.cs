@@ -18,8 +18,8 @@ namespace Pulumi.AwsIam.Inputs
         /// <summary>
         /// Determines whether to attach the Karpenter Controller policy to the role.
         /// </summary>
-        [Input("attach", required: true)]
-        public Input<bool> Attach { get; set; } = null!;
+        [Input("attach")]
+        public Input<bool>? Attach { get; set; }
 
         /// <summary>
         /// Cluster ID where the Karpenter controller is provisioned/managing.
@@ -27,12 +27,18 @@ namespace Pulumi.AwsIam.Inputs
         [Input("clusterId")]
         public Input<string>? ClusterId { get; set; }
 
+        /// <summary>
+        /// ARN of the SQS queue Karpenter reads interruption events from. Only used by the `v1` policy.
+        /// </summary>
+        [Input("interruptionQueueArn")]
+        public Input<string>? InterruptionQueueArn { get; set; }
+
         [Input("nodeIamRoleArns")]
         private InputList<string>? _nodeIamRoleArns;
 
         /// <summary>
         /// List of node IAM role ARNs Karpenter can use to launch nodes. If not provided,
-        /// the default ARN "*" will be applied.
+        /// the default ARN "*" will be applied. Required by the `v1` policy.
         /// </summary>
         public InputList<string> NodeIamRoleArns
         {
@@ -40,6 +46,12 @@ namespace Pulumi.AwsIam.Inputs
             set => _nodeIamRoleArns = value;
         }
 
+        /// <summary>
+        /// Region of the cluster. Only used by the `v1` policy, defaults to the region of the provider.
+        /// </summary>
+        [Input("region")]
+        public Input<string>? Region { get; set; }
+
         [Input("ssmParameterArns")]
         private InputList<string>? _ssmParameterArns;
 
@@ -65,10 +77,19 @@ namespace Pulumi.AwsIam.Inputs
         [Input("tagKey")]
         public Input<string>? TagKey { get; set; }
 
+        /// <summary>
+        /// Version of the Karpenter controller policy. `v0` is the policy of Karpenter releases before v1, using a single
+        /// discovery tag. `v1` matches the permissions of the Karpenter v1 controller, scoped by the
+        /// `kubernetes.io/cluster/&lt;clusterId&gt;` and `karpenter.sh/nodepool` tags, and requires `clusterId` to be the cluster name.
+        /// </summary>
+        [Input("version")]
+        public Input<string>? Version { get; set; }
+
         public EKSKarpenterControllerPolicyArgs()
         {
             ClusterId = "*";
             TagKey = "karpenter.sh/discovery";
+            Version = "v0";
         }
         public static new EKSKarpenterControllerPolicyArgs Empty => new EKSKarpenterControllerPolicyArgs();
     }
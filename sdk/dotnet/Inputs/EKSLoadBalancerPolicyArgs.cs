@@ -15,12 +15,50 @@ namespace Pulumi.AwsIam.Inputs
     /// </summary>
     public sealed class EKSLoadBalancerPolicyArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// Name of the cluster. When set, the controller can only manage resources whose cluster tag has this value.
+        /// </summary>
+        [Input("clusterName")]
+        public Input<string>? ClusterName { get; set; }
+
+        /// <summary>
+        /// Tag key the controller adds to the resources it manages.
+        /// </summary>
+        [Input("clusterTagKey")]
+        public Input<string>? ClusterTagKey { get; set; }
+
         /// <summary>
         /// Determines whether to attach the Load Balancer Controller policy to the role.
         /// </summary>
         [Input("controller")]
         public Input<bool>? Controller { get; set; }
 
+        /// <summary>
+        /// Version of the Load Balancer Controller the policy is for, e.g. `v2.7`. Permissions added by later controller
+        /// releases are only granted to the versions needing them.
+        /// </summary>
+        [Input("controllerVersion")]
+        public Input<string>? ControllerVersion { get; set; }
+
+        /// <summary>
+        /// Leaves out the WAF, WAFv2 and Shield permissions, for controllers running with `--enable-waf=false`,
+        /// `--enable-wafv2=false` and `--enable-shield=false`.
+        /// </summary>
+        [Input("restrictWafShield")]
+        public Input<bool>? RestrictWafShield { get; set; }
+
+        [Input("restrictedSecurityGroupArns")]
+        private InputList<string>? _restrictedSecurityGroupArns;
+
+        /// <summary>
+        /// List of security group ARNs the controller can change the ingress rules of. Defaults to all security groups.
+        /// </summary>
+        public InputList<string> RestrictedSecurityGroupArns
+        {
+            get => _restrictedSecurityGroupArns ?? (_restrictedSecurityGroupArns = new InputList<string>());
+            set => _restrictedSecurityGroupArns = value;
+        }
+
         /// <summary>
         /// Determines whether to attach the Load Balancer Controller policy for the TargetGroupBinding only.
         /// </summary>
@@ -29,6 +67,8 @@ namespace Pulumi.AwsIam.Inputs
 
         public EKSLoadBalancerPolicyArgs()
         {
+            ClusterTagKey = "elbv2.k8s.aws/cluster";
+            ControllerVersion = "v2.4";
         }
         public static new EKSLoadBalancerPolicyArgs Empty => new EKSLoadBalancerPolicyArgs();
     }
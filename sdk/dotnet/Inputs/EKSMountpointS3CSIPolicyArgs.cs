@@ -0,0 +1,65 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    /// <summary>
+    /// The Mountpoint for Amazon S3 CSI IAM policy to the role.
+    /// </summary>
+    public sealed class EKSMountpointS3CSIPolicyArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Determines whether to attach the Mountpoint for Amazon S3 CSI IAM policy to the role.
+        /// </summary>
+        [Input("attach")]
+        public Input<bool>? Attach { get; set; }
+
+        [Input("kmsKeyArns")]
+        private InputList<string>? _kmsKeyArns;
+
+        /// <summary>
+        /// List of KMS key ARNs used to encrypt the objects in the buckets.
+        /// </summary>
+        public InputList<string> KmsKeyArns
+        {
+            get => _kmsKeyArns ?? (_kmsKeyArns = new InputList<string>());
+            set => _kmsKeyArns = value;
+        }
+
+        [Input("s3BucketArns")]
+        private InputList<string>? _s3BucketArns;
+
+        /// <summary>
+        /// List of S3 Bucket ARNs the driver is allowed to mount. At least one is required.
+        /// </summary>
+        public InputList<string> S3BucketArns
+        {
+            get => _s3BucketArns ?? (_s3BucketArns = new InputList<string>());
+            set => _s3BucketArns = value;
+        }
+
+        [Input("s3PathPrefixes")]
+        private InputList<string>? _s3PathPrefixes;
+
+        /// <summary>
+        /// List of key prefixes within the buckets the driver is allowed to access. Defaults to the whole bucket.
+        /// </summary>
+        public InputList<string> S3PathPrefixes
+        {
+            get => _s3PathPrefixes ?? (_s3PathPrefixes = new InputList<string>());
+            set => _s3PathPrefixes = value;
+        }
+
+        public EKSMountpointS3CSIPolicyArgs()
+        {
+        }
+        public static new EKSMountpointS3CSIPolicyArgs Empty => new EKSMountpointS3CSIPolicyArgs();
+    }
+}
@@ -15,6 +15,12 @@ namespace Pulumi.AwsIam.Inputs
     /// </summary>
     public sealed class EKSRolePoliciesArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// The AWS Distro for OpenTelemetry IAM policy.
+        /// </summary>
+        [Input("adot")]
+        public Input<Inputs.EKSADOTPolicyArgs>? Adot { get; set; }
+
         /// <summary>
         /// The Amazon Managed Service for Prometheus IAM policy.
         /// </summary>
@@ -33,12 +39,31 @@ namespace Pulumi.AwsIam.Inputs
         [Input("certManager")]
         public Input<Inputs.EKSCertManagerPolicyArgs>? CertManager { get; set; }
 
+        /// <summary>
+        /// The CloudWatch Observability IAM policy.
+        /// </summary>
+        [Input("cloudwatchObservability")]
+        public Input<Inputs.EKSCloudWatchObservabilityPolicyArgs>? CloudwatchObservability { get; set; }
+
         /// <summary>
         /// The Cluster Autoscaler IAM policy.
         /// </summary>
         [Input("clusterAutoScaling")]
         public Input<Inputs.EKSClusterAutoscalerPolicyArgs>? ClusterAutoScaling { get; set; }
 
+        [Input("custom")]
+        private InputMap<object>? _custom;
+
+        /// <summary>
+        /// Arguments of organization specific add-ons, keyed by the name they are registered with in
+        /// the provider's add-on registry. Unknown add-on names are rejected.
+        /// </summary>
+        public InputMap<object> Custom
+        {
+            get => _custom ?? (_custom = new InputMap<object>());
+            set => _custom = value;
+        }
+
         /// <summary>
         /// The EBS CSI IAM policy.
         /// </summary>
@@ -69,24 +94,48 @@ namespace Pulumi.AwsIam.Inputs
         [Input("fsxLustreCsi")]
         public Input<Inputs.FSxLustreCSIPolicyArgs>? FsxLustreCsi { get; set; }
 
+        /// <summary>
+        /// The VPC Lattice Gateway API Controller IAM policy.
+        /// </summary>
+        [Input("gatewayApiController")]
+        public Input<Inputs.EKSGatewayAPIControllerPolicyArgs>? GatewayApiController { get; set; }
+
         /// <summary>
         /// The Karpenter Controller policy.
         /// </summary>
         [Input("karpenterController")]
         public Input<Inputs.EKSKarpenterControllerPolicyArgs>? KarpenterController { get; set; }
 
+        /// <summary>
+        /// The KEDA IAM policy.
+        /// </summary>
+        [Input("keda")]
+        public Input<Inputs.EKSKEDAPolicyArgs>? Keda { get; set; }
+
         /// <summary>
         /// The Load Balancer policy.
         /// </summary>
         [Input("loadBalancer")]
         public Input<Inputs.EKSLoadBalancerPolicyArgs>? LoadBalancer { get; set; }
 
+        /// <summary>
+        /// The Mountpoint for Amazon S3 CSI IAM policy.
+        /// </summary>
+        [Input("mountpointS3Csi")]
+        public Input<Inputs.EKSMountpointS3CSIPolicyArgs>? MountpointS3Csi { get; set; }
+
         /// <summary>
         /// The Node Termination Handler policy to the role.
         /// </summary>
         [Input("nodeTerminationHandler")]
         public Input<Inputs.EKSNodeTerminationHandlerPolicyArgs>? NodeTerminationHandler { get; set; }
 
+        /// <summary>
+        /// The Secrets Store CSI driver IAM policy.
+        /// </summary>
+        [Input("secretsStoreCsi")]
+        public Input<Inputs.EKSSecretsStoreCSIPolicyArgs>? SecretsStoreCsi { get; set; }
+
         /// <summary>
         /// The Velero IAM policy.
         /// </summary>
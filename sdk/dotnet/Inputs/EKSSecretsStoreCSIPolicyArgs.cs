@@ -0,0 +1,55 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    /// <summary>
+    /// The Secrets Store CSI driver IAM policy to the role.
+    /// </summary>
+    public sealed class EKSSecretsStoreCSIPolicyArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Determines whether to attach the Secrets Store CSI driver IAM policy to the role.
+        /// </summary>
+        [Input("attach")]
+        public Input<bool>? Attach { get; set; }
+
+        [Input("secretsManagerArns")]
+        private InputList<string>? _secretsManagerArns;
+
+        /// <summary>
+        /// List of Secrets Manager ARNs that contain secrets to mount using the Secrets Store CSI driver.
+        /// If not provided, a default ARN of "arn:aws:secretsmanager:*:*:secret:*" will be provided.
+        /// </summary>
+        public InputList<string> SecretsManagerArns
+        {
+            get => _secretsManagerArns ?? (_secretsManagerArns = new InputList<string>());
+            set => _secretsManagerArns = value;
+        }
+
+        [Input("ssmParameterArns")]
+        private InputList<string>? _ssmParameterArns;
+
+        /// <summary>
+        /// List of Systems Manager Parameter ARNs that contain secrets to mount using the Secrets Store CSI driver.
+        /// If not provided, a default ARN of "arn:aws:ssm:*:*:parameter/*" will be provided.
+        /// </summary>
+        public InputList<string> SsmParameterArns
+        {
+            get => _ssmParameterArns ?? (_ssmParameterArns = new InputList<string>());
+            set => _ssmParameterArns = value;
+        }
+
+        public EKSSecretsStoreCSIPolicyArgs()
+        {
+        }
+        public static new EKSSecretsStoreCSIPolicyArgs Empty => new EKSSecretsStoreCSIPolicyArgs();
+    }
+}
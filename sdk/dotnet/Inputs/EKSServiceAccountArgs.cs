@@ -25,7 +25,7 @@ namespace Pulumi.AwsIam.Inputs
         private InputList<string>? _serviceAccounts;
 
         /// <summary>
-        /// Service accounts to pair with the cluster.
+        /// Service accounts to pair with the cluster, as `namespace:name`. Wildcards such as `team-a:*` are matched with StringLike.
         /// </summary>
         public InputList<string> ServiceAccounts
         {
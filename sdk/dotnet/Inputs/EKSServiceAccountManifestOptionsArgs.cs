@@ -0,0 +1,33 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    public sealed class EKSServiceAccountManifestOptionsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether to annotate the ServiceAccounts to use the regional STS endpoint.
+        /// </summary>
+        [Input("stsRegionalEndpoints")]
+        public Input<bool>? StsRegionalEndpoints { get; set; }
+
+        /// <summary>
+        /// Expiration of the projected ServiceAccount token in seconds. The annotation is left out when 0.
+        /// </summary>
+        [Input("tokenExpiration")]
+        public Input<int>? TokenExpiration { get; set; }
+
+        public EKSServiceAccountManifestOptionsArgs()
+        {
+            StsRegionalEndpoints = false;
+        }
+        public static new EKSServiceAccountManifestOptionsArgs Empty => new EKSServiceAccountManifestOptionsArgs();
+    }
+}
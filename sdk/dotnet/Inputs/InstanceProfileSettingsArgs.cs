@@ -0,0 +1,44 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    public sealed class InstanceProfileSettingsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Name of IAM instance profile. Defaults to the name of the role.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// Path of IAM instance profile. Defaults to the path of the role.
+        /// </summary>
+        [Input("path")]
+        public Input<string>? Path { get; set; }
+
+        [Input("tags")]
+        private InputMap<string>? _tags;
+
+        /// <summary>
+        /// A map of tags to add to the instance profile. Defaults to the tags of the role.
+        /// </summary>
+        public InputMap<string> Tags
+        {
+            get => _tags ?? (_tags = new InputMap<string>());
+            set => _tags = value;
+        }
+
+        public InstanceProfileSettingsArgs()
+        {
+        }
+        public static new InstanceProfileSettingsArgs Empty => new InstanceProfileSettingsArgs();
+    }
+}
@@ -12,6 +12,24 @@ namespace Pulumi.AwsIam.Inputs
 
     public sealed class OIDCProviderArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// The AWS account ID where the IAM OIDC provider of `clusterName` or `issuerUrl` lives, defaults to the current account.
+        /// </summary>
+        [Input("awsAccountId")]
+        public Input<string>? AwsAccountId { get; set; }
+
+        /// <summary>
+        /// Name of an EKS cluster in the current region whose OIDC issuer is looked up. Used when `providerArn` is not set.
+        /// </summary>
+        [Input("clusterName")]
+        public Input<string>? ClusterName { get; set; }
+
+        /// <summary>
+        /// URL of the OIDC issuer. Used when neither `providerArn` nor `clusterName` is set.
+        /// </summary>
+        [Input("issuerUrl")]
+        public Input<string>? IssuerUrl { get; set; }
+
         [Input("namespaceServiceAccounts")]
         private InputList<string>? _namespaceServiceAccounts;
         public InputList<string> NamespaceServiceAccounts
@@ -20,6 +38,9 @@ namespace Pulumi.AwsIam.Inputs
             set => _namespaceServiceAccounts = value;
         }
 
+        /// <summary>
+        /// ARN of the IAM OIDC provider.
+        /// </summary>
         [Input("providerArn")]
         public Input<string>? ProviderArn { get; set; }
 
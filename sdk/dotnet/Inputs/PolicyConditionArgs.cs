@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    /// <summary>
+    /// A condition block of an IAM policy statement.
+    /// </summary>
+    public sealed class PolicyConditionArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Name of the IAM condition operator to evaluate, e.g. `StringEquals`.
+        /// </summary>
+        [Input("test", required: true)]
+        public Input<string> Test { get; set; } = null!;
+
+        [Input("values", required: true)]
+        private InputList<string>? _values;
+
+        /// <summary>
+        /// Values to evaluate the condition against.
+        /// </summary>
+        public InputList<string> Values
+        {
+            get => _values ?? (_values = new InputList<string>());
+            set => _values = value;
+        }
+
+        /// <summary>
+        /// Name of a Context Variable to apply the condition to, e.g. `aws:PrincipalTag/team`.
+        /// </summary>
+        [Input("variable", required: true)]
+        public Input<string> Variable { get; set; } = null!;
+
+        public PolicyConditionArgs()
+        {
+        }
+        public static new PolicyConditionArgs Empty => new PolicyConditionArgs();
+    }
+}
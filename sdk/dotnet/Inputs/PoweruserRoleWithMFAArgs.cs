@@ -15,6 +15,33 @@ namespace Pulumi.AwsIam.Inputs
     /// </summary>
     public sealed class PoweruserRoleWithMFAArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        /// </summary>
+        [Input("exclusiveInlinePolicies")]
+        public Input<bool>? ExclusiveInlinePolicies { get; set; }
+
+        /// <summary>
+        /// Whether managed policies not declared for the role are detached from it. The role then manages its
+        /// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+        /// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+        /// policies. Previews of existing roles warn that undeclared policies are detached.
+        /// </summary>
+        [Input("exclusivePolicyAttachments")]
+        public Input<bool>? ExclusivePolicyAttachments { get; set; }
+
+        [Input("inlinePolicies")]
+        private InputMap<string>? _inlinePolicies;
+
+        /// <summary>
+        /// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+        /// </summary>
+        public InputMap<string> InlinePolicies
+        {
+            get => _inlinePolicies ?? (_inlinePolicies = new InputMap<string>());
+            set => _inlinePolicies = value;
+        }
+
         /// <summary>
         /// IAM role with poweruser access.
         /// </summary>
@@ -65,6 +92,8 @@ namespace Pulumi.AwsIam.Inputs
 
         public PoweruserRoleWithMFAArgs()
         {
+            ExclusiveInlinePolicies = false;
+            ExclusivePolicyAttachments = false;
         }
         public static new PoweruserRoleWithMFAArgs Empty => new PoweruserRoleWithMFAArgs();
     }
@@ -0,0 +1,113 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Inputs
+{
+
+    public sealed class RoleSessionArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Requested session duration in seconds. Capped by the maximum session duration of the role and defaults to it.
+        /// </summary>
+        [Input("durationSeconds")]
+        public Input<int>? DurationSeconds { get; set; }
+
+        [Input("policyActions")]
+        private InputList<string>? _policyActions;
+
+        /// <summary>
+        /// Actions the session is limited to by a session policy. No session policy is rendered when empty.
+        /// </summary>
+        public InputList<string> PolicyActions
+        {
+            get => _policyActions ?? (_policyActions = new InputList<string>());
+            set => _policyActions = value;
+        }
+
+        [Input("policyResources")]
+        private InputList<string>? _policyResources;
+
+        /// <summary>
+        /// Resources the session policy limits the actions to. Defaults to all resources.
+        /// </summary>
+        public InputList<string> PolicyResources
+        {
+            get => _policyResources ?? (_policyResources = new InputList<string>());
+            set => _policyResources = value;
+        }
+
+        /// <summary>
+        /// Name of the AWS CLI profile. Defaults to the role name.
+        /// </summary>
+        [Input("profileName")]
+        public Input<string>? ProfileName { get; set; }
+
+        /// <summary>
+        /// Whether the role is assumed from another role session, which STS limits to one hour.
+        /// </summary>
+        [Input("roleChaining")]
+        public Input<bool>? RoleChaining { get; set; }
+
+        /// <summary>
+        /// Name of the role session. Defaults to the profile name.
+        /// </summary>
+        [Input("sessionName")]
+        public Input<string>? SessionName { get; set; }
+
+        [Input("sessionTags")]
+        private InputMap<string>? _sessionTags;
+
+        /// <summary>
+        /// Session tags to pass when assuming the role.
+        /// </summary>
+        public InputMap<string> SessionTags
+        {
+            get => _sessionTags ?? (_sessionTags = new InputMap<string>());
+            set => _sessionTags = value;
+        }
+
+        /// <summary>
+        /// Source identity to set on the session, e.g. the name of the CI pipeline.
+        /// </summary>
+        [Input("sourceIdentity")]
+        public Input<string>? SourceIdentity { get; set; }
+
+        /// <summary>
+        /// Name of the AWS CLI profile whose credentials assume the role.
+        /// </summary>
+        [Input("sourceProfile")]
+        public Input<string>? SourceProfile { get; set; }
+
+        [Input("transitiveTagKeys")]
+        private InputList<string>? _transitiveTagKeys;
+
+        /// <summary>
+        /// Session tag keys which persist when the session assumes another role.
+        /// </summary>
+        public InputList<string> TransitiveTagKeys
+        {
+            get => _transitiveTagKeys ?? (_transitiveTagKeys = new InputList<string>());
+            set => _transitiveTagKeys = value;
+        }
+
+        /// <summary>
+        /// Path of the web identity token for roles assumed with AssumeRoleWithWebIdentity. Required for the
+        /// AWS CLI config profile of these roles.
+        /// </summary>
+        [Input("webIdentityTokenFile")]
+        public Input<string>? WebIdentityTokenFile { get; set; }
+
+        public RoleSessionArgs()
+        {
+            RoleChaining = false;
+            SourceProfile = "default";
+        }
+        public static new RoleSessionArgs Empty => new RoleSessionArgs();
+    }
+}
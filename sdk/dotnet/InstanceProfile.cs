@@ -0,0 +1,120 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam
+{
+    /// <summary>
+    /// This resource helps you create an IAM instance profile for an existing IAM role, e.g. a role created by
+    /// `AssumableRole` with `createInstanceProfile` turned off or by `EKSNodeRole`. The role can be given by
+    /// name or ARN.
+    /// 
+    /// ## Example Usage
+    /// ## Instance Profile
+    /// 
+    /// ```csharp
+    /// using Pulumi;
+    /// using Pulumi.AwsIam;
+    /// 
+    /// class MyStack : Stack
+    /// {
+    ///     public MyStack()
+    ///     {
+    ///         var instanceProfile = new InstanceProfile("instance-profile", new InstanceProfileArgs
+    ///         {
+    ///             Role = "arn:aws:iam::111111111111:role/app/web",
+    ///             Name = "web",
+    ///             Path = "/app/",
+    ///         });
+    /// 
+    ///         this.InstanceProfile = Output.Create&lt;InstanceProfile&gt;(instanceProfile);
+    ///     }
+    /// 
+    ///     [Output]
+    ///     public Output&lt;InstanceProfile&gt; InstanceProfile { get; set; }
+    /// }
+    /// ```
+    /// {{ /example }}
+    /// </summary>
+    [AwsIamResourceType("aws-iam:index:InstanceProfile")]
+    public partial class InstanceProfile : global::Pulumi.ComponentResource
+    {
+        [Output("instanceProfile")]
+        public Output<ImmutableDictionary<string, string>> InstanceProfile { get; private set; } = null!;
+
+        /// <summary>
+        /// Name of the IAM role in the instance profile.
+        /// </summary>
+        [Output("roleName")]
+        public Output<string> RoleName { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a InstanceProfile resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public InstanceProfile(string name, InstanceProfileArgs args, ComponentResourceOptions? options = null)
+            : base("aws-iam:index:InstanceProfile", name, args ?? new InstanceProfileArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class InstanceProfileArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Name of IAM instance profile.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// Path of IAM instance profile.
+        /// </summary>
+        [Input("path")]
+        public Input<string>? Path { get; set; }
+
+        /// <summary>
+        /// Name or ARN of the IAM role to add to the instance profile.
+        /// </summary>
+        [Input("role", required: true)]
+        public Input<string> Role { get; set; } = null!;
+
+        [Input("tags")]
+        private InputMap<string>? _tags;
+
+        /// <summary>
+        /// A map of tags to add.
+        /// </summary>
+        public InputMap<string> Tags
+        {
+            get => _tags ?? (_tags = new InputMap<string>());
+            set => _tags = value;
+        }
+
+        public InstanceProfileArgs()
+        {
+            Path = "/";
+        }
+        public static new InstanceProfileArgs Empty => new InstanceProfileArgs();
+    }
+}
@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Outputs
+{
+
+    [OutputType]
+    public sealed class EKSServiceAccountManifest
+    {
+        /// <summary>
+        /// Annotations binding the ServiceAccount to the IAM role.
+        /// </summary>
+        public readonly ImmutableDictionary<string, string> Annotations;
+        /// <summary>
+        /// ServiceAccount manifest as JSON.
+        /// </summary>
+        public readonly string Json;
+        /// <summary>
+        /// Name of the ServiceAccount.
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// Namespace of the ServiceAccount.
+        /// </summary>
+        public readonly string Namespace;
+        /// <summary>
+        /// ServiceAccount manifest as YAML.
+        /// </summary>
+        public readonly string Yaml;
+
+        [OutputConstructor]
+        private EKSServiceAccountManifest(
+            ImmutableDictionary<string, string> annotations,
+
+            string json,
+
+            string name,
+
+            string @namespace,
+
+            string yaml)
+        {
+            Annotations = annotations;
+            Json = json;
+            Name = name;
+            Namespace = @namespace;
+            Yaml = yaml;
+        }
+    }
+}
@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam.Outputs
+{
+
+    [OutputType]
+    public sealed class RoleSessionHelpers
+    {
+        /// <summary>
+        /// AWS CLI command assuming the role with the source identity, session tags and session policy.
+        /// </summary>
+        public readonly string CliCommand;
+        /// <summary>
+        /// AWS CLI config profile assuming the role. Empty for web identity roles without a web identity token file.
+        /// </summary>
+        public readonly string CliConfig;
+        /// <summary>
+        /// Session duration in seconds, capped by the maximum session duration of the role.
+        /// </summary>
+        public readonly int DurationSeconds;
+        /// <summary>
+        /// Parameters of the STS request assuming the role as JSON, usable with any AWS SDK.
+        /// </summary>
+        public readonly string SdkParameters;
+        /// <summary>
+        /// Session policy limiting what the session can do, as JSON. Empty when no policy actions are given.
+        /// </summary>
+        public readonly string SessionPolicy;
+
+        [OutputConstructor]
+        private RoleSessionHelpers(
+            string cliCommand,
+
+            string cliConfig,
+
+            int durationSeconds,
+
+            string sdkParameters,
+
+            string sessionPolicy)
+        {
+            CliCommand = cliCommand;
+            CliConfig = cliConfig;
+            DurationSeconds = durationSeconds;
+            SdkParameters = sdkParameters;
+            SessionPolicy = sessionPolicy;
+        }
+    }
+}
@@ -52,6 +52,12 @@ namespace Pulumi.AwsIam
         [Output("arn")]
         public Output<string> Arn { get; private set; } = null!;
 
+        /// <summary>
+        /// Version of the action catalog used to expand actions when `metadataOnly` is set.
+        /// </summary>
+        [Output("catalogVersion")]
+        public Output<string> CatalogVersion { get; private set; } = null!;
+
         /// <summary>
         /// The description of the policy.
         /// </summary>
@@ -158,6 +164,12 @@ namespace Pulumi.AwsIam
         [Input("description")]
         public Input<string>? Description { get; set; }
 
+        /// <summary>
+        /// Allow only List, Describe and metadata Read actions instead of the List*, Get*, Describe* and View* wildcards, leaving out reads of data and secrets such as s3:GetObject or secretsmanager:GetSecretValue. Since the embedded action catalog is a curated subset, services it does not list completely keep List* and Describe* next to their other cataloged metadata actions, and services missing from it fall back to List* and Describe*.
+        /// </summary>
+        [Input("metadataOnly")]
+        public Input<bool>? MetadataOnly { get; set; }
+
         /// <summary>
         /// The name of the policy.
         /// </summary>
@@ -201,6 +213,7 @@ namespace Pulumi.AwsIam
             AllowPredefinedStsActions = true;
             AllowWebConsoleServices = true;
             Description = "IAM Policy";
+            MetadataOnly = false;
             Path = "/";
         }
         public static new ReadOnlyPolicyArgs Empty => new ReadOnlyPolicyArgs();
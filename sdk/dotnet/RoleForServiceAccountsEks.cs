@@ -83,6 +83,18 @@ namespace Pulumi.AwsIam
         [Output("role")]
         public Output<ImmutableDictionary<string, string>> Role { get; private set; } = null!;
 
+        /// <summary>
+        /// Annotations and ServiceAccount manifests binding each ServiceAccount to the role.
+        /// </summary>
+        [Output("serviceAccounts")]
+        public Output<ImmutableArray<Outputs.EKSServiceAccountManifest>> ServiceAccounts { get; private set; } = null!;
+
+        /// <summary>
+        /// Session policy and AWS CLI / SDK snippets assuming the role.
+        /// </summary>
+        [Output("session")]
+        public Output<Outputs.RoleSessionHelpers> Session { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a RoleForServiceAccountsEks resource with the given unique name, arguments, and options.
@@ -112,7 +124,14 @@ namespace Pulumi.AwsIam
     public sealed class RoleForServiceAccountsEksArgs : global::Pulumi.ResourceArgs
     {
         /// <summary>
-        /// Name of the IAM condition operator to evaluate when assuming the role.
+        /// Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+        /// </summary>
+        [Input("allowAllNamespaces")]
+        public Input<bool>? AllowAllNamespaces { get; set; }
+
+        /// <summary>
+        /// Name of the IAM condition operator to evaluate when assuming the role. ServiceAccounts with wildcards
+        /// are matched with StringLike in a statement of their own.
         /// </summary>
         [Input("assumeRoleConditionTest")]
         public Input<string>? AssumeRoleConditionTest { get; set; }
@@ -153,6 +172,19 @@ namespace Pulumi.AwsIam
         [Input("role")]
         public Input<Inputs.EKSServiceAccountRoleArgs>? Role { get; set; }
 
+        /// <summary>
+        /// Annotations added to the rendered ServiceAccount manifests.
+        /// </summary>
+        [Input("serviceAccountManifest")]
+        public Input<Inputs.EKSServiceAccountManifestOptionsArgs>? ServiceAccountManifest { get; set; }
+
+        /// <summary>
+        /// Settings of the session helpers rendered for the role. The web identity token file defaults to the
+        /// token EKS mounts into pods.
+        /// </summary>
+        [Input("session")]
+        public Input<Inputs.RoleSessionArgs>? Session { get; set; }
+
         [Input("tags")]
         private InputMap<string>? _tags;
 
@@ -167,6 +199,7 @@ namespace Pulumi.AwsIam
 
         public RoleForServiceAccountsEksArgs()
         {
+            AllowAllNamespaces = false;
             AssumeRoleConditionTest = "StringEquals";
             ForceDetachPolicies = false;
             MaxSessionDuration = 3600;
@@ -0,0 +1,155 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.AwsIam
+{
+    /// <summary>
+    /// This resource helps you create an IAM SAML identity provider from the metadata document of your IdP. The
+    /// metadata is validated before the provider is created: it must contain an entity ID and at least one
+    /// signing certificate that has not expired. A warning is reported when a signing certificate is close to
+    /// its expiry date so that the metadata can be updated before the IdP rotates its certificate.
+    /// 
+    /// ## Example Usage
+    /// ## SAML Provider
+    /// 
+    /// ```csharp
+    /// using Pulumi;
+    /// using Pulumi.AwsIam;
+    /// 
+    /// class MyStack : Stack
+    /// {
+    ///     public MyStack()
+    ///     {
+    ///         var samlProvider = new SAMLProvider("saml-provider", new SAMLProviderArgs
+    ///         {
+    ///             Name = "okta",
+    ///             SamlMetadataFile = "./okta-metadata.xml",
+    ///             CertificateExpiryWarningDays = 45,
+    ///         });
+    /// 
+    ///         this.SamlProvider = Output.Create&lt;SAMLProvider&gt;(samlProvider);
+    ///     }
+    /// 
+    ///     [Output]
+    ///     public Output&lt;SAMLProvider&gt; SamlProvider { get; set; }
+    /// }
+    /// ```
+    /// {{ /example }}
+    /// </summary>
+    [AwsIamResourceType("aws-iam:index:SAMLProvider")]
+    public partial class SAMLProvider : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// The ARN assigned by AWS for this provider.
+        /// </summary>
+        [Output("arn")]
+        public Output<string> Arn { get; private set; } = null!;
+
+        /// <summary>
+        /// The expiration date of the valid signing certificate that expires first, in RFC3339 format.
+        /// </summary>
+        [Output("certificateExpiry")]
+        public Output<string> CertificateExpiry { get; private set; } = null!;
+
+        /// <summary>
+        /// The number of days left until the valid signing certificate that expires first is no longer valid.
+        /// </summary>
+        [Output("daysUntilCertificateExpiry")]
+        public Output<int> DaysUntilCertificateExpiry { get; private set; } = null!;
+
+        /// <summary>
+        /// The entity ID of the IdP found in the metadata document.
+        /// </summary>
+        [Output("entityId")]
+        public Output<string> EntityId { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the provider.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The expiration date and time for the SAML provider in RFC1123 format.
+        /// </summary>
+        [Output("validUntil")]
+        public Output<string> ValidUntil { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a SAMLProvider resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public SAMLProvider(string name, SAMLProviderArgs? args = null, ComponentResourceOptions? options = null)
+            : base("aws-iam:index:SAMLProvider", name, args ?? new SAMLProviderArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class SAMLProviderArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Number of days before a signing certificate expires at which a warning is reported. `0` disables the warning.
+        /// </summary>
+        [Input("certificateExpiryWarningDays")]
+        public Input<int>? CertificateExpiryWarningDays { get; set; }
+
+        /// <summary>
+        /// The name of the SAML provider. Defaults to the resource name.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// The IdP metadata XML document.
+        /// </summary>
+        [Input("samlMetadataDocument")]
+        public Input<string>? SamlMetadataDocument { get; set; }
+
+        /// <summary>
+        /// Path to a local file containing the IdP metadata XML document. Used when `samlMetadataDocument`
+        /// is not provided.
+        /// </summary>
+        [Input("samlMetadataFile")]
+        public Input<string>? SamlMetadataFile { get; set; }
+
+        [Input("tags")]
+        private InputMap<string>? _tags;
+
+        /// <summary>
+        /// A map of tags to add.
+        /// </summary>
+        public InputMap<string> Tags
+        {
+            get => _tags ?? (_tags = new InputMap<string>());
+            set => _tags = value;
+        }
+
+        public SAMLProviderArgs()
+        {
+            CertificateExpiryWarningDays = 30;
+        }
+        public static new SAMLProviderArgs Empty => new SAMLProviderArgs();
+    }
+}
@@ -94,6 +94,13 @@ namespace Pulumi.AwsIam
 
     public sealed class UserArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// Whether managed policies not declared for the user are detached from it. Not supported for users
+        /// by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
+        /// </summary>
+        [Input("exclusivePolicyAttachments")]
+        public Input<bool>? ExclusivePolicyAttachments { get; set; }
+
         /// <summary>
         /// When destroying this user, destroy even if it has non-Pulumi-managed IAM access keys, login profile or MFA devices. Without forceDestroy a user with non-Pulumi-managed access keys and login profile will fail to be destroyed.
         /// </summary>
@@ -168,6 +175,7 @@ namespace Pulumi.AwsIam
 
         public UserArgs()
         {
+            ExclusivePolicyAttachments = false;
             Path = "/";
             SshKeyEncoding = "SSH";
         }
@@ -0,0 +1,305 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package awsiam
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// This resource helps you create an attribute-based access control (ABAC) IAM policy. Actions are only allowed
+// on resources whose tags match the tags of the principal (`aws:ResourceTag` against `aws:PrincipalTag`),
+// resources can only be created with matching tags (`aws:RequestTag`) and principals are prevented from
+// changing or removing the tags used for access control.
+//
+// ## Example Usage
+// ## ABAC Policy
+//
+// ```go
+// package main
+//
+// import (
+//
+//	iam "github.com/pulumi/pulumi-aws-iam/sdk/go/aws-iam"
+//	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+//
+// )
+//
+//	func main() {
+//	    pulumi.Run(func(ctx *pulumi.Context) error {
+//	        abacPolicy, err := iam.NewAbacPolicy(ctx, "abac-policy", &iam.AbacPolicyArgs{
+//	            Name:    pulumi.String("team-abac"),
+//	            TagKeys: pulumi.ToStringArray([]string{"team", "project"}),
+//	            ActionGroups: iam.AbacActionGroupArray{
+//	                iam.AbacActionGroupArgs{
+//	                    Service:       pulumi.String("ec2"),
+//	                    Actions:       pulumi.ToStringArray([]string{"StartInstances", "StopInstances", "RebootInstances"}),
+//	                    CreateActions: pulumi.ToStringArray([]string{"RunInstances"}),
+//	                },
+//	            },
+//	        })
+//	        if err != nil {
+//	            return err
+//	        }
+//
+//	        ctx.Export("abacPolicy", abacPolicy)
+//
+//	        return nil
+//	    })
+//	}
+//
+// ```
+// {{ /example }}
+type AbacPolicy struct {
+	pulumi.ResourceState
+
+	// The ARN assigned by AWS to this policy.
+	Arn pulumi.StringOutput `pulumi:"arn"`
+	// The description of the policy.
+	Description pulumi.StringOutput `pulumi:"description"`
+	// The policy's ID.
+	Id pulumi.StringOutput `pulumi:"id"`
+	// The name of the policy.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// The path of the policy in IAM.
+	Path pulumi.StringOutput `pulumi:"path"`
+	// Policy document as json.
+	PolicyJson pulumi.StringOutput `pulumi:"policyJson"`
+}
+
+// NewAbacPolicy registers a new resource with the given unique name, arguments, and options.
+func NewAbacPolicy(ctx *pulumi.Context,
+	name string, args *AbacPolicyArgs, opts ...pulumi.ResourceOption) (*AbacPolicy, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ActionGroups == nil {
+		return nil, errors.New("invalid value for required argument 'ActionGroups'")
+	}
+	if args.TagKeys == nil {
+		return nil, errors.New("invalid value for required argument 'TagKeys'")
+	}
+	if args.AllowTagModification == nil {
+		args.AllowTagModification = pulumi.BoolPtr(false)
+	}
+	if args.Description == nil {
+		args.Description = pulumi.StringPtr("Attribute-based access control policy")
+	}
+	if args.Path == nil {
+		args.Path = pulumi.StringPtr("/")
+	}
+	var resource AbacPolicy
+	err := ctx.RegisterRemoteComponentResource("aws-iam:index:AbacPolicy", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type abacPolicyArgs struct {
+	// Groups of actions to allow for each service.
+	ActionGroups []AbacActionGroup `pulumi:"actionGroups"`
+	// Whether to leave out the statements which prevent principals from changing or removing the tags
+	// used for access control.
+	AllowTagModification *bool `pulumi:"allowTagModification"`
+	// The description of the policy.
+	Description *string `pulumi:"description"`
+	// The name of the policy.
+	Name *string `pulumi:"name"`
+	// The path of the policy in IAM.
+	Path *string `pulumi:"path"`
+	// Tag keys that must match between the principal and the resources.
+	TagKeys []string `pulumi:"tagKeys"`
+	// A map of tags to add.
+	Tags map[string]string `pulumi:"tags"`
+}
+
+// The set of arguments for constructing a AbacPolicy resource.
+type AbacPolicyArgs struct {
+	// Groups of actions to allow for each service.
+	ActionGroups AbacActionGroupArrayInput
+	// Whether to leave out the statements which prevent principals from changing or removing the tags
+	// used for access control.
+	AllowTagModification pulumi.BoolPtrInput
+	// The description of the policy.
+	Description pulumi.StringPtrInput
+	// The name of the policy.
+	Name pulumi.StringPtrInput
+	// The path of the policy in IAM.
+	Path pulumi.StringPtrInput
+	// Tag keys that must match between the principal and the resources.
+	TagKeys pulumi.StringArrayInput
+	// A map of tags to add.
+	Tags pulumi.StringMapInput
+}
+
+func (AbacPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*abacPolicyArgs)(nil)).Elem()
+}
+
+type AbacPolicyInput interface {
+	pulumi.Input
+
+	ToAbacPolicyOutput() AbacPolicyOutput
+	ToAbacPolicyOutputWithContext(ctx context.Context) AbacPolicyOutput
+}
+
+func (*AbacPolicy) ElementType() reflect.Type {
+	return reflect.TypeOf((**AbacPolicy)(nil)).Elem()
+}
+
+func (i *AbacPolicy) ToAbacPolicyOutput() AbacPolicyOutput {
+	return i.ToAbacPolicyOutputWithContext(context.Background())
+}
+
+func (i *AbacPolicy) ToAbacPolicyOutputWithContext(ctx context.Context) AbacPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AbacPolicyOutput)
+}
+
+// AbacPolicyArrayInput is an input type that accepts AbacPolicyArray and AbacPolicyArrayOutput values.
+// You can construct a concrete instance of `AbacPolicyArrayInput` via:
+//
+//	AbacPolicyArray{ AbacPolicyArgs{...} }
+type AbacPolicyArrayInput interface {
+	pulumi.Input
+
+	ToAbacPolicyArrayOutput() AbacPolicyArrayOutput
+	ToAbacPolicyArrayOutputWithContext(context.Context) AbacPolicyArrayOutput
+}
+
+type AbacPolicyArray []AbacPolicyInput
+
+func (AbacPolicyArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*AbacPolicy)(nil)).Elem()
+}
+
+func (i AbacPolicyArray) ToAbacPolicyArrayOutput() AbacPolicyArrayOutput {
+	return i.ToAbacPolicyArrayOutputWithContext(context.Background())
+}
+
+func (i AbacPolicyArray) ToAbacPolicyArrayOutputWithContext(ctx context.Context) AbacPolicyArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AbacPolicyArrayOutput)
+}
+
+// AbacPolicyMapInput is an input type that accepts AbacPolicyMap and AbacPolicyMapOutput values.
+// You can construct a concrete instance of `AbacPolicyMapInput` via:
+//
+//	AbacPolicyMap{ "key": AbacPolicyArgs{...} }
+type AbacPolicyMapInput interface {
+	pulumi.Input
+
+	ToAbacPolicyMapOutput() AbacPolicyMapOutput
+	ToAbacPolicyMapOutputWithContext(context.Context) AbacPolicyMapOutput
+}
+
+type AbacPolicyMap map[string]AbacPolicyInput
+
+func (AbacPolicyMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*AbacPolicy)(nil)).Elem()
+}
+
+func (i AbacPolicyMap) ToAbacPolicyMapOutput() AbacPolicyMapOutput {
+	return i.ToAbacPolicyMapOutputWithContext(context.Background())
+}
+
+func (i AbacPolicyMap) ToAbacPolicyMapOutputWithContext(ctx context.Context) AbacPolicyMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AbacPolicyMapOutput)
+}
+
+type AbacPolicyOutput struct{ *pulumi.OutputState }
+
+func (AbacPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AbacPolicy)(nil)).Elem()
+}
+
+func (o AbacPolicyOutput) ToAbacPolicyOutput() AbacPolicyOutput {
+	return o
+}
+
+func (o AbacPolicyOutput) ToAbacPolicyOutputWithContext(ctx context.Context) AbacPolicyOutput {
+	return o
+}
+
+// The ARN assigned by AWS to this policy.
+func (o AbacPolicyOutput) Arn() pulumi.StringOutput {
+	return o.ApplyT(func(v *AbacPolicy) pulumi.StringOutput { return v.Arn }).(pulumi.StringOutput)
+}
+
+// The description of the policy.
+func (o AbacPolicyOutput) Description() pulumi.StringOutput {
+	return o.ApplyT(func(v *AbacPolicy) pulumi.StringOutput { return v.Description }).(pulumi.StringOutput)
+}
+
+// The policy's ID.
+func (o AbacPolicyOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v *AbacPolicy) pulumi.StringOutput { return v.Id }).(pulumi.StringOutput)
+}
+
+// The name of the policy.
+func (o AbacPolicyOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *AbacPolicy) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// The path of the policy in IAM.
+func (o AbacPolicyOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v *AbacPolicy) pulumi.StringOutput { return v.Path }).(pulumi.StringOutput)
+}
+
+// Policy document as json.
+func (o AbacPolicyOutput) PolicyJson() pulumi.StringOutput {
+	return o.ApplyT(func(v *AbacPolicy) pulumi.StringOutput { return v.PolicyJson }).(pulumi.StringOutput)
+}
+
+type AbacPolicyArrayOutput struct{ *pulumi.OutputState }
+
+func (AbacPolicyArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*AbacPolicy)(nil)).Elem()
+}
+
+func (o AbacPolicyArrayOutput) ToAbacPolicyArrayOutput() AbacPolicyArrayOutput {
+	return o
+}
+
+func (o AbacPolicyArrayOutput) ToAbacPolicyArrayOutputWithContext(ctx context.Context) AbacPolicyArrayOutput {
+	return o
+}
+
+func (o AbacPolicyArrayOutput) Index(i pulumi.IntInput) AbacPolicyOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *AbacPolicy {
+		return vs[0].([]*AbacPolicy)[vs[1].(int)]
+	}).(AbacPolicyOutput)
+}
+
+type AbacPolicyMapOutput struct{ *pulumi.OutputState }
+
+func (AbacPolicyMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*AbacPolicy)(nil)).Elem()
+}
+
+func (o AbacPolicyMapOutput) ToAbacPolicyMapOutput() AbacPolicyMapOutput {
+	return o
+}
+
+func (o AbacPolicyMapOutput) ToAbacPolicyMapOutputWithContext(ctx context.Context) AbacPolicyMapOutput {
+	return o
+}
+
+func (o AbacPolicyMapOutput) MapIndex(k pulumi.StringInput) AbacPolicyOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *AbacPolicy {
+		return vs[0].(map[string]*AbacPolicy)[vs[1].(string)]
+	}).(AbacPolicyOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*AbacPolicyInput)(nil)).Elem(), &AbacPolicy{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AbacPolicyArrayInput)(nil)).Elem(), AbacPolicyArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AbacPolicyMapInput)(nil)).Elem(), AbacPolicyMap{})
+	pulumi.RegisterOutputType(AbacPolicyOutput{})
+	pulumi.RegisterOutputType(AbacPolicyArrayOutput{})
+	pulumi.RegisterOutputType(AbacPolicyMapOutput{})
+}
@@ -7,14 +7,15 @@ import (
 	"context"
 	"reflect"
 
-	"errors"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
-// This resource helps you manage an Iam Account's Alias and Password Policy. If your IAM Account Alias was previously
-// set (either via the AWS console or when AWS created your Account) you will see an error like the below:
+// This resource helps you manage an Iam Account's Alias and Password Policy. Both are optional, only the ones
+// provided will be managed. If your IAM Account Alias was previously set (either via the AWS console or when
+// AWS created your Account) you will see an error like the below:
 //
-// If you want to manage you Alias using Pulumi you will need to import this resource.
+// If you want to manage you Alias using Pulumi you will need to import this resource, which can be done by
+// setting `importAccountAlias`. An existing password policy can be adopted the same way with `importPasswordPolicy`.
 //
 // ## Example Usage
 // ## Account
@@ -73,14 +74,14 @@ type Account struct {
 func NewAccount(ctx *pulumi.Context,
 	name string, args *AccountArgs, opts ...pulumi.ResourceOption) (*Account, error) {
 	if args == nil {
-		return nil, errors.New("missing one or more required arguments")
+		args = &AccountArgs{}
 	}
 
-	if args.AccountAlias == nil {
-		return nil, errors.New("invalid value for required argument 'AccountAlias'")
+	if args.ImportAccountAlias == nil {
+		args.ImportAccountAlias = pulumi.BoolPtr(false)
 	}
-	if args.PasswordPolicy == nil {
-		return nil, errors.New("invalid value for required argument 'PasswordPolicy'")
+	if args.ImportPasswordPolicy == nil {
+		args.ImportPasswordPolicy = pulumi.BoolPtr(false)
 	}
 	var resource Account
 	err := ctx.RegisterRemoteComponentResource("aws-iam:index:Account", name, args, &resource, opts...)
@@ -91,20 +92,38 @@ func NewAccount(ctx *pulumi.Context,
 }
 
 type accountArgs struct {
-	// AWS IAM account alias for this account.
-	AccountAlias string `pulumi:"accountAlias"`
+	// AWS IAM account alias for this account. If not set, the account alias is not managed.
+	AccountAlias *string `pulumi:"accountAlias"`
+	// Whether to adopt an account alias that already exists with the same name instead of creating it.
+	ImportAccountAlias *bool `pulumi:"importAccountAlias"`
+	// Whether to adopt the password policy that already exists in the account instead of creating it. The
+	// provided settings must match the existing policy for the import to succeed.
+	ImportPasswordPolicy *bool `pulumi:"importPasswordPolicy"`
 	// Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords. If
 	// left empty the default AWS password policy will be applied.
-	PasswordPolicy AccountPasswordPolicy `pulumi:"passwordPolicy"`
+	PasswordPolicy *AccountPasswordPolicy `pulumi:"passwordPolicy"`
+	// Name of a predefined password policy to apply. Valid values are `cis`, which follows the CIS AWS Foundations
+	// Benchmark (minimum length of 14, 24 passwords remembered, passwords expire after 90 days). Settings
+	// provided in `passwordPolicy` take precedence over the preset, e.g. `requireSymbols: false`.
+	PasswordPolicyPreset *string `pulumi:"passwordPolicyPreset"`
 }
 
 // The set of arguments for constructing a Account resource.
 type AccountArgs struct {
-	// AWS IAM account alias for this account.
-	AccountAlias pulumi.StringInput
+	// AWS IAM account alias for this account. If not set, the account alias is not managed.
+	AccountAlias pulumi.StringPtrInput
+	// Whether to adopt an account alias that already exists with the same name instead of creating it.
+	ImportAccountAlias pulumi.BoolPtrInput
+	// Whether to adopt the password policy that already exists in the account instead of creating it. The
+	// provided settings must match the existing policy for the import to succeed.
+	ImportPasswordPolicy pulumi.BoolPtrInput
 	// Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords. If
 	// left empty the default AWS password policy will be applied.
-	PasswordPolicy AccountPasswordPolicyInput
+	PasswordPolicy AccountPasswordPolicyPtrInput
+	// Name of a predefined password policy to apply. Valid values are `cis`, which follows the CIS AWS Foundations
+	// Benchmark (minimum length of 14, 24 passwords remembered, passwords expire after 90 days). Settings
+	// provided in `passwordPolicy` take precedence over the preset, e.g. `requireSymbols: false`.
+	PasswordPolicyPreset pulumi.StringPtrInput
 }
 
 func (AccountArgs) ElementType() reflect.Type {
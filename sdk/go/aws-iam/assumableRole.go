@@ -51,8 +51,14 @@ import (
 type AssumableRole struct {
 	pulumi.ResourceState
 
+	// AWS CLI config with a profile per role, in INI format.
+	AwsConfig pulumi.StringOutput `pulumi:"awsConfig"`
+	// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+	AwsVaultConfig  pulumi.StringOutput    `pulumi:"awsVaultConfig"`
 	InstanceProfile pulumi.StringMapOutput `pulumi:"instanceProfile"`
 	Role            pulumi.StringMapOutput `pulumi:"role"`
+	// Session policy and AWS CLI / SDK snippets assuming the role.
+	Session RoleSessionHelpersOutput `pulumi:"session"`
 }
 
 // NewAssumableRole registers a new resource with the given unique name, arguments, and options.
@@ -62,6 +68,9 @@ func NewAssumableRole(ctx *pulumi.Context,
 		args = &AssumableRoleArgs{}
 	}
 
+	if args.AllowSessionTags == nil {
+		args.AllowSessionTags = pulumi.BoolPtr(false)
+	}
 	if args.AttachAdminPolicy == nil {
 		args.AttachAdminPolicy = pulumi.BoolPtr(false)
 	}
@@ -71,6 +80,9 @@ func NewAssumableRole(ctx *pulumi.Context,
 	if args.AttachReadonlyPolicy == nil {
 		args.AttachReadonlyPolicy = pulumi.BoolPtr(false)
 	}
+	if args.CreateInstanceProfile == nil {
+		args.CreateInstanceProfile = pulumi.BoolPtr(true)
+	}
 	if args.CustomRoleTrustPolicy == nil {
 		args.CustomRoleTrustPolicy = pulumi.StringPtr("")
 	}
@@ -83,6 +95,12 @@ func NewAssumableRole(ctx *pulumi.Context,
 	if args.MfaAge == nil {
 		args.MfaAge = pulumi.IntPtr(86400)
 	}
+	if args.Role != nil {
+		args.Role = args.Role.ToRoleWithMFAPtrOutput().ApplyT(func(v *RoleWithMFA) *RoleWithMFA { return v.Defaults() }).(RoleWithMFAPtrOutput)
+	}
+	if args.Session != nil {
+		args.Session = args.Session.ToRoleSessionPtrOutput().ApplyT(func(v *RoleSession) *RoleSession { return v.Defaults() }).(RoleSessionPtrOutput)
+	}
 	var resource AssumableRole
 	err := ctx.RegisterRemoteComponentResource("aws-iam:index:AssumableRole", name, args, &resource, opts...)
 	if err != nil {
@@ -92,61 +110,89 @@ func NewAssumableRole(ctx *pulumi.Context,
 }
 
 type assumableRoleArgs struct {
+	// Whether trusted entities are allowed to pass session tags (`sts:TagSession`) when assuming the role.
+	AllowSessionTags *bool `pulumi:"allowSessionTags"`
 	// Whether to attach an admin policy to a role.
 	AttachAdminPolicy *bool `pulumi:"attachAdminPolicy"`
 	// Whether to attach a poweruser policy to a role.
 	AttachPoweruserPolicy *bool `pulumi:"attachPoweruserPolicy"`
 	// Whether to attach a readonly policy to a role.
 	AttachReadonlyPolicy *bool `pulumi:"attachReadonlyPolicy"`
+	// Credentials assuming the roles in the rendered AWS CLI config.
+	AwsConfigSource *AWSConfigSource `pulumi:"awsConfigSource"`
+	// Whether to create an IAM instance profile for the role.
+	CreateInstanceProfile *bool `pulumi:"createInstanceProfile"`
 	// A custom role trust policy.
 	CustomRoleTrustPolicy *string `pulumi:"customRoleTrustPolicy"`
 	// Whether policies should be detached from this role when destroying.
 	ForceDetachPolicies *bool `pulumi:"forceDetachPolicies"`
+	// IAM instance profile created when `createInstanceProfile` is set.
+	InstanceProfile *InstanceProfileSettings `pulumi:"instanceProfile"`
 	// Maximum CLI/API session duration in seconds between 3600 and 43200.
 	MaxSessionDuration *int `pulumi:"maxSessionDuration"`
 	// Max age of valid MFA (in seconds) for roles which require MFA.
 	MfaAge *int `pulumi:"mfaAge"`
 	// An IAM role that requires MFA.
 	Role *RoleWithMFA `pulumi:"role"`
-	// STS ExternalId condition values to use with a role (when MFA is not required).
+	// STS ExternalId condition values to use with a role. Combined with the MFA conditions when MFA is required.
 	RoleStsExternalIds []string `pulumi:"roleStsExternalIds"`
+	// Settings of the session helpers rendered for the role.
+	Session *RoleSession `pulumi:"session"`
+	// Tag keys that trusted entities are allowed to pass as session tags.
+	SessionTagKeys []string `pulumi:"sessionTagKeys"`
 	// A map of tags to add.
 	Tags map[string]string `pulumi:"tags"`
+	// Additional conditions to add to the role trust policy.
+	TrustConditions []PolicyCondition `pulumi:"trustConditions"`
 	// Actions of STS.
 	TrustedRoleActions []string `pulumi:"trustedRoleActions"`
 	// ARNs of AWS entities who can assume these roles.
 	TrustedRoleArns []string `pulumi:"trustedRoleArns"`
-	// AWS Services that can assume these roles.
+	// AWS Services that can assume these roles. They are trusted without the MFA and ExternalId conditions.
 	TrustedRoleServices []string `pulumi:"trustedRoleServices"`
 }
 
 // The set of arguments for constructing a AssumableRole resource.
 type AssumableRoleArgs struct {
+	// Whether trusted entities are allowed to pass session tags (`sts:TagSession`) when assuming the role.
+	AllowSessionTags pulumi.BoolPtrInput
 	// Whether to attach an admin policy to a role.
 	AttachAdminPolicy pulumi.BoolPtrInput
 	// Whether to attach a poweruser policy to a role.
 	AttachPoweruserPolicy pulumi.BoolPtrInput
 	// Whether to attach a readonly policy to a role.
 	AttachReadonlyPolicy pulumi.BoolPtrInput
+	// Credentials assuming the roles in the rendered AWS CLI config.
+	AwsConfigSource AWSConfigSourcePtrInput
+	// Whether to create an IAM instance profile for the role.
+	CreateInstanceProfile pulumi.BoolPtrInput
 	// A custom role trust policy.
 	CustomRoleTrustPolicy pulumi.StringPtrInput
 	// Whether policies should be detached from this role when destroying.
 	ForceDetachPolicies pulumi.BoolPtrInput
+	// IAM instance profile created when `createInstanceProfile` is set.
+	InstanceProfile InstanceProfileSettingsPtrInput
 	// Maximum CLI/API session duration in seconds between 3600 and 43200.
 	MaxSessionDuration pulumi.IntPtrInput
 	// Max age of valid MFA (in seconds) for roles which require MFA.
 	MfaAge pulumi.IntPtrInput
 	// An IAM role that requires MFA.
 	Role RoleWithMFAPtrInput
-	// STS ExternalId condition values to use with a role (when MFA is not required).
+	// STS ExternalId condition values to use with a role. Combined with the MFA conditions when MFA is required.
 	RoleStsExternalIds pulumi.StringArrayInput
+	// Settings of the session helpers rendered for the role.
+	Session RoleSessionPtrInput
+	// Tag keys that trusted entities are allowed to pass as session tags.
+	SessionTagKeys pulumi.StringArrayInput
 	// A map of tags to add.
 	Tags pulumi.StringMapInput
+	// Additional conditions to add to the role trust policy.
+	TrustConditions PolicyConditionArrayInput
 	// Actions of STS.
 	TrustedRoleActions pulumi.StringArrayInput
 	// ARNs of AWS entities who can assume these roles.
 	TrustedRoleArns pulumi.StringArrayInput
-	// AWS Services that can assume these roles.
+	// AWS Services that can assume these roles. They are trusted without the MFA and ExternalId conditions.
 	TrustedRoleServices pulumi.StringArrayInput
 }
 
@@ -237,6 +283,16 @@ func (o AssumableRoleOutput) ToAssumableRoleOutputWithContext(ctx context.Contex
 	return o
 }
 
+// AWS CLI config with a profile per role, in INI format.
+func (o AssumableRoleOutput) AwsConfig() pulumi.StringOutput {
+	return o.ApplyT(func(v *AssumableRole) pulumi.StringOutput { return v.AwsConfig }).(pulumi.StringOutput)
+}
+
+// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+func (o AssumableRoleOutput) AwsVaultConfig() pulumi.StringOutput {
+	return o.ApplyT(func(v *AssumableRole) pulumi.StringOutput { return v.AwsVaultConfig }).(pulumi.StringOutput)
+}
+
 func (o AssumableRoleOutput) InstanceProfile() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *AssumableRole) pulumi.StringMapOutput { return v.InstanceProfile }).(pulumi.StringMapOutput)
 }
@@ -245,6 +301,11 @@ func (o AssumableRoleOutput) Role() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *AssumableRole) pulumi.StringMapOutput { return v.Role }).(pulumi.StringMapOutput)
 }
 
+// Session policy and AWS CLI / SDK snippets assuming the role.
+func (o AssumableRoleOutput) Session() RoleSessionHelpersOutput {
+	return o.ApplyT(func(v *AssumableRole) RoleSessionHelpersOutput { return v.Session }).(RoleSessionHelpersOutput)
+}
+
 type AssumableRoleArrayOutput struct{ *pulumi.OutputState }
 
 func (AssumableRoleArrayOutput) ElementType() reflect.Type {
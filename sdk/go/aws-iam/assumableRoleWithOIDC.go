@@ -59,6 +59,8 @@ type AssumableRoleWithOIDC struct {
 	Name pulumi.StringOutput `pulumi:"name"`
 	// Path of IAM role.
 	Path pulumi.StringOutput `pulumi:"path"`
+	// Session policy and AWS CLI / SDK snippets assuming the role.
+	Session RoleSessionHelpersOutput `pulumi:"session"`
 	// Unique ID of IAM role.
 	UniqueId pulumi.StringOutput `pulumi:"uniqueId"`
 }
@@ -70,6 +72,9 @@ func NewAssumableRoleWithOIDC(ctx *pulumi.Context,
 		args = &AssumableRoleWithOIDCArgs{}
 	}
 
+	if args.AllowSessionTags == nil {
+		args.AllowSessionTags = pulumi.BoolPtr(false)
+	}
 	if args.AwsAccountId == nil {
 		args.AwsAccountId = pulumi.StringPtr("")
 	}
@@ -79,6 +84,12 @@ func NewAssumableRoleWithOIDC(ctx *pulumi.Context,
 	if args.MaxSessionDuration == nil {
 		args.MaxSessionDuration = pulumi.IntPtr(3600)
 	}
+	if args.Role != nil {
+		args.Role = args.Role.ToRolePtrOutput().ApplyT(func(v *Role) *Role { return v.Defaults() }).(RolePtrOutput)
+	}
+	if args.Session != nil {
+		args.Session = args.Session.ToRoleSessionPtrOutput().ApplyT(func(v *RoleSession) *RoleSession { return v.Defaults() }).(RoleSessionPtrOutput)
+	}
 	var resource AssumableRoleWithOIDC
 	err := ctx.RegisterRemoteComponentResource("aws-iam:index:AssumableRoleWithOIDC", name, args, &resource, opts...)
 	if err != nil {
@@ -88,6 +99,8 @@ func NewAssumableRoleWithOIDC(ctx *pulumi.Context,
 }
 
 type assumableRoleWithOIDCArgs struct {
+	// Whether the OIDC provider is allowed to pass session tags (`sts:TagSession`).
+	AllowSessionTags *bool `pulumi:"allowSessionTags"`
 	// The AWS account ID where the OIDC provider lives, leave empty to use the account for the AWS provider.
 	AwsAccountId *string `pulumi:"awsAccountId"`
 	// Whether policies should be detached from this role when destroying.
@@ -102,14 +115,23 @@ type assumableRoleWithOIDCArgs struct {
 	OidcSubjectsWithWildcards []string `pulumi:"oidcSubjectsWithWildcards"`
 	// List of URLs of the OIDC Providers.
 	ProviderUrls []string `pulumi:"providerUrls"`
+	// OIDC Providers with their own account, subjects and audiences. Used together with `providerUrls`,
+	// all providers become statements of the same trust policy.
+	Providers []AssumableRoleWithOIDCProvider `pulumi:"providers"`
 	// The IAM role.
 	Role *Role `pulumi:"role"`
+	// Settings of the session helpers rendered for the role.
+	Session *RoleSession `pulumi:"session"`
+	// Tag keys that the OIDC provider is allowed to pass as session tags.
+	SessionTagKeys []string `pulumi:"sessionTagKeys"`
 	// A map of tags to add.
 	Tags map[string]string `pulumi:"tags"`
 }
 
 // The set of arguments for constructing a AssumableRoleWithOIDC resource.
 type AssumableRoleWithOIDCArgs struct {
+	// Whether the OIDC provider is allowed to pass session tags (`sts:TagSession`).
+	AllowSessionTags pulumi.BoolPtrInput
 	// The AWS account ID where the OIDC provider lives, leave empty to use the account for the AWS provider.
 	AwsAccountId pulumi.StringPtrInput
 	// Whether policies should be detached from this role when destroying.
@@ -124,8 +146,15 @@ type AssumableRoleWithOIDCArgs struct {
 	OidcSubjectsWithWildcards pulumi.StringArrayInput
 	// List of URLs of the OIDC Providers.
 	ProviderUrls pulumi.StringArrayInput
+	// OIDC Providers with their own account, subjects and audiences. Used together with `providerUrls`,
+	// all providers become statements of the same trust policy.
+	Providers AssumableRoleWithOIDCProviderArrayInput
 	// The IAM role.
 	Role RolePtrInput
+	// Settings of the session helpers rendered for the role.
+	Session RoleSessionPtrInput
+	// Tag keys that the OIDC provider is allowed to pass as session tags.
+	SessionTagKeys pulumi.StringArrayInput
 	// A map of tags to add.
 	Tags pulumi.StringMapInput
 }
@@ -232,6 +261,11 @@ func (o AssumableRoleWithOIDCOutput) Path() pulumi.StringOutput {
 	return o.ApplyT(func(v *AssumableRoleWithOIDC) pulumi.StringOutput { return v.Path }).(pulumi.StringOutput)
 }
 
+// Session policy and AWS CLI / SDK snippets assuming the role.
+func (o AssumableRoleWithOIDCOutput) Session() RoleSessionHelpersOutput {
+	return o.ApplyT(func(v *AssumableRoleWithOIDC) RoleSessionHelpersOutput { return v.Session }).(RoleSessionHelpersOutput)
+}
+
 // Unique ID of IAM role.
 func (o AssumableRoleWithOIDCOutput) UniqueId() pulumi.StringOutput {
 	return o.ApplyT(func(v *AssumableRoleWithOIDC) pulumi.StringOutput { return v.UniqueId }).(pulumi.StringOutput)
@@ -70,6 +70,12 @@ func NewAssumableRoleWithSAML(ctx *pulumi.Context,
 		args = &AssumableRoleWithSAMLArgs{}
 	}
 
+	if args.AllowSessionTags == nil {
+		args.AllowSessionTags = pulumi.BoolPtr(false)
+	}
+	if args.AllowSourceIdentity == nil {
+		args.AllowSourceIdentity = pulumi.BoolPtr(false)
+	}
 	if args.AwsSamlEndpoint == nil {
 		args.AwsSamlEndpoint = pulumi.StringPtr("https://signin.aws.amazon.com/saml")
 	}
@@ -79,6 +85,9 @@ func NewAssumableRoleWithSAML(ctx *pulumi.Context,
 	if args.MaxSessionDuration == nil {
 		args.MaxSessionDuration = pulumi.IntPtr(3600)
 	}
+	if args.Role != nil {
+		args.Role = args.Role.ToRolePtrOutput().ApplyT(func(v *Role) *Role { return v.Defaults() }).(RolePtrOutput)
+	}
 	var resource AssumableRoleWithSAML
 	err := ctx.RegisterRemoteComponentResource("aws-iam:index:AssumableRoleWithSAML", name, args, &resource, opts...)
 	if err != nil {
@@ -88,32 +97,74 @@ func NewAssumableRoleWithSAML(ctx *pulumi.Context,
 }
 
 type assumableRoleWithSAMLArgs struct {
+	// Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+	AllowSessionTags *bool `pulumi:"allowSessionTags"`
+	// Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
+	AllowSourceIdentity *bool `pulumi:"allowSourceIdentity"`
 	// AWS SAML Endpoint.
 	AwsSamlEndpoint *string `pulumi:"awsSamlEndpoint"`
+	// List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+	// `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
+	AwsSamlEndpoints []string `pulumi:"awsSamlEndpoints"`
 	// Whether policies should be detached from this role when destroying.
 	ForceDetachPolicies *bool `pulumi:"forceDetachPolicies"`
 	// Maximum CLI/API session duration in seconds between 3600 and 43200.
 	MaxSessionDuration *int `pulumi:"maxSessionDuration"`
+	// Map of session tag keys passed by the IdP to the values allowed to assume the role, matched as
+	// `aws:RequestTag/<key>`. Implies `allowSessionTags`.
+	PrincipalTags map[string][]string `pulumi:"principalTags"`
 	// List of SAML Provider IDs.
 	ProviderIds []string `pulumi:"providerIds"`
 	Role        *Role    `pulumi:"role"`
+	// Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the role.
+	// Attributes can be multivalued, a match on any of the values is enough.
+	SamlAttributes map[string][]string `pulumi:"samlAttributes"`
+	// Values of `SAML:iss` allowed to assume the role.
+	SamlIssuers []string `pulumi:"samlIssuers"`
+	// Values of `SAML:sub` allowed to assume the role.
+	SamlSubjects []string `pulumi:"samlSubjects"`
+	// Tag keys that the IdP is allowed to pass as session tags.
+	SessionTagKeys []string `pulumi:"sessionTagKeys"`
 	// A map of tags to add.
 	Tags map[string]string `pulumi:"tags"`
+	// Additional conditions to add to the trust policy.
+	TrustConditions []PolicyCondition `pulumi:"trustConditions"`
 }
 
 // The set of arguments for constructing a AssumableRoleWithSAML resource.
 type AssumableRoleWithSAMLArgs struct {
+	// Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+	AllowSessionTags pulumi.BoolPtrInput
+	// Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
+	AllowSourceIdentity pulumi.BoolPtrInput
 	// AWS SAML Endpoint.
 	AwsSamlEndpoint pulumi.StringPtrInput
+	// List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+	// `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
+	AwsSamlEndpoints pulumi.StringArrayInput
 	// Whether policies should be detached from this role when destroying.
 	ForceDetachPolicies pulumi.BoolPtrInput
 	// Maximum CLI/API session duration in seconds between 3600 and 43200.
 	MaxSessionDuration pulumi.IntPtrInput
+	// Map of session tag keys passed by the IdP to the values allowed to assume the role, matched as
+	// `aws:RequestTag/<key>`. Implies `allowSessionTags`.
+	PrincipalTags pulumi.StringArrayMapInput
 	// List of SAML Provider IDs.
 	ProviderIds pulumi.StringArrayInput
 	Role        RolePtrInput
+	// Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the role.
+	// Attributes can be multivalued, a match on any of the values is enough.
+	SamlAttributes pulumi.StringArrayMapInput
+	// Values of `SAML:iss` allowed to assume the role.
+	SamlIssuers pulumi.StringArrayInput
+	// Values of `SAML:sub` allowed to assume the role.
+	SamlSubjects pulumi.StringArrayInput
+	// Tag keys that the IdP is allowed to pass as session tags.
+	SessionTagKeys pulumi.StringArrayInput
 	// A map of tags to add.
 	Tags pulumi.StringMapInput
+	// Additional conditions to add to the trust policy.
+	TrustConditions PolicyConditionArrayInput
 }
 
 func (AssumableRoleWithSAMLArgs) ElementType() reflect.Type {
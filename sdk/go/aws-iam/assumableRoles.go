@@ -55,9 +55,15 @@ import (
 type AssumableRoles struct {
 	pulumi.ResourceState
 
-	Admin     pulumi.StringMapOutput `pulumi:"admin"`
-	Poweruser pulumi.StringMapOutput `pulumi:"poweruser"`
-	Readonly  pulumi.StringMapOutput `pulumi:"readonly"`
+	Admin pulumi.StringMapOutput `pulumi:"admin"`
+	// The `mapRoles` entries of the aws-auth ConfigMap granting the roles access to EKS clusters, as YAML.
+	AwsAuthMapRoles pulumi.StringOutput `pulumi:"awsAuthMapRoles"`
+	// AWS CLI config with a profile per role, in INI format.
+	AwsConfig pulumi.StringOutput `pulumi:"awsConfig"`
+	// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+	AwsVaultConfig pulumi.StringOutput    `pulumi:"awsVaultConfig"`
+	Poweruser      pulumi.StringMapOutput `pulumi:"poweruser"`
+	Readonly       pulumi.StringMapOutput `pulumi:"readonly"`
 }
 
 // NewAssumableRoles registers a new resource with the given unique name, arguments, and options.
@@ -70,6 +76,7 @@ func NewAssumableRoles(ctx *pulumi.Context,
 	if args.Admin == nil {
 		return nil, errors.New("invalid value for required argument 'Admin'")
 	}
+	args.Admin = args.Admin.ToAdminRoleWithMFAOutput().ApplyT(func(v AdminRoleWithMFA) AdminRoleWithMFA { return *v.Defaults() }).(AdminRoleWithMFAOutput)
 	if args.ForceDetachPolicies == nil {
 		args.ForceDetachPolicies = pulumi.BoolPtr(false)
 	}
@@ -79,6 +86,12 @@ func NewAssumableRoles(ctx *pulumi.Context,
 	if args.MfaAge == nil {
 		args.MfaAge = pulumi.IntPtr(86400)
 	}
+	if args.Poweruser != nil {
+		args.Poweruser = args.Poweruser.ToPoweruserRoleWithMFAPtrOutput().ApplyT(func(v *PoweruserRoleWithMFA) *PoweruserRoleWithMFA { return v.Defaults() }).(PoweruserRoleWithMFAPtrOutput)
+	}
+	if args.Readonly != nil {
+		args.Readonly = args.Readonly.ToReadonlyRoleWithMFAPtrOutput().ApplyT(func(v *ReadonlyRoleWithMFA) *ReadonlyRoleWithMFA { return v.Defaults() }).(ReadonlyRoleWithMFAPtrOutput)
+	}
 	var resource AssumableRoles
 	err := ctx.RegisterRemoteComponentResource("aws-iam:index:AssumableRoles", name, args, &resource, opts...)
 	if err != nil {
@@ -89,6 +102,10 @@ func NewAssumableRoles(ctx *pulumi.Context,
 
 type assumableRolesArgs struct {
 	Admin AdminRoleWithMFA `pulumi:"admin"`
+	// Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+	AwsAuth *AWSAuth `pulumi:"awsAuth"`
+	// Credentials assuming the roles in the rendered AWS CLI config.
+	AwsConfigSource *AWSConfigSource `pulumi:"awsConfigSource"`
 	// Whether policies should be detached from this role when destroying.
 	ForceDetachPolicies *bool `pulumi:"forceDetachPolicies"`
 	// Maximum CLI/API session duration in seconds between 3600 and 43200.
@@ -106,6 +123,10 @@ type assumableRolesArgs struct {
 // The set of arguments for constructing a AssumableRoles resource.
 type AssumableRolesArgs struct {
 	Admin AdminRoleWithMFAInput
+	// Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+	AwsAuth AWSAuthPtrInput
+	// Credentials assuming the roles in the rendered AWS CLI config.
+	AwsConfigSource AWSConfigSourcePtrInput
 	// Whether policies should be detached from this role when destroying.
 	ForceDetachPolicies pulumi.BoolPtrInput
 	// Maximum CLI/API session duration in seconds between 3600 and 43200.
@@ -211,6 +232,21 @@ func (o AssumableRolesOutput) Admin() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *AssumableRoles) pulumi.StringMapOutput { return v.Admin }).(pulumi.StringMapOutput)
 }
 
+// The `mapRoles` entries of the aws-auth ConfigMap granting the roles access to EKS clusters, as YAML.
+func (o AssumableRolesOutput) AwsAuthMapRoles() pulumi.StringOutput {
+	return o.ApplyT(func(v *AssumableRoles) pulumi.StringOutput { return v.AwsAuthMapRoles }).(pulumi.StringOutput)
+}
+
+// AWS CLI config with a profile per role, in INI format.
+func (o AssumableRolesOutput) AwsConfig() pulumi.StringOutput {
+	return o.ApplyT(func(v *AssumableRoles) pulumi.StringOutput { return v.AwsConfig }).(pulumi.StringOutput)
+}
+
+// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+func (o AssumableRolesOutput) AwsVaultConfig() pulumi.StringOutput {
+	return o.ApplyT(func(v *AssumableRoles) pulumi.StringOutput { return v.AwsVaultConfig }).(pulumi.StringOutput)
+}
+
 func (o AssumableRolesOutput) Poweruser() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *AssumableRoles) pulumi.StringMapOutput { return v.Poweruser }).(pulumi.StringMapOutput)
 }
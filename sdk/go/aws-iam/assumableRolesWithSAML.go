@@ -51,9 +51,15 @@ import (
 type AssumableRolesWithSAML struct {
 	pulumi.ResourceState
 
-	Admin     pulumi.StringMapOutput `pulumi:"admin"`
-	Poweruser pulumi.StringMapOutput `pulumi:"poweruser"`
-	Readonly  pulumi.StringMapOutput `pulumi:"readonly"`
+	Admin pulumi.StringMapOutput `pulumi:"admin"`
+	// The `mapRoles` entries of the aws-auth ConfigMap granting the roles access to EKS clusters, as YAML.
+	AwsAuthMapRoles pulumi.StringOutput `pulumi:"awsAuthMapRoles"`
+	// AWS CLI config with a profile per role, in INI format.
+	AwsConfig pulumi.StringOutput `pulumi:"awsConfig"`
+	// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+	AwsVaultConfig pulumi.StringOutput    `pulumi:"awsVaultConfig"`
+	Poweruser      pulumi.StringMapOutput `pulumi:"poweruser"`
+	Readonly       pulumi.StringMapOutput `pulumi:"readonly"`
 }
 
 // NewAssumableRolesWithSAML registers a new resource with the given unique name, arguments, and options.
@@ -66,6 +72,12 @@ func NewAssumableRolesWithSAML(ctx *pulumi.Context,
 	if args.Admin != nil {
 		args.Admin = args.Admin.ToAdminRolePtrOutput().ApplyT(func(v *AdminRole) *AdminRole { return v.Defaults() }).(AdminRolePtrOutput)
 	}
+	if args.AllowSessionTags == nil {
+		args.AllowSessionTags = pulumi.BoolPtr(false)
+	}
+	if args.AllowSourceIdentity == nil {
+		args.AllowSourceIdentity = pulumi.BoolPtr(false)
+	}
 	if args.AwsSamlEndpoint == nil {
 		args.AwsSamlEndpoint = pulumi.StringPtr("https://signin.aws.amazon.com/saml")
 	}
@@ -75,6 +87,12 @@ func NewAssumableRolesWithSAML(ctx *pulumi.Context,
 	if args.MaxSessionDuration == nil {
 		args.MaxSessionDuration = pulumi.IntPtr(3600)
 	}
+	if args.Poweruser != nil {
+		args.Poweruser = args.Poweruser.ToPoweruserRolePtrOutput().ApplyT(func(v *PoweruserRole) *PoweruserRole { return v.Defaults() }).(PoweruserRolePtrOutput)
+	}
+	if args.Readonly != nil {
+		args.Readonly = args.Readonly.ToReadonlyRolePtrOutput().ApplyT(func(v *ReadonlyRole) *ReadonlyRole { return v.Defaults() }).(ReadonlyRolePtrOutput)
+	}
 	var resource AssumableRolesWithSAML
 	err := ctx.RegisterRemoteComponentResource("aws-iam:index:AssumableRolesWithSAML", name, args, &resource, opts...)
 	if err != nil {
@@ -85,31 +103,83 @@ func NewAssumableRolesWithSAML(ctx *pulumi.Context,
 
 type assumableRolesWithSAMLArgs struct {
 	Admin *AdminRole `pulumi:"admin"`
+	// Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+	AllowSessionTags *bool `pulumi:"allowSessionTags"`
+	// Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
+	AllowSourceIdentity *bool `pulumi:"allowSourceIdentity"`
+	// Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+	AwsAuth *AWSAuth `pulumi:"awsAuth"`
+	// Credentials assuming the roles in the rendered AWS CLI config. Only a `credentialProcess` with a `{role_arn}`
+	// placeholder can sign in to the roles, the AWS CLI config is empty without it.
+	AwsConfigSource *AWSConfigSource `pulumi:"awsConfigSource"`
 	// AWS SAML Endpoint.
 	AwsSamlEndpoint *string `pulumi:"awsSamlEndpoint"`
+	// List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+	// `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
+	AwsSamlEndpoints []string `pulumi:"awsSamlEndpoints"`
 	// Whether policies should be detached from this role when destroying.
 	ForceDetachPolicies *bool `pulumi:"forceDetachPolicies"`
 	// Maximum CLI/API session duration in seconds between 3600 and 43200.
 	MaxSessionDuration *int           `pulumi:"maxSessionDuration"`
 	Poweruser          *PoweruserRole `pulumi:"poweruser"`
+	// Map of session tag keys passed by the IdP to the values allowed to assume the roles, matched as
+	// `aws:RequestTag/<key>`. Implies `allowSessionTags`.
+	PrincipalTags map[string][]string `pulumi:"principalTags"`
 	// List of SAML Provider IDs.
 	ProviderIds []string      `pulumi:"providerIds"`
 	Readonly    *ReadonlyRole `pulumi:"readonly"`
+	// Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the roles.
+	// Attributes can be multivalued, a match on any of the values is enough.
+	SamlAttributes map[string][]string `pulumi:"samlAttributes"`
+	// Values of `SAML:iss` allowed to assume the roles.
+	SamlIssuers []string `pulumi:"samlIssuers"`
+	// Values of `SAML:sub` allowed to assume the roles.
+	SamlSubjects []string `pulumi:"samlSubjects"`
+	// Tag keys that the IdP is allowed to pass as session tags.
+	SessionTagKeys []string `pulumi:"sessionTagKeys"`
+	// Additional conditions to add to the trust policy.
+	TrustConditions []PolicyCondition `pulumi:"trustConditions"`
 }
 
 // The set of arguments for constructing a AssumableRolesWithSAML resource.
 type AssumableRolesWithSAMLArgs struct {
 	Admin AdminRolePtrInput
+	// Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+	AllowSessionTags pulumi.BoolPtrInput
+	// Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
+	AllowSourceIdentity pulumi.BoolPtrInput
+	// Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+	AwsAuth AWSAuthPtrInput
+	// Credentials assuming the roles in the rendered AWS CLI config. Only a `credentialProcess` with a `{role_arn}`
+	// placeholder can sign in to the roles, the AWS CLI config is empty without it.
+	AwsConfigSource AWSConfigSourcePtrInput
 	// AWS SAML Endpoint.
 	AwsSamlEndpoint pulumi.StringPtrInput
+	// List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+	// `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
+	AwsSamlEndpoints pulumi.StringArrayInput
 	// Whether policies should be detached from this role when destroying.
 	ForceDetachPolicies pulumi.BoolPtrInput
 	// Maximum CLI/API session duration in seconds between 3600 and 43200.
 	MaxSessionDuration pulumi.IntPtrInput
 	Poweruser          PoweruserRolePtrInput
+	// Map of session tag keys passed by the IdP to the values allowed to assume the roles, matched as
+	// `aws:RequestTag/<key>`. Implies `allowSessionTags`.
+	PrincipalTags pulumi.StringArrayMapInput
 	// List of SAML Provider IDs.
 	ProviderIds pulumi.StringArrayInput
 	Readonly    ReadonlyRolePtrInput
+	// Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the roles.
+	// Attributes can be multivalued, a match on any of the values is enough.
+	SamlAttributes pulumi.StringArrayMapInput
+	// Values of `SAML:iss` allowed to assume the roles.
+	SamlIssuers pulumi.StringArrayInput
+	// Values of `SAML:sub` allowed to assume the roles.
+	SamlSubjects pulumi.StringArrayInput
+	// Tag keys that the IdP is allowed to pass as session tags.
+	SessionTagKeys pulumi.StringArrayInput
+	// Additional conditions to add to the trust policy.
+	TrustConditions PolicyConditionArrayInput
 }
 
 func (AssumableRolesWithSAMLArgs) ElementType() reflect.Type {
@@ -203,6 +273,21 @@ func (o AssumableRolesWithSAMLOutput) Admin() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *AssumableRolesWithSAML) pulumi.StringMapOutput { return v.Admin }).(pulumi.StringMapOutput)
 }
 
+// The `mapRoles` entries of the aws-auth ConfigMap granting the roles access to EKS clusters, as YAML.
+func (o AssumableRolesWithSAMLOutput) AwsAuthMapRoles() pulumi.StringOutput {
+	return o.ApplyT(func(v *AssumableRolesWithSAML) pulumi.StringOutput { return v.AwsAuthMapRoles }).(pulumi.StringOutput)
+}
+
+// AWS CLI config with a profile per role, in INI format.
+func (o AssumableRolesWithSAMLOutput) AwsConfig() pulumi.StringOutput {
+	return o.ApplyT(func(v *AssumableRolesWithSAML) pulumi.StringOutput { return v.AwsConfig }).(pulumi.StringOutput)
+}
+
+// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+func (o AssumableRolesWithSAMLOutput) AwsVaultConfig() pulumi.StringOutput {
+	return o.ApplyT(func(v *AssumableRolesWithSAML) pulumi.StringOutput { return v.AwsVaultConfig }).(pulumi.StringOutput)
+}
+
 func (o AssumableRolesWithSAMLOutput) Poweruser() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *AssumableRolesWithSAML) pulumi.StringMapOutput { return v.Poweruser }).(pulumi.StringMapOutput)
 }
@@ -0,0 +1,247 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package awsiam
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// This resource renders an AWS CLI config with a profile per IAM role, e.g. the roles of `AssumableRoles` or
+// `AssumableRolesWithSAML`, ready to be published to engineers. The roles are assumed with the credentials
+// of a source profile, a credential process or an IAM Identity Center session. A second variant of the
+// config uses the legacy IAM Identity Center settings supported by granted and aws-vault.
+//
+// ## Example Usage
+// ## AWS Config Profiles
+//
+// ```go
+// package main
+//
+// import (
+//
+//	iam "github.com/pulumi/pulumi-aws-iam/sdk/go/aws-iam"
+//	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+//
+// )
+//
+//	func main() {
+//	    pulumi.Run(func(ctx *pulumi.Context) error {
+//	        profiles, err := iam.NewAWSConfigProfiles(ctx, "aws-config-profiles", &iam.AWSConfigProfilesArgs{
+//	            Source: iam.AWSConfigSourceArgs{
+//	                ProfilePrefix: pulumi.String("acme-"),
+//	                SourceProfile: pulumi.String("acme-identity"),
+//	                MfaSerial:     pulumi.String("arn:aws:iam::111111111111:mfa/jane"),
+//	            },
+//	            Roles: iam.AWSConfigRoleArray{
+//	                iam.AWSConfigRoleArgs{
+//	                    ProfileName:     pulumi.String("admin"),
+//	                    RoleArn:         pulumi.String("arn:aws:iam::222222222222:role/admin"),
+//	                    RequiresMfa:     pulumi.BoolPtr(true),
+//	                    DurationSeconds: pulumi.IntPtr(3600),
+//	                },
+//	            },
+//	        })
+//	        if err != nil {
+//	            return err
+//	        }
+//
+//	        ctx.Export("profiles", profiles)
+//
+//	        return nil
+//	    })
+//	}
+//
+// ```
+// {{ /example }}
+type AWSConfigProfiles struct {
+	pulumi.ResourceState
+
+	// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+	AwsVaultConfig pulumi.StringOutput `pulumi:"awsVaultConfig"`
+	// AWS CLI config with a profile per role, in INI format.
+	Config pulumi.StringOutput `pulumi:"config"`
+}
+
+// NewAWSConfigProfiles registers a new resource with the given unique name, arguments, and options.
+func NewAWSConfigProfiles(ctx *pulumi.Context,
+	name string, args *AWSConfigProfilesArgs, opts ...pulumi.ResourceOption) (*AWSConfigProfiles, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Roles == nil {
+		return nil, errors.New("invalid value for required argument 'Roles'")
+	}
+	var resource AWSConfigProfiles
+	err := ctx.RegisterRemoteComponentResource("aws-iam:index:AWSConfigProfiles", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type awsconfigProfilesArgs struct {
+	// Roles to render a profile for.
+	Roles []AWSConfigRole `pulumi:"roles"`
+	// Credentials assuming the roles.
+	Source *AWSConfigSource `pulumi:"source"`
+}
+
+// The set of arguments for constructing a AWSConfigProfiles resource.
+type AWSConfigProfilesArgs struct {
+	// Roles to render a profile for.
+	Roles AWSConfigRoleArrayInput
+	// Credentials assuming the roles.
+	Source AWSConfigSourcePtrInput
+}
+
+func (AWSConfigProfilesArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*awsconfigProfilesArgs)(nil)).Elem()
+}
+
+type AWSConfigProfilesInput interface {
+	pulumi.Input
+
+	ToAWSConfigProfilesOutput() AWSConfigProfilesOutput
+	ToAWSConfigProfilesOutputWithContext(ctx context.Context) AWSConfigProfilesOutput
+}
+
+func (*AWSConfigProfiles) ElementType() reflect.Type {
+	return reflect.TypeOf((**AWSConfigProfiles)(nil)).Elem()
+}
+
+func (i *AWSConfigProfiles) ToAWSConfigProfilesOutput() AWSConfigProfilesOutput {
+	return i.ToAWSConfigProfilesOutputWithContext(context.Background())
+}
+
+func (i *AWSConfigProfiles) ToAWSConfigProfilesOutputWithContext(ctx context.Context) AWSConfigProfilesOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSConfigProfilesOutput)
+}
+
+// AWSConfigProfilesArrayInput is an input type that accepts AWSConfigProfilesArray and AWSConfigProfilesArrayOutput values.
+// You can construct a concrete instance of `AWSConfigProfilesArrayInput` via:
+//
+//	AWSConfigProfilesArray{ AWSConfigProfilesArgs{...} }
+type AWSConfigProfilesArrayInput interface {
+	pulumi.Input
+
+	ToAWSConfigProfilesArrayOutput() AWSConfigProfilesArrayOutput
+	ToAWSConfigProfilesArrayOutputWithContext(context.Context) AWSConfigProfilesArrayOutput
+}
+
+type AWSConfigProfilesArray []AWSConfigProfilesInput
+
+func (AWSConfigProfilesArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*AWSConfigProfiles)(nil)).Elem()
+}
+
+func (i AWSConfigProfilesArray) ToAWSConfigProfilesArrayOutput() AWSConfigProfilesArrayOutput {
+	return i.ToAWSConfigProfilesArrayOutputWithContext(context.Background())
+}
+
+func (i AWSConfigProfilesArray) ToAWSConfigProfilesArrayOutputWithContext(ctx context.Context) AWSConfigProfilesArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSConfigProfilesArrayOutput)
+}
+
+// AWSConfigProfilesMapInput is an input type that accepts AWSConfigProfilesMap and AWSConfigProfilesMapOutput values.
+// You can construct a concrete instance of `AWSConfigProfilesMapInput` via:
+//
+//	AWSConfigProfilesMap{ "key": AWSConfigProfilesArgs{...} }
+type AWSConfigProfilesMapInput interface {
+	pulumi.Input
+
+	ToAWSConfigProfilesMapOutput() AWSConfigProfilesMapOutput
+	ToAWSConfigProfilesMapOutputWithContext(context.Context) AWSConfigProfilesMapOutput
+}
+
+type AWSConfigProfilesMap map[string]AWSConfigProfilesInput
+
+func (AWSConfigProfilesMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*AWSConfigProfiles)(nil)).Elem()
+}
+
+func (i AWSConfigProfilesMap) ToAWSConfigProfilesMapOutput() AWSConfigProfilesMapOutput {
+	return i.ToAWSConfigProfilesMapOutputWithContext(context.Background())
+}
+
+func (i AWSConfigProfilesMap) ToAWSConfigProfilesMapOutputWithContext(ctx context.Context) AWSConfigProfilesMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSConfigProfilesMapOutput)
+}
+
+type AWSConfigProfilesOutput struct{ *pulumi.OutputState }
+
+func (AWSConfigProfilesOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AWSConfigProfiles)(nil)).Elem()
+}
+
+func (o AWSConfigProfilesOutput) ToAWSConfigProfilesOutput() AWSConfigProfilesOutput {
+	return o
+}
+
+func (o AWSConfigProfilesOutput) ToAWSConfigProfilesOutputWithContext(ctx context.Context) AWSConfigProfilesOutput {
+	return o
+}
+
+// AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+func (o AWSConfigProfilesOutput) AwsVaultConfig() pulumi.StringOutput {
+	return o.ApplyT(func(v *AWSConfigProfiles) pulumi.StringOutput { return v.AwsVaultConfig }).(pulumi.StringOutput)
+}
+
+// AWS CLI config with a profile per role, in INI format.
+func (o AWSConfigProfilesOutput) Config() pulumi.StringOutput {
+	return o.ApplyT(func(v *AWSConfigProfiles) pulumi.StringOutput { return v.Config }).(pulumi.StringOutput)
+}
+
+type AWSConfigProfilesArrayOutput struct{ *pulumi.OutputState }
+
+func (AWSConfigProfilesArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*AWSConfigProfiles)(nil)).Elem()
+}
+
+func (o AWSConfigProfilesArrayOutput) ToAWSConfigProfilesArrayOutput() AWSConfigProfilesArrayOutput {
+	return o
+}
+
+func (o AWSConfigProfilesArrayOutput) ToAWSConfigProfilesArrayOutputWithContext(ctx context.Context) AWSConfigProfilesArrayOutput {
+	return o
+}
+
+func (o AWSConfigProfilesArrayOutput) Index(i pulumi.IntInput) AWSConfigProfilesOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *AWSConfigProfiles {
+		return vs[0].([]*AWSConfigProfiles)[vs[1].(int)]
+	}).(AWSConfigProfilesOutput)
+}
+
+type AWSConfigProfilesMapOutput struct{ *pulumi.OutputState }
+
+func (AWSConfigProfilesMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*AWSConfigProfiles)(nil)).Elem()
+}
+
+func (o AWSConfigProfilesMapOutput) ToAWSConfigProfilesMapOutput() AWSConfigProfilesMapOutput {
+	return o
+}
+
+func (o AWSConfigProfilesMapOutput) ToAWSConfigProfilesMapOutputWithContext(ctx context.Context) AWSConfigProfilesMapOutput {
+	return o
+}
+
+func (o AWSConfigProfilesMapOutput) MapIndex(k pulumi.StringInput) AWSConfigProfilesOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *AWSConfigProfiles {
+		return vs[0].(map[string]*AWSConfigProfiles)[vs[1].(string)]
+	}).(AWSConfigProfilesOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*AWSConfigProfilesInput)(nil)).Elem(), &AWSConfigProfiles{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AWSConfigProfilesArrayInput)(nil)).Elem(), AWSConfigProfilesArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AWSConfigProfilesMapInput)(nil)).Elem(), AWSConfigProfilesMap{})
+	pulumi.RegisterOutputType(AWSConfigProfilesOutput{})
+	pulumi.RegisterOutputType(AWSConfigProfilesArrayOutput{})
+	pulumi.RegisterOutputType(AWSConfigProfilesMapOutput{})
+}
@@ -0,0 +1,303 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package awsiam
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// This resource helps you create a break-glass role for emergency access. The role has AdministratorAccess,
+// can only be assumed by the trusted principals with a recent MFA sign-in and optionally from specific source
+// IPs, and is tagged with `BreakGlass: true`. An EventBridge rule sends an alert to an SNS topic whenever
+// the role is assumed, so its usage is always noticed.
+//
+// The rule matches the AssumeRole events CloudTrail delivers to EventBridge in the region the resource is
+// deployed to. AssumeRole calls made to the global STS endpoint, including console role switches, are
+// delivered in us-east-1. Outside of us-east-1 a second rule forwards them from us-east-1 to the default
+// event bus of the region. It is created with the `aws` provider for us-east-1 passed as
+// `globalEventsProvider`, which is required outside of us-east-1.
+//
+// ## Example Usage
+// ## Break Glass Role
+//
+// ```go
+// package main
+//
+// import (
+//
+//	iam "github.com/pulumi/pulumi-aws-iam/sdk/go/aws-iam"
+//	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+//
+// )
+//
+//	func main() {
+//	    pulumi.Run(func(ctx *pulumi.Context) error {
+//	        breakGlassRole, err := iam.NewBreakGlassRole(ctx, "break-glass-role", &iam.BreakGlassRoleArgs{
+//	            TrustedRoleArns: pulumi.ToStringArray([]string{"arn:aws:iam::111111111111:user/oncall"}),
+//	            SourceIps:       pulumi.ToStringArray([]string{"203.0.113.0/24"}),
+//	            AlertEmails:     pulumi.ToStringArray([]string{"security@example.com"}),
+//	        })
+//	        if err != nil {
+//	            return err
+//	        }
+//
+//	        ctx.Export("breakGlassRole", breakGlassRole)
+//
+//	        return nil
+//	    })
+//	}
+//
+// ```
+// {{ /example }}
+type BreakGlassRole struct {
+	pulumi.ResourceState
+
+	// ARN of the SNS topic receiving the alerts.
+	AlertTopicArn pulumi.StringOutput `pulumi:"alertTopicArn"`
+	// EventBridge event pattern matching AssumeRole calls into the role.
+	EventPattern pulumi.StringOutput `pulumi:"eventPattern"`
+	// ARN of the EventBridge rule alerting on AssumeRole calls into the role. Empty when only the event pattern is output.
+	// Outside of us-east-1 a second rule forwards the calls to the global STS endpoint from us-east-1 to this rule.
+	EventRuleArn pulumi.StringOutput    `pulumi:"eventRuleArn"`
+	Role         pulumi.StringMapOutput `pulumi:"role"`
+}
+
+// NewBreakGlassRole registers a new resource with the given unique name, arguments, and options.
+func NewBreakGlassRole(ctx *pulumi.Context,
+	name string, args *BreakGlassRoleArgs, opts ...pulumi.ResourceOption) (*BreakGlassRole, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.TrustedRoleArns == nil {
+		return nil, errors.New("invalid value for required argument 'TrustedRoleArns'")
+	}
+	if args.EventPatternOnly == nil {
+		args.EventPatternOnly = pulumi.BoolPtr(false)
+	}
+	if args.MaxSessionDuration == nil {
+		args.MaxSessionDuration = pulumi.IntPtr(3600)
+	}
+	if args.MfaAge == nil {
+		args.MfaAge = pulumi.IntPtr(900)
+	}
+	if args.Role != nil {
+		args.Role = args.Role.ToRolePtrOutput().ApplyT(func(v *Role) *Role { return v.Defaults() }).(RolePtrOutput)
+	}
+	var resource BreakGlassRole
+	err := ctx.RegisterRemoteComponentResource("aws-iam:index:BreakGlassRole", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type breakGlassRoleArgs struct {
+	// Email addresses subscribed to the alert topic.
+	AlertEmails []string `pulumi:"alertEmails"`
+	// ARN of an existing SNS topic to send alerts to. A topic is created when not set.
+	AlertTopicArn *string `pulumi:"alertTopicArn"`
+	// Whether to only output the EventBridge event pattern instead of creating the rule and SNS topic.
+	EventPatternOnly *bool `pulumi:"eventPatternOnly"`
+	// Explicit `aws` provider for us-east-1, used to forward the calls to the global STS endpoint to the
+	// region of the role. Required outside of us-east-1, unless only the event pattern is output.
+	GlobalEventsProvider interface{} `pulumi:"globalEventsProvider"`
+	// Maximum CLI/API session duration in seconds between 3600 and 43200.
+	MaxSessionDuration *int `pulumi:"maxSessionDuration"`
+	// Max age of valid MFA (in seconds) when assuming the role.
+	MfaAge *int `pulumi:"mfaAge"`
+	// The IAM role. Its name defaults to `break-glass`.
+	Role *Role `pulumi:"role"`
+	// Source IP ranges the role can be assumed from. Any source IP is allowed when empty.
+	SourceIps []string `pulumi:"sourceIps"`
+	// A map of tags to add. The role is also tagged with `BreakGlass` set to `true`.
+	Tags map[string]string `pulumi:"tags"`
+	// ARNs of the principals allowed to assume the role.
+	TrustedRoleArns []string `pulumi:"trustedRoleArns"`
+}
+
+// The set of arguments for constructing a BreakGlassRole resource.
+type BreakGlassRoleArgs struct {
+	// Email addresses subscribed to the alert topic.
+	AlertEmails pulumi.StringArrayInput
+	// ARN of an existing SNS topic to send alerts to. A topic is created when not set.
+	AlertTopicArn pulumi.StringPtrInput
+	// Whether to only output the EventBridge event pattern instead of creating the rule and SNS topic.
+	EventPatternOnly pulumi.BoolPtrInput
+	// Explicit `aws` provider for us-east-1, used to forward the calls to the global STS endpoint to the
+	// region of the role. Required outside of us-east-1, unless only the event pattern is output.
+	GlobalEventsProvider pulumi.Input
+	// Maximum CLI/API session duration in seconds between 3600 and 43200.
+	MaxSessionDuration pulumi.IntPtrInput
+	// Max age of valid MFA (in seconds) when assuming the role.
+	MfaAge pulumi.IntPtrInput
+	// The IAM role. Its name defaults to `break-glass`.
+	Role RolePtrInput
+	// Source IP ranges the role can be assumed from. Any source IP is allowed when empty.
+	SourceIps pulumi.StringArrayInput
+	// A map of tags to add. The role is also tagged with `BreakGlass` set to `true`.
+	Tags pulumi.StringMapInput
+	// ARNs of the principals allowed to assume the role.
+	TrustedRoleArns pulumi.StringArrayInput
+}
+
+func (BreakGlassRoleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*breakGlassRoleArgs)(nil)).Elem()
+}
+
+type BreakGlassRoleInput interface {
+	pulumi.Input
+
+	ToBreakGlassRoleOutput() BreakGlassRoleOutput
+	ToBreakGlassRoleOutputWithContext(ctx context.Context) BreakGlassRoleOutput
+}
+
+func (*BreakGlassRole) ElementType() reflect.Type {
+	return reflect.TypeOf((**BreakGlassRole)(nil)).Elem()
+}
+
+func (i *BreakGlassRole) ToBreakGlassRoleOutput() BreakGlassRoleOutput {
+	return i.ToBreakGlassRoleOutputWithContext(context.Background())
+}
+
+func (i *BreakGlassRole) ToBreakGlassRoleOutputWithContext(ctx context.Context) BreakGlassRoleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BreakGlassRoleOutput)
+}
+
+// BreakGlassRoleArrayInput is an input type that accepts BreakGlassRoleArray and BreakGlassRoleArrayOutput values.
+// You can construct a concrete instance of `BreakGlassRoleArrayInput` via:
+//
+//	BreakGlassRoleArray{ BreakGlassRoleArgs{...} }
+type BreakGlassRoleArrayInput interface {
+	pulumi.Input
+
+	ToBreakGlassRoleArrayOutput() BreakGlassRoleArrayOutput
+	ToBreakGlassRoleArrayOutputWithContext(context.Context) BreakGlassRoleArrayOutput
+}
+
+type BreakGlassRoleArray []BreakGlassRoleInput
+
+func (BreakGlassRoleArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*BreakGlassRole)(nil)).Elem()
+}
+
+func (i BreakGlassRoleArray) ToBreakGlassRoleArrayOutput() BreakGlassRoleArrayOutput {
+	return i.ToBreakGlassRoleArrayOutputWithContext(context.Background())
+}
+
+func (i BreakGlassRoleArray) ToBreakGlassRoleArrayOutputWithContext(ctx context.Context) BreakGlassRoleArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BreakGlassRoleArrayOutput)
+}
+
+// BreakGlassRoleMapInput is an input type that accepts BreakGlassRoleMap and BreakGlassRoleMapOutput values.
+// You can construct a concrete instance of `BreakGlassRoleMapInput` via:
+//
+//	BreakGlassRoleMap{ "key": BreakGlassRoleArgs{...} }
+type BreakGlassRoleMapInput interface {
+	pulumi.Input
+
+	ToBreakGlassRoleMapOutput() BreakGlassRoleMapOutput
+	ToBreakGlassRoleMapOutputWithContext(context.Context) BreakGlassRoleMapOutput
+}
+
+type BreakGlassRoleMap map[string]BreakGlassRoleInput
+
+func (BreakGlassRoleMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*BreakGlassRole)(nil)).Elem()
+}
+
+func (i BreakGlassRoleMap) ToBreakGlassRoleMapOutput() BreakGlassRoleMapOutput {
+	return i.ToBreakGlassRoleMapOutputWithContext(context.Background())
+}
+
+func (i BreakGlassRoleMap) ToBreakGlassRoleMapOutputWithContext(ctx context.Context) BreakGlassRoleMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BreakGlassRoleMapOutput)
+}
+
+type BreakGlassRoleOutput struct{ *pulumi.OutputState }
+
+func (BreakGlassRoleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**BreakGlassRole)(nil)).Elem()
+}
+
+func (o BreakGlassRoleOutput) ToBreakGlassRoleOutput() BreakGlassRoleOutput {
+	return o
+}
+
+func (o BreakGlassRoleOutput) ToBreakGlassRoleOutputWithContext(ctx context.Context) BreakGlassRoleOutput {
+	return o
+}
+
+// ARN of the SNS topic receiving the alerts.
+func (o BreakGlassRoleOutput) AlertTopicArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *BreakGlassRole) pulumi.StringOutput { return v.AlertTopicArn }).(pulumi.StringOutput)
+}
+
+// EventBridge event pattern matching AssumeRole calls into the role.
+func (o BreakGlassRoleOutput) EventPattern() pulumi.StringOutput {
+	return o.ApplyT(func(v *BreakGlassRole) pulumi.StringOutput { return v.EventPattern }).(pulumi.StringOutput)
+}
+
+// ARN of the EventBridge rule alerting on AssumeRole calls into the role. Empty when only the event pattern is output.
+// Outside of us-east-1 a second rule forwards the calls to the global STS endpoint from us-east-1 to this rule.
+func (o BreakGlassRoleOutput) EventRuleArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *BreakGlassRole) pulumi.StringOutput { return v.EventRuleArn }).(pulumi.StringOutput)
+}
+
+func (o BreakGlassRoleOutput) Role() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *BreakGlassRole) pulumi.StringMapOutput { return v.Role }).(pulumi.StringMapOutput)
+}
+
+type BreakGlassRoleArrayOutput struct{ *pulumi.OutputState }
+
+func (BreakGlassRoleArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*BreakGlassRole)(nil)).Elem()
+}
+
+func (o BreakGlassRoleArrayOutput) ToBreakGlassRoleArrayOutput() BreakGlassRoleArrayOutput {
+	return o
+}
+
+func (o BreakGlassRoleArrayOutput) ToBreakGlassRoleArrayOutputWithContext(ctx context.Context) BreakGlassRoleArrayOutput {
+	return o
+}
+
+func (o BreakGlassRoleArrayOutput) Index(i pulumi.IntInput) BreakGlassRoleOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *BreakGlassRole {
+		return vs[0].([]*BreakGlassRole)[vs[1].(int)]
+	}).(BreakGlassRoleOutput)
+}
+
+type BreakGlassRoleMapOutput struct{ *pulumi.OutputState }
+
+func (BreakGlassRoleMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*BreakGlassRole)(nil)).Elem()
+}
+
+func (o BreakGlassRoleMapOutput) ToBreakGlassRoleMapOutput() BreakGlassRoleMapOutput {
+	return o
+}
+
+func (o BreakGlassRoleMapOutput) ToBreakGlassRoleMapOutputWithContext(ctx context.Context) BreakGlassRoleMapOutput {
+	return o
+}
+
+func (o BreakGlassRoleMapOutput) MapIndex(k pulumi.StringInput) BreakGlassRoleOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *BreakGlassRole {
+		return vs[0].(map[string]*BreakGlassRole)[vs[1].(string)]
+	}).(BreakGlassRoleOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*BreakGlassRoleInput)(nil)).Elem(), &BreakGlassRole{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BreakGlassRoleArrayInput)(nil)).Elem(), BreakGlassRoleArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BreakGlassRoleMapInput)(nil)).Elem(), BreakGlassRoleMap{})
+	pulumi.RegisterOutputType(BreakGlassRoleOutput{})
+	pulumi.RegisterOutputType(BreakGlassRoleArrayOutput{})
+	pulumi.RegisterOutputType(BreakGlassRoleMapOutput{})
+}
@@ -0,0 +1,293 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package awsiam
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// This resource helps you create the IAM policy of a single EKS add-on, the same policy `RoleForServiceAccountsEks`
+// attaches for it, without creating a role. The policy can then be attached to node roles, EKS Pod Identity
+// roles or roles managed elsewhere.
+//
+// ## Example Usage
+// ## EKS Add-on Policy
+//
+// ```go
+// package main
+//
+// import (
+//
+//	iam "github.com/pulumi/pulumi-aws-iam/sdk/go/aws-iam"
+//	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+//
+// )
+//
+//	func main() {
+//	    pulumi.Run(func(ctx *pulumi.Context) error {
+//	        veleroPolicy, err := iam.NewEKSAddonPolicy(ctx, "velero-policy", &iam.EKSAddonPolicyArgs{
+//	            Addon: pulumi.String("velero"),
+//	            Policies: iam.EKSRolePoliciesArgs{
+//	                Velero: iam.EKSVeleroPolicyArgs{
+//	                    S3BucketArns: pulumi.ToStringArray([]string{"arn:aws:s3:::velero-backups"}),
+//	                },
+//	            },
+//	        })
+//	        if err != nil {
+//	            return err
+//	        }
+//
+//	        ctx.Export("veleroPolicy", veleroPolicy)
+//
+//	        return nil
+//	    })
+//	}
+//
+// ```
+// {{ /example }}
+type EKSAddonPolicy struct {
+	pulumi.ResourceState
+
+	// The ARN assigned by AWS to this policy.
+	Arn pulumi.StringOutput `pulumi:"arn"`
+	// The description of the policy.
+	Description pulumi.StringOutput `pulumi:"description"`
+	// The policy's ID.
+	Id pulumi.StringOutput `pulumi:"id"`
+	// The name of the policy.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// The path of the policy in IAM.
+	Path pulumi.StringOutput `pulumi:"path"`
+	// Policy document as json.
+	PolicyJson pulumi.StringOutput `pulumi:"policyJson"`
+}
+
+// NewEKSAddonPolicy registers a new resource with the given unique name, arguments, and options.
+func NewEKSAddonPolicy(ctx *pulumi.Context,
+	name string, args *EKSAddonPolicyArgs, opts ...pulumi.ResourceOption) (*EKSAddonPolicy, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Addon == nil {
+		return nil, errors.New("invalid value for required argument 'Addon'")
+	}
+	if args.Policies == nil {
+		return nil, errors.New("invalid value for required argument 'Policies'")
+	}
+	if args.Path == nil {
+		args.Path = pulumi.StringPtr("/")
+	}
+	args.Policies = args.Policies.ToEKSRolePoliciesOutput().ApplyT(func(v EKSRolePolicies) EKSRolePolicies { return *v.Defaults() }).(EKSRolePoliciesOutput)
+	if args.PolicyNamePrefix == nil {
+		args.PolicyNamePrefix = pulumi.StringPtr("AmazonEKS_")
+	}
+	var resource EKSAddonPolicy
+	err := ctx.RegisterRemoteComponentResource("aws-iam:index:EKSAddonPolicy", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type eksaddonPolicyArgs struct {
+	// Name of the add-on to create the policy for, matching its key in `policies`, e.g. `velero` or
+	// `karpenterController`.
+	Addon string `pulumi:"addon"`
+	// The path of the policy in IAM.
+	Path *string `pulumi:"path"`
+	// Arguments of the add-ons. Only the arguments of the selected add-on are used, its `attach` flag is
+	// implied. Add-ons with several policies, e.g. `loadBalancer`, must select exactly one of them.
+	Policies EKSRolePolicies `pulumi:"policies"`
+	// IAM policy name prefix.
+	PolicyNamePrefix *string `pulumi:"policyNamePrefix"`
+	// A map of tags to add.
+	Tags map[string]string `pulumi:"tags"`
+}
+
+// The set of arguments for constructing a EKSAddonPolicy resource.
+type EKSAddonPolicyArgs struct {
+	// Name of the add-on to create the policy for, matching its key in `policies`, e.g. `velero` or
+	// `karpenterController`.
+	Addon pulumi.StringInput
+	// The path of the policy in IAM.
+	Path pulumi.StringPtrInput
+	// Arguments of the add-ons. Only the arguments of the selected add-on are used, its `attach` flag is
+	// implied. Add-ons with several policies, e.g. `loadBalancer`, must select exactly one of them.
+	Policies EKSRolePoliciesInput
+	// IAM policy name prefix.
+	PolicyNamePrefix pulumi.StringPtrInput
+	// A map of tags to add.
+	Tags pulumi.StringMapInput
+}
+
+func (EKSAddonPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*eksaddonPolicyArgs)(nil)).Elem()
+}
+
+type EKSAddonPolicyInput interface {
+	pulumi.Input
+
+	ToEKSAddonPolicyOutput() EKSAddonPolicyOutput
+	ToEKSAddonPolicyOutputWithContext(ctx context.Context) EKSAddonPolicyOutput
+}
+
+func (*EKSAddonPolicy) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSAddonPolicy)(nil)).Elem()
+}
+
+func (i *EKSAddonPolicy) ToEKSAddonPolicyOutput() EKSAddonPolicyOutput {
+	return i.ToEKSAddonPolicyOutputWithContext(context.Background())
+}
+
+func (i *EKSAddonPolicy) ToEKSAddonPolicyOutputWithContext(ctx context.Context) EKSAddonPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSAddonPolicyOutput)
+}
+
+// EKSAddonPolicyArrayInput is an input type that accepts EKSAddonPolicyArray and EKSAddonPolicyArrayOutput values.
+// You can construct a concrete instance of `EKSAddonPolicyArrayInput` via:
+//
+//	EKSAddonPolicyArray{ EKSAddonPolicyArgs{...} }
+type EKSAddonPolicyArrayInput interface {
+	pulumi.Input
+
+	ToEKSAddonPolicyArrayOutput() EKSAddonPolicyArrayOutput
+	ToEKSAddonPolicyArrayOutputWithContext(context.Context) EKSAddonPolicyArrayOutput
+}
+
+type EKSAddonPolicyArray []EKSAddonPolicyInput
+
+func (EKSAddonPolicyArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*EKSAddonPolicy)(nil)).Elem()
+}
+
+func (i EKSAddonPolicyArray) ToEKSAddonPolicyArrayOutput() EKSAddonPolicyArrayOutput {
+	return i.ToEKSAddonPolicyArrayOutputWithContext(context.Background())
+}
+
+func (i EKSAddonPolicyArray) ToEKSAddonPolicyArrayOutputWithContext(ctx context.Context) EKSAddonPolicyArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSAddonPolicyArrayOutput)
+}
+
+// EKSAddonPolicyMapInput is an input type that accepts EKSAddonPolicyMap and EKSAddonPolicyMapOutput values.
+// You can construct a concrete instance of `EKSAddonPolicyMapInput` via:
+//
+//	EKSAddonPolicyMap{ "key": EKSAddonPolicyArgs{...} }
+type EKSAddonPolicyMapInput interface {
+	pulumi.Input
+
+	ToEKSAddonPolicyMapOutput() EKSAddonPolicyMapOutput
+	ToEKSAddonPolicyMapOutputWithContext(context.Context) EKSAddonPolicyMapOutput
+}
+
+type EKSAddonPolicyMap map[string]EKSAddonPolicyInput
+
+func (EKSAddonPolicyMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*EKSAddonPolicy)(nil)).Elem()
+}
+
+func (i EKSAddonPolicyMap) ToEKSAddonPolicyMapOutput() EKSAddonPolicyMapOutput {
+	return i.ToEKSAddonPolicyMapOutputWithContext(context.Background())
+}
+
+func (i EKSAddonPolicyMap) ToEKSAddonPolicyMapOutputWithContext(ctx context.Context) EKSAddonPolicyMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSAddonPolicyMapOutput)
+}
+
+type EKSAddonPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSAddonPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSAddonPolicy)(nil)).Elem()
+}
+
+func (o EKSAddonPolicyOutput) ToEKSAddonPolicyOutput() EKSAddonPolicyOutput {
+	return o
+}
+
+func (o EKSAddonPolicyOutput) ToEKSAddonPolicyOutputWithContext(ctx context.Context) EKSAddonPolicyOutput {
+	return o
+}
+
+// The ARN assigned by AWS to this policy.
+func (o EKSAddonPolicyOutput) Arn() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSAddonPolicy) pulumi.StringOutput { return v.Arn }).(pulumi.StringOutput)
+}
+
+// The description of the policy.
+func (o EKSAddonPolicyOutput) Description() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSAddonPolicy) pulumi.StringOutput { return v.Description }).(pulumi.StringOutput)
+}
+
+// The policy's ID.
+func (o EKSAddonPolicyOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSAddonPolicy) pulumi.StringOutput { return v.Id }).(pulumi.StringOutput)
+}
+
+// The name of the policy.
+func (o EKSAddonPolicyOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSAddonPolicy) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// The path of the policy in IAM.
+func (o EKSAddonPolicyOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSAddonPolicy) pulumi.StringOutput { return v.Path }).(pulumi.StringOutput)
+}
+
+// Policy document as json.
+func (o EKSAddonPolicyOutput) PolicyJson() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSAddonPolicy) pulumi.StringOutput { return v.PolicyJson }).(pulumi.StringOutput)
+}
+
+type EKSAddonPolicyArrayOutput struct{ *pulumi.OutputState }
+
+func (EKSAddonPolicyArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*EKSAddonPolicy)(nil)).Elem()
+}
+
+func (o EKSAddonPolicyArrayOutput) ToEKSAddonPolicyArrayOutput() EKSAddonPolicyArrayOutput {
+	return o
+}
+
+func (o EKSAddonPolicyArrayOutput) ToEKSAddonPolicyArrayOutputWithContext(ctx context.Context) EKSAddonPolicyArrayOutput {
+	return o
+}
+
+func (o EKSAddonPolicyArrayOutput) Index(i pulumi.IntInput) EKSAddonPolicyOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *EKSAddonPolicy {
+		return vs[0].([]*EKSAddonPolicy)[vs[1].(int)]
+	}).(EKSAddonPolicyOutput)
+}
+
+type EKSAddonPolicyMapOutput struct{ *pulumi.OutputState }
+
+func (EKSAddonPolicyMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*EKSAddonPolicy)(nil)).Elem()
+}
+
+func (o EKSAddonPolicyMapOutput) ToEKSAddonPolicyMapOutput() EKSAddonPolicyMapOutput {
+	return o
+}
+
+func (o EKSAddonPolicyMapOutput) ToEKSAddonPolicyMapOutputWithContext(ctx context.Context) EKSAddonPolicyMapOutput {
+	return o
+}
+
+func (o EKSAddonPolicyMapOutput) MapIndex(k pulumi.StringInput) EKSAddonPolicyOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *EKSAddonPolicy {
+		return vs[0].(map[string]*EKSAddonPolicy)[vs[1].(string)]
+	}).(EKSAddonPolicyOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSAddonPolicyInput)(nil)).Elem(), &EKSAddonPolicy{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSAddonPolicyArrayInput)(nil)).Elem(), EKSAddonPolicyArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSAddonPolicyMapInput)(nil)).Elem(), EKSAddonPolicyMap{})
+	pulumi.RegisterOutputType(EKSAddonPolicyOutput{})
+	pulumi.RegisterOutputType(EKSAddonPolicyArrayOutput{})
+	pulumi.RegisterOutputType(EKSAddonPolicyMapOutput{})
+}
@@ -0,0 +1,277 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package awsiam
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// This resource helps you create the IAM role an EKS cluster uses to manage AWS resources on your behalf.
+// The role trusts `eks.amazonaws.com` and has the `AmazonEKSClusterPolicy` attached, optionally together
+// with the `AmazonEKSVPCResourceController` policy and the policies needed by EKS Auto Mode.
+//
+// ## Example Usage
+// ## EKS Cluster Role
+//
+// ```go
+// package main
+//
+// import (
+//
+//	iam "github.com/pulumi/pulumi-aws-iam/sdk/go/aws-iam"
+//	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+//
+// )
+//
+//	func main() {
+//	    pulumi.Run(func(ctx *pulumi.Context) error {
+//	        clusterRole, err := iam.NewEKSClusterRole(ctx, "eks-cluster-role", &iam.EKSClusterRoleArgs{
+//	            Role: iam.RoleArgs{
+//	                Name: pulumi.String("eks-cluster"),
+//	            },
+//	            AttachVpcResourceControllerPolicy: pulumi.BoolPtr(true),
+//	            EnableAutoMode:                    pulumi.BoolPtr(true),
+//	        })
+//	        if err != nil {
+//	            return err
+//	        }
+//
+//	        ctx.Export("clusterRole", clusterRole)
+//
+//	        return nil
+//	    })
+//	}
+//
+// ```
+// {{ /example }}
+type EKSClusterRole struct {
+	pulumi.ResourceState
+
+	// ARN of IAM role.
+	Arn pulumi.StringOutput `pulumi:"arn"`
+	// Name of IAM role.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// Path of IAM role.
+	Path pulumi.StringOutput `pulumi:"path"`
+	// Unique ID of IAM role.
+	UniqueId pulumi.StringOutput `pulumi:"uniqueId"`
+}
+
+// NewEKSClusterRole registers a new resource with the given unique name, arguments, and options.
+func NewEKSClusterRole(ctx *pulumi.Context,
+	name string, args *EKSClusterRoleArgs, opts ...pulumi.ResourceOption) (*EKSClusterRole, error) {
+	if args == nil {
+		args = &EKSClusterRoleArgs{}
+	}
+
+	if args.AttachVpcResourceControllerPolicy == nil {
+		args.AttachVpcResourceControllerPolicy = pulumi.BoolPtr(false)
+	}
+	if args.EnableAutoMode == nil {
+		args.EnableAutoMode = pulumi.BoolPtr(false)
+	}
+	if args.ForceDetachPolicies == nil {
+		args.ForceDetachPolicies = pulumi.BoolPtr(false)
+	}
+	if args.MaxSessionDuration == nil {
+		args.MaxSessionDuration = pulumi.IntPtr(3600)
+	}
+	if args.Role != nil {
+		args.Role = args.Role.ToRolePtrOutput().ApplyT(func(v *Role) *Role { return v.Defaults() }).(RolePtrOutput)
+	}
+	var resource EKSClusterRole
+	err := ctx.RegisterRemoteComponentResource("aws-iam:index:EKSClusterRole", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type eksclusterRoleArgs struct {
+	// Whether to attach the AmazonEKSVPCResourceController policy, needed for security groups for pods.
+	AttachVpcResourceControllerPolicy *bool `pulumi:"attachVpcResourceControllerPolicy"`
+	// Whether to attach the policies needed by EKS Auto Mode and allow EKS to tag its sessions.
+	EnableAutoMode *bool `pulumi:"enableAutoMode"`
+	// Whether policies should be detached from this role when destroying.
+	ForceDetachPolicies *bool `pulumi:"forceDetachPolicies"`
+	// Maximum CLI/API session duration in seconds between 3600 and 43200.
+	MaxSessionDuration *int  `pulumi:"maxSessionDuration"`
+	Role               *Role `pulumi:"role"`
+	// A map of tags to add.
+	Tags map[string]string `pulumi:"tags"`
+}
+
+// The set of arguments for constructing a EKSClusterRole resource.
+type EKSClusterRoleArgs struct {
+	// Whether to attach the AmazonEKSVPCResourceController policy, needed for security groups for pods.
+	AttachVpcResourceControllerPolicy pulumi.BoolPtrInput
+	// Whether to attach the policies needed by EKS Auto Mode and allow EKS to tag its sessions.
+	EnableAutoMode pulumi.BoolPtrInput
+	// Whether policies should be detached from this role when destroying.
+	ForceDetachPolicies pulumi.BoolPtrInput
+	// Maximum CLI/API session duration in seconds between 3600 and 43200.
+	MaxSessionDuration pulumi.IntPtrInput
+	Role               RolePtrInput
+	// A map of tags to add.
+	Tags pulumi.StringMapInput
+}
+
+func (EKSClusterRoleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*eksclusterRoleArgs)(nil)).Elem()
+}
+
+type EKSClusterRoleInput interface {
+	pulumi.Input
+
+	ToEKSClusterRoleOutput() EKSClusterRoleOutput
+	ToEKSClusterRoleOutputWithContext(ctx context.Context) EKSClusterRoleOutput
+}
+
+func (*EKSClusterRole) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSClusterRole)(nil)).Elem()
+}
+
+func (i *EKSClusterRole) ToEKSClusterRoleOutput() EKSClusterRoleOutput {
+	return i.ToEKSClusterRoleOutputWithContext(context.Background())
+}
+
+func (i *EKSClusterRole) ToEKSClusterRoleOutputWithContext(ctx context.Context) EKSClusterRoleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSClusterRoleOutput)
+}
+
+// EKSClusterRoleArrayInput is an input type that accepts EKSClusterRoleArray and EKSClusterRoleArrayOutput values.
+// You can construct a concrete instance of `EKSClusterRoleArrayInput` via:
+//
+//	EKSClusterRoleArray{ EKSClusterRoleArgs{...} }
+type EKSClusterRoleArrayInput interface {
+	pulumi.Input
+
+	ToEKSClusterRoleArrayOutput() EKSClusterRoleArrayOutput
+	ToEKSClusterRoleArrayOutputWithContext(context.Context) EKSClusterRoleArrayOutput
+}
+
+type EKSClusterRoleArray []EKSClusterRoleInput
+
+func (EKSClusterRoleArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*EKSClusterRole)(nil)).Elem()
+}
+
+func (i EKSClusterRoleArray) ToEKSClusterRoleArrayOutput() EKSClusterRoleArrayOutput {
+	return i.ToEKSClusterRoleArrayOutputWithContext(context.Background())
+}
+
+func (i EKSClusterRoleArray) ToEKSClusterRoleArrayOutputWithContext(ctx context.Context) EKSClusterRoleArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSClusterRoleArrayOutput)
+}
+
+// EKSClusterRoleMapInput is an input type that accepts EKSClusterRoleMap and EKSClusterRoleMapOutput values.
+// You can construct a concrete instance of `EKSClusterRoleMapInput` via:
+//
+//	EKSClusterRoleMap{ "key": EKSClusterRoleArgs{...} }
+type EKSClusterRoleMapInput interface {
+	pulumi.Input
+
+	ToEKSClusterRoleMapOutput() EKSClusterRoleMapOutput
+	ToEKSClusterRoleMapOutputWithContext(context.Context) EKSClusterRoleMapOutput
+}
+
+type EKSClusterRoleMap map[string]EKSClusterRoleInput
+
+func (EKSClusterRoleMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*EKSClusterRole)(nil)).Elem()
+}
+
+func (i EKSClusterRoleMap) ToEKSClusterRoleMapOutput() EKSClusterRoleMapOutput {
+	return i.ToEKSClusterRoleMapOutputWithContext(context.Background())
+}
+
+func (i EKSClusterRoleMap) ToEKSClusterRoleMapOutputWithContext(ctx context.Context) EKSClusterRoleMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSClusterRoleMapOutput)
+}
+
+type EKSClusterRoleOutput struct{ *pulumi.OutputState }
+
+func (EKSClusterRoleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSClusterRole)(nil)).Elem()
+}
+
+func (o EKSClusterRoleOutput) ToEKSClusterRoleOutput() EKSClusterRoleOutput {
+	return o
+}
+
+func (o EKSClusterRoleOutput) ToEKSClusterRoleOutputWithContext(ctx context.Context) EKSClusterRoleOutput {
+	return o
+}
+
+// ARN of IAM role.
+func (o EKSClusterRoleOutput) Arn() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSClusterRole) pulumi.StringOutput { return v.Arn }).(pulumi.StringOutput)
+}
+
+// Name of IAM role.
+func (o EKSClusterRoleOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSClusterRole) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// Path of IAM role.
+func (o EKSClusterRoleOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSClusterRole) pulumi.StringOutput { return v.Path }).(pulumi.StringOutput)
+}
+
+// Unique ID of IAM role.
+func (o EKSClusterRoleOutput) UniqueId() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSClusterRole) pulumi.StringOutput { return v.UniqueId }).(pulumi.StringOutput)
+}
+
+type EKSClusterRoleArrayOutput struct{ *pulumi.OutputState }
+
+func (EKSClusterRoleArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*EKSClusterRole)(nil)).Elem()
+}
+
+func (o EKSClusterRoleArrayOutput) ToEKSClusterRoleArrayOutput() EKSClusterRoleArrayOutput {
+	return o
+}
+
+func (o EKSClusterRoleArrayOutput) ToEKSClusterRoleArrayOutputWithContext(ctx context.Context) EKSClusterRoleArrayOutput {
+	return o
+}
+
+func (o EKSClusterRoleArrayOutput) Index(i pulumi.IntInput) EKSClusterRoleOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *EKSClusterRole {
+		return vs[0].([]*EKSClusterRole)[vs[1].(int)]
+	}).(EKSClusterRoleOutput)
+}
+
+type EKSClusterRoleMapOutput struct{ *pulumi.OutputState }
+
+func (EKSClusterRoleMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*EKSClusterRole)(nil)).Elem()
+}
+
+func (o EKSClusterRoleMapOutput) ToEKSClusterRoleMapOutput() EKSClusterRoleMapOutput {
+	return o
+}
+
+func (o EKSClusterRoleMapOutput) ToEKSClusterRoleMapOutputWithContext(ctx context.Context) EKSClusterRoleMapOutput {
+	return o
+}
+
+func (o EKSClusterRoleMapOutput) MapIndex(k pulumi.StringInput) EKSClusterRoleOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *EKSClusterRole {
+		return vs[0].(map[string]*EKSClusterRole)[vs[1].(string)]
+	}).(EKSClusterRoleOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSClusterRoleInput)(nil)).Elem(), &EKSClusterRole{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSClusterRoleArrayInput)(nil)).Elem(), EKSClusterRoleArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSClusterRoleMapInput)(nil)).Elem(), EKSClusterRoleMap{})
+	pulumi.RegisterOutputType(EKSClusterRoleOutput{})
+	pulumi.RegisterOutputType(EKSClusterRoleArrayOutput{})
+	pulumi.RegisterOutputType(EKSClusterRoleMapOutput{})
+}
@@ -0,0 +1,298 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package awsiam
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// This resource helps you create the IAM role of EKS nodes. Depending on `type` the role is for:
+//
+//   - `node`: managed and self-managed node groups. The role trusts EC2, has the worker node, CNI and ECR
+//     read only policies attached and gets an instance profile.
+//   - `karpenter`: nodes launched by Karpenter. Same as `node` plus the SSM policy, without an instance
+//     profile since Karpenter creates those itself.
+//   - `fargate`: the pod execution role of Fargate profiles, optionally restricted to the profiles of one cluster.
+//
+// ## Example Usage
+// ## EKS Node Role
+//
+// ```go
+// package main
+//
+// import (
+//
+//	iam "github.com/pulumi/pulumi-aws-iam/sdk/go/aws-iam"
+//	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+//
+// )
+//
+//	func main() {
+//	    pulumi.Run(func(ctx *pulumi.Context) error {
+//	        nodeRole, err := iam.NewEKSNodeRole(ctx, "eks-node-role", &iam.EKSNodeRoleArgs{
+//	            Role: iam.RoleArgs{
+//	                Name: pulumi.String("eks-node"),
+//	            },
+//	            AttachSsmPolicy: pulumi.BoolPtr(true),
+//	        })
+//	        if err != nil {
+//	            return err
+//	        }
+//
+//	        ctx.Export("nodeRole", nodeRole)
+//
+//	        return nil
+//	    })
+//	}
+//
+// ```
+// {{ /example }}
+type EKSNodeRole struct {
+	pulumi.ResourceState
+
+	// ARN of IAM role.
+	Arn pulumi.StringOutput `pulumi:"arn"`
+	// IAM instance profile, only created for `node` roles.
+	InstanceProfile pulumi.StringMapOutput `pulumi:"instanceProfile"`
+	// Name of IAM role.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// Path of IAM role.
+	Path pulumi.StringOutput `pulumi:"path"`
+	// Unique ID of IAM role.
+	UniqueId pulumi.StringOutput `pulumi:"uniqueId"`
+}
+
+// NewEKSNodeRole registers a new resource with the given unique name, arguments, and options.
+func NewEKSNodeRole(ctx *pulumi.Context,
+	name string, args *EKSNodeRoleArgs, opts ...pulumi.ResourceOption) (*EKSNodeRole, error) {
+	if args == nil {
+		args = &EKSNodeRoleArgs{}
+	}
+
+	if args.AttachSsmPolicy == nil {
+		args.AttachSsmPolicy = pulumi.BoolPtr(false)
+	}
+	if args.ExcludeCniPolicy == nil {
+		args.ExcludeCniPolicy = pulumi.BoolPtr(false)
+	}
+	if args.ForceDetachPolicies == nil {
+		args.ForceDetachPolicies = pulumi.BoolPtr(false)
+	}
+	if args.MaxSessionDuration == nil {
+		args.MaxSessionDuration = pulumi.IntPtr(3600)
+	}
+	if args.Role != nil {
+		args.Role = args.Role.ToRolePtrOutput().ApplyT(func(v *Role) *Role { return v.Defaults() }).(RolePtrOutput)
+	}
+	if args.Type == nil {
+		args.Type = pulumi.StringPtr("node")
+	}
+	var resource EKSNodeRole
+	err := ctx.RegisterRemoteComponentResource("aws-iam:index:EKSNodeRole", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type eksnodeRoleArgs struct {
+	// Whether to attach the AmazonSSMManagedInstanceCore policy. Always attached for `karpenter` roles.
+	AttachSsmPolicy *bool `pulumi:"attachSsmPolicy"`
+	// Name of the cluster whose Fargate profiles can assume a `fargate` role. Defaults to all clusters of the account.
+	ClusterName *string `pulumi:"clusterName"`
+	// Whether to leave out the AmazonEKS_CNI_Policy, e.g. when the VPC CNI uses its own IRSA role.
+	ExcludeCniPolicy *bool `pulumi:"excludeCniPolicy"`
+	// Whether policies should be detached from this role when destroying.
+	ForceDetachPolicies *bool `pulumi:"forceDetachPolicies"`
+	// Maximum CLI/API session duration in seconds between 3600 and 43200.
+	MaxSessionDuration *int  `pulumi:"maxSessionDuration"`
+	Role               *Role `pulumi:"role"`
+	// A map of tags to add.
+	Tags map[string]string `pulumi:"tags"`
+	// Type of the role, one of `node`, `karpenter` or `fargate`.
+	Type *string `pulumi:"type"`
+}
+
+// The set of arguments for constructing a EKSNodeRole resource.
+type EKSNodeRoleArgs struct {
+	// Whether to attach the AmazonSSMManagedInstanceCore policy. Always attached for `karpenter` roles.
+	AttachSsmPolicy pulumi.BoolPtrInput
+	// Name of the cluster whose Fargate profiles can assume a `fargate` role. Defaults to all clusters of the account.
+	ClusterName pulumi.StringPtrInput
+	// Whether to leave out the AmazonEKS_CNI_Policy, e.g. when the VPC CNI uses its own IRSA role.
+	ExcludeCniPolicy pulumi.BoolPtrInput
+	// Whether policies should be detached from this role when destroying.
+	ForceDetachPolicies pulumi.BoolPtrInput
+	// Maximum CLI/API session duration in seconds between 3600 and 43200.
+	MaxSessionDuration pulumi.IntPtrInput
+	Role               RolePtrInput
+	// A map of tags to add.
+	Tags pulumi.StringMapInput
+	// Type of the role, one of `node`, `karpenter` or `fargate`.
+	Type pulumi.StringPtrInput
+}
+
+func (EKSNodeRoleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*eksnodeRoleArgs)(nil)).Elem()
+}
+
+type EKSNodeRoleInput interface {
+	pulumi.Input
+
+	ToEKSNodeRoleOutput() EKSNodeRoleOutput
+	ToEKSNodeRoleOutputWithContext(ctx context.Context) EKSNodeRoleOutput
+}
+
+func (*EKSNodeRole) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSNodeRole)(nil)).Elem()
+}
+
+func (i *EKSNodeRole) ToEKSNodeRoleOutput() EKSNodeRoleOutput {
+	return i.ToEKSNodeRoleOutputWithContext(context.Background())
+}
+
+func (i *EKSNodeRole) ToEKSNodeRoleOutputWithContext(ctx context.Context) EKSNodeRoleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSNodeRoleOutput)
+}
+
+// EKSNodeRoleArrayInput is an input type that accepts EKSNodeRoleArray and EKSNodeRoleArrayOutput values.
+// You can construct a concrete instance of `EKSNodeRoleArrayInput` via:
+//
+//	EKSNodeRoleArray{ EKSNodeRoleArgs{...} }
+type EKSNodeRoleArrayInput interface {
+	pulumi.Input
+
+	ToEKSNodeRoleArrayOutput() EKSNodeRoleArrayOutput
+	ToEKSNodeRoleArrayOutputWithContext(context.Context) EKSNodeRoleArrayOutput
+}
+
+type EKSNodeRoleArray []EKSNodeRoleInput
+
+func (EKSNodeRoleArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*EKSNodeRole)(nil)).Elem()
+}
+
+func (i EKSNodeRoleArray) ToEKSNodeRoleArrayOutput() EKSNodeRoleArrayOutput {
+	return i.ToEKSNodeRoleArrayOutputWithContext(context.Background())
+}
+
+func (i EKSNodeRoleArray) ToEKSNodeRoleArrayOutputWithContext(ctx context.Context) EKSNodeRoleArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSNodeRoleArrayOutput)
+}
+
+// EKSNodeRoleMapInput is an input type that accepts EKSNodeRoleMap and EKSNodeRoleMapOutput values.
+// You can construct a concrete instance of `EKSNodeRoleMapInput` via:
+//
+//	EKSNodeRoleMap{ "key": EKSNodeRoleArgs{...} }
+type EKSNodeRoleMapInput interface {
+	pulumi.Input
+
+	ToEKSNodeRoleMapOutput() EKSNodeRoleMapOutput
+	ToEKSNodeRoleMapOutputWithContext(context.Context) EKSNodeRoleMapOutput
+}
+
+type EKSNodeRoleMap map[string]EKSNodeRoleInput
+
+func (EKSNodeRoleMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*EKSNodeRole)(nil)).Elem()
+}
+
+func (i EKSNodeRoleMap) ToEKSNodeRoleMapOutput() EKSNodeRoleMapOutput {
+	return i.ToEKSNodeRoleMapOutputWithContext(context.Background())
+}
+
+func (i EKSNodeRoleMap) ToEKSNodeRoleMapOutputWithContext(ctx context.Context) EKSNodeRoleMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSNodeRoleMapOutput)
+}
+
+type EKSNodeRoleOutput struct{ *pulumi.OutputState }
+
+func (EKSNodeRoleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSNodeRole)(nil)).Elem()
+}
+
+func (o EKSNodeRoleOutput) ToEKSNodeRoleOutput() EKSNodeRoleOutput {
+	return o
+}
+
+func (o EKSNodeRoleOutput) ToEKSNodeRoleOutputWithContext(ctx context.Context) EKSNodeRoleOutput {
+	return o
+}
+
+// ARN of IAM role.
+func (o EKSNodeRoleOutput) Arn() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSNodeRole) pulumi.StringOutput { return v.Arn }).(pulumi.StringOutput)
+}
+
+// IAM instance profile, only created for `node` roles.
+func (o EKSNodeRoleOutput) InstanceProfile() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *EKSNodeRole) pulumi.StringMapOutput { return v.InstanceProfile }).(pulumi.StringMapOutput)
+}
+
+// Name of IAM role.
+func (o EKSNodeRoleOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSNodeRole) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// Path of IAM role.
+func (o EKSNodeRoleOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSNodeRole) pulumi.StringOutput { return v.Path }).(pulumi.StringOutput)
+}
+
+// Unique ID of IAM role.
+func (o EKSNodeRoleOutput) UniqueId() pulumi.StringOutput {
+	return o.ApplyT(func(v *EKSNodeRole) pulumi.StringOutput { return v.UniqueId }).(pulumi.StringOutput)
+}
+
+type EKSNodeRoleArrayOutput struct{ *pulumi.OutputState }
+
+func (EKSNodeRoleArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*EKSNodeRole)(nil)).Elem()
+}
+
+func (o EKSNodeRoleArrayOutput) ToEKSNodeRoleArrayOutput() EKSNodeRoleArrayOutput {
+	return o
+}
+
+func (o EKSNodeRoleArrayOutput) ToEKSNodeRoleArrayOutputWithContext(ctx context.Context) EKSNodeRoleArrayOutput {
+	return o
+}
+
+func (o EKSNodeRoleArrayOutput) Index(i pulumi.IntInput) EKSNodeRoleOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *EKSNodeRole {
+		return vs[0].([]*EKSNodeRole)[vs[1].(int)]
+	}).(EKSNodeRoleOutput)
+}
+
+type EKSNodeRoleMapOutput struct{ *pulumi.OutputState }
+
+func (EKSNodeRoleMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*EKSNodeRole)(nil)).Elem()
+}
+
+func (o EKSNodeRoleMapOutput) ToEKSNodeRoleMapOutput() EKSNodeRoleMapOutput {
+	return o
+}
+
+func (o EKSNodeRoleMapOutput) ToEKSNodeRoleMapOutputWithContext(ctx context.Context) EKSNodeRoleMapOutput {
+	return o
+}
+
+func (o EKSNodeRoleMapOutput) MapIndex(k pulumi.StringInput) EKSNodeRoleOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *EKSNodeRole {
+		return vs[0].(map[string]*EKSNodeRole)[vs[1].(string)]
+	}).(EKSNodeRoleOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSNodeRoleInput)(nil)).Elem(), &EKSNodeRole{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSNodeRoleArrayInput)(nil)).Elem(), EKSNodeRoleArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSNodeRoleMapInput)(nil)).Elem(), EKSNodeRoleMap{})
+	pulumi.RegisterOutputType(EKSNodeRoleOutput{})
+	pulumi.RegisterOutputType(EKSNodeRoleArrayOutput{})
+	pulumi.RegisterOutputType(EKSNodeRoleMapOutput{})
+}
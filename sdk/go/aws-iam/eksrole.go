@@ -77,6 +77,8 @@ type EKSRole struct {
 	Name pulumi.StringOutput `pulumi:"name"`
 	// Path of IAM role.
 	Path pulumi.StringOutput `pulumi:"path"`
+	// Annotations and ServiceAccount manifests binding each ServiceAccount to the role.
+	ServiceAccounts EKSServiceAccountManifestArrayOutput `pulumi:"serviceAccounts"`
 	// Unique ID of IAM role.
 	UniqueId pulumi.StringOutput `pulumi:"uniqueId"`
 }
@@ -88,12 +90,21 @@ func NewEKSRole(ctx *pulumi.Context,
 		args = &EKSRoleArgs{}
 	}
 
+	if args.AllowAllNamespaces == nil {
+		args.AllowAllNamespaces = pulumi.BoolPtr(false)
+	}
 	if args.ForceDetachPolicies == nil {
 		args.ForceDetachPolicies = pulumi.BoolPtr(false)
 	}
 	if args.MaxSessionDuration == nil {
 		args.MaxSessionDuration = pulumi.IntPtr(3600)
 	}
+	if args.Role != nil {
+		args.Role = args.Role.ToRolePtrOutput().ApplyT(func(v *Role) *Role { return v.Defaults() }).(RolePtrOutput)
+	}
+	if args.ServiceAccountManifest != nil {
+		args.ServiceAccountManifest = args.ServiceAccountManifest.ToEKSServiceAccountManifestOptionsPtrOutput().ApplyT(func(v *EKSServiceAccountManifestOptions) *EKSServiceAccountManifestOptions { return v.Defaults() }).(EKSServiceAccountManifestOptionsPtrOutput)
+	}
 	var resource EKSRole
 	err := ctx.RegisterRemoteComponentResource("aws-iam:index:EKSRole", name, args, &resource, opts...)
 	if err != nil {
@@ -103,6 +114,8 @@ func NewEKSRole(ctx *pulumi.Context,
 }
 
 type eksroleArgs struct {
+	// Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+	AllowAllNamespaces *bool `pulumi:"allowAllNamespaces"`
 	// EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount. See README for details
 	ClusterServiceAccounts []EKSServiceAccount `pulumi:"clusterServiceAccounts"`
 	// Whether policies should be detached from this role when destroying.
@@ -114,12 +127,16 @@ type eksroleArgs struct {
 	Role               *Role               `pulumi:"role"`
 	// ARNs of any policies to attach to the IAM role.
 	RolePolicyArns []string `pulumi:"rolePolicyArns"`
+	// Annotations added to the rendered ServiceAccount manifests.
+	ServiceAccountManifest *EKSServiceAccountManifestOptions `pulumi:"serviceAccountManifest"`
 	// A map of tags to add.
 	Tags map[string]string `pulumi:"tags"`
 }
 
 // The set of arguments for constructing a EKSRole resource.
 type EKSRoleArgs struct {
+	// Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+	AllowAllNamespaces pulumi.BoolPtrInput
 	// EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount. See README for details
 	ClusterServiceAccounts EKSServiceAccountArrayInput
 	// Whether policies should be detached from this role when destroying.
@@ -131,6 +148,8 @@ type EKSRoleArgs struct {
 	Role               RolePtrInput
 	// ARNs of any policies to attach to the IAM role.
 	RolePolicyArns pulumi.StringArrayInput
+	// Annotations added to the rendered ServiceAccount manifests.
+	ServiceAccountManifest EKSServiceAccountManifestOptionsPtrInput
 	// A map of tags to add.
 	Tags pulumi.StringMapInput
 }
@@ -237,6 +256,11 @@ func (o EKSRoleOutput) Path() pulumi.StringOutput {
 	return o.ApplyT(func(v *EKSRole) pulumi.StringOutput { return v.Path }).(pulumi.StringOutput)
 }
 
+// Annotations and ServiceAccount manifests binding each ServiceAccount to the role.
+func (o EKSRoleOutput) ServiceAccounts() EKSServiceAccountManifestArrayOutput {
+	return o.ApplyT(func(v *EKSRole) EKSServiceAccountManifestArrayOutput { return v.ServiceAccounts }).(EKSServiceAccountManifestArrayOutput)
+}
+
 // Unique ID of IAM role.
 func (o EKSRoleOutput) UniqueId() pulumi.StringOutput {
 	return o.ApplyT(func(v *EKSRole) pulumi.StringOutput { return v.UniqueId }).(pulumi.StringOutput)
@@ -82,6 +82,9 @@ func NewGroupWithPolicies(ctx *pulumi.Context,
 	if args.AwsAccountId == nil {
 		args.AwsAccountId = pulumi.StringPtr("")
 	}
+	if args.ExclusivePolicyAttachments == nil {
+		args.ExclusivePolicyAttachments = pulumi.BoolPtr(false)
+	}
 	if args.IamSelfManagementPolicyNamePrefix == nil {
 		args.IamSelfManagementPolicyNamePrefix = pulumi.StringPtr("IAMSelfManagement-")
 	}
@@ -105,6 +108,9 @@ type groupWithPoliciesArgs struct {
 	CustomGroupPolicies []map[string]string `pulumi:"customGroupPolicies"`
 	// List of IAM policies ARNs to attach to IAM group.
 	CustomGroupPolicyArns []string `pulumi:"customGroupPolicyArns"`
+	// Whether managed policies not declared for the group are detached from it. Not supported for groups
+	// by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
+	ExclusivePolicyAttachments *bool `pulumi:"exclusivePolicyAttachments"`
 	// List of IAM users to have in an IAM group which can assume the role.
 	GroupUsers []string `pulumi:"groupUsers"`
 	// Name prefix for IAM policy to create with IAM self-management permissions.
@@ -125,6 +131,9 @@ type GroupWithPoliciesArgs struct {
 	CustomGroupPolicies pulumi.StringMapArrayInput
 	// List of IAM policies ARNs to attach to IAM group.
 	CustomGroupPolicyArns pulumi.StringArrayInput
+	// Whether managed policies not declared for the group are detached from it. Not supported for groups
+	// by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
+	ExclusivePolicyAttachments pulumi.BoolPtrInput
 	// List of IAM users to have in an IAM group which can assume the role.
 	GroupUsers pulumi.StringArrayInput
 	// Name prefix for IAM policy to create with IAM self-management permissions.
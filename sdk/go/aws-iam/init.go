@@ -20,6 +20,10 @@ func (m *module) Version() semver.Version {
 
 func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi.Resource, err error) {
 	switch typ {
+	case "aws-iam:index:AWSConfigProfiles":
+		r = &AWSConfigProfiles{}
+	case "aws-iam:index:AbacPolicy":
+		r = &AbacPolicy{}
 	case "aws-iam:index:Account":
 		r = &Account{}
 	case "aws-iam:index:AssumableRole":
@@ -32,18 +36,30 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 		r = &AssumableRoles{}
 	case "aws-iam:index:AssumableRolesWithSAML":
 		r = &AssumableRolesWithSAML{}
+	case "aws-iam:index:BreakGlassRole":
+		r = &BreakGlassRole{}
+	case "aws-iam:index:EKSAddonPolicy":
+		r = &EKSAddonPolicy{}
+	case "aws-iam:index:EKSClusterRole":
+		r = &EKSClusterRole{}
+	case "aws-iam:index:EKSNodeRole":
+		r = &EKSNodeRole{}
 	case "aws-iam:index:EKSRole":
 		r = &EKSRole{}
 	case "aws-iam:index:GroupWithAssumableRolesPolicy":
 		r = &GroupWithAssumableRolesPolicy{}
 	case "aws-iam:index:GroupWithPolicies":
 		r = &GroupWithPolicies{}
+	case "aws-iam:index:InstanceProfile":
+		r = &InstanceProfile{}
 	case "aws-iam:index:Policy":
 		r = &Policy{}
 	case "aws-iam:index:ReadOnlyPolicy":
 		r = &ReadOnlyPolicy{}
 	case "aws-iam:index:RoleForServiceAccountsEks":
 		r = &RoleForServiceAccountsEks{}
+	case "aws-iam:index:SAMLProvider":
+		r = &SAMLProvider{}
 	case "aws-iam:index:User":
 		r = &User{}
 	default:
@@ -0,0 +1,245 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package awsiam
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// This resource helps you create an IAM instance profile for an existing IAM role, e.g. a role created by
+// `AssumableRole` with `createInstanceProfile` turned off or by `EKSNodeRole`. The role can be given by
+// name or ARN.
+//
+// ## Example Usage
+// ## Instance Profile
+//
+// ```go
+// package main
+//
+// import (
+//
+//	iam "github.com/pulumi/pulumi-aws-iam/sdk/go/aws-iam"
+//	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+//
+// )
+//
+//	func main() {
+//	    pulumi.Run(func(ctx *pulumi.Context) error {
+//	        instanceProfile, err := iam.NewInstanceProfile(ctx, "instance-profile", &iam.InstanceProfileArgs{
+//	            Role: pulumi.String("arn:aws:iam::111111111111:role/app/web"),
+//	            Name: pulumi.String("web"),
+//	            Path: pulumi.String("/app/"),
+//	        })
+//	        if err != nil {
+//	            return err
+//	        }
+//
+//	        ctx.Export("instanceProfile", instanceProfile)
+//
+//	        return nil
+//	    })
+//	}
+//
+// ```
+// {{ /example }}
+type InstanceProfile struct {
+	pulumi.ResourceState
+
+	InstanceProfile pulumi.StringMapOutput `pulumi:"instanceProfile"`
+	// Name of the IAM role in the instance profile.
+	RoleName pulumi.StringOutput `pulumi:"roleName"`
+}
+
+// NewInstanceProfile registers a new resource with the given unique name, arguments, and options.
+func NewInstanceProfile(ctx *pulumi.Context,
+	name string, args *InstanceProfileArgs, opts ...pulumi.ResourceOption) (*InstanceProfile, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Role == nil {
+		return nil, errors.New("invalid value for required argument 'Role'")
+	}
+	if args.Path == nil {
+		args.Path = pulumi.StringPtr("/")
+	}
+	var resource InstanceProfile
+	err := ctx.RegisterRemoteComponentResource("aws-iam:index:InstanceProfile", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type instanceProfileArgs struct {
+	// Name of IAM instance profile.
+	Name *string `pulumi:"name"`
+	// Path of IAM instance profile.
+	Path *string `pulumi:"path"`
+	// Name or ARN of the IAM role to add to the instance profile.
+	Role string `pulumi:"role"`
+	// A map of tags to add.
+	Tags map[string]string `pulumi:"tags"`
+}
+
+// The set of arguments for constructing a InstanceProfile resource.
+type InstanceProfileArgs struct {
+	// Name of IAM instance profile.
+	Name pulumi.StringPtrInput
+	// Path of IAM instance profile.
+	Path pulumi.StringPtrInput
+	// Name or ARN of the IAM role to add to the instance profile.
+	Role pulumi.StringInput
+	// A map of tags to add.
+	Tags pulumi.StringMapInput
+}
+
+func (InstanceProfileArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*instanceProfileArgs)(nil)).Elem()
+}
+
+type InstanceProfileInput interface {
+	pulumi.Input
+
+	ToInstanceProfileOutput() InstanceProfileOutput
+	ToInstanceProfileOutputWithContext(ctx context.Context) InstanceProfileOutput
+}
+
+func (*InstanceProfile) ElementType() reflect.Type {
+	return reflect.TypeOf((**InstanceProfile)(nil)).Elem()
+}
+
+func (i *InstanceProfile) ToInstanceProfileOutput() InstanceProfileOutput {
+	return i.ToInstanceProfileOutputWithContext(context.Background())
+}
+
+func (i *InstanceProfile) ToInstanceProfileOutputWithContext(ctx context.Context) InstanceProfileOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InstanceProfileOutput)
+}
+
+// InstanceProfileArrayInput is an input type that accepts InstanceProfileArray and InstanceProfileArrayOutput values.
+// You can construct a concrete instance of `InstanceProfileArrayInput` via:
+//
+//	InstanceProfileArray{ InstanceProfileArgs{...} }
+type InstanceProfileArrayInput interface {
+	pulumi.Input
+
+	ToInstanceProfileArrayOutput() InstanceProfileArrayOutput
+	ToInstanceProfileArrayOutputWithContext(context.Context) InstanceProfileArrayOutput
+}
+
+type InstanceProfileArray []InstanceProfileInput
+
+func (InstanceProfileArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*InstanceProfile)(nil)).Elem()
+}
+
+func (i InstanceProfileArray) ToInstanceProfileArrayOutput() InstanceProfileArrayOutput {
+	return i.ToInstanceProfileArrayOutputWithContext(context.Background())
+}
+
+func (i InstanceProfileArray) ToInstanceProfileArrayOutputWithContext(ctx context.Context) InstanceProfileArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InstanceProfileArrayOutput)
+}
+
+// InstanceProfileMapInput is an input type that accepts InstanceProfileMap and InstanceProfileMapOutput values.
+// You can construct a concrete instance of `InstanceProfileMapInput` via:
+//
+//	InstanceProfileMap{ "key": InstanceProfileArgs{...} }
+type InstanceProfileMapInput interface {
+	pulumi.Input
+
+	ToInstanceProfileMapOutput() InstanceProfileMapOutput
+	ToInstanceProfileMapOutputWithContext(context.Context) InstanceProfileMapOutput
+}
+
+type InstanceProfileMap map[string]InstanceProfileInput
+
+func (InstanceProfileMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*InstanceProfile)(nil)).Elem()
+}
+
+func (i InstanceProfileMap) ToInstanceProfileMapOutput() InstanceProfileMapOutput {
+	return i.ToInstanceProfileMapOutputWithContext(context.Background())
+}
+
+func (i InstanceProfileMap) ToInstanceProfileMapOutputWithContext(ctx context.Context) InstanceProfileMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InstanceProfileMapOutput)
+}
+
+type InstanceProfileOutput struct{ *pulumi.OutputState }
+
+func (InstanceProfileOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**InstanceProfile)(nil)).Elem()
+}
+
+func (o InstanceProfileOutput) ToInstanceProfileOutput() InstanceProfileOutput {
+	return o
+}
+
+func (o InstanceProfileOutput) ToInstanceProfileOutputWithContext(ctx context.Context) InstanceProfileOutput {
+	return o
+}
+
+func (o InstanceProfileOutput) InstanceProfile() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *InstanceProfile) pulumi.StringMapOutput { return v.InstanceProfile }).(pulumi.StringMapOutput)
+}
+
+// Name of the IAM role in the instance profile.
+func (o InstanceProfileOutput) RoleName() pulumi.StringOutput {
+	return o.ApplyT(func(v *InstanceProfile) pulumi.StringOutput { return v.RoleName }).(pulumi.StringOutput)
+}
+
+type InstanceProfileArrayOutput struct{ *pulumi.OutputState }
+
+func (InstanceProfileArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*InstanceProfile)(nil)).Elem()
+}
+
+func (o InstanceProfileArrayOutput) ToInstanceProfileArrayOutput() InstanceProfileArrayOutput {
+	return o
+}
+
+func (o InstanceProfileArrayOutput) ToInstanceProfileArrayOutputWithContext(ctx context.Context) InstanceProfileArrayOutput {
+	return o
+}
+
+func (o InstanceProfileArrayOutput) Index(i pulumi.IntInput) InstanceProfileOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *InstanceProfile {
+		return vs[0].([]*InstanceProfile)[vs[1].(int)]
+	}).(InstanceProfileOutput)
+}
+
+type InstanceProfileMapOutput struct{ *pulumi.OutputState }
+
+func (InstanceProfileMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*InstanceProfile)(nil)).Elem()
+}
+
+func (o InstanceProfileMapOutput) ToInstanceProfileMapOutput() InstanceProfileMapOutput {
+	return o
+}
+
+func (o InstanceProfileMapOutput) ToInstanceProfileMapOutputWithContext(ctx context.Context) InstanceProfileMapOutput {
+	return o
+}
+
+func (o InstanceProfileMapOutput) MapIndex(k pulumi.StringInput) InstanceProfileOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *InstanceProfile {
+		return vs[0].(map[string]*InstanceProfile)[vs[1].(string)]
+	}).(InstanceProfileOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*InstanceProfileInput)(nil)).Elem(), &InstanceProfile{})
+	pulumi.RegisterInputType(reflect.TypeOf((*InstanceProfileArrayInput)(nil)).Elem(), InstanceProfileArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*InstanceProfileMapInput)(nil)).Elem(), InstanceProfileMap{})
+	pulumi.RegisterOutputType(InstanceProfileOutput{})
+	pulumi.RegisterOutputType(InstanceProfileArrayOutput{})
+	pulumi.RegisterOutputType(InstanceProfileMapOutput{})
+}
@@ -10,2250 +10,2462 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
-// The IAM access key.
-type AccessKeyOutput struct {
-	// The encrypted secret, base64 encoded.
-	EncryptedSecret *string `pulumi:"encryptedSecret"`
-	// The access key ID.
-	Id *string `pulumi:"id"`
-	// The fingerprint of the PGP key used to encrypt the secret.
-	KeyFingerprint *string `pulumi:"keyFingerprint"`
-	// The access key secret.
-	Secret *string `pulumi:"secret"`
-	// The secret access key converted into an SES SMTP password by applying AWS's Sigv4 conversion algorithm.
-	SesSmtpPasswordV4 *string `pulumi:"sesSmtpPasswordV4"`
-	// Active or Inactive. Keys are initially active, but can be made inactive by other means.
-	Status *string `pulumi:"status"`
-}
-
-// The IAM access key.
-type AccessKeyOutputOutput struct{ *pulumi.OutputState }
-
-func (AccessKeyOutputOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*AccessKeyOutput)(nil)).Elem()
-}
-
-func (o AccessKeyOutputOutput) ToAccessKeyOutputOutput() AccessKeyOutputOutput {
-	return o
+type AWSAuth struct {
+	// Mapping of the admin role.
+	Admin *AWSAuthRole `pulumi:"admin"`
+	// Mapping of the poweruser role.
+	Poweruser *AWSAuthRole `pulumi:"poweruser"`
+	// Mapping of the readonly role.
+	Readonly *AWSAuthRole `pulumi:"readonly"`
 }
 
-func (o AccessKeyOutputOutput) ToAccessKeyOutputOutputWithContext(ctx context.Context) AccessKeyOutputOutput {
-	return o
-}
+// AWSAuthInput is an input type that accepts AWSAuthArgs and AWSAuthOutput values.
+// You can construct a concrete instance of `AWSAuthInput` via:
+//
+//	AWSAuthArgs{...}
+type AWSAuthInput interface {
+	pulumi.Input
 
-// The encrypted secret, base64 encoded.
-func (o AccessKeyOutputOutput) EncryptedSecret() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v AccessKeyOutput) *string { return v.EncryptedSecret }).(pulumi.StringPtrOutput)
+	ToAWSAuthOutput() AWSAuthOutput
+	ToAWSAuthOutputWithContext(context.Context) AWSAuthOutput
 }
 
-// The access key ID.
-func (o AccessKeyOutputOutput) Id() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v AccessKeyOutput) *string { return v.Id }).(pulumi.StringPtrOutput)
+type AWSAuthArgs struct {
+	// Mapping of the admin role.
+	Admin AWSAuthRolePtrInput `pulumi:"admin"`
+	// Mapping of the poweruser role.
+	Poweruser AWSAuthRolePtrInput `pulumi:"poweruser"`
+	// Mapping of the readonly role.
+	Readonly AWSAuthRolePtrInput `pulumi:"readonly"`
 }
 
-// The fingerprint of the PGP key used to encrypt the secret.
-func (o AccessKeyOutputOutput) KeyFingerprint() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v AccessKeyOutput) *string { return v.KeyFingerprint }).(pulumi.StringPtrOutput)
+func (AWSAuthArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AWSAuth)(nil)).Elem()
 }
 
-// The access key secret.
-func (o AccessKeyOutputOutput) Secret() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v AccessKeyOutput) *string { return v.Secret }).(pulumi.StringPtrOutput)
+func (i AWSAuthArgs) ToAWSAuthOutput() AWSAuthOutput {
+	return i.ToAWSAuthOutputWithContext(context.Background())
 }
 
-// The secret access key converted into an SES SMTP password by applying AWS's Sigv4 conversion algorithm.
-func (o AccessKeyOutputOutput) SesSmtpPasswordV4() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v AccessKeyOutput) *string { return v.SesSmtpPasswordV4 }).(pulumi.StringPtrOutput)
+func (i AWSAuthArgs) ToAWSAuthOutputWithContext(ctx context.Context) AWSAuthOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSAuthOutput)
 }
 
-// Active or Inactive. Keys are initially active, but can be made inactive by other means.
-func (o AccessKeyOutputOutput) Status() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v AccessKeyOutput) *string { return v.Status }).(pulumi.StringPtrOutput)
+func (i AWSAuthArgs) ToAWSAuthPtrOutput() AWSAuthPtrOutput {
+	return i.ToAWSAuthPtrOutputWithContext(context.Background())
 }
 
-// Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords.
-type AccountPasswordPolicy struct {
-	// Whether to allow users to change their own password.
-	AllowUsersToChange bool `pulumi:"allowUsersToChange"`
-	// Whether users are prevented from setting a new password after their password has
-	// expired (i.e. require administrator reset).
-	HardExpiry bool `pulumi:"hardExpiry"`
-	// The number of days that an user password is valid. If not set or a value of `0` is provided, then
-	// passwords will not expire.
-	MaxAge *int `pulumi:"maxAge"`
-	// Minimum length to require for user passwords. Defaults to `8` if not set or
-	// the provided value is invalid. Valid values are between 6 and 128.
-	MinimumLength *int `pulumi:"minimumLength"`
-	// Whether to require lowercase characters for user passwords.
-	RequireLowercaseCharacters bool `pulumi:"requireLowercaseCharacters"`
-	// Whether to require numbers for user passwords.
-	RequireNumbers bool `pulumi:"requireNumbers"`
-	// Whether to require symbols for user passwords.
-	RequireSymbols bool `pulumi:"requireSymbols"`
-	// Whether to require uppercase characters for user passwords.
-	RequireUppercaseCharacters bool `pulumi:"requireUppercaseCharacters"`
-	// The number of previous passwords that users are prevented from reusing. If not set or a
-	// value of `0` is provided, no reuse prevention policy will be used.
-	ReusePrevention *int `pulumi:"reusePrevention"`
+func (i AWSAuthArgs) ToAWSAuthPtrOutputWithContext(ctx context.Context) AWSAuthPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSAuthOutput).ToAWSAuthPtrOutputWithContext(ctx)
 }
 
-// AccountPasswordPolicyInput is an input type that accepts AccountPasswordPolicyArgs and AccountPasswordPolicyOutput values.
-// You can construct a concrete instance of `AccountPasswordPolicyInput` via:
+// AWSAuthPtrInput is an input type that accepts AWSAuthArgs, AWSAuthPtr and AWSAuthPtrOutput values.
+// You can construct a concrete instance of `AWSAuthPtrInput` via:
 //
-//	AccountPasswordPolicyArgs{...}
-type AccountPasswordPolicyInput interface {
+//	        AWSAuthArgs{...}
+//
+//	or:
+//
+//	        nil
+type AWSAuthPtrInput interface {
 	pulumi.Input
 
-	ToAccountPasswordPolicyOutput() AccountPasswordPolicyOutput
-	ToAccountPasswordPolicyOutputWithContext(context.Context) AccountPasswordPolicyOutput
+	ToAWSAuthPtrOutput() AWSAuthPtrOutput
+	ToAWSAuthPtrOutputWithContext(context.Context) AWSAuthPtrOutput
 }
 
-// Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords.
-type AccountPasswordPolicyArgs struct {
-	// Whether to allow users to change their own password.
-	AllowUsersToChange pulumi.BoolInput `pulumi:"allowUsersToChange"`
-	// Whether users are prevented from setting a new password after their password has
-	// expired (i.e. require administrator reset).
-	HardExpiry pulumi.BoolInput `pulumi:"hardExpiry"`
-	// The number of days that an user password is valid. If not set or a value of `0` is provided, then
-	// passwords will not expire.
-	MaxAge pulumi.IntPtrInput `pulumi:"maxAge"`
-	// Minimum length to require for user passwords. Defaults to `8` if not set or
-	// the provided value is invalid. Valid values are between 6 and 128.
-	MinimumLength pulumi.IntPtrInput `pulumi:"minimumLength"`
-	// Whether to require lowercase characters for user passwords.
-	RequireLowercaseCharacters pulumi.BoolInput `pulumi:"requireLowercaseCharacters"`
-	// Whether to require numbers for user passwords.
-	RequireNumbers pulumi.BoolInput `pulumi:"requireNumbers"`
-	// Whether to require symbols for user passwords.
-	RequireSymbols pulumi.BoolInput `pulumi:"requireSymbols"`
-	// Whether to require uppercase characters for user passwords.
-	RequireUppercaseCharacters pulumi.BoolInput `pulumi:"requireUppercaseCharacters"`
-	// The number of previous passwords that users are prevented from reusing. If not set or a
-	// value of `0` is provided, no reuse prevention policy will be used.
-	ReusePrevention pulumi.IntPtrInput `pulumi:"reusePrevention"`
+type awsauthPtrType AWSAuthArgs
+
+func AWSAuthPtr(v *AWSAuthArgs) AWSAuthPtrInput {
+	return (*awsauthPtrType)(v)
 }
 
-func (AccountPasswordPolicyArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*AccountPasswordPolicy)(nil)).Elem()
+func (*awsauthPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**AWSAuth)(nil)).Elem()
 }
 
-func (i AccountPasswordPolicyArgs) ToAccountPasswordPolicyOutput() AccountPasswordPolicyOutput {
-	return i.ToAccountPasswordPolicyOutputWithContext(context.Background())
+func (i *awsauthPtrType) ToAWSAuthPtrOutput() AWSAuthPtrOutput {
+	return i.ToAWSAuthPtrOutputWithContext(context.Background())
 }
 
-func (i AccountPasswordPolicyArgs) ToAccountPasswordPolicyOutputWithContext(ctx context.Context) AccountPasswordPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(AccountPasswordPolicyOutput)
+func (i *awsauthPtrType) ToAWSAuthPtrOutputWithContext(ctx context.Context) AWSAuthPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSAuthPtrOutput)
 }
 
-// Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords.
-type AccountPasswordPolicyOutput struct{ *pulumi.OutputState }
+type AWSAuthOutput struct{ *pulumi.OutputState }
 
-func (AccountPasswordPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*AccountPasswordPolicy)(nil)).Elem()
+func (AWSAuthOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AWSAuth)(nil)).Elem()
 }
 
-func (o AccountPasswordPolicyOutput) ToAccountPasswordPolicyOutput() AccountPasswordPolicyOutput {
+func (o AWSAuthOutput) ToAWSAuthOutput() AWSAuthOutput {
 	return o
 }
 
-func (o AccountPasswordPolicyOutput) ToAccountPasswordPolicyOutputWithContext(ctx context.Context) AccountPasswordPolicyOutput {
+func (o AWSAuthOutput) ToAWSAuthOutputWithContext(ctx context.Context) AWSAuthOutput {
 	return o
 }
 
-// Whether to allow users to change their own password.
-func (o AccountPasswordPolicyOutput) AllowUsersToChange() pulumi.BoolOutput {
-	return o.ApplyT(func(v AccountPasswordPolicy) bool { return v.AllowUsersToChange }).(pulumi.BoolOutput)
+func (o AWSAuthOutput) ToAWSAuthPtrOutput() AWSAuthPtrOutput {
+	return o.ToAWSAuthPtrOutputWithContext(context.Background())
 }
 
-// Whether users are prevented from setting a new password after their password has
-// expired (i.e. require administrator reset).
-func (o AccountPasswordPolicyOutput) HardExpiry() pulumi.BoolOutput {
-	return o.ApplyT(func(v AccountPasswordPolicy) bool { return v.HardExpiry }).(pulumi.BoolOutput)
+func (o AWSAuthOutput) ToAWSAuthPtrOutputWithContext(ctx context.Context) AWSAuthPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v AWSAuth) *AWSAuth {
+		return &v
+	}).(AWSAuthPtrOutput)
 }
 
-// The number of days that an user password is valid. If not set or a value of `0` is provided, then
-// passwords will not expire.
-func (o AccountPasswordPolicyOutput) MaxAge() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v AccountPasswordPolicy) *int { return v.MaxAge }).(pulumi.IntPtrOutput)
+// Mapping of the admin role.
+func (o AWSAuthOutput) Admin() AWSAuthRolePtrOutput {
+	return o.ApplyT(func(v AWSAuth) *AWSAuthRole { return v.Admin }).(AWSAuthRolePtrOutput)
 }
 
-// Minimum length to require for user passwords. Defaults to `8` if not set or
-// the provided value is invalid. Valid values are between 6 and 128.
-func (o AccountPasswordPolicyOutput) MinimumLength() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v AccountPasswordPolicy) *int { return v.MinimumLength }).(pulumi.IntPtrOutput)
+// Mapping of the poweruser role.
+func (o AWSAuthOutput) Poweruser() AWSAuthRolePtrOutput {
+	return o.ApplyT(func(v AWSAuth) *AWSAuthRole { return v.Poweruser }).(AWSAuthRolePtrOutput)
 }
 
-// Whether to require lowercase characters for user passwords.
-func (o AccountPasswordPolicyOutput) RequireLowercaseCharacters() pulumi.BoolOutput {
-	return o.ApplyT(func(v AccountPasswordPolicy) bool { return v.RequireLowercaseCharacters }).(pulumi.BoolOutput)
+// Mapping of the readonly role.
+func (o AWSAuthOutput) Readonly() AWSAuthRolePtrOutput {
+	return o.ApplyT(func(v AWSAuth) *AWSAuthRole { return v.Readonly }).(AWSAuthRolePtrOutput)
 }
 
-// Whether to require numbers for user passwords.
-func (o AccountPasswordPolicyOutput) RequireNumbers() pulumi.BoolOutput {
-	return o.ApplyT(func(v AccountPasswordPolicy) bool { return v.RequireNumbers }).(pulumi.BoolOutput)
+type AWSAuthPtrOutput struct{ *pulumi.OutputState }
+
+func (AWSAuthPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AWSAuth)(nil)).Elem()
 }
 
-// Whether to require symbols for user passwords.
-func (o AccountPasswordPolicyOutput) RequireSymbols() pulumi.BoolOutput {
-	return o.ApplyT(func(v AccountPasswordPolicy) bool { return v.RequireSymbols }).(pulumi.BoolOutput)
+func (o AWSAuthPtrOutput) ToAWSAuthPtrOutput() AWSAuthPtrOutput {
+	return o
 }
 
-// Whether to require uppercase characters for user passwords.
-func (o AccountPasswordPolicyOutput) RequireUppercaseCharacters() pulumi.BoolOutput {
-	return o.ApplyT(func(v AccountPasswordPolicy) bool { return v.RequireUppercaseCharacters }).(pulumi.BoolOutput)
+func (o AWSAuthPtrOutput) ToAWSAuthPtrOutputWithContext(ctx context.Context) AWSAuthPtrOutput {
+	return o
 }
 
-// The number of previous passwords that users are prevented from reusing. If not set or a
-// value of `0` is provided, no reuse prevention policy will be used.
-func (o AccountPasswordPolicyOutput) ReusePrevention() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v AccountPasswordPolicy) *int { return v.ReusePrevention }).(pulumi.IntPtrOutput)
+func (o AWSAuthPtrOutput) Elem() AWSAuthOutput {
+	return o.ApplyT(func(v *AWSAuth) AWSAuth {
+		if v != nil {
+			return *v
+		}
+		var ret AWSAuth
+		return ret
+	}).(AWSAuthOutput)
 }
 
-// The admin role.
-type AdminRole struct {
-	// IAM role with admin access.
-	Name *string `pulumi:"name"`
-	// Path of admin IAM role. Defaults to '/'
-	Path *string `pulumi:"path"`
-	// Permissions boundary ARN to use for admin role.
-	PermissionsBoundaryArn *string `pulumi:"permissionsBoundaryArn"`
-	// List of policy ARNs to use for admin role.
-	PolicyArns []string `pulumi:"policyArns"`
-	// A map of tags to add.
-	Tags map[string]string `pulumi:"tags"`
+// Mapping of the admin role.
+func (o AWSAuthPtrOutput) Admin() AWSAuthRolePtrOutput {
+	return o.ApplyT(func(v *AWSAuth) *AWSAuthRole {
+		if v == nil {
+			return nil
+		}
+		return v.Admin
+	}).(AWSAuthRolePtrOutput)
 }
 
-// Defaults sets the appropriate defaults for AdminRole
-func (val *AdminRole) Defaults() *AdminRole {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	if tmp.Name == nil {
-		name_ := "admin"
-		tmp.Name = &name_
-	}
-	return &tmp
+// Mapping of the poweruser role.
+func (o AWSAuthPtrOutput) Poweruser() AWSAuthRolePtrOutput {
+	return o.ApplyT(func(v *AWSAuth) *AWSAuthRole {
+		if v == nil {
+			return nil
+		}
+		return v.Poweruser
+	}).(AWSAuthRolePtrOutput)
 }
 
-// AdminRoleInput is an input type that accepts AdminRoleArgs and AdminRoleOutput values.
-// You can construct a concrete instance of `AdminRoleInput` via:
+// Mapping of the readonly role.
+func (o AWSAuthPtrOutput) Readonly() AWSAuthRolePtrOutput {
+	return o.ApplyT(func(v *AWSAuth) *AWSAuthRole {
+		if v == nil {
+			return nil
+		}
+		return v.Readonly
+	}).(AWSAuthRolePtrOutput)
+}
+
+type AWSAuthRole struct {
+	// Kubernetes groups of the role, e.g. `system:masters`.
+	Groups []string `pulumi:"groups"`
+	// Kubernetes username of the role. Defaults to `<role name>:{{SessionName}}`.
+	Username *string `pulumi:"username"`
+}
+
+// AWSAuthRoleInput is an input type that accepts AWSAuthRoleArgs and AWSAuthRoleOutput values.
+// You can construct a concrete instance of `AWSAuthRoleInput` via:
 //
-//	AdminRoleArgs{...}
-type AdminRoleInput interface {
+//	AWSAuthRoleArgs{...}
+type AWSAuthRoleInput interface {
 	pulumi.Input
 
-	ToAdminRoleOutput() AdminRoleOutput
-	ToAdminRoleOutputWithContext(context.Context) AdminRoleOutput
+	ToAWSAuthRoleOutput() AWSAuthRoleOutput
+	ToAWSAuthRoleOutputWithContext(context.Context) AWSAuthRoleOutput
 }
 
-// The admin role.
-type AdminRoleArgs struct {
-	// IAM role with admin access.
-	Name pulumi.StringPtrInput `pulumi:"name"`
-	// Path of admin IAM role. Defaults to '/'
-	Path pulumi.StringPtrInput `pulumi:"path"`
-	// Permissions boundary ARN to use for admin role.
-	PermissionsBoundaryArn pulumi.StringPtrInput `pulumi:"permissionsBoundaryArn"`
-	// List of policy ARNs to use for admin role.
-	PolicyArns pulumi.StringArrayInput `pulumi:"policyArns"`
-	// A map of tags to add.
-	Tags pulumi.StringMapInput `pulumi:"tags"`
+type AWSAuthRoleArgs struct {
+	// Kubernetes groups of the role, e.g. `system:masters`.
+	Groups pulumi.StringArrayInput `pulumi:"groups"`
+	// Kubernetes username of the role. Defaults to `<role name>:{{SessionName}}`.
+	Username pulumi.StringPtrInput `pulumi:"username"`
 }
 
-// Defaults sets the appropriate defaults for AdminRoleArgs
-func (val *AdminRoleArgs) Defaults() *AdminRoleArgs {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	if tmp.Name == nil {
-		tmp.Name = pulumi.StringPtr("admin")
-	}
-	return &tmp
-}
-func (AdminRoleArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*AdminRole)(nil)).Elem()
+func (AWSAuthRoleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AWSAuthRole)(nil)).Elem()
 }
 
-func (i AdminRoleArgs) ToAdminRoleOutput() AdminRoleOutput {
-	return i.ToAdminRoleOutputWithContext(context.Background())
+func (i AWSAuthRoleArgs) ToAWSAuthRoleOutput() AWSAuthRoleOutput {
+	return i.ToAWSAuthRoleOutputWithContext(context.Background())
 }
 
-func (i AdminRoleArgs) ToAdminRoleOutputWithContext(ctx context.Context) AdminRoleOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(AdminRoleOutput)
+func (i AWSAuthRoleArgs) ToAWSAuthRoleOutputWithContext(ctx context.Context) AWSAuthRoleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSAuthRoleOutput)
 }
 
-func (i AdminRoleArgs) ToAdminRolePtrOutput() AdminRolePtrOutput {
-	return i.ToAdminRolePtrOutputWithContext(context.Background())
+func (i AWSAuthRoleArgs) ToAWSAuthRolePtrOutput() AWSAuthRolePtrOutput {
+	return i.ToAWSAuthRolePtrOutputWithContext(context.Background())
 }
 
-func (i AdminRoleArgs) ToAdminRolePtrOutputWithContext(ctx context.Context) AdminRolePtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(AdminRoleOutput).ToAdminRolePtrOutputWithContext(ctx)
+func (i AWSAuthRoleArgs) ToAWSAuthRolePtrOutputWithContext(ctx context.Context) AWSAuthRolePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSAuthRoleOutput).ToAWSAuthRolePtrOutputWithContext(ctx)
 }
 
-// AdminRolePtrInput is an input type that accepts AdminRoleArgs, AdminRolePtr and AdminRolePtrOutput values.
-// You can construct a concrete instance of `AdminRolePtrInput` via:
+// AWSAuthRolePtrInput is an input type that accepts AWSAuthRoleArgs, AWSAuthRolePtr and AWSAuthRolePtrOutput values.
+// You can construct a concrete instance of `AWSAuthRolePtrInput` via:
 //
-//	        AdminRoleArgs{...}
+//	        AWSAuthRoleArgs{...}
 //
 //	or:
 //
 //	        nil
-type AdminRolePtrInput interface {
+type AWSAuthRolePtrInput interface {
 	pulumi.Input
 
-	ToAdminRolePtrOutput() AdminRolePtrOutput
-	ToAdminRolePtrOutputWithContext(context.Context) AdminRolePtrOutput
+	ToAWSAuthRolePtrOutput() AWSAuthRolePtrOutput
+	ToAWSAuthRolePtrOutputWithContext(context.Context) AWSAuthRolePtrOutput
 }
 
-type adminRolePtrType AdminRoleArgs
+type awsauthRolePtrType AWSAuthRoleArgs
 
-func AdminRolePtr(v *AdminRoleArgs) AdminRolePtrInput {
-	return (*adminRolePtrType)(v)
+func AWSAuthRolePtr(v *AWSAuthRoleArgs) AWSAuthRolePtrInput {
+	return (*awsauthRolePtrType)(v)
 }
 
-func (*adminRolePtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**AdminRole)(nil)).Elem()
+func (*awsauthRolePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**AWSAuthRole)(nil)).Elem()
 }
 
-func (i *adminRolePtrType) ToAdminRolePtrOutput() AdminRolePtrOutput {
-	return i.ToAdminRolePtrOutputWithContext(context.Background())
+func (i *awsauthRolePtrType) ToAWSAuthRolePtrOutput() AWSAuthRolePtrOutput {
+	return i.ToAWSAuthRolePtrOutputWithContext(context.Background())
 }
 
-func (i *adminRolePtrType) ToAdminRolePtrOutputWithContext(ctx context.Context) AdminRolePtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(AdminRolePtrOutput)
+func (i *awsauthRolePtrType) ToAWSAuthRolePtrOutputWithContext(ctx context.Context) AWSAuthRolePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSAuthRolePtrOutput)
 }
 
-// The admin role.
-type AdminRoleOutput struct{ *pulumi.OutputState }
+type AWSAuthRoleOutput struct{ *pulumi.OutputState }
 
-func (AdminRoleOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*AdminRole)(nil)).Elem()
+func (AWSAuthRoleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AWSAuthRole)(nil)).Elem()
 }
 
-func (o AdminRoleOutput) ToAdminRoleOutput() AdminRoleOutput {
+func (o AWSAuthRoleOutput) ToAWSAuthRoleOutput() AWSAuthRoleOutput {
 	return o
 }
 
-func (o AdminRoleOutput) ToAdminRoleOutputWithContext(ctx context.Context) AdminRoleOutput {
+func (o AWSAuthRoleOutput) ToAWSAuthRoleOutputWithContext(ctx context.Context) AWSAuthRoleOutput {
 	return o
 }
 
-func (o AdminRoleOutput) ToAdminRolePtrOutput() AdminRolePtrOutput {
-	return o.ToAdminRolePtrOutputWithContext(context.Background())
+func (o AWSAuthRoleOutput) ToAWSAuthRolePtrOutput() AWSAuthRolePtrOutput {
+	return o.ToAWSAuthRolePtrOutputWithContext(context.Background())
 }
 
-func (o AdminRoleOutput) ToAdminRolePtrOutputWithContext(ctx context.Context) AdminRolePtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v AdminRole) *AdminRole {
+func (o AWSAuthRoleOutput) ToAWSAuthRolePtrOutputWithContext(ctx context.Context) AWSAuthRolePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v AWSAuthRole) *AWSAuthRole {
 		return &v
-	}).(AdminRolePtrOutput)
+	}).(AWSAuthRolePtrOutput)
 }
 
-// IAM role with admin access.
-func (o AdminRoleOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v AdminRole) *string { return v.Name }).(pulumi.StringPtrOutput)
+// Kubernetes groups of the role, e.g. `system:masters`.
+func (o AWSAuthRoleOutput) Groups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AWSAuthRole) []string { return v.Groups }).(pulumi.StringArrayOutput)
 }
 
-// Path of admin IAM role. Defaults to '/'
-func (o AdminRoleOutput) Path() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v AdminRole) *string { return v.Path }).(pulumi.StringPtrOutput)
+// Kubernetes username of the role. Defaults to `<role name>:{{SessionName}}`.
+func (o AWSAuthRoleOutput) Username() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AWSAuthRole) *string { return v.Username }).(pulumi.StringPtrOutput)
 }
 
-// Permissions boundary ARN to use for admin role.
-func (o AdminRoleOutput) PermissionsBoundaryArn() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v AdminRole) *string { return v.PermissionsBoundaryArn }).(pulumi.StringPtrOutput)
-}
+type AWSAuthRolePtrOutput struct{ *pulumi.OutputState }
 
-// List of policy ARNs to use for admin role.
-func (o AdminRoleOutput) PolicyArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v AdminRole) []string { return v.PolicyArns }).(pulumi.StringArrayOutput)
+func (AWSAuthRolePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AWSAuthRole)(nil)).Elem()
 }
 
-// A map of tags to add.
-func (o AdminRoleOutput) Tags() pulumi.StringMapOutput {
-	return o.ApplyT(func(v AdminRole) map[string]string { return v.Tags }).(pulumi.StringMapOutput)
+func (o AWSAuthRolePtrOutput) ToAWSAuthRolePtrOutput() AWSAuthRolePtrOutput {
+	return o
 }
 
-type AdminRolePtrOutput struct{ *pulumi.OutputState }
+func (o AWSAuthRolePtrOutput) ToAWSAuthRolePtrOutputWithContext(ctx context.Context) AWSAuthRolePtrOutput {
+	return o
+}
 
-func (AdminRolePtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**AdminRole)(nil)).Elem()
-}
-
-func (o AdminRolePtrOutput) ToAdminRolePtrOutput() AdminRolePtrOutput {
-	return o
-}
-
-func (o AdminRolePtrOutput) ToAdminRolePtrOutputWithContext(ctx context.Context) AdminRolePtrOutput {
-	return o
-}
-
-func (o AdminRolePtrOutput) Elem() AdminRoleOutput {
-	return o.ApplyT(func(v *AdminRole) AdminRole {
+func (o AWSAuthRolePtrOutput) Elem() AWSAuthRoleOutput {
+	return o.ApplyT(func(v *AWSAuthRole) AWSAuthRole {
 		if v != nil {
 			return *v
 		}
-		var ret AdminRole
+		var ret AWSAuthRole
 		return ret
-	}).(AdminRoleOutput)
+	}).(AWSAuthRoleOutput)
 }
 
-// IAM role with admin access.
-func (o AdminRolePtrOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *AdminRole) *string {
+// Kubernetes groups of the role, e.g. `system:masters`.
+func (o AWSAuthRolePtrOutput) Groups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *AWSAuthRole) []string {
 		if v == nil {
 			return nil
 		}
-		return v.Name
-	}).(pulumi.StringPtrOutput)
+		return v.Groups
+	}).(pulumi.StringArrayOutput)
 }
 
-// Path of admin IAM role. Defaults to '/'
-func (o AdminRolePtrOutput) Path() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *AdminRole) *string {
+// Kubernetes username of the role. Defaults to `<role name>:{{SessionName}}`.
+func (o AWSAuthRolePtrOutput) Username() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AWSAuthRole) *string {
 		if v == nil {
 			return nil
 		}
-		return v.Path
+		return v.Username
 	}).(pulumi.StringPtrOutput)
 }
 
-// Permissions boundary ARN to use for admin role.
-func (o AdminRolePtrOutput) PermissionsBoundaryArn() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *AdminRole) *string {
-		if v == nil {
-			return nil
-		}
-		return v.PermissionsBoundaryArn
-	}).(pulumi.StringPtrOutput)
+type AWSConfigRole struct {
+	// Session duration in seconds.
+	DurationSeconds *int `pulumi:"durationSeconds"`
+	// STS ExternalId to pass when assuming the role.
+	ExternalId *string `pulumi:"externalId"`
+	// Name of the profile, without the profile prefix.
+	ProfileName string `pulumi:"profileName"`
+	// Whether the role requires MFA.
+	RequiresMfa *bool `pulumi:"requiresMfa"`
+	// ARN of the IAM role.
+	RoleArn string `pulumi:"roleArn"`
 }
 
-// List of policy ARNs to use for admin role.
-func (o AdminRolePtrOutput) PolicyArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *AdminRole) []string {
-		if v == nil {
-			return nil
-		}
-		return v.PolicyArns
-	}).(pulumi.StringArrayOutput)
+// Defaults sets the appropriate defaults for AWSConfigRole
+func (val *AWSConfigRole) Defaults() *AWSConfigRole {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.RequiresMfa == nil {
+		requiresMfa_ := false
+		tmp.RequiresMfa = &requiresMfa_
+	}
+	return &tmp
 }
 
-// A map of tags to add.
-func (o AdminRolePtrOutput) Tags() pulumi.StringMapOutput {
-	return o.ApplyT(func(v *AdminRole) map[string]string {
-		if v == nil {
-			return nil
-		}
-		return v.Tags
-	}).(pulumi.StringMapOutput)
+// AWSConfigRoleInput is an input type that accepts AWSConfigRoleArgs and AWSConfigRoleOutput values.
+// You can construct a concrete instance of `AWSConfigRoleInput` via:
+//
+//	AWSConfigRoleArgs{...}
+type AWSConfigRoleInput interface {
+	pulumi.Input
+
+	ToAWSConfigRoleOutput() AWSConfigRoleOutput
+	ToAWSConfigRoleOutputWithContext(context.Context) AWSConfigRoleOutput
 }
 
-// The admin role.
-type AdminRoleWithMFA struct {
-	// IAM role with admin access.
-	Name *string `pulumi:"name"`
-	// Path of admin IAM role.
-	Path *string `pulumi:"path"`
-	// Permissions boundary ARN to use for admin role.
-	PermissionsBoundaryArn *string `pulumi:"permissionsBoundaryArn"`
-	// List of policy ARNs to use for admin role.
-	PolicyArns []string `pulumi:"policyArns"`
-	// Whether admin role requires MFA.
-	RequiresMfa *bool `pulumi:"requiresMfa"`
-	// A map of tags to add.
-	Tags map[string]string `pulumi:"tags"`
+type AWSConfigRoleArgs struct {
+	// Session duration in seconds.
+	DurationSeconds pulumi.IntPtrInput `pulumi:"durationSeconds"`
+	// STS ExternalId to pass when assuming the role.
+	ExternalId pulumi.StringPtrInput `pulumi:"externalId"`
+	// Name of the profile, without the profile prefix.
+	ProfileName pulumi.StringInput `pulumi:"profileName"`
+	// Whether the role requires MFA.
+	RequiresMfa pulumi.BoolPtrInput `pulumi:"requiresMfa"`
+	// ARN of the IAM role.
+	RoleArn pulumi.StringInput `pulumi:"roleArn"`
 }
 
-// AdminRoleWithMFAInput is an input type that accepts AdminRoleWithMFAArgs and AdminRoleWithMFAOutput values.
-// You can construct a concrete instance of `AdminRoleWithMFAInput` via:
+// Defaults sets the appropriate defaults for AWSConfigRoleArgs
+func (val *AWSConfigRoleArgs) Defaults() *AWSConfigRoleArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.RequiresMfa == nil {
+		tmp.RequiresMfa = pulumi.BoolPtr(false)
+	}
+	return &tmp
+}
+func (AWSConfigRoleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AWSConfigRole)(nil)).Elem()
+}
+
+func (i AWSConfigRoleArgs) ToAWSConfigRoleOutput() AWSConfigRoleOutput {
+	return i.ToAWSConfigRoleOutputWithContext(context.Background())
+}
+
+func (i AWSConfigRoleArgs) ToAWSConfigRoleOutputWithContext(ctx context.Context) AWSConfigRoleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSConfigRoleOutput)
+}
+
+// AWSConfigRoleArrayInput is an input type that accepts AWSConfigRoleArray and AWSConfigRoleArrayOutput values.
+// You can construct a concrete instance of `AWSConfigRoleArrayInput` via:
 //
-//	AdminRoleWithMFAArgs{...}
-type AdminRoleWithMFAInput interface {
+//	AWSConfigRoleArray{ AWSConfigRoleArgs{...} }
+type AWSConfigRoleArrayInput interface {
 	pulumi.Input
 
-	ToAdminRoleWithMFAOutput() AdminRoleWithMFAOutput
-	ToAdminRoleWithMFAOutputWithContext(context.Context) AdminRoleWithMFAOutput
+	ToAWSConfigRoleArrayOutput() AWSConfigRoleArrayOutput
+	ToAWSConfigRoleArrayOutputWithContext(context.Context) AWSConfigRoleArrayOutput
 }
 
-// The admin role.
-type AdminRoleWithMFAArgs struct {
-	// IAM role with admin access.
-	Name pulumi.StringPtrInput `pulumi:"name"`
-	// Path of admin IAM role.
-	Path pulumi.StringPtrInput `pulumi:"path"`
-	// Permissions boundary ARN to use for admin role.
-	PermissionsBoundaryArn pulumi.StringPtrInput `pulumi:"permissionsBoundaryArn"`
-	// List of policy ARNs to use for admin role.
-	PolicyArns pulumi.StringArrayInput `pulumi:"policyArns"`
-	// Whether admin role requires MFA.
-	RequiresMfa pulumi.BoolPtrInput `pulumi:"requiresMfa"`
-	// A map of tags to add.
-	Tags pulumi.StringMapInput `pulumi:"tags"`
-}
+type AWSConfigRoleArray []AWSConfigRoleInput
 
-func (AdminRoleWithMFAArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*AdminRoleWithMFA)(nil)).Elem()
+func (AWSConfigRoleArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AWSConfigRole)(nil)).Elem()
 }
 
-func (i AdminRoleWithMFAArgs) ToAdminRoleWithMFAOutput() AdminRoleWithMFAOutput {
-	return i.ToAdminRoleWithMFAOutputWithContext(context.Background())
+func (i AWSConfigRoleArray) ToAWSConfigRoleArrayOutput() AWSConfigRoleArrayOutput {
+	return i.ToAWSConfigRoleArrayOutputWithContext(context.Background())
 }
 
-func (i AdminRoleWithMFAArgs) ToAdminRoleWithMFAOutputWithContext(ctx context.Context) AdminRoleWithMFAOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(AdminRoleWithMFAOutput)
+func (i AWSConfigRoleArray) ToAWSConfigRoleArrayOutputWithContext(ctx context.Context) AWSConfigRoleArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSConfigRoleArrayOutput)
 }
 
-// The admin role.
-type AdminRoleWithMFAOutput struct{ *pulumi.OutputState }
+type AWSConfigRoleOutput struct{ *pulumi.OutputState }
 
-func (AdminRoleWithMFAOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*AdminRoleWithMFA)(nil)).Elem()
+func (AWSConfigRoleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AWSConfigRole)(nil)).Elem()
 }
 
-func (o AdminRoleWithMFAOutput) ToAdminRoleWithMFAOutput() AdminRoleWithMFAOutput {
+func (o AWSConfigRoleOutput) ToAWSConfigRoleOutput() AWSConfigRoleOutput {
 	return o
 }
 
-func (o AdminRoleWithMFAOutput) ToAdminRoleWithMFAOutputWithContext(ctx context.Context) AdminRoleWithMFAOutput {
+func (o AWSConfigRoleOutput) ToAWSConfigRoleOutputWithContext(ctx context.Context) AWSConfigRoleOutput {
 	return o
 }
 
-// IAM role with admin access.
-func (o AdminRoleWithMFAOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v AdminRoleWithMFA) *string { return v.Name }).(pulumi.StringPtrOutput)
+// Session duration in seconds.
+func (o AWSConfigRoleOutput) DurationSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v AWSConfigRole) *int { return v.DurationSeconds }).(pulumi.IntPtrOutput)
 }
 
-// Path of admin IAM role.
-func (o AdminRoleWithMFAOutput) Path() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v AdminRoleWithMFA) *string { return v.Path }).(pulumi.StringPtrOutput)
+// STS ExternalId to pass when assuming the role.
+func (o AWSConfigRoleOutput) ExternalId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AWSConfigRole) *string { return v.ExternalId }).(pulumi.StringPtrOutput)
 }
 
-// Permissions boundary ARN to use for admin role.
-func (o AdminRoleWithMFAOutput) PermissionsBoundaryArn() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v AdminRoleWithMFA) *string { return v.PermissionsBoundaryArn }).(pulumi.StringPtrOutput)
+// Name of the profile, without the profile prefix.
+func (o AWSConfigRoleOutput) ProfileName() pulumi.StringOutput {
+	return o.ApplyT(func(v AWSConfigRole) string { return v.ProfileName }).(pulumi.StringOutput)
 }
 
-// List of policy ARNs to use for admin role.
-func (o AdminRoleWithMFAOutput) PolicyArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v AdminRoleWithMFA) []string { return v.PolicyArns }).(pulumi.StringArrayOutput)
+// Whether the role requires MFA.
+func (o AWSConfigRoleOutput) RequiresMfa() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AWSConfigRole) *bool { return v.RequiresMfa }).(pulumi.BoolPtrOutput)
 }
 
-// Whether admin role requires MFA.
-func (o AdminRoleWithMFAOutput) RequiresMfa() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v AdminRoleWithMFA) *bool { return v.RequiresMfa }).(pulumi.BoolPtrOutput)
+// ARN of the IAM role.
+func (o AWSConfigRoleOutput) RoleArn() pulumi.StringOutput {
+	return o.ApplyT(func(v AWSConfigRole) string { return v.RoleArn }).(pulumi.StringOutput)
 }
 
-// A map of tags to add.
-func (o AdminRoleWithMFAOutput) Tags() pulumi.StringMapOutput {
-	return o.ApplyT(func(v AdminRoleWithMFA) map[string]string { return v.Tags }).(pulumi.StringMapOutput)
+type AWSConfigRoleArrayOutput struct{ *pulumi.OutputState }
+
+func (AWSConfigRoleArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AWSConfigRole)(nil)).Elem()
 }
 
-// The Amazon Managed Service for Prometheus IAM policy to the role.
-type EKSAmazonManagedServicePrometheusPolicy struct {
-	// Determines whether to attach the Amazon Managed Service for Prometheus IAM policy to the role.
-	Attach bool `pulumi:"attach"`
-	// List of AMP Workspace ARNs to read and write metrics. If not provided, a default ARN of "*"
-	// will be provided.
-	WorkspaceArns []string `pulumi:"workspaceArns"`
+func (o AWSConfigRoleArrayOutput) ToAWSConfigRoleArrayOutput() AWSConfigRoleArrayOutput {
+	return o
 }
 
-// EKSAmazonManagedServicePrometheusPolicyInput is an input type that accepts EKSAmazonManagedServicePrometheusPolicyArgs and EKSAmazonManagedServicePrometheusPolicyOutput values.
-// You can construct a concrete instance of `EKSAmazonManagedServicePrometheusPolicyInput` via:
+func (o AWSConfigRoleArrayOutput) ToAWSConfigRoleArrayOutputWithContext(ctx context.Context) AWSConfigRoleArrayOutput {
+	return o
+}
+
+func (o AWSConfigRoleArrayOutput) Index(i pulumi.IntInput) AWSConfigRoleOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) AWSConfigRole {
+		return vs[0].([]AWSConfigRole)[vs[1].(int)]
+	}).(AWSConfigRoleOutput)
+}
+
+type AWSConfigSource struct {
+	// Command printing the credentials which assume the roles. When it contains `{role_arn}` it is run for
+	// each role with its ARN instead, e.g. to sign in to SAML roles with saml2aws.
+	CredentialProcess *string `pulumi:"credentialProcess"`
+	// ARN of the MFA device used for roles requiring MFA.
+	MfaSerial *string `pulumi:"mfaSerial"`
+	// Prefix added to the name of each role profile.
+	ProfilePrefix *string `pulumi:"profilePrefix"`
+	// Default region of the profiles.
+	Region *string `pulumi:"region"`
+	// Profile whose credentials assume the roles. Defaults to `default` when no other source is set.
+	SourceProfile *string `pulumi:"sourceProfile"`
+	// Account ID of the IAM Identity Center permission set assuming the roles.
+	SsoAccountId *string `pulumi:"ssoAccountId"`
+	// Region of the IAM Identity Center session.
+	SsoRegion *string `pulumi:"ssoRegion"`
+	// Name of the IAM Identity Center permission set assuming the roles.
+	SsoRoleName *string `pulumi:"ssoRoleName"`
+	// Name of the IAM Identity Center session whose credentials assume the roles.
+	SsoSession *string `pulumi:"ssoSession"`
+	// Start URL of the IAM Identity Center session.
+	SsoStartUrl *string `pulumi:"ssoStartUrl"`
+}
+
+// AWSConfigSourceInput is an input type that accepts AWSConfigSourceArgs and AWSConfigSourceOutput values.
+// You can construct a concrete instance of `AWSConfigSourceInput` via:
 //
-//	EKSAmazonManagedServicePrometheusPolicyArgs{...}
-type EKSAmazonManagedServicePrometheusPolicyInput interface {
+//	AWSConfigSourceArgs{...}
+type AWSConfigSourceInput interface {
 	pulumi.Input
 
-	ToEKSAmazonManagedServicePrometheusPolicyOutput() EKSAmazonManagedServicePrometheusPolicyOutput
-	ToEKSAmazonManagedServicePrometheusPolicyOutputWithContext(context.Context) EKSAmazonManagedServicePrometheusPolicyOutput
+	ToAWSConfigSourceOutput() AWSConfigSourceOutput
+	ToAWSConfigSourceOutputWithContext(context.Context) AWSConfigSourceOutput
 }
 
-// The Amazon Managed Service for Prometheus IAM policy to the role.
-type EKSAmazonManagedServicePrometheusPolicyArgs struct {
-	// Determines whether to attach the Amazon Managed Service for Prometheus IAM policy to the role.
-	Attach pulumi.BoolInput `pulumi:"attach"`
-	// List of AMP Workspace ARNs to read and write metrics. If not provided, a default ARN of "*"
-	// will be provided.
-	WorkspaceArns pulumi.StringArrayInput `pulumi:"workspaceArns"`
+type AWSConfigSourceArgs struct {
+	// Command printing the credentials which assume the roles. When it contains `{role_arn}` it is run for
+	// each role with its ARN instead, e.g. to sign in to SAML roles with saml2aws.
+	CredentialProcess pulumi.StringPtrInput `pulumi:"credentialProcess"`
+	// ARN of the MFA device used for roles requiring MFA.
+	MfaSerial pulumi.StringPtrInput `pulumi:"mfaSerial"`
+	// Prefix added to the name of each role profile.
+	ProfilePrefix pulumi.StringPtrInput `pulumi:"profilePrefix"`
+	// Default region of the profiles.
+	Region pulumi.StringPtrInput `pulumi:"region"`
+	// Profile whose credentials assume the roles. Defaults to `default` when no other source is set.
+	SourceProfile pulumi.StringPtrInput `pulumi:"sourceProfile"`
+	// Account ID of the IAM Identity Center permission set assuming the roles.
+	SsoAccountId pulumi.StringPtrInput `pulumi:"ssoAccountId"`
+	// Region of the IAM Identity Center session.
+	SsoRegion pulumi.StringPtrInput `pulumi:"ssoRegion"`
+	// Name of the IAM Identity Center permission set assuming the roles.
+	SsoRoleName pulumi.StringPtrInput `pulumi:"ssoRoleName"`
+	// Name of the IAM Identity Center session whose credentials assume the roles.
+	SsoSession pulumi.StringPtrInput `pulumi:"ssoSession"`
+	// Start URL of the IAM Identity Center session.
+	SsoStartUrl pulumi.StringPtrInput `pulumi:"ssoStartUrl"`
 }
 
-func (EKSAmazonManagedServicePrometheusPolicyArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSAmazonManagedServicePrometheusPolicy)(nil)).Elem()
+func (AWSConfigSourceArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AWSConfigSource)(nil)).Elem()
 }
 
-func (i EKSAmazonManagedServicePrometheusPolicyArgs) ToEKSAmazonManagedServicePrometheusPolicyOutput() EKSAmazonManagedServicePrometheusPolicyOutput {
-	return i.ToEKSAmazonManagedServicePrometheusPolicyOutputWithContext(context.Background())
+func (i AWSConfigSourceArgs) ToAWSConfigSourceOutput() AWSConfigSourceOutput {
+	return i.ToAWSConfigSourceOutputWithContext(context.Background())
 }
 
-func (i EKSAmazonManagedServicePrometheusPolicyArgs) ToEKSAmazonManagedServicePrometheusPolicyOutputWithContext(ctx context.Context) EKSAmazonManagedServicePrometheusPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSAmazonManagedServicePrometheusPolicyOutput)
+func (i AWSConfigSourceArgs) ToAWSConfigSourceOutputWithContext(ctx context.Context) AWSConfigSourceOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSConfigSourceOutput)
 }
 
-func (i EKSAmazonManagedServicePrometheusPolicyArgs) ToEKSAmazonManagedServicePrometheusPolicyPtrOutput() EKSAmazonManagedServicePrometheusPolicyPtrOutput {
-	return i.ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(context.Background())
+func (i AWSConfigSourceArgs) ToAWSConfigSourcePtrOutput() AWSConfigSourcePtrOutput {
+	return i.ToAWSConfigSourcePtrOutputWithContext(context.Background())
 }
 
-func (i EKSAmazonManagedServicePrometheusPolicyArgs) ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(ctx context.Context) EKSAmazonManagedServicePrometheusPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSAmazonManagedServicePrometheusPolicyOutput).ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(ctx)
+func (i AWSConfigSourceArgs) ToAWSConfigSourcePtrOutputWithContext(ctx context.Context) AWSConfigSourcePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSConfigSourceOutput).ToAWSConfigSourcePtrOutputWithContext(ctx)
 }
 
-// EKSAmazonManagedServicePrometheusPolicyPtrInput is an input type that accepts EKSAmazonManagedServicePrometheusPolicyArgs, EKSAmazonManagedServicePrometheusPolicyPtr and EKSAmazonManagedServicePrometheusPolicyPtrOutput values.
-// You can construct a concrete instance of `EKSAmazonManagedServicePrometheusPolicyPtrInput` via:
+// AWSConfigSourcePtrInput is an input type that accepts AWSConfigSourceArgs, AWSConfigSourcePtr and AWSConfigSourcePtrOutput values.
+// You can construct a concrete instance of `AWSConfigSourcePtrInput` via:
 //
-//	        EKSAmazonManagedServicePrometheusPolicyArgs{...}
+//	        AWSConfigSourceArgs{...}
 //
 //	or:
 //
 //	        nil
-type EKSAmazonManagedServicePrometheusPolicyPtrInput interface {
+type AWSConfigSourcePtrInput interface {
 	pulumi.Input
 
-	ToEKSAmazonManagedServicePrometheusPolicyPtrOutput() EKSAmazonManagedServicePrometheusPolicyPtrOutput
-	ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(context.Context) EKSAmazonManagedServicePrometheusPolicyPtrOutput
+	ToAWSConfigSourcePtrOutput() AWSConfigSourcePtrOutput
+	ToAWSConfigSourcePtrOutputWithContext(context.Context) AWSConfigSourcePtrOutput
 }
 
-type eksamazonManagedServicePrometheusPolicyPtrType EKSAmazonManagedServicePrometheusPolicyArgs
+type awsconfigSourcePtrType AWSConfigSourceArgs
 
-func EKSAmazonManagedServicePrometheusPolicyPtr(v *EKSAmazonManagedServicePrometheusPolicyArgs) EKSAmazonManagedServicePrometheusPolicyPtrInput {
-	return (*eksamazonManagedServicePrometheusPolicyPtrType)(v)
+func AWSConfigSourcePtr(v *AWSConfigSourceArgs) AWSConfigSourcePtrInput {
+	return (*awsconfigSourcePtrType)(v)
 }
 
-func (*eksamazonManagedServicePrometheusPolicyPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSAmazonManagedServicePrometheusPolicy)(nil)).Elem()
+func (*awsconfigSourcePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**AWSConfigSource)(nil)).Elem()
 }
 
-func (i *eksamazonManagedServicePrometheusPolicyPtrType) ToEKSAmazonManagedServicePrometheusPolicyPtrOutput() EKSAmazonManagedServicePrometheusPolicyPtrOutput {
-	return i.ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(context.Background())
+func (i *awsconfigSourcePtrType) ToAWSConfigSourcePtrOutput() AWSConfigSourcePtrOutput {
+	return i.ToAWSConfigSourcePtrOutputWithContext(context.Background())
 }
 
-func (i *eksamazonManagedServicePrometheusPolicyPtrType) ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(ctx context.Context) EKSAmazonManagedServicePrometheusPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSAmazonManagedServicePrometheusPolicyPtrOutput)
+func (i *awsconfigSourcePtrType) ToAWSConfigSourcePtrOutputWithContext(ctx context.Context) AWSConfigSourcePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AWSConfigSourcePtrOutput)
 }
 
-// The Amazon Managed Service for Prometheus IAM policy to the role.
-type EKSAmazonManagedServicePrometheusPolicyOutput struct{ *pulumi.OutputState }
+type AWSConfigSourceOutput struct{ *pulumi.OutputState }
 
-func (EKSAmazonManagedServicePrometheusPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSAmazonManagedServicePrometheusPolicy)(nil)).Elem()
+func (AWSConfigSourceOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AWSConfigSource)(nil)).Elem()
 }
 
-func (o EKSAmazonManagedServicePrometheusPolicyOutput) ToEKSAmazonManagedServicePrometheusPolicyOutput() EKSAmazonManagedServicePrometheusPolicyOutput {
+func (o AWSConfigSourceOutput) ToAWSConfigSourceOutput() AWSConfigSourceOutput {
 	return o
 }
 
-func (o EKSAmazonManagedServicePrometheusPolicyOutput) ToEKSAmazonManagedServicePrometheusPolicyOutputWithContext(ctx context.Context) EKSAmazonManagedServicePrometheusPolicyOutput {
+func (o AWSConfigSourceOutput) ToAWSConfigSourceOutputWithContext(ctx context.Context) AWSConfigSourceOutput {
 	return o
 }
 
-func (o EKSAmazonManagedServicePrometheusPolicyOutput) ToEKSAmazonManagedServicePrometheusPolicyPtrOutput() EKSAmazonManagedServicePrometheusPolicyPtrOutput {
-	return o.ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(context.Background())
+func (o AWSConfigSourceOutput) ToAWSConfigSourcePtrOutput() AWSConfigSourcePtrOutput {
+	return o.ToAWSConfigSourcePtrOutputWithContext(context.Background())
 }
 
-func (o EKSAmazonManagedServicePrometheusPolicyOutput) ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(ctx context.Context) EKSAmazonManagedServicePrometheusPolicyPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSAmazonManagedServicePrometheusPolicy) *EKSAmazonManagedServicePrometheusPolicy {
+func (o AWSConfigSourceOutput) ToAWSConfigSourcePtrOutputWithContext(ctx context.Context) AWSConfigSourcePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v AWSConfigSource) *AWSConfigSource {
 		return &v
-	}).(EKSAmazonManagedServicePrometheusPolicyPtrOutput)
+	}).(AWSConfigSourcePtrOutput)
 }
 
-// Determines whether to attach the Amazon Managed Service for Prometheus IAM policy to the role.
-func (o EKSAmazonManagedServicePrometheusPolicyOutput) Attach() pulumi.BoolOutput {
-	return o.ApplyT(func(v EKSAmazonManagedServicePrometheusPolicy) bool { return v.Attach }).(pulumi.BoolOutput)
+// Command printing the credentials which assume the roles. When it contains `{role_arn}` it is run for
+// each role with its ARN instead, e.g. to sign in to SAML roles with saml2aws.
+func (o AWSConfigSourceOutput) CredentialProcess() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AWSConfigSource) *string { return v.CredentialProcess }).(pulumi.StringPtrOutput)
 }
 
-// List of AMP Workspace ARNs to read and write metrics. If not provided, a default ARN of "*"
-// will be provided.
-func (o EKSAmazonManagedServicePrometheusPolicyOutput) WorkspaceArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v EKSAmazonManagedServicePrometheusPolicy) []string { return v.WorkspaceArns }).(pulumi.StringArrayOutput)
+// ARN of the MFA device used for roles requiring MFA.
+func (o AWSConfigSourceOutput) MfaSerial() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AWSConfigSource) *string { return v.MfaSerial }).(pulumi.StringPtrOutput)
 }
 
-type EKSAmazonManagedServicePrometheusPolicyPtrOutput struct{ *pulumi.OutputState }
+// Prefix added to the name of each role profile.
+func (o AWSConfigSourceOutput) ProfilePrefix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AWSConfigSource) *string { return v.ProfilePrefix }).(pulumi.StringPtrOutput)
+}
 
-func (EKSAmazonManagedServicePrometheusPolicyPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSAmazonManagedServicePrometheusPolicy)(nil)).Elem()
+// Default region of the profiles.
+func (o AWSConfigSourceOutput) Region() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AWSConfigSource) *string { return v.Region }).(pulumi.StringPtrOutput)
 }
 
-func (o EKSAmazonManagedServicePrometheusPolicyPtrOutput) ToEKSAmazonManagedServicePrometheusPolicyPtrOutput() EKSAmazonManagedServicePrometheusPolicyPtrOutput {
+// Profile whose credentials assume the roles. Defaults to `default` when no other source is set.
+func (o AWSConfigSourceOutput) SourceProfile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AWSConfigSource) *string { return v.SourceProfile }).(pulumi.StringPtrOutput)
+}
+
+// Account ID of the IAM Identity Center permission set assuming the roles.
+func (o AWSConfigSourceOutput) SsoAccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AWSConfigSource) *string { return v.SsoAccountId }).(pulumi.StringPtrOutput)
+}
+
+// Region of the IAM Identity Center session.
+func (o AWSConfigSourceOutput) SsoRegion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AWSConfigSource) *string { return v.SsoRegion }).(pulumi.StringPtrOutput)
+}
+
+// Name of the IAM Identity Center permission set assuming the roles.
+func (o AWSConfigSourceOutput) SsoRoleName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AWSConfigSource) *string { return v.SsoRoleName }).(pulumi.StringPtrOutput)
+}
+
+// Name of the IAM Identity Center session whose credentials assume the roles.
+func (o AWSConfigSourceOutput) SsoSession() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AWSConfigSource) *string { return v.SsoSession }).(pulumi.StringPtrOutput)
+}
+
+// Start URL of the IAM Identity Center session.
+func (o AWSConfigSourceOutput) SsoStartUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AWSConfigSource) *string { return v.SsoStartUrl }).(pulumi.StringPtrOutput)
+}
+
+type AWSConfigSourcePtrOutput struct{ *pulumi.OutputState }
+
+func (AWSConfigSourcePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AWSConfigSource)(nil)).Elem()
+}
+
+func (o AWSConfigSourcePtrOutput) ToAWSConfigSourcePtrOutput() AWSConfigSourcePtrOutput {
 	return o
 }
 
-func (o EKSAmazonManagedServicePrometheusPolicyPtrOutput) ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(ctx context.Context) EKSAmazonManagedServicePrometheusPolicyPtrOutput {
+func (o AWSConfigSourcePtrOutput) ToAWSConfigSourcePtrOutputWithContext(ctx context.Context) AWSConfigSourcePtrOutput {
 	return o
 }
 
-func (o EKSAmazonManagedServicePrometheusPolicyPtrOutput) Elem() EKSAmazonManagedServicePrometheusPolicyOutput {
-	return o.ApplyT(func(v *EKSAmazonManagedServicePrometheusPolicy) EKSAmazonManagedServicePrometheusPolicy {
+func (o AWSConfigSourcePtrOutput) Elem() AWSConfigSourceOutput {
+	return o.ApplyT(func(v *AWSConfigSource) AWSConfigSource {
 		if v != nil {
 			return *v
 		}
-		var ret EKSAmazonManagedServicePrometheusPolicy
+		var ret AWSConfigSource
 		return ret
-	}).(EKSAmazonManagedServicePrometheusPolicyOutput)
+	}).(AWSConfigSourceOutput)
 }
 
-// Determines whether to attach the Amazon Managed Service for Prometheus IAM policy to the role.
-func (o EKSAmazonManagedServicePrometheusPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSAmazonManagedServicePrometheusPolicy) *bool {
+// Command printing the credentials which assume the roles. When it contains `{role_arn}` it is run for
+// each role with its ARN instead, e.g. to sign in to SAML roles with saml2aws.
+func (o AWSConfigSourcePtrOutput) CredentialProcess() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AWSConfigSource) *string {
 		if v == nil {
 			return nil
 		}
-		return &v.Attach
-	}).(pulumi.BoolPtrOutput)
+		return v.CredentialProcess
+	}).(pulumi.StringPtrOutput)
 }
 
-// List of AMP Workspace ARNs to read and write metrics. If not provided, a default ARN of "*"
-// will be provided.
-func (o EKSAmazonManagedServicePrometheusPolicyPtrOutput) WorkspaceArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *EKSAmazonManagedServicePrometheusPolicy) []string {
+// ARN of the MFA device used for roles requiring MFA.
+func (o AWSConfigSourcePtrOutput) MfaSerial() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AWSConfigSource) *string {
 		if v == nil {
 			return nil
 		}
-		return v.WorkspaceArns
-	}).(pulumi.StringArrayOutput)
+		return v.MfaSerial
+	}).(pulumi.StringPtrOutput)
 }
 
-// The Appmesh policies.
-type EKSAppmeshPolicy struct {
-	// Determines whether to attach the Appmesh Controller policy to the role.
-	Controller *bool `pulumi:"controller"`
-	// Determines whether to attach the Appmesh envoy proxy policy to the role.
-	EnvoyProxy *bool `pulumi:"envoyProxy"`
+// Prefix added to the name of each role profile.
+func (o AWSConfigSourcePtrOutput) ProfilePrefix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AWSConfigSource) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ProfilePrefix
+	}).(pulumi.StringPtrOutput)
 }
 
-// EKSAppmeshPolicyInput is an input type that accepts EKSAppmeshPolicyArgs and EKSAppmeshPolicyOutput values.
-// You can construct a concrete instance of `EKSAppmeshPolicyInput` via:
-//
-//	EKSAppmeshPolicyArgs{...}
-type EKSAppmeshPolicyInput interface {
-	pulumi.Input
+// Default region of the profiles.
+func (o AWSConfigSourcePtrOutput) Region() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AWSConfigSource) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Region
+	}).(pulumi.StringPtrOutput)
+}
 
-	ToEKSAppmeshPolicyOutput() EKSAppmeshPolicyOutput
-	ToEKSAppmeshPolicyOutputWithContext(context.Context) EKSAppmeshPolicyOutput
+// Profile whose credentials assume the roles. Defaults to `default` when no other source is set.
+func (o AWSConfigSourcePtrOutput) SourceProfile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AWSConfigSource) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SourceProfile
+	}).(pulumi.StringPtrOutput)
 }
 
-// The Appmesh policies.
-type EKSAppmeshPolicyArgs struct {
-	// Determines whether to attach the Appmesh Controller policy to the role.
-	Controller pulumi.BoolPtrInput `pulumi:"controller"`
-	// Determines whether to attach the Appmesh envoy proxy policy to the role.
-	EnvoyProxy pulumi.BoolPtrInput `pulumi:"envoyProxy"`
+// Account ID of the IAM Identity Center permission set assuming the roles.
+func (o AWSConfigSourcePtrOutput) SsoAccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AWSConfigSource) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SsoAccountId
+	}).(pulumi.StringPtrOutput)
 }
 
-func (EKSAppmeshPolicyArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSAppmeshPolicy)(nil)).Elem()
+// Region of the IAM Identity Center session.
+func (o AWSConfigSourcePtrOutput) SsoRegion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AWSConfigSource) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SsoRegion
+	}).(pulumi.StringPtrOutput)
 }
 
-func (i EKSAppmeshPolicyArgs) ToEKSAppmeshPolicyOutput() EKSAppmeshPolicyOutput {
-	return i.ToEKSAppmeshPolicyOutputWithContext(context.Background())
+// Name of the IAM Identity Center permission set assuming the roles.
+func (o AWSConfigSourcePtrOutput) SsoRoleName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AWSConfigSource) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SsoRoleName
+	}).(pulumi.StringPtrOutput)
 }
 
-func (i EKSAppmeshPolicyArgs) ToEKSAppmeshPolicyOutputWithContext(ctx context.Context) EKSAppmeshPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSAppmeshPolicyOutput)
+// Name of the IAM Identity Center session whose credentials assume the roles.
+func (o AWSConfigSourcePtrOutput) SsoSession() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AWSConfigSource) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SsoSession
+	}).(pulumi.StringPtrOutput)
 }
 
-func (i EKSAppmeshPolicyArgs) ToEKSAppmeshPolicyPtrOutput() EKSAppmeshPolicyPtrOutput {
-	return i.ToEKSAppmeshPolicyPtrOutputWithContext(context.Background())
+// Start URL of the IAM Identity Center session.
+func (o AWSConfigSourcePtrOutput) SsoStartUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AWSConfigSource) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SsoStartUrl
+	}).(pulumi.StringPtrOutput)
 }
 
-func (i EKSAppmeshPolicyArgs) ToEKSAppmeshPolicyPtrOutputWithContext(ctx context.Context) EKSAppmeshPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSAppmeshPolicyOutput).ToEKSAppmeshPolicyPtrOutputWithContext(ctx)
+// A group of actions of a service to allow based on matching tags.
+type AbacActionGroup struct {
+	// Actions allowed on resources whose tags match the principal tags. Actions without a service
+	// prefix are prefixed with the service.
+	Actions []string `pulumi:"actions"`
+	// Actions creating resources, only allowed when the request tags match the principal tags.
+	CreateActions []string `pulumi:"createActions"`
+	// Name of the group, used as the statement ID and unique across the groups. Defaults to the service, suffixed with the position of the group when the service is used by more than one unnamed group.
+	Name *string `pulumi:"name"`
+	// Resources the actions apply to. Defaults to `*`.
+	Resources []string `pulumi:"resources"`
+	// IAM prefix of the service, e.g. `ec2`.
+	Service string `pulumi:"service"`
 }
 
-// EKSAppmeshPolicyPtrInput is an input type that accepts EKSAppmeshPolicyArgs, EKSAppmeshPolicyPtr and EKSAppmeshPolicyPtrOutput values.
-// You can construct a concrete instance of `EKSAppmeshPolicyPtrInput` via:
-//
-//	        EKSAppmeshPolicyArgs{...}
-//
-//	or:
+// AbacActionGroupInput is an input type that accepts AbacActionGroupArgs and AbacActionGroupOutput values.
+// You can construct a concrete instance of `AbacActionGroupInput` via:
 //
-//	        nil
-type EKSAppmeshPolicyPtrInput interface {
+//	AbacActionGroupArgs{...}
+type AbacActionGroupInput interface {
 	pulumi.Input
 
-	ToEKSAppmeshPolicyPtrOutput() EKSAppmeshPolicyPtrOutput
-	ToEKSAppmeshPolicyPtrOutputWithContext(context.Context) EKSAppmeshPolicyPtrOutput
-}
-
-type eksappmeshPolicyPtrType EKSAppmeshPolicyArgs
-
-func EKSAppmeshPolicyPtr(v *EKSAppmeshPolicyArgs) EKSAppmeshPolicyPtrInput {
-	return (*eksappmeshPolicyPtrType)(v)
+	ToAbacActionGroupOutput() AbacActionGroupOutput
+	ToAbacActionGroupOutputWithContext(context.Context) AbacActionGroupOutput
 }
 
-func (*eksappmeshPolicyPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSAppmeshPolicy)(nil)).Elem()
+// A group of actions of a service to allow based on matching tags.
+type AbacActionGroupArgs struct {
+	// Actions allowed on resources whose tags match the principal tags. Actions without a service
+	// prefix are prefixed with the service.
+	Actions pulumi.StringArrayInput `pulumi:"actions"`
+	// Actions creating resources, only allowed when the request tags match the principal tags.
+	CreateActions pulumi.StringArrayInput `pulumi:"createActions"`
+	// Name of the group, used as the statement ID and unique across the groups. Defaults to the service, suffixed with the position of the group when the service is used by more than one unnamed group.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// Resources the actions apply to. Defaults to `*`.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
+	// IAM prefix of the service, e.g. `ec2`.
+	Service pulumi.StringInput `pulumi:"service"`
 }
 
-func (i *eksappmeshPolicyPtrType) ToEKSAppmeshPolicyPtrOutput() EKSAppmeshPolicyPtrOutput {
-	return i.ToEKSAppmeshPolicyPtrOutputWithContext(context.Background())
+func (AbacActionGroupArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AbacActionGroup)(nil)).Elem()
 }
 
-func (i *eksappmeshPolicyPtrType) ToEKSAppmeshPolicyPtrOutputWithContext(ctx context.Context) EKSAppmeshPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSAppmeshPolicyPtrOutput)
+func (i AbacActionGroupArgs) ToAbacActionGroupOutput() AbacActionGroupOutput {
+	return i.ToAbacActionGroupOutputWithContext(context.Background())
 }
 
-// The Appmesh policies.
-type EKSAppmeshPolicyOutput struct{ *pulumi.OutputState }
-
-func (EKSAppmeshPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSAppmeshPolicy)(nil)).Elem()
+func (i AbacActionGroupArgs) ToAbacActionGroupOutputWithContext(ctx context.Context) AbacActionGroupOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AbacActionGroupOutput)
 }
 
-func (o EKSAppmeshPolicyOutput) ToEKSAppmeshPolicyOutput() EKSAppmeshPolicyOutput {
-	return o
-}
+// AbacActionGroupArrayInput is an input type that accepts AbacActionGroupArray and AbacActionGroupArrayOutput values.
+// You can construct a concrete instance of `AbacActionGroupArrayInput` via:
+//
+//	AbacActionGroupArray{ AbacActionGroupArgs{...} }
+type AbacActionGroupArrayInput interface {
+	pulumi.Input
 
-func (o EKSAppmeshPolicyOutput) ToEKSAppmeshPolicyOutputWithContext(ctx context.Context) EKSAppmeshPolicyOutput {
-	return o
+	ToAbacActionGroupArrayOutput() AbacActionGroupArrayOutput
+	ToAbacActionGroupArrayOutputWithContext(context.Context) AbacActionGroupArrayOutput
 }
 
-func (o EKSAppmeshPolicyOutput) ToEKSAppmeshPolicyPtrOutput() EKSAppmeshPolicyPtrOutput {
-	return o.ToEKSAppmeshPolicyPtrOutputWithContext(context.Background())
-}
+type AbacActionGroupArray []AbacActionGroupInput
 
-func (o EKSAppmeshPolicyOutput) ToEKSAppmeshPolicyPtrOutputWithContext(ctx context.Context) EKSAppmeshPolicyPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSAppmeshPolicy) *EKSAppmeshPolicy {
-		return &v
-	}).(EKSAppmeshPolicyPtrOutput)
+func (AbacActionGroupArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AbacActionGroup)(nil)).Elem()
 }
 
-// Determines whether to attach the Appmesh Controller policy to the role.
-func (o EKSAppmeshPolicyOutput) Controller() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v EKSAppmeshPolicy) *bool { return v.Controller }).(pulumi.BoolPtrOutput)
+func (i AbacActionGroupArray) ToAbacActionGroupArrayOutput() AbacActionGroupArrayOutput {
+	return i.ToAbacActionGroupArrayOutputWithContext(context.Background())
 }
 
-// Determines whether to attach the Appmesh envoy proxy policy to the role.
-func (o EKSAppmeshPolicyOutput) EnvoyProxy() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v EKSAppmeshPolicy) *bool { return v.EnvoyProxy }).(pulumi.BoolPtrOutput)
+func (i AbacActionGroupArray) ToAbacActionGroupArrayOutputWithContext(ctx context.Context) AbacActionGroupArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AbacActionGroupArrayOutput)
 }
 
-type EKSAppmeshPolicyPtrOutput struct{ *pulumi.OutputState }
+// A group of actions of a service to allow based on matching tags.
+type AbacActionGroupOutput struct{ *pulumi.OutputState }
 
-func (EKSAppmeshPolicyPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSAppmeshPolicy)(nil)).Elem()
+func (AbacActionGroupOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AbacActionGroup)(nil)).Elem()
 }
 
-func (o EKSAppmeshPolicyPtrOutput) ToEKSAppmeshPolicyPtrOutput() EKSAppmeshPolicyPtrOutput {
+func (o AbacActionGroupOutput) ToAbacActionGroupOutput() AbacActionGroupOutput {
 	return o
 }
 
-func (o EKSAppmeshPolicyPtrOutput) ToEKSAppmeshPolicyPtrOutputWithContext(ctx context.Context) EKSAppmeshPolicyPtrOutput {
+func (o AbacActionGroupOutput) ToAbacActionGroupOutputWithContext(ctx context.Context) AbacActionGroupOutput {
 	return o
 }
 
-func (o EKSAppmeshPolicyPtrOutput) Elem() EKSAppmeshPolicyOutput {
-	return o.ApplyT(func(v *EKSAppmeshPolicy) EKSAppmeshPolicy {
-		if v != nil {
-			return *v
-		}
-		var ret EKSAppmeshPolicy
-		return ret
-	}).(EKSAppmeshPolicyOutput)
-}
-
-// Determines whether to attach the Appmesh Controller policy to the role.
-func (o EKSAppmeshPolicyPtrOutput) Controller() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSAppmeshPolicy) *bool {
-		if v == nil {
-			return nil
-		}
-		return v.Controller
-	}).(pulumi.BoolPtrOutput)
-}
-
-// Determines whether to attach the Appmesh envoy proxy policy to the role.
-func (o EKSAppmeshPolicyPtrOutput) EnvoyProxy() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSAppmeshPolicy) *bool {
-		if v == nil {
-			return nil
-		}
-		return v.EnvoyProxy
-	}).(pulumi.BoolPtrOutput)
-}
-
-// The Cert Manager IAM policy to attach to the role.
-type EKSCertManagerPolicy struct {
-	// Determines whether to attach the Cert Manager IAM policy to the role.
-	Attach bool `pulumi:"attach"`
-	// Route53 hosted zone ARNs to allow Cert manager to manage records. If not provided,
-	// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
-	HostedZoneArns []string `pulumi:"hostedZoneArns"`
-}
-
-// EKSCertManagerPolicyInput is an input type that accepts EKSCertManagerPolicyArgs and EKSCertManagerPolicyOutput values.
-// You can construct a concrete instance of `EKSCertManagerPolicyInput` via:
-//
-//	EKSCertManagerPolicyArgs{...}
-type EKSCertManagerPolicyInput interface {
-	pulumi.Input
-
-	ToEKSCertManagerPolicyOutput() EKSCertManagerPolicyOutput
-	ToEKSCertManagerPolicyOutputWithContext(context.Context) EKSCertManagerPolicyOutput
-}
-
-// The Cert Manager IAM policy to attach to the role.
-type EKSCertManagerPolicyArgs struct {
-	// Determines whether to attach the Cert Manager IAM policy to the role.
-	Attach pulumi.BoolInput `pulumi:"attach"`
-	// Route53 hosted zone ARNs to allow Cert manager to manage records. If not provided,
-	// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
-	HostedZoneArns pulumi.StringArrayInput `pulumi:"hostedZoneArns"`
-}
-
-func (EKSCertManagerPolicyArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSCertManagerPolicy)(nil)).Elem()
+// Actions allowed on resources whose tags match the principal tags. Actions without a service
+// prefix are prefixed with the service.
+func (o AbacActionGroupOutput) Actions() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AbacActionGroup) []string { return v.Actions }).(pulumi.StringArrayOutput)
 }
 
-func (i EKSCertManagerPolicyArgs) ToEKSCertManagerPolicyOutput() EKSCertManagerPolicyOutput {
-	return i.ToEKSCertManagerPolicyOutputWithContext(context.Background())
+// Actions creating resources, only allowed when the request tags match the principal tags.
+func (o AbacActionGroupOutput) CreateActions() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AbacActionGroup) []string { return v.CreateActions }).(pulumi.StringArrayOutput)
 }
 
-func (i EKSCertManagerPolicyArgs) ToEKSCertManagerPolicyOutputWithContext(ctx context.Context) EKSCertManagerPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSCertManagerPolicyOutput)
+// Name of the group, used as the statement ID and unique across the groups. Defaults to the service, suffixed with the position of the group when the service is used by more than one unnamed group.
+func (o AbacActionGroupOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AbacActionGroup) *string { return v.Name }).(pulumi.StringPtrOutput)
 }
 
-func (i EKSCertManagerPolicyArgs) ToEKSCertManagerPolicyPtrOutput() EKSCertManagerPolicyPtrOutput {
-	return i.ToEKSCertManagerPolicyPtrOutputWithContext(context.Background())
+// Resources the actions apply to. Defaults to `*`.
+func (o AbacActionGroupOutput) Resources() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AbacActionGroup) []string { return v.Resources }).(pulumi.StringArrayOutput)
 }
 
-func (i EKSCertManagerPolicyArgs) ToEKSCertManagerPolicyPtrOutputWithContext(ctx context.Context) EKSCertManagerPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSCertManagerPolicyOutput).ToEKSCertManagerPolicyPtrOutputWithContext(ctx)
+// IAM prefix of the service, e.g. `ec2`.
+func (o AbacActionGroupOutput) Service() pulumi.StringOutput {
+	return o.ApplyT(func(v AbacActionGroup) string { return v.Service }).(pulumi.StringOutput)
 }
 
-// EKSCertManagerPolicyPtrInput is an input type that accepts EKSCertManagerPolicyArgs, EKSCertManagerPolicyPtr and EKSCertManagerPolicyPtrOutput values.
-// You can construct a concrete instance of `EKSCertManagerPolicyPtrInput` via:
-//
-//	        EKSCertManagerPolicyArgs{...}
-//
-//	or:
-//
-//	        nil
-type EKSCertManagerPolicyPtrInput interface {
-	pulumi.Input
+type AbacActionGroupArrayOutput struct{ *pulumi.OutputState }
 
-	ToEKSCertManagerPolicyPtrOutput() EKSCertManagerPolicyPtrOutput
-	ToEKSCertManagerPolicyPtrOutputWithContext(context.Context) EKSCertManagerPolicyPtrOutput
+func (AbacActionGroupArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AbacActionGroup)(nil)).Elem()
 }
 
-type ekscertManagerPolicyPtrType EKSCertManagerPolicyArgs
-
-func EKSCertManagerPolicyPtr(v *EKSCertManagerPolicyArgs) EKSCertManagerPolicyPtrInput {
-	return (*ekscertManagerPolicyPtrType)(v)
+func (o AbacActionGroupArrayOutput) ToAbacActionGroupArrayOutput() AbacActionGroupArrayOutput {
+	return o
 }
 
-func (*ekscertManagerPolicyPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSCertManagerPolicy)(nil)).Elem()
+func (o AbacActionGroupArrayOutput) ToAbacActionGroupArrayOutputWithContext(ctx context.Context) AbacActionGroupArrayOutput {
+	return o
 }
 
-func (i *ekscertManagerPolicyPtrType) ToEKSCertManagerPolicyPtrOutput() EKSCertManagerPolicyPtrOutput {
-	return i.ToEKSCertManagerPolicyPtrOutputWithContext(context.Background())
+func (o AbacActionGroupArrayOutput) Index(i pulumi.IntInput) AbacActionGroupOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) AbacActionGroup {
+		return vs[0].([]AbacActionGroup)[vs[1].(int)]
+	}).(AbacActionGroupOutput)
 }
 
-func (i *ekscertManagerPolicyPtrType) ToEKSCertManagerPolicyPtrOutputWithContext(ctx context.Context) EKSCertManagerPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSCertManagerPolicyPtrOutput)
+// The IAM access key.
+type AccessKeyOutput struct {
+	// The encrypted secret, base64 encoded.
+	EncryptedSecret *string `pulumi:"encryptedSecret"`
+	// The access key ID.
+	Id *string `pulumi:"id"`
+	// The fingerprint of the PGP key used to encrypt the secret.
+	KeyFingerprint *string `pulumi:"keyFingerprint"`
+	// The access key secret.
+	Secret *string `pulumi:"secret"`
+	// The secret access key converted into an SES SMTP password by applying AWS's Sigv4 conversion algorithm.
+	SesSmtpPasswordV4 *string `pulumi:"sesSmtpPasswordV4"`
+	// Active or Inactive. Keys are initially active, but can be made inactive by other means.
+	Status *string `pulumi:"status"`
 }
 
-// The Cert Manager IAM policy to attach to the role.
-type EKSCertManagerPolicyOutput struct{ *pulumi.OutputState }
+// The IAM access key.
+type AccessKeyOutputOutput struct{ *pulumi.OutputState }
 
-func (EKSCertManagerPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSCertManagerPolicy)(nil)).Elem()
+func (AccessKeyOutputOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AccessKeyOutput)(nil)).Elem()
 }
 
-func (o EKSCertManagerPolicyOutput) ToEKSCertManagerPolicyOutput() EKSCertManagerPolicyOutput {
+func (o AccessKeyOutputOutput) ToAccessKeyOutputOutput() AccessKeyOutputOutput {
 	return o
 }
 
-func (o EKSCertManagerPolicyOutput) ToEKSCertManagerPolicyOutputWithContext(ctx context.Context) EKSCertManagerPolicyOutput {
+func (o AccessKeyOutputOutput) ToAccessKeyOutputOutputWithContext(ctx context.Context) AccessKeyOutputOutput {
 	return o
 }
 
-func (o EKSCertManagerPolicyOutput) ToEKSCertManagerPolicyPtrOutput() EKSCertManagerPolicyPtrOutput {
-	return o.ToEKSCertManagerPolicyPtrOutputWithContext(context.Background())
+// The encrypted secret, base64 encoded.
+func (o AccessKeyOutputOutput) EncryptedSecret() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AccessKeyOutput) *string { return v.EncryptedSecret }).(pulumi.StringPtrOutput)
 }
 
-func (o EKSCertManagerPolicyOutput) ToEKSCertManagerPolicyPtrOutputWithContext(ctx context.Context) EKSCertManagerPolicyPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSCertManagerPolicy) *EKSCertManagerPolicy {
-		return &v
-	}).(EKSCertManagerPolicyPtrOutput)
+// The access key ID.
+func (o AccessKeyOutputOutput) Id() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AccessKeyOutput) *string { return v.Id }).(pulumi.StringPtrOutput)
 }
 
-// Determines whether to attach the Cert Manager IAM policy to the role.
-func (o EKSCertManagerPolicyOutput) Attach() pulumi.BoolOutput {
-	return o.ApplyT(func(v EKSCertManagerPolicy) bool { return v.Attach }).(pulumi.BoolOutput)
+// The fingerprint of the PGP key used to encrypt the secret.
+func (o AccessKeyOutputOutput) KeyFingerprint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AccessKeyOutput) *string { return v.KeyFingerprint }).(pulumi.StringPtrOutput)
 }
 
-// Route53 hosted zone ARNs to allow Cert manager to manage records. If not provided,
-// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
-func (o EKSCertManagerPolicyOutput) HostedZoneArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v EKSCertManagerPolicy) []string { return v.HostedZoneArns }).(pulumi.StringArrayOutput)
+// The access key secret.
+func (o AccessKeyOutputOutput) Secret() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AccessKeyOutput) *string { return v.Secret }).(pulumi.StringPtrOutput)
 }
 
-type EKSCertManagerPolicyPtrOutput struct{ *pulumi.OutputState }
-
-func (EKSCertManagerPolicyPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSCertManagerPolicy)(nil)).Elem()
+// The secret access key converted into an SES SMTP password by applying AWS's Sigv4 conversion algorithm.
+func (o AccessKeyOutputOutput) SesSmtpPasswordV4() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AccessKeyOutput) *string { return v.SesSmtpPasswordV4 }).(pulumi.StringPtrOutput)
 }
 
-func (o EKSCertManagerPolicyPtrOutput) ToEKSCertManagerPolicyPtrOutput() EKSCertManagerPolicyPtrOutput {
-	return o
+// Active or Inactive. Keys are initially active, but can be made inactive by other means.
+func (o AccessKeyOutputOutput) Status() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AccessKeyOutput) *string { return v.Status }).(pulumi.StringPtrOutput)
 }
 
-func (o EKSCertManagerPolicyPtrOutput) ToEKSCertManagerPolicyPtrOutputWithContext(ctx context.Context) EKSCertManagerPolicyPtrOutput {
-	return o
-}
-
-func (o EKSCertManagerPolicyPtrOutput) Elem() EKSCertManagerPolicyOutput {
-	return o.ApplyT(func(v *EKSCertManagerPolicy) EKSCertManagerPolicy {
-		if v != nil {
-			return *v
-		}
-		var ret EKSCertManagerPolicy
-		return ret
-	}).(EKSCertManagerPolicyOutput)
-}
-
-// Determines whether to attach the Cert Manager IAM policy to the role.
-func (o EKSCertManagerPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSCertManagerPolicy) *bool {
-		if v == nil {
-			return nil
-		}
-		return &v.Attach
-	}).(pulumi.BoolPtrOutput)
-}
-
-// Route53 hosted zone ARNs to allow Cert manager to manage records. If not provided,
-// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
-func (o EKSCertManagerPolicyPtrOutput) HostedZoneArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *EKSCertManagerPolicy) []string {
-		if v == nil {
-			return nil
-		}
-		return v.HostedZoneArns
-	}).(pulumi.StringArrayOutput)
-}
-
-// The Cluster Autoscaler IAM policy to the role.
-type EKSClusterAutoscalerPolicy struct {
-	// Determines whether to attach the Cluster Autoscaler IAM policy to the role.
-	Attach bool `pulumi:"attach"`
-	// List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
-	ClusterIds []string `pulumi:"clusterIds"`
+// Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords.
+type AccountPasswordPolicy struct {
+	// Whether to allow users to change their own password.
+	AllowUsersToChange *bool `pulumi:"allowUsersToChange"`
+	// Whether users are prevented from setting a new password after their password has
+	// expired (i.e. require administrator reset).
+	HardExpiry *bool `pulumi:"hardExpiry"`
+	// The number of days that an user password is valid. If not set or a value of `0` is provided, then
+	// passwords will not expire.
+	MaxAge *int `pulumi:"maxAge"`
+	// Minimum length to require for user passwords. Defaults to `8` if not set or
+	// the provided value is invalid. Valid values are between 6 and 128.
+	MinimumLength *int `pulumi:"minimumLength"`
+	// Whether to require lowercase characters for user passwords.
+	RequireLowercaseCharacters *bool `pulumi:"requireLowercaseCharacters"`
+	// Whether to require numbers for user passwords.
+	RequireNumbers *bool `pulumi:"requireNumbers"`
+	// Whether to require symbols for user passwords.
+	RequireSymbols *bool `pulumi:"requireSymbols"`
+	// Whether to require uppercase characters for user passwords.
+	RequireUppercaseCharacters *bool `pulumi:"requireUppercaseCharacters"`
+	// The number of previous passwords that users are prevented from reusing. If not set or a
+	// value of `0` is provided, no reuse prevention policy will be used.
+	ReusePrevention *int `pulumi:"reusePrevention"`
 }
 
-// EKSClusterAutoscalerPolicyInput is an input type that accepts EKSClusterAutoscalerPolicyArgs and EKSClusterAutoscalerPolicyOutput values.
-// You can construct a concrete instance of `EKSClusterAutoscalerPolicyInput` via:
+// AccountPasswordPolicyInput is an input type that accepts AccountPasswordPolicyArgs and AccountPasswordPolicyOutput values.
+// You can construct a concrete instance of `AccountPasswordPolicyInput` via:
 //
-//	EKSClusterAutoscalerPolicyArgs{...}
-type EKSClusterAutoscalerPolicyInput interface {
+//	AccountPasswordPolicyArgs{...}
+type AccountPasswordPolicyInput interface {
 	pulumi.Input
 
-	ToEKSClusterAutoscalerPolicyOutput() EKSClusterAutoscalerPolicyOutput
-	ToEKSClusterAutoscalerPolicyOutputWithContext(context.Context) EKSClusterAutoscalerPolicyOutput
+	ToAccountPasswordPolicyOutput() AccountPasswordPolicyOutput
+	ToAccountPasswordPolicyOutputWithContext(context.Context) AccountPasswordPolicyOutput
 }
 
-// The Cluster Autoscaler IAM policy to the role.
-type EKSClusterAutoscalerPolicyArgs struct {
-	// Determines whether to attach the Cluster Autoscaler IAM policy to the role.
-	Attach pulumi.BoolInput `pulumi:"attach"`
-	// List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
-	ClusterIds pulumi.StringArrayInput `pulumi:"clusterIds"`
+// Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords.
+type AccountPasswordPolicyArgs struct {
+	// Whether to allow users to change their own password.
+	AllowUsersToChange pulumi.BoolPtrInput `pulumi:"allowUsersToChange"`
+	// Whether users are prevented from setting a new password after their password has
+	// expired (i.e. require administrator reset).
+	HardExpiry pulumi.BoolPtrInput `pulumi:"hardExpiry"`
+	// The number of days that an user password is valid. If not set or a value of `0` is provided, then
+	// passwords will not expire.
+	MaxAge pulumi.IntPtrInput `pulumi:"maxAge"`
+	// Minimum length to require for user passwords. Defaults to `8` if not set or
+	// the provided value is invalid. Valid values are between 6 and 128.
+	MinimumLength pulumi.IntPtrInput `pulumi:"minimumLength"`
+	// Whether to require lowercase characters for user passwords.
+	RequireLowercaseCharacters pulumi.BoolPtrInput `pulumi:"requireLowercaseCharacters"`
+	// Whether to require numbers for user passwords.
+	RequireNumbers pulumi.BoolPtrInput `pulumi:"requireNumbers"`
+	// Whether to require symbols for user passwords.
+	RequireSymbols pulumi.BoolPtrInput `pulumi:"requireSymbols"`
+	// Whether to require uppercase characters for user passwords.
+	RequireUppercaseCharacters pulumi.BoolPtrInput `pulumi:"requireUppercaseCharacters"`
+	// The number of previous passwords that users are prevented from reusing. If not set or a
+	// value of `0` is provided, no reuse prevention policy will be used.
+	ReusePrevention pulumi.IntPtrInput `pulumi:"reusePrevention"`
 }
 
-func (EKSClusterAutoscalerPolicyArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSClusterAutoscalerPolicy)(nil)).Elem()
+func (AccountPasswordPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AccountPasswordPolicy)(nil)).Elem()
 }
 
-func (i EKSClusterAutoscalerPolicyArgs) ToEKSClusterAutoscalerPolicyOutput() EKSClusterAutoscalerPolicyOutput {
-	return i.ToEKSClusterAutoscalerPolicyOutputWithContext(context.Background())
+func (i AccountPasswordPolicyArgs) ToAccountPasswordPolicyOutput() AccountPasswordPolicyOutput {
+	return i.ToAccountPasswordPolicyOutputWithContext(context.Background())
 }
 
-func (i EKSClusterAutoscalerPolicyArgs) ToEKSClusterAutoscalerPolicyOutputWithContext(ctx context.Context) EKSClusterAutoscalerPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSClusterAutoscalerPolicyOutput)
+func (i AccountPasswordPolicyArgs) ToAccountPasswordPolicyOutputWithContext(ctx context.Context) AccountPasswordPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AccountPasswordPolicyOutput)
 }
 
-func (i EKSClusterAutoscalerPolicyArgs) ToEKSClusterAutoscalerPolicyPtrOutput() EKSClusterAutoscalerPolicyPtrOutput {
-	return i.ToEKSClusterAutoscalerPolicyPtrOutputWithContext(context.Background())
+func (i AccountPasswordPolicyArgs) ToAccountPasswordPolicyPtrOutput() AccountPasswordPolicyPtrOutput {
+	return i.ToAccountPasswordPolicyPtrOutputWithContext(context.Background())
 }
 
-func (i EKSClusterAutoscalerPolicyArgs) ToEKSClusterAutoscalerPolicyPtrOutputWithContext(ctx context.Context) EKSClusterAutoscalerPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSClusterAutoscalerPolicyOutput).ToEKSClusterAutoscalerPolicyPtrOutputWithContext(ctx)
+func (i AccountPasswordPolicyArgs) ToAccountPasswordPolicyPtrOutputWithContext(ctx context.Context) AccountPasswordPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AccountPasswordPolicyOutput).ToAccountPasswordPolicyPtrOutputWithContext(ctx)
 }
 
-// EKSClusterAutoscalerPolicyPtrInput is an input type that accepts EKSClusterAutoscalerPolicyArgs, EKSClusterAutoscalerPolicyPtr and EKSClusterAutoscalerPolicyPtrOutput values.
-// You can construct a concrete instance of `EKSClusterAutoscalerPolicyPtrInput` via:
+// AccountPasswordPolicyPtrInput is an input type that accepts AccountPasswordPolicyArgs, AccountPasswordPolicyPtr and AccountPasswordPolicyPtrOutput values.
+// You can construct a concrete instance of `AccountPasswordPolicyPtrInput` via:
 //
-//	        EKSClusterAutoscalerPolicyArgs{...}
+//	        AccountPasswordPolicyArgs{...}
 //
 //	or:
 //
 //	        nil
-type EKSClusterAutoscalerPolicyPtrInput interface {
+type AccountPasswordPolicyPtrInput interface {
 	pulumi.Input
 
-	ToEKSClusterAutoscalerPolicyPtrOutput() EKSClusterAutoscalerPolicyPtrOutput
-	ToEKSClusterAutoscalerPolicyPtrOutputWithContext(context.Context) EKSClusterAutoscalerPolicyPtrOutput
+	ToAccountPasswordPolicyPtrOutput() AccountPasswordPolicyPtrOutput
+	ToAccountPasswordPolicyPtrOutputWithContext(context.Context) AccountPasswordPolicyPtrOutput
 }
 
-type eksclusterAutoscalerPolicyPtrType EKSClusterAutoscalerPolicyArgs
+type accountPasswordPolicyPtrType AccountPasswordPolicyArgs
 
-func EKSClusterAutoscalerPolicyPtr(v *EKSClusterAutoscalerPolicyArgs) EKSClusterAutoscalerPolicyPtrInput {
-	return (*eksclusterAutoscalerPolicyPtrType)(v)
+func AccountPasswordPolicyPtr(v *AccountPasswordPolicyArgs) AccountPasswordPolicyPtrInput {
+	return (*accountPasswordPolicyPtrType)(v)
 }
 
-func (*eksclusterAutoscalerPolicyPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSClusterAutoscalerPolicy)(nil)).Elem()
+func (*accountPasswordPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**AccountPasswordPolicy)(nil)).Elem()
 }
 
-func (i *eksclusterAutoscalerPolicyPtrType) ToEKSClusterAutoscalerPolicyPtrOutput() EKSClusterAutoscalerPolicyPtrOutput {
-	return i.ToEKSClusterAutoscalerPolicyPtrOutputWithContext(context.Background())
+func (i *accountPasswordPolicyPtrType) ToAccountPasswordPolicyPtrOutput() AccountPasswordPolicyPtrOutput {
+	return i.ToAccountPasswordPolicyPtrOutputWithContext(context.Background())
 }
 
-func (i *eksclusterAutoscalerPolicyPtrType) ToEKSClusterAutoscalerPolicyPtrOutputWithContext(ctx context.Context) EKSClusterAutoscalerPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSClusterAutoscalerPolicyPtrOutput)
+func (i *accountPasswordPolicyPtrType) ToAccountPasswordPolicyPtrOutputWithContext(ctx context.Context) AccountPasswordPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AccountPasswordPolicyPtrOutput)
 }
 
-// The Cluster Autoscaler IAM policy to the role.
-type EKSClusterAutoscalerPolicyOutput struct{ *pulumi.OutputState }
+// Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords.
+type AccountPasswordPolicyOutput struct{ *pulumi.OutputState }
 
-func (EKSClusterAutoscalerPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSClusterAutoscalerPolicy)(nil)).Elem()
+func (AccountPasswordPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AccountPasswordPolicy)(nil)).Elem()
 }
 
-func (o EKSClusterAutoscalerPolicyOutput) ToEKSClusterAutoscalerPolicyOutput() EKSClusterAutoscalerPolicyOutput {
+func (o AccountPasswordPolicyOutput) ToAccountPasswordPolicyOutput() AccountPasswordPolicyOutput {
 	return o
 }
 
-func (o EKSClusterAutoscalerPolicyOutput) ToEKSClusterAutoscalerPolicyOutputWithContext(ctx context.Context) EKSClusterAutoscalerPolicyOutput {
+func (o AccountPasswordPolicyOutput) ToAccountPasswordPolicyOutputWithContext(ctx context.Context) AccountPasswordPolicyOutput {
 	return o
 }
 
-func (o EKSClusterAutoscalerPolicyOutput) ToEKSClusterAutoscalerPolicyPtrOutput() EKSClusterAutoscalerPolicyPtrOutput {
-	return o.ToEKSClusterAutoscalerPolicyPtrOutputWithContext(context.Background())
+func (o AccountPasswordPolicyOutput) ToAccountPasswordPolicyPtrOutput() AccountPasswordPolicyPtrOutput {
+	return o.ToAccountPasswordPolicyPtrOutputWithContext(context.Background())
 }
 
-func (o EKSClusterAutoscalerPolicyOutput) ToEKSClusterAutoscalerPolicyPtrOutputWithContext(ctx context.Context) EKSClusterAutoscalerPolicyPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSClusterAutoscalerPolicy) *EKSClusterAutoscalerPolicy {
+func (o AccountPasswordPolicyOutput) ToAccountPasswordPolicyPtrOutputWithContext(ctx context.Context) AccountPasswordPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v AccountPasswordPolicy) *AccountPasswordPolicy {
 		return &v
-	}).(EKSClusterAutoscalerPolicyPtrOutput)
+	}).(AccountPasswordPolicyPtrOutput)
 }
 
-// Determines whether to attach the Cluster Autoscaler IAM policy to the role.
-func (o EKSClusterAutoscalerPolicyOutput) Attach() pulumi.BoolOutput {
-	return o.ApplyT(func(v EKSClusterAutoscalerPolicy) bool { return v.Attach }).(pulumi.BoolOutput)
+// Whether to allow users to change their own password.
+func (o AccountPasswordPolicyOutput) AllowUsersToChange() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AccountPasswordPolicy) *bool { return v.AllowUsersToChange }).(pulumi.BoolPtrOutput)
 }
 
-// List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
-func (o EKSClusterAutoscalerPolicyOutput) ClusterIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v EKSClusterAutoscalerPolicy) []string { return v.ClusterIds }).(pulumi.StringArrayOutput)
+// Whether users are prevented from setting a new password after their password has
+// expired (i.e. require administrator reset).
+func (o AccountPasswordPolicyOutput) HardExpiry() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AccountPasswordPolicy) *bool { return v.HardExpiry }).(pulumi.BoolPtrOutput)
 }
 
-type EKSClusterAutoscalerPolicyPtrOutput struct{ *pulumi.OutputState }
+// The number of days that an user password is valid. If not set or a value of `0` is provided, then
+// passwords will not expire.
+func (o AccountPasswordPolicyOutput) MaxAge() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v AccountPasswordPolicy) *int { return v.MaxAge }).(pulumi.IntPtrOutput)
+}
 
-func (EKSClusterAutoscalerPolicyPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSClusterAutoscalerPolicy)(nil)).Elem()
+// Minimum length to require for user passwords. Defaults to `8` if not set or
+// the provided value is invalid. Valid values are between 6 and 128.
+func (o AccountPasswordPolicyOutput) MinimumLength() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v AccountPasswordPolicy) *int { return v.MinimumLength }).(pulumi.IntPtrOutput)
 }
 
-func (o EKSClusterAutoscalerPolicyPtrOutput) ToEKSClusterAutoscalerPolicyPtrOutput() EKSClusterAutoscalerPolicyPtrOutput {
+// Whether to require lowercase characters for user passwords.
+func (o AccountPasswordPolicyOutput) RequireLowercaseCharacters() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AccountPasswordPolicy) *bool { return v.RequireLowercaseCharacters }).(pulumi.BoolPtrOutput)
+}
+
+// Whether to require numbers for user passwords.
+func (o AccountPasswordPolicyOutput) RequireNumbers() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AccountPasswordPolicy) *bool { return v.RequireNumbers }).(pulumi.BoolPtrOutput)
+}
+
+// Whether to require symbols for user passwords.
+func (o AccountPasswordPolicyOutput) RequireSymbols() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AccountPasswordPolicy) *bool { return v.RequireSymbols }).(pulumi.BoolPtrOutput)
+}
+
+// Whether to require uppercase characters for user passwords.
+func (o AccountPasswordPolicyOutput) RequireUppercaseCharacters() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AccountPasswordPolicy) *bool { return v.RequireUppercaseCharacters }).(pulumi.BoolPtrOutput)
+}
+
+// The number of previous passwords that users are prevented from reusing. If not set or a
+// value of `0` is provided, no reuse prevention policy will be used.
+func (o AccountPasswordPolicyOutput) ReusePrevention() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v AccountPasswordPolicy) *int { return v.ReusePrevention }).(pulumi.IntPtrOutput)
+}
+
+type AccountPasswordPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (AccountPasswordPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AccountPasswordPolicy)(nil)).Elem()
+}
+
+func (o AccountPasswordPolicyPtrOutput) ToAccountPasswordPolicyPtrOutput() AccountPasswordPolicyPtrOutput {
 	return o
 }
 
-func (o EKSClusterAutoscalerPolicyPtrOutput) ToEKSClusterAutoscalerPolicyPtrOutputWithContext(ctx context.Context) EKSClusterAutoscalerPolicyPtrOutput {
+func (o AccountPasswordPolicyPtrOutput) ToAccountPasswordPolicyPtrOutputWithContext(ctx context.Context) AccountPasswordPolicyPtrOutput {
 	return o
 }
 
-func (o EKSClusterAutoscalerPolicyPtrOutput) Elem() EKSClusterAutoscalerPolicyOutput {
-	return o.ApplyT(func(v *EKSClusterAutoscalerPolicy) EKSClusterAutoscalerPolicy {
+func (o AccountPasswordPolicyPtrOutput) Elem() AccountPasswordPolicyOutput {
+	return o.ApplyT(func(v *AccountPasswordPolicy) AccountPasswordPolicy {
 		if v != nil {
 			return *v
 		}
-		var ret EKSClusterAutoscalerPolicy
+		var ret AccountPasswordPolicy
 		return ret
-	}).(EKSClusterAutoscalerPolicyOutput)
+	}).(AccountPasswordPolicyOutput)
 }
 
-// Determines whether to attach the Cluster Autoscaler IAM policy to the role.
-func (o EKSClusterAutoscalerPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSClusterAutoscalerPolicy) *bool {
+// Whether to allow users to change their own password.
+func (o AccountPasswordPolicyPtrOutput) AllowUsersToChange() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AccountPasswordPolicy) *bool {
 		if v == nil {
 			return nil
 		}
-		return &v.Attach
+		return v.AllowUsersToChange
 	}).(pulumi.BoolPtrOutput)
 }
 
-// List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
-func (o EKSClusterAutoscalerPolicyPtrOutput) ClusterIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *EKSClusterAutoscalerPolicy) []string {
+// Whether users are prevented from setting a new password after their password has
+// expired (i.e. require administrator reset).
+func (o AccountPasswordPolicyPtrOutput) HardExpiry() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AccountPasswordPolicy) *bool {
 		if v == nil {
 			return nil
 		}
-		return v.ClusterIds
-	}).(pulumi.StringArrayOutput)
-}
-
-// The EBS CSI IAM policy to the role.
-type EKSEBSCSIPolicy struct {
-	// Determines whether to attach the EBS CSI IAM policy to the role.
-	Attach bool `pulumi:"attach"`
-	// KMS CMK IDs to allow EBS CSI to manage encrypted volumes.
-	KmsCmkIds []string `pulumi:"kmsCmkIds"`
+		return v.HardExpiry
+	}).(pulumi.BoolPtrOutput)
 }
 
-// EKSEBSCSIPolicyInput is an input type that accepts EKSEBSCSIPolicyArgs and EKSEBSCSIPolicyOutput values.
-// You can construct a concrete instance of `EKSEBSCSIPolicyInput` via:
-//
-//	EKSEBSCSIPolicyArgs{...}
-type EKSEBSCSIPolicyInput interface {
-	pulumi.Input
-
-	ToEKSEBSCSIPolicyOutput() EKSEBSCSIPolicyOutput
-	ToEKSEBSCSIPolicyOutputWithContext(context.Context) EKSEBSCSIPolicyOutput
+// The number of days that an user password is valid. If not set or a value of `0` is provided, then
+// passwords will not expire.
+func (o AccountPasswordPolicyPtrOutput) MaxAge() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *AccountPasswordPolicy) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MaxAge
+	}).(pulumi.IntPtrOutput)
 }
 
-// The EBS CSI IAM policy to the role.
-type EKSEBSCSIPolicyArgs struct {
-	// Determines whether to attach the EBS CSI IAM policy to the role.
-	Attach pulumi.BoolInput `pulumi:"attach"`
-	// KMS CMK IDs to allow EBS CSI to manage encrypted volumes.
-	KmsCmkIds pulumi.StringArrayInput `pulumi:"kmsCmkIds"`
+// Minimum length to require for user passwords. Defaults to `8` if not set or
+// the provided value is invalid. Valid values are between 6 and 128.
+func (o AccountPasswordPolicyPtrOutput) MinimumLength() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *AccountPasswordPolicy) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MinimumLength
+	}).(pulumi.IntPtrOutput)
 }
 
-func (EKSEBSCSIPolicyArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSEBSCSIPolicy)(nil)).Elem()
+// Whether to require lowercase characters for user passwords.
+func (o AccountPasswordPolicyPtrOutput) RequireLowercaseCharacters() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AccountPasswordPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.RequireLowercaseCharacters
+	}).(pulumi.BoolPtrOutput)
 }
 
-func (i EKSEBSCSIPolicyArgs) ToEKSEBSCSIPolicyOutput() EKSEBSCSIPolicyOutput {
-	return i.ToEKSEBSCSIPolicyOutputWithContext(context.Background())
+// Whether to require numbers for user passwords.
+func (o AccountPasswordPolicyPtrOutput) RequireNumbers() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AccountPasswordPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.RequireNumbers
+	}).(pulumi.BoolPtrOutput)
 }
 
-func (i EKSEBSCSIPolicyArgs) ToEKSEBSCSIPolicyOutputWithContext(ctx context.Context) EKSEBSCSIPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSEBSCSIPolicyOutput)
+// Whether to require symbols for user passwords.
+func (o AccountPasswordPolicyPtrOutput) RequireSymbols() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AccountPasswordPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.RequireSymbols
+	}).(pulumi.BoolPtrOutput)
 }
 
-func (i EKSEBSCSIPolicyArgs) ToEKSEBSCSIPolicyPtrOutput() EKSEBSCSIPolicyPtrOutput {
-	return i.ToEKSEBSCSIPolicyPtrOutputWithContext(context.Background())
+// Whether to require uppercase characters for user passwords.
+func (o AccountPasswordPolicyPtrOutput) RequireUppercaseCharacters() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AccountPasswordPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.RequireUppercaseCharacters
+	}).(pulumi.BoolPtrOutput)
 }
 
-func (i EKSEBSCSIPolicyArgs) ToEKSEBSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEBSCSIPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSEBSCSIPolicyOutput).ToEKSEBSCSIPolicyPtrOutputWithContext(ctx)
+// The number of previous passwords that users are prevented from reusing. If not set or a
+// value of `0` is provided, no reuse prevention policy will be used.
+func (o AccountPasswordPolicyPtrOutput) ReusePrevention() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *AccountPasswordPolicy) *int {
+		if v == nil {
+			return nil
+		}
+		return v.ReusePrevention
+	}).(pulumi.IntPtrOutput)
 }
 
-// EKSEBSCSIPolicyPtrInput is an input type that accepts EKSEBSCSIPolicyArgs, EKSEBSCSIPolicyPtr and EKSEBSCSIPolicyPtrOutput values.
-// You can construct a concrete instance of `EKSEBSCSIPolicyPtrInput` via:
+// The admin role.
+type AdminRole struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies *bool `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments *bool `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies map[string]string `pulumi:"inlinePolicies"`
+	// IAM role with admin access.
+	Name *string `pulumi:"name"`
+	// Path of admin IAM role. Defaults to '/'
+	Path *string `pulumi:"path"`
+	// Permissions boundary ARN to use for admin role.
+	PermissionsBoundaryArn *string `pulumi:"permissionsBoundaryArn"`
+	// List of policy ARNs to use for admin role.
+	PolicyArns []string `pulumi:"policyArns"`
+	// A map of tags to add.
+	Tags map[string]string `pulumi:"tags"`
+}
+
+// Defaults sets the appropriate defaults for AdminRole
+func (val *AdminRole) Defaults() *AdminRole {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		exclusiveInlinePolicies_ := false
+		tmp.ExclusiveInlinePolicies = &exclusiveInlinePolicies_
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		exclusivePolicyAttachments_ := false
+		tmp.ExclusivePolicyAttachments = &exclusivePolicyAttachments_
+	}
+	if tmp.Name == nil {
+		name_ := "admin"
+		tmp.Name = &name_
+	}
+	return &tmp
+}
+
+// AdminRoleInput is an input type that accepts AdminRoleArgs and AdminRoleOutput values.
+// You can construct a concrete instance of `AdminRoleInput` via:
 //
-//	        EKSEBSCSIPolicyArgs{...}
+//	AdminRoleArgs{...}
+type AdminRoleInput interface {
+	pulumi.Input
+
+	ToAdminRoleOutput() AdminRoleOutput
+	ToAdminRoleOutputWithContext(context.Context) AdminRoleOutput
+}
+
+// The admin role.
+type AdminRoleArgs struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies pulumi.BoolPtrInput `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments pulumi.BoolPtrInput `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies pulumi.StringMapInput `pulumi:"inlinePolicies"`
+	// IAM role with admin access.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// Path of admin IAM role. Defaults to '/'
+	Path pulumi.StringPtrInput `pulumi:"path"`
+	// Permissions boundary ARN to use for admin role.
+	PermissionsBoundaryArn pulumi.StringPtrInput `pulumi:"permissionsBoundaryArn"`
+	// List of policy ARNs to use for admin role.
+	PolicyArns pulumi.StringArrayInput `pulumi:"policyArns"`
+	// A map of tags to add.
+	Tags pulumi.StringMapInput `pulumi:"tags"`
+}
+
+// Defaults sets the appropriate defaults for AdminRoleArgs
+func (val *AdminRoleArgs) Defaults() *AdminRoleArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		tmp.ExclusiveInlinePolicies = pulumi.BoolPtr(false)
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		tmp.ExclusivePolicyAttachments = pulumi.BoolPtr(false)
+	}
+	if tmp.Name == nil {
+		tmp.Name = pulumi.StringPtr("admin")
+	}
+	return &tmp
+}
+func (AdminRoleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AdminRole)(nil)).Elem()
+}
+
+func (i AdminRoleArgs) ToAdminRoleOutput() AdminRoleOutput {
+	return i.ToAdminRoleOutputWithContext(context.Background())
+}
+
+func (i AdminRoleArgs) ToAdminRoleOutputWithContext(ctx context.Context) AdminRoleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AdminRoleOutput)
+}
+
+func (i AdminRoleArgs) ToAdminRolePtrOutput() AdminRolePtrOutput {
+	return i.ToAdminRolePtrOutputWithContext(context.Background())
+}
+
+func (i AdminRoleArgs) ToAdminRolePtrOutputWithContext(ctx context.Context) AdminRolePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AdminRoleOutput).ToAdminRolePtrOutputWithContext(ctx)
+}
+
+// AdminRolePtrInput is an input type that accepts AdminRoleArgs, AdminRolePtr and AdminRolePtrOutput values.
+// You can construct a concrete instance of `AdminRolePtrInput` via:
+//
+//	        AdminRoleArgs{...}
 //
 //	or:
 //
 //	        nil
-type EKSEBSCSIPolicyPtrInput interface {
+type AdminRolePtrInput interface {
 	pulumi.Input
 
-	ToEKSEBSCSIPolicyPtrOutput() EKSEBSCSIPolicyPtrOutput
-	ToEKSEBSCSIPolicyPtrOutputWithContext(context.Context) EKSEBSCSIPolicyPtrOutput
+	ToAdminRolePtrOutput() AdminRolePtrOutput
+	ToAdminRolePtrOutputWithContext(context.Context) AdminRolePtrOutput
 }
 
-type eksebscsipolicyPtrType EKSEBSCSIPolicyArgs
+type adminRolePtrType AdminRoleArgs
 
-func EKSEBSCSIPolicyPtr(v *EKSEBSCSIPolicyArgs) EKSEBSCSIPolicyPtrInput {
-	return (*eksebscsipolicyPtrType)(v)
+func AdminRolePtr(v *AdminRoleArgs) AdminRolePtrInput {
+	return (*adminRolePtrType)(v)
 }
 
-func (*eksebscsipolicyPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSEBSCSIPolicy)(nil)).Elem()
+func (*adminRolePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**AdminRole)(nil)).Elem()
 }
 
-func (i *eksebscsipolicyPtrType) ToEKSEBSCSIPolicyPtrOutput() EKSEBSCSIPolicyPtrOutput {
-	return i.ToEKSEBSCSIPolicyPtrOutputWithContext(context.Background())
+func (i *adminRolePtrType) ToAdminRolePtrOutput() AdminRolePtrOutput {
+	return i.ToAdminRolePtrOutputWithContext(context.Background())
 }
 
-func (i *eksebscsipolicyPtrType) ToEKSEBSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEBSCSIPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSEBSCSIPolicyPtrOutput)
+func (i *adminRolePtrType) ToAdminRolePtrOutputWithContext(ctx context.Context) AdminRolePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AdminRolePtrOutput)
 }
 
-// The EBS CSI IAM policy to the role.
-type EKSEBSCSIPolicyOutput struct{ *pulumi.OutputState }
+// The admin role.
+type AdminRoleOutput struct{ *pulumi.OutputState }
 
-func (EKSEBSCSIPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSEBSCSIPolicy)(nil)).Elem()
+func (AdminRoleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AdminRole)(nil)).Elem()
 }
 
-func (o EKSEBSCSIPolicyOutput) ToEKSEBSCSIPolicyOutput() EKSEBSCSIPolicyOutput {
+func (o AdminRoleOutput) ToAdminRoleOutput() AdminRoleOutput {
 	return o
 }
 
-func (o EKSEBSCSIPolicyOutput) ToEKSEBSCSIPolicyOutputWithContext(ctx context.Context) EKSEBSCSIPolicyOutput {
+func (o AdminRoleOutput) ToAdminRoleOutputWithContext(ctx context.Context) AdminRoleOutput {
 	return o
 }
 
-func (o EKSEBSCSIPolicyOutput) ToEKSEBSCSIPolicyPtrOutput() EKSEBSCSIPolicyPtrOutput {
-	return o.ToEKSEBSCSIPolicyPtrOutputWithContext(context.Background())
+func (o AdminRoleOutput) ToAdminRolePtrOutput() AdminRolePtrOutput {
+	return o.ToAdminRolePtrOutputWithContext(context.Background())
 }
 
-func (o EKSEBSCSIPolicyOutput) ToEKSEBSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEBSCSIPolicyPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSEBSCSIPolicy) *EKSEBSCSIPolicy {
+func (o AdminRoleOutput) ToAdminRolePtrOutputWithContext(ctx context.Context) AdminRolePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v AdminRole) *AdminRole {
 		return &v
-	}).(EKSEBSCSIPolicyPtrOutput)
+	}).(AdminRolePtrOutput)
 }
 
-// Determines whether to attach the EBS CSI IAM policy to the role.
-func (o EKSEBSCSIPolicyOutput) Attach() pulumi.BoolOutput {
-	return o.ApplyT(func(v EKSEBSCSIPolicy) bool { return v.Attach }).(pulumi.BoolOutput)
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o AdminRoleOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AdminRole) *bool { return v.ExclusiveInlinePolicies }).(pulumi.BoolPtrOutput)
 }
 
-// KMS CMK IDs to allow EBS CSI to manage encrypted volumes.
-func (o EKSEBSCSIPolicyOutput) KmsCmkIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v EKSEBSCSIPolicy) []string { return v.KmsCmkIds }).(pulumi.StringArrayOutput)
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o AdminRoleOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AdminRole) *bool { return v.ExclusivePolicyAttachments }).(pulumi.BoolPtrOutput)
 }
 
-type EKSEBSCSIPolicyPtrOutput struct{ *pulumi.OutputState }
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o AdminRoleOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v AdminRole) map[string]string { return v.InlinePolicies }).(pulumi.StringMapOutput)
+}
 
-func (EKSEBSCSIPolicyPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSEBSCSIPolicy)(nil)).Elem()
+// IAM role with admin access.
+func (o AdminRoleOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AdminRole) *string { return v.Name }).(pulumi.StringPtrOutput)
 }
 
-func (o EKSEBSCSIPolicyPtrOutput) ToEKSEBSCSIPolicyPtrOutput() EKSEBSCSIPolicyPtrOutput {
+// Path of admin IAM role. Defaults to '/'
+func (o AdminRoleOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AdminRole) *string { return v.Path }).(pulumi.StringPtrOutput)
+}
+
+// Permissions boundary ARN to use for admin role.
+func (o AdminRoleOutput) PermissionsBoundaryArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AdminRole) *string { return v.PermissionsBoundaryArn }).(pulumi.StringPtrOutput)
+}
+
+// List of policy ARNs to use for admin role.
+func (o AdminRoleOutput) PolicyArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AdminRole) []string { return v.PolicyArns }).(pulumi.StringArrayOutput)
+}
+
+// A map of tags to add.
+func (o AdminRoleOutput) Tags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v AdminRole) map[string]string { return v.Tags }).(pulumi.StringMapOutput)
+}
+
+type AdminRolePtrOutput struct{ *pulumi.OutputState }
+
+func (AdminRolePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AdminRole)(nil)).Elem()
+}
+
+func (o AdminRolePtrOutput) ToAdminRolePtrOutput() AdminRolePtrOutput {
 	return o
 }
 
-func (o EKSEBSCSIPolicyPtrOutput) ToEKSEBSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEBSCSIPolicyPtrOutput {
+func (o AdminRolePtrOutput) ToAdminRolePtrOutputWithContext(ctx context.Context) AdminRolePtrOutput {
 	return o
 }
 
-func (o EKSEBSCSIPolicyPtrOutput) Elem() EKSEBSCSIPolicyOutput {
-	return o.ApplyT(func(v *EKSEBSCSIPolicy) EKSEBSCSIPolicy {
+func (o AdminRolePtrOutput) Elem() AdminRoleOutput {
+	return o.ApplyT(func(v *AdminRole) AdminRole {
 		if v != nil {
 			return *v
 		}
-		var ret EKSEBSCSIPolicy
+		var ret AdminRole
 		return ret
-	}).(EKSEBSCSIPolicyOutput)
+	}).(AdminRoleOutput)
 }
 
-// Determines whether to attach the EBS CSI IAM policy to the role.
-func (o EKSEBSCSIPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSEBSCSIPolicy) *bool {
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o AdminRolePtrOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AdminRole) *bool {
 		if v == nil {
 			return nil
 		}
-		return &v.Attach
+		return v.ExclusiveInlinePolicies
 	}).(pulumi.BoolPtrOutput)
 }
 
-// KMS CMK IDs to allow EBS CSI to manage encrypted volumes.
-func (o EKSEBSCSIPolicyPtrOutput) KmsCmkIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *EKSEBSCSIPolicy) []string {
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o AdminRolePtrOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AdminRole) *bool {
 		if v == nil {
 			return nil
 		}
-		return v.KmsCmkIds
-	}).(pulumi.StringArrayOutput)
-}
-
-// The EFS CSI IAM policy to the role.
-type EKSEFSCSIPolicy struct {
-	// Determines whether to attach the EFS CSI IAM policy to the role.
-	Attach bool `pulumi:"attach"`
+		return v.ExclusivePolicyAttachments
+	}).(pulumi.BoolPtrOutput)
 }
 
-// EKSEFSCSIPolicyInput is an input type that accepts EKSEFSCSIPolicyArgs and EKSEFSCSIPolicyOutput values.
-// You can construct a concrete instance of `EKSEFSCSIPolicyInput` via:
-//
-//	EKSEFSCSIPolicyArgs{...}
-type EKSEFSCSIPolicyInput interface {
-	pulumi.Input
-
-	ToEKSEFSCSIPolicyOutput() EKSEFSCSIPolicyOutput
-	ToEKSEFSCSIPolicyOutputWithContext(context.Context) EKSEFSCSIPolicyOutput
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o AdminRolePtrOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *AdminRole) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.InlinePolicies
+	}).(pulumi.StringMapOutput)
 }
 
-// The EFS CSI IAM policy to the role.
-type EKSEFSCSIPolicyArgs struct {
-	// Determines whether to attach the EFS CSI IAM policy to the role.
-	Attach pulumi.BoolInput `pulumi:"attach"`
+// IAM role with admin access.
+func (o AdminRolePtrOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AdminRole) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Name
+	}).(pulumi.StringPtrOutput)
 }
 
-func (EKSEFSCSIPolicyArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSEFSCSIPolicy)(nil)).Elem()
+// Path of admin IAM role. Defaults to '/'
+func (o AdminRolePtrOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AdminRole) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Path
+	}).(pulumi.StringPtrOutput)
 }
 
-func (i EKSEFSCSIPolicyArgs) ToEKSEFSCSIPolicyOutput() EKSEFSCSIPolicyOutput {
-	return i.ToEKSEFSCSIPolicyOutputWithContext(context.Background())
+// Permissions boundary ARN to use for admin role.
+func (o AdminRolePtrOutput) PermissionsBoundaryArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AdminRole) *string {
+		if v == nil {
+			return nil
+		}
+		return v.PermissionsBoundaryArn
+	}).(pulumi.StringPtrOutput)
 }
 
-func (i EKSEFSCSIPolicyArgs) ToEKSEFSCSIPolicyOutputWithContext(ctx context.Context) EKSEFSCSIPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSEFSCSIPolicyOutput)
+// List of policy ARNs to use for admin role.
+func (o AdminRolePtrOutput) PolicyArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *AdminRole) []string {
+		if v == nil {
+			return nil
+		}
+		return v.PolicyArns
+	}).(pulumi.StringArrayOutput)
 }
 
-func (i EKSEFSCSIPolicyArgs) ToEKSEFSCSIPolicyPtrOutput() EKSEFSCSIPolicyPtrOutput {
-	return i.ToEKSEFSCSIPolicyPtrOutputWithContext(context.Background())
+// A map of tags to add.
+func (o AdminRolePtrOutput) Tags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *AdminRole) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Tags
+	}).(pulumi.StringMapOutput)
 }
 
-func (i EKSEFSCSIPolicyArgs) ToEKSEFSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEFSCSIPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSEFSCSIPolicyOutput).ToEKSEFSCSIPolicyPtrOutputWithContext(ctx)
+// The admin role.
+type AdminRoleWithMFA struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies *bool `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments *bool `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies map[string]string `pulumi:"inlinePolicies"`
+	// IAM role with admin access.
+	Name *string `pulumi:"name"`
+	// Path of admin IAM role.
+	Path *string `pulumi:"path"`
+	// Permissions boundary ARN to use for admin role.
+	PermissionsBoundaryArn *string `pulumi:"permissionsBoundaryArn"`
+	// List of policy ARNs to use for admin role.
+	PolicyArns []string `pulumi:"policyArns"`
+	// Whether admin role requires MFA.
+	RequiresMfa *bool `pulumi:"requiresMfa"`
+	// A map of tags to add.
+	Tags map[string]string `pulumi:"tags"`
 }
 
-// EKSEFSCSIPolicyPtrInput is an input type that accepts EKSEFSCSIPolicyArgs, EKSEFSCSIPolicyPtr and EKSEFSCSIPolicyPtrOutput values.
-// You can construct a concrete instance of `EKSEFSCSIPolicyPtrInput` via:
-//
-//	        EKSEFSCSIPolicyArgs{...}
-//
-//	or:
+// Defaults sets the appropriate defaults for AdminRoleWithMFA
+func (val *AdminRoleWithMFA) Defaults() *AdminRoleWithMFA {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		exclusiveInlinePolicies_ := false
+		tmp.ExclusiveInlinePolicies = &exclusiveInlinePolicies_
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		exclusivePolicyAttachments_ := false
+		tmp.ExclusivePolicyAttachments = &exclusivePolicyAttachments_
+	}
+	return &tmp
+}
+
+// AdminRoleWithMFAInput is an input type that accepts AdminRoleWithMFAArgs and AdminRoleWithMFAOutput values.
+// You can construct a concrete instance of `AdminRoleWithMFAInput` via:
 //
-//	        nil
-type EKSEFSCSIPolicyPtrInput interface {
+//	AdminRoleWithMFAArgs{...}
+type AdminRoleWithMFAInput interface {
 	pulumi.Input
 
-	ToEKSEFSCSIPolicyPtrOutput() EKSEFSCSIPolicyPtrOutput
-	ToEKSEFSCSIPolicyPtrOutputWithContext(context.Context) EKSEFSCSIPolicyPtrOutput
+	ToAdminRoleWithMFAOutput() AdminRoleWithMFAOutput
+	ToAdminRoleWithMFAOutputWithContext(context.Context) AdminRoleWithMFAOutput
 }
 
-type eksefscsipolicyPtrType EKSEFSCSIPolicyArgs
-
-func EKSEFSCSIPolicyPtr(v *EKSEFSCSIPolicyArgs) EKSEFSCSIPolicyPtrInput {
-	return (*eksefscsipolicyPtrType)(v)
+// The admin role.
+type AdminRoleWithMFAArgs struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies pulumi.BoolPtrInput `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments pulumi.BoolPtrInput `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies pulumi.StringMapInput `pulumi:"inlinePolicies"`
+	// IAM role with admin access.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// Path of admin IAM role.
+	Path pulumi.StringPtrInput `pulumi:"path"`
+	// Permissions boundary ARN to use for admin role.
+	PermissionsBoundaryArn pulumi.StringPtrInput `pulumi:"permissionsBoundaryArn"`
+	// List of policy ARNs to use for admin role.
+	PolicyArns pulumi.StringArrayInput `pulumi:"policyArns"`
+	// Whether admin role requires MFA.
+	RequiresMfa pulumi.BoolPtrInput `pulumi:"requiresMfa"`
+	// A map of tags to add.
+	Tags pulumi.StringMapInput `pulumi:"tags"`
 }
 
-func (*eksefscsipolicyPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSEFSCSIPolicy)(nil)).Elem()
+// Defaults sets the appropriate defaults for AdminRoleWithMFAArgs
+func (val *AdminRoleWithMFAArgs) Defaults() *AdminRoleWithMFAArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		tmp.ExclusiveInlinePolicies = pulumi.BoolPtr(false)
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		tmp.ExclusivePolicyAttachments = pulumi.BoolPtr(false)
+	}
+	return &tmp
+}
+func (AdminRoleWithMFAArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AdminRoleWithMFA)(nil)).Elem()
 }
 
-func (i *eksefscsipolicyPtrType) ToEKSEFSCSIPolicyPtrOutput() EKSEFSCSIPolicyPtrOutput {
-	return i.ToEKSEFSCSIPolicyPtrOutputWithContext(context.Background())
+func (i AdminRoleWithMFAArgs) ToAdminRoleWithMFAOutput() AdminRoleWithMFAOutput {
+	return i.ToAdminRoleWithMFAOutputWithContext(context.Background())
 }
 
-func (i *eksefscsipolicyPtrType) ToEKSEFSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEFSCSIPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSEFSCSIPolicyPtrOutput)
+func (i AdminRoleWithMFAArgs) ToAdminRoleWithMFAOutputWithContext(ctx context.Context) AdminRoleWithMFAOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AdminRoleWithMFAOutput)
 }
 
-// The EFS CSI IAM policy to the role.
-type EKSEFSCSIPolicyOutput struct{ *pulumi.OutputState }
+// The admin role.
+type AdminRoleWithMFAOutput struct{ *pulumi.OutputState }
 
-func (EKSEFSCSIPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSEFSCSIPolicy)(nil)).Elem()
+func (AdminRoleWithMFAOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AdminRoleWithMFA)(nil)).Elem()
 }
 
-func (o EKSEFSCSIPolicyOutput) ToEKSEFSCSIPolicyOutput() EKSEFSCSIPolicyOutput {
+func (o AdminRoleWithMFAOutput) ToAdminRoleWithMFAOutput() AdminRoleWithMFAOutput {
 	return o
 }
 
-func (o EKSEFSCSIPolicyOutput) ToEKSEFSCSIPolicyOutputWithContext(ctx context.Context) EKSEFSCSIPolicyOutput {
+func (o AdminRoleWithMFAOutput) ToAdminRoleWithMFAOutputWithContext(ctx context.Context) AdminRoleWithMFAOutput {
 	return o
 }
 
-func (o EKSEFSCSIPolicyOutput) ToEKSEFSCSIPolicyPtrOutput() EKSEFSCSIPolicyPtrOutput {
-	return o.ToEKSEFSCSIPolicyPtrOutputWithContext(context.Background())
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o AdminRoleWithMFAOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AdminRoleWithMFA) *bool { return v.ExclusiveInlinePolicies }).(pulumi.BoolPtrOutput)
 }
 
-func (o EKSEFSCSIPolicyOutput) ToEKSEFSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEFSCSIPolicyPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSEFSCSIPolicy) *EKSEFSCSIPolicy {
-		return &v
-	}).(EKSEFSCSIPolicyPtrOutput)
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o AdminRoleWithMFAOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AdminRoleWithMFA) *bool { return v.ExclusivePolicyAttachments }).(pulumi.BoolPtrOutput)
 }
 
-// Determines whether to attach the EFS CSI IAM policy to the role.
-func (o EKSEFSCSIPolicyOutput) Attach() pulumi.BoolOutput {
-	return o.ApplyT(func(v EKSEFSCSIPolicy) bool { return v.Attach }).(pulumi.BoolOutput)
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o AdminRoleWithMFAOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v AdminRoleWithMFA) map[string]string { return v.InlinePolicies }).(pulumi.StringMapOutput)
 }
 
-type EKSEFSCSIPolicyPtrOutput struct{ *pulumi.OutputState }
+// IAM role with admin access.
+func (o AdminRoleWithMFAOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AdminRoleWithMFA) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
 
-func (EKSEFSCSIPolicyPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSEFSCSIPolicy)(nil)).Elem()
+// Path of admin IAM role.
+func (o AdminRoleWithMFAOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AdminRoleWithMFA) *string { return v.Path }).(pulumi.StringPtrOutput)
 }
 
-func (o EKSEFSCSIPolicyPtrOutput) ToEKSEFSCSIPolicyPtrOutput() EKSEFSCSIPolicyPtrOutput {
-	return o
+// Permissions boundary ARN to use for admin role.
+func (o AdminRoleWithMFAOutput) PermissionsBoundaryArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AdminRoleWithMFA) *string { return v.PermissionsBoundaryArn }).(pulumi.StringPtrOutput)
 }
 
-func (o EKSEFSCSIPolicyPtrOutput) ToEKSEFSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEFSCSIPolicyPtrOutput {
-	return o
+// List of policy ARNs to use for admin role.
+func (o AdminRoleWithMFAOutput) PolicyArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AdminRoleWithMFA) []string { return v.PolicyArns }).(pulumi.StringArrayOutput)
 }
 
-func (o EKSEFSCSIPolicyPtrOutput) Elem() EKSEFSCSIPolicyOutput {
-	return o.ApplyT(func(v *EKSEFSCSIPolicy) EKSEFSCSIPolicy {
-		if v != nil {
-			return *v
-		}
-		var ret EKSEFSCSIPolicy
-		return ret
-	}).(EKSEFSCSIPolicyOutput)
+// Whether admin role requires MFA.
+func (o AdminRoleWithMFAOutput) RequiresMfa() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AdminRoleWithMFA) *bool { return v.RequiresMfa }).(pulumi.BoolPtrOutput)
 }
 
-// Determines whether to attach the EFS CSI IAM policy to the role.
-func (o EKSEFSCSIPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSEFSCSIPolicy) *bool {
-		if v == nil {
-			return nil
-		}
-		return &v.Attach
-	}).(pulumi.BoolPtrOutput)
+// A map of tags to add.
+func (o AdminRoleWithMFAOutput) Tags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v AdminRoleWithMFA) map[string]string { return v.Tags }).(pulumi.StringMapOutput)
 }
 
-// The External DNS IAM policy to the role.
-type EKSExternalDNSPolicy struct {
-	// Determines whether to attach the External DNS IAM policy to the role.
-	Attach bool `pulumi:"attach"`
-	// Route53 hosted zone ARNs to allow External DNS to manage records. If not provided,
-	// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
-	HostedZoneArns []string `pulumi:"hostedZoneArns"`
+type AssumableRoleWithOIDCProvider struct {
+	// The AWS account ID where the OIDC provider lives, defaults to `awsAccountId`.
+	AwsAccountId *string `pulumi:"awsAccountId"`
+	// The audiences of this provider, replacing `oidcFullyQualifiedAudiences` when set.
+	OidcFullyQualifiedAudiences []string `pulumi:"oidcFullyQualifiedAudiences"`
+	// The fully qualified OIDC subjects of this provider, replacing `oidcFullyQualifiedSubjects` when set.
+	OidcFullyQualifiedSubjects []string `pulumi:"oidcFullyQualifiedSubjects"`
+	// The OIDC subjects using wildcards of this provider, replacing `oidcSubjectsWithWildcards` when set.
+	OidcSubjectsWithWildcards []string `pulumi:"oidcSubjectsWithWildcards"`
+	// URL of the OIDC Provider.
+	Url string `pulumi:"url"`
 }
 
-// EKSExternalDNSPolicyInput is an input type that accepts EKSExternalDNSPolicyArgs and EKSExternalDNSPolicyOutput values.
-// You can construct a concrete instance of `EKSExternalDNSPolicyInput` via:
+// AssumableRoleWithOIDCProviderInput is an input type that accepts AssumableRoleWithOIDCProviderArgs and AssumableRoleWithOIDCProviderOutput values.
+// You can construct a concrete instance of `AssumableRoleWithOIDCProviderInput` via:
 //
-//	EKSExternalDNSPolicyArgs{...}
-type EKSExternalDNSPolicyInput interface {
+//	AssumableRoleWithOIDCProviderArgs{...}
+type AssumableRoleWithOIDCProviderInput interface {
 	pulumi.Input
 
-	ToEKSExternalDNSPolicyOutput() EKSExternalDNSPolicyOutput
-	ToEKSExternalDNSPolicyOutputWithContext(context.Context) EKSExternalDNSPolicyOutput
-}
-
-// The External DNS IAM policy to the role.
-type EKSExternalDNSPolicyArgs struct {
-	// Determines whether to attach the External DNS IAM policy to the role.
-	Attach pulumi.BoolInput `pulumi:"attach"`
-	// Route53 hosted zone ARNs to allow External DNS to manage records. If not provided,
-	// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
-	HostedZoneArns pulumi.StringArrayInput `pulumi:"hostedZoneArns"`
-}
-
-func (EKSExternalDNSPolicyArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSExternalDNSPolicy)(nil)).Elem()
+	ToAssumableRoleWithOIDCProviderOutput() AssumableRoleWithOIDCProviderOutput
+	ToAssumableRoleWithOIDCProviderOutputWithContext(context.Context) AssumableRoleWithOIDCProviderOutput
 }
 
-func (i EKSExternalDNSPolicyArgs) ToEKSExternalDNSPolicyOutput() EKSExternalDNSPolicyOutput {
-	return i.ToEKSExternalDNSPolicyOutputWithContext(context.Background())
+type AssumableRoleWithOIDCProviderArgs struct {
+	// The AWS account ID where the OIDC provider lives, defaults to `awsAccountId`.
+	AwsAccountId pulumi.StringPtrInput `pulumi:"awsAccountId"`
+	// The audiences of this provider, replacing `oidcFullyQualifiedAudiences` when set.
+	OidcFullyQualifiedAudiences pulumi.StringArrayInput `pulumi:"oidcFullyQualifiedAudiences"`
+	// The fully qualified OIDC subjects of this provider, replacing `oidcFullyQualifiedSubjects` when set.
+	OidcFullyQualifiedSubjects pulumi.StringArrayInput `pulumi:"oidcFullyQualifiedSubjects"`
+	// The OIDC subjects using wildcards of this provider, replacing `oidcSubjectsWithWildcards` when set.
+	OidcSubjectsWithWildcards pulumi.StringArrayInput `pulumi:"oidcSubjectsWithWildcards"`
+	// URL of the OIDC Provider.
+	Url pulumi.StringInput `pulumi:"url"`
 }
 
-func (i EKSExternalDNSPolicyArgs) ToEKSExternalDNSPolicyOutputWithContext(ctx context.Context) EKSExternalDNSPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSExternalDNSPolicyOutput)
+func (AssumableRoleWithOIDCProviderArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AssumableRoleWithOIDCProvider)(nil)).Elem()
 }
 
-func (i EKSExternalDNSPolicyArgs) ToEKSExternalDNSPolicyPtrOutput() EKSExternalDNSPolicyPtrOutput {
-	return i.ToEKSExternalDNSPolicyPtrOutputWithContext(context.Background())
+func (i AssumableRoleWithOIDCProviderArgs) ToAssumableRoleWithOIDCProviderOutput() AssumableRoleWithOIDCProviderOutput {
+	return i.ToAssumableRoleWithOIDCProviderOutputWithContext(context.Background())
 }
 
-func (i EKSExternalDNSPolicyArgs) ToEKSExternalDNSPolicyPtrOutputWithContext(ctx context.Context) EKSExternalDNSPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSExternalDNSPolicyOutput).ToEKSExternalDNSPolicyPtrOutputWithContext(ctx)
+func (i AssumableRoleWithOIDCProviderArgs) ToAssumableRoleWithOIDCProviderOutputWithContext(ctx context.Context) AssumableRoleWithOIDCProviderOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssumableRoleWithOIDCProviderOutput)
 }
 
-// EKSExternalDNSPolicyPtrInput is an input type that accepts EKSExternalDNSPolicyArgs, EKSExternalDNSPolicyPtr and EKSExternalDNSPolicyPtrOutput values.
-// You can construct a concrete instance of `EKSExternalDNSPolicyPtrInput` via:
-//
-//	        EKSExternalDNSPolicyArgs{...}
-//
-//	or:
+// AssumableRoleWithOIDCProviderArrayInput is an input type that accepts AssumableRoleWithOIDCProviderArray and AssumableRoleWithOIDCProviderArrayOutput values.
+// You can construct a concrete instance of `AssumableRoleWithOIDCProviderArrayInput` via:
 //
-//	        nil
-type EKSExternalDNSPolicyPtrInput interface {
+//	AssumableRoleWithOIDCProviderArray{ AssumableRoleWithOIDCProviderArgs{...} }
+type AssumableRoleWithOIDCProviderArrayInput interface {
 	pulumi.Input
 
-	ToEKSExternalDNSPolicyPtrOutput() EKSExternalDNSPolicyPtrOutput
-	ToEKSExternalDNSPolicyPtrOutputWithContext(context.Context) EKSExternalDNSPolicyPtrOutput
+	ToAssumableRoleWithOIDCProviderArrayOutput() AssumableRoleWithOIDCProviderArrayOutput
+	ToAssumableRoleWithOIDCProviderArrayOutputWithContext(context.Context) AssumableRoleWithOIDCProviderArrayOutput
 }
 
-type eksexternalDNSPolicyPtrType EKSExternalDNSPolicyArgs
-
-func EKSExternalDNSPolicyPtr(v *EKSExternalDNSPolicyArgs) EKSExternalDNSPolicyPtrInput {
-	return (*eksexternalDNSPolicyPtrType)(v)
-}
+type AssumableRoleWithOIDCProviderArray []AssumableRoleWithOIDCProviderInput
 
-func (*eksexternalDNSPolicyPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSExternalDNSPolicy)(nil)).Elem()
+func (AssumableRoleWithOIDCProviderArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AssumableRoleWithOIDCProvider)(nil)).Elem()
 }
 
-func (i *eksexternalDNSPolicyPtrType) ToEKSExternalDNSPolicyPtrOutput() EKSExternalDNSPolicyPtrOutput {
-	return i.ToEKSExternalDNSPolicyPtrOutputWithContext(context.Background())
+func (i AssumableRoleWithOIDCProviderArray) ToAssumableRoleWithOIDCProviderArrayOutput() AssumableRoleWithOIDCProviderArrayOutput {
+	return i.ToAssumableRoleWithOIDCProviderArrayOutputWithContext(context.Background())
 }
 
-func (i *eksexternalDNSPolicyPtrType) ToEKSExternalDNSPolicyPtrOutputWithContext(ctx context.Context) EKSExternalDNSPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSExternalDNSPolicyPtrOutput)
+func (i AssumableRoleWithOIDCProviderArray) ToAssumableRoleWithOIDCProviderArrayOutputWithContext(ctx context.Context) AssumableRoleWithOIDCProviderArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssumableRoleWithOIDCProviderArrayOutput)
 }
 
-// The External DNS IAM policy to the role.
-type EKSExternalDNSPolicyOutput struct{ *pulumi.OutputState }
+type AssumableRoleWithOIDCProviderOutput struct{ *pulumi.OutputState }
 
-func (EKSExternalDNSPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSExternalDNSPolicy)(nil)).Elem()
+func (AssumableRoleWithOIDCProviderOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AssumableRoleWithOIDCProvider)(nil)).Elem()
 }
 
-func (o EKSExternalDNSPolicyOutput) ToEKSExternalDNSPolicyOutput() EKSExternalDNSPolicyOutput {
+func (o AssumableRoleWithOIDCProviderOutput) ToAssumableRoleWithOIDCProviderOutput() AssumableRoleWithOIDCProviderOutput {
 	return o
 }
 
-func (o EKSExternalDNSPolicyOutput) ToEKSExternalDNSPolicyOutputWithContext(ctx context.Context) EKSExternalDNSPolicyOutput {
+func (o AssumableRoleWithOIDCProviderOutput) ToAssumableRoleWithOIDCProviderOutputWithContext(ctx context.Context) AssumableRoleWithOIDCProviderOutput {
 	return o
 }
 
-func (o EKSExternalDNSPolicyOutput) ToEKSExternalDNSPolicyPtrOutput() EKSExternalDNSPolicyPtrOutput {
-	return o.ToEKSExternalDNSPolicyPtrOutputWithContext(context.Background())
+// The AWS account ID where the OIDC provider lives, defaults to `awsAccountId`.
+func (o AssumableRoleWithOIDCProviderOutput) AwsAccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AssumableRoleWithOIDCProvider) *string { return v.AwsAccountId }).(pulumi.StringPtrOutput)
 }
 
-func (o EKSExternalDNSPolicyOutput) ToEKSExternalDNSPolicyPtrOutputWithContext(ctx context.Context) EKSExternalDNSPolicyPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSExternalDNSPolicy) *EKSExternalDNSPolicy {
-		return &v
-	}).(EKSExternalDNSPolicyPtrOutput)
+// The audiences of this provider, replacing `oidcFullyQualifiedAudiences` when set.
+func (o AssumableRoleWithOIDCProviderOutput) OidcFullyQualifiedAudiences() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AssumableRoleWithOIDCProvider) []string { return v.OidcFullyQualifiedAudiences }).(pulumi.StringArrayOutput)
 }
 
-// Determines whether to attach the External DNS IAM policy to the role.
-func (o EKSExternalDNSPolicyOutput) Attach() pulumi.BoolOutput {
-	return o.ApplyT(func(v EKSExternalDNSPolicy) bool { return v.Attach }).(pulumi.BoolOutput)
+// The fully qualified OIDC subjects of this provider, replacing `oidcFullyQualifiedSubjects` when set.
+func (o AssumableRoleWithOIDCProviderOutput) OidcFullyQualifiedSubjects() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AssumableRoleWithOIDCProvider) []string { return v.OidcFullyQualifiedSubjects }).(pulumi.StringArrayOutput)
 }
 
-// Route53 hosted zone ARNs to allow External DNS to manage records. If not provided,
-// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
-func (o EKSExternalDNSPolicyOutput) HostedZoneArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v EKSExternalDNSPolicy) []string { return v.HostedZoneArns }).(pulumi.StringArrayOutput)
+// The OIDC subjects using wildcards of this provider, replacing `oidcSubjectsWithWildcards` when set.
+func (o AssumableRoleWithOIDCProviderOutput) OidcSubjectsWithWildcards() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AssumableRoleWithOIDCProvider) []string { return v.OidcSubjectsWithWildcards }).(pulumi.StringArrayOutput)
 }
 
-type EKSExternalDNSPolicyPtrOutput struct{ *pulumi.OutputState }
+// URL of the OIDC Provider.
+func (o AssumableRoleWithOIDCProviderOutput) Url() pulumi.StringOutput {
+	return o.ApplyT(func(v AssumableRoleWithOIDCProvider) string { return v.Url }).(pulumi.StringOutput)
+}
 
-func (EKSExternalDNSPolicyPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSExternalDNSPolicy)(nil)).Elem()
+type AssumableRoleWithOIDCProviderArrayOutput struct{ *pulumi.OutputState }
+
+func (AssumableRoleWithOIDCProviderArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AssumableRoleWithOIDCProvider)(nil)).Elem()
 }
 
-func (o EKSExternalDNSPolicyPtrOutput) ToEKSExternalDNSPolicyPtrOutput() EKSExternalDNSPolicyPtrOutput {
+func (o AssumableRoleWithOIDCProviderArrayOutput) ToAssumableRoleWithOIDCProviderArrayOutput() AssumableRoleWithOIDCProviderArrayOutput {
 	return o
 }
 
-func (o EKSExternalDNSPolicyPtrOutput) ToEKSExternalDNSPolicyPtrOutputWithContext(ctx context.Context) EKSExternalDNSPolicyPtrOutput {
+func (o AssumableRoleWithOIDCProviderArrayOutput) ToAssumableRoleWithOIDCProviderArrayOutputWithContext(ctx context.Context) AssumableRoleWithOIDCProviderArrayOutput {
 	return o
 }
 
-func (o EKSExternalDNSPolicyPtrOutput) Elem() EKSExternalDNSPolicyOutput {
-	return o.ApplyT(func(v *EKSExternalDNSPolicy) EKSExternalDNSPolicy {
-		if v != nil {
-			return *v
-		}
-		var ret EKSExternalDNSPolicy
-		return ret
-	}).(EKSExternalDNSPolicyOutput)
+func (o AssumableRoleWithOIDCProviderArrayOutput) Index(i pulumi.IntInput) AssumableRoleWithOIDCProviderOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) AssumableRoleWithOIDCProvider {
+		return vs[0].([]AssumableRoleWithOIDCProvider)[vs[1].(int)]
+	}).(AssumableRoleWithOIDCProviderOutput)
 }
 
-// Determines whether to attach the External DNS IAM policy to the role.
-func (o EKSExternalDNSPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSExternalDNSPolicy) *bool {
-		if v == nil {
-			return nil
-		}
-		return &v.Attach
-	}).(pulumi.BoolPtrOutput)
+// The AWS Distro for OpenTelemetry IAM policy to the role.
+type EKSADOTPolicy struct {
+	// List of AMP Workspace ARNs to write metrics to. If not provided, a default ARN of "*"
+	// will be provided.
+	AmpWorkspaceArns []string `pulumi:"ampWorkspaceArns"`
+	// Determines whether to attach the AWS Distro for OpenTelemetry IAM policy to the role.
+	Attach *bool `pulumi:"attach"`
+	// Allows exporting metrics to Amazon Managed Service for Prometheus.
+	EnableAmp *bool `pulumi:"enableAmp"`
+	// Allows exporting metrics and logs to CloudWatch.
+	EnableCloudwatch *bool `pulumi:"enableCloudwatch"`
+	// Allows exporting traces to AWS X-Ray.
+	EnableXray *bool `pulumi:"enableXray"`
+}
+
+// EKSADOTPolicyInput is an input type that accepts EKSADOTPolicyArgs and EKSADOTPolicyOutput values.
+// You can construct a concrete instance of `EKSADOTPolicyInput` via:
+//
+//	EKSADOTPolicyArgs{...}
+type EKSADOTPolicyInput interface {
+	pulumi.Input
+
+	ToEKSADOTPolicyOutput() EKSADOTPolicyOutput
+	ToEKSADOTPolicyOutputWithContext(context.Context) EKSADOTPolicyOutput
 }
 
-// Route53 hosted zone ARNs to allow External DNS to manage records. If not provided,
-// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
-func (o EKSExternalDNSPolicyPtrOutput) HostedZoneArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *EKSExternalDNSPolicy) []string {
-		if v == nil {
-			return nil
-		}
-		return v.HostedZoneArns
-	}).(pulumi.StringArrayOutput)
-}
-
-// The External Secrets policy to the role.
-type EKSExternalSecretsPolicy struct {
-	// Determines whether to attach the External Secrets policy to the role.
-	Attach bool `pulumi:"attach"`
-	// List of Secrets Manager ARNs that contain secrets to mount using External Secrets. If not provided, the default ARN "arn:aws:secretsmanager:*:*:secret:*" will be applied.
-	SecretsManagerArns []string `pulumi:"secretsManagerArns"`
-	// List of Systems Manager Parameter ARNs that contain secrets to mount using External Secrets. If not provided,
-	// the default ARN "arn:aws:ssm:*:*:parameter/*" will be applied.
-	SsmParameterArns []string `pulumi:"ssmParameterArns"`
-}
-
-// EKSExternalSecretsPolicyInput is an input type that accepts EKSExternalSecretsPolicyArgs and EKSExternalSecretsPolicyOutput values.
-// You can construct a concrete instance of `EKSExternalSecretsPolicyInput` via:
-//
-//	EKSExternalSecretsPolicyArgs{...}
-type EKSExternalSecretsPolicyInput interface {
-	pulumi.Input
-
-	ToEKSExternalSecretsPolicyOutput() EKSExternalSecretsPolicyOutput
-	ToEKSExternalSecretsPolicyOutputWithContext(context.Context) EKSExternalSecretsPolicyOutput
-}
-
-// The External Secrets policy to the role.
-type EKSExternalSecretsPolicyArgs struct {
-	// Determines whether to attach the External Secrets policy to the role.
-	Attach pulumi.BoolInput `pulumi:"attach"`
-	// List of Secrets Manager ARNs that contain secrets to mount using External Secrets. If not provided, the default ARN "arn:aws:secretsmanager:*:*:secret:*" will be applied.
-	SecretsManagerArns pulumi.StringArrayInput `pulumi:"secretsManagerArns"`
-	// List of Systems Manager Parameter ARNs that contain secrets to mount using External Secrets. If not provided,
-	// the default ARN "arn:aws:ssm:*:*:parameter/*" will be applied.
-	SsmParameterArns pulumi.StringArrayInput `pulumi:"ssmParameterArns"`
+// The AWS Distro for OpenTelemetry IAM policy to the role.
+type EKSADOTPolicyArgs struct {
+	// List of AMP Workspace ARNs to write metrics to. If not provided, a default ARN of "*"
+	// will be provided.
+	AmpWorkspaceArns pulumi.StringArrayInput `pulumi:"ampWorkspaceArns"`
+	// Determines whether to attach the AWS Distro for OpenTelemetry IAM policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+	// Allows exporting metrics to Amazon Managed Service for Prometheus.
+	EnableAmp pulumi.BoolPtrInput `pulumi:"enableAmp"`
+	// Allows exporting metrics and logs to CloudWatch.
+	EnableCloudwatch pulumi.BoolPtrInput `pulumi:"enableCloudwatch"`
+	// Allows exporting traces to AWS X-Ray.
+	EnableXray pulumi.BoolPtrInput `pulumi:"enableXray"`
 }
 
-func (EKSExternalSecretsPolicyArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSExternalSecretsPolicy)(nil)).Elem()
+func (EKSADOTPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSADOTPolicy)(nil)).Elem()
 }
 
-func (i EKSExternalSecretsPolicyArgs) ToEKSExternalSecretsPolicyOutput() EKSExternalSecretsPolicyOutput {
-	return i.ToEKSExternalSecretsPolicyOutputWithContext(context.Background())
+func (i EKSADOTPolicyArgs) ToEKSADOTPolicyOutput() EKSADOTPolicyOutput {
+	return i.ToEKSADOTPolicyOutputWithContext(context.Background())
 }
 
-func (i EKSExternalSecretsPolicyArgs) ToEKSExternalSecretsPolicyOutputWithContext(ctx context.Context) EKSExternalSecretsPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSExternalSecretsPolicyOutput)
+func (i EKSADOTPolicyArgs) ToEKSADOTPolicyOutputWithContext(ctx context.Context) EKSADOTPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSADOTPolicyOutput)
 }
 
-func (i EKSExternalSecretsPolicyArgs) ToEKSExternalSecretsPolicyPtrOutput() EKSExternalSecretsPolicyPtrOutput {
-	return i.ToEKSExternalSecretsPolicyPtrOutputWithContext(context.Background())
+func (i EKSADOTPolicyArgs) ToEKSADOTPolicyPtrOutput() EKSADOTPolicyPtrOutput {
+	return i.ToEKSADOTPolicyPtrOutputWithContext(context.Background())
 }
 
-func (i EKSExternalSecretsPolicyArgs) ToEKSExternalSecretsPolicyPtrOutputWithContext(ctx context.Context) EKSExternalSecretsPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSExternalSecretsPolicyOutput).ToEKSExternalSecretsPolicyPtrOutputWithContext(ctx)
+func (i EKSADOTPolicyArgs) ToEKSADOTPolicyPtrOutputWithContext(ctx context.Context) EKSADOTPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSADOTPolicyOutput).ToEKSADOTPolicyPtrOutputWithContext(ctx)
 }
 
-// EKSExternalSecretsPolicyPtrInput is an input type that accepts EKSExternalSecretsPolicyArgs, EKSExternalSecretsPolicyPtr and EKSExternalSecretsPolicyPtrOutput values.
-// You can construct a concrete instance of `EKSExternalSecretsPolicyPtrInput` via:
+// EKSADOTPolicyPtrInput is an input type that accepts EKSADOTPolicyArgs, EKSADOTPolicyPtr and EKSADOTPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSADOTPolicyPtrInput` via:
 //
-//	        EKSExternalSecretsPolicyArgs{...}
+//	        EKSADOTPolicyArgs{...}
 //
 //	or:
 //
 //	        nil
-type EKSExternalSecretsPolicyPtrInput interface {
+type EKSADOTPolicyPtrInput interface {
 	pulumi.Input
 
-	ToEKSExternalSecretsPolicyPtrOutput() EKSExternalSecretsPolicyPtrOutput
-	ToEKSExternalSecretsPolicyPtrOutputWithContext(context.Context) EKSExternalSecretsPolicyPtrOutput
+	ToEKSADOTPolicyPtrOutput() EKSADOTPolicyPtrOutput
+	ToEKSADOTPolicyPtrOutputWithContext(context.Context) EKSADOTPolicyPtrOutput
 }
 
-type eksexternalSecretsPolicyPtrType EKSExternalSecretsPolicyArgs
+type eksadotpolicyPtrType EKSADOTPolicyArgs
 
-func EKSExternalSecretsPolicyPtr(v *EKSExternalSecretsPolicyArgs) EKSExternalSecretsPolicyPtrInput {
-	return (*eksexternalSecretsPolicyPtrType)(v)
+func EKSADOTPolicyPtr(v *EKSADOTPolicyArgs) EKSADOTPolicyPtrInput {
+	return (*eksadotpolicyPtrType)(v)
 }
 
-func (*eksexternalSecretsPolicyPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSExternalSecretsPolicy)(nil)).Elem()
+func (*eksadotpolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSADOTPolicy)(nil)).Elem()
 }
 
-func (i *eksexternalSecretsPolicyPtrType) ToEKSExternalSecretsPolicyPtrOutput() EKSExternalSecretsPolicyPtrOutput {
-	return i.ToEKSExternalSecretsPolicyPtrOutputWithContext(context.Background())
+func (i *eksadotpolicyPtrType) ToEKSADOTPolicyPtrOutput() EKSADOTPolicyPtrOutput {
+	return i.ToEKSADOTPolicyPtrOutputWithContext(context.Background())
 }
 
-func (i *eksexternalSecretsPolicyPtrType) ToEKSExternalSecretsPolicyPtrOutputWithContext(ctx context.Context) EKSExternalSecretsPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSExternalSecretsPolicyPtrOutput)
+func (i *eksadotpolicyPtrType) ToEKSADOTPolicyPtrOutputWithContext(ctx context.Context) EKSADOTPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSADOTPolicyPtrOutput)
 }
 
-// The External Secrets policy to the role.
-type EKSExternalSecretsPolicyOutput struct{ *pulumi.OutputState }
+// The AWS Distro for OpenTelemetry IAM policy to the role.
+type EKSADOTPolicyOutput struct{ *pulumi.OutputState }
 
-func (EKSExternalSecretsPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSExternalSecretsPolicy)(nil)).Elem()
+func (EKSADOTPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSADOTPolicy)(nil)).Elem()
 }
 
-func (o EKSExternalSecretsPolicyOutput) ToEKSExternalSecretsPolicyOutput() EKSExternalSecretsPolicyOutput {
+func (o EKSADOTPolicyOutput) ToEKSADOTPolicyOutput() EKSADOTPolicyOutput {
 	return o
 }
 
-func (o EKSExternalSecretsPolicyOutput) ToEKSExternalSecretsPolicyOutputWithContext(ctx context.Context) EKSExternalSecretsPolicyOutput {
+func (o EKSADOTPolicyOutput) ToEKSADOTPolicyOutputWithContext(ctx context.Context) EKSADOTPolicyOutput {
 	return o
 }
 
-func (o EKSExternalSecretsPolicyOutput) ToEKSExternalSecretsPolicyPtrOutput() EKSExternalSecretsPolicyPtrOutput {
-	return o.ToEKSExternalSecretsPolicyPtrOutputWithContext(context.Background())
+func (o EKSADOTPolicyOutput) ToEKSADOTPolicyPtrOutput() EKSADOTPolicyPtrOutput {
+	return o.ToEKSADOTPolicyPtrOutputWithContext(context.Background())
 }
 
-func (o EKSExternalSecretsPolicyOutput) ToEKSExternalSecretsPolicyPtrOutputWithContext(ctx context.Context) EKSExternalSecretsPolicyPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSExternalSecretsPolicy) *EKSExternalSecretsPolicy {
+func (o EKSADOTPolicyOutput) ToEKSADOTPolicyPtrOutputWithContext(ctx context.Context) EKSADOTPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSADOTPolicy) *EKSADOTPolicy {
 		return &v
-	}).(EKSExternalSecretsPolicyPtrOutput)
+	}).(EKSADOTPolicyPtrOutput)
 }
 
-// Determines whether to attach the External Secrets policy to the role.
-func (o EKSExternalSecretsPolicyOutput) Attach() pulumi.BoolOutput {
-	return o.ApplyT(func(v EKSExternalSecretsPolicy) bool { return v.Attach }).(pulumi.BoolOutput)
+// List of AMP Workspace ARNs to write metrics to. If not provided, a default ARN of "*"
+// will be provided.
+func (o EKSADOTPolicyOutput) AmpWorkspaceArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSADOTPolicy) []string { return v.AmpWorkspaceArns }).(pulumi.StringArrayOutput)
 }
 
-// List of Secrets Manager ARNs that contain secrets to mount using External Secrets. If not provided, the default ARN "arn:aws:secretsmanager:*:*:secret:*" will be applied.
-func (o EKSExternalSecretsPolicyOutput) SecretsManagerArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v EKSExternalSecretsPolicy) []string { return v.SecretsManagerArns }).(pulumi.StringArrayOutput)
+// Determines whether to attach the AWS Distro for OpenTelemetry IAM policy to the role.
+func (o EKSADOTPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSADOTPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
 }
 
-// List of Systems Manager Parameter ARNs that contain secrets to mount using External Secrets. If not provided,
-// the default ARN "arn:aws:ssm:*:*:parameter/*" will be applied.
-func (o EKSExternalSecretsPolicyOutput) SsmParameterArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v EKSExternalSecretsPolicy) []string { return v.SsmParameterArns }).(pulumi.StringArrayOutput)
+// Allows exporting metrics to Amazon Managed Service for Prometheus.
+func (o EKSADOTPolicyOutput) EnableAmp() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSADOTPolicy) *bool { return v.EnableAmp }).(pulumi.BoolPtrOutput)
 }
 
-type EKSExternalSecretsPolicyPtrOutput struct{ *pulumi.OutputState }
+// Allows exporting metrics and logs to CloudWatch.
+func (o EKSADOTPolicyOutput) EnableCloudwatch() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSADOTPolicy) *bool { return v.EnableCloudwatch }).(pulumi.BoolPtrOutput)
+}
 
-func (EKSExternalSecretsPolicyPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSExternalSecretsPolicy)(nil)).Elem()
+// Allows exporting traces to AWS X-Ray.
+func (o EKSADOTPolicyOutput) EnableXray() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSADOTPolicy) *bool { return v.EnableXray }).(pulumi.BoolPtrOutput)
 }
 
-func (o EKSExternalSecretsPolicyPtrOutput) ToEKSExternalSecretsPolicyPtrOutput() EKSExternalSecretsPolicyPtrOutput {
+type EKSADOTPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSADOTPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSADOTPolicy)(nil)).Elem()
+}
+
+func (o EKSADOTPolicyPtrOutput) ToEKSADOTPolicyPtrOutput() EKSADOTPolicyPtrOutput {
 	return o
 }
 
-func (o EKSExternalSecretsPolicyPtrOutput) ToEKSExternalSecretsPolicyPtrOutputWithContext(ctx context.Context) EKSExternalSecretsPolicyPtrOutput {
+func (o EKSADOTPolicyPtrOutput) ToEKSADOTPolicyPtrOutputWithContext(ctx context.Context) EKSADOTPolicyPtrOutput {
 	return o
 }
 
-func (o EKSExternalSecretsPolicyPtrOutput) Elem() EKSExternalSecretsPolicyOutput {
-	return o.ApplyT(func(v *EKSExternalSecretsPolicy) EKSExternalSecretsPolicy {
+func (o EKSADOTPolicyPtrOutput) Elem() EKSADOTPolicyOutput {
+	return o.ApplyT(func(v *EKSADOTPolicy) EKSADOTPolicy {
 		if v != nil {
 			return *v
 		}
-		var ret EKSExternalSecretsPolicy
+		var ret EKSADOTPolicy
 		return ret
-	}).(EKSExternalSecretsPolicyOutput)
+	}).(EKSADOTPolicyOutput)
 }
 
-// Determines whether to attach the External Secrets policy to the role.
-func (o EKSExternalSecretsPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSExternalSecretsPolicy) *bool {
+// List of AMP Workspace ARNs to write metrics to. If not provided, a default ARN of "*"
+// will be provided.
+func (o EKSADOTPolicyPtrOutput) AmpWorkspaceArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSADOTPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.AmpWorkspaceArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// Determines whether to attach the AWS Distro for OpenTelemetry IAM policy to the role.
+func (o EKSADOTPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSADOTPolicy) *bool {
 		if v == nil {
 			return nil
 		}
-		return &v.Attach
+		return v.Attach
 	}).(pulumi.BoolPtrOutput)
 }
 
-// List of Secrets Manager ARNs that contain secrets to mount using External Secrets. If not provided, the default ARN "arn:aws:secretsmanager:*:*:secret:*" will be applied.
-func (o EKSExternalSecretsPolicyPtrOutput) SecretsManagerArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *EKSExternalSecretsPolicy) []string {
+// Allows exporting metrics to Amazon Managed Service for Prometheus.
+func (o EKSADOTPolicyPtrOutput) EnableAmp() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSADOTPolicy) *bool {
 		if v == nil {
 			return nil
 		}
-		return v.SecretsManagerArns
-	}).(pulumi.StringArrayOutput)
+		return v.EnableAmp
+	}).(pulumi.BoolPtrOutput)
 }
 
-// List of Systems Manager Parameter ARNs that contain secrets to mount using External Secrets. If not provided,
-// the default ARN "arn:aws:ssm:*:*:parameter/*" will be applied.
-func (o EKSExternalSecretsPolicyPtrOutput) SsmParameterArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *EKSExternalSecretsPolicy) []string {
+// Allows exporting metrics and logs to CloudWatch.
+func (o EKSADOTPolicyPtrOutput) EnableCloudwatch() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSADOTPolicy) *bool {
 		if v == nil {
 			return nil
 		}
-		return v.SsmParameterArns
-	}).(pulumi.StringArrayOutput)
+		return v.EnableCloudwatch
+	}).(pulumi.BoolPtrOutput)
 }
 
-// The Karpenter Controller policy to the role.
-type EKSKarpenterControllerPolicy struct {
-	// Determines whether to attach the Karpenter Controller policy to the role.
-	Attach bool `pulumi:"attach"`
-	// Cluster ID where the Karpenter controller is provisioned/managing.
-	ClusterId *string `pulumi:"clusterId"`
-	// List of node IAM role ARNs Karpenter can use to launch nodes. If not provided,
-	// the default ARN "*" will be applied.
-	NodeIamRoleArns []string `pulumi:"nodeIamRoleArns"`
-	// List of SSM Parameter ARNs that contain AMI IDs launched by Karpenter. If not provided,
-	// the default ARN "arn:aws:ssm:*:*:parameter/aws/service/*" will be applied.
-	SsmParameterArns []string `pulumi:"ssmParameterArns"`
-	// Account ID of where the subnets Karpenter will utilize resides. Used when subnets are shared from another account.
-	SubnetAccountId *string `pulumi:"subnetAccountId"`
-	// Tag key (`{key = value}`) applied to resources launched by Karpenter through the Karpenter provisioner.
-	TagKey *string `pulumi:"tagKey"`
+// Allows exporting traces to AWS X-Ray.
+func (o EKSADOTPolicyPtrOutput) EnableXray() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSADOTPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.EnableXray
+	}).(pulumi.BoolPtrOutput)
 }
 
-// Defaults sets the appropriate defaults for EKSKarpenterControllerPolicy
-func (val *EKSKarpenterControllerPolicy) Defaults() *EKSKarpenterControllerPolicy {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	if tmp.ClusterId == nil {
-		clusterId_ := "*"
-		tmp.ClusterId = &clusterId_
-	}
-	if tmp.TagKey == nil {
-		tagKey_ := "karpenter.sh/discovery"
-		tmp.TagKey = &tagKey_
-	}
-	return &tmp
+// The Amazon Managed Service for Prometheus IAM policy to the role.
+type EKSAmazonManagedServicePrometheusPolicy struct {
+	// Determines whether to attach the Amazon Managed Service for Prometheus IAM policy to the role.
+	Attach *bool `pulumi:"attach"`
+	// List of AMP Workspace ARNs to read and write metrics. If not provided, a default ARN of "*"
+	// will be provided.
+	WorkspaceArns []string `pulumi:"workspaceArns"`
 }
 
-// EKSKarpenterControllerPolicyInput is an input type that accepts EKSKarpenterControllerPolicyArgs and EKSKarpenterControllerPolicyOutput values.
-// You can construct a concrete instance of `EKSKarpenterControllerPolicyInput` via:
+// EKSAmazonManagedServicePrometheusPolicyInput is an input type that accepts EKSAmazonManagedServicePrometheusPolicyArgs and EKSAmazonManagedServicePrometheusPolicyOutput values.
+// You can construct a concrete instance of `EKSAmazonManagedServicePrometheusPolicyInput` via:
 //
-//	EKSKarpenterControllerPolicyArgs{...}
-type EKSKarpenterControllerPolicyInput interface {
+//	EKSAmazonManagedServicePrometheusPolicyArgs{...}
+type EKSAmazonManagedServicePrometheusPolicyInput interface {
 	pulumi.Input
 
-	ToEKSKarpenterControllerPolicyOutput() EKSKarpenterControllerPolicyOutput
-	ToEKSKarpenterControllerPolicyOutputWithContext(context.Context) EKSKarpenterControllerPolicyOutput
+	ToEKSAmazonManagedServicePrometheusPolicyOutput() EKSAmazonManagedServicePrometheusPolicyOutput
+	ToEKSAmazonManagedServicePrometheusPolicyOutputWithContext(context.Context) EKSAmazonManagedServicePrometheusPolicyOutput
 }
 
-// The Karpenter Controller policy to the role.
-type EKSKarpenterControllerPolicyArgs struct {
-	// Determines whether to attach the Karpenter Controller policy to the role.
-	Attach pulumi.BoolInput `pulumi:"attach"`
-	// Cluster ID where the Karpenter controller is provisioned/managing.
-	ClusterId pulumi.StringPtrInput `pulumi:"clusterId"`
-	// List of node IAM role ARNs Karpenter can use to launch nodes. If not provided,
-	// the default ARN "*" will be applied.
-	NodeIamRoleArns pulumi.StringArrayInput `pulumi:"nodeIamRoleArns"`
-	// List of SSM Parameter ARNs that contain AMI IDs launched by Karpenter. If not provided,
-	// the default ARN "arn:aws:ssm:*:*:parameter/aws/service/*" will be applied.
-	SsmParameterArns pulumi.StringArrayInput `pulumi:"ssmParameterArns"`
-	// Account ID of where the subnets Karpenter will utilize resides. Used when subnets are shared from another account.
-	SubnetAccountId pulumi.StringPtrInput `pulumi:"subnetAccountId"`
-	// Tag key (`{key = value}`) applied to resources launched by Karpenter through the Karpenter provisioner.
-	TagKey pulumi.StringPtrInput `pulumi:"tagKey"`
+// The Amazon Managed Service for Prometheus IAM policy to the role.
+type EKSAmazonManagedServicePrometheusPolicyArgs struct {
+	// Determines whether to attach the Amazon Managed Service for Prometheus IAM policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+	// List of AMP Workspace ARNs to read and write metrics. If not provided, a default ARN of "*"
+	// will be provided.
+	WorkspaceArns pulumi.StringArrayInput `pulumi:"workspaceArns"`
 }
 
-// Defaults sets the appropriate defaults for EKSKarpenterControllerPolicyArgs
-func (val *EKSKarpenterControllerPolicyArgs) Defaults() *EKSKarpenterControllerPolicyArgs {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	if tmp.ClusterId == nil {
-		tmp.ClusterId = pulumi.StringPtr("*")
-	}
-	if tmp.TagKey == nil {
-		tmp.TagKey = pulumi.StringPtr("karpenter.sh/discovery")
-	}
-	return &tmp
-}
-func (EKSKarpenterControllerPolicyArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSKarpenterControllerPolicy)(nil)).Elem()
+func (EKSAmazonManagedServicePrometheusPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSAmazonManagedServicePrometheusPolicy)(nil)).Elem()
 }
 
-func (i EKSKarpenterControllerPolicyArgs) ToEKSKarpenterControllerPolicyOutput() EKSKarpenterControllerPolicyOutput {
-	return i.ToEKSKarpenterControllerPolicyOutputWithContext(context.Background())
+func (i EKSAmazonManagedServicePrometheusPolicyArgs) ToEKSAmazonManagedServicePrometheusPolicyOutput() EKSAmazonManagedServicePrometheusPolicyOutput {
+	return i.ToEKSAmazonManagedServicePrometheusPolicyOutputWithContext(context.Background())
 }
 
-func (i EKSKarpenterControllerPolicyArgs) ToEKSKarpenterControllerPolicyOutputWithContext(ctx context.Context) EKSKarpenterControllerPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSKarpenterControllerPolicyOutput)
+func (i EKSAmazonManagedServicePrometheusPolicyArgs) ToEKSAmazonManagedServicePrometheusPolicyOutputWithContext(ctx context.Context) EKSAmazonManagedServicePrometheusPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSAmazonManagedServicePrometheusPolicyOutput)
 }
 
-func (i EKSKarpenterControllerPolicyArgs) ToEKSKarpenterControllerPolicyPtrOutput() EKSKarpenterControllerPolicyPtrOutput {
-	return i.ToEKSKarpenterControllerPolicyPtrOutputWithContext(context.Background())
+func (i EKSAmazonManagedServicePrometheusPolicyArgs) ToEKSAmazonManagedServicePrometheusPolicyPtrOutput() EKSAmazonManagedServicePrometheusPolicyPtrOutput {
+	return i.ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(context.Background())
 }
 
-func (i EKSKarpenterControllerPolicyArgs) ToEKSKarpenterControllerPolicyPtrOutputWithContext(ctx context.Context) EKSKarpenterControllerPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSKarpenterControllerPolicyOutput).ToEKSKarpenterControllerPolicyPtrOutputWithContext(ctx)
+func (i EKSAmazonManagedServicePrometheusPolicyArgs) ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(ctx context.Context) EKSAmazonManagedServicePrometheusPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSAmazonManagedServicePrometheusPolicyOutput).ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(ctx)
 }
 
-// EKSKarpenterControllerPolicyPtrInput is an input type that accepts EKSKarpenterControllerPolicyArgs, EKSKarpenterControllerPolicyPtr and EKSKarpenterControllerPolicyPtrOutput values.
-// You can construct a concrete instance of `EKSKarpenterControllerPolicyPtrInput` via:
+// EKSAmazonManagedServicePrometheusPolicyPtrInput is an input type that accepts EKSAmazonManagedServicePrometheusPolicyArgs, EKSAmazonManagedServicePrometheusPolicyPtr and EKSAmazonManagedServicePrometheusPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSAmazonManagedServicePrometheusPolicyPtrInput` via:
 //
-//	        EKSKarpenterControllerPolicyArgs{...}
+//	        EKSAmazonManagedServicePrometheusPolicyArgs{...}
 //
 //	or:
 //
 //	        nil
-type EKSKarpenterControllerPolicyPtrInput interface {
+type EKSAmazonManagedServicePrometheusPolicyPtrInput interface {
 	pulumi.Input
 
-	ToEKSKarpenterControllerPolicyPtrOutput() EKSKarpenterControllerPolicyPtrOutput
-	ToEKSKarpenterControllerPolicyPtrOutputWithContext(context.Context) EKSKarpenterControllerPolicyPtrOutput
+	ToEKSAmazonManagedServicePrometheusPolicyPtrOutput() EKSAmazonManagedServicePrometheusPolicyPtrOutput
+	ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(context.Context) EKSAmazonManagedServicePrometheusPolicyPtrOutput
 }
 
-type ekskarpenterControllerPolicyPtrType EKSKarpenterControllerPolicyArgs
+type eksamazonManagedServicePrometheusPolicyPtrType EKSAmazonManagedServicePrometheusPolicyArgs
 
-func EKSKarpenterControllerPolicyPtr(v *EKSKarpenterControllerPolicyArgs) EKSKarpenterControllerPolicyPtrInput {
-	return (*ekskarpenterControllerPolicyPtrType)(v)
+func EKSAmazonManagedServicePrometheusPolicyPtr(v *EKSAmazonManagedServicePrometheusPolicyArgs) EKSAmazonManagedServicePrometheusPolicyPtrInput {
+	return (*eksamazonManagedServicePrometheusPolicyPtrType)(v)
 }
 
-func (*ekskarpenterControllerPolicyPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSKarpenterControllerPolicy)(nil)).Elem()
+func (*eksamazonManagedServicePrometheusPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSAmazonManagedServicePrometheusPolicy)(nil)).Elem()
 }
 
-func (i *ekskarpenterControllerPolicyPtrType) ToEKSKarpenterControllerPolicyPtrOutput() EKSKarpenterControllerPolicyPtrOutput {
-	return i.ToEKSKarpenterControllerPolicyPtrOutputWithContext(context.Background())
+func (i *eksamazonManagedServicePrometheusPolicyPtrType) ToEKSAmazonManagedServicePrometheusPolicyPtrOutput() EKSAmazonManagedServicePrometheusPolicyPtrOutput {
+	return i.ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(context.Background())
 }
 
-func (i *ekskarpenterControllerPolicyPtrType) ToEKSKarpenterControllerPolicyPtrOutputWithContext(ctx context.Context) EKSKarpenterControllerPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSKarpenterControllerPolicyPtrOutput)
+func (i *eksamazonManagedServicePrometheusPolicyPtrType) ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(ctx context.Context) EKSAmazonManagedServicePrometheusPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSAmazonManagedServicePrometheusPolicyPtrOutput)
 }
 
-// The Karpenter Controller policy to the role.
-type EKSKarpenterControllerPolicyOutput struct{ *pulumi.OutputState }
+// The Amazon Managed Service for Prometheus IAM policy to the role.
+type EKSAmazonManagedServicePrometheusPolicyOutput struct{ *pulumi.OutputState }
 
-func (EKSKarpenterControllerPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSKarpenterControllerPolicy)(nil)).Elem()
+func (EKSAmazonManagedServicePrometheusPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSAmazonManagedServicePrometheusPolicy)(nil)).Elem()
 }
 
-func (o EKSKarpenterControllerPolicyOutput) ToEKSKarpenterControllerPolicyOutput() EKSKarpenterControllerPolicyOutput {
+func (o EKSAmazonManagedServicePrometheusPolicyOutput) ToEKSAmazonManagedServicePrometheusPolicyOutput() EKSAmazonManagedServicePrometheusPolicyOutput {
 	return o
 }
 
-func (o EKSKarpenterControllerPolicyOutput) ToEKSKarpenterControllerPolicyOutputWithContext(ctx context.Context) EKSKarpenterControllerPolicyOutput {
+func (o EKSAmazonManagedServicePrometheusPolicyOutput) ToEKSAmazonManagedServicePrometheusPolicyOutputWithContext(ctx context.Context) EKSAmazonManagedServicePrometheusPolicyOutput {
 	return o
 }
 
-func (o EKSKarpenterControllerPolicyOutput) ToEKSKarpenterControllerPolicyPtrOutput() EKSKarpenterControllerPolicyPtrOutput {
-	return o.ToEKSKarpenterControllerPolicyPtrOutputWithContext(context.Background())
+func (o EKSAmazonManagedServicePrometheusPolicyOutput) ToEKSAmazonManagedServicePrometheusPolicyPtrOutput() EKSAmazonManagedServicePrometheusPolicyPtrOutput {
+	return o.ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(context.Background())
 }
 
-func (o EKSKarpenterControllerPolicyOutput) ToEKSKarpenterControllerPolicyPtrOutputWithContext(ctx context.Context) EKSKarpenterControllerPolicyPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSKarpenterControllerPolicy) *EKSKarpenterControllerPolicy {
+func (o EKSAmazonManagedServicePrometheusPolicyOutput) ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(ctx context.Context) EKSAmazonManagedServicePrometheusPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSAmazonManagedServicePrometheusPolicy) *EKSAmazonManagedServicePrometheusPolicy {
 		return &v
-	}).(EKSKarpenterControllerPolicyPtrOutput)
-}
-
-// Determines whether to attach the Karpenter Controller policy to the role.
-func (o EKSKarpenterControllerPolicyOutput) Attach() pulumi.BoolOutput {
-	return o.ApplyT(func(v EKSKarpenterControllerPolicy) bool { return v.Attach }).(pulumi.BoolOutput)
-}
-
-// Cluster ID where the Karpenter controller is provisioned/managing.
-func (o EKSKarpenterControllerPolicyOutput) ClusterId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v EKSKarpenterControllerPolicy) *string { return v.ClusterId }).(pulumi.StringPtrOutput)
-}
-
-// List of node IAM role ARNs Karpenter can use to launch nodes. If not provided,
-// the default ARN "*" will be applied.
-func (o EKSKarpenterControllerPolicyOutput) NodeIamRoleArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v EKSKarpenterControllerPolicy) []string { return v.NodeIamRoleArns }).(pulumi.StringArrayOutput)
-}
-
-// List of SSM Parameter ARNs that contain AMI IDs launched by Karpenter. If not provided,
-// the default ARN "arn:aws:ssm:*:*:parameter/aws/service/*" will be applied.
-func (o EKSKarpenterControllerPolicyOutput) SsmParameterArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v EKSKarpenterControllerPolicy) []string { return v.SsmParameterArns }).(pulumi.StringArrayOutput)
+	}).(EKSAmazonManagedServicePrometheusPolicyPtrOutput)
 }
 
-// Account ID of where the subnets Karpenter will utilize resides. Used when subnets are shared from another account.
-func (o EKSKarpenterControllerPolicyOutput) SubnetAccountId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v EKSKarpenterControllerPolicy) *string { return v.SubnetAccountId }).(pulumi.StringPtrOutput)
+// Determines whether to attach the Amazon Managed Service for Prometheus IAM policy to the role.
+func (o EKSAmazonManagedServicePrometheusPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSAmazonManagedServicePrometheusPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
 }
 
-// Tag key (`{key = value}`) applied to resources launched by Karpenter through the Karpenter provisioner.
-func (o EKSKarpenterControllerPolicyOutput) TagKey() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v EKSKarpenterControllerPolicy) *string { return v.TagKey }).(pulumi.StringPtrOutput)
+// List of AMP Workspace ARNs to read and write metrics. If not provided, a default ARN of "*"
+// will be provided.
+func (o EKSAmazonManagedServicePrometheusPolicyOutput) WorkspaceArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSAmazonManagedServicePrometheusPolicy) []string { return v.WorkspaceArns }).(pulumi.StringArrayOutput)
 }
 
-type EKSKarpenterControllerPolicyPtrOutput struct{ *pulumi.OutputState }
+type EKSAmazonManagedServicePrometheusPolicyPtrOutput struct{ *pulumi.OutputState }
 
-func (EKSKarpenterControllerPolicyPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSKarpenterControllerPolicy)(nil)).Elem()
+func (EKSAmazonManagedServicePrometheusPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSAmazonManagedServicePrometheusPolicy)(nil)).Elem()
 }
 
-func (o EKSKarpenterControllerPolicyPtrOutput) ToEKSKarpenterControllerPolicyPtrOutput() EKSKarpenterControllerPolicyPtrOutput {
+func (o EKSAmazonManagedServicePrometheusPolicyPtrOutput) ToEKSAmazonManagedServicePrometheusPolicyPtrOutput() EKSAmazonManagedServicePrometheusPolicyPtrOutput {
 	return o
 }
 
-func (o EKSKarpenterControllerPolicyPtrOutput) ToEKSKarpenterControllerPolicyPtrOutputWithContext(ctx context.Context) EKSKarpenterControllerPolicyPtrOutput {
+func (o EKSAmazonManagedServicePrometheusPolicyPtrOutput) ToEKSAmazonManagedServicePrometheusPolicyPtrOutputWithContext(ctx context.Context) EKSAmazonManagedServicePrometheusPolicyPtrOutput {
 	return o
 }
 
-func (o EKSKarpenterControllerPolicyPtrOutput) Elem() EKSKarpenterControllerPolicyOutput {
-	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) EKSKarpenterControllerPolicy {
+func (o EKSAmazonManagedServicePrometheusPolicyPtrOutput) Elem() EKSAmazonManagedServicePrometheusPolicyOutput {
+	return o.ApplyT(func(v *EKSAmazonManagedServicePrometheusPolicy) EKSAmazonManagedServicePrometheusPolicy {
 		if v != nil {
 			return *v
 		}
-		var ret EKSKarpenterControllerPolicy
+		var ret EKSAmazonManagedServicePrometheusPolicy
 		return ret
-	}).(EKSKarpenterControllerPolicyOutput)
+	}).(EKSAmazonManagedServicePrometheusPolicyOutput)
 }
 
-// Determines whether to attach the Karpenter Controller policy to the role.
-func (o EKSKarpenterControllerPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) *bool {
+// Determines whether to attach the Amazon Managed Service for Prometheus IAM policy to the role.
+func (o EKSAmazonManagedServicePrometheusPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSAmazonManagedServicePrometheusPolicy) *bool {
 		if v == nil {
 			return nil
 		}
-		return &v.Attach
+		return v.Attach
 	}).(pulumi.BoolPtrOutput)
 }
 
-// Cluster ID where the Karpenter controller is provisioned/managing.
-func (o EKSKarpenterControllerPolicyPtrOutput) ClusterId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) *string {
-		if v == nil {
-			return nil
-		}
-		return v.ClusterId
-	}).(pulumi.StringPtrOutput)
-}
-
-// List of node IAM role ARNs Karpenter can use to launch nodes. If not provided,
-// the default ARN "*" will be applied.
-func (o EKSKarpenterControllerPolicyPtrOutput) NodeIamRoleArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) []string {
-		if v == nil {
-			return nil
-		}
-		return v.NodeIamRoleArns
-	}).(pulumi.StringArrayOutput)
-}
-
-// List of SSM Parameter ARNs that contain AMI IDs launched by Karpenter. If not provided,
-// the default ARN "arn:aws:ssm:*:*:parameter/aws/service/*" will be applied.
-func (o EKSKarpenterControllerPolicyPtrOutput) SsmParameterArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) []string {
+// List of AMP Workspace ARNs to read and write metrics. If not provided, a default ARN of "*"
+// will be provided.
+func (o EKSAmazonManagedServicePrometheusPolicyPtrOutput) WorkspaceArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSAmazonManagedServicePrometheusPolicy) []string {
 		if v == nil {
 			return nil
 		}
-		return v.SsmParameterArns
+		return v.WorkspaceArns
 	}).(pulumi.StringArrayOutput)
 }
 
-// Account ID of where the subnets Karpenter will utilize resides. Used when subnets are shared from another account.
-func (o EKSKarpenterControllerPolicyPtrOutput) SubnetAccountId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) *string {
-		if v == nil {
-			return nil
-		}
-		return v.SubnetAccountId
-	}).(pulumi.StringPtrOutput)
-}
-
-// Tag key (`{key = value}`) applied to resources launched by Karpenter through the Karpenter provisioner.
-func (o EKSKarpenterControllerPolicyPtrOutput) TagKey() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) *string {
-		if v == nil {
-			return nil
-		}
-		return v.TagKey
-	}).(pulumi.StringPtrOutput)
-}
-
-// The Load Balancer policy.
-type EKSLoadBalancerPolicy struct {
-	// Determines whether to attach the Load Balancer Controller policy to the role.
+// The Appmesh policies.
+type EKSAppmeshPolicy struct {
+	// Determines whether to attach the Appmesh Controller policy to the role.
 	Controller *bool `pulumi:"controller"`
-	// Determines whether to attach the Load Balancer Controller policy for the TargetGroupBinding only.
-	TargetGroupBindingOnly *bool `pulumi:"targetGroupBindingOnly"`
+	// Determines whether to attach the Appmesh envoy proxy policy to the role.
+	EnvoyProxy *bool `pulumi:"envoyProxy"`
 }
 
-// EKSLoadBalancerPolicyInput is an input type that accepts EKSLoadBalancerPolicyArgs and EKSLoadBalancerPolicyOutput values.
-// You can construct a concrete instance of `EKSLoadBalancerPolicyInput` via:
+// EKSAppmeshPolicyInput is an input type that accepts EKSAppmeshPolicyArgs and EKSAppmeshPolicyOutput values.
+// You can construct a concrete instance of `EKSAppmeshPolicyInput` via:
 //
-//	EKSLoadBalancerPolicyArgs{...}
-type EKSLoadBalancerPolicyInput interface {
+//	EKSAppmeshPolicyArgs{...}
+type EKSAppmeshPolicyInput interface {
 	pulumi.Input
 
-	ToEKSLoadBalancerPolicyOutput() EKSLoadBalancerPolicyOutput
-	ToEKSLoadBalancerPolicyOutputWithContext(context.Context) EKSLoadBalancerPolicyOutput
+	ToEKSAppmeshPolicyOutput() EKSAppmeshPolicyOutput
+	ToEKSAppmeshPolicyOutputWithContext(context.Context) EKSAppmeshPolicyOutput
 }
 
-// The Load Balancer policy.
-type EKSLoadBalancerPolicyArgs struct {
-	// Determines whether to attach the Load Balancer Controller policy to the role.
+// The Appmesh policies.
+type EKSAppmeshPolicyArgs struct {
+	// Determines whether to attach the Appmesh Controller policy to the role.
 	Controller pulumi.BoolPtrInput `pulumi:"controller"`
-	// Determines whether to attach the Load Balancer Controller policy for the TargetGroupBinding only.
-	TargetGroupBindingOnly pulumi.BoolPtrInput `pulumi:"targetGroupBindingOnly"`
+	// Determines whether to attach the Appmesh envoy proxy policy to the role.
+	EnvoyProxy pulumi.BoolPtrInput `pulumi:"envoyProxy"`
 }
 
-func (EKSLoadBalancerPolicyArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSLoadBalancerPolicy)(nil)).Elem()
+func (EKSAppmeshPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSAppmeshPolicy)(nil)).Elem()
 }
 
-func (i EKSLoadBalancerPolicyArgs) ToEKSLoadBalancerPolicyOutput() EKSLoadBalancerPolicyOutput {
-	return i.ToEKSLoadBalancerPolicyOutputWithContext(context.Background())
+func (i EKSAppmeshPolicyArgs) ToEKSAppmeshPolicyOutput() EKSAppmeshPolicyOutput {
+	return i.ToEKSAppmeshPolicyOutputWithContext(context.Background())
 }
 
-func (i EKSLoadBalancerPolicyArgs) ToEKSLoadBalancerPolicyOutputWithContext(ctx context.Context) EKSLoadBalancerPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSLoadBalancerPolicyOutput)
+func (i EKSAppmeshPolicyArgs) ToEKSAppmeshPolicyOutputWithContext(ctx context.Context) EKSAppmeshPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSAppmeshPolicyOutput)
 }
 
-func (i EKSLoadBalancerPolicyArgs) ToEKSLoadBalancerPolicyPtrOutput() EKSLoadBalancerPolicyPtrOutput {
-	return i.ToEKSLoadBalancerPolicyPtrOutputWithContext(context.Background())
+func (i EKSAppmeshPolicyArgs) ToEKSAppmeshPolicyPtrOutput() EKSAppmeshPolicyPtrOutput {
+	return i.ToEKSAppmeshPolicyPtrOutputWithContext(context.Background())
 }
 
-func (i EKSLoadBalancerPolicyArgs) ToEKSLoadBalancerPolicyPtrOutputWithContext(ctx context.Context) EKSLoadBalancerPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSLoadBalancerPolicyOutput).ToEKSLoadBalancerPolicyPtrOutputWithContext(ctx)
+func (i EKSAppmeshPolicyArgs) ToEKSAppmeshPolicyPtrOutputWithContext(ctx context.Context) EKSAppmeshPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSAppmeshPolicyOutput).ToEKSAppmeshPolicyPtrOutputWithContext(ctx)
 }
 
-// EKSLoadBalancerPolicyPtrInput is an input type that accepts EKSLoadBalancerPolicyArgs, EKSLoadBalancerPolicyPtr and EKSLoadBalancerPolicyPtrOutput values.
-// You can construct a concrete instance of `EKSLoadBalancerPolicyPtrInput` via:
+// EKSAppmeshPolicyPtrInput is an input type that accepts EKSAppmeshPolicyArgs, EKSAppmeshPolicyPtr and EKSAppmeshPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSAppmeshPolicyPtrInput` via:
 //
-//	        EKSLoadBalancerPolicyArgs{...}
+//	        EKSAppmeshPolicyArgs{...}
 //
 //	or:
 //
 //	        nil
-type EKSLoadBalancerPolicyPtrInput interface {
+type EKSAppmeshPolicyPtrInput interface {
 	pulumi.Input
 
-	ToEKSLoadBalancerPolicyPtrOutput() EKSLoadBalancerPolicyPtrOutput
-	ToEKSLoadBalancerPolicyPtrOutputWithContext(context.Context) EKSLoadBalancerPolicyPtrOutput
+	ToEKSAppmeshPolicyPtrOutput() EKSAppmeshPolicyPtrOutput
+	ToEKSAppmeshPolicyPtrOutputWithContext(context.Context) EKSAppmeshPolicyPtrOutput
 }
 
-type eksloadBalancerPolicyPtrType EKSLoadBalancerPolicyArgs
+type eksappmeshPolicyPtrType EKSAppmeshPolicyArgs
 
-func EKSLoadBalancerPolicyPtr(v *EKSLoadBalancerPolicyArgs) EKSLoadBalancerPolicyPtrInput {
-	return (*eksloadBalancerPolicyPtrType)(v)
+func EKSAppmeshPolicyPtr(v *EKSAppmeshPolicyArgs) EKSAppmeshPolicyPtrInput {
+	return (*eksappmeshPolicyPtrType)(v)
 }
 
-func (*eksloadBalancerPolicyPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSLoadBalancerPolicy)(nil)).Elem()
+func (*eksappmeshPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSAppmeshPolicy)(nil)).Elem()
 }
 
-func (i *eksloadBalancerPolicyPtrType) ToEKSLoadBalancerPolicyPtrOutput() EKSLoadBalancerPolicyPtrOutput {
-	return i.ToEKSLoadBalancerPolicyPtrOutputWithContext(context.Background())
+func (i *eksappmeshPolicyPtrType) ToEKSAppmeshPolicyPtrOutput() EKSAppmeshPolicyPtrOutput {
+	return i.ToEKSAppmeshPolicyPtrOutputWithContext(context.Background())
 }
 
-func (i *eksloadBalancerPolicyPtrType) ToEKSLoadBalancerPolicyPtrOutputWithContext(ctx context.Context) EKSLoadBalancerPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSLoadBalancerPolicyPtrOutput)
+func (i *eksappmeshPolicyPtrType) ToEKSAppmeshPolicyPtrOutputWithContext(ctx context.Context) EKSAppmeshPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSAppmeshPolicyPtrOutput)
 }
 
-// The Load Balancer policy.
-type EKSLoadBalancerPolicyOutput struct{ *pulumi.OutputState }
+// The Appmesh policies.
+type EKSAppmeshPolicyOutput struct{ *pulumi.OutputState }
 
-func (EKSLoadBalancerPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSLoadBalancerPolicy)(nil)).Elem()
+func (EKSAppmeshPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSAppmeshPolicy)(nil)).Elem()
 }
 
-func (o EKSLoadBalancerPolicyOutput) ToEKSLoadBalancerPolicyOutput() EKSLoadBalancerPolicyOutput {
+func (o EKSAppmeshPolicyOutput) ToEKSAppmeshPolicyOutput() EKSAppmeshPolicyOutput {
 	return o
 }
 
-func (o EKSLoadBalancerPolicyOutput) ToEKSLoadBalancerPolicyOutputWithContext(ctx context.Context) EKSLoadBalancerPolicyOutput {
+func (o EKSAppmeshPolicyOutput) ToEKSAppmeshPolicyOutputWithContext(ctx context.Context) EKSAppmeshPolicyOutput {
 	return o
 }
 
-func (o EKSLoadBalancerPolicyOutput) ToEKSLoadBalancerPolicyPtrOutput() EKSLoadBalancerPolicyPtrOutput {
-	return o.ToEKSLoadBalancerPolicyPtrOutputWithContext(context.Background())
+func (o EKSAppmeshPolicyOutput) ToEKSAppmeshPolicyPtrOutput() EKSAppmeshPolicyPtrOutput {
+	return o.ToEKSAppmeshPolicyPtrOutputWithContext(context.Background())
 }
 
-func (o EKSLoadBalancerPolicyOutput) ToEKSLoadBalancerPolicyPtrOutputWithContext(ctx context.Context) EKSLoadBalancerPolicyPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSLoadBalancerPolicy) *EKSLoadBalancerPolicy {
+func (o EKSAppmeshPolicyOutput) ToEKSAppmeshPolicyPtrOutputWithContext(ctx context.Context) EKSAppmeshPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSAppmeshPolicy) *EKSAppmeshPolicy {
 		return &v
-	}).(EKSLoadBalancerPolicyPtrOutput)
+	}).(EKSAppmeshPolicyPtrOutput)
 }
 
-// Determines whether to attach the Load Balancer Controller policy to the role.
-func (o EKSLoadBalancerPolicyOutput) Controller() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v EKSLoadBalancerPolicy) *bool { return v.Controller }).(pulumi.BoolPtrOutput)
+// Determines whether to attach the Appmesh Controller policy to the role.
+func (o EKSAppmeshPolicyOutput) Controller() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSAppmeshPolicy) *bool { return v.Controller }).(pulumi.BoolPtrOutput)
 }
 
-// Determines whether to attach the Load Balancer Controller policy for the TargetGroupBinding only.
-func (o EKSLoadBalancerPolicyOutput) TargetGroupBindingOnly() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v EKSLoadBalancerPolicy) *bool { return v.TargetGroupBindingOnly }).(pulumi.BoolPtrOutput)
+// Determines whether to attach the Appmesh envoy proxy policy to the role.
+func (o EKSAppmeshPolicyOutput) EnvoyProxy() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSAppmeshPolicy) *bool { return v.EnvoyProxy }).(pulumi.BoolPtrOutput)
 }
 
-type EKSLoadBalancerPolicyPtrOutput struct{ *pulumi.OutputState }
+type EKSAppmeshPolicyPtrOutput struct{ *pulumi.OutputState }
 
-func (EKSLoadBalancerPolicyPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSLoadBalancerPolicy)(nil)).Elem()
+func (EKSAppmeshPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSAppmeshPolicy)(nil)).Elem()
 }
 
-func (o EKSLoadBalancerPolicyPtrOutput) ToEKSLoadBalancerPolicyPtrOutput() EKSLoadBalancerPolicyPtrOutput {
+func (o EKSAppmeshPolicyPtrOutput) ToEKSAppmeshPolicyPtrOutput() EKSAppmeshPolicyPtrOutput {
 	return o
 }
 
-func (o EKSLoadBalancerPolicyPtrOutput) ToEKSLoadBalancerPolicyPtrOutputWithContext(ctx context.Context) EKSLoadBalancerPolicyPtrOutput {
+func (o EKSAppmeshPolicyPtrOutput) ToEKSAppmeshPolicyPtrOutputWithContext(ctx context.Context) EKSAppmeshPolicyPtrOutput {
 	return o
 }
 
-func (o EKSLoadBalancerPolicyPtrOutput) Elem() EKSLoadBalancerPolicyOutput {
-	return o.ApplyT(func(v *EKSLoadBalancerPolicy) EKSLoadBalancerPolicy {
+func (o EKSAppmeshPolicyPtrOutput) Elem() EKSAppmeshPolicyOutput {
+	return o.ApplyT(func(v *EKSAppmeshPolicy) EKSAppmeshPolicy {
 		if v != nil {
 			return *v
 		}
-		var ret EKSLoadBalancerPolicy
+		var ret EKSAppmeshPolicy
 		return ret
-	}).(EKSLoadBalancerPolicyOutput)
+	}).(EKSAppmeshPolicyOutput)
 }
 
-// Determines whether to attach the Load Balancer Controller policy to the role.
-func (o EKSLoadBalancerPolicyPtrOutput) Controller() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSLoadBalancerPolicy) *bool {
+// Determines whether to attach the Appmesh Controller policy to the role.
+func (o EKSAppmeshPolicyPtrOutput) Controller() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSAppmeshPolicy) *bool {
 		if v == nil {
 			return nil
 		}
@@ -2261,500 +2473,2943 @@ func (o EKSLoadBalancerPolicyPtrOutput) Controller() pulumi.BoolPtrOutput {
 	}).(pulumi.BoolPtrOutput)
 }
 
-// Determines whether to attach the Load Balancer Controller policy for the TargetGroupBinding only.
-func (o EKSLoadBalancerPolicyPtrOutput) TargetGroupBindingOnly() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSLoadBalancerPolicy) *bool {
+// Determines whether to attach the Appmesh envoy proxy policy to the role.
+func (o EKSAppmeshPolicyPtrOutput) EnvoyProxy() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSAppmeshPolicy) *bool {
 		if v == nil {
 			return nil
 		}
-		return v.TargetGroupBindingOnly
+		return v.EnvoyProxy
 	}).(pulumi.BoolPtrOutput)
 }
 
-// The Node Termination Handler policy to the role.
-type EKSNodeTerminationHandlerPolicy struct {
-	// Determines whether to attach the Node Termination Handler policy to the role.
-	Attach bool `pulumi:"attach"`
-	// List of SQS ARNs that contain node termination events. If not provided, then a default
-	// ARN of "*" will be provided.
-	SqsQueueArns []string `pulumi:"sqsQueueArns"`
+// The Cert Manager IAM policy to attach to the role.
+type EKSCertManagerPolicy struct {
+	// Determines whether to attach the Cert Manager IAM policy to the role.
+	Attach *bool `pulumi:"attach"`
+	// Route53 hosted zone ARNs to allow Cert manager to manage records. If not provided,
+	// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
+	HostedZoneArns []string `pulumi:"hostedZoneArns"`
 }
 
-// EKSNodeTerminationHandlerPolicyInput is an input type that accepts EKSNodeTerminationHandlerPolicyArgs and EKSNodeTerminationHandlerPolicyOutput values.
-// You can construct a concrete instance of `EKSNodeTerminationHandlerPolicyInput` via:
+// EKSCertManagerPolicyInput is an input type that accepts EKSCertManagerPolicyArgs and EKSCertManagerPolicyOutput values.
+// You can construct a concrete instance of `EKSCertManagerPolicyInput` via:
 //
-//	EKSNodeTerminationHandlerPolicyArgs{...}
-type EKSNodeTerminationHandlerPolicyInput interface {
+//	EKSCertManagerPolicyArgs{...}
+type EKSCertManagerPolicyInput interface {
 	pulumi.Input
 
-	ToEKSNodeTerminationHandlerPolicyOutput() EKSNodeTerminationHandlerPolicyOutput
-	ToEKSNodeTerminationHandlerPolicyOutputWithContext(context.Context) EKSNodeTerminationHandlerPolicyOutput
+	ToEKSCertManagerPolicyOutput() EKSCertManagerPolicyOutput
+	ToEKSCertManagerPolicyOutputWithContext(context.Context) EKSCertManagerPolicyOutput
 }
 
-// The Node Termination Handler policy to the role.
-type EKSNodeTerminationHandlerPolicyArgs struct {
-	// Determines whether to attach the Node Termination Handler policy to the role.
-	Attach pulumi.BoolInput `pulumi:"attach"`
-	// List of SQS ARNs that contain node termination events. If not provided, then a default
-	// ARN of "*" will be provided.
-	SqsQueueArns pulumi.StringArrayInput `pulumi:"sqsQueueArns"`
+// The Cert Manager IAM policy to attach to the role.
+type EKSCertManagerPolicyArgs struct {
+	// Determines whether to attach the Cert Manager IAM policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+	// Route53 hosted zone ARNs to allow Cert manager to manage records. If not provided,
+	// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
+	HostedZoneArns pulumi.StringArrayInput `pulumi:"hostedZoneArns"`
 }
 
-func (EKSNodeTerminationHandlerPolicyArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSNodeTerminationHandlerPolicy)(nil)).Elem()
+func (EKSCertManagerPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSCertManagerPolicy)(nil)).Elem()
 }
 
-func (i EKSNodeTerminationHandlerPolicyArgs) ToEKSNodeTerminationHandlerPolicyOutput() EKSNodeTerminationHandlerPolicyOutput {
-	return i.ToEKSNodeTerminationHandlerPolicyOutputWithContext(context.Background())
+func (i EKSCertManagerPolicyArgs) ToEKSCertManagerPolicyOutput() EKSCertManagerPolicyOutput {
+	return i.ToEKSCertManagerPolicyOutputWithContext(context.Background())
 }
 
-func (i EKSNodeTerminationHandlerPolicyArgs) ToEKSNodeTerminationHandlerPolicyOutputWithContext(ctx context.Context) EKSNodeTerminationHandlerPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSNodeTerminationHandlerPolicyOutput)
+func (i EKSCertManagerPolicyArgs) ToEKSCertManagerPolicyOutputWithContext(ctx context.Context) EKSCertManagerPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSCertManagerPolicyOutput)
 }
 
-func (i EKSNodeTerminationHandlerPolicyArgs) ToEKSNodeTerminationHandlerPolicyPtrOutput() EKSNodeTerminationHandlerPolicyPtrOutput {
-	return i.ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(context.Background())
+func (i EKSCertManagerPolicyArgs) ToEKSCertManagerPolicyPtrOutput() EKSCertManagerPolicyPtrOutput {
+	return i.ToEKSCertManagerPolicyPtrOutputWithContext(context.Background())
 }
 
-func (i EKSNodeTerminationHandlerPolicyArgs) ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(ctx context.Context) EKSNodeTerminationHandlerPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSNodeTerminationHandlerPolicyOutput).ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(ctx)
+func (i EKSCertManagerPolicyArgs) ToEKSCertManagerPolicyPtrOutputWithContext(ctx context.Context) EKSCertManagerPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSCertManagerPolicyOutput).ToEKSCertManagerPolicyPtrOutputWithContext(ctx)
 }
 
-// EKSNodeTerminationHandlerPolicyPtrInput is an input type that accepts EKSNodeTerminationHandlerPolicyArgs, EKSNodeTerminationHandlerPolicyPtr and EKSNodeTerminationHandlerPolicyPtrOutput values.
-// You can construct a concrete instance of `EKSNodeTerminationHandlerPolicyPtrInput` via:
+// EKSCertManagerPolicyPtrInput is an input type that accepts EKSCertManagerPolicyArgs, EKSCertManagerPolicyPtr and EKSCertManagerPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSCertManagerPolicyPtrInput` via:
 //
-//	        EKSNodeTerminationHandlerPolicyArgs{...}
+//	        EKSCertManagerPolicyArgs{...}
 //
 //	or:
 //
 //	        nil
-type EKSNodeTerminationHandlerPolicyPtrInput interface {
+type EKSCertManagerPolicyPtrInput interface {
 	pulumi.Input
 
-	ToEKSNodeTerminationHandlerPolicyPtrOutput() EKSNodeTerminationHandlerPolicyPtrOutput
-	ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(context.Context) EKSNodeTerminationHandlerPolicyPtrOutput
+	ToEKSCertManagerPolicyPtrOutput() EKSCertManagerPolicyPtrOutput
+	ToEKSCertManagerPolicyPtrOutputWithContext(context.Context) EKSCertManagerPolicyPtrOutput
 }
 
-type eksnodeTerminationHandlerPolicyPtrType EKSNodeTerminationHandlerPolicyArgs
+type ekscertManagerPolicyPtrType EKSCertManagerPolicyArgs
 
-func EKSNodeTerminationHandlerPolicyPtr(v *EKSNodeTerminationHandlerPolicyArgs) EKSNodeTerminationHandlerPolicyPtrInput {
-	return (*eksnodeTerminationHandlerPolicyPtrType)(v)
+func EKSCertManagerPolicyPtr(v *EKSCertManagerPolicyArgs) EKSCertManagerPolicyPtrInput {
+	return (*ekscertManagerPolicyPtrType)(v)
 }
 
-func (*eksnodeTerminationHandlerPolicyPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSNodeTerminationHandlerPolicy)(nil)).Elem()
+func (*ekscertManagerPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSCertManagerPolicy)(nil)).Elem()
 }
 
-func (i *eksnodeTerminationHandlerPolicyPtrType) ToEKSNodeTerminationHandlerPolicyPtrOutput() EKSNodeTerminationHandlerPolicyPtrOutput {
-	return i.ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(context.Background())
+func (i *ekscertManagerPolicyPtrType) ToEKSCertManagerPolicyPtrOutput() EKSCertManagerPolicyPtrOutput {
+	return i.ToEKSCertManagerPolicyPtrOutputWithContext(context.Background())
 }
 
-func (i *eksnodeTerminationHandlerPolicyPtrType) ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(ctx context.Context) EKSNodeTerminationHandlerPolicyPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSNodeTerminationHandlerPolicyPtrOutput)
+func (i *ekscertManagerPolicyPtrType) ToEKSCertManagerPolicyPtrOutputWithContext(ctx context.Context) EKSCertManagerPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSCertManagerPolicyPtrOutput)
 }
 
-// The Node Termination Handler policy to the role.
-type EKSNodeTerminationHandlerPolicyOutput struct{ *pulumi.OutputState }
+// The Cert Manager IAM policy to attach to the role.
+type EKSCertManagerPolicyOutput struct{ *pulumi.OutputState }
 
-func (EKSNodeTerminationHandlerPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSNodeTerminationHandlerPolicy)(nil)).Elem()
+func (EKSCertManagerPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSCertManagerPolicy)(nil)).Elem()
 }
 
-func (o EKSNodeTerminationHandlerPolicyOutput) ToEKSNodeTerminationHandlerPolicyOutput() EKSNodeTerminationHandlerPolicyOutput {
+func (o EKSCertManagerPolicyOutput) ToEKSCertManagerPolicyOutput() EKSCertManagerPolicyOutput {
 	return o
 }
 
-func (o EKSNodeTerminationHandlerPolicyOutput) ToEKSNodeTerminationHandlerPolicyOutputWithContext(ctx context.Context) EKSNodeTerminationHandlerPolicyOutput {
+func (o EKSCertManagerPolicyOutput) ToEKSCertManagerPolicyOutputWithContext(ctx context.Context) EKSCertManagerPolicyOutput {
 	return o
 }
 
-func (o EKSNodeTerminationHandlerPolicyOutput) ToEKSNodeTerminationHandlerPolicyPtrOutput() EKSNodeTerminationHandlerPolicyPtrOutput {
-	return o.ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(context.Background())
+func (o EKSCertManagerPolicyOutput) ToEKSCertManagerPolicyPtrOutput() EKSCertManagerPolicyPtrOutput {
+	return o.ToEKSCertManagerPolicyPtrOutputWithContext(context.Background())
 }
 
-func (o EKSNodeTerminationHandlerPolicyOutput) ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(ctx context.Context) EKSNodeTerminationHandlerPolicyPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSNodeTerminationHandlerPolicy) *EKSNodeTerminationHandlerPolicy {
+func (o EKSCertManagerPolicyOutput) ToEKSCertManagerPolicyPtrOutputWithContext(ctx context.Context) EKSCertManagerPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSCertManagerPolicy) *EKSCertManagerPolicy {
 		return &v
-	}).(EKSNodeTerminationHandlerPolicyPtrOutput)
+	}).(EKSCertManagerPolicyPtrOutput)
 }
 
-// Determines whether to attach the Node Termination Handler policy to the role.
-func (o EKSNodeTerminationHandlerPolicyOutput) Attach() pulumi.BoolOutput {
-	return o.ApplyT(func(v EKSNodeTerminationHandlerPolicy) bool { return v.Attach }).(pulumi.BoolOutput)
+// Determines whether to attach the Cert Manager IAM policy to the role.
+func (o EKSCertManagerPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSCertManagerPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
 }
 
-// List of SQS ARNs that contain node termination events. If not provided, then a default
-// ARN of "*" will be provided.
-func (o EKSNodeTerminationHandlerPolicyOutput) SqsQueueArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v EKSNodeTerminationHandlerPolicy) []string { return v.SqsQueueArns }).(pulumi.StringArrayOutput)
+// Route53 hosted zone ARNs to allow Cert manager to manage records. If not provided,
+// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
+func (o EKSCertManagerPolicyOutput) HostedZoneArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSCertManagerPolicy) []string { return v.HostedZoneArns }).(pulumi.StringArrayOutput)
 }
 
-type EKSNodeTerminationHandlerPolicyPtrOutput struct{ *pulumi.OutputState }
+type EKSCertManagerPolicyPtrOutput struct{ *pulumi.OutputState }
 
-func (EKSNodeTerminationHandlerPolicyPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSNodeTerminationHandlerPolicy)(nil)).Elem()
+func (EKSCertManagerPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSCertManagerPolicy)(nil)).Elem()
 }
 
-func (o EKSNodeTerminationHandlerPolicyPtrOutput) ToEKSNodeTerminationHandlerPolicyPtrOutput() EKSNodeTerminationHandlerPolicyPtrOutput {
+func (o EKSCertManagerPolicyPtrOutput) ToEKSCertManagerPolicyPtrOutput() EKSCertManagerPolicyPtrOutput {
 	return o
 }
 
-func (o EKSNodeTerminationHandlerPolicyPtrOutput) ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(ctx context.Context) EKSNodeTerminationHandlerPolicyPtrOutput {
+func (o EKSCertManagerPolicyPtrOutput) ToEKSCertManagerPolicyPtrOutputWithContext(ctx context.Context) EKSCertManagerPolicyPtrOutput {
 	return o
 }
 
-func (o EKSNodeTerminationHandlerPolicyPtrOutput) Elem() EKSNodeTerminationHandlerPolicyOutput {
-	return o.ApplyT(func(v *EKSNodeTerminationHandlerPolicy) EKSNodeTerminationHandlerPolicy {
+func (o EKSCertManagerPolicyPtrOutput) Elem() EKSCertManagerPolicyOutput {
+	return o.ApplyT(func(v *EKSCertManagerPolicy) EKSCertManagerPolicy {
 		if v != nil {
 			return *v
 		}
-		var ret EKSNodeTerminationHandlerPolicy
+		var ret EKSCertManagerPolicy
 		return ret
-	}).(EKSNodeTerminationHandlerPolicyOutput)
+	}).(EKSCertManagerPolicyOutput)
 }
 
-// Determines whether to attach the Node Termination Handler policy to the role.
-func (o EKSNodeTerminationHandlerPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *EKSNodeTerminationHandlerPolicy) *bool {
+// Determines whether to attach the Cert Manager IAM policy to the role.
+func (o EKSCertManagerPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSCertManagerPolicy) *bool {
 		if v == nil {
 			return nil
 		}
-		return &v.Attach
+		return v.Attach
 	}).(pulumi.BoolPtrOutput)
 }
 
-// List of SQS ARNs that contain node termination events. If not provided, then a default
-// ARN of "*" will be provided.
-func (o EKSNodeTerminationHandlerPolicyPtrOutput) SqsQueueArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *EKSNodeTerminationHandlerPolicy) []string {
+// Route53 hosted zone ARNs to allow Cert manager to manage records. If not provided,
+// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
+func (o EKSCertManagerPolicyPtrOutput) HostedZoneArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSCertManagerPolicy) []string {
 		if v == nil {
 			return nil
 		}
-		return v.SqsQueueArns
+		return v.HostedZoneArns
 	}).(pulumi.StringArrayOutput)
 }
 
-// The different policies to attach to the role.
-type EKSRolePolicies struct {
-	// The Amazon Managed Service for Prometheus IAM policy.
-	AmazonManagedServicePrometheus *EKSAmazonManagedServicePrometheusPolicy `pulumi:"amazonManagedServicePrometheus"`
-	// The Appmesh policies.
-	Appmesh *EKSAppmeshPolicy `pulumi:"appmesh"`
-	// The Cert Manager IAM policy.
-	CertManager *EKSCertManagerPolicy `pulumi:"certManager"`
-	// The Cluster Autoscaler IAM policy.
-	ClusterAutoScaling *EKSClusterAutoscalerPolicy `pulumi:"clusterAutoScaling"`
-	// The EBS CSI IAM policy.
-	EbsCsi *EKSEBSCSIPolicy `pulumi:"ebsCsi"`
-	// The EFS CSI IAM policy.
-	EfsCsi *EKSEFSCSIPolicy `pulumi:"efsCsi"`
-	// The External DNS IAM policy.
-	ExternalDns *EKSExternalDNSPolicy `pulumi:"externalDns"`
-	// The External Secrets policy.
-	ExternalSecrets *EKSExternalSecretsPolicy `pulumi:"externalSecrets"`
-	// The FSx for Lustre CSI Driver IAM policy.
-	FsxLustreCsi *FSxLustreCSIPolicy `pulumi:"fsxLustreCsi"`
-	// The Karpenter Controller policy.
-	KarpenterController *EKSKarpenterControllerPolicy `pulumi:"karpenterController"`
-	// The Load Balancer policy.
-	LoadBalancer *EKSLoadBalancerPolicy `pulumi:"loadBalancer"`
-	// The Node Termination Handler policy to the role.
-	NodeTerminationHandler *EKSNodeTerminationHandlerPolicy `pulumi:"nodeTerminationHandler"`
-	// The Velero IAM policy.
-	Velero *EKSVeleroPolicy `pulumi:"velero"`
-	// The VPC CNI IAM policy to the role.
-	VpnCni *EKSVPNCNIPolicy `pulumi:"vpnCni"`
-}
-
-// Defaults sets the appropriate defaults for EKSRolePolicies
-func (val *EKSRolePolicies) Defaults() *EKSRolePolicies {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	tmp.KarpenterController = tmp.KarpenterController.Defaults()
-
-	return &tmp
+// The CloudWatch Observability IAM policy to the role.
+type EKSCloudWatchObservabilityPolicy struct {
+	// Determines whether to attach the CloudWatch Observability IAM policy to the role.
+	Attach *bool `pulumi:"attach"`
 }
 
-// EKSRolePoliciesInput is an input type that accepts EKSRolePoliciesArgs and EKSRolePoliciesOutput values.
-// You can construct a concrete instance of `EKSRolePoliciesInput` via:
+// EKSCloudWatchObservabilityPolicyInput is an input type that accepts EKSCloudWatchObservabilityPolicyArgs and EKSCloudWatchObservabilityPolicyOutput values.
+// You can construct a concrete instance of `EKSCloudWatchObservabilityPolicyInput` via:
 //
-//	EKSRolePoliciesArgs{...}
-type EKSRolePoliciesInput interface {
+//	EKSCloudWatchObservabilityPolicyArgs{...}
+type EKSCloudWatchObservabilityPolicyInput interface {
 	pulumi.Input
 
-	ToEKSRolePoliciesOutput() EKSRolePoliciesOutput
-	ToEKSRolePoliciesOutputWithContext(context.Context) EKSRolePoliciesOutput
+	ToEKSCloudWatchObservabilityPolicyOutput() EKSCloudWatchObservabilityPolicyOutput
+	ToEKSCloudWatchObservabilityPolicyOutputWithContext(context.Context) EKSCloudWatchObservabilityPolicyOutput
 }
 
-// The different policies to attach to the role.
-type EKSRolePoliciesArgs struct {
-	// The Amazon Managed Service for Prometheus IAM policy.
-	AmazonManagedServicePrometheus EKSAmazonManagedServicePrometheusPolicyPtrInput `pulumi:"amazonManagedServicePrometheus"`
-	// The Appmesh policies.
-	Appmesh EKSAppmeshPolicyPtrInput `pulumi:"appmesh"`
-	// The Cert Manager IAM policy.
-	CertManager EKSCertManagerPolicyPtrInput `pulumi:"certManager"`
-	// The Cluster Autoscaler IAM policy.
-	ClusterAutoScaling EKSClusterAutoscalerPolicyPtrInput `pulumi:"clusterAutoScaling"`
-	// The EBS CSI IAM policy.
-	EbsCsi EKSEBSCSIPolicyPtrInput `pulumi:"ebsCsi"`
-	// The EFS CSI IAM policy.
-	EfsCsi EKSEFSCSIPolicyPtrInput `pulumi:"efsCsi"`
-	// The External DNS IAM policy.
-	ExternalDns EKSExternalDNSPolicyPtrInput `pulumi:"externalDns"`
-	// The External Secrets policy.
-	ExternalSecrets EKSExternalSecretsPolicyPtrInput `pulumi:"externalSecrets"`
-	// The FSx for Lustre CSI Driver IAM policy.
-	FsxLustreCsi FSxLustreCSIPolicyPtrInput `pulumi:"fsxLustreCsi"`
-	// The Karpenter Controller policy.
-	KarpenterController EKSKarpenterControllerPolicyPtrInput `pulumi:"karpenterController"`
-	// The Load Balancer policy.
-	LoadBalancer EKSLoadBalancerPolicyPtrInput `pulumi:"loadBalancer"`
-	// The Node Termination Handler policy to the role.
-	NodeTerminationHandler EKSNodeTerminationHandlerPolicyPtrInput `pulumi:"nodeTerminationHandler"`
-	// The Velero IAM policy.
-	Velero EKSVeleroPolicyPtrInput `pulumi:"velero"`
-	// The VPC CNI IAM policy to the role.
-	VpnCni EKSVPNCNIPolicyPtrInput `pulumi:"vpnCni"`
+// The CloudWatch Observability IAM policy to the role.
+type EKSCloudWatchObservabilityPolicyArgs struct {
+	// Determines whether to attach the CloudWatch Observability IAM policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
 }
 
-// Defaults sets the appropriate defaults for EKSRolePoliciesArgs
-func (val *EKSRolePoliciesArgs) Defaults() *EKSRolePoliciesArgs {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
+func (EKSCloudWatchObservabilityPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSCloudWatchObservabilityPolicy)(nil)).Elem()
+}
 
-	return &tmp
+func (i EKSCloudWatchObservabilityPolicyArgs) ToEKSCloudWatchObservabilityPolicyOutput() EKSCloudWatchObservabilityPolicyOutput {
+	return i.ToEKSCloudWatchObservabilityPolicyOutputWithContext(context.Background())
 }
-func (EKSRolePoliciesArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSRolePolicies)(nil)).Elem()
+
+func (i EKSCloudWatchObservabilityPolicyArgs) ToEKSCloudWatchObservabilityPolicyOutputWithContext(ctx context.Context) EKSCloudWatchObservabilityPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSCloudWatchObservabilityPolicyOutput)
 }
 
-func (i EKSRolePoliciesArgs) ToEKSRolePoliciesOutput() EKSRolePoliciesOutput {
-	return i.ToEKSRolePoliciesOutputWithContext(context.Background())
-}
-
-func (i EKSRolePoliciesArgs) ToEKSRolePoliciesOutputWithContext(ctx context.Context) EKSRolePoliciesOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSRolePoliciesOutput)
-}
-
-func (i EKSRolePoliciesArgs) ToEKSRolePoliciesPtrOutput() EKSRolePoliciesPtrOutput {
-	return i.ToEKSRolePoliciesPtrOutputWithContext(context.Background())
+func (i EKSCloudWatchObservabilityPolicyArgs) ToEKSCloudWatchObservabilityPolicyPtrOutput() EKSCloudWatchObservabilityPolicyPtrOutput {
+	return i.ToEKSCloudWatchObservabilityPolicyPtrOutputWithContext(context.Background())
 }
 
-func (i EKSRolePoliciesArgs) ToEKSRolePoliciesPtrOutputWithContext(ctx context.Context) EKSRolePoliciesPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSRolePoliciesOutput).ToEKSRolePoliciesPtrOutputWithContext(ctx)
+func (i EKSCloudWatchObservabilityPolicyArgs) ToEKSCloudWatchObservabilityPolicyPtrOutputWithContext(ctx context.Context) EKSCloudWatchObservabilityPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSCloudWatchObservabilityPolicyOutput).ToEKSCloudWatchObservabilityPolicyPtrOutputWithContext(ctx)
 }
 
-// EKSRolePoliciesPtrInput is an input type that accepts EKSRolePoliciesArgs, EKSRolePoliciesPtr and EKSRolePoliciesPtrOutput values.
-// You can construct a concrete instance of `EKSRolePoliciesPtrInput` via:
+// EKSCloudWatchObservabilityPolicyPtrInput is an input type that accepts EKSCloudWatchObservabilityPolicyArgs, EKSCloudWatchObservabilityPolicyPtr and EKSCloudWatchObservabilityPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSCloudWatchObservabilityPolicyPtrInput` via:
 //
-//	        EKSRolePoliciesArgs{...}
+//	        EKSCloudWatchObservabilityPolicyArgs{...}
 //
 //	or:
 //
 //	        nil
-type EKSRolePoliciesPtrInput interface {
+type EKSCloudWatchObservabilityPolicyPtrInput interface {
 	pulumi.Input
 
-	ToEKSRolePoliciesPtrOutput() EKSRolePoliciesPtrOutput
-	ToEKSRolePoliciesPtrOutputWithContext(context.Context) EKSRolePoliciesPtrOutput
+	ToEKSCloudWatchObservabilityPolicyPtrOutput() EKSCloudWatchObservabilityPolicyPtrOutput
+	ToEKSCloudWatchObservabilityPolicyPtrOutputWithContext(context.Context) EKSCloudWatchObservabilityPolicyPtrOutput
 }
 
-type eksrolePoliciesPtrType EKSRolePoliciesArgs
+type ekscloudWatchObservabilityPolicyPtrType EKSCloudWatchObservabilityPolicyArgs
 
-func EKSRolePoliciesPtr(v *EKSRolePoliciesArgs) EKSRolePoliciesPtrInput {
-	return (*eksrolePoliciesPtrType)(v)
+func EKSCloudWatchObservabilityPolicyPtr(v *EKSCloudWatchObservabilityPolicyArgs) EKSCloudWatchObservabilityPolicyPtrInput {
+	return (*ekscloudWatchObservabilityPolicyPtrType)(v)
 }
 
-func (*eksrolePoliciesPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSRolePolicies)(nil)).Elem()
+func (*ekscloudWatchObservabilityPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSCloudWatchObservabilityPolicy)(nil)).Elem()
 }
 
-func (i *eksrolePoliciesPtrType) ToEKSRolePoliciesPtrOutput() EKSRolePoliciesPtrOutput {
-	return i.ToEKSRolePoliciesPtrOutputWithContext(context.Background())
+func (i *ekscloudWatchObservabilityPolicyPtrType) ToEKSCloudWatchObservabilityPolicyPtrOutput() EKSCloudWatchObservabilityPolicyPtrOutput {
+	return i.ToEKSCloudWatchObservabilityPolicyPtrOutputWithContext(context.Background())
 }
 
-func (i *eksrolePoliciesPtrType) ToEKSRolePoliciesPtrOutputWithContext(ctx context.Context) EKSRolePoliciesPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSRolePoliciesPtrOutput)
+func (i *ekscloudWatchObservabilityPolicyPtrType) ToEKSCloudWatchObservabilityPolicyPtrOutputWithContext(ctx context.Context) EKSCloudWatchObservabilityPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSCloudWatchObservabilityPolicyPtrOutput)
 }
 
-// The different policies to attach to the role.
-type EKSRolePoliciesOutput struct{ *pulumi.OutputState }
+// The CloudWatch Observability IAM policy to the role.
+type EKSCloudWatchObservabilityPolicyOutput struct{ *pulumi.OutputState }
 
-func (EKSRolePoliciesOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSRolePolicies)(nil)).Elem()
+func (EKSCloudWatchObservabilityPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSCloudWatchObservabilityPolicy)(nil)).Elem()
 }
 
-func (o EKSRolePoliciesOutput) ToEKSRolePoliciesOutput() EKSRolePoliciesOutput {
+func (o EKSCloudWatchObservabilityPolicyOutput) ToEKSCloudWatchObservabilityPolicyOutput() EKSCloudWatchObservabilityPolicyOutput {
 	return o
 }
 
-func (o EKSRolePoliciesOutput) ToEKSRolePoliciesOutputWithContext(ctx context.Context) EKSRolePoliciesOutput {
+func (o EKSCloudWatchObservabilityPolicyOutput) ToEKSCloudWatchObservabilityPolicyOutputWithContext(ctx context.Context) EKSCloudWatchObservabilityPolicyOutput {
 	return o
 }
 
-func (o EKSRolePoliciesOutput) ToEKSRolePoliciesPtrOutput() EKSRolePoliciesPtrOutput {
-	return o.ToEKSRolePoliciesPtrOutputWithContext(context.Background())
+func (o EKSCloudWatchObservabilityPolicyOutput) ToEKSCloudWatchObservabilityPolicyPtrOutput() EKSCloudWatchObservabilityPolicyPtrOutput {
+	return o.ToEKSCloudWatchObservabilityPolicyPtrOutputWithContext(context.Background())
 }
 
-func (o EKSRolePoliciesOutput) ToEKSRolePoliciesPtrOutputWithContext(ctx context.Context) EKSRolePoliciesPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSRolePolicies) *EKSRolePolicies {
+func (o EKSCloudWatchObservabilityPolicyOutput) ToEKSCloudWatchObservabilityPolicyPtrOutputWithContext(ctx context.Context) EKSCloudWatchObservabilityPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSCloudWatchObservabilityPolicy) *EKSCloudWatchObservabilityPolicy {
 		return &v
-	}).(EKSRolePoliciesPtrOutput)
+	}).(EKSCloudWatchObservabilityPolicyPtrOutput)
 }
 
-// The Amazon Managed Service for Prometheus IAM policy.
-func (o EKSRolePoliciesOutput) AmazonManagedServicePrometheus() EKSAmazonManagedServicePrometheusPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSAmazonManagedServicePrometheusPolicy {
-		return v.AmazonManagedServicePrometheus
-	}).(EKSAmazonManagedServicePrometheusPolicyPtrOutput)
+// Determines whether to attach the CloudWatch Observability IAM policy to the role.
+func (o EKSCloudWatchObservabilityPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSCloudWatchObservabilityPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
 }
 
-// The Appmesh policies.
-func (o EKSRolePoliciesOutput) Appmesh() EKSAppmeshPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSAppmeshPolicy { return v.Appmesh }).(EKSAppmeshPolicyPtrOutput)
+type EKSCloudWatchObservabilityPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSCloudWatchObservabilityPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSCloudWatchObservabilityPolicy)(nil)).Elem()
 }
 
-// The Cert Manager IAM policy.
-func (o EKSRolePoliciesOutput) CertManager() EKSCertManagerPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSCertManagerPolicy { return v.CertManager }).(EKSCertManagerPolicyPtrOutput)
+func (o EKSCloudWatchObservabilityPolicyPtrOutput) ToEKSCloudWatchObservabilityPolicyPtrOutput() EKSCloudWatchObservabilityPolicyPtrOutput {
+	return o
 }
 
-// The Cluster Autoscaler IAM policy.
-func (o EKSRolePoliciesOutput) ClusterAutoScaling() EKSClusterAutoscalerPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSClusterAutoscalerPolicy { return v.ClusterAutoScaling }).(EKSClusterAutoscalerPolicyPtrOutput)
+func (o EKSCloudWatchObservabilityPolicyPtrOutput) ToEKSCloudWatchObservabilityPolicyPtrOutputWithContext(ctx context.Context) EKSCloudWatchObservabilityPolicyPtrOutput {
+	return o
 }
 
-// The EBS CSI IAM policy.
-func (o EKSRolePoliciesOutput) EbsCsi() EKSEBSCSIPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSEBSCSIPolicy { return v.EbsCsi }).(EKSEBSCSIPolicyPtrOutput)
+func (o EKSCloudWatchObservabilityPolicyPtrOutput) Elem() EKSCloudWatchObservabilityPolicyOutput {
+	return o.ApplyT(func(v *EKSCloudWatchObservabilityPolicy) EKSCloudWatchObservabilityPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret EKSCloudWatchObservabilityPolicy
+		return ret
+	}).(EKSCloudWatchObservabilityPolicyOutput)
 }
 
-// The EFS CSI IAM policy.
-func (o EKSRolePoliciesOutput) EfsCsi() EKSEFSCSIPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSEFSCSIPolicy { return v.EfsCsi }).(EKSEFSCSIPolicyPtrOutput)
+// Determines whether to attach the CloudWatch Observability IAM policy to the role.
+func (o EKSCloudWatchObservabilityPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSCloudWatchObservabilityPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Attach
+	}).(pulumi.BoolPtrOutput)
 }
 
-// The External DNS IAM policy.
-func (o EKSRolePoliciesOutput) ExternalDns() EKSExternalDNSPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSExternalDNSPolicy { return v.ExternalDns }).(EKSExternalDNSPolicyPtrOutput)
+// The Cluster Autoscaler IAM policy to the role.
+type EKSClusterAutoscalerPolicy struct {
+	// Determines whether to attach the Cluster Autoscaler IAM policy to the role.
+	Attach *bool `pulumi:"attach"`
+	// List of Auto Scaling group ARNs the Cluster Autoscaler can scale, e.g. the groups of specific node groups.
+	AutoScalingGroupArns []string `pulumi:"autoScalingGroupArns"`
+	// List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
+	ClusterIds []string `pulumi:"clusterIds"`
+	// Allows eks:DescribeNodegroup, used to discover the labels and taints of managed node groups scaled from zero.
+	EnableDescribeNodegroup *bool `pulumi:"enableDescribeNodegroup"`
+	// Only grants the read actions, e.g. for dashboards showing the autoscaler status. Without `clusterIds`,
+	// `autoScalingGroupArns` or `tagKeys` the policy is read only as well, with a warning.
+	ReadOnly *bool `pulumi:"readOnly"`
+	// List of tag keys which must all be present on the Auto Scaling groups the Cluster Autoscaler can scale,
+	// e.g. `k8s.io/cluster-autoscaler/enabled`. Combined with `clusterIds` and `autoScalingGroupArns` when given.
+	TagKeys []string `pulumi:"tagKeys"`
 }
 
-// The External Secrets policy.
-func (o EKSRolePoliciesOutput) ExternalSecrets() EKSExternalSecretsPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSExternalSecretsPolicy { return v.ExternalSecrets }).(EKSExternalSecretsPolicyPtrOutput)
+// EKSClusterAutoscalerPolicyInput is an input type that accepts EKSClusterAutoscalerPolicyArgs and EKSClusterAutoscalerPolicyOutput values.
+// You can construct a concrete instance of `EKSClusterAutoscalerPolicyInput` via:
+//
+//	EKSClusterAutoscalerPolicyArgs{...}
+type EKSClusterAutoscalerPolicyInput interface {
+	pulumi.Input
+
+	ToEKSClusterAutoscalerPolicyOutput() EKSClusterAutoscalerPolicyOutput
+	ToEKSClusterAutoscalerPolicyOutputWithContext(context.Context) EKSClusterAutoscalerPolicyOutput
 }
 
-// The FSx for Lustre CSI Driver IAM policy.
-func (o EKSRolePoliciesOutput) FsxLustreCsi() FSxLustreCSIPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *FSxLustreCSIPolicy { return v.FsxLustreCsi }).(FSxLustreCSIPolicyPtrOutput)
+// The Cluster Autoscaler IAM policy to the role.
+type EKSClusterAutoscalerPolicyArgs struct {
+	// Determines whether to attach the Cluster Autoscaler IAM policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+	// List of Auto Scaling group ARNs the Cluster Autoscaler can scale, e.g. the groups of specific node groups.
+	AutoScalingGroupArns pulumi.StringArrayInput `pulumi:"autoScalingGroupArns"`
+	// List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
+	ClusterIds pulumi.StringArrayInput `pulumi:"clusterIds"`
+	// Allows eks:DescribeNodegroup, used to discover the labels and taints of managed node groups scaled from zero.
+	EnableDescribeNodegroup pulumi.BoolPtrInput `pulumi:"enableDescribeNodegroup"`
+	// Only grants the read actions, e.g. for dashboards showing the autoscaler status. Without `clusterIds`,
+	// `autoScalingGroupArns` or `tagKeys` the policy is read only as well, with a warning.
+	ReadOnly pulumi.BoolPtrInput `pulumi:"readOnly"`
+	// List of tag keys which must all be present on the Auto Scaling groups the Cluster Autoscaler can scale,
+	// e.g. `k8s.io/cluster-autoscaler/enabled`. Combined with `clusterIds` and `autoScalingGroupArns` when given.
+	TagKeys pulumi.StringArrayInput `pulumi:"tagKeys"`
 }
 
-// The Karpenter Controller policy.
-func (o EKSRolePoliciesOutput) KarpenterController() EKSKarpenterControllerPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSKarpenterControllerPolicy { return v.KarpenterController }).(EKSKarpenterControllerPolicyPtrOutput)
+func (EKSClusterAutoscalerPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSClusterAutoscalerPolicy)(nil)).Elem()
 }
 
-// The Load Balancer policy.
-func (o EKSRolePoliciesOutput) LoadBalancer() EKSLoadBalancerPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSLoadBalancerPolicy { return v.LoadBalancer }).(EKSLoadBalancerPolicyPtrOutput)
+func (i EKSClusterAutoscalerPolicyArgs) ToEKSClusterAutoscalerPolicyOutput() EKSClusterAutoscalerPolicyOutput {
+	return i.ToEKSClusterAutoscalerPolicyOutputWithContext(context.Background())
 }
 
-// The Node Termination Handler policy to the role.
-func (o EKSRolePoliciesOutput) NodeTerminationHandler() EKSNodeTerminationHandlerPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSNodeTerminationHandlerPolicy { return v.NodeTerminationHandler }).(EKSNodeTerminationHandlerPolicyPtrOutput)
+func (i EKSClusterAutoscalerPolicyArgs) ToEKSClusterAutoscalerPolicyOutputWithContext(ctx context.Context) EKSClusterAutoscalerPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSClusterAutoscalerPolicyOutput)
 }
 
-// The Velero IAM policy.
-func (o EKSRolePoliciesOutput) Velero() EKSVeleroPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSVeleroPolicy { return v.Velero }).(EKSVeleroPolicyPtrOutput)
+func (i EKSClusterAutoscalerPolicyArgs) ToEKSClusterAutoscalerPolicyPtrOutput() EKSClusterAutoscalerPolicyPtrOutput {
+	return i.ToEKSClusterAutoscalerPolicyPtrOutputWithContext(context.Background())
 }
 
-// The VPC CNI IAM policy to the role.
-func (o EKSRolePoliciesOutput) VpnCni() EKSVPNCNIPolicyPtrOutput {
-	return o.ApplyT(func(v EKSRolePolicies) *EKSVPNCNIPolicy { return v.VpnCni }).(EKSVPNCNIPolicyPtrOutput)
+func (i EKSClusterAutoscalerPolicyArgs) ToEKSClusterAutoscalerPolicyPtrOutputWithContext(ctx context.Context) EKSClusterAutoscalerPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSClusterAutoscalerPolicyOutput).ToEKSClusterAutoscalerPolicyPtrOutputWithContext(ctx)
 }
 
-type EKSRolePoliciesPtrOutput struct{ *pulumi.OutputState }
+// EKSClusterAutoscalerPolicyPtrInput is an input type that accepts EKSClusterAutoscalerPolicyArgs, EKSClusterAutoscalerPolicyPtr and EKSClusterAutoscalerPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSClusterAutoscalerPolicyPtrInput` via:
+//
+//	        EKSClusterAutoscalerPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSClusterAutoscalerPolicyPtrInput interface {
+	pulumi.Input
 
-func (EKSRolePoliciesPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**EKSRolePolicies)(nil)).Elem()
+	ToEKSClusterAutoscalerPolicyPtrOutput() EKSClusterAutoscalerPolicyPtrOutput
+	ToEKSClusterAutoscalerPolicyPtrOutputWithContext(context.Context) EKSClusterAutoscalerPolicyPtrOutput
 }
 
-func (o EKSRolePoliciesPtrOutput) ToEKSRolePoliciesPtrOutput() EKSRolePoliciesPtrOutput {
+type eksclusterAutoscalerPolicyPtrType EKSClusterAutoscalerPolicyArgs
+
+func EKSClusterAutoscalerPolicyPtr(v *EKSClusterAutoscalerPolicyArgs) EKSClusterAutoscalerPolicyPtrInput {
+	return (*eksclusterAutoscalerPolicyPtrType)(v)
+}
+
+func (*eksclusterAutoscalerPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSClusterAutoscalerPolicy)(nil)).Elem()
+}
+
+func (i *eksclusterAutoscalerPolicyPtrType) ToEKSClusterAutoscalerPolicyPtrOutput() EKSClusterAutoscalerPolicyPtrOutput {
+	return i.ToEKSClusterAutoscalerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *eksclusterAutoscalerPolicyPtrType) ToEKSClusterAutoscalerPolicyPtrOutputWithContext(ctx context.Context) EKSClusterAutoscalerPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSClusterAutoscalerPolicyPtrOutput)
+}
+
+// The Cluster Autoscaler IAM policy to the role.
+type EKSClusterAutoscalerPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSClusterAutoscalerPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSClusterAutoscalerPolicy)(nil)).Elem()
+}
+
+func (o EKSClusterAutoscalerPolicyOutput) ToEKSClusterAutoscalerPolicyOutput() EKSClusterAutoscalerPolicyOutput {
 	return o
 }
 
-func (o EKSRolePoliciesPtrOutput) ToEKSRolePoliciesPtrOutputWithContext(ctx context.Context) EKSRolePoliciesPtrOutput {
+func (o EKSClusterAutoscalerPolicyOutput) ToEKSClusterAutoscalerPolicyOutputWithContext(ctx context.Context) EKSClusterAutoscalerPolicyOutput {
 	return o
 }
 
-func (o EKSRolePoliciesPtrOutput) Elem() EKSRolePoliciesOutput {
-	return o.ApplyT(func(v *EKSRolePolicies) EKSRolePolicies {
+func (o EKSClusterAutoscalerPolicyOutput) ToEKSClusterAutoscalerPolicyPtrOutput() EKSClusterAutoscalerPolicyPtrOutput {
+	return o.ToEKSClusterAutoscalerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o EKSClusterAutoscalerPolicyOutput) ToEKSClusterAutoscalerPolicyPtrOutputWithContext(ctx context.Context) EKSClusterAutoscalerPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSClusterAutoscalerPolicy) *EKSClusterAutoscalerPolicy {
+		return &v
+	}).(EKSClusterAutoscalerPolicyPtrOutput)
+}
+
+// Determines whether to attach the Cluster Autoscaler IAM policy to the role.
+func (o EKSClusterAutoscalerPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSClusterAutoscalerPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
+}
+
+// List of Auto Scaling group ARNs the Cluster Autoscaler can scale, e.g. the groups of specific node groups.
+func (o EKSClusterAutoscalerPolicyOutput) AutoScalingGroupArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSClusterAutoscalerPolicy) []string { return v.AutoScalingGroupArns }).(pulumi.StringArrayOutput)
+}
+
+// List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
+func (o EKSClusterAutoscalerPolicyOutput) ClusterIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSClusterAutoscalerPolicy) []string { return v.ClusterIds }).(pulumi.StringArrayOutput)
+}
+
+// Allows eks:DescribeNodegroup, used to discover the labels and taints of managed node groups scaled from zero.
+func (o EKSClusterAutoscalerPolicyOutput) EnableDescribeNodegroup() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSClusterAutoscalerPolicy) *bool { return v.EnableDescribeNodegroup }).(pulumi.BoolPtrOutput)
+}
+
+// Only grants the read actions, e.g. for dashboards showing the autoscaler status. Without `clusterIds`,
+// `autoScalingGroupArns` or `tagKeys` the policy is read only as well, with a warning.
+func (o EKSClusterAutoscalerPolicyOutput) ReadOnly() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSClusterAutoscalerPolicy) *bool { return v.ReadOnly }).(pulumi.BoolPtrOutput)
+}
+
+// List of tag keys which must all be present on the Auto Scaling groups the Cluster Autoscaler can scale,
+// e.g. `k8s.io/cluster-autoscaler/enabled`. Combined with `clusterIds` and `autoScalingGroupArns` when given.
+func (o EKSClusterAutoscalerPolicyOutput) TagKeys() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSClusterAutoscalerPolicy) []string { return v.TagKeys }).(pulumi.StringArrayOutput)
+}
+
+type EKSClusterAutoscalerPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSClusterAutoscalerPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSClusterAutoscalerPolicy)(nil)).Elem()
+}
+
+func (o EKSClusterAutoscalerPolicyPtrOutput) ToEKSClusterAutoscalerPolicyPtrOutput() EKSClusterAutoscalerPolicyPtrOutput {
+	return o
+}
+
+func (o EKSClusterAutoscalerPolicyPtrOutput) ToEKSClusterAutoscalerPolicyPtrOutputWithContext(ctx context.Context) EKSClusterAutoscalerPolicyPtrOutput {
+	return o
+}
+
+func (o EKSClusterAutoscalerPolicyPtrOutput) Elem() EKSClusterAutoscalerPolicyOutput {
+	return o.ApplyT(func(v *EKSClusterAutoscalerPolicy) EKSClusterAutoscalerPolicy {
 		if v != nil {
 			return *v
 		}
-		var ret EKSRolePolicies
+		var ret EKSClusterAutoscalerPolicy
 		return ret
-	}).(EKSRolePoliciesOutput)
+	}).(EKSClusterAutoscalerPolicyOutput)
 }
 
-// The Amazon Managed Service for Prometheus IAM policy.
-func (o EKSRolePoliciesPtrOutput) AmazonManagedServicePrometheus() EKSAmazonManagedServicePrometheusPolicyPtrOutput {
-	return o.ApplyT(func(v *EKSRolePolicies) *EKSAmazonManagedServicePrometheusPolicy {
+// Determines whether to attach the Cluster Autoscaler IAM policy to the role.
+func (o EKSClusterAutoscalerPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSClusterAutoscalerPolicy) *bool {
 		if v == nil {
 			return nil
 		}
-		return v.AmazonManagedServicePrometheus
-	}).(EKSAmazonManagedServicePrometheusPolicyPtrOutput)
+		return v.Attach
+	}).(pulumi.BoolPtrOutput)
 }
 
-// The Appmesh policies.
-func (o EKSRolePoliciesPtrOutput) Appmesh() EKSAppmeshPolicyPtrOutput {
-	return o.ApplyT(func(v *EKSRolePolicies) *EKSAppmeshPolicy {
+// List of Auto Scaling group ARNs the Cluster Autoscaler can scale, e.g. the groups of specific node groups.
+func (o EKSClusterAutoscalerPolicyPtrOutput) AutoScalingGroupArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSClusterAutoscalerPolicy) []string {
 		if v == nil {
 			return nil
 		}
-		return v.Appmesh
-	}).(EKSAppmeshPolicyPtrOutput)
+		return v.AutoScalingGroupArns
+	}).(pulumi.StringArrayOutput)
 }
 
-// The Cert Manager IAM policy.
-func (o EKSRolePoliciesPtrOutput) CertManager() EKSCertManagerPolicyPtrOutput {
-	return o.ApplyT(func(v *EKSRolePolicies) *EKSCertManagerPolicy {
+// List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
+func (o EKSClusterAutoscalerPolicyPtrOutput) ClusterIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSClusterAutoscalerPolicy) []string {
 		if v == nil {
 			return nil
 		}
-		return v.CertManager
-	}).(EKSCertManagerPolicyPtrOutput)
+		return v.ClusterIds
+	}).(pulumi.StringArrayOutput)
 }
 
-// The Cluster Autoscaler IAM policy.
-func (o EKSRolePoliciesPtrOutput) ClusterAutoScaling() EKSClusterAutoscalerPolicyPtrOutput {
-	return o.ApplyT(func(v *EKSRolePolicies) *EKSClusterAutoscalerPolicy {
+// Allows eks:DescribeNodegroup, used to discover the labels and taints of managed node groups scaled from zero.
+func (o EKSClusterAutoscalerPolicyPtrOutput) EnableDescribeNodegroup() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSClusterAutoscalerPolicy) *bool {
 		if v == nil {
 			return nil
 		}
-		return v.ClusterAutoScaling
-	}).(EKSClusterAutoscalerPolicyPtrOutput)
+		return v.EnableDescribeNodegroup
+	}).(pulumi.BoolPtrOutput)
 }
 
-// The EBS CSI IAM policy.
-func (o EKSRolePoliciesPtrOutput) EbsCsi() EKSEBSCSIPolicyPtrOutput {
-	return o.ApplyT(func(v *EKSRolePolicies) *EKSEBSCSIPolicy {
+// Only grants the read actions, e.g. for dashboards showing the autoscaler status. Without `clusterIds`,
+// `autoScalingGroupArns` or `tagKeys` the policy is read only as well, with a warning.
+func (o EKSClusterAutoscalerPolicyPtrOutput) ReadOnly() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSClusterAutoscalerPolicy) *bool {
 		if v == nil {
 			return nil
 		}
-		return v.EbsCsi
-	}).(EKSEBSCSIPolicyPtrOutput)
+		return v.ReadOnly
+	}).(pulumi.BoolPtrOutput)
 }
 
-// The EFS CSI IAM policy.
-func (o EKSRolePoliciesPtrOutput) EfsCsi() EKSEFSCSIPolicyPtrOutput {
+// List of tag keys which must all be present on the Auto Scaling groups the Cluster Autoscaler can scale,
+// e.g. `k8s.io/cluster-autoscaler/enabled`. Combined with `clusterIds` and `autoScalingGroupArns` when given.
+func (o EKSClusterAutoscalerPolicyPtrOutput) TagKeys() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSClusterAutoscalerPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.TagKeys
+	}).(pulumi.StringArrayOutput)
+}
+
+// The EBS CSI IAM policy to the role.
+type EKSEBSCSIPolicy struct {
+	// Determines whether to attach the EBS CSI IAM policy to the role.
+	Attach *bool `pulumi:"attach"`
+	// KMS CMK IDs to allow EBS CSI to manage encrypted volumes.
+	KmsCmkIds []string `pulumi:"kmsCmkIds"`
+}
+
+// EKSEBSCSIPolicyInput is an input type that accepts EKSEBSCSIPolicyArgs and EKSEBSCSIPolicyOutput values.
+// You can construct a concrete instance of `EKSEBSCSIPolicyInput` via:
+//
+//	EKSEBSCSIPolicyArgs{...}
+type EKSEBSCSIPolicyInput interface {
+	pulumi.Input
+
+	ToEKSEBSCSIPolicyOutput() EKSEBSCSIPolicyOutput
+	ToEKSEBSCSIPolicyOutputWithContext(context.Context) EKSEBSCSIPolicyOutput
+}
+
+// The EBS CSI IAM policy to the role.
+type EKSEBSCSIPolicyArgs struct {
+	// Determines whether to attach the EBS CSI IAM policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+	// KMS CMK IDs to allow EBS CSI to manage encrypted volumes.
+	KmsCmkIds pulumi.StringArrayInput `pulumi:"kmsCmkIds"`
+}
+
+func (EKSEBSCSIPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSEBSCSIPolicy)(nil)).Elem()
+}
+
+func (i EKSEBSCSIPolicyArgs) ToEKSEBSCSIPolicyOutput() EKSEBSCSIPolicyOutput {
+	return i.ToEKSEBSCSIPolicyOutputWithContext(context.Background())
+}
+
+func (i EKSEBSCSIPolicyArgs) ToEKSEBSCSIPolicyOutputWithContext(ctx context.Context) EKSEBSCSIPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSEBSCSIPolicyOutput)
+}
+
+func (i EKSEBSCSIPolicyArgs) ToEKSEBSCSIPolicyPtrOutput() EKSEBSCSIPolicyPtrOutput {
+	return i.ToEKSEBSCSIPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i EKSEBSCSIPolicyArgs) ToEKSEBSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEBSCSIPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSEBSCSIPolicyOutput).ToEKSEBSCSIPolicyPtrOutputWithContext(ctx)
+}
+
+// EKSEBSCSIPolicyPtrInput is an input type that accepts EKSEBSCSIPolicyArgs, EKSEBSCSIPolicyPtr and EKSEBSCSIPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSEBSCSIPolicyPtrInput` via:
+//
+//	        EKSEBSCSIPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSEBSCSIPolicyPtrInput interface {
+	pulumi.Input
+
+	ToEKSEBSCSIPolicyPtrOutput() EKSEBSCSIPolicyPtrOutput
+	ToEKSEBSCSIPolicyPtrOutputWithContext(context.Context) EKSEBSCSIPolicyPtrOutput
+}
+
+type eksebscsipolicyPtrType EKSEBSCSIPolicyArgs
+
+func EKSEBSCSIPolicyPtr(v *EKSEBSCSIPolicyArgs) EKSEBSCSIPolicyPtrInput {
+	return (*eksebscsipolicyPtrType)(v)
+}
+
+func (*eksebscsipolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSEBSCSIPolicy)(nil)).Elem()
+}
+
+func (i *eksebscsipolicyPtrType) ToEKSEBSCSIPolicyPtrOutput() EKSEBSCSIPolicyPtrOutput {
+	return i.ToEKSEBSCSIPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *eksebscsipolicyPtrType) ToEKSEBSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEBSCSIPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSEBSCSIPolicyPtrOutput)
+}
+
+// The EBS CSI IAM policy to the role.
+type EKSEBSCSIPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSEBSCSIPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSEBSCSIPolicy)(nil)).Elem()
+}
+
+func (o EKSEBSCSIPolicyOutput) ToEKSEBSCSIPolicyOutput() EKSEBSCSIPolicyOutput {
+	return o
+}
+
+func (o EKSEBSCSIPolicyOutput) ToEKSEBSCSIPolicyOutputWithContext(ctx context.Context) EKSEBSCSIPolicyOutput {
+	return o
+}
+
+func (o EKSEBSCSIPolicyOutput) ToEKSEBSCSIPolicyPtrOutput() EKSEBSCSIPolicyPtrOutput {
+	return o.ToEKSEBSCSIPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o EKSEBSCSIPolicyOutput) ToEKSEBSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEBSCSIPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSEBSCSIPolicy) *EKSEBSCSIPolicy {
+		return &v
+	}).(EKSEBSCSIPolicyPtrOutput)
+}
+
+// Determines whether to attach the EBS CSI IAM policy to the role.
+func (o EKSEBSCSIPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSEBSCSIPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
+}
+
+// KMS CMK IDs to allow EBS CSI to manage encrypted volumes.
+func (o EKSEBSCSIPolicyOutput) KmsCmkIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSEBSCSIPolicy) []string { return v.KmsCmkIds }).(pulumi.StringArrayOutput)
+}
+
+type EKSEBSCSIPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSEBSCSIPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSEBSCSIPolicy)(nil)).Elem()
+}
+
+func (o EKSEBSCSIPolicyPtrOutput) ToEKSEBSCSIPolicyPtrOutput() EKSEBSCSIPolicyPtrOutput {
+	return o
+}
+
+func (o EKSEBSCSIPolicyPtrOutput) ToEKSEBSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEBSCSIPolicyPtrOutput {
+	return o
+}
+
+func (o EKSEBSCSIPolicyPtrOutput) Elem() EKSEBSCSIPolicyOutput {
+	return o.ApplyT(func(v *EKSEBSCSIPolicy) EKSEBSCSIPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret EKSEBSCSIPolicy
+		return ret
+	}).(EKSEBSCSIPolicyOutput)
+}
+
+// Determines whether to attach the EBS CSI IAM policy to the role.
+func (o EKSEBSCSIPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSEBSCSIPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Attach
+	}).(pulumi.BoolPtrOutput)
+}
+
+// KMS CMK IDs to allow EBS CSI to manage encrypted volumes.
+func (o EKSEBSCSIPolicyPtrOutput) KmsCmkIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSEBSCSIPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.KmsCmkIds
+	}).(pulumi.StringArrayOutput)
+}
+
+// The EFS CSI IAM policy to the role.
+type EKSEFSCSIPolicy struct {
+	// Determines whether to attach the EFS CSI IAM policy to the role.
+	Attach *bool `pulumi:"attach"`
+}
+
+// EKSEFSCSIPolicyInput is an input type that accepts EKSEFSCSIPolicyArgs and EKSEFSCSIPolicyOutput values.
+// You can construct a concrete instance of `EKSEFSCSIPolicyInput` via:
+//
+//	EKSEFSCSIPolicyArgs{...}
+type EKSEFSCSIPolicyInput interface {
+	pulumi.Input
+
+	ToEKSEFSCSIPolicyOutput() EKSEFSCSIPolicyOutput
+	ToEKSEFSCSIPolicyOutputWithContext(context.Context) EKSEFSCSIPolicyOutput
+}
+
+// The EFS CSI IAM policy to the role.
+type EKSEFSCSIPolicyArgs struct {
+	// Determines whether to attach the EFS CSI IAM policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+}
+
+func (EKSEFSCSIPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSEFSCSIPolicy)(nil)).Elem()
+}
+
+func (i EKSEFSCSIPolicyArgs) ToEKSEFSCSIPolicyOutput() EKSEFSCSIPolicyOutput {
+	return i.ToEKSEFSCSIPolicyOutputWithContext(context.Background())
+}
+
+func (i EKSEFSCSIPolicyArgs) ToEKSEFSCSIPolicyOutputWithContext(ctx context.Context) EKSEFSCSIPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSEFSCSIPolicyOutput)
+}
+
+func (i EKSEFSCSIPolicyArgs) ToEKSEFSCSIPolicyPtrOutput() EKSEFSCSIPolicyPtrOutput {
+	return i.ToEKSEFSCSIPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i EKSEFSCSIPolicyArgs) ToEKSEFSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEFSCSIPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSEFSCSIPolicyOutput).ToEKSEFSCSIPolicyPtrOutputWithContext(ctx)
+}
+
+// EKSEFSCSIPolicyPtrInput is an input type that accepts EKSEFSCSIPolicyArgs, EKSEFSCSIPolicyPtr and EKSEFSCSIPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSEFSCSIPolicyPtrInput` via:
+//
+//	        EKSEFSCSIPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSEFSCSIPolicyPtrInput interface {
+	pulumi.Input
+
+	ToEKSEFSCSIPolicyPtrOutput() EKSEFSCSIPolicyPtrOutput
+	ToEKSEFSCSIPolicyPtrOutputWithContext(context.Context) EKSEFSCSIPolicyPtrOutput
+}
+
+type eksefscsipolicyPtrType EKSEFSCSIPolicyArgs
+
+func EKSEFSCSIPolicyPtr(v *EKSEFSCSIPolicyArgs) EKSEFSCSIPolicyPtrInput {
+	return (*eksefscsipolicyPtrType)(v)
+}
+
+func (*eksefscsipolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSEFSCSIPolicy)(nil)).Elem()
+}
+
+func (i *eksefscsipolicyPtrType) ToEKSEFSCSIPolicyPtrOutput() EKSEFSCSIPolicyPtrOutput {
+	return i.ToEKSEFSCSIPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *eksefscsipolicyPtrType) ToEKSEFSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEFSCSIPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSEFSCSIPolicyPtrOutput)
+}
+
+// The EFS CSI IAM policy to the role.
+type EKSEFSCSIPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSEFSCSIPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSEFSCSIPolicy)(nil)).Elem()
+}
+
+func (o EKSEFSCSIPolicyOutput) ToEKSEFSCSIPolicyOutput() EKSEFSCSIPolicyOutput {
+	return o
+}
+
+func (o EKSEFSCSIPolicyOutput) ToEKSEFSCSIPolicyOutputWithContext(ctx context.Context) EKSEFSCSIPolicyOutput {
+	return o
+}
+
+func (o EKSEFSCSIPolicyOutput) ToEKSEFSCSIPolicyPtrOutput() EKSEFSCSIPolicyPtrOutput {
+	return o.ToEKSEFSCSIPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o EKSEFSCSIPolicyOutput) ToEKSEFSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEFSCSIPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSEFSCSIPolicy) *EKSEFSCSIPolicy {
+		return &v
+	}).(EKSEFSCSIPolicyPtrOutput)
+}
+
+// Determines whether to attach the EFS CSI IAM policy to the role.
+func (o EKSEFSCSIPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSEFSCSIPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
+}
+
+type EKSEFSCSIPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSEFSCSIPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSEFSCSIPolicy)(nil)).Elem()
+}
+
+func (o EKSEFSCSIPolicyPtrOutput) ToEKSEFSCSIPolicyPtrOutput() EKSEFSCSIPolicyPtrOutput {
+	return o
+}
+
+func (o EKSEFSCSIPolicyPtrOutput) ToEKSEFSCSIPolicyPtrOutputWithContext(ctx context.Context) EKSEFSCSIPolicyPtrOutput {
+	return o
+}
+
+func (o EKSEFSCSIPolicyPtrOutput) Elem() EKSEFSCSIPolicyOutput {
+	return o.ApplyT(func(v *EKSEFSCSIPolicy) EKSEFSCSIPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret EKSEFSCSIPolicy
+		return ret
+	}).(EKSEFSCSIPolicyOutput)
+}
+
+// Determines whether to attach the EFS CSI IAM policy to the role.
+func (o EKSEFSCSIPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSEFSCSIPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Attach
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The External DNS IAM policy to the role.
+type EKSExternalDNSPolicy struct {
+	// Determines whether to attach the External DNS IAM policy to the role.
+	Attach *bool `pulumi:"attach"`
+	// Route53 hosted zone ARNs to allow External DNS to manage records. If not provided,
+	// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
+	HostedZoneArns []string `pulumi:"hostedZoneArns"`
+}
+
+// EKSExternalDNSPolicyInput is an input type that accepts EKSExternalDNSPolicyArgs and EKSExternalDNSPolicyOutput values.
+// You can construct a concrete instance of `EKSExternalDNSPolicyInput` via:
+//
+//	EKSExternalDNSPolicyArgs{...}
+type EKSExternalDNSPolicyInput interface {
+	pulumi.Input
+
+	ToEKSExternalDNSPolicyOutput() EKSExternalDNSPolicyOutput
+	ToEKSExternalDNSPolicyOutputWithContext(context.Context) EKSExternalDNSPolicyOutput
+}
+
+// The External DNS IAM policy to the role.
+type EKSExternalDNSPolicyArgs struct {
+	// Determines whether to attach the External DNS IAM policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+	// Route53 hosted zone ARNs to allow External DNS to manage records. If not provided,
+	// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
+	HostedZoneArns pulumi.StringArrayInput `pulumi:"hostedZoneArns"`
+}
+
+func (EKSExternalDNSPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSExternalDNSPolicy)(nil)).Elem()
+}
+
+func (i EKSExternalDNSPolicyArgs) ToEKSExternalDNSPolicyOutput() EKSExternalDNSPolicyOutput {
+	return i.ToEKSExternalDNSPolicyOutputWithContext(context.Background())
+}
+
+func (i EKSExternalDNSPolicyArgs) ToEKSExternalDNSPolicyOutputWithContext(ctx context.Context) EKSExternalDNSPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSExternalDNSPolicyOutput)
+}
+
+func (i EKSExternalDNSPolicyArgs) ToEKSExternalDNSPolicyPtrOutput() EKSExternalDNSPolicyPtrOutput {
+	return i.ToEKSExternalDNSPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i EKSExternalDNSPolicyArgs) ToEKSExternalDNSPolicyPtrOutputWithContext(ctx context.Context) EKSExternalDNSPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSExternalDNSPolicyOutput).ToEKSExternalDNSPolicyPtrOutputWithContext(ctx)
+}
+
+// EKSExternalDNSPolicyPtrInput is an input type that accepts EKSExternalDNSPolicyArgs, EKSExternalDNSPolicyPtr and EKSExternalDNSPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSExternalDNSPolicyPtrInput` via:
+//
+//	        EKSExternalDNSPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSExternalDNSPolicyPtrInput interface {
+	pulumi.Input
+
+	ToEKSExternalDNSPolicyPtrOutput() EKSExternalDNSPolicyPtrOutput
+	ToEKSExternalDNSPolicyPtrOutputWithContext(context.Context) EKSExternalDNSPolicyPtrOutput
+}
+
+type eksexternalDNSPolicyPtrType EKSExternalDNSPolicyArgs
+
+func EKSExternalDNSPolicyPtr(v *EKSExternalDNSPolicyArgs) EKSExternalDNSPolicyPtrInput {
+	return (*eksexternalDNSPolicyPtrType)(v)
+}
+
+func (*eksexternalDNSPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSExternalDNSPolicy)(nil)).Elem()
+}
+
+func (i *eksexternalDNSPolicyPtrType) ToEKSExternalDNSPolicyPtrOutput() EKSExternalDNSPolicyPtrOutput {
+	return i.ToEKSExternalDNSPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *eksexternalDNSPolicyPtrType) ToEKSExternalDNSPolicyPtrOutputWithContext(ctx context.Context) EKSExternalDNSPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSExternalDNSPolicyPtrOutput)
+}
+
+// The External DNS IAM policy to the role.
+type EKSExternalDNSPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSExternalDNSPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSExternalDNSPolicy)(nil)).Elem()
+}
+
+func (o EKSExternalDNSPolicyOutput) ToEKSExternalDNSPolicyOutput() EKSExternalDNSPolicyOutput {
+	return o
+}
+
+func (o EKSExternalDNSPolicyOutput) ToEKSExternalDNSPolicyOutputWithContext(ctx context.Context) EKSExternalDNSPolicyOutput {
+	return o
+}
+
+func (o EKSExternalDNSPolicyOutput) ToEKSExternalDNSPolicyPtrOutput() EKSExternalDNSPolicyPtrOutput {
+	return o.ToEKSExternalDNSPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o EKSExternalDNSPolicyOutput) ToEKSExternalDNSPolicyPtrOutputWithContext(ctx context.Context) EKSExternalDNSPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSExternalDNSPolicy) *EKSExternalDNSPolicy {
+		return &v
+	}).(EKSExternalDNSPolicyPtrOutput)
+}
+
+// Determines whether to attach the External DNS IAM policy to the role.
+func (o EKSExternalDNSPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSExternalDNSPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
+}
+
+// Route53 hosted zone ARNs to allow External DNS to manage records. If not provided,
+// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
+func (o EKSExternalDNSPolicyOutput) HostedZoneArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSExternalDNSPolicy) []string { return v.HostedZoneArns }).(pulumi.StringArrayOutput)
+}
+
+type EKSExternalDNSPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSExternalDNSPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSExternalDNSPolicy)(nil)).Elem()
+}
+
+func (o EKSExternalDNSPolicyPtrOutput) ToEKSExternalDNSPolicyPtrOutput() EKSExternalDNSPolicyPtrOutput {
+	return o
+}
+
+func (o EKSExternalDNSPolicyPtrOutput) ToEKSExternalDNSPolicyPtrOutputWithContext(ctx context.Context) EKSExternalDNSPolicyPtrOutput {
+	return o
+}
+
+func (o EKSExternalDNSPolicyPtrOutput) Elem() EKSExternalDNSPolicyOutput {
+	return o.ApplyT(func(v *EKSExternalDNSPolicy) EKSExternalDNSPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret EKSExternalDNSPolicy
+		return ret
+	}).(EKSExternalDNSPolicyOutput)
+}
+
+// Determines whether to attach the External DNS IAM policy to the role.
+func (o EKSExternalDNSPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSExternalDNSPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Attach
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Route53 hosted zone ARNs to allow External DNS to manage records. If not provided,
+// the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
+func (o EKSExternalDNSPolicyPtrOutput) HostedZoneArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSExternalDNSPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.HostedZoneArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// The External Secrets policy to the role.
+type EKSExternalSecretsPolicy struct {
+	// Determines whether to attach the External Secrets policy to the role.
+	Attach *bool `pulumi:"attach"`
+	// List of Secrets Manager ARNs that contain secrets to mount using External Secrets. If not provided, the default ARN "arn:aws:secretsmanager:*:*:secret:*" will be applied.
+	SecretsManagerArns []string `pulumi:"secretsManagerArns"`
+	// List of Systems Manager Parameter ARNs that contain secrets to mount using External Secrets. If not provided,
+	// the default ARN "arn:aws:ssm:*:*:parameter/*" will be applied.
+	SsmParameterArns []string `pulumi:"ssmParameterArns"`
+}
+
+// EKSExternalSecretsPolicyInput is an input type that accepts EKSExternalSecretsPolicyArgs and EKSExternalSecretsPolicyOutput values.
+// You can construct a concrete instance of `EKSExternalSecretsPolicyInput` via:
+//
+//	EKSExternalSecretsPolicyArgs{...}
+type EKSExternalSecretsPolicyInput interface {
+	pulumi.Input
+
+	ToEKSExternalSecretsPolicyOutput() EKSExternalSecretsPolicyOutput
+	ToEKSExternalSecretsPolicyOutputWithContext(context.Context) EKSExternalSecretsPolicyOutput
+}
+
+// The External Secrets policy to the role.
+type EKSExternalSecretsPolicyArgs struct {
+	// Determines whether to attach the External Secrets policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+	// List of Secrets Manager ARNs that contain secrets to mount using External Secrets. If not provided, the default ARN "arn:aws:secretsmanager:*:*:secret:*" will be applied.
+	SecretsManagerArns pulumi.StringArrayInput `pulumi:"secretsManagerArns"`
+	// List of Systems Manager Parameter ARNs that contain secrets to mount using External Secrets. If not provided,
+	// the default ARN "arn:aws:ssm:*:*:parameter/*" will be applied.
+	SsmParameterArns pulumi.StringArrayInput `pulumi:"ssmParameterArns"`
+}
+
+func (EKSExternalSecretsPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSExternalSecretsPolicy)(nil)).Elem()
+}
+
+func (i EKSExternalSecretsPolicyArgs) ToEKSExternalSecretsPolicyOutput() EKSExternalSecretsPolicyOutput {
+	return i.ToEKSExternalSecretsPolicyOutputWithContext(context.Background())
+}
+
+func (i EKSExternalSecretsPolicyArgs) ToEKSExternalSecretsPolicyOutputWithContext(ctx context.Context) EKSExternalSecretsPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSExternalSecretsPolicyOutput)
+}
+
+func (i EKSExternalSecretsPolicyArgs) ToEKSExternalSecretsPolicyPtrOutput() EKSExternalSecretsPolicyPtrOutput {
+	return i.ToEKSExternalSecretsPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i EKSExternalSecretsPolicyArgs) ToEKSExternalSecretsPolicyPtrOutputWithContext(ctx context.Context) EKSExternalSecretsPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSExternalSecretsPolicyOutput).ToEKSExternalSecretsPolicyPtrOutputWithContext(ctx)
+}
+
+// EKSExternalSecretsPolicyPtrInput is an input type that accepts EKSExternalSecretsPolicyArgs, EKSExternalSecretsPolicyPtr and EKSExternalSecretsPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSExternalSecretsPolicyPtrInput` via:
+//
+//	        EKSExternalSecretsPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSExternalSecretsPolicyPtrInput interface {
+	pulumi.Input
+
+	ToEKSExternalSecretsPolicyPtrOutput() EKSExternalSecretsPolicyPtrOutput
+	ToEKSExternalSecretsPolicyPtrOutputWithContext(context.Context) EKSExternalSecretsPolicyPtrOutput
+}
+
+type eksexternalSecretsPolicyPtrType EKSExternalSecretsPolicyArgs
+
+func EKSExternalSecretsPolicyPtr(v *EKSExternalSecretsPolicyArgs) EKSExternalSecretsPolicyPtrInput {
+	return (*eksexternalSecretsPolicyPtrType)(v)
+}
+
+func (*eksexternalSecretsPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSExternalSecretsPolicy)(nil)).Elem()
+}
+
+func (i *eksexternalSecretsPolicyPtrType) ToEKSExternalSecretsPolicyPtrOutput() EKSExternalSecretsPolicyPtrOutput {
+	return i.ToEKSExternalSecretsPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *eksexternalSecretsPolicyPtrType) ToEKSExternalSecretsPolicyPtrOutputWithContext(ctx context.Context) EKSExternalSecretsPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSExternalSecretsPolicyPtrOutput)
+}
+
+// The External Secrets policy to the role.
+type EKSExternalSecretsPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSExternalSecretsPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSExternalSecretsPolicy)(nil)).Elem()
+}
+
+func (o EKSExternalSecretsPolicyOutput) ToEKSExternalSecretsPolicyOutput() EKSExternalSecretsPolicyOutput {
+	return o
+}
+
+func (o EKSExternalSecretsPolicyOutput) ToEKSExternalSecretsPolicyOutputWithContext(ctx context.Context) EKSExternalSecretsPolicyOutput {
+	return o
+}
+
+func (o EKSExternalSecretsPolicyOutput) ToEKSExternalSecretsPolicyPtrOutput() EKSExternalSecretsPolicyPtrOutput {
+	return o.ToEKSExternalSecretsPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o EKSExternalSecretsPolicyOutput) ToEKSExternalSecretsPolicyPtrOutputWithContext(ctx context.Context) EKSExternalSecretsPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSExternalSecretsPolicy) *EKSExternalSecretsPolicy {
+		return &v
+	}).(EKSExternalSecretsPolicyPtrOutput)
+}
+
+// Determines whether to attach the External Secrets policy to the role.
+func (o EKSExternalSecretsPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSExternalSecretsPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
+}
+
+// List of Secrets Manager ARNs that contain secrets to mount using External Secrets. If not provided, the default ARN "arn:aws:secretsmanager:*:*:secret:*" will be applied.
+func (o EKSExternalSecretsPolicyOutput) SecretsManagerArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSExternalSecretsPolicy) []string { return v.SecretsManagerArns }).(pulumi.StringArrayOutput)
+}
+
+// List of Systems Manager Parameter ARNs that contain secrets to mount using External Secrets. If not provided,
+// the default ARN "arn:aws:ssm:*:*:parameter/*" will be applied.
+func (o EKSExternalSecretsPolicyOutput) SsmParameterArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSExternalSecretsPolicy) []string { return v.SsmParameterArns }).(pulumi.StringArrayOutput)
+}
+
+type EKSExternalSecretsPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSExternalSecretsPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSExternalSecretsPolicy)(nil)).Elem()
+}
+
+func (o EKSExternalSecretsPolicyPtrOutput) ToEKSExternalSecretsPolicyPtrOutput() EKSExternalSecretsPolicyPtrOutput {
+	return o
+}
+
+func (o EKSExternalSecretsPolicyPtrOutput) ToEKSExternalSecretsPolicyPtrOutputWithContext(ctx context.Context) EKSExternalSecretsPolicyPtrOutput {
+	return o
+}
+
+func (o EKSExternalSecretsPolicyPtrOutput) Elem() EKSExternalSecretsPolicyOutput {
+	return o.ApplyT(func(v *EKSExternalSecretsPolicy) EKSExternalSecretsPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret EKSExternalSecretsPolicy
+		return ret
+	}).(EKSExternalSecretsPolicyOutput)
+}
+
+// Determines whether to attach the External Secrets policy to the role.
+func (o EKSExternalSecretsPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSExternalSecretsPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Attach
+	}).(pulumi.BoolPtrOutput)
+}
+
+// List of Secrets Manager ARNs that contain secrets to mount using External Secrets. If not provided, the default ARN "arn:aws:secretsmanager:*:*:secret:*" will be applied.
+func (o EKSExternalSecretsPolicyPtrOutput) SecretsManagerArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSExternalSecretsPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.SecretsManagerArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// List of Systems Manager Parameter ARNs that contain secrets to mount using External Secrets. If not provided,
+// the default ARN "arn:aws:ssm:*:*:parameter/*" will be applied.
+func (o EKSExternalSecretsPolicyPtrOutput) SsmParameterArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSExternalSecretsPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.SsmParameterArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// The VPC Lattice Gateway API Controller IAM policy to the role.
+type EKSGatewayAPIControllerPolicy struct {
+	// Determines whether to attach the VPC Lattice Gateway API Controller IAM policy to the role.
+	Attach *bool `pulumi:"attach"`
+}
+
+// EKSGatewayAPIControllerPolicyInput is an input type that accepts EKSGatewayAPIControllerPolicyArgs and EKSGatewayAPIControllerPolicyOutput values.
+// You can construct a concrete instance of `EKSGatewayAPIControllerPolicyInput` via:
+//
+//	EKSGatewayAPIControllerPolicyArgs{...}
+type EKSGatewayAPIControllerPolicyInput interface {
+	pulumi.Input
+
+	ToEKSGatewayAPIControllerPolicyOutput() EKSGatewayAPIControllerPolicyOutput
+	ToEKSGatewayAPIControllerPolicyOutputWithContext(context.Context) EKSGatewayAPIControllerPolicyOutput
+}
+
+// The VPC Lattice Gateway API Controller IAM policy to the role.
+type EKSGatewayAPIControllerPolicyArgs struct {
+	// Determines whether to attach the VPC Lattice Gateway API Controller IAM policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+}
+
+func (EKSGatewayAPIControllerPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSGatewayAPIControllerPolicy)(nil)).Elem()
+}
+
+func (i EKSGatewayAPIControllerPolicyArgs) ToEKSGatewayAPIControllerPolicyOutput() EKSGatewayAPIControllerPolicyOutput {
+	return i.ToEKSGatewayAPIControllerPolicyOutputWithContext(context.Background())
+}
+
+func (i EKSGatewayAPIControllerPolicyArgs) ToEKSGatewayAPIControllerPolicyOutputWithContext(ctx context.Context) EKSGatewayAPIControllerPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSGatewayAPIControllerPolicyOutput)
+}
+
+func (i EKSGatewayAPIControllerPolicyArgs) ToEKSGatewayAPIControllerPolicyPtrOutput() EKSGatewayAPIControllerPolicyPtrOutput {
+	return i.ToEKSGatewayAPIControllerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i EKSGatewayAPIControllerPolicyArgs) ToEKSGatewayAPIControllerPolicyPtrOutputWithContext(ctx context.Context) EKSGatewayAPIControllerPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSGatewayAPIControllerPolicyOutput).ToEKSGatewayAPIControllerPolicyPtrOutputWithContext(ctx)
+}
+
+// EKSGatewayAPIControllerPolicyPtrInput is an input type that accepts EKSGatewayAPIControllerPolicyArgs, EKSGatewayAPIControllerPolicyPtr and EKSGatewayAPIControllerPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSGatewayAPIControllerPolicyPtrInput` via:
+//
+//	        EKSGatewayAPIControllerPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSGatewayAPIControllerPolicyPtrInput interface {
+	pulumi.Input
+
+	ToEKSGatewayAPIControllerPolicyPtrOutput() EKSGatewayAPIControllerPolicyPtrOutput
+	ToEKSGatewayAPIControllerPolicyPtrOutputWithContext(context.Context) EKSGatewayAPIControllerPolicyPtrOutput
+}
+
+type eksgatewayAPIControllerPolicyPtrType EKSGatewayAPIControllerPolicyArgs
+
+func EKSGatewayAPIControllerPolicyPtr(v *EKSGatewayAPIControllerPolicyArgs) EKSGatewayAPIControllerPolicyPtrInput {
+	return (*eksgatewayAPIControllerPolicyPtrType)(v)
+}
+
+func (*eksgatewayAPIControllerPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSGatewayAPIControllerPolicy)(nil)).Elem()
+}
+
+func (i *eksgatewayAPIControllerPolicyPtrType) ToEKSGatewayAPIControllerPolicyPtrOutput() EKSGatewayAPIControllerPolicyPtrOutput {
+	return i.ToEKSGatewayAPIControllerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *eksgatewayAPIControllerPolicyPtrType) ToEKSGatewayAPIControllerPolicyPtrOutputWithContext(ctx context.Context) EKSGatewayAPIControllerPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSGatewayAPIControllerPolicyPtrOutput)
+}
+
+// The VPC Lattice Gateway API Controller IAM policy to the role.
+type EKSGatewayAPIControllerPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSGatewayAPIControllerPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSGatewayAPIControllerPolicy)(nil)).Elem()
+}
+
+func (o EKSGatewayAPIControllerPolicyOutput) ToEKSGatewayAPIControllerPolicyOutput() EKSGatewayAPIControllerPolicyOutput {
+	return o
+}
+
+func (o EKSGatewayAPIControllerPolicyOutput) ToEKSGatewayAPIControllerPolicyOutputWithContext(ctx context.Context) EKSGatewayAPIControllerPolicyOutput {
+	return o
+}
+
+func (o EKSGatewayAPIControllerPolicyOutput) ToEKSGatewayAPIControllerPolicyPtrOutput() EKSGatewayAPIControllerPolicyPtrOutput {
+	return o.ToEKSGatewayAPIControllerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o EKSGatewayAPIControllerPolicyOutput) ToEKSGatewayAPIControllerPolicyPtrOutputWithContext(ctx context.Context) EKSGatewayAPIControllerPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSGatewayAPIControllerPolicy) *EKSGatewayAPIControllerPolicy {
+		return &v
+	}).(EKSGatewayAPIControllerPolicyPtrOutput)
+}
+
+// Determines whether to attach the VPC Lattice Gateway API Controller IAM policy to the role.
+func (o EKSGatewayAPIControllerPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSGatewayAPIControllerPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
+}
+
+type EKSGatewayAPIControllerPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSGatewayAPIControllerPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSGatewayAPIControllerPolicy)(nil)).Elem()
+}
+
+func (o EKSGatewayAPIControllerPolicyPtrOutput) ToEKSGatewayAPIControllerPolicyPtrOutput() EKSGatewayAPIControllerPolicyPtrOutput {
+	return o
+}
+
+func (o EKSGatewayAPIControllerPolicyPtrOutput) ToEKSGatewayAPIControllerPolicyPtrOutputWithContext(ctx context.Context) EKSGatewayAPIControllerPolicyPtrOutput {
+	return o
+}
+
+func (o EKSGatewayAPIControllerPolicyPtrOutput) Elem() EKSGatewayAPIControllerPolicyOutput {
+	return o.ApplyT(func(v *EKSGatewayAPIControllerPolicy) EKSGatewayAPIControllerPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret EKSGatewayAPIControllerPolicy
+		return ret
+	}).(EKSGatewayAPIControllerPolicyOutput)
+}
+
+// Determines whether to attach the VPC Lattice Gateway API Controller IAM policy to the role.
+func (o EKSGatewayAPIControllerPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSGatewayAPIControllerPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Attach
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The KEDA IAM policy to the role.
+type EKSKEDAPolicy struct {
+	// Determines whether to attach the KEDA IAM policy to the role.
+	Attach *bool `pulumi:"attach"`
+	// List of DynamoDB table ARNs queried by the aws-dynamodb scaler.
+	DynamodbTableArns []string `pulumi:"dynamodbTableArns"`
+	// List of SQS queue ARNs read by the aws-sqs-queue scaler.
+	SqsQueueArns []string `pulumi:"sqsQueueArns"`
+}
+
+// EKSKEDAPolicyInput is an input type that accepts EKSKEDAPolicyArgs and EKSKEDAPolicyOutput values.
+// You can construct a concrete instance of `EKSKEDAPolicyInput` via:
+//
+//	EKSKEDAPolicyArgs{...}
+type EKSKEDAPolicyInput interface {
+	pulumi.Input
+
+	ToEKSKEDAPolicyOutput() EKSKEDAPolicyOutput
+	ToEKSKEDAPolicyOutputWithContext(context.Context) EKSKEDAPolicyOutput
+}
+
+// The KEDA IAM policy to the role.
+type EKSKEDAPolicyArgs struct {
+	// Determines whether to attach the KEDA IAM policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+	// List of DynamoDB table ARNs queried by the aws-dynamodb scaler.
+	DynamodbTableArns pulumi.StringArrayInput `pulumi:"dynamodbTableArns"`
+	// List of SQS queue ARNs read by the aws-sqs-queue scaler.
+	SqsQueueArns pulumi.StringArrayInput `pulumi:"sqsQueueArns"`
+}
+
+func (EKSKEDAPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSKEDAPolicy)(nil)).Elem()
+}
+
+func (i EKSKEDAPolicyArgs) ToEKSKEDAPolicyOutput() EKSKEDAPolicyOutput {
+	return i.ToEKSKEDAPolicyOutputWithContext(context.Background())
+}
+
+func (i EKSKEDAPolicyArgs) ToEKSKEDAPolicyOutputWithContext(ctx context.Context) EKSKEDAPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSKEDAPolicyOutput)
+}
+
+func (i EKSKEDAPolicyArgs) ToEKSKEDAPolicyPtrOutput() EKSKEDAPolicyPtrOutput {
+	return i.ToEKSKEDAPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i EKSKEDAPolicyArgs) ToEKSKEDAPolicyPtrOutputWithContext(ctx context.Context) EKSKEDAPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSKEDAPolicyOutput).ToEKSKEDAPolicyPtrOutputWithContext(ctx)
+}
+
+// EKSKEDAPolicyPtrInput is an input type that accepts EKSKEDAPolicyArgs, EKSKEDAPolicyPtr and EKSKEDAPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSKEDAPolicyPtrInput` via:
+//
+//	        EKSKEDAPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSKEDAPolicyPtrInput interface {
+	pulumi.Input
+
+	ToEKSKEDAPolicyPtrOutput() EKSKEDAPolicyPtrOutput
+	ToEKSKEDAPolicyPtrOutputWithContext(context.Context) EKSKEDAPolicyPtrOutput
+}
+
+type ekskedapolicyPtrType EKSKEDAPolicyArgs
+
+func EKSKEDAPolicyPtr(v *EKSKEDAPolicyArgs) EKSKEDAPolicyPtrInput {
+	return (*ekskedapolicyPtrType)(v)
+}
+
+func (*ekskedapolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSKEDAPolicy)(nil)).Elem()
+}
+
+func (i *ekskedapolicyPtrType) ToEKSKEDAPolicyPtrOutput() EKSKEDAPolicyPtrOutput {
+	return i.ToEKSKEDAPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *ekskedapolicyPtrType) ToEKSKEDAPolicyPtrOutputWithContext(ctx context.Context) EKSKEDAPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSKEDAPolicyPtrOutput)
+}
+
+// The KEDA IAM policy to the role.
+type EKSKEDAPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSKEDAPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSKEDAPolicy)(nil)).Elem()
+}
+
+func (o EKSKEDAPolicyOutput) ToEKSKEDAPolicyOutput() EKSKEDAPolicyOutput {
+	return o
+}
+
+func (o EKSKEDAPolicyOutput) ToEKSKEDAPolicyOutputWithContext(ctx context.Context) EKSKEDAPolicyOutput {
+	return o
+}
+
+func (o EKSKEDAPolicyOutput) ToEKSKEDAPolicyPtrOutput() EKSKEDAPolicyPtrOutput {
+	return o.ToEKSKEDAPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o EKSKEDAPolicyOutput) ToEKSKEDAPolicyPtrOutputWithContext(ctx context.Context) EKSKEDAPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSKEDAPolicy) *EKSKEDAPolicy {
+		return &v
+	}).(EKSKEDAPolicyPtrOutput)
+}
+
+// Determines whether to attach the KEDA IAM policy to the role.
+func (o EKSKEDAPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSKEDAPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
+}
+
+// List of DynamoDB table ARNs queried by the aws-dynamodb scaler.
+func (o EKSKEDAPolicyOutput) DynamodbTableArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSKEDAPolicy) []string { return v.DynamodbTableArns }).(pulumi.StringArrayOutput)
+}
+
+// List of SQS queue ARNs read by the aws-sqs-queue scaler.
+func (o EKSKEDAPolicyOutput) SqsQueueArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSKEDAPolicy) []string { return v.SqsQueueArns }).(pulumi.StringArrayOutput)
+}
+
+type EKSKEDAPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSKEDAPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSKEDAPolicy)(nil)).Elem()
+}
+
+func (o EKSKEDAPolicyPtrOutput) ToEKSKEDAPolicyPtrOutput() EKSKEDAPolicyPtrOutput {
+	return o
+}
+
+func (o EKSKEDAPolicyPtrOutput) ToEKSKEDAPolicyPtrOutputWithContext(ctx context.Context) EKSKEDAPolicyPtrOutput {
+	return o
+}
+
+func (o EKSKEDAPolicyPtrOutput) Elem() EKSKEDAPolicyOutput {
+	return o.ApplyT(func(v *EKSKEDAPolicy) EKSKEDAPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret EKSKEDAPolicy
+		return ret
+	}).(EKSKEDAPolicyOutput)
+}
+
+// Determines whether to attach the KEDA IAM policy to the role.
+func (o EKSKEDAPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSKEDAPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Attach
+	}).(pulumi.BoolPtrOutput)
+}
+
+// List of DynamoDB table ARNs queried by the aws-dynamodb scaler.
+func (o EKSKEDAPolicyPtrOutput) DynamodbTableArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSKEDAPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.DynamodbTableArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// List of SQS queue ARNs read by the aws-sqs-queue scaler.
+func (o EKSKEDAPolicyPtrOutput) SqsQueueArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSKEDAPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.SqsQueueArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// The Karpenter Controller policy to the role.
+type EKSKarpenterControllerPolicy struct {
+	// Determines whether to attach the Karpenter Controller policy to the role.
+	Attach *bool `pulumi:"attach"`
+	// Cluster ID where the Karpenter controller is provisioned/managing.
+	ClusterId *string `pulumi:"clusterId"`
+	// ARN of the SQS queue Karpenter reads interruption events from. Only used by the `v1` policy.
+	InterruptionQueueArn *string `pulumi:"interruptionQueueArn"`
+	// List of node IAM role ARNs Karpenter can use to launch nodes. If not provided,
+	// the default ARN "*" will be applied. Required by the `v1` policy.
+	NodeIamRoleArns []string `pulumi:"nodeIamRoleArns"`
+	// Region of the cluster. Only used by the `v1` policy, defaults to the region of the provider.
+	Region *string `pulumi:"region"`
+	// List of SSM Parameter ARNs that contain AMI IDs launched by Karpenter. If not provided,
+	// the default ARN "arn:aws:ssm:*:*:parameter/aws/service/*" will be applied.
+	SsmParameterArns []string `pulumi:"ssmParameterArns"`
+	// Account ID of where the subnets Karpenter will utilize resides. Used when subnets are shared from another account.
+	SubnetAccountId *string `pulumi:"subnetAccountId"`
+	// Tag key (`{key = value}`) applied to resources launched by Karpenter through the Karpenter provisioner.
+	TagKey *string `pulumi:"tagKey"`
+	// Version of the Karpenter controller policy. `v0` is the policy of Karpenter releases before v1, using a single
+	// discovery tag. `v1` matches the permissions of the Karpenter v1 controller, scoped by the
+	// `kubernetes.io/cluster/<clusterId>` and `karpenter.sh/nodepool` tags, and requires `clusterId` to be the cluster name.
+	Version *string `pulumi:"version"`
+}
+
+// Defaults sets the appropriate defaults for EKSKarpenterControllerPolicy
+func (val *EKSKarpenterControllerPolicy) Defaults() *EKSKarpenterControllerPolicy {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ClusterId == nil {
+		clusterId_ := "*"
+		tmp.ClusterId = &clusterId_
+	}
+	if tmp.TagKey == nil {
+		tagKey_ := "karpenter.sh/discovery"
+		tmp.TagKey = &tagKey_
+	}
+	if tmp.Version == nil {
+		version_ := "v0"
+		tmp.Version = &version_
+	}
+	return &tmp
+}
+
+// EKSKarpenterControllerPolicyInput is an input type that accepts EKSKarpenterControllerPolicyArgs and EKSKarpenterControllerPolicyOutput values.
+// You can construct a concrete instance of `EKSKarpenterControllerPolicyInput` via:
+//
+//	EKSKarpenterControllerPolicyArgs{...}
+type EKSKarpenterControllerPolicyInput interface {
+	pulumi.Input
+
+	ToEKSKarpenterControllerPolicyOutput() EKSKarpenterControllerPolicyOutput
+	ToEKSKarpenterControllerPolicyOutputWithContext(context.Context) EKSKarpenterControllerPolicyOutput
+}
+
+// The Karpenter Controller policy to the role.
+type EKSKarpenterControllerPolicyArgs struct {
+	// Determines whether to attach the Karpenter Controller policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+	// Cluster ID where the Karpenter controller is provisioned/managing.
+	ClusterId pulumi.StringPtrInput `pulumi:"clusterId"`
+	// ARN of the SQS queue Karpenter reads interruption events from. Only used by the `v1` policy.
+	InterruptionQueueArn pulumi.StringPtrInput `pulumi:"interruptionQueueArn"`
+	// List of node IAM role ARNs Karpenter can use to launch nodes. If not provided,
+	// the default ARN "*" will be applied. Required by the `v1` policy.
+	NodeIamRoleArns pulumi.StringArrayInput `pulumi:"nodeIamRoleArns"`
+	// Region of the cluster. Only used by the `v1` policy, defaults to the region of the provider.
+	Region pulumi.StringPtrInput `pulumi:"region"`
+	// List of SSM Parameter ARNs that contain AMI IDs launched by Karpenter. If not provided,
+	// the default ARN "arn:aws:ssm:*:*:parameter/aws/service/*" will be applied.
+	SsmParameterArns pulumi.StringArrayInput `pulumi:"ssmParameterArns"`
+	// Account ID of where the subnets Karpenter will utilize resides. Used when subnets are shared from another account.
+	SubnetAccountId pulumi.StringPtrInput `pulumi:"subnetAccountId"`
+	// Tag key (`{key = value}`) applied to resources launched by Karpenter through the Karpenter provisioner.
+	TagKey pulumi.StringPtrInput `pulumi:"tagKey"`
+	// Version of the Karpenter controller policy. `v0` is the policy of Karpenter releases before v1, using a single
+	// discovery tag. `v1` matches the permissions of the Karpenter v1 controller, scoped by the
+	// `kubernetes.io/cluster/<clusterId>` and `karpenter.sh/nodepool` tags, and requires `clusterId` to be the cluster name.
+	Version pulumi.StringPtrInput `pulumi:"version"`
+}
+
+// Defaults sets the appropriate defaults for EKSKarpenterControllerPolicyArgs
+func (val *EKSKarpenterControllerPolicyArgs) Defaults() *EKSKarpenterControllerPolicyArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ClusterId == nil {
+		tmp.ClusterId = pulumi.StringPtr("*")
+	}
+	if tmp.TagKey == nil {
+		tmp.TagKey = pulumi.StringPtr("karpenter.sh/discovery")
+	}
+	if tmp.Version == nil {
+		tmp.Version = pulumi.StringPtr("v0")
+	}
+	return &tmp
+}
+func (EKSKarpenterControllerPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSKarpenterControllerPolicy)(nil)).Elem()
+}
+
+func (i EKSKarpenterControllerPolicyArgs) ToEKSKarpenterControllerPolicyOutput() EKSKarpenterControllerPolicyOutput {
+	return i.ToEKSKarpenterControllerPolicyOutputWithContext(context.Background())
+}
+
+func (i EKSKarpenterControllerPolicyArgs) ToEKSKarpenterControllerPolicyOutputWithContext(ctx context.Context) EKSKarpenterControllerPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSKarpenterControllerPolicyOutput)
+}
+
+func (i EKSKarpenterControllerPolicyArgs) ToEKSKarpenterControllerPolicyPtrOutput() EKSKarpenterControllerPolicyPtrOutput {
+	return i.ToEKSKarpenterControllerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i EKSKarpenterControllerPolicyArgs) ToEKSKarpenterControllerPolicyPtrOutputWithContext(ctx context.Context) EKSKarpenterControllerPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSKarpenterControllerPolicyOutput).ToEKSKarpenterControllerPolicyPtrOutputWithContext(ctx)
+}
+
+// EKSKarpenterControllerPolicyPtrInput is an input type that accepts EKSKarpenterControllerPolicyArgs, EKSKarpenterControllerPolicyPtr and EKSKarpenterControllerPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSKarpenterControllerPolicyPtrInput` via:
+//
+//	        EKSKarpenterControllerPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSKarpenterControllerPolicyPtrInput interface {
+	pulumi.Input
+
+	ToEKSKarpenterControllerPolicyPtrOutput() EKSKarpenterControllerPolicyPtrOutput
+	ToEKSKarpenterControllerPolicyPtrOutputWithContext(context.Context) EKSKarpenterControllerPolicyPtrOutput
+}
+
+type ekskarpenterControllerPolicyPtrType EKSKarpenterControllerPolicyArgs
+
+func EKSKarpenterControllerPolicyPtr(v *EKSKarpenterControllerPolicyArgs) EKSKarpenterControllerPolicyPtrInput {
+	return (*ekskarpenterControllerPolicyPtrType)(v)
+}
+
+func (*ekskarpenterControllerPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSKarpenterControllerPolicy)(nil)).Elem()
+}
+
+func (i *ekskarpenterControllerPolicyPtrType) ToEKSKarpenterControllerPolicyPtrOutput() EKSKarpenterControllerPolicyPtrOutput {
+	return i.ToEKSKarpenterControllerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *ekskarpenterControllerPolicyPtrType) ToEKSKarpenterControllerPolicyPtrOutputWithContext(ctx context.Context) EKSKarpenterControllerPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSKarpenterControllerPolicyPtrOutput)
+}
+
+// The Karpenter Controller policy to the role.
+type EKSKarpenterControllerPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSKarpenterControllerPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSKarpenterControllerPolicy)(nil)).Elem()
+}
+
+func (o EKSKarpenterControllerPolicyOutput) ToEKSKarpenterControllerPolicyOutput() EKSKarpenterControllerPolicyOutput {
+	return o
+}
+
+func (o EKSKarpenterControllerPolicyOutput) ToEKSKarpenterControllerPolicyOutputWithContext(ctx context.Context) EKSKarpenterControllerPolicyOutput {
+	return o
+}
+
+func (o EKSKarpenterControllerPolicyOutput) ToEKSKarpenterControllerPolicyPtrOutput() EKSKarpenterControllerPolicyPtrOutput {
+	return o.ToEKSKarpenterControllerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o EKSKarpenterControllerPolicyOutput) ToEKSKarpenterControllerPolicyPtrOutputWithContext(ctx context.Context) EKSKarpenterControllerPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSKarpenterControllerPolicy) *EKSKarpenterControllerPolicy {
+		return &v
+	}).(EKSKarpenterControllerPolicyPtrOutput)
+}
+
+// Determines whether to attach the Karpenter Controller policy to the role.
+func (o EKSKarpenterControllerPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSKarpenterControllerPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
+}
+
+// Cluster ID where the Karpenter controller is provisioned/managing.
+func (o EKSKarpenterControllerPolicyOutput) ClusterId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EKSKarpenterControllerPolicy) *string { return v.ClusterId }).(pulumi.StringPtrOutput)
+}
+
+// ARN of the SQS queue Karpenter reads interruption events from. Only used by the `v1` policy.
+func (o EKSKarpenterControllerPolicyOutput) InterruptionQueueArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EKSKarpenterControllerPolicy) *string { return v.InterruptionQueueArn }).(pulumi.StringPtrOutput)
+}
+
+// List of node IAM role ARNs Karpenter can use to launch nodes. If not provided,
+// the default ARN "*" will be applied. Required by the `v1` policy.
+func (o EKSKarpenterControllerPolicyOutput) NodeIamRoleArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSKarpenterControllerPolicy) []string { return v.NodeIamRoleArns }).(pulumi.StringArrayOutput)
+}
+
+// Region of the cluster. Only used by the `v1` policy, defaults to the region of the provider.
+func (o EKSKarpenterControllerPolicyOutput) Region() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EKSKarpenterControllerPolicy) *string { return v.Region }).(pulumi.StringPtrOutput)
+}
+
+// List of SSM Parameter ARNs that contain AMI IDs launched by Karpenter. If not provided,
+// the default ARN "arn:aws:ssm:*:*:parameter/aws/service/*" will be applied.
+func (o EKSKarpenterControllerPolicyOutput) SsmParameterArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSKarpenterControllerPolicy) []string { return v.SsmParameterArns }).(pulumi.StringArrayOutput)
+}
+
+// Account ID of where the subnets Karpenter will utilize resides. Used when subnets are shared from another account.
+func (o EKSKarpenterControllerPolicyOutput) SubnetAccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EKSKarpenterControllerPolicy) *string { return v.SubnetAccountId }).(pulumi.StringPtrOutput)
+}
+
+// Tag key (`{key = value}`) applied to resources launched by Karpenter through the Karpenter provisioner.
+func (o EKSKarpenterControllerPolicyOutput) TagKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EKSKarpenterControllerPolicy) *string { return v.TagKey }).(pulumi.StringPtrOutput)
+}
+
+// Version of the Karpenter controller policy. `v0` is the policy of Karpenter releases before v1, using a single
+// discovery tag. `v1` matches the permissions of the Karpenter v1 controller, scoped by the
+// `kubernetes.io/cluster/<clusterId>` and `karpenter.sh/nodepool` tags, and requires `clusterId` to be the cluster name.
+func (o EKSKarpenterControllerPolicyOutput) Version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EKSKarpenterControllerPolicy) *string { return v.Version }).(pulumi.StringPtrOutput)
+}
+
+type EKSKarpenterControllerPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSKarpenterControllerPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSKarpenterControllerPolicy)(nil)).Elem()
+}
+
+func (o EKSKarpenterControllerPolicyPtrOutput) ToEKSKarpenterControllerPolicyPtrOutput() EKSKarpenterControllerPolicyPtrOutput {
+	return o
+}
+
+func (o EKSKarpenterControllerPolicyPtrOutput) ToEKSKarpenterControllerPolicyPtrOutputWithContext(ctx context.Context) EKSKarpenterControllerPolicyPtrOutput {
+	return o
+}
+
+func (o EKSKarpenterControllerPolicyPtrOutput) Elem() EKSKarpenterControllerPolicyOutput {
+	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) EKSKarpenterControllerPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret EKSKarpenterControllerPolicy
+		return ret
+	}).(EKSKarpenterControllerPolicyOutput)
+}
+
+// Determines whether to attach the Karpenter Controller policy to the role.
+func (o EKSKarpenterControllerPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Attach
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Cluster ID where the Karpenter controller is provisioned/managing.
+func (o EKSKarpenterControllerPolicyPtrOutput) ClusterId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ClusterId
+	}).(pulumi.StringPtrOutput)
+}
+
+// ARN of the SQS queue Karpenter reads interruption events from. Only used by the `v1` policy.
+func (o EKSKarpenterControllerPolicyPtrOutput) InterruptionQueueArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.InterruptionQueueArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// List of node IAM role ARNs Karpenter can use to launch nodes. If not provided,
+// the default ARN "*" will be applied. Required by the `v1` policy.
+func (o EKSKarpenterControllerPolicyPtrOutput) NodeIamRoleArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.NodeIamRoleArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// Region of the cluster. Only used by the `v1` policy, defaults to the region of the provider.
+func (o EKSKarpenterControllerPolicyPtrOutput) Region() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Region
+	}).(pulumi.StringPtrOutput)
+}
+
+// List of SSM Parameter ARNs that contain AMI IDs launched by Karpenter. If not provided,
+// the default ARN "arn:aws:ssm:*:*:parameter/aws/service/*" will be applied.
+func (o EKSKarpenterControllerPolicyPtrOutput) SsmParameterArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.SsmParameterArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// Account ID of where the subnets Karpenter will utilize resides. Used when subnets are shared from another account.
+func (o EKSKarpenterControllerPolicyPtrOutput) SubnetAccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SubnetAccountId
+	}).(pulumi.StringPtrOutput)
+}
+
+// Tag key (`{key = value}`) applied to resources launched by Karpenter through the Karpenter provisioner.
+func (o EKSKarpenterControllerPolicyPtrOutput) TagKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.TagKey
+	}).(pulumi.StringPtrOutput)
+}
+
+// Version of the Karpenter controller policy. `v0` is the policy of Karpenter releases before v1, using a single
+// discovery tag. `v1` matches the permissions of the Karpenter v1 controller, scoped by the
+// `kubernetes.io/cluster/<clusterId>` and `karpenter.sh/nodepool` tags, and requires `clusterId` to be the cluster name.
+func (o EKSKarpenterControllerPolicyPtrOutput) Version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EKSKarpenterControllerPolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Version
+	}).(pulumi.StringPtrOutput)
+}
+
+// The Load Balancer policy.
+type EKSLoadBalancerPolicy struct {
+	// Name of the cluster. When set, the controller can only manage resources whose cluster tag has this value.
+	ClusterName *string `pulumi:"clusterName"`
+	// Tag key the controller adds to the resources it manages.
+	ClusterTagKey *string `pulumi:"clusterTagKey"`
+	// Determines whether to attach the Load Balancer Controller policy to the role.
+	Controller *bool `pulumi:"controller"`
+	// Version of the Load Balancer Controller the policy is for, e.g. `v2.7`. Permissions added by later controller
+	// releases are only granted to the versions needing them.
+	ControllerVersion *string `pulumi:"controllerVersion"`
+	// Leaves out the WAF, WAFv2 and Shield permissions, for controllers running with `--enable-waf=false`,
+	// `--enable-wafv2=false` and `--enable-shield=false`.
+	RestrictWafShield *bool `pulumi:"restrictWafShield"`
+	// List of security group ARNs the controller can change the ingress rules of. Defaults to all security groups.
+	RestrictedSecurityGroupArns []string `pulumi:"restrictedSecurityGroupArns"`
+	// Determines whether to attach the Load Balancer Controller policy for the TargetGroupBinding only.
+	TargetGroupBindingOnly *bool `pulumi:"targetGroupBindingOnly"`
+}
+
+// Defaults sets the appropriate defaults for EKSLoadBalancerPolicy
+func (val *EKSLoadBalancerPolicy) Defaults() *EKSLoadBalancerPolicy {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ClusterTagKey == nil {
+		clusterTagKey_ := "elbv2.k8s.aws/cluster"
+		tmp.ClusterTagKey = &clusterTagKey_
+	}
+	if tmp.ControllerVersion == nil {
+		controllerVersion_ := "v2.4"
+		tmp.ControllerVersion = &controllerVersion_
+	}
+	return &tmp
+}
+
+// EKSLoadBalancerPolicyInput is an input type that accepts EKSLoadBalancerPolicyArgs and EKSLoadBalancerPolicyOutput values.
+// You can construct a concrete instance of `EKSLoadBalancerPolicyInput` via:
+//
+//	EKSLoadBalancerPolicyArgs{...}
+type EKSLoadBalancerPolicyInput interface {
+	pulumi.Input
+
+	ToEKSLoadBalancerPolicyOutput() EKSLoadBalancerPolicyOutput
+	ToEKSLoadBalancerPolicyOutputWithContext(context.Context) EKSLoadBalancerPolicyOutput
+}
+
+// The Load Balancer policy.
+type EKSLoadBalancerPolicyArgs struct {
+	// Name of the cluster. When set, the controller can only manage resources whose cluster tag has this value.
+	ClusterName pulumi.StringPtrInput `pulumi:"clusterName"`
+	// Tag key the controller adds to the resources it manages.
+	ClusterTagKey pulumi.StringPtrInput `pulumi:"clusterTagKey"`
+	// Determines whether to attach the Load Balancer Controller policy to the role.
+	Controller pulumi.BoolPtrInput `pulumi:"controller"`
+	// Version of the Load Balancer Controller the policy is for, e.g. `v2.7`. Permissions added by later controller
+	// releases are only granted to the versions needing them.
+	ControllerVersion pulumi.StringPtrInput `pulumi:"controllerVersion"`
+	// Leaves out the WAF, WAFv2 and Shield permissions, for controllers running with `--enable-waf=false`,
+	// `--enable-wafv2=false` and `--enable-shield=false`.
+	RestrictWafShield pulumi.BoolPtrInput `pulumi:"restrictWafShield"`
+	// List of security group ARNs the controller can change the ingress rules of. Defaults to all security groups.
+	RestrictedSecurityGroupArns pulumi.StringArrayInput `pulumi:"restrictedSecurityGroupArns"`
+	// Determines whether to attach the Load Balancer Controller policy for the TargetGroupBinding only.
+	TargetGroupBindingOnly pulumi.BoolPtrInput `pulumi:"targetGroupBindingOnly"`
+}
+
+// Defaults sets the appropriate defaults for EKSLoadBalancerPolicyArgs
+func (val *EKSLoadBalancerPolicyArgs) Defaults() *EKSLoadBalancerPolicyArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ClusterTagKey == nil {
+		tmp.ClusterTagKey = pulumi.StringPtr("elbv2.k8s.aws/cluster")
+	}
+	if tmp.ControllerVersion == nil {
+		tmp.ControllerVersion = pulumi.StringPtr("v2.4")
+	}
+	return &tmp
+}
+func (EKSLoadBalancerPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSLoadBalancerPolicy)(nil)).Elem()
+}
+
+func (i EKSLoadBalancerPolicyArgs) ToEKSLoadBalancerPolicyOutput() EKSLoadBalancerPolicyOutput {
+	return i.ToEKSLoadBalancerPolicyOutputWithContext(context.Background())
+}
+
+func (i EKSLoadBalancerPolicyArgs) ToEKSLoadBalancerPolicyOutputWithContext(ctx context.Context) EKSLoadBalancerPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSLoadBalancerPolicyOutput)
+}
+
+func (i EKSLoadBalancerPolicyArgs) ToEKSLoadBalancerPolicyPtrOutput() EKSLoadBalancerPolicyPtrOutput {
+	return i.ToEKSLoadBalancerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i EKSLoadBalancerPolicyArgs) ToEKSLoadBalancerPolicyPtrOutputWithContext(ctx context.Context) EKSLoadBalancerPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSLoadBalancerPolicyOutput).ToEKSLoadBalancerPolicyPtrOutputWithContext(ctx)
+}
+
+// EKSLoadBalancerPolicyPtrInput is an input type that accepts EKSLoadBalancerPolicyArgs, EKSLoadBalancerPolicyPtr and EKSLoadBalancerPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSLoadBalancerPolicyPtrInput` via:
+//
+//	        EKSLoadBalancerPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSLoadBalancerPolicyPtrInput interface {
+	pulumi.Input
+
+	ToEKSLoadBalancerPolicyPtrOutput() EKSLoadBalancerPolicyPtrOutput
+	ToEKSLoadBalancerPolicyPtrOutputWithContext(context.Context) EKSLoadBalancerPolicyPtrOutput
+}
+
+type eksloadBalancerPolicyPtrType EKSLoadBalancerPolicyArgs
+
+func EKSLoadBalancerPolicyPtr(v *EKSLoadBalancerPolicyArgs) EKSLoadBalancerPolicyPtrInput {
+	return (*eksloadBalancerPolicyPtrType)(v)
+}
+
+func (*eksloadBalancerPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSLoadBalancerPolicy)(nil)).Elem()
+}
+
+func (i *eksloadBalancerPolicyPtrType) ToEKSLoadBalancerPolicyPtrOutput() EKSLoadBalancerPolicyPtrOutput {
+	return i.ToEKSLoadBalancerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *eksloadBalancerPolicyPtrType) ToEKSLoadBalancerPolicyPtrOutputWithContext(ctx context.Context) EKSLoadBalancerPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSLoadBalancerPolicyPtrOutput)
+}
+
+// The Load Balancer policy.
+type EKSLoadBalancerPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSLoadBalancerPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSLoadBalancerPolicy)(nil)).Elem()
+}
+
+func (o EKSLoadBalancerPolicyOutput) ToEKSLoadBalancerPolicyOutput() EKSLoadBalancerPolicyOutput {
+	return o
+}
+
+func (o EKSLoadBalancerPolicyOutput) ToEKSLoadBalancerPolicyOutputWithContext(ctx context.Context) EKSLoadBalancerPolicyOutput {
+	return o
+}
+
+func (o EKSLoadBalancerPolicyOutput) ToEKSLoadBalancerPolicyPtrOutput() EKSLoadBalancerPolicyPtrOutput {
+	return o.ToEKSLoadBalancerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o EKSLoadBalancerPolicyOutput) ToEKSLoadBalancerPolicyPtrOutputWithContext(ctx context.Context) EKSLoadBalancerPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSLoadBalancerPolicy) *EKSLoadBalancerPolicy {
+		return &v
+	}).(EKSLoadBalancerPolicyPtrOutput)
+}
+
+// Name of the cluster. When set, the controller can only manage resources whose cluster tag has this value.
+func (o EKSLoadBalancerPolicyOutput) ClusterName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EKSLoadBalancerPolicy) *string { return v.ClusterName }).(pulumi.StringPtrOutput)
+}
+
+// Tag key the controller adds to the resources it manages.
+func (o EKSLoadBalancerPolicyOutput) ClusterTagKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EKSLoadBalancerPolicy) *string { return v.ClusterTagKey }).(pulumi.StringPtrOutput)
+}
+
+// Determines whether to attach the Load Balancer Controller policy to the role.
+func (o EKSLoadBalancerPolicyOutput) Controller() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSLoadBalancerPolicy) *bool { return v.Controller }).(pulumi.BoolPtrOutput)
+}
+
+// Version of the Load Balancer Controller the policy is for, e.g. `v2.7`. Permissions added by later controller
+// releases are only granted to the versions needing them.
+func (o EKSLoadBalancerPolicyOutput) ControllerVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EKSLoadBalancerPolicy) *string { return v.ControllerVersion }).(pulumi.StringPtrOutput)
+}
+
+// Leaves out the WAF, WAFv2 and Shield permissions, for controllers running with `--enable-waf=false`,
+// `--enable-wafv2=false` and `--enable-shield=false`.
+func (o EKSLoadBalancerPolicyOutput) RestrictWafShield() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSLoadBalancerPolicy) *bool { return v.RestrictWafShield }).(pulumi.BoolPtrOutput)
+}
+
+// List of security group ARNs the controller can change the ingress rules of. Defaults to all security groups.
+func (o EKSLoadBalancerPolicyOutput) RestrictedSecurityGroupArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSLoadBalancerPolicy) []string { return v.RestrictedSecurityGroupArns }).(pulumi.StringArrayOutput)
+}
+
+// Determines whether to attach the Load Balancer Controller policy for the TargetGroupBinding only.
+func (o EKSLoadBalancerPolicyOutput) TargetGroupBindingOnly() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSLoadBalancerPolicy) *bool { return v.TargetGroupBindingOnly }).(pulumi.BoolPtrOutput)
+}
+
+type EKSLoadBalancerPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSLoadBalancerPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSLoadBalancerPolicy)(nil)).Elem()
+}
+
+func (o EKSLoadBalancerPolicyPtrOutput) ToEKSLoadBalancerPolicyPtrOutput() EKSLoadBalancerPolicyPtrOutput {
+	return o
+}
+
+func (o EKSLoadBalancerPolicyPtrOutput) ToEKSLoadBalancerPolicyPtrOutputWithContext(ctx context.Context) EKSLoadBalancerPolicyPtrOutput {
+	return o
+}
+
+func (o EKSLoadBalancerPolicyPtrOutput) Elem() EKSLoadBalancerPolicyOutput {
+	return o.ApplyT(func(v *EKSLoadBalancerPolicy) EKSLoadBalancerPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret EKSLoadBalancerPolicy
+		return ret
+	}).(EKSLoadBalancerPolicyOutput)
+}
+
+// Name of the cluster. When set, the controller can only manage resources whose cluster tag has this value.
+func (o EKSLoadBalancerPolicyPtrOutput) ClusterName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EKSLoadBalancerPolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ClusterName
+	}).(pulumi.StringPtrOutput)
+}
+
+// Tag key the controller adds to the resources it manages.
+func (o EKSLoadBalancerPolicyPtrOutput) ClusterTagKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EKSLoadBalancerPolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ClusterTagKey
+	}).(pulumi.StringPtrOutput)
+}
+
+// Determines whether to attach the Load Balancer Controller policy to the role.
+func (o EKSLoadBalancerPolicyPtrOutput) Controller() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSLoadBalancerPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Controller
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Version of the Load Balancer Controller the policy is for, e.g. `v2.7`. Permissions added by later controller
+// releases are only granted to the versions needing them.
+func (o EKSLoadBalancerPolicyPtrOutput) ControllerVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EKSLoadBalancerPolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ControllerVersion
+	}).(pulumi.StringPtrOutput)
+}
+
+// Leaves out the WAF, WAFv2 and Shield permissions, for controllers running with `--enable-waf=false`,
+// `--enable-wafv2=false` and `--enable-shield=false`.
+func (o EKSLoadBalancerPolicyPtrOutput) RestrictWafShield() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSLoadBalancerPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.RestrictWafShield
+	}).(pulumi.BoolPtrOutput)
+}
+
+// List of security group ARNs the controller can change the ingress rules of. Defaults to all security groups.
+func (o EKSLoadBalancerPolicyPtrOutput) RestrictedSecurityGroupArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSLoadBalancerPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.RestrictedSecurityGroupArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// Determines whether to attach the Load Balancer Controller policy for the TargetGroupBinding only.
+func (o EKSLoadBalancerPolicyPtrOutput) TargetGroupBindingOnly() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSLoadBalancerPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.TargetGroupBindingOnly
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The Mountpoint for Amazon S3 CSI IAM policy to the role.
+type EKSMountpointS3CSIPolicy struct {
+	// Determines whether to attach the Mountpoint for Amazon S3 CSI IAM policy to the role.
+	Attach *bool `pulumi:"attach"`
+	// List of KMS key ARNs used to encrypt the objects in the buckets.
+	KmsKeyArns []string `pulumi:"kmsKeyArns"`
+	// List of S3 Bucket ARNs the driver is allowed to mount. At least one is required.
+	S3BucketArns []string `pulumi:"s3BucketArns"`
+	// List of key prefixes within the buckets the driver is allowed to access. Defaults to the whole bucket.
+	S3PathPrefixes []string `pulumi:"s3PathPrefixes"`
+}
+
+// EKSMountpointS3CSIPolicyInput is an input type that accepts EKSMountpointS3CSIPolicyArgs and EKSMountpointS3CSIPolicyOutput values.
+// You can construct a concrete instance of `EKSMountpointS3CSIPolicyInput` via:
+//
+//	EKSMountpointS3CSIPolicyArgs{...}
+type EKSMountpointS3CSIPolicyInput interface {
+	pulumi.Input
+
+	ToEKSMountpointS3CSIPolicyOutput() EKSMountpointS3CSIPolicyOutput
+	ToEKSMountpointS3CSIPolicyOutputWithContext(context.Context) EKSMountpointS3CSIPolicyOutput
+}
+
+// The Mountpoint for Amazon S3 CSI IAM policy to the role.
+type EKSMountpointS3CSIPolicyArgs struct {
+	// Determines whether to attach the Mountpoint for Amazon S3 CSI IAM policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+	// List of KMS key ARNs used to encrypt the objects in the buckets.
+	KmsKeyArns pulumi.StringArrayInput `pulumi:"kmsKeyArns"`
+	// List of S3 Bucket ARNs the driver is allowed to mount. At least one is required.
+	S3BucketArns pulumi.StringArrayInput `pulumi:"s3BucketArns"`
+	// List of key prefixes within the buckets the driver is allowed to access. Defaults to the whole bucket.
+	S3PathPrefixes pulumi.StringArrayInput `pulumi:"s3PathPrefixes"`
+}
+
+func (EKSMountpointS3CSIPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSMountpointS3CSIPolicy)(nil)).Elem()
+}
+
+func (i EKSMountpointS3CSIPolicyArgs) ToEKSMountpointS3CSIPolicyOutput() EKSMountpointS3CSIPolicyOutput {
+	return i.ToEKSMountpointS3CSIPolicyOutputWithContext(context.Background())
+}
+
+func (i EKSMountpointS3CSIPolicyArgs) ToEKSMountpointS3CSIPolicyOutputWithContext(ctx context.Context) EKSMountpointS3CSIPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSMountpointS3CSIPolicyOutput)
+}
+
+func (i EKSMountpointS3CSIPolicyArgs) ToEKSMountpointS3CSIPolicyPtrOutput() EKSMountpointS3CSIPolicyPtrOutput {
+	return i.ToEKSMountpointS3CSIPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i EKSMountpointS3CSIPolicyArgs) ToEKSMountpointS3CSIPolicyPtrOutputWithContext(ctx context.Context) EKSMountpointS3CSIPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSMountpointS3CSIPolicyOutput).ToEKSMountpointS3CSIPolicyPtrOutputWithContext(ctx)
+}
+
+// EKSMountpointS3CSIPolicyPtrInput is an input type that accepts EKSMountpointS3CSIPolicyArgs, EKSMountpointS3CSIPolicyPtr and EKSMountpointS3CSIPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSMountpointS3CSIPolicyPtrInput` via:
+//
+//	        EKSMountpointS3CSIPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSMountpointS3CSIPolicyPtrInput interface {
+	pulumi.Input
+
+	ToEKSMountpointS3CSIPolicyPtrOutput() EKSMountpointS3CSIPolicyPtrOutput
+	ToEKSMountpointS3CSIPolicyPtrOutputWithContext(context.Context) EKSMountpointS3CSIPolicyPtrOutput
+}
+
+type eksmountpointS3CSIPolicyPtrType EKSMountpointS3CSIPolicyArgs
+
+func EKSMountpointS3CSIPolicyPtr(v *EKSMountpointS3CSIPolicyArgs) EKSMountpointS3CSIPolicyPtrInput {
+	return (*eksmountpointS3CSIPolicyPtrType)(v)
+}
+
+func (*eksmountpointS3CSIPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSMountpointS3CSIPolicy)(nil)).Elem()
+}
+
+func (i *eksmountpointS3CSIPolicyPtrType) ToEKSMountpointS3CSIPolicyPtrOutput() EKSMountpointS3CSIPolicyPtrOutput {
+	return i.ToEKSMountpointS3CSIPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *eksmountpointS3CSIPolicyPtrType) ToEKSMountpointS3CSIPolicyPtrOutputWithContext(ctx context.Context) EKSMountpointS3CSIPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSMountpointS3CSIPolicyPtrOutput)
+}
+
+// The Mountpoint for Amazon S3 CSI IAM policy to the role.
+type EKSMountpointS3CSIPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSMountpointS3CSIPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSMountpointS3CSIPolicy)(nil)).Elem()
+}
+
+func (o EKSMountpointS3CSIPolicyOutput) ToEKSMountpointS3CSIPolicyOutput() EKSMountpointS3CSIPolicyOutput {
+	return o
+}
+
+func (o EKSMountpointS3CSIPolicyOutput) ToEKSMountpointS3CSIPolicyOutputWithContext(ctx context.Context) EKSMountpointS3CSIPolicyOutput {
+	return o
+}
+
+func (o EKSMountpointS3CSIPolicyOutput) ToEKSMountpointS3CSIPolicyPtrOutput() EKSMountpointS3CSIPolicyPtrOutput {
+	return o.ToEKSMountpointS3CSIPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o EKSMountpointS3CSIPolicyOutput) ToEKSMountpointS3CSIPolicyPtrOutputWithContext(ctx context.Context) EKSMountpointS3CSIPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSMountpointS3CSIPolicy) *EKSMountpointS3CSIPolicy {
+		return &v
+	}).(EKSMountpointS3CSIPolicyPtrOutput)
+}
+
+// Determines whether to attach the Mountpoint for Amazon S3 CSI IAM policy to the role.
+func (o EKSMountpointS3CSIPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSMountpointS3CSIPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
+}
+
+// List of KMS key ARNs used to encrypt the objects in the buckets.
+func (o EKSMountpointS3CSIPolicyOutput) KmsKeyArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSMountpointS3CSIPolicy) []string { return v.KmsKeyArns }).(pulumi.StringArrayOutput)
+}
+
+// List of S3 Bucket ARNs the driver is allowed to mount. At least one is required.
+func (o EKSMountpointS3CSIPolicyOutput) S3BucketArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSMountpointS3CSIPolicy) []string { return v.S3BucketArns }).(pulumi.StringArrayOutput)
+}
+
+// List of key prefixes within the buckets the driver is allowed to access. Defaults to the whole bucket.
+func (o EKSMountpointS3CSIPolicyOutput) S3PathPrefixes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSMountpointS3CSIPolicy) []string { return v.S3PathPrefixes }).(pulumi.StringArrayOutput)
+}
+
+type EKSMountpointS3CSIPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSMountpointS3CSIPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSMountpointS3CSIPolicy)(nil)).Elem()
+}
+
+func (o EKSMountpointS3CSIPolicyPtrOutput) ToEKSMountpointS3CSIPolicyPtrOutput() EKSMountpointS3CSIPolicyPtrOutput {
+	return o
+}
+
+func (o EKSMountpointS3CSIPolicyPtrOutput) ToEKSMountpointS3CSIPolicyPtrOutputWithContext(ctx context.Context) EKSMountpointS3CSIPolicyPtrOutput {
+	return o
+}
+
+func (o EKSMountpointS3CSIPolicyPtrOutput) Elem() EKSMountpointS3CSIPolicyOutput {
+	return o.ApplyT(func(v *EKSMountpointS3CSIPolicy) EKSMountpointS3CSIPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret EKSMountpointS3CSIPolicy
+		return ret
+	}).(EKSMountpointS3CSIPolicyOutput)
+}
+
+// Determines whether to attach the Mountpoint for Amazon S3 CSI IAM policy to the role.
+func (o EKSMountpointS3CSIPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSMountpointS3CSIPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Attach
+	}).(pulumi.BoolPtrOutput)
+}
+
+// List of KMS key ARNs used to encrypt the objects in the buckets.
+func (o EKSMountpointS3CSIPolicyPtrOutput) KmsKeyArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSMountpointS3CSIPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.KmsKeyArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// List of S3 Bucket ARNs the driver is allowed to mount. At least one is required.
+func (o EKSMountpointS3CSIPolicyPtrOutput) S3BucketArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSMountpointS3CSIPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.S3BucketArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// List of key prefixes within the buckets the driver is allowed to access. Defaults to the whole bucket.
+func (o EKSMountpointS3CSIPolicyPtrOutput) S3PathPrefixes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSMountpointS3CSIPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.S3PathPrefixes
+	}).(pulumi.StringArrayOutput)
+}
+
+// The Node Termination Handler policy to the role.
+type EKSNodeTerminationHandlerPolicy struct {
+	// Determines whether to attach the Node Termination Handler policy to the role.
+	Attach *bool `pulumi:"attach"`
+	// List of SQS ARNs that contain node termination events. If not provided, then a default
+	// ARN of "*" will be provided.
+	SqsQueueArns []string `pulumi:"sqsQueueArns"`
+}
+
+// EKSNodeTerminationHandlerPolicyInput is an input type that accepts EKSNodeTerminationHandlerPolicyArgs and EKSNodeTerminationHandlerPolicyOutput values.
+// You can construct a concrete instance of `EKSNodeTerminationHandlerPolicyInput` via:
+//
+//	EKSNodeTerminationHandlerPolicyArgs{...}
+type EKSNodeTerminationHandlerPolicyInput interface {
+	pulumi.Input
+
+	ToEKSNodeTerminationHandlerPolicyOutput() EKSNodeTerminationHandlerPolicyOutput
+	ToEKSNodeTerminationHandlerPolicyOutputWithContext(context.Context) EKSNodeTerminationHandlerPolicyOutput
+}
+
+// The Node Termination Handler policy to the role.
+type EKSNodeTerminationHandlerPolicyArgs struct {
+	// Determines whether to attach the Node Termination Handler policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+	// List of SQS ARNs that contain node termination events. If not provided, then a default
+	// ARN of "*" will be provided.
+	SqsQueueArns pulumi.StringArrayInput `pulumi:"sqsQueueArns"`
+}
+
+func (EKSNodeTerminationHandlerPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSNodeTerminationHandlerPolicy)(nil)).Elem()
+}
+
+func (i EKSNodeTerminationHandlerPolicyArgs) ToEKSNodeTerminationHandlerPolicyOutput() EKSNodeTerminationHandlerPolicyOutput {
+	return i.ToEKSNodeTerminationHandlerPolicyOutputWithContext(context.Background())
+}
+
+func (i EKSNodeTerminationHandlerPolicyArgs) ToEKSNodeTerminationHandlerPolicyOutputWithContext(ctx context.Context) EKSNodeTerminationHandlerPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSNodeTerminationHandlerPolicyOutput)
+}
+
+func (i EKSNodeTerminationHandlerPolicyArgs) ToEKSNodeTerminationHandlerPolicyPtrOutput() EKSNodeTerminationHandlerPolicyPtrOutput {
+	return i.ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i EKSNodeTerminationHandlerPolicyArgs) ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(ctx context.Context) EKSNodeTerminationHandlerPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSNodeTerminationHandlerPolicyOutput).ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(ctx)
+}
+
+// EKSNodeTerminationHandlerPolicyPtrInput is an input type that accepts EKSNodeTerminationHandlerPolicyArgs, EKSNodeTerminationHandlerPolicyPtr and EKSNodeTerminationHandlerPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSNodeTerminationHandlerPolicyPtrInput` via:
+//
+//	        EKSNodeTerminationHandlerPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSNodeTerminationHandlerPolicyPtrInput interface {
+	pulumi.Input
+
+	ToEKSNodeTerminationHandlerPolicyPtrOutput() EKSNodeTerminationHandlerPolicyPtrOutput
+	ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(context.Context) EKSNodeTerminationHandlerPolicyPtrOutput
+}
+
+type eksnodeTerminationHandlerPolicyPtrType EKSNodeTerminationHandlerPolicyArgs
+
+func EKSNodeTerminationHandlerPolicyPtr(v *EKSNodeTerminationHandlerPolicyArgs) EKSNodeTerminationHandlerPolicyPtrInput {
+	return (*eksnodeTerminationHandlerPolicyPtrType)(v)
+}
+
+func (*eksnodeTerminationHandlerPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSNodeTerminationHandlerPolicy)(nil)).Elem()
+}
+
+func (i *eksnodeTerminationHandlerPolicyPtrType) ToEKSNodeTerminationHandlerPolicyPtrOutput() EKSNodeTerminationHandlerPolicyPtrOutput {
+	return i.ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *eksnodeTerminationHandlerPolicyPtrType) ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(ctx context.Context) EKSNodeTerminationHandlerPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSNodeTerminationHandlerPolicyPtrOutput)
+}
+
+// The Node Termination Handler policy to the role.
+type EKSNodeTerminationHandlerPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSNodeTerminationHandlerPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSNodeTerminationHandlerPolicy)(nil)).Elem()
+}
+
+func (o EKSNodeTerminationHandlerPolicyOutput) ToEKSNodeTerminationHandlerPolicyOutput() EKSNodeTerminationHandlerPolicyOutput {
+	return o
+}
+
+func (o EKSNodeTerminationHandlerPolicyOutput) ToEKSNodeTerminationHandlerPolicyOutputWithContext(ctx context.Context) EKSNodeTerminationHandlerPolicyOutput {
+	return o
+}
+
+func (o EKSNodeTerminationHandlerPolicyOutput) ToEKSNodeTerminationHandlerPolicyPtrOutput() EKSNodeTerminationHandlerPolicyPtrOutput {
+	return o.ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o EKSNodeTerminationHandlerPolicyOutput) ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(ctx context.Context) EKSNodeTerminationHandlerPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSNodeTerminationHandlerPolicy) *EKSNodeTerminationHandlerPolicy {
+		return &v
+	}).(EKSNodeTerminationHandlerPolicyPtrOutput)
+}
+
+// Determines whether to attach the Node Termination Handler policy to the role.
+func (o EKSNodeTerminationHandlerPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSNodeTerminationHandlerPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
+}
+
+// List of SQS ARNs that contain node termination events. If not provided, then a default
+// ARN of "*" will be provided.
+func (o EKSNodeTerminationHandlerPolicyOutput) SqsQueueArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSNodeTerminationHandlerPolicy) []string { return v.SqsQueueArns }).(pulumi.StringArrayOutput)
+}
+
+type EKSNodeTerminationHandlerPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSNodeTerminationHandlerPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSNodeTerminationHandlerPolicy)(nil)).Elem()
+}
+
+func (o EKSNodeTerminationHandlerPolicyPtrOutput) ToEKSNodeTerminationHandlerPolicyPtrOutput() EKSNodeTerminationHandlerPolicyPtrOutput {
+	return o
+}
+
+func (o EKSNodeTerminationHandlerPolicyPtrOutput) ToEKSNodeTerminationHandlerPolicyPtrOutputWithContext(ctx context.Context) EKSNodeTerminationHandlerPolicyPtrOutput {
+	return o
+}
+
+func (o EKSNodeTerminationHandlerPolicyPtrOutput) Elem() EKSNodeTerminationHandlerPolicyOutput {
+	return o.ApplyT(func(v *EKSNodeTerminationHandlerPolicy) EKSNodeTerminationHandlerPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret EKSNodeTerminationHandlerPolicy
+		return ret
+	}).(EKSNodeTerminationHandlerPolicyOutput)
+}
+
+// Determines whether to attach the Node Termination Handler policy to the role.
+func (o EKSNodeTerminationHandlerPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSNodeTerminationHandlerPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Attach
+	}).(pulumi.BoolPtrOutput)
+}
+
+// List of SQS ARNs that contain node termination events. If not provided, then a default
+// ARN of "*" will be provided.
+func (o EKSNodeTerminationHandlerPolicyPtrOutput) SqsQueueArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSNodeTerminationHandlerPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.SqsQueueArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// The different policies to attach to the role.
+type EKSRolePolicies struct {
+	// The AWS Distro for OpenTelemetry IAM policy.
+	Adot *EKSADOTPolicy `pulumi:"adot"`
+	// The Amazon Managed Service for Prometheus IAM policy.
+	AmazonManagedServicePrometheus *EKSAmazonManagedServicePrometheusPolicy `pulumi:"amazonManagedServicePrometheus"`
+	// The Appmesh policies.
+	Appmesh *EKSAppmeshPolicy `pulumi:"appmesh"`
+	// The Cert Manager IAM policy.
+	CertManager *EKSCertManagerPolicy `pulumi:"certManager"`
+	// The CloudWatch Observability IAM policy.
+	CloudwatchObservability *EKSCloudWatchObservabilityPolicy `pulumi:"cloudwatchObservability"`
+	// The Cluster Autoscaler IAM policy.
+	ClusterAutoScaling *EKSClusterAutoscalerPolicy `pulumi:"clusterAutoScaling"`
+	// Arguments of organization specific add-ons, keyed by the name they are registered with in
+	// the provider's add-on registry. Unknown add-on names are rejected.
+	Custom map[string]interface{} `pulumi:"custom"`
+	// The EBS CSI IAM policy.
+	EbsCsi *EKSEBSCSIPolicy `pulumi:"ebsCsi"`
+	// The EFS CSI IAM policy.
+	EfsCsi *EKSEFSCSIPolicy `pulumi:"efsCsi"`
+	// The External DNS IAM policy.
+	ExternalDns *EKSExternalDNSPolicy `pulumi:"externalDns"`
+	// The External Secrets policy.
+	ExternalSecrets *EKSExternalSecretsPolicy `pulumi:"externalSecrets"`
+	// The FSx for Lustre CSI Driver IAM policy.
+	FsxLustreCsi *FSxLustreCSIPolicy `pulumi:"fsxLustreCsi"`
+	// The VPC Lattice Gateway API Controller IAM policy.
+	GatewayApiController *EKSGatewayAPIControllerPolicy `pulumi:"gatewayApiController"`
+	// The Karpenter Controller policy.
+	KarpenterController *EKSKarpenterControllerPolicy `pulumi:"karpenterController"`
+	// The KEDA IAM policy.
+	Keda *EKSKEDAPolicy `pulumi:"keda"`
+	// The Load Balancer policy.
+	LoadBalancer *EKSLoadBalancerPolicy `pulumi:"loadBalancer"`
+	// The Mountpoint for Amazon S3 CSI IAM policy.
+	MountpointS3Csi *EKSMountpointS3CSIPolicy `pulumi:"mountpointS3Csi"`
+	// The Node Termination Handler policy to the role.
+	NodeTerminationHandler *EKSNodeTerminationHandlerPolicy `pulumi:"nodeTerminationHandler"`
+	// The Secrets Store CSI driver IAM policy.
+	SecretsStoreCsi *EKSSecretsStoreCSIPolicy `pulumi:"secretsStoreCsi"`
+	// The Velero IAM policy.
+	Velero *EKSVeleroPolicy `pulumi:"velero"`
+	// The VPC CNI IAM policy to the role.
+	VpnCni *EKSVPNCNIPolicy `pulumi:"vpnCni"`
+}
+
+// Defaults sets the appropriate defaults for EKSRolePolicies
+func (val *EKSRolePolicies) Defaults() *EKSRolePolicies {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	tmp.KarpenterController = tmp.KarpenterController.Defaults()
+
+	tmp.LoadBalancer = tmp.LoadBalancer.Defaults()
+
+	return &tmp
+}
+
+// EKSRolePoliciesInput is an input type that accepts EKSRolePoliciesArgs and EKSRolePoliciesOutput values.
+// You can construct a concrete instance of `EKSRolePoliciesInput` via:
+//
+//	EKSRolePoliciesArgs{...}
+type EKSRolePoliciesInput interface {
+	pulumi.Input
+
+	ToEKSRolePoliciesOutput() EKSRolePoliciesOutput
+	ToEKSRolePoliciesOutputWithContext(context.Context) EKSRolePoliciesOutput
+}
+
+// The different policies to attach to the role.
+type EKSRolePoliciesArgs struct {
+	// The AWS Distro for OpenTelemetry IAM policy.
+	Adot EKSADOTPolicyPtrInput `pulumi:"adot"`
+	// The Amazon Managed Service for Prometheus IAM policy.
+	AmazonManagedServicePrometheus EKSAmazonManagedServicePrometheusPolicyPtrInput `pulumi:"amazonManagedServicePrometheus"`
+	// The Appmesh policies.
+	Appmesh EKSAppmeshPolicyPtrInput `pulumi:"appmesh"`
+	// The Cert Manager IAM policy.
+	CertManager EKSCertManagerPolicyPtrInput `pulumi:"certManager"`
+	// The CloudWatch Observability IAM policy.
+	CloudwatchObservability EKSCloudWatchObservabilityPolicyPtrInput `pulumi:"cloudwatchObservability"`
+	// The Cluster Autoscaler IAM policy.
+	ClusterAutoScaling EKSClusterAutoscalerPolicyPtrInput `pulumi:"clusterAutoScaling"`
+	// Arguments of organization specific add-ons, keyed by the name they are registered with in
+	// the provider's add-on registry. Unknown add-on names are rejected.
+	Custom pulumi.MapInput `pulumi:"custom"`
+	// The EBS CSI IAM policy.
+	EbsCsi EKSEBSCSIPolicyPtrInput `pulumi:"ebsCsi"`
+	// The EFS CSI IAM policy.
+	EfsCsi EKSEFSCSIPolicyPtrInput `pulumi:"efsCsi"`
+	// The External DNS IAM policy.
+	ExternalDns EKSExternalDNSPolicyPtrInput `pulumi:"externalDns"`
+	// The External Secrets policy.
+	ExternalSecrets EKSExternalSecretsPolicyPtrInput `pulumi:"externalSecrets"`
+	// The FSx for Lustre CSI Driver IAM policy.
+	FsxLustreCsi FSxLustreCSIPolicyPtrInput `pulumi:"fsxLustreCsi"`
+	// The VPC Lattice Gateway API Controller IAM policy.
+	GatewayApiController EKSGatewayAPIControllerPolicyPtrInput `pulumi:"gatewayApiController"`
+	// The Karpenter Controller policy.
+	KarpenterController EKSKarpenterControllerPolicyPtrInput `pulumi:"karpenterController"`
+	// The KEDA IAM policy.
+	Keda EKSKEDAPolicyPtrInput `pulumi:"keda"`
+	// The Load Balancer policy.
+	LoadBalancer EKSLoadBalancerPolicyPtrInput `pulumi:"loadBalancer"`
+	// The Mountpoint for Amazon S3 CSI IAM policy.
+	MountpointS3Csi EKSMountpointS3CSIPolicyPtrInput `pulumi:"mountpointS3Csi"`
+	// The Node Termination Handler policy to the role.
+	NodeTerminationHandler EKSNodeTerminationHandlerPolicyPtrInput `pulumi:"nodeTerminationHandler"`
+	// The Secrets Store CSI driver IAM policy.
+	SecretsStoreCsi EKSSecretsStoreCSIPolicyPtrInput `pulumi:"secretsStoreCsi"`
+	// The Velero IAM policy.
+	Velero EKSVeleroPolicyPtrInput `pulumi:"velero"`
+	// The VPC CNI IAM policy to the role.
+	VpnCni EKSVPNCNIPolicyPtrInput `pulumi:"vpnCni"`
+}
+
+// Defaults sets the appropriate defaults for EKSRolePoliciesArgs
+func (val *EKSRolePoliciesArgs) Defaults() *EKSRolePoliciesArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+
+	return &tmp
+}
+func (EKSRolePoliciesArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSRolePolicies)(nil)).Elem()
+}
+
+func (i EKSRolePoliciesArgs) ToEKSRolePoliciesOutput() EKSRolePoliciesOutput {
+	return i.ToEKSRolePoliciesOutputWithContext(context.Background())
+}
+
+func (i EKSRolePoliciesArgs) ToEKSRolePoliciesOutputWithContext(ctx context.Context) EKSRolePoliciesOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSRolePoliciesOutput)
+}
+
+func (i EKSRolePoliciesArgs) ToEKSRolePoliciesPtrOutput() EKSRolePoliciesPtrOutput {
+	return i.ToEKSRolePoliciesPtrOutputWithContext(context.Background())
+}
+
+func (i EKSRolePoliciesArgs) ToEKSRolePoliciesPtrOutputWithContext(ctx context.Context) EKSRolePoliciesPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSRolePoliciesOutput).ToEKSRolePoliciesPtrOutputWithContext(ctx)
+}
+
+// EKSRolePoliciesPtrInput is an input type that accepts EKSRolePoliciesArgs, EKSRolePoliciesPtr and EKSRolePoliciesPtrOutput values.
+// You can construct a concrete instance of `EKSRolePoliciesPtrInput` via:
+//
+//	        EKSRolePoliciesArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSRolePoliciesPtrInput interface {
+	pulumi.Input
+
+	ToEKSRolePoliciesPtrOutput() EKSRolePoliciesPtrOutput
+	ToEKSRolePoliciesPtrOutputWithContext(context.Context) EKSRolePoliciesPtrOutput
+}
+
+type eksrolePoliciesPtrType EKSRolePoliciesArgs
+
+func EKSRolePoliciesPtr(v *EKSRolePoliciesArgs) EKSRolePoliciesPtrInput {
+	return (*eksrolePoliciesPtrType)(v)
+}
+
+func (*eksrolePoliciesPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSRolePolicies)(nil)).Elem()
+}
+
+func (i *eksrolePoliciesPtrType) ToEKSRolePoliciesPtrOutput() EKSRolePoliciesPtrOutput {
+	return i.ToEKSRolePoliciesPtrOutputWithContext(context.Background())
+}
+
+func (i *eksrolePoliciesPtrType) ToEKSRolePoliciesPtrOutputWithContext(ctx context.Context) EKSRolePoliciesPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSRolePoliciesPtrOutput)
+}
+
+// The different policies to attach to the role.
+type EKSRolePoliciesOutput struct{ *pulumi.OutputState }
+
+func (EKSRolePoliciesOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSRolePolicies)(nil)).Elem()
+}
+
+func (o EKSRolePoliciesOutput) ToEKSRolePoliciesOutput() EKSRolePoliciesOutput {
+	return o
+}
+
+func (o EKSRolePoliciesOutput) ToEKSRolePoliciesOutputWithContext(ctx context.Context) EKSRolePoliciesOutput {
+	return o
+}
+
+func (o EKSRolePoliciesOutput) ToEKSRolePoliciesPtrOutput() EKSRolePoliciesPtrOutput {
+	return o.ToEKSRolePoliciesPtrOutputWithContext(context.Background())
+}
+
+func (o EKSRolePoliciesOutput) ToEKSRolePoliciesPtrOutputWithContext(ctx context.Context) EKSRolePoliciesPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSRolePolicies) *EKSRolePolicies {
+		return &v
+	}).(EKSRolePoliciesPtrOutput)
+}
+
+// The AWS Distro for OpenTelemetry IAM policy.
+func (o EKSRolePoliciesOutput) Adot() EKSADOTPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSADOTPolicy { return v.Adot }).(EKSADOTPolicyPtrOutput)
+}
+
+// The Amazon Managed Service for Prometheus IAM policy.
+func (o EKSRolePoliciesOutput) AmazonManagedServicePrometheus() EKSAmazonManagedServicePrometheusPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSAmazonManagedServicePrometheusPolicy {
+		return v.AmazonManagedServicePrometheus
+	}).(EKSAmazonManagedServicePrometheusPolicyPtrOutput)
+}
+
+// The Appmesh policies.
+func (o EKSRolePoliciesOutput) Appmesh() EKSAppmeshPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSAppmeshPolicy { return v.Appmesh }).(EKSAppmeshPolicyPtrOutput)
+}
+
+// The Cert Manager IAM policy.
+func (o EKSRolePoliciesOutput) CertManager() EKSCertManagerPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSCertManagerPolicy { return v.CertManager }).(EKSCertManagerPolicyPtrOutput)
+}
+
+// The CloudWatch Observability IAM policy.
+func (o EKSRolePoliciesOutput) CloudwatchObservability() EKSCloudWatchObservabilityPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSCloudWatchObservabilityPolicy { return v.CloudwatchObservability }).(EKSCloudWatchObservabilityPolicyPtrOutput)
+}
+
+// The Cluster Autoscaler IAM policy.
+func (o EKSRolePoliciesOutput) ClusterAutoScaling() EKSClusterAutoscalerPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSClusterAutoscalerPolicy { return v.ClusterAutoScaling }).(EKSClusterAutoscalerPolicyPtrOutput)
+}
+
+// Arguments of organization specific add-ons, keyed by the name they are registered with in
+// the provider's add-on registry. Unknown add-on names are rejected.
+func (o EKSRolePoliciesOutput) Custom() pulumi.MapOutput {
+	return o.ApplyT(func(v EKSRolePolicies) map[string]interface{} { return v.Custom }).(pulumi.MapOutput)
+}
+
+// The EBS CSI IAM policy.
+func (o EKSRolePoliciesOutput) EbsCsi() EKSEBSCSIPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSEBSCSIPolicy { return v.EbsCsi }).(EKSEBSCSIPolicyPtrOutput)
+}
+
+// The EFS CSI IAM policy.
+func (o EKSRolePoliciesOutput) EfsCsi() EKSEFSCSIPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSEFSCSIPolicy { return v.EfsCsi }).(EKSEFSCSIPolicyPtrOutput)
+}
+
+// The External DNS IAM policy.
+func (o EKSRolePoliciesOutput) ExternalDns() EKSExternalDNSPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSExternalDNSPolicy { return v.ExternalDns }).(EKSExternalDNSPolicyPtrOutput)
+}
+
+// The External Secrets policy.
+func (o EKSRolePoliciesOutput) ExternalSecrets() EKSExternalSecretsPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSExternalSecretsPolicy { return v.ExternalSecrets }).(EKSExternalSecretsPolicyPtrOutput)
+}
+
+// The FSx for Lustre CSI Driver IAM policy.
+func (o EKSRolePoliciesOutput) FsxLustreCsi() FSxLustreCSIPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *FSxLustreCSIPolicy { return v.FsxLustreCsi }).(FSxLustreCSIPolicyPtrOutput)
+}
+
+// The VPC Lattice Gateway API Controller IAM policy.
+func (o EKSRolePoliciesOutput) GatewayApiController() EKSGatewayAPIControllerPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSGatewayAPIControllerPolicy { return v.GatewayApiController }).(EKSGatewayAPIControllerPolicyPtrOutput)
+}
+
+// The Karpenter Controller policy.
+func (o EKSRolePoliciesOutput) KarpenterController() EKSKarpenterControllerPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSKarpenterControllerPolicy { return v.KarpenterController }).(EKSKarpenterControllerPolicyPtrOutput)
+}
+
+// The KEDA IAM policy.
+func (o EKSRolePoliciesOutput) Keda() EKSKEDAPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSKEDAPolicy { return v.Keda }).(EKSKEDAPolicyPtrOutput)
+}
+
+// The Load Balancer policy.
+func (o EKSRolePoliciesOutput) LoadBalancer() EKSLoadBalancerPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSLoadBalancerPolicy { return v.LoadBalancer }).(EKSLoadBalancerPolicyPtrOutput)
+}
+
+// The Mountpoint for Amazon S3 CSI IAM policy.
+func (o EKSRolePoliciesOutput) MountpointS3Csi() EKSMountpointS3CSIPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSMountpointS3CSIPolicy { return v.MountpointS3Csi }).(EKSMountpointS3CSIPolicyPtrOutput)
+}
+
+// The Node Termination Handler policy to the role.
+func (o EKSRolePoliciesOutput) NodeTerminationHandler() EKSNodeTerminationHandlerPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSNodeTerminationHandlerPolicy { return v.NodeTerminationHandler }).(EKSNodeTerminationHandlerPolicyPtrOutput)
+}
+
+// The Secrets Store CSI driver IAM policy.
+func (o EKSRolePoliciesOutput) SecretsStoreCsi() EKSSecretsStoreCSIPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSSecretsStoreCSIPolicy { return v.SecretsStoreCsi }).(EKSSecretsStoreCSIPolicyPtrOutput)
+}
+
+// The Velero IAM policy.
+func (o EKSRolePoliciesOutput) Velero() EKSVeleroPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSVeleroPolicy { return v.Velero }).(EKSVeleroPolicyPtrOutput)
+}
+
+// The VPC CNI IAM policy to the role.
+func (o EKSRolePoliciesOutput) VpnCni() EKSVPNCNIPolicyPtrOutput {
+	return o.ApplyT(func(v EKSRolePolicies) *EKSVPNCNIPolicy { return v.VpnCni }).(EKSVPNCNIPolicyPtrOutput)
+}
+
+type EKSRolePoliciesPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSRolePoliciesPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSRolePolicies)(nil)).Elem()
+}
+
+func (o EKSRolePoliciesPtrOutput) ToEKSRolePoliciesPtrOutput() EKSRolePoliciesPtrOutput {
+	return o
+}
+
+func (o EKSRolePoliciesPtrOutput) ToEKSRolePoliciesPtrOutputWithContext(ctx context.Context) EKSRolePoliciesPtrOutput {
+	return o
+}
+
+func (o EKSRolePoliciesPtrOutput) Elem() EKSRolePoliciesOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) EKSRolePolicies {
+		if v != nil {
+			return *v
+		}
+		var ret EKSRolePolicies
+		return ret
+	}).(EKSRolePoliciesOutput)
+}
+
+// The AWS Distro for OpenTelemetry IAM policy.
+func (o EKSRolePoliciesPtrOutput) Adot() EKSADOTPolicyPtrOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) *EKSADOTPolicy {
+		if v == nil {
+			return nil
+		}
+		return v.Adot
+	}).(EKSADOTPolicyPtrOutput)
+}
+
+// The Amazon Managed Service for Prometheus IAM policy.
+func (o EKSRolePoliciesPtrOutput) AmazonManagedServicePrometheus() EKSAmazonManagedServicePrometheusPolicyPtrOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) *EKSAmazonManagedServicePrometheusPolicy {
+		if v == nil {
+			return nil
+		}
+		return v.AmazonManagedServicePrometheus
+	}).(EKSAmazonManagedServicePrometheusPolicyPtrOutput)
+}
+
+// The Appmesh policies.
+func (o EKSRolePoliciesPtrOutput) Appmesh() EKSAppmeshPolicyPtrOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) *EKSAppmeshPolicy {
+		if v == nil {
+			return nil
+		}
+		return v.Appmesh
+	}).(EKSAppmeshPolicyPtrOutput)
+}
+
+// The Cert Manager IAM policy.
+func (o EKSRolePoliciesPtrOutput) CertManager() EKSCertManagerPolicyPtrOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) *EKSCertManagerPolicy {
+		if v == nil {
+			return nil
+		}
+		return v.CertManager
+	}).(EKSCertManagerPolicyPtrOutput)
+}
+
+// The CloudWatch Observability IAM policy.
+func (o EKSRolePoliciesPtrOutput) CloudwatchObservability() EKSCloudWatchObservabilityPolicyPtrOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) *EKSCloudWatchObservabilityPolicy {
+		if v == nil {
+			return nil
+		}
+		return v.CloudwatchObservability
+	}).(EKSCloudWatchObservabilityPolicyPtrOutput)
+}
+
+// The Cluster Autoscaler IAM policy.
+func (o EKSRolePoliciesPtrOutput) ClusterAutoScaling() EKSClusterAutoscalerPolicyPtrOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) *EKSClusterAutoscalerPolicy {
+		if v == nil {
+			return nil
+		}
+		return v.ClusterAutoScaling
+	}).(EKSClusterAutoscalerPolicyPtrOutput)
+}
+
+// Arguments of organization specific add-ons, keyed by the name they are registered with in
+// the provider's add-on registry. Unknown add-on names are rejected.
+func (o EKSRolePoliciesPtrOutput) Custom() pulumi.MapOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) map[string]interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.Custom
+	}).(pulumi.MapOutput)
+}
+
+// The EBS CSI IAM policy.
+func (o EKSRolePoliciesPtrOutput) EbsCsi() EKSEBSCSIPolicyPtrOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) *EKSEBSCSIPolicy {
+		if v == nil {
+			return nil
+		}
+		return v.EbsCsi
+	}).(EKSEBSCSIPolicyPtrOutput)
+}
+
+// The EFS CSI IAM policy.
+func (o EKSRolePoliciesPtrOutput) EfsCsi() EKSEFSCSIPolicyPtrOutput {
 	return o.ApplyT(func(v *EKSRolePolicies) *EKSEFSCSIPolicy {
 		if v == nil {
 			return nil
@@ -2789,8 +5444,18 @@ func (o EKSRolePoliciesPtrOutput) FsxLustreCsi() FSxLustreCSIPolicyPtrOutput {
 		if v == nil {
 			return nil
 		}
-		return v.FsxLustreCsi
-	}).(FSxLustreCSIPolicyPtrOutput)
+		return v.FsxLustreCsi
+	}).(FSxLustreCSIPolicyPtrOutput)
+}
+
+// The VPC Lattice Gateway API Controller IAM policy.
+func (o EKSRolePoliciesPtrOutput) GatewayApiController() EKSGatewayAPIControllerPolicyPtrOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) *EKSGatewayAPIControllerPolicy {
+		if v == nil {
+			return nil
+		}
+		return v.GatewayApiController
+	}).(EKSGatewayAPIControllerPolicyPtrOutput)
 }
 
 // The Karpenter Controller policy.
@@ -2803,6 +5468,16 @@ func (o EKSRolePoliciesPtrOutput) KarpenterController() EKSKarpenterControllerPo
 	}).(EKSKarpenterControllerPolicyPtrOutput)
 }
 
+// The KEDA IAM policy.
+func (o EKSRolePoliciesPtrOutput) Keda() EKSKEDAPolicyPtrOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) *EKSKEDAPolicy {
+		if v == nil {
+			return nil
+		}
+		return v.Keda
+	}).(EKSKEDAPolicyPtrOutput)
+}
+
 // The Load Balancer policy.
 func (o EKSRolePoliciesPtrOutput) LoadBalancer() EKSLoadBalancerPolicyPtrOutput {
 	return o.ApplyT(func(v *EKSRolePolicies) *EKSLoadBalancerPolicy {
@@ -2813,6 +5488,16 @@ func (o EKSRolePoliciesPtrOutput) LoadBalancer() EKSLoadBalancerPolicyPtrOutput
 	}).(EKSLoadBalancerPolicyPtrOutput)
 }
 
+// The Mountpoint for Amazon S3 CSI IAM policy.
+func (o EKSRolePoliciesPtrOutput) MountpointS3Csi() EKSMountpointS3CSIPolicyPtrOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) *EKSMountpointS3CSIPolicy {
+		if v == nil {
+			return nil
+		}
+		return v.MountpointS3Csi
+	}).(EKSMountpointS3CSIPolicyPtrOutput)
+}
+
 // The Node Termination Handler policy to the role.
 func (o EKSRolePoliciesPtrOutput) NodeTerminationHandler() EKSNodeTerminationHandlerPolicyPtrOutput {
 	return o.ApplyT(func(v *EKSRolePolicies) *EKSNodeTerminationHandlerPolicy {
@@ -2823,6 +5508,16 @@ func (o EKSRolePoliciesPtrOutput) NodeTerminationHandler() EKSNodeTerminationHan
 	}).(EKSNodeTerminationHandlerPolicyPtrOutput)
 }
 
+// The Secrets Store CSI driver IAM policy.
+func (o EKSRolePoliciesPtrOutput) SecretsStoreCsi() EKSSecretsStoreCSIPolicyPtrOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) *EKSSecretsStoreCSIPolicy {
+		if v == nil {
+			return nil
+		}
+		return v.SecretsStoreCsi
+	}).(EKSSecretsStoreCSIPolicyPtrOutput)
+}
+
 // The Velero IAM policy.
 func (o EKSRolePoliciesPtrOutput) Velero() EKSVeleroPolicyPtrOutput {
 	return o.ApplyT(func(v *EKSRolePolicies) *EKSVeleroPolicy {
@@ -2833,128 +5528,575 @@ func (o EKSRolePoliciesPtrOutput) Velero() EKSVeleroPolicyPtrOutput {
 	}).(EKSVeleroPolicyPtrOutput)
 }
 
-// The VPC CNI IAM policy to the role.
-func (o EKSRolePoliciesPtrOutput) VpnCni() EKSVPNCNIPolicyPtrOutput {
-	return o.ApplyT(func(v *EKSRolePolicies) *EKSVPNCNIPolicy {
-		if v == nil {
-			return nil
-		}
-		return v.VpnCni
-	}).(EKSVPNCNIPolicyPtrOutput)
+// The VPC CNI IAM policy to the role.
+func (o EKSRolePoliciesPtrOutput) VpnCni() EKSVPNCNIPolicyPtrOutput {
+	return o.ApplyT(func(v *EKSRolePolicies) *EKSVPNCNIPolicy {
+		if v == nil {
+			return nil
+		}
+		return v.VpnCni
+	}).(EKSVPNCNIPolicyPtrOutput)
+}
+
+// The Secrets Store CSI driver IAM policy to the role.
+type EKSSecretsStoreCSIPolicy struct {
+	// Determines whether to attach the Secrets Store CSI driver IAM policy to the role.
+	Attach *bool `pulumi:"attach"`
+	// List of Secrets Manager ARNs that contain secrets to mount using the Secrets Store CSI driver.
+	// If not provided, a default ARN of "arn:aws:secretsmanager:*:*:secret:*" will be provided.
+	SecretsManagerArns []string `pulumi:"secretsManagerArns"`
+	// List of Systems Manager Parameter ARNs that contain secrets to mount using the Secrets Store CSI driver.
+	// If not provided, a default ARN of "arn:aws:ssm:*:*:parameter/*" will be provided.
+	SsmParameterArns []string `pulumi:"ssmParameterArns"`
+}
+
+// EKSSecretsStoreCSIPolicyInput is an input type that accepts EKSSecretsStoreCSIPolicyArgs and EKSSecretsStoreCSIPolicyOutput values.
+// You can construct a concrete instance of `EKSSecretsStoreCSIPolicyInput` via:
+//
+//	EKSSecretsStoreCSIPolicyArgs{...}
+type EKSSecretsStoreCSIPolicyInput interface {
+	pulumi.Input
+
+	ToEKSSecretsStoreCSIPolicyOutput() EKSSecretsStoreCSIPolicyOutput
+	ToEKSSecretsStoreCSIPolicyOutputWithContext(context.Context) EKSSecretsStoreCSIPolicyOutput
+}
+
+// The Secrets Store CSI driver IAM policy to the role.
+type EKSSecretsStoreCSIPolicyArgs struct {
+	// Determines whether to attach the Secrets Store CSI driver IAM policy to the role.
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
+	// List of Secrets Manager ARNs that contain secrets to mount using the Secrets Store CSI driver.
+	// If not provided, a default ARN of "arn:aws:secretsmanager:*:*:secret:*" will be provided.
+	SecretsManagerArns pulumi.StringArrayInput `pulumi:"secretsManagerArns"`
+	// List of Systems Manager Parameter ARNs that contain secrets to mount using the Secrets Store CSI driver.
+	// If not provided, a default ARN of "arn:aws:ssm:*:*:parameter/*" will be provided.
+	SsmParameterArns pulumi.StringArrayInput `pulumi:"ssmParameterArns"`
+}
+
+func (EKSSecretsStoreCSIPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSSecretsStoreCSIPolicy)(nil)).Elem()
+}
+
+func (i EKSSecretsStoreCSIPolicyArgs) ToEKSSecretsStoreCSIPolicyOutput() EKSSecretsStoreCSIPolicyOutput {
+	return i.ToEKSSecretsStoreCSIPolicyOutputWithContext(context.Background())
+}
+
+func (i EKSSecretsStoreCSIPolicyArgs) ToEKSSecretsStoreCSIPolicyOutputWithContext(ctx context.Context) EKSSecretsStoreCSIPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSSecretsStoreCSIPolicyOutput)
+}
+
+func (i EKSSecretsStoreCSIPolicyArgs) ToEKSSecretsStoreCSIPolicyPtrOutput() EKSSecretsStoreCSIPolicyPtrOutput {
+	return i.ToEKSSecretsStoreCSIPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i EKSSecretsStoreCSIPolicyArgs) ToEKSSecretsStoreCSIPolicyPtrOutputWithContext(ctx context.Context) EKSSecretsStoreCSIPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSSecretsStoreCSIPolicyOutput).ToEKSSecretsStoreCSIPolicyPtrOutputWithContext(ctx)
+}
+
+// EKSSecretsStoreCSIPolicyPtrInput is an input type that accepts EKSSecretsStoreCSIPolicyArgs, EKSSecretsStoreCSIPolicyPtr and EKSSecretsStoreCSIPolicyPtrOutput values.
+// You can construct a concrete instance of `EKSSecretsStoreCSIPolicyPtrInput` via:
+//
+//	        EKSSecretsStoreCSIPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSSecretsStoreCSIPolicyPtrInput interface {
+	pulumi.Input
+
+	ToEKSSecretsStoreCSIPolicyPtrOutput() EKSSecretsStoreCSIPolicyPtrOutput
+	ToEKSSecretsStoreCSIPolicyPtrOutputWithContext(context.Context) EKSSecretsStoreCSIPolicyPtrOutput
+}
+
+type ekssecretsStoreCSIPolicyPtrType EKSSecretsStoreCSIPolicyArgs
+
+func EKSSecretsStoreCSIPolicyPtr(v *EKSSecretsStoreCSIPolicyArgs) EKSSecretsStoreCSIPolicyPtrInput {
+	return (*ekssecretsStoreCSIPolicyPtrType)(v)
+}
+
+func (*ekssecretsStoreCSIPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSSecretsStoreCSIPolicy)(nil)).Elem()
+}
+
+func (i *ekssecretsStoreCSIPolicyPtrType) ToEKSSecretsStoreCSIPolicyPtrOutput() EKSSecretsStoreCSIPolicyPtrOutput {
+	return i.ToEKSSecretsStoreCSIPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *ekssecretsStoreCSIPolicyPtrType) ToEKSSecretsStoreCSIPolicyPtrOutputWithContext(ctx context.Context) EKSSecretsStoreCSIPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSSecretsStoreCSIPolicyPtrOutput)
+}
+
+// The Secrets Store CSI driver IAM policy to the role.
+type EKSSecretsStoreCSIPolicyOutput struct{ *pulumi.OutputState }
+
+func (EKSSecretsStoreCSIPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSSecretsStoreCSIPolicy)(nil)).Elem()
+}
+
+func (o EKSSecretsStoreCSIPolicyOutput) ToEKSSecretsStoreCSIPolicyOutput() EKSSecretsStoreCSIPolicyOutput {
+	return o
+}
+
+func (o EKSSecretsStoreCSIPolicyOutput) ToEKSSecretsStoreCSIPolicyOutputWithContext(ctx context.Context) EKSSecretsStoreCSIPolicyOutput {
+	return o
+}
+
+func (o EKSSecretsStoreCSIPolicyOutput) ToEKSSecretsStoreCSIPolicyPtrOutput() EKSSecretsStoreCSIPolicyPtrOutput {
+	return o.ToEKSSecretsStoreCSIPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o EKSSecretsStoreCSIPolicyOutput) ToEKSSecretsStoreCSIPolicyPtrOutputWithContext(ctx context.Context) EKSSecretsStoreCSIPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSSecretsStoreCSIPolicy) *EKSSecretsStoreCSIPolicy {
+		return &v
+	}).(EKSSecretsStoreCSIPolicyPtrOutput)
+}
+
+// Determines whether to attach the Secrets Store CSI driver IAM policy to the role.
+func (o EKSSecretsStoreCSIPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSSecretsStoreCSIPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
+}
+
+// List of Secrets Manager ARNs that contain secrets to mount using the Secrets Store CSI driver.
+// If not provided, a default ARN of "arn:aws:secretsmanager:*:*:secret:*" will be provided.
+func (o EKSSecretsStoreCSIPolicyOutput) SecretsManagerArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSSecretsStoreCSIPolicy) []string { return v.SecretsManagerArns }).(pulumi.StringArrayOutput)
+}
+
+// List of Systems Manager Parameter ARNs that contain secrets to mount using the Secrets Store CSI driver.
+// If not provided, a default ARN of "arn:aws:ssm:*:*:parameter/*" will be provided.
+func (o EKSSecretsStoreCSIPolicyOutput) SsmParameterArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSSecretsStoreCSIPolicy) []string { return v.SsmParameterArns }).(pulumi.StringArrayOutput)
+}
+
+type EKSSecretsStoreCSIPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSSecretsStoreCSIPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSSecretsStoreCSIPolicy)(nil)).Elem()
+}
+
+func (o EKSSecretsStoreCSIPolicyPtrOutput) ToEKSSecretsStoreCSIPolicyPtrOutput() EKSSecretsStoreCSIPolicyPtrOutput {
+	return o
+}
+
+func (o EKSSecretsStoreCSIPolicyPtrOutput) ToEKSSecretsStoreCSIPolicyPtrOutputWithContext(ctx context.Context) EKSSecretsStoreCSIPolicyPtrOutput {
+	return o
+}
+
+func (o EKSSecretsStoreCSIPolicyPtrOutput) Elem() EKSSecretsStoreCSIPolicyOutput {
+	return o.ApplyT(func(v *EKSSecretsStoreCSIPolicy) EKSSecretsStoreCSIPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret EKSSecretsStoreCSIPolicy
+		return ret
+	}).(EKSSecretsStoreCSIPolicyOutput)
+}
+
+// Determines whether to attach the Secrets Store CSI driver IAM policy to the role.
+func (o EKSSecretsStoreCSIPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSSecretsStoreCSIPolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Attach
+	}).(pulumi.BoolPtrOutput)
+}
+
+// List of Secrets Manager ARNs that contain secrets to mount using the Secrets Store CSI driver.
+// If not provided, a default ARN of "arn:aws:secretsmanager:*:*:secret:*" will be provided.
+func (o EKSSecretsStoreCSIPolicyPtrOutput) SecretsManagerArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSSecretsStoreCSIPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.SecretsManagerArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// List of Systems Manager Parameter ARNs that contain secrets to mount using the Secrets Store CSI driver.
+// If not provided, a default ARN of "arn:aws:ssm:*:*:parameter/*" will be provided.
+func (o EKSSecretsStoreCSIPolicyPtrOutput) SsmParameterArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *EKSSecretsStoreCSIPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.SsmParameterArns
+	}).(pulumi.StringArrayOutput)
+}
+
+// EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount.
+type EKSServiceAccount struct {
+	// Name of the EKS cluster.
+	Name *string `pulumi:"name"`
+	// Service accounts to pair with the cluster, as `namespace:name`. Wildcards such as `team-a:*` are matched with StringLike.
+	ServiceAccounts []string `pulumi:"serviceAccounts"`
+}
+
+// EKSServiceAccountInput is an input type that accepts EKSServiceAccountArgs and EKSServiceAccountOutput values.
+// You can construct a concrete instance of `EKSServiceAccountInput` via:
+//
+//	EKSServiceAccountArgs{...}
+type EKSServiceAccountInput interface {
+	pulumi.Input
+
+	ToEKSServiceAccountOutput() EKSServiceAccountOutput
+	ToEKSServiceAccountOutputWithContext(context.Context) EKSServiceAccountOutput
+}
+
+// EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount.
+type EKSServiceAccountArgs struct {
+	// Name of the EKS cluster.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// Service accounts to pair with the cluster, as `namespace:name`. Wildcards such as `team-a:*` are matched with StringLike.
+	ServiceAccounts pulumi.StringArrayInput `pulumi:"serviceAccounts"`
+}
+
+func (EKSServiceAccountArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSServiceAccount)(nil)).Elem()
+}
+
+func (i EKSServiceAccountArgs) ToEKSServiceAccountOutput() EKSServiceAccountOutput {
+	return i.ToEKSServiceAccountOutputWithContext(context.Background())
+}
+
+func (i EKSServiceAccountArgs) ToEKSServiceAccountOutputWithContext(ctx context.Context) EKSServiceAccountOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSServiceAccountOutput)
+}
+
+// EKSServiceAccountArrayInput is an input type that accepts EKSServiceAccountArray and EKSServiceAccountArrayOutput values.
+// You can construct a concrete instance of `EKSServiceAccountArrayInput` via:
+//
+//	EKSServiceAccountArray{ EKSServiceAccountArgs{...} }
+type EKSServiceAccountArrayInput interface {
+	pulumi.Input
+
+	ToEKSServiceAccountArrayOutput() EKSServiceAccountArrayOutput
+	ToEKSServiceAccountArrayOutputWithContext(context.Context) EKSServiceAccountArrayOutput
+}
+
+type EKSServiceAccountArray []EKSServiceAccountInput
+
+func (EKSServiceAccountArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]EKSServiceAccount)(nil)).Elem()
+}
+
+func (i EKSServiceAccountArray) ToEKSServiceAccountArrayOutput() EKSServiceAccountArrayOutput {
+	return i.ToEKSServiceAccountArrayOutputWithContext(context.Background())
+}
+
+func (i EKSServiceAccountArray) ToEKSServiceAccountArrayOutputWithContext(ctx context.Context) EKSServiceAccountArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSServiceAccountArrayOutput)
+}
+
+// EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount.
+type EKSServiceAccountOutput struct{ *pulumi.OutputState }
+
+func (EKSServiceAccountOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSServiceAccount)(nil)).Elem()
+}
+
+func (o EKSServiceAccountOutput) ToEKSServiceAccountOutput() EKSServiceAccountOutput {
+	return o
+}
+
+func (o EKSServiceAccountOutput) ToEKSServiceAccountOutputWithContext(ctx context.Context) EKSServiceAccountOutput {
+	return o
+}
+
+// Name of the EKS cluster.
+func (o EKSServiceAccountOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EKSServiceAccount) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// Service accounts to pair with the cluster, as `namespace:name`. Wildcards such as `team-a:*` are matched with StringLike.
+func (o EKSServiceAccountOutput) ServiceAccounts() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v EKSServiceAccount) []string { return v.ServiceAccounts }).(pulumi.StringArrayOutput)
+}
+
+type EKSServiceAccountArrayOutput struct{ *pulumi.OutputState }
+
+func (EKSServiceAccountArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]EKSServiceAccount)(nil)).Elem()
+}
+
+func (o EKSServiceAccountArrayOutput) ToEKSServiceAccountArrayOutput() EKSServiceAccountArrayOutput {
+	return o
+}
+
+func (o EKSServiceAccountArrayOutput) ToEKSServiceAccountArrayOutputWithContext(ctx context.Context) EKSServiceAccountArrayOutput {
+	return o
+}
+
+func (o EKSServiceAccountArrayOutput) Index(i pulumi.IntInput) EKSServiceAccountOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) EKSServiceAccount {
+		return vs[0].([]EKSServiceAccount)[vs[1].(int)]
+	}).(EKSServiceAccountOutput)
+}
+
+type EKSServiceAccountManifest struct {
+	// Annotations binding the ServiceAccount to the IAM role.
+	Annotations map[string]string `pulumi:"annotations"`
+	// ServiceAccount manifest as JSON.
+	Json string `pulumi:"json"`
+	// Name of the ServiceAccount.
+	Name string `pulumi:"name"`
+	// Namespace of the ServiceAccount.
+	Namespace string `pulumi:"namespace"`
+	// ServiceAccount manifest as YAML.
+	Yaml string `pulumi:"yaml"`
+}
+
+type EKSServiceAccountManifestOutput struct{ *pulumi.OutputState }
+
+func (EKSServiceAccountManifestOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSServiceAccountManifest)(nil)).Elem()
+}
+
+func (o EKSServiceAccountManifestOutput) ToEKSServiceAccountManifestOutput() EKSServiceAccountManifestOutput {
+	return o
+}
+
+func (o EKSServiceAccountManifestOutput) ToEKSServiceAccountManifestOutputWithContext(ctx context.Context) EKSServiceAccountManifestOutput {
+	return o
+}
+
+// Annotations binding the ServiceAccount to the IAM role.
+func (o EKSServiceAccountManifestOutput) Annotations() pulumi.StringMapOutput {
+	return o.ApplyT(func(v EKSServiceAccountManifest) map[string]string { return v.Annotations }).(pulumi.StringMapOutput)
+}
+
+// ServiceAccount manifest as JSON.
+func (o EKSServiceAccountManifestOutput) Json() pulumi.StringOutput {
+	return o.ApplyT(func(v EKSServiceAccountManifest) string { return v.Json }).(pulumi.StringOutput)
+}
+
+// Name of the ServiceAccount.
+func (o EKSServiceAccountManifestOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v EKSServiceAccountManifest) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Namespace of the ServiceAccount.
+func (o EKSServiceAccountManifestOutput) Namespace() pulumi.StringOutput {
+	return o.ApplyT(func(v EKSServiceAccountManifest) string { return v.Namespace }).(pulumi.StringOutput)
+}
+
+// ServiceAccount manifest as YAML.
+func (o EKSServiceAccountManifestOutput) Yaml() pulumi.StringOutput {
+	return o.ApplyT(func(v EKSServiceAccountManifest) string { return v.Yaml }).(pulumi.StringOutput)
+}
+
+type EKSServiceAccountManifestArrayOutput struct{ *pulumi.OutputState }
+
+func (EKSServiceAccountManifestArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]EKSServiceAccountManifest)(nil)).Elem()
+}
+
+func (o EKSServiceAccountManifestArrayOutput) ToEKSServiceAccountManifestArrayOutput() EKSServiceAccountManifestArrayOutput {
+	return o
+}
+
+func (o EKSServiceAccountManifestArrayOutput) ToEKSServiceAccountManifestArrayOutputWithContext(ctx context.Context) EKSServiceAccountManifestArrayOutput {
+	return o
 }
 
-// EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount.
-type EKSServiceAccount struct {
-	// Name of the EKS cluster.
-	Name *string `pulumi:"name"`
-	// Service accounts to pair with the cluster.
-	ServiceAccounts []string `pulumi:"serviceAccounts"`
+func (o EKSServiceAccountManifestArrayOutput) Index(i pulumi.IntInput) EKSServiceAccountManifestOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) EKSServiceAccountManifest {
+		return vs[0].([]EKSServiceAccountManifest)[vs[1].(int)]
+	}).(EKSServiceAccountManifestOutput)
 }
 
-// EKSServiceAccountInput is an input type that accepts EKSServiceAccountArgs and EKSServiceAccountOutput values.
-// You can construct a concrete instance of `EKSServiceAccountInput` via:
+type EKSServiceAccountManifestOptions struct {
+	// Whether to annotate the ServiceAccounts to use the regional STS endpoint.
+	StsRegionalEndpoints *bool `pulumi:"stsRegionalEndpoints"`
+	// Expiration of the projected ServiceAccount token in seconds. The annotation is left out when 0.
+	TokenExpiration *int `pulumi:"tokenExpiration"`
+}
+
+// Defaults sets the appropriate defaults for EKSServiceAccountManifestOptions
+func (val *EKSServiceAccountManifestOptions) Defaults() *EKSServiceAccountManifestOptions {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.StsRegionalEndpoints == nil {
+		stsRegionalEndpoints_ := false
+		tmp.StsRegionalEndpoints = &stsRegionalEndpoints_
+	}
+	return &tmp
+}
+
+// EKSServiceAccountManifestOptionsInput is an input type that accepts EKSServiceAccountManifestOptionsArgs and EKSServiceAccountManifestOptionsOutput values.
+// You can construct a concrete instance of `EKSServiceAccountManifestOptionsInput` via:
 //
-//	EKSServiceAccountArgs{...}
-type EKSServiceAccountInput interface {
+//	EKSServiceAccountManifestOptionsArgs{...}
+type EKSServiceAccountManifestOptionsInput interface {
 	pulumi.Input
 
-	ToEKSServiceAccountOutput() EKSServiceAccountOutput
-	ToEKSServiceAccountOutputWithContext(context.Context) EKSServiceAccountOutput
+	ToEKSServiceAccountManifestOptionsOutput() EKSServiceAccountManifestOptionsOutput
+	ToEKSServiceAccountManifestOptionsOutputWithContext(context.Context) EKSServiceAccountManifestOptionsOutput
 }
 
-// EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount.
-type EKSServiceAccountArgs struct {
-	// Name of the EKS cluster.
-	Name pulumi.StringPtrInput `pulumi:"name"`
-	// Service accounts to pair with the cluster.
-	ServiceAccounts pulumi.StringArrayInput `pulumi:"serviceAccounts"`
+type EKSServiceAccountManifestOptionsArgs struct {
+	// Whether to annotate the ServiceAccounts to use the regional STS endpoint.
+	StsRegionalEndpoints pulumi.BoolPtrInput `pulumi:"stsRegionalEndpoints"`
+	// Expiration of the projected ServiceAccount token in seconds. The annotation is left out when 0.
+	TokenExpiration pulumi.IntPtrInput `pulumi:"tokenExpiration"`
 }
 
-func (EKSServiceAccountArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSServiceAccount)(nil)).Elem()
+// Defaults sets the appropriate defaults for EKSServiceAccountManifestOptionsArgs
+func (val *EKSServiceAccountManifestOptionsArgs) Defaults() *EKSServiceAccountManifestOptionsArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.StsRegionalEndpoints == nil {
+		tmp.StsRegionalEndpoints = pulumi.BoolPtr(false)
+	}
+	return &tmp
+}
+func (EKSServiceAccountManifestOptionsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSServiceAccountManifestOptions)(nil)).Elem()
 }
 
-func (i EKSServiceAccountArgs) ToEKSServiceAccountOutput() EKSServiceAccountOutput {
-	return i.ToEKSServiceAccountOutputWithContext(context.Background())
+func (i EKSServiceAccountManifestOptionsArgs) ToEKSServiceAccountManifestOptionsOutput() EKSServiceAccountManifestOptionsOutput {
+	return i.ToEKSServiceAccountManifestOptionsOutputWithContext(context.Background())
 }
 
-func (i EKSServiceAccountArgs) ToEKSServiceAccountOutputWithContext(ctx context.Context) EKSServiceAccountOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSServiceAccountOutput)
+func (i EKSServiceAccountManifestOptionsArgs) ToEKSServiceAccountManifestOptionsOutputWithContext(ctx context.Context) EKSServiceAccountManifestOptionsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSServiceAccountManifestOptionsOutput)
 }
 
-// EKSServiceAccountArrayInput is an input type that accepts EKSServiceAccountArray and EKSServiceAccountArrayOutput values.
-// You can construct a concrete instance of `EKSServiceAccountArrayInput` via:
+func (i EKSServiceAccountManifestOptionsArgs) ToEKSServiceAccountManifestOptionsPtrOutput() EKSServiceAccountManifestOptionsPtrOutput {
+	return i.ToEKSServiceAccountManifestOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i EKSServiceAccountManifestOptionsArgs) ToEKSServiceAccountManifestOptionsPtrOutputWithContext(ctx context.Context) EKSServiceAccountManifestOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSServiceAccountManifestOptionsOutput).ToEKSServiceAccountManifestOptionsPtrOutputWithContext(ctx)
+}
+
+// EKSServiceAccountManifestOptionsPtrInput is an input type that accepts EKSServiceAccountManifestOptionsArgs, EKSServiceAccountManifestOptionsPtr and EKSServiceAccountManifestOptionsPtrOutput values.
+// You can construct a concrete instance of `EKSServiceAccountManifestOptionsPtrInput` via:
 //
-//	EKSServiceAccountArray{ EKSServiceAccountArgs{...} }
-type EKSServiceAccountArrayInput interface {
+//	        EKSServiceAccountManifestOptionsArgs{...}
+//
+//	or:
+//
+//	        nil
+type EKSServiceAccountManifestOptionsPtrInput interface {
 	pulumi.Input
 
-	ToEKSServiceAccountArrayOutput() EKSServiceAccountArrayOutput
-	ToEKSServiceAccountArrayOutputWithContext(context.Context) EKSServiceAccountArrayOutput
+	ToEKSServiceAccountManifestOptionsPtrOutput() EKSServiceAccountManifestOptionsPtrOutput
+	ToEKSServiceAccountManifestOptionsPtrOutputWithContext(context.Context) EKSServiceAccountManifestOptionsPtrOutput
 }
 
-type EKSServiceAccountArray []EKSServiceAccountInput
+type eksserviceAccountManifestOptionsPtrType EKSServiceAccountManifestOptionsArgs
 
-func (EKSServiceAccountArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]EKSServiceAccount)(nil)).Elem()
+func EKSServiceAccountManifestOptionsPtr(v *EKSServiceAccountManifestOptionsArgs) EKSServiceAccountManifestOptionsPtrInput {
+	return (*eksserviceAccountManifestOptionsPtrType)(v)
 }
 
-func (i EKSServiceAccountArray) ToEKSServiceAccountArrayOutput() EKSServiceAccountArrayOutput {
-	return i.ToEKSServiceAccountArrayOutputWithContext(context.Background())
+func (*eksserviceAccountManifestOptionsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSServiceAccountManifestOptions)(nil)).Elem()
 }
 
-func (i EKSServiceAccountArray) ToEKSServiceAccountArrayOutputWithContext(ctx context.Context) EKSServiceAccountArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(EKSServiceAccountArrayOutput)
+func (i *eksserviceAccountManifestOptionsPtrType) ToEKSServiceAccountManifestOptionsPtrOutput() EKSServiceAccountManifestOptionsPtrOutput {
+	return i.ToEKSServiceAccountManifestOptionsPtrOutputWithContext(context.Background())
 }
 
-// EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount.
-type EKSServiceAccountOutput struct{ *pulumi.OutputState }
+func (i *eksserviceAccountManifestOptionsPtrType) ToEKSServiceAccountManifestOptionsPtrOutputWithContext(ctx context.Context) EKSServiceAccountManifestOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EKSServiceAccountManifestOptionsPtrOutput)
+}
 
-func (EKSServiceAccountOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*EKSServiceAccount)(nil)).Elem()
+type EKSServiceAccountManifestOptionsOutput struct{ *pulumi.OutputState }
+
+func (EKSServiceAccountManifestOptionsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EKSServiceAccountManifestOptions)(nil)).Elem()
 }
 
-func (o EKSServiceAccountOutput) ToEKSServiceAccountOutput() EKSServiceAccountOutput {
+func (o EKSServiceAccountManifestOptionsOutput) ToEKSServiceAccountManifestOptionsOutput() EKSServiceAccountManifestOptionsOutput {
 	return o
 }
 
-func (o EKSServiceAccountOutput) ToEKSServiceAccountOutputWithContext(ctx context.Context) EKSServiceAccountOutput {
+func (o EKSServiceAccountManifestOptionsOutput) ToEKSServiceAccountManifestOptionsOutputWithContext(ctx context.Context) EKSServiceAccountManifestOptionsOutput {
 	return o
 }
 
-// Name of the EKS cluster.
-func (o EKSServiceAccountOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v EKSServiceAccount) *string { return v.Name }).(pulumi.StringPtrOutput)
+func (o EKSServiceAccountManifestOptionsOutput) ToEKSServiceAccountManifestOptionsPtrOutput() EKSServiceAccountManifestOptionsPtrOutput {
+	return o.ToEKSServiceAccountManifestOptionsPtrOutputWithContext(context.Background())
 }
 
-// Service accounts to pair with the cluster.
-func (o EKSServiceAccountOutput) ServiceAccounts() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v EKSServiceAccount) []string { return v.ServiceAccounts }).(pulumi.StringArrayOutput)
+func (o EKSServiceAccountManifestOptionsOutput) ToEKSServiceAccountManifestOptionsPtrOutputWithContext(ctx context.Context) EKSServiceAccountManifestOptionsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EKSServiceAccountManifestOptions) *EKSServiceAccountManifestOptions {
+		return &v
+	}).(EKSServiceAccountManifestOptionsPtrOutput)
 }
 
-type EKSServiceAccountArrayOutput struct{ *pulumi.OutputState }
+// Whether to annotate the ServiceAccounts to use the regional STS endpoint.
+func (o EKSServiceAccountManifestOptionsOutput) StsRegionalEndpoints() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSServiceAccountManifestOptions) *bool { return v.StsRegionalEndpoints }).(pulumi.BoolPtrOutput)
+}
 
-func (EKSServiceAccountArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]EKSServiceAccount)(nil)).Elem()
+// Expiration of the projected ServiceAccount token in seconds. The annotation is left out when 0.
+func (o EKSServiceAccountManifestOptionsOutput) TokenExpiration() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v EKSServiceAccountManifestOptions) *int { return v.TokenExpiration }).(pulumi.IntPtrOutput)
 }
 
-func (o EKSServiceAccountArrayOutput) ToEKSServiceAccountArrayOutput() EKSServiceAccountArrayOutput {
+type EKSServiceAccountManifestOptionsPtrOutput struct{ *pulumi.OutputState }
+
+func (EKSServiceAccountManifestOptionsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EKSServiceAccountManifestOptions)(nil)).Elem()
+}
+
+func (o EKSServiceAccountManifestOptionsPtrOutput) ToEKSServiceAccountManifestOptionsPtrOutput() EKSServiceAccountManifestOptionsPtrOutput {
 	return o
 }
 
-func (o EKSServiceAccountArrayOutput) ToEKSServiceAccountArrayOutputWithContext(ctx context.Context) EKSServiceAccountArrayOutput {
+func (o EKSServiceAccountManifestOptionsPtrOutput) ToEKSServiceAccountManifestOptionsPtrOutputWithContext(ctx context.Context) EKSServiceAccountManifestOptionsPtrOutput {
 	return o
 }
 
-func (o EKSServiceAccountArrayOutput) Index(i pulumi.IntInput) EKSServiceAccountOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) EKSServiceAccount {
-		return vs[0].([]EKSServiceAccount)[vs[1].(int)]
-	}).(EKSServiceAccountOutput)
+func (o EKSServiceAccountManifestOptionsPtrOutput) Elem() EKSServiceAccountManifestOptionsOutput {
+	return o.ApplyT(func(v *EKSServiceAccountManifestOptions) EKSServiceAccountManifestOptions {
+		if v != nil {
+			return *v
+		}
+		var ret EKSServiceAccountManifestOptions
+		return ret
+	}).(EKSServiceAccountManifestOptionsOutput)
+}
+
+// Whether to annotate the ServiceAccounts to use the regional STS endpoint.
+func (o EKSServiceAccountManifestOptionsPtrOutput) StsRegionalEndpoints() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSServiceAccountManifestOptions) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.StsRegionalEndpoints
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Expiration of the projected ServiceAccount token in seconds. The annotation is left out when 0.
+func (o EKSServiceAccountManifestOptionsPtrOutput) TokenExpiration() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *EKSServiceAccountManifestOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.TokenExpiration
+	}).(pulumi.IntPtrOutput)
 }
 
 type EKSServiceAccountRole struct {
 	// IAM Role description.
 	Description *string `pulumi:"description"`
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies *bool `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments *bool `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies map[string]string `pulumi:"inlinePolicies"`
 	// IAM role name.
 	Name *string `pulumi:"name"`
 	// IAM role name prefix.
@@ -2967,6 +6109,23 @@ type EKSServiceAccountRole struct {
 	PolicyArns []string `pulumi:"policyArns"`
 }
 
+// Defaults sets the appropriate defaults for EKSServiceAccountRole
+func (val *EKSServiceAccountRole) Defaults() *EKSServiceAccountRole {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		exclusiveInlinePolicies_ := false
+		tmp.ExclusiveInlinePolicies = &exclusiveInlinePolicies_
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		exclusivePolicyAttachments_ := false
+		tmp.ExclusivePolicyAttachments = &exclusivePolicyAttachments_
+	}
+	return &tmp
+}
+
 // EKSServiceAccountRoleInput is an input type that accepts EKSServiceAccountRoleArgs and EKSServiceAccountRoleOutput values.
 // You can construct a concrete instance of `EKSServiceAccountRoleInput` via:
 //
@@ -2981,6 +6140,15 @@ type EKSServiceAccountRoleInput interface {
 type EKSServiceAccountRoleArgs struct {
 	// IAM Role description.
 	Description pulumi.StringPtrInput `pulumi:"description"`
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies pulumi.BoolPtrInput `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments pulumi.BoolPtrInput `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies pulumi.StringMapInput `pulumi:"inlinePolicies"`
 	// IAM role name.
 	Name pulumi.StringPtrInput `pulumi:"name"`
 	// IAM role name prefix.
@@ -2993,6 +6161,20 @@ type EKSServiceAccountRoleArgs struct {
 	PolicyArns pulumi.StringArrayInput `pulumi:"policyArns"`
 }
 
+// Defaults sets the appropriate defaults for EKSServiceAccountRoleArgs
+func (val *EKSServiceAccountRoleArgs) Defaults() *EKSServiceAccountRoleArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		tmp.ExclusiveInlinePolicies = pulumi.BoolPtr(false)
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		tmp.ExclusivePolicyAttachments = pulumi.BoolPtr(false)
+	}
+	return &tmp
+}
 func (EKSServiceAccountRoleArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*EKSServiceAccountRole)(nil)).Elem()
 }
@@ -3075,6 +6257,24 @@ func (o EKSServiceAccountRoleOutput) Description() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v EKSServiceAccountRole) *string { return v.Description }).(pulumi.StringPtrOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o EKSServiceAccountRoleOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSServiceAccountRole) *bool { return v.ExclusiveInlinePolicies }).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o EKSServiceAccountRoleOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSServiceAccountRole) *bool { return v.ExclusivePolicyAttachments }).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o EKSServiceAccountRoleOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v EKSServiceAccountRole) map[string]string { return v.InlinePolicies }).(pulumi.StringMapOutput)
+}
+
 // IAM role name.
 func (o EKSServiceAccountRoleOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v EKSServiceAccountRole) *string { return v.Name }).(pulumi.StringPtrOutput)
@@ -3134,6 +6334,39 @@ func (o EKSServiceAccountRolePtrOutput) Description() pulumi.StringPtrOutput {
 	}).(pulumi.StringPtrOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o EKSServiceAccountRolePtrOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSServiceAccountRole) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusiveInlinePolicies
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o EKSServiceAccountRolePtrOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *EKSServiceAccountRole) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusivePolicyAttachments
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o EKSServiceAccountRolePtrOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *EKSServiceAccountRole) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.InlinePolicies
+	}).(pulumi.StringMapOutput)
+}
+
 // IAM role name.
 func (o EKSServiceAccountRolePtrOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *EKSServiceAccountRole) *string {
@@ -3187,7 +6420,7 @@ func (o EKSServiceAccountRolePtrOutput) PolicyArns() pulumi.StringArrayOutput {
 // The VPC CNI IAM policy to the role.
 type EKSVPNCNIPolicy struct {
 	// Determines whether to attach the VPC CNI IAM policy to the role.
-	Attach bool `pulumi:"attach"`
+	Attach *bool `pulumi:"attach"`
 	// Determines whether to enable IPv4 permissions for VPC CNI policy.
 	EnableIpv4 *bool `pulumi:"enableIpv4"`
 	// Determines whether to enable IPv6 permissions for VPC CNI policy.
@@ -3208,7 +6441,7 @@ type EKSVPNCNIPolicyInput interface {
 // The VPC CNI IAM policy to the role.
 type EKSVPNCNIPolicyArgs struct {
 	// Determines whether to attach the VPC CNI IAM policy to the role.
-	Attach pulumi.BoolInput `pulumi:"attach"`
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
 	// Determines whether to enable IPv4 permissions for VPC CNI policy.
 	EnableIpv4 pulumi.BoolPtrInput `pulumi:"enableIpv4"`
 	// Determines whether to enable IPv6 permissions for VPC CNI policy.
@@ -3294,8 +6527,8 @@ func (o EKSVPNCNIPolicyOutput) ToEKSVPNCNIPolicyPtrOutputWithContext(ctx context
 }
 
 // Determines whether to attach the VPC CNI IAM policy to the role.
-func (o EKSVPNCNIPolicyOutput) Attach() pulumi.BoolOutput {
-	return o.ApplyT(func(v EKSVPNCNIPolicy) bool { return v.Attach }).(pulumi.BoolOutput)
+func (o EKSVPNCNIPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSVPNCNIPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
 }
 
 // Determines whether to enable IPv4 permissions for VPC CNI policy.
@@ -3338,7 +6571,7 @@ func (o EKSVPNCNIPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
 		if v == nil {
 			return nil
 		}
-		return &v.Attach
+		return v.Attach
 	}).(pulumi.BoolPtrOutput)
 }
 
@@ -3365,7 +6598,7 @@ func (o EKSVPNCNIPolicyPtrOutput) EnableIpv6() pulumi.BoolPtrOutput {
 // The Velero IAM policy to the role.
 type EKSVeleroPolicy struct {
 	// Determines whether to attach the Velero IAM policy to the role.
-	Attach bool `pulumi:"attach"`
+	Attach *bool `pulumi:"attach"`
 	// List of S3 Bucket ARNs that Velero needs access to in order to backup and restore cluster resources.
 	// If not provided, a default ARN of "*" will be provided.
 	S3BucketArns []string `pulumi:"s3BucketArns"`
@@ -3385,7 +6618,7 @@ type EKSVeleroPolicyInput interface {
 // The Velero IAM policy to the role.
 type EKSVeleroPolicyArgs struct {
 	// Determines whether to attach the Velero IAM policy to the role.
-	Attach pulumi.BoolInput `pulumi:"attach"`
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
 	// List of S3 Bucket ARNs that Velero needs access to in order to backup and restore cluster resources.
 	// If not provided, a default ARN of "*" will be provided.
 	S3BucketArns pulumi.StringArrayInput `pulumi:"s3BucketArns"`
@@ -3470,8 +6703,8 @@ func (o EKSVeleroPolicyOutput) ToEKSVeleroPolicyPtrOutputWithContext(ctx context
 }
 
 // Determines whether to attach the Velero IAM policy to the role.
-func (o EKSVeleroPolicyOutput) Attach() pulumi.BoolOutput {
-	return o.ApplyT(func(v EKSVeleroPolicy) bool { return v.Attach }).(pulumi.BoolOutput)
+func (o EKSVeleroPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v EKSVeleroPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
 }
 
 // List of S3 Bucket ARNs that Velero needs access to in order to backup and restore cluster resources.
@@ -3510,7 +6743,7 @@ func (o EKSVeleroPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
 		if v == nil {
 			return nil
 		}
-		return &v.Attach
+		return v.Attach
 	}).(pulumi.BoolPtrOutput)
 }
 
@@ -3528,7 +6761,7 @@ func (o EKSVeleroPolicyPtrOutput) S3BucketArns() pulumi.StringArrayOutput {
 // The FSx for Lustre CSI Driver IAM policy to the role.
 type FSxLustreCSIPolicy struct {
 	// Determines whether to attach the FSx for Lustre CSI Driver IAM policy to the role.
-	Attach bool `pulumi:"attach"`
+	Attach *bool `pulumi:"attach"`
 	// Service role ARNs to allow FSx for Lustre CSI create and manage FSX for Lustre service linked roles. If not provided,
 	// the default ARN "arn:aws:iam::*:role/aws-service-role/s3.data-source.lustre.fsx.amazonaws.com/*" will be applied.
 	ServiceRoleArns []string `pulumi:"serviceRoleArns"`
@@ -3548,7 +6781,7 @@ type FSxLustreCSIPolicyInput interface {
 // The FSx for Lustre CSI Driver IAM policy to the role.
 type FSxLustreCSIPolicyArgs struct {
 	// Determines whether to attach the FSx for Lustre CSI Driver IAM policy to the role.
-	Attach pulumi.BoolInput `pulumi:"attach"`
+	Attach pulumi.BoolPtrInput `pulumi:"attach"`
 	// Service role ARNs to allow FSx for Lustre CSI create and manage FSX for Lustre service linked roles. If not provided,
 	// the default ARN "arn:aws:iam::*:role/aws-service-role/s3.data-source.lustre.fsx.amazonaws.com/*" will be applied.
 	ServiceRoleArns pulumi.StringArrayInput `pulumi:"serviceRoleArns"`
@@ -3633,8 +6866,8 @@ func (o FSxLustreCSIPolicyOutput) ToFSxLustreCSIPolicyPtrOutputWithContext(ctx c
 }
 
 // Determines whether to attach the FSx for Lustre CSI Driver IAM policy to the role.
-func (o FSxLustreCSIPolicyOutput) Attach() pulumi.BoolOutput {
-	return o.ApplyT(func(v FSxLustreCSIPolicy) bool { return v.Attach }).(pulumi.BoolOutput)
+func (o FSxLustreCSIPolicyOutput) Attach() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FSxLustreCSIPolicy) *bool { return v.Attach }).(pulumi.BoolPtrOutput)
 }
 
 // Service role ARNs to allow FSx for Lustre CSI create and manage FSX for Lustre service linked roles. If not provided,
@@ -3673,19 +6906,194 @@ func (o FSxLustreCSIPolicyPtrOutput) Attach() pulumi.BoolPtrOutput {
 		if v == nil {
 			return nil
 		}
-		return &v.Attach
+		return v.Attach
 	}).(pulumi.BoolPtrOutput)
 }
 
-// Service role ARNs to allow FSx for Lustre CSI create and manage FSX for Lustre service linked roles. If not provided,
-// the default ARN "arn:aws:iam::*:role/aws-service-role/s3.data-source.lustre.fsx.amazonaws.com/*" will be applied.
-func (o FSxLustreCSIPolicyPtrOutput) ServiceRoleArns() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *FSxLustreCSIPolicy) []string {
+// Service role ARNs to allow FSx for Lustre CSI create and manage FSX for Lustre service linked roles. If not provided,
+// the default ARN "arn:aws:iam::*:role/aws-service-role/s3.data-source.lustre.fsx.amazonaws.com/*" will be applied.
+func (o FSxLustreCSIPolicyPtrOutput) ServiceRoleArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FSxLustreCSIPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.ServiceRoleArns
+	}).(pulumi.StringArrayOutput)
+}
+
+type InstanceProfileSettings struct {
+	// Name of IAM instance profile. Defaults to the name of the role.
+	Name *string `pulumi:"name"`
+	// Path of IAM instance profile. Defaults to the path of the role.
+	Path *string `pulumi:"path"`
+	// A map of tags to add to the instance profile. Defaults to the tags of the role.
+	Tags map[string]string `pulumi:"tags"`
+}
+
+// InstanceProfileSettingsInput is an input type that accepts InstanceProfileSettingsArgs and InstanceProfileSettingsOutput values.
+// You can construct a concrete instance of `InstanceProfileSettingsInput` via:
+//
+//	InstanceProfileSettingsArgs{...}
+type InstanceProfileSettingsInput interface {
+	pulumi.Input
+
+	ToInstanceProfileSettingsOutput() InstanceProfileSettingsOutput
+	ToInstanceProfileSettingsOutputWithContext(context.Context) InstanceProfileSettingsOutput
+}
+
+type InstanceProfileSettingsArgs struct {
+	// Name of IAM instance profile. Defaults to the name of the role.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// Path of IAM instance profile. Defaults to the path of the role.
+	Path pulumi.StringPtrInput `pulumi:"path"`
+	// A map of tags to add to the instance profile. Defaults to the tags of the role.
+	Tags pulumi.StringMapInput `pulumi:"tags"`
+}
+
+func (InstanceProfileSettingsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*InstanceProfileSettings)(nil)).Elem()
+}
+
+func (i InstanceProfileSettingsArgs) ToInstanceProfileSettingsOutput() InstanceProfileSettingsOutput {
+	return i.ToInstanceProfileSettingsOutputWithContext(context.Background())
+}
+
+func (i InstanceProfileSettingsArgs) ToInstanceProfileSettingsOutputWithContext(ctx context.Context) InstanceProfileSettingsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InstanceProfileSettingsOutput)
+}
+
+func (i InstanceProfileSettingsArgs) ToInstanceProfileSettingsPtrOutput() InstanceProfileSettingsPtrOutput {
+	return i.ToInstanceProfileSettingsPtrOutputWithContext(context.Background())
+}
+
+func (i InstanceProfileSettingsArgs) ToInstanceProfileSettingsPtrOutputWithContext(ctx context.Context) InstanceProfileSettingsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InstanceProfileSettingsOutput).ToInstanceProfileSettingsPtrOutputWithContext(ctx)
+}
+
+// InstanceProfileSettingsPtrInput is an input type that accepts InstanceProfileSettingsArgs, InstanceProfileSettingsPtr and InstanceProfileSettingsPtrOutput values.
+// You can construct a concrete instance of `InstanceProfileSettingsPtrInput` via:
+//
+//	        InstanceProfileSettingsArgs{...}
+//
+//	or:
+//
+//	        nil
+type InstanceProfileSettingsPtrInput interface {
+	pulumi.Input
+
+	ToInstanceProfileSettingsPtrOutput() InstanceProfileSettingsPtrOutput
+	ToInstanceProfileSettingsPtrOutputWithContext(context.Context) InstanceProfileSettingsPtrOutput
+}
+
+type instanceProfileSettingsPtrType InstanceProfileSettingsArgs
+
+func InstanceProfileSettingsPtr(v *InstanceProfileSettingsArgs) InstanceProfileSettingsPtrInput {
+	return (*instanceProfileSettingsPtrType)(v)
+}
+
+func (*instanceProfileSettingsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**InstanceProfileSettings)(nil)).Elem()
+}
+
+func (i *instanceProfileSettingsPtrType) ToInstanceProfileSettingsPtrOutput() InstanceProfileSettingsPtrOutput {
+	return i.ToInstanceProfileSettingsPtrOutputWithContext(context.Background())
+}
+
+func (i *instanceProfileSettingsPtrType) ToInstanceProfileSettingsPtrOutputWithContext(ctx context.Context) InstanceProfileSettingsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InstanceProfileSettingsPtrOutput)
+}
+
+type InstanceProfileSettingsOutput struct{ *pulumi.OutputState }
+
+func (InstanceProfileSettingsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*InstanceProfileSettings)(nil)).Elem()
+}
+
+func (o InstanceProfileSettingsOutput) ToInstanceProfileSettingsOutput() InstanceProfileSettingsOutput {
+	return o
+}
+
+func (o InstanceProfileSettingsOutput) ToInstanceProfileSettingsOutputWithContext(ctx context.Context) InstanceProfileSettingsOutput {
+	return o
+}
+
+func (o InstanceProfileSettingsOutput) ToInstanceProfileSettingsPtrOutput() InstanceProfileSettingsPtrOutput {
+	return o.ToInstanceProfileSettingsPtrOutputWithContext(context.Background())
+}
+
+func (o InstanceProfileSettingsOutput) ToInstanceProfileSettingsPtrOutputWithContext(ctx context.Context) InstanceProfileSettingsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v InstanceProfileSettings) *InstanceProfileSettings {
+		return &v
+	}).(InstanceProfileSettingsPtrOutput)
+}
+
+// Name of IAM instance profile. Defaults to the name of the role.
+func (o InstanceProfileSettingsOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v InstanceProfileSettings) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// Path of IAM instance profile. Defaults to the path of the role.
+func (o InstanceProfileSettingsOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v InstanceProfileSettings) *string { return v.Path }).(pulumi.StringPtrOutput)
+}
+
+// A map of tags to add to the instance profile. Defaults to the tags of the role.
+func (o InstanceProfileSettingsOutput) Tags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v InstanceProfileSettings) map[string]string { return v.Tags }).(pulumi.StringMapOutput)
+}
+
+type InstanceProfileSettingsPtrOutput struct{ *pulumi.OutputState }
+
+func (InstanceProfileSettingsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**InstanceProfileSettings)(nil)).Elem()
+}
+
+func (o InstanceProfileSettingsPtrOutput) ToInstanceProfileSettingsPtrOutput() InstanceProfileSettingsPtrOutput {
+	return o
+}
+
+func (o InstanceProfileSettingsPtrOutput) ToInstanceProfileSettingsPtrOutputWithContext(ctx context.Context) InstanceProfileSettingsPtrOutput {
+	return o
+}
+
+func (o InstanceProfileSettingsPtrOutput) Elem() InstanceProfileSettingsOutput {
+	return o.ApplyT(func(v *InstanceProfileSettings) InstanceProfileSettings {
+		if v != nil {
+			return *v
+		}
+		var ret InstanceProfileSettings
+		return ret
+	}).(InstanceProfileSettingsOutput)
+}
+
+// Name of IAM instance profile. Defaults to the name of the role.
+func (o InstanceProfileSettingsPtrOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *InstanceProfileSettings) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Name
+	}).(pulumi.StringPtrOutput)
+}
+
+// Path of IAM instance profile. Defaults to the path of the role.
+func (o InstanceProfileSettingsPtrOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *InstanceProfileSettings) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Path
+	}).(pulumi.StringPtrOutput)
+}
+
+// A map of tags to add to the instance profile. Defaults to the tags of the role.
+func (o InstanceProfileSettingsPtrOutput) Tags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *InstanceProfileSettings) map[string]string {
 		if v == nil {
 			return nil
 		}
-		return v.ServiceRoleArns
-	}).(pulumi.StringArrayOutput)
+		return v.Tags
+	}).(pulumi.StringMapOutput)
 }
 
 type KeybaseOutput struct {
@@ -3734,8 +7142,15 @@ func (o KeybaseOutputOutput) SecretKeyPgpMessage() pulumi.StringPtrOutput {
 }
 
 type OIDCProvider struct {
+	// The AWS account ID where the IAM OIDC provider of `clusterName` or `issuerUrl` lives, defaults to the current account.
+	AwsAccountId *string `pulumi:"awsAccountId"`
+	// Name of an EKS cluster in the current region whose OIDC issuer is looked up. Used when `providerArn` is not set.
+	ClusterName *string `pulumi:"clusterName"`
+	// URL of the OIDC issuer. Used when neither `providerArn` nor `clusterName` is set.
+	IssuerUrl                *string  `pulumi:"issuerUrl"`
 	NamespaceServiceAccounts []string `pulumi:"namespaceServiceAccounts"`
-	ProviderArn              *string  `pulumi:"providerArn"`
+	// ARN of the IAM OIDC provider.
+	ProviderArn *string `pulumi:"providerArn"`
 }
 
 // OIDCProviderInput is an input type that accepts OIDCProviderArgs and OIDCProviderOutput values.
@@ -3750,8 +7165,15 @@ type OIDCProviderInput interface {
 }
 
 type OIDCProviderArgs struct {
+	// The AWS account ID where the IAM OIDC provider of `clusterName` or `issuerUrl` lives, defaults to the current account.
+	AwsAccountId pulumi.StringPtrInput `pulumi:"awsAccountId"`
+	// Name of an EKS cluster in the current region whose OIDC issuer is looked up. Used when `providerArn` is not set.
+	ClusterName pulumi.StringPtrInput `pulumi:"clusterName"`
+	// URL of the OIDC issuer. Used when neither `providerArn` nor `clusterName` is set.
+	IssuerUrl                pulumi.StringPtrInput   `pulumi:"issuerUrl"`
 	NamespaceServiceAccounts pulumi.StringArrayInput `pulumi:"namespaceServiceAccounts"`
-	ProviderArn              pulumi.StringPtrInput   `pulumi:"providerArn"`
+	// ARN of the IAM OIDC provider.
+	ProviderArn pulumi.StringPtrInput `pulumi:"providerArn"`
 }
 
 func (OIDCProviderArgs) ElementType() reflect.Type {
@@ -3805,10 +7227,26 @@ func (o OIDCProviderOutput) ToOIDCProviderOutputWithContext(ctx context.Context)
 	return o
 }
 
+// The AWS account ID where the IAM OIDC provider of `clusterName` or `issuerUrl` lives, defaults to the current account.
+func (o OIDCProviderOutput) AwsAccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OIDCProvider) *string { return v.AwsAccountId }).(pulumi.StringPtrOutput)
+}
+
+// Name of an EKS cluster in the current region whose OIDC issuer is looked up. Used when `providerArn` is not set.
+func (o OIDCProviderOutput) ClusterName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OIDCProvider) *string { return v.ClusterName }).(pulumi.StringPtrOutput)
+}
+
+// URL of the OIDC issuer. Used when neither `providerArn` nor `clusterName` is set.
+func (o OIDCProviderOutput) IssuerUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OIDCProvider) *string { return v.IssuerUrl }).(pulumi.StringPtrOutput)
+}
+
 func (o OIDCProviderOutput) NamespaceServiceAccounts() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v OIDCProvider) []string { return v.NamespaceServiceAccounts }).(pulumi.StringArrayOutput)
 }
 
+// ARN of the IAM OIDC provider.
 func (o OIDCProviderOutput) ProviderArn() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v OIDCProvider) *string { return v.ProviderArn }).(pulumi.StringPtrOutput)
 }
@@ -3833,8 +7271,135 @@ func (o OIDCProviderMapOutput) MapIndex(k pulumi.StringInput) OIDCProviderOutput
 	}).(OIDCProviderOutput)
 }
 
+// A condition block of an IAM policy statement.
+type PolicyCondition struct {
+	// Name of the IAM condition operator to evaluate, e.g. `StringEquals`.
+	Test string `pulumi:"test"`
+	// Values to evaluate the condition against.
+	Values []string `pulumi:"values"`
+	// Name of a Context Variable to apply the condition to, e.g. `aws:PrincipalTag/team`.
+	Variable string `pulumi:"variable"`
+}
+
+// PolicyConditionInput is an input type that accepts PolicyConditionArgs and PolicyConditionOutput values.
+// You can construct a concrete instance of `PolicyConditionInput` via:
+//
+//	PolicyConditionArgs{...}
+type PolicyConditionInput interface {
+	pulumi.Input
+
+	ToPolicyConditionOutput() PolicyConditionOutput
+	ToPolicyConditionOutputWithContext(context.Context) PolicyConditionOutput
+}
+
+// A condition block of an IAM policy statement.
+type PolicyConditionArgs struct {
+	// Name of the IAM condition operator to evaluate, e.g. `StringEquals`.
+	Test pulumi.StringInput `pulumi:"test"`
+	// Values to evaluate the condition against.
+	Values pulumi.StringArrayInput `pulumi:"values"`
+	// Name of a Context Variable to apply the condition to, e.g. `aws:PrincipalTag/team`.
+	Variable pulumi.StringInput `pulumi:"variable"`
+}
+
+func (PolicyConditionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*PolicyCondition)(nil)).Elem()
+}
+
+func (i PolicyConditionArgs) ToPolicyConditionOutput() PolicyConditionOutput {
+	return i.ToPolicyConditionOutputWithContext(context.Background())
+}
+
+func (i PolicyConditionArgs) ToPolicyConditionOutputWithContext(ctx context.Context) PolicyConditionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PolicyConditionOutput)
+}
+
+// PolicyConditionArrayInput is an input type that accepts PolicyConditionArray and PolicyConditionArrayOutput values.
+// You can construct a concrete instance of `PolicyConditionArrayInput` via:
+//
+//	PolicyConditionArray{ PolicyConditionArgs{...} }
+type PolicyConditionArrayInput interface {
+	pulumi.Input
+
+	ToPolicyConditionArrayOutput() PolicyConditionArrayOutput
+	ToPolicyConditionArrayOutputWithContext(context.Context) PolicyConditionArrayOutput
+}
+
+type PolicyConditionArray []PolicyConditionInput
+
+func (PolicyConditionArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]PolicyCondition)(nil)).Elem()
+}
+
+func (i PolicyConditionArray) ToPolicyConditionArrayOutput() PolicyConditionArrayOutput {
+	return i.ToPolicyConditionArrayOutputWithContext(context.Background())
+}
+
+func (i PolicyConditionArray) ToPolicyConditionArrayOutputWithContext(ctx context.Context) PolicyConditionArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PolicyConditionArrayOutput)
+}
+
+// A condition block of an IAM policy statement.
+type PolicyConditionOutput struct{ *pulumi.OutputState }
+
+func (PolicyConditionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*PolicyCondition)(nil)).Elem()
+}
+
+func (o PolicyConditionOutput) ToPolicyConditionOutput() PolicyConditionOutput {
+	return o
+}
+
+func (o PolicyConditionOutput) ToPolicyConditionOutputWithContext(ctx context.Context) PolicyConditionOutput {
+	return o
+}
+
+// Name of the IAM condition operator to evaluate, e.g. `StringEquals`.
+func (o PolicyConditionOutput) Test() pulumi.StringOutput {
+	return o.ApplyT(func(v PolicyCondition) string { return v.Test }).(pulumi.StringOutput)
+}
+
+// Values to evaluate the condition against.
+func (o PolicyConditionOutput) Values() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v PolicyCondition) []string { return v.Values }).(pulumi.StringArrayOutput)
+}
+
+// Name of a Context Variable to apply the condition to, e.g. `aws:PrincipalTag/team`.
+func (o PolicyConditionOutput) Variable() pulumi.StringOutput {
+	return o.ApplyT(func(v PolicyCondition) string { return v.Variable }).(pulumi.StringOutput)
+}
+
+type PolicyConditionArrayOutput struct{ *pulumi.OutputState }
+
+func (PolicyConditionArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]PolicyCondition)(nil)).Elem()
+}
+
+func (o PolicyConditionArrayOutput) ToPolicyConditionArrayOutput() PolicyConditionArrayOutput {
+	return o
+}
+
+func (o PolicyConditionArrayOutput) ToPolicyConditionArrayOutputWithContext(ctx context.Context) PolicyConditionArrayOutput {
+	return o
+}
+
+func (o PolicyConditionArrayOutput) Index(i pulumi.IntInput) PolicyConditionOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) PolicyCondition {
+		return vs[0].([]PolicyCondition)[vs[1].(int)]
+	}).(PolicyConditionOutput)
+}
+
 // The poweruser role.
 type PoweruserRole struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies *bool `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments *bool `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies map[string]string `pulumi:"inlinePolicies"`
 	// IAM role with poweruser access.
 	Name *string `pulumi:"name"`
 	// Path of poweruser IAM role.
@@ -3847,6 +7412,23 @@ type PoweruserRole struct {
 	Tags map[string]string `pulumi:"tags"`
 }
 
+// Defaults sets the appropriate defaults for PoweruserRole
+func (val *PoweruserRole) Defaults() *PoweruserRole {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		exclusiveInlinePolicies_ := false
+		tmp.ExclusiveInlinePolicies = &exclusiveInlinePolicies_
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		exclusivePolicyAttachments_ := false
+		tmp.ExclusivePolicyAttachments = &exclusivePolicyAttachments_
+	}
+	return &tmp
+}
+
 // PoweruserRoleInput is an input type that accepts PoweruserRoleArgs and PoweruserRoleOutput values.
 // You can construct a concrete instance of `PoweruserRoleInput` via:
 //
@@ -3860,6 +7442,15 @@ type PoweruserRoleInput interface {
 
 // The poweruser role.
 type PoweruserRoleArgs struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies pulumi.BoolPtrInput `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments pulumi.BoolPtrInput `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies pulumi.StringMapInput `pulumi:"inlinePolicies"`
 	// IAM role with poweruser access.
 	Name pulumi.StringPtrInput `pulumi:"name"`
 	// Path of poweruser IAM role.
@@ -3872,6 +7463,20 @@ type PoweruserRoleArgs struct {
 	Tags pulumi.StringMapInput `pulumi:"tags"`
 }
 
+// Defaults sets the appropriate defaults for PoweruserRoleArgs
+func (val *PoweruserRoleArgs) Defaults() *PoweruserRoleArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		tmp.ExclusiveInlinePolicies = pulumi.BoolPtr(false)
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		tmp.ExclusivePolicyAttachments = pulumi.BoolPtr(false)
+	}
+	return &tmp
+}
 func (PoweruserRoleArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*PoweruserRole)(nil)).Elem()
 }
@@ -3950,6 +7555,24 @@ func (o PoweruserRoleOutput) ToPoweruserRolePtrOutputWithContext(ctx context.Con
 	}).(PoweruserRolePtrOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o PoweruserRoleOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v PoweruserRole) *bool { return v.ExclusiveInlinePolicies }).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o PoweruserRoleOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v PoweruserRole) *bool { return v.ExclusivePolicyAttachments }).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o PoweruserRoleOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v PoweruserRole) map[string]string { return v.InlinePolicies }).(pulumi.StringMapOutput)
+}
+
 // IAM role with poweruser access.
 func (o PoweruserRoleOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v PoweruserRole) *string { return v.Name }).(pulumi.StringPtrOutput)
@@ -3999,6 +7622,39 @@ func (o PoweruserRolePtrOutput) Elem() PoweruserRoleOutput {
 	}).(PoweruserRoleOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o PoweruserRolePtrOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *PoweruserRole) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusiveInlinePolicies
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o PoweruserRolePtrOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *PoweruserRole) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusivePolicyAttachments
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o PoweruserRolePtrOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *PoweruserRole) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.InlinePolicies
+	}).(pulumi.StringMapOutput)
+}
+
 // IAM role with poweruser access.
 func (o PoweruserRolePtrOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *PoweruserRole) *string {
@@ -4051,6 +7707,15 @@ func (o PoweruserRolePtrOutput) Tags() pulumi.StringMapOutput {
 
 // The poweruser role.
 type PoweruserRoleWithMFA struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies *bool `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments *bool `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies map[string]string `pulumi:"inlinePolicies"`
 	// IAM role with poweruser access.
 	Name *string `pulumi:"name"`
 	// Path of poweruser IAM role.
@@ -4065,6 +7730,23 @@ type PoweruserRoleWithMFA struct {
 	Tags map[string]string `pulumi:"tags"`
 }
 
+// Defaults sets the appropriate defaults for PoweruserRoleWithMFA
+func (val *PoweruserRoleWithMFA) Defaults() *PoweruserRoleWithMFA {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		exclusiveInlinePolicies_ := false
+		tmp.ExclusiveInlinePolicies = &exclusiveInlinePolicies_
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		exclusivePolicyAttachments_ := false
+		tmp.ExclusivePolicyAttachments = &exclusivePolicyAttachments_
+	}
+	return &tmp
+}
+
 // PoweruserRoleWithMFAInput is an input type that accepts PoweruserRoleWithMFAArgs and PoweruserRoleWithMFAOutput values.
 // You can construct a concrete instance of `PoweruserRoleWithMFAInput` via:
 //
@@ -4078,6 +7760,15 @@ type PoweruserRoleWithMFAInput interface {
 
 // The poweruser role.
 type PoweruserRoleWithMFAArgs struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies pulumi.BoolPtrInput `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments pulumi.BoolPtrInput `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies pulumi.StringMapInput `pulumi:"inlinePolicies"`
 	// IAM role with poweruser access.
 	Name pulumi.StringPtrInput `pulumi:"name"`
 	// Path of poweruser IAM role.
@@ -4092,6 +7783,20 @@ type PoweruserRoleWithMFAArgs struct {
 	Tags pulumi.StringMapInput `pulumi:"tags"`
 }
 
+// Defaults sets the appropriate defaults for PoweruserRoleWithMFAArgs
+func (val *PoweruserRoleWithMFAArgs) Defaults() *PoweruserRoleWithMFAArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		tmp.ExclusiveInlinePolicies = pulumi.BoolPtr(false)
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		tmp.ExclusivePolicyAttachments = pulumi.BoolPtr(false)
+	}
+	return &tmp
+}
 func (PoweruserRoleWithMFAArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*PoweruserRoleWithMFA)(nil)).Elem()
 }
@@ -4170,6 +7875,24 @@ func (o PoweruserRoleWithMFAOutput) ToPoweruserRoleWithMFAPtrOutputWithContext(c
 	}).(PoweruserRoleWithMFAPtrOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o PoweruserRoleWithMFAOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v PoweruserRoleWithMFA) *bool { return v.ExclusiveInlinePolicies }).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o PoweruserRoleWithMFAOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v PoweruserRoleWithMFA) *bool { return v.ExclusivePolicyAttachments }).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o PoweruserRoleWithMFAOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v PoweruserRoleWithMFA) map[string]string { return v.InlinePolicies }).(pulumi.StringMapOutput)
+}
+
 // IAM role with poweruser access.
 func (o PoweruserRoleWithMFAOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v PoweruserRoleWithMFA) *string { return v.Name }).(pulumi.StringPtrOutput)
@@ -4224,6 +7947,39 @@ func (o PoweruserRoleWithMFAPtrOutput) Elem() PoweruserRoleWithMFAOutput {
 	}).(PoweruserRoleWithMFAOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o PoweruserRoleWithMFAPtrOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *PoweruserRoleWithMFA) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusiveInlinePolicies
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o PoweruserRoleWithMFAPtrOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *PoweruserRoleWithMFA) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusivePolicyAttachments
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o PoweruserRoleWithMFAPtrOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *PoweruserRoleWithMFA) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.InlinePolicies
+	}).(pulumi.StringMapOutput)
+}
+
 // IAM role with poweruser access.
 func (o PoweruserRoleWithMFAPtrOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *PoweruserRoleWithMFA) *string {
@@ -4286,6 +8042,15 @@ func (o PoweruserRoleWithMFAPtrOutput) Tags() pulumi.StringMapOutput {
 
 // The readonly role.
 type ReadonlyRole struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies *bool `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments *bool `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies map[string]string `pulumi:"inlinePolicies"`
 	// IAM role with readonly access.
 	Name *string `pulumi:"name"`
 	// Path of readonly IAM role. Defaults to '/'.
@@ -4298,6 +8063,23 @@ type ReadonlyRole struct {
 	Tags map[string]string `pulumi:"tags"`
 }
 
+// Defaults sets the appropriate defaults for ReadonlyRole
+func (val *ReadonlyRole) Defaults() *ReadonlyRole {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		exclusiveInlinePolicies_ := false
+		tmp.ExclusiveInlinePolicies = &exclusiveInlinePolicies_
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		exclusivePolicyAttachments_ := false
+		tmp.ExclusivePolicyAttachments = &exclusivePolicyAttachments_
+	}
+	return &tmp
+}
+
 // ReadonlyRoleInput is an input type that accepts ReadonlyRoleArgs and ReadonlyRoleOutput values.
 // You can construct a concrete instance of `ReadonlyRoleInput` via:
 //
@@ -4311,6 +8093,15 @@ type ReadonlyRoleInput interface {
 
 // The readonly role.
 type ReadonlyRoleArgs struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies pulumi.BoolPtrInput `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments pulumi.BoolPtrInput `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies pulumi.StringMapInput `pulumi:"inlinePolicies"`
 	// IAM role with readonly access.
 	Name pulumi.StringPtrInput `pulumi:"name"`
 	// Path of readonly IAM role. Defaults to '/'.
@@ -4323,6 +8114,20 @@ type ReadonlyRoleArgs struct {
 	Tags pulumi.StringMapInput `pulumi:"tags"`
 }
 
+// Defaults sets the appropriate defaults for ReadonlyRoleArgs
+func (val *ReadonlyRoleArgs) Defaults() *ReadonlyRoleArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		tmp.ExclusiveInlinePolicies = pulumi.BoolPtr(false)
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		tmp.ExclusivePolicyAttachments = pulumi.BoolPtr(false)
+	}
+	return &tmp
+}
 func (ReadonlyRoleArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*ReadonlyRole)(nil)).Elem()
 }
@@ -4401,6 +8206,24 @@ func (o ReadonlyRoleOutput) ToReadonlyRolePtrOutputWithContext(ctx context.Conte
 	}).(ReadonlyRolePtrOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o ReadonlyRoleOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ReadonlyRole) *bool { return v.ExclusiveInlinePolicies }).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o ReadonlyRoleOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ReadonlyRole) *bool { return v.ExclusivePolicyAttachments }).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o ReadonlyRoleOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v ReadonlyRole) map[string]string { return v.InlinePolicies }).(pulumi.StringMapOutput)
+}
+
 // IAM role with readonly access.
 func (o ReadonlyRoleOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v ReadonlyRole) *string { return v.Name }).(pulumi.StringPtrOutput)
@@ -4450,6 +8273,39 @@ func (o ReadonlyRolePtrOutput) Elem() ReadonlyRoleOutput {
 	}).(ReadonlyRoleOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o ReadonlyRolePtrOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ReadonlyRole) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusiveInlinePolicies
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o ReadonlyRolePtrOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ReadonlyRole) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusivePolicyAttachments
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o ReadonlyRolePtrOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *ReadonlyRole) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.InlinePolicies
+	}).(pulumi.StringMapOutput)
+}
+
 // IAM role with readonly access.
 func (o ReadonlyRolePtrOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *ReadonlyRole) *string {
@@ -4502,6 +8358,15 @@ func (o ReadonlyRolePtrOutput) Tags() pulumi.StringMapOutput {
 
 // The readonly role.
 type ReadonlyRoleWithMFA struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies *bool `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments *bool `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies map[string]string `pulumi:"inlinePolicies"`
 	// IAM role with readonly access.
 	Name *string `pulumi:"name"`
 	// Path of readonly IAM role. Defaults to '/'.
@@ -4516,6 +8381,23 @@ type ReadonlyRoleWithMFA struct {
 	Tags map[string]string `pulumi:"tags"`
 }
 
+// Defaults sets the appropriate defaults for ReadonlyRoleWithMFA
+func (val *ReadonlyRoleWithMFA) Defaults() *ReadonlyRoleWithMFA {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		exclusiveInlinePolicies_ := false
+		tmp.ExclusiveInlinePolicies = &exclusiveInlinePolicies_
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		exclusivePolicyAttachments_ := false
+		tmp.ExclusivePolicyAttachments = &exclusivePolicyAttachments_
+	}
+	return &tmp
+}
+
 // ReadonlyRoleWithMFAInput is an input type that accepts ReadonlyRoleWithMFAArgs and ReadonlyRoleWithMFAOutput values.
 // You can construct a concrete instance of `ReadonlyRoleWithMFAInput` via:
 //
@@ -4529,6 +8411,15 @@ type ReadonlyRoleWithMFAInput interface {
 
 // The readonly role.
 type ReadonlyRoleWithMFAArgs struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies pulumi.BoolPtrInput `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments pulumi.BoolPtrInput `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies pulumi.StringMapInput `pulumi:"inlinePolicies"`
 	// IAM role with readonly access.
 	Name pulumi.StringPtrInput `pulumi:"name"`
 	// Path of readonly IAM role. Defaults to '/'.
@@ -4543,6 +8434,20 @@ type ReadonlyRoleWithMFAArgs struct {
 	Tags pulumi.StringMapInput `pulumi:"tags"`
 }
 
+// Defaults sets the appropriate defaults for ReadonlyRoleWithMFAArgs
+func (val *ReadonlyRoleWithMFAArgs) Defaults() *ReadonlyRoleWithMFAArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		tmp.ExclusiveInlinePolicies = pulumi.BoolPtr(false)
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		tmp.ExclusivePolicyAttachments = pulumi.BoolPtr(false)
+	}
+	return &tmp
+}
 func (ReadonlyRoleWithMFAArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*ReadonlyRoleWithMFA)(nil)).Elem()
 }
@@ -4621,6 +8526,24 @@ func (o ReadonlyRoleWithMFAOutput) ToReadonlyRoleWithMFAPtrOutputWithContext(ctx
 	}).(ReadonlyRoleWithMFAPtrOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o ReadonlyRoleWithMFAOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ReadonlyRoleWithMFA) *bool { return v.ExclusiveInlinePolicies }).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o ReadonlyRoleWithMFAOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ReadonlyRoleWithMFA) *bool { return v.ExclusivePolicyAttachments }).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o ReadonlyRoleWithMFAOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v ReadonlyRoleWithMFA) map[string]string { return v.InlinePolicies }).(pulumi.StringMapOutput)
+}
+
 // IAM role with readonly access.
 func (o ReadonlyRoleWithMFAOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v ReadonlyRoleWithMFA) *string { return v.Name }).(pulumi.StringPtrOutput)
@@ -4675,6 +8598,39 @@ func (o ReadonlyRoleWithMFAPtrOutput) Elem() ReadonlyRoleWithMFAOutput {
 	}).(ReadonlyRoleWithMFAOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o ReadonlyRoleWithMFAPtrOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ReadonlyRoleWithMFA) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusiveInlinePolicies
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o ReadonlyRoleWithMFAPtrOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ReadonlyRoleWithMFA) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusivePolicyAttachments
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o ReadonlyRoleWithMFAPtrOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *ReadonlyRoleWithMFA) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.InlinePolicies
+	}).(pulumi.StringMapOutput)
+}
+
 // IAM role with readonly access.
 func (o ReadonlyRoleWithMFAPtrOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *ReadonlyRoleWithMFA) *string {
@@ -4737,6 +8693,15 @@ func (o ReadonlyRoleWithMFAPtrOutput) Tags() pulumi.StringMapOutput {
 
 // An IAM role.
 type Role struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies *bool `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments *bool `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies map[string]string `pulumi:"inlinePolicies"`
 	// IAM role name.
 	Name *string `pulumi:"name"`
 	// IAM role name prefix.
@@ -4749,6 +8714,23 @@ type Role struct {
 	PolicyArns []string `pulumi:"policyArns"`
 }
 
+// Defaults sets the appropriate defaults for Role
+func (val *Role) Defaults() *Role {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		exclusiveInlinePolicies_ := false
+		tmp.ExclusiveInlinePolicies = &exclusiveInlinePolicies_
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		exclusivePolicyAttachments_ := false
+		tmp.ExclusivePolicyAttachments = &exclusivePolicyAttachments_
+	}
+	return &tmp
+}
+
 // RoleInput is an input type that accepts RoleArgs and RoleOutput values.
 // You can construct a concrete instance of `RoleInput` via:
 //
@@ -4762,6 +8744,15 @@ type RoleInput interface {
 
 // An IAM role.
 type RoleArgs struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies pulumi.BoolPtrInput `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments pulumi.BoolPtrInput `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies pulumi.StringMapInput `pulumi:"inlinePolicies"`
 	// IAM role name.
 	Name pulumi.StringPtrInput `pulumi:"name"`
 	// IAM role name prefix.
@@ -4773,7 +8764,21 @@ type RoleArgs struct {
 	// List of policy ARNs to use for the role.
 	PolicyArns pulumi.StringArrayInput `pulumi:"policyArns"`
 }
-
+
+// Defaults sets the appropriate defaults for RoleArgs
+func (val *RoleArgs) Defaults() *RoleArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		tmp.ExclusiveInlinePolicies = pulumi.BoolPtr(false)
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		tmp.ExclusivePolicyAttachments = pulumi.BoolPtr(false)
+	}
+	return &tmp
+}
 func (RoleArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*Role)(nil)).Elem()
 }
@@ -4852,6 +8857,24 @@ func (o RoleOutput) ToRolePtrOutputWithContext(ctx context.Context) RolePtrOutpu
 	}).(RolePtrOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o RoleOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Role) *bool { return v.ExclusiveInlinePolicies }).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o RoleOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Role) *bool { return v.ExclusivePolicyAttachments }).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o RoleOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v Role) map[string]string { return v.InlinePolicies }).(pulumi.StringMapOutput)
+}
+
 // IAM role name.
 func (o RoleOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v Role) *string { return v.Name }).(pulumi.StringPtrOutput)
@@ -4901,6 +8924,39 @@ func (o RolePtrOutput) Elem() RoleOutput {
 	}).(RoleOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o RolePtrOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Role) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusiveInlinePolicies
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o RolePtrOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Role) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusivePolicyAttachments
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o RolePtrOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Role) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.InlinePolicies
+	}).(pulumi.StringMapOutput)
+}
+
 // IAM role name.
 func (o RolePtrOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Role) *string {
@@ -4951,8 +9007,431 @@ func (o RolePtrOutput) PolicyArns() pulumi.StringArrayOutput {
 	}).(pulumi.StringArrayOutput)
 }
 
+type RoleSession struct {
+	// Requested session duration in seconds. Capped by the maximum session duration of the role and defaults to it.
+	DurationSeconds *int `pulumi:"durationSeconds"`
+	// Actions the session is limited to by a session policy. No session policy is rendered when empty.
+	PolicyActions []string `pulumi:"policyActions"`
+	// Resources the session policy limits the actions to. Defaults to all resources.
+	PolicyResources []string `pulumi:"policyResources"`
+	// Name of the AWS CLI profile. Defaults to the role name.
+	ProfileName *string `pulumi:"profileName"`
+	// Whether the role is assumed from another role session, which STS limits to one hour.
+	RoleChaining *bool `pulumi:"roleChaining"`
+	// Name of the role session. Defaults to the profile name.
+	SessionName *string `pulumi:"sessionName"`
+	// Session tags to pass when assuming the role.
+	SessionTags map[string]string `pulumi:"sessionTags"`
+	// Source identity to set on the session, e.g. the name of the CI pipeline.
+	SourceIdentity *string `pulumi:"sourceIdentity"`
+	// Name of the AWS CLI profile whose credentials assume the role.
+	SourceProfile *string `pulumi:"sourceProfile"`
+	// Session tag keys which persist when the session assumes another role.
+	TransitiveTagKeys []string `pulumi:"transitiveTagKeys"`
+	// Path of the web identity token for roles assumed with AssumeRoleWithWebIdentity. Required for the
+	// AWS CLI config profile of these roles.
+	WebIdentityTokenFile *string `pulumi:"webIdentityTokenFile"`
+}
+
+// Defaults sets the appropriate defaults for RoleSession
+func (val *RoleSession) Defaults() *RoleSession {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.RoleChaining == nil {
+		roleChaining_ := false
+		tmp.RoleChaining = &roleChaining_
+	}
+	if tmp.SourceProfile == nil {
+		sourceProfile_ := "default"
+		tmp.SourceProfile = &sourceProfile_
+	}
+	return &tmp
+}
+
+// RoleSessionInput is an input type that accepts RoleSessionArgs and RoleSessionOutput values.
+// You can construct a concrete instance of `RoleSessionInput` via:
+//
+//	RoleSessionArgs{...}
+type RoleSessionInput interface {
+	pulumi.Input
+
+	ToRoleSessionOutput() RoleSessionOutput
+	ToRoleSessionOutputWithContext(context.Context) RoleSessionOutput
+}
+
+type RoleSessionArgs struct {
+	// Requested session duration in seconds. Capped by the maximum session duration of the role and defaults to it.
+	DurationSeconds pulumi.IntPtrInput `pulumi:"durationSeconds"`
+	// Actions the session is limited to by a session policy. No session policy is rendered when empty.
+	PolicyActions pulumi.StringArrayInput `pulumi:"policyActions"`
+	// Resources the session policy limits the actions to. Defaults to all resources.
+	PolicyResources pulumi.StringArrayInput `pulumi:"policyResources"`
+	// Name of the AWS CLI profile. Defaults to the role name.
+	ProfileName pulumi.StringPtrInput `pulumi:"profileName"`
+	// Whether the role is assumed from another role session, which STS limits to one hour.
+	RoleChaining pulumi.BoolPtrInput `pulumi:"roleChaining"`
+	// Name of the role session. Defaults to the profile name.
+	SessionName pulumi.StringPtrInput `pulumi:"sessionName"`
+	// Session tags to pass when assuming the role.
+	SessionTags pulumi.StringMapInput `pulumi:"sessionTags"`
+	// Source identity to set on the session, e.g. the name of the CI pipeline.
+	SourceIdentity pulumi.StringPtrInput `pulumi:"sourceIdentity"`
+	// Name of the AWS CLI profile whose credentials assume the role.
+	SourceProfile pulumi.StringPtrInput `pulumi:"sourceProfile"`
+	// Session tag keys which persist when the session assumes another role.
+	TransitiveTagKeys pulumi.StringArrayInput `pulumi:"transitiveTagKeys"`
+	// Path of the web identity token for roles assumed with AssumeRoleWithWebIdentity. Required for the
+	// AWS CLI config profile of these roles.
+	WebIdentityTokenFile pulumi.StringPtrInput `pulumi:"webIdentityTokenFile"`
+}
+
+// Defaults sets the appropriate defaults for RoleSessionArgs
+func (val *RoleSessionArgs) Defaults() *RoleSessionArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.RoleChaining == nil {
+		tmp.RoleChaining = pulumi.BoolPtr(false)
+	}
+	if tmp.SourceProfile == nil {
+		tmp.SourceProfile = pulumi.StringPtr("default")
+	}
+	return &tmp
+}
+func (RoleSessionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*RoleSession)(nil)).Elem()
+}
+
+func (i RoleSessionArgs) ToRoleSessionOutput() RoleSessionOutput {
+	return i.ToRoleSessionOutputWithContext(context.Background())
+}
+
+func (i RoleSessionArgs) ToRoleSessionOutputWithContext(ctx context.Context) RoleSessionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RoleSessionOutput)
+}
+
+func (i RoleSessionArgs) ToRoleSessionPtrOutput() RoleSessionPtrOutput {
+	return i.ToRoleSessionPtrOutputWithContext(context.Background())
+}
+
+func (i RoleSessionArgs) ToRoleSessionPtrOutputWithContext(ctx context.Context) RoleSessionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RoleSessionOutput).ToRoleSessionPtrOutputWithContext(ctx)
+}
+
+// RoleSessionPtrInput is an input type that accepts RoleSessionArgs, RoleSessionPtr and RoleSessionPtrOutput values.
+// You can construct a concrete instance of `RoleSessionPtrInput` via:
+//
+//	        RoleSessionArgs{...}
+//
+//	or:
+//
+//	        nil
+type RoleSessionPtrInput interface {
+	pulumi.Input
+
+	ToRoleSessionPtrOutput() RoleSessionPtrOutput
+	ToRoleSessionPtrOutputWithContext(context.Context) RoleSessionPtrOutput
+}
+
+type roleSessionPtrType RoleSessionArgs
+
+func RoleSessionPtr(v *RoleSessionArgs) RoleSessionPtrInput {
+	return (*roleSessionPtrType)(v)
+}
+
+func (*roleSessionPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**RoleSession)(nil)).Elem()
+}
+
+func (i *roleSessionPtrType) ToRoleSessionPtrOutput() RoleSessionPtrOutput {
+	return i.ToRoleSessionPtrOutputWithContext(context.Background())
+}
+
+func (i *roleSessionPtrType) ToRoleSessionPtrOutputWithContext(ctx context.Context) RoleSessionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RoleSessionPtrOutput)
+}
+
+type RoleSessionOutput struct{ *pulumi.OutputState }
+
+func (RoleSessionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RoleSession)(nil)).Elem()
+}
+
+func (o RoleSessionOutput) ToRoleSessionOutput() RoleSessionOutput {
+	return o
+}
+
+func (o RoleSessionOutput) ToRoleSessionOutputWithContext(ctx context.Context) RoleSessionOutput {
+	return o
+}
+
+func (o RoleSessionOutput) ToRoleSessionPtrOutput() RoleSessionPtrOutput {
+	return o.ToRoleSessionPtrOutputWithContext(context.Background())
+}
+
+func (o RoleSessionOutput) ToRoleSessionPtrOutputWithContext(ctx context.Context) RoleSessionPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v RoleSession) *RoleSession {
+		return &v
+	}).(RoleSessionPtrOutput)
+}
+
+// Requested session duration in seconds. Capped by the maximum session duration of the role and defaults to it.
+func (o RoleSessionOutput) DurationSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v RoleSession) *int { return v.DurationSeconds }).(pulumi.IntPtrOutput)
+}
+
+// Actions the session is limited to by a session policy. No session policy is rendered when empty.
+func (o RoleSessionOutput) PolicyActions() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v RoleSession) []string { return v.PolicyActions }).(pulumi.StringArrayOutput)
+}
+
+// Resources the session policy limits the actions to. Defaults to all resources.
+func (o RoleSessionOutput) PolicyResources() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v RoleSession) []string { return v.PolicyResources }).(pulumi.StringArrayOutput)
+}
+
+// Name of the AWS CLI profile. Defaults to the role name.
+func (o RoleSessionOutput) ProfileName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RoleSession) *string { return v.ProfileName }).(pulumi.StringPtrOutput)
+}
+
+// Whether the role is assumed from another role session, which STS limits to one hour.
+func (o RoleSessionOutput) RoleChaining() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v RoleSession) *bool { return v.RoleChaining }).(pulumi.BoolPtrOutput)
+}
+
+// Name of the role session. Defaults to the profile name.
+func (o RoleSessionOutput) SessionName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RoleSession) *string { return v.SessionName }).(pulumi.StringPtrOutput)
+}
+
+// Session tags to pass when assuming the role.
+func (o RoleSessionOutput) SessionTags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v RoleSession) map[string]string { return v.SessionTags }).(pulumi.StringMapOutput)
+}
+
+// Source identity to set on the session, e.g. the name of the CI pipeline.
+func (o RoleSessionOutput) SourceIdentity() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RoleSession) *string { return v.SourceIdentity }).(pulumi.StringPtrOutput)
+}
+
+// Name of the AWS CLI profile whose credentials assume the role.
+func (o RoleSessionOutput) SourceProfile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RoleSession) *string { return v.SourceProfile }).(pulumi.StringPtrOutput)
+}
+
+// Session tag keys which persist when the session assumes another role.
+func (o RoleSessionOutput) TransitiveTagKeys() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v RoleSession) []string { return v.TransitiveTagKeys }).(pulumi.StringArrayOutput)
+}
+
+// Path of the web identity token for roles assumed with AssumeRoleWithWebIdentity. Required for the
+// AWS CLI config profile of these roles.
+func (o RoleSessionOutput) WebIdentityTokenFile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RoleSession) *string { return v.WebIdentityTokenFile }).(pulumi.StringPtrOutput)
+}
+
+type RoleSessionPtrOutput struct{ *pulumi.OutputState }
+
+func (RoleSessionPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**RoleSession)(nil)).Elem()
+}
+
+func (o RoleSessionPtrOutput) ToRoleSessionPtrOutput() RoleSessionPtrOutput {
+	return o
+}
+
+func (o RoleSessionPtrOutput) ToRoleSessionPtrOutputWithContext(ctx context.Context) RoleSessionPtrOutput {
+	return o
+}
+
+func (o RoleSessionPtrOutput) Elem() RoleSessionOutput {
+	return o.ApplyT(func(v *RoleSession) RoleSession {
+		if v != nil {
+			return *v
+		}
+		var ret RoleSession
+		return ret
+	}).(RoleSessionOutput)
+}
+
+// Requested session duration in seconds. Capped by the maximum session duration of the role and defaults to it.
+func (o RoleSessionPtrOutput) DurationSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *RoleSession) *int {
+		if v == nil {
+			return nil
+		}
+		return v.DurationSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// Actions the session is limited to by a session policy. No session policy is rendered when empty.
+func (o RoleSessionPtrOutput) PolicyActions() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *RoleSession) []string {
+		if v == nil {
+			return nil
+		}
+		return v.PolicyActions
+	}).(pulumi.StringArrayOutput)
+}
+
+// Resources the session policy limits the actions to. Defaults to all resources.
+func (o RoleSessionPtrOutput) PolicyResources() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *RoleSession) []string {
+		if v == nil {
+			return nil
+		}
+		return v.PolicyResources
+	}).(pulumi.StringArrayOutput)
+}
+
+// Name of the AWS CLI profile. Defaults to the role name.
+func (o RoleSessionPtrOutput) ProfileName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *RoleSession) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ProfileName
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether the role is assumed from another role session, which STS limits to one hour.
+func (o RoleSessionPtrOutput) RoleChaining() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *RoleSession) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.RoleChaining
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Name of the role session. Defaults to the profile name.
+func (o RoleSessionPtrOutput) SessionName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *RoleSession) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SessionName
+	}).(pulumi.StringPtrOutput)
+}
+
+// Session tags to pass when assuming the role.
+func (o RoleSessionPtrOutput) SessionTags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *RoleSession) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.SessionTags
+	}).(pulumi.StringMapOutput)
+}
+
+// Source identity to set on the session, e.g. the name of the CI pipeline.
+func (o RoleSessionPtrOutput) SourceIdentity() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *RoleSession) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SourceIdentity
+	}).(pulumi.StringPtrOutput)
+}
+
+// Name of the AWS CLI profile whose credentials assume the role.
+func (o RoleSessionPtrOutput) SourceProfile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *RoleSession) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SourceProfile
+	}).(pulumi.StringPtrOutput)
+}
+
+// Session tag keys which persist when the session assumes another role.
+func (o RoleSessionPtrOutput) TransitiveTagKeys() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *RoleSession) []string {
+		if v == nil {
+			return nil
+		}
+		return v.TransitiveTagKeys
+	}).(pulumi.StringArrayOutput)
+}
+
+// Path of the web identity token for roles assumed with AssumeRoleWithWebIdentity. Required for the
+// AWS CLI config profile of these roles.
+func (o RoleSessionPtrOutput) WebIdentityTokenFile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *RoleSession) *string {
+		if v == nil {
+			return nil
+		}
+		return v.WebIdentityTokenFile
+	}).(pulumi.StringPtrOutput)
+}
+
+type RoleSessionHelpers struct {
+	// AWS CLI command assuming the role with the source identity, session tags and session policy.
+	CliCommand string `pulumi:"cliCommand"`
+	// AWS CLI config profile assuming the role. Empty for web identity roles without a web identity token file.
+	CliConfig string `pulumi:"cliConfig"`
+	// Session duration in seconds, capped by the maximum session duration of the role.
+	DurationSeconds int `pulumi:"durationSeconds"`
+	// Parameters of the STS request assuming the role as JSON, usable with any AWS SDK.
+	SdkParameters string `pulumi:"sdkParameters"`
+	// Session policy limiting what the session can do, as JSON. Empty when no policy actions are given.
+	SessionPolicy string `pulumi:"sessionPolicy"`
+}
+
+type RoleSessionHelpersOutput struct{ *pulumi.OutputState }
+
+func (RoleSessionHelpersOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RoleSessionHelpers)(nil)).Elem()
+}
+
+func (o RoleSessionHelpersOutput) ToRoleSessionHelpersOutput() RoleSessionHelpersOutput {
+	return o
+}
+
+func (o RoleSessionHelpersOutput) ToRoleSessionHelpersOutputWithContext(ctx context.Context) RoleSessionHelpersOutput {
+	return o
+}
+
+// AWS CLI command assuming the role with the source identity, session tags and session policy.
+func (o RoleSessionHelpersOutput) CliCommand() pulumi.StringOutput {
+	return o.ApplyT(func(v RoleSessionHelpers) string { return v.CliCommand }).(pulumi.StringOutput)
+}
+
+// AWS CLI config profile assuming the role. Empty for web identity roles without a web identity token file.
+func (o RoleSessionHelpersOutput) CliConfig() pulumi.StringOutput {
+	return o.ApplyT(func(v RoleSessionHelpers) string { return v.CliConfig }).(pulumi.StringOutput)
+}
+
+// Session duration in seconds, capped by the maximum session duration of the role.
+func (o RoleSessionHelpersOutput) DurationSeconds() pulumi.IntOutput {
+	return o.ApplyT(func(v RoleSessionHelpers) int { return v.DurationSeconds }).(pulumi.IntOutput)
+}
+
+// Parameters of the STS request assuming the role as JSON, usable with any AWS SDK.
+func (o RoleSessionHelpersOutput) SdkParameters() pulumi.StringOutput {
+	return o.ApplyT(func(v RoleSessionHelpers) string { return v.SdkParameters }).(pulumi.StringOutput)
+}
+
+// Session policy limiting what the session can do, as JSON. Empty when no policy actions are given.
+func (o RoleSessionHelpersOutput) SessionPolicy() pulumi.StringOutput {
+	return o.ApplyT(func(v RoleSessionHelpers) string { return v.SessionPolicy }).(pulumi.StringOutput)
+}
+
 // An IAM role that requires MFA.
 type RoleWithMFA struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies *bool `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments *bool `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies map[string]string `pulumi:"inlinePolicies"`
 	// IAM role with the access. Defaults to 'admin'.
 	Name *string `pulumi:"name"`
 	// Path of the IAM role. Defaults to '/'.
@@ -4967,6 +9446,23 @@ type RoleWithMFA struct {
 	Tags map[string]string `pulumi:"tags"`
 }
 
+// Defaults sets the appropriate defaults for RoleWithMFA
+func (val *RoleWithMFA) Defaults() *RoleWithMFA {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		exclusiveInlinePolicies_ := false
+		tmp.ExclusiveInlinePolicies = &exclusiveInlinePolicies_
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		exclusivePolicyAttachments_ := false
+		tmp.ExclusivePolicyAttachments = &exclusivePolicyAttachments_
+	}
+	return &tmp
+}
+
 // RoleWithMFAInput is an input type that accepts RoleWithMFAArgs and RoleWithMFAOutput values.
 // You can construct a concrete instance of `RoleWithMFAInput` via:
 //
@@ -4980,6 +9476,15 @@ type RoleWithMFAInput interface {
 
 // An IAM role that requires MFA.
 type RoleWithMFAArgs struct {
+	// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+	ExclusiveInlinePolicies pulumi.BoolPtrInput `pulumi:"exclusiveInlinePolicies"`
+	// Whether managed policies not declared for the role are detached from it. The role then manages its
+	// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+	// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+	// policies. Previews of existing roles warn that undeclared policies are detached.
+	ExclusivePolicyAttachments pulumi.BoolPtrInput `pulumi:"exclusivePolicyAttachments"`
+	// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+	InlinePolicies pulumi.StringMapInput `pulumi:"inlinePolicies"`
 	// IAM role with the access. Defaults to 'admin'.
 	Name pulumi.StringPtrInput `pulumi:"name"`
 	// Path of the IAM role. Defaults to '/'.
@@ -4994,6 +9499,20 @@ type RoleWithMFAArgs struct {
 	Tags pulumi.StringMapInput `pulumi:"tags"`
 }
 
+// Defaults sets the appropriate defaults for RoleWithMFAArgs
+func (val *RoleWithMFAArgs) Defaults() *RoleWithMFAArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.ExclusiveInlinePolicies == nil {
+		tmp.ExclusiveInlinePolicies = pulumi.BoolPtr(false)
+	}
+	if tmp.ExclusivePolicyAttachments == nil {
+		tmp.ExclusivePolicyAttachments = pulumi.BoolPtr(false)
+	}
+	return &tmp
+}
 func (RoleWithMFAArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*RoleWithMFA)(nil)).Elem()
 }
@@ -5072,6 +9591,24 @@ func (o RoleWithMFAOutput) ToRoleWithMFAPtrOutputWithContext(ctx context.Context
 	}).(RoleWithMFAPtrOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o RoleWithMFAOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v RoleWithMFA) *bool { return v.ExclusiveInlinePolicies }).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o RoleWithMFAOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v RoleWithMFA) *bool { return v.ExclusivePolicyAttachments }).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o RoleWithMFAOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v RoleWithMFA) map[string]string { return v.InlinePolicies }).(pulumi.StringMapOutput)
+}
+
 // IAM role with the access. Defaults to 'admin'.
 func (o RoleWithMFAOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v RoleWithMFA) *string { return v.Name }).(pulumi.StringPtrOutput)
@@ -5126,6 +9663,39 @@ func (o RoleWithMFAPtrOutput) Elem() RoleWithMFAOutput {
 	}).(RoleWithMFAOutput)
 }
 
+// Whether inline policies not declared in `inlinePolicies` are removed from the role.
+func (o RoleWithMFAPtrOutput) ExclusiveInlinePolicies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *RoleWithMFA) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusiveInlinePolicies
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether managed policies not declared for the role are detached from it. The role then manages its
+// policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+// attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+// policies. Previews of existing roles warn that undeclared policies are detached.
+func (o RoleWithMFAPtrOutput) ExclusivePolicyAttachments() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *RoleWithMFA) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ExclusivePolicyAttachments
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+func (o RoleWithMFAPtrOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *RoleWithMFA) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.InlinePolicies
+	}).(pulumi.StringMapOutput)
+}
+
 // IAM role with the access. Defaults to 'admin'.
 func (o RoleWithMFAPtrOutput) Name() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *RoleWithMFA) *string {
@@ -5262,16 +9832,33 @@ func (o UserOutputTypeOutput) UniqueId() pulumi.StringOutput {
 }
 
 func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*AWSAuthInput)(nil)).Elem(), AWSAuthArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AWSAuthPtrInput)(nil)).Elem(), AWSAuthArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AWSAuthRoleInput)(nil)).Elem(), AWSAuthRoleArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AWSAuthRolePtrInput)(nil)).Elem(), AWSAuthRoleArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AWSConfigRoleInput)(nil)).Elem(), AWSConfigRoleArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AWSConfigRoleArrayInput)(nil)).Elem(), AWSConfigRoleArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AWSConfigSourceInput)(nil)).Elem(), AWSConfigSourceArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AWSConfigSourcePtrInput)(nil)).Elem(), AWSConfigSourceArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AbacActionGroupInput)(nil)).Elem(), AbacActionGroupArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AbacActionGroupArrayInput)(nil)).Elem(), AbacActionGroupArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*AccountPasswordPolicyInput)(nil)).Elem(), AccountPasswordPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AccountPasswordPolicyPtrInput)(nil)).Elem(), AccountPasswordPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*AdminRoleInput)(nil)).Elem(), AdminRoleArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*AdminRolePtrInput)(nil)).Elem(), AdminRoleArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*AdminRoleWithMFAInput)(nil)).Elem(), AdminRoleWithMFAArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AssumableRoleWithOIDCProviderInput)(nil)).Elem(), AssumableRoleWithOIDCProviderArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AssumableRoleWithOIDCProviderArrayInput)(nil)).Elem(), AssumableRoleWithOIDCProviderArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSADOTPolicyInput)(nil)).Elem(), EKSADOTPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSADOTPolicyPtrInput)(nil)).Elem(), EKSADOTPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSAmazonManagedServicePrometheusPolicyInput)(nil)).Elem(), EKSAmazonManagedServicePrometheusPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSAmazonManagedServicePrometheusPolicyPtrInput)(nil)).Elem(), EKSAmazonManagedServicePrometheusPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSAppmeshPolicyInput)(nil)).Elem(), EKSAppmeshPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSAppmeshPolicyPtrInput)(nil)).Elem(), EKSAppmeshPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSCertManagerPolicyInput)(nil)).Elem(), EKSCertManagerPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSCertManagerPolicyPtrInput)(nil)).Elem(), EKSCertManagerPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSCloudWatchObservabilityPolicyInput)(nil)).Elem(), EKSCloudWatchObservabilityPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSCloudWatchObservabilityPolicyPtrInput)(nil)).Elem(), EKSCloudWatchObservabilityPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSClusterAutoscalerPolicyInput)(nil)).Elem(), EKSClusterAutoscalerPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSClusterAutoscalerPolicyPtrInput)(nil)).Elem(), EKSClusterAutoscalerPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSEBSCSIPolicyInput)(nil)).Elem(), EKSEBSCSIPolicyArgs{})
@@ -5282,16 +9869,26 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSExternalDNSPolicyPtrInput)(nil)).Elem(), EKSExternalDNSPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSExternalSecretsPolicyInput)(nil)).Elem(), EKSExternalSecretsPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSExternalSecretsPolicyPtrInput)(nil)).Elem(), EKSExternalSecretsPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSGatewayAPIControllerPolicyInput)(nil)).Elem(), EKSGatewayAPIControllerPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSGatewayAPIControllerPolicyPtrInput)(nil)).Elem(), EKSGatewayAPIControllerPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSKEDAPolicyInput)(nil)).Elem(), EKSKEDAPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSKEDAPolicyPtrInput)(nil)).Elem(), EKSKEDAPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSKarpenterControllerPolicyInput)(nil)).Elem(), EKSKarpenterControllerPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSKarpenterControllerPolicyPtrInput)(nil)).Elem(), EKSKarpenterControllerPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSLoadBalancerPolicyInput)(nil)).Elem(), EKSLoadBalancerPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSLoadBalancerPolicyPtrInput)(nil)).Elem(), EKSLoadBalancerPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSMountpointS3CSIPolicyInput)(nil)).Elem(), EKSMountpointS3CSIPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSMountpointS3CSIPolicyPtrInput)(nil)).Elem(), EKSMountpointS3CSIPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSNodeTerminationHandlerPolicyInput)(nil)).Elem(), EKSNodeTerminationHandlerPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSNodeTerminationHandlerPolicyPtrInput)(nil)).Elem(), EKSNodeTerminationHandlerPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSRolePoliciesInput)(nil)).Elem(), EKSRolePoliciesArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSRolePoliciesPtrInput)(nil)).Elem(), EKSRolePoliciesArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSSecretsStoreCSIPolicyInput)(nil)).Elem(), EKSSecretsStoreCSIPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSSecretsStoreCSIPolicyPtrInput)(nil)).Elem(), EKSSecretsStoreCSIPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSServiceAccountInput)(nil)).Elem(), EKSServiceAccountArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSServiceAccountArrayInput)(nil)).Elem(), EKSServiceAccountArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSServiceAccountManifestOptionsInput)(nil)).Elem(), EKSServiceAccountManifestOptionsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EKSServiceAccountManifestOptionsPtrInput)(nil)).Elem(), EKSServiceAccountManifestOptionsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSServiceAccountRoleInput)(nil)).Elem(), EKSServiceAccountRoleArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSServiceAccountRolePtrInput)(nil)).Elem(), EKSServiceAccountRoleArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSVPNCNIPolicyInput)(nil)).Elem(), EKSVPNCNIPolicyArgs{})
@@ -5300,8 +9897,12 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*EKSVeleroPolicyPtrInput)(nil)).Elem(), EKSVeleroPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*FSxLustreCSIPolicyInput)(nil)).Elem(), FSxLustreCSIPolicyArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*FSxLustreCSIPolicyPtrInput)(nil)).Elem(), FSxLustreCSIPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*InstanceProfileSettingsInput)(nil)).Elem(), InstanceProfileSettingsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*InstanceProfileSettingsPtrInput)(nil)).Elem(), InstanceProfileSettingsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*OIDCProviderInput)(nil)).Elem(), OIDCProviderArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*OIDCProviderMapInput)(nil)).Elem(), OIDCProviderMap{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PolicyConditionInput)(nil)).Elem(), PolicyConditionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PolicyConditionArrayInput)(nil)).Elem(), PolicyConditionArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*PoweruserRoleInput)(nil)).Elem(), PoweruserRoleArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*PoweruserRolePtrInput)(nil)).Elem(), PoweruserRoleArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*PoweruserRoleWithMFAInput)(nil)).Elem(), PoweruserRoleWithMFAArgs{})
@@ -5312,19 +9913,38 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ReadonlyRoleWithMFAPtrInput)(nil)).Elem(), ReadonlyRoleWithMFAArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RoleInput)(nil)).Elem(), RoleArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RolePtrInput)(nil)).Elem(), RoleArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*RoleSessionInput)(nil)).Elem(), RoleSessionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*RoleSessionPtrInput)(nil)).Elem(), RoleSessionArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RoleWithMFAInput)(nil)).Elem(), RoleWithMFAArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RoleWithMFAPtrInput)(nil)).Elem(), RoleWithMFAArgs{})
+	pulumi.RegisterOutputType(AWSAuthOutput{})
+	pulumi.RegisterOutputType(AWSAuthPtrOutput{})
+	pulumi.RegisterOutputType(AWSAuthRoleOutput{})
+	pulumi.RegisterOutputType(AWSAuthRolePtrOutput{})
+	pulumi.RegisterOutputType(AWSConfigRoleOutput{})
+	pulumi.RegisterOutputType(AWSConfigRoleArrayOutput{})
+	pulumi.RegisterOutputType(AWSConfigSourceOutput{})
+	pulumi.RegisterOutputType(AWSConfigSourcePtrOutput{})
+	pulumi.RegisterOutputType(AbacActionGroupOutput{})
+	pulumi.RegisterOutputType(AbacActionGroupArrayOutput{})
 	pulumi.RegisterOutputType(AccessKeyOutputOutput{})
 	pulumi.RegisterOutputType(AccountPasswordPolicyOutput{})
+	pulumi.RegisterOutputType(AccountPasswordPolicyPtrOutput{})
 	pulumi.RegisterOutputType(AdminRoleOutput{})
 	pulumi.RegisterOutputType(AdminRolePtrOutput{})
 	pulumi.RegisterOutputType(AdminRoleWithMFAOutput{})
+	pulumi.RegisterOutputType(AssumableRoleWithOIDCProviderOutput{})
+	pulumi.RegisterOutputType(AssumableRoleWithOIDCProviderArrayOutput{})
+	pulumi.RegisterOutputType(EKSADOTPolicyOutput{})
+	pulumi.RegisterOutputType(EKSADOTPolicyPtrOutput{})
 	pulumi.RegisterOutputType(EKSAmazonManagedServicePrometheusPolicyOutput{})
 	pulumi.RegisterOutputType(EKSAmazonManagedServicePrometheusPolicyPtrOutput{})
 	pulumi.RegisterOutputType(EKSAppmeshPolicyOutput{})
 	pulumi.RegisterOutputType(EKSAppmeshPolicyPtrOutput{})
 	pulumi.RegisterOutputType(EKSCertManagerPolicyOutput{})
 	pulumi.RegisterOutputType(EKSCertManagerPolicyPtrOutput{})
+	pulumi.RegisterOutputType(EKSCloudWatchObservabilityPolicyOutput{})
+	pulumi.RegisterOutputType(EKSCloudWatchObservabilityPolicyPtrOutput{})
 	pulumi.RegisterOutputType(EKSClusterAutoscalerPolicyOutput{})
 	pulumi.RegisterOutputType(EKSClusterAutoscalerPolicyPtrOutput{})
 	pulumi.RegisterOutputType(EKSEBSCSIPolicyOutput{})
@@ -5335,16 +9955,28 @@ func init() {
 	pulumi.RegisterOutputType(EKSExternalDNSPolicyPtrOutput{})
 	pulumi.RegisterOutputType(EKSExternalSecretsPolicyOutput{})
 	pulumi.RegisterOutputType(EKSExternalSecretsPolicyPtrOutput{})
+	pulumi.RegisterOutputType(EKSGatewayAPIControllerPolicyOutput{})
+	pulumi.RegisterOutputType(EKSGatewayAPIControllerPolicyPtrOutput{})
+	pulumi.RegisterOutputType(EKSKEDAPolicyOutput{})
+	pulumi.RegisterOutputType(EKSKEDAPolicyPtrOutput{})
 	pulumi.RegisterOutputType(EKSKarpenterControllerPolicyOutput{})
 	pulumi.RegisterOutputType(EKSKarpenterControllerPolicyPtrOutput{})
 	pulumi.RegisterOutputType(EKSLoadBalancerPolicyOutput{})
 	pulumi.RegisterOutputType(EKSLoadBalancerPolicyPtrOutput{})
+	pulumi.RegisterOutputType(EKSMountpointS3CSIPolicyOutput{})
+	pulumi.RegisterOutputType(EKSMountpointS3CSIPolicyPtrOutput{})
 	pulumi.RegisterOutputType(EKSNodeTerminationHandlerPolicyOutput{})
 	pulumi.RegisterOutputType(EKSNodeTerminationHandlerPolicyPtrOutput{})
 	pulumi.RegisterOutputType(EKSRolePoliciesOutput{})
 	pulumi.RegisterOutputType(EKSRolePoliciesPtrOutput{})
+	pulumi.RegisterOutputType(EKSSecretsStoreCSIPolicyOutput{})
+	pulumi.RegisterOutputType(EKSSecretsStoreCSIPolicyPtrOutput{})
 	pulumi.RegisterOutputType(EKSServiceAccountOutput{})
 	pulumi.RegisterOutputType(EKSServiceAccountArrayOutput{})
+	pulumi.RegisterOutputType(EKSServiceAccountManifestOutput{})
+	pulumi.RegisterOutputType(EKSServiceAccountManifestArrayOutput{})
+	pulumi.RegisterOutputType(EKSServiceAccountManifestOptionsOutput{})
+	pulumi.RegisterOutputType(EKSServiceAccountManifestOptionsPtrOutput{})
 	pulumi.RegisterOutputType(EKSServiceAccountRoleOutput{})
 	pulumi.RegisterOutputType(EKSServiceAccountRolePtrOutput{})
 	pulumi.RegisterOutputType(EKSVPNCNIPolicyOutput{})
@@ -5353,9 +9985,13 @@ func init() {
 	pulumi.RegisterOutputType(EKSVeleroPolicyPtrOutput{})
 	pulumi.RegisterOutputType(FSxLustreCSIPolicyOutput{})
 	pulumi.RegisterOutputType(FSxLustreCSIPolicyPtrOutput{})
+	pulumi.RegisterOutputType(InstanceProfileSettingsOutput{})
+	pulumi.RegisterOutputType(InstanceProfileSettingsPtrOutput{})
 	pulumi.RegisterOutputType(KeybaseOutputOutput{})
 	pulumi.RegisterOutputType(OIDCProviderOutput{})
 	pulumi.RegisterOutputType(OIDCProviderMapOutput{})
+	pulumi.RegisterOutputType(PolicyConditionOutput{})
+	pulumi.RegisterOutputType(PolicyConditionArrayOutput{})
 	pulumi.RegisterOutputType(PoweruserRoleOutput{})
 	pulumi.RegisterOutputType(PoweruserRolePtrOutput{})
 	pulumi.RegisterOutputType(PoweruserRoleWithMFAOutput{})
@@ -5366,6 +10002,9 @@ func init() {
 	pulumi.RegisterOutputType(ReadonlyRoleWithMFAPtrOutput{})
 	pulumi.RegisterOutputType(RoleOutput{})
 	pulumi.RegisterOutputType(RolePtrOutput{})
+	pulumi.RegisterOutputType(RoleSessionOutput{})
+	pulumi.RegisterOutputType(RoleSessionPtrOutput{})
+	pulumi.RegisterOutputType(RoleSessionHelpersOutput{})
 	pulumi.RegisterOutputType(RoleWithMFAOutput{})
 	pulumi.RegisterOutputType(RoleWithMFAPtrOutput{})
 	pulumi.RegisterOutputType(UserOutputTypeOutput{})
@@ -53,6 +53,8 @@ type ReadOnlyPolicy struct {
 
 	// The ARN assigned by AWS to this policy.
 	Arn pulumi.StringOutput `pulumi:"arn"`
+	// Version of the action catalog used to expand actions when `metadataOnly` is set.
+	CatalogVersion pulumi.StringOutput `pulumi:"catalogVersion"`
 	// The description of the policy.
 	Description pulumi.StringOutput `pulumi:"description"`
 	// The policy's ID.
@@ -92,6 +94,9 @@ func NewReadOnlyPolicy(ctx *pulumi.Context,
 	if args.Description == nil {
 		args.Description = pulumi.StringPtr("IAM Policy")
 	}
+	if args.MetadataOnly == nil {
+		args.MetadataOnly = pulumi.BoolPtr(false)
+	}
 	if args.Path == nil {
 		args.Path = pulumi.StringPtr("/")
 	}
@@ -116,6 +121,8 @@ type readOnlyPolicyArgs struct {
 	AllowedServices []string `pulumi:"allowedServices"`
 	// The description of the policy.
 	Description *string `pulumi:"description"`
+	// Allow only List, Describe and metadata Read actions instead of the List*, Get*, Describe* and View* wildcards, leaving out reads of data and secrets such as s3:GetObject or secretsmanager:GetSecretValue. Since the embedded action catalog is a curated subset, services it does not list completely keep List* and Describe* next to their other cataloged metadata actions, and services missing from it fall back to List* and Describe*.
+	MetadataOnly *bool `pulumi:"metadataOnly"`
 	// The name of the policy.
 	Name string `pulumi:"name"`
 	// The path of the policy in IAM.
@@ -140,6 +147,8 @@ type ReadOnlyPolicyArgs struct {
 	AllowedServices pulumi.StringArrayInput
 	// The description of the policy.
 	Description pulumi.StringPtrInput
+	// Allow only List, Describe and metadata Read actions instead of the List*, Get*, Describe* and View* wildcards, leaving out reads of data and secrets such as s3:GetObject or secretsmanager:GetSecretValue. Since the embedded action catalog is a curated subset, services it does not list completely keep List* and Describe* next to their other cataloged metadata actions, and services missing from it fall back to List* and Describe*.
+	MetadataOnly pulumi.BoolPtrInput
 	// The name of the policy.
 	Name pulumi.StringInput
 	// The path of the policy in IAM.
@@ -242,6 +251,11 @@ func (o ReadOnlyPolicyOutput) Arn() pulumi.StringOutput {
 	return o.ApplyT(func(v *ReadOnlyPolicy) pulumi.StringOutput { return v.Arn }).(pulumi.StringOutput)
 }
 
+// Version of the action catalog used to expand actions when `metadataOnly` is set.
+func (o ReadOnlyPolicyOutput) CatalogVersion() pulumi.StringOutput {
+	return o.ApplyT(func(v *ReadOnlyPolicy) pulumi.StringOutput { return v.CatalogVersion }).(pulumi.StringOutput)
+}
+
 // The description of the policy.
 func (o ReadOnlyPolicyOutput) Description() pulumi.StringOutput {
 	return o.ApplyT(func(v *ReadOnlyPolicy) pulumi.StringOutput { return v.Description }).(pulumi.StringOutput)
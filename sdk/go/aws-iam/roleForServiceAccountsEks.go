@@ -81,6 +81,10 @@ type RoleForServiceAccountsEks struct {
 	pulumi.ResourceState
 
 	Role pulumi.StringMapOutput `pulumi:"role"`
+	// Annotations and ServiceAccount manifests binding each ServiceAccount to the role.
+	ServiceAccounts EKSServiceAccountManifestArrayOutput `pulumi:"serviceAccounts"`
+	// Session policy and AWS CLI / SDK snippets assuming the role.
+	Session RoleSessionHelpersOutput `pulumi:"session"`
 }
 
 // NewRoleForServiceAccountsEks registers a new resource with the given unique name, arguments, and options.
@@ -90,6 +94,9 @@ func NewRoleForServiceAccountsEks(ctx *pulumi.Context,
 		args = &RoleForServiceAccountsEksArgs{}
 	}
 
+	if args.AllowAllNamespaces == nil {
+		args.AllowAllNamespaces = pulumi.BoolPtr(false)
+	}
 	if args.AssumeRoleConditionTest == nil {
 		args.AssumeRoleConditionTest = pulumi.StringPtr("StringEquals")
 	}
@@ -105,6 +112,15 @@ func NewRoleForServiceAccountsEks(ctx *pulumi.Context,
 	if args.PolicyNamePrefix == nil {
 		args.PolicyNamePrefix = pulumi.StringPtr("AmazonEKS_")
 	}
+	if args.Role != nil {
+		args.Role = args.Role.ToEKSServiceAccountRolePtrOutput().ApplyT(func(v *EKSServiceAccountRole) *EKSServiceAccountRole { return v.Defaults() }).(EKSServiceAccountRolePtrOutput)
+	}
+	if args.ServiceAccountManifest != nil {
+		args.ServiceAccountManifest = args.ServiceAccountManifest.ToEKSServiceAccountManifestOptionsPtrOutput().ApplyT(func(v *EKSServiceAccountManifestOptions) *EKSServiceAccountManifestOptions { return v.Defaults() }).(EKSServiceAccountManifestOptionsPtrOutput)
+	}
+	if args.Session != nil {
+		args.Session = args.Session.ToRoleSessionPtrOutput().ApplyT(func(v *RoleSession) *RoleSession { return v.Defaults() }).(RoleSessionPtrOutput)
+	}
 	var resource RoleForServiceAccountsEks
 	err := ctx.RegisterRemoteComponentResource("aws-iam:index:RoleForServiceAccountsEks", name, args, &resource, opts...)
 	if err != nil {
@@ -114,7 +130,10 @@ func NewRoleForServiceAccountsEks(ctx *pulumi.Context,
 }
 
 type roleForServiceAccountsEksArgs struct {
-	// Name of the IAM condition operator to evaluate when assuming the role.
+	// Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+	AllowAllNamespaces *bool `pulumi:"allowAllNamespaces"`
+	// Name of the IAM condition operator to evaluate when assuming the role. ServiceAccounts with wildcards
+	// are matched with StringLike in a statement of their own.
 	AssumeRoleConditionTest *string `pulumi:"assumeRoleConditionTest"`
 	// Whether policies should be detached from this role when destroying.
 	ForceDetachPolicies *bool `pulumi:"forceDetachPolicies"`
@@ -126,13 +145,21 @@ type roleForServiceAccountsEksArgs struct {
 	// IAM policy name prefix.
 	PolicyNamePrefix *string                `pulumi:"policyNamePrefix"`
 	Role             *EKSServiceAccountRole `pulumi:"role"`
+	// Annotations added to the rendered ServiceAccount manifests.
+	ServiceAccountManifest *EKSServiceAccountManifestOptions `pulumi:"serviceAccountManifest"`
+	// Settings of the session helpers rendered for the role. The web identity token file defaults to the
+	// token EKS mounts into pods.
+	Session *RoleSession `pulumi:"session"`
 	// A map of tags to add.
 	Tags map[string]string `pulumi:"tags"`
 }
 
 // The set of arguments for constructing a RoleForServiceAccountsEks resource.
 type RoleForServiceAccountsEksArgs struct {
-	// Name of the IAM condition operator to evaluate when assuming the role.
+	// Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+	AllowAllNamespaces pulumi.BoolPtrInput
+	// Name of the IAM condition operator to evaluate when assuming the role. ServiceAccounts with wildcards
+	// are matched with StringLike in a statement of their own.
 	AssumeRoleConditionTest pulumi.StringPtrInput
 	// Whether policies should be detached from this role when destroying.
 	ForceDetachPolicies pulumi.BoolPtrInput
@@ -144,6 +171,11 @@ type RoleForServiceAccountsEksArgs struct {
 	// IAM policy name prefix.
 	PolicyNamePrefix pulumi.StringPtrInput
 	Role             EKSServiceAccountRolePtrInput
+	// Annotations added to the rendered ServiceAccount manifests.
+	ServiceAccountManifest EKSServiceAccountManifestOptionsPtrInput
+	// Settings of the session helpers rendered for the role. The web identity token file defaults to the
+	// token EKS mounts into pods.
+	Session RoleSessionPtrInput
 	// A map of tags to add.
 	Tags pulumi.StringMapInput
 }
@@ -239,6 +271,16 @@ func (o RoleForServiceAccountsEksOutput) Role() pulumi.StringMapOutput {
 	return o.ApplyT(func(v *RoleForServiceAccountsEks) pulumi.StringMapOutput { return v.Role }).(pulumi.StringMapOutput)
 }
 
+// Annotations and ServiceAccount manifests binding each ServiceAccount to the role.
+func (o RoleForServiceAccountsEksOutput) ServiceAccounts() EKSServiceAccountManifestArrayOutput {
+	return o.ApplyT(func(v *RoleForServiceAccountsEks) EKSServiceAccountManifestArrayOutput { return v.ServiceAccounts }).(EKSServiceAccountManifestArrayOutput)
+}
+
+// Session policy and AWS CLI / SDK snippets assuming the role.
+func (o RoleForServiceAccountsEksOutput) Session() RoleSessionHelpersOutput {
+	return o.ApplyT(func(v *RoleForServiceAccountsEks) RoleSessionHelpersOutput { return v.Session }).(RoleSessionHelpersOutput)
+}
+
 type RoleForServiceAccountsEksArrayOutput struct{ *pulumi.OutputState }
 
 func (RoleForServiceAccountsEksArrayOutput) ElementType() reflect.Type {
@@ -0,0 +1,278 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package awsiam
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// This resource helps you create an IAM SAML identity provider from the metadata document of your IdP. The
+// metadata is validated before the provider is created: it must contain an entity ID and at least one
+// signing certificate that has not expired. A warning is reported when a signing certificate is close to
+// its expiry date so that the metadata can be updated before the IdP rotates its certificate.
+//
+// ## Example Usage
+// ## SAML Provider
+//
+// ```go
+// package main
+//
+// import (
+//
+//	iam "github.com/pulumi/pulumi-aws-iam/sdk/go/aws-iam"
+//	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+//
+// )
+//
+//	func main() {
+//	    pulumi.Run(func(ctx *pulumi.Context) error {
+//	        samlProvider, err := iam.NewSAMLProvider(ctx, "saml-provider", &iam.SAMLProviderArgs{
+//	            Name:                         pulumi.String("okta"),
+//	            SamlMetadataFile:             pulumi.String("./okta-metadata.xml"),
+//	            CertificateExpiryWarningDays: pulumi.IntPtr(45),
+//	        })
+//	        if err != nil {
+//	            return err
+//	        }
+//
+//	        ctx.Export("samlProvider", samlProvider)
+//
+//	        return nil
+//	    })
+//	}
+//
+// ```
+// {{ /example }}
+type SAMLProvider struct {
+	pulumi.ResourceState
+
+	// The ARN assigned by AWS for this provider.
+	Arn pulumi.StringOutput `pulumi:"arn"`
+	// The expiration date of the valid signing certificate that expires first, in RFC3339 format.
+	CertificateExpiry pulumi.StringOutput `pulumi:"certificateExpiry"`
+	// The number of days left until the valid signing certificate that expires first is no longer valid.
+	DaysUntilCertificateExpiry pulumi.IntOutput `pulumi:"daysUntilCertificateExpiry"`
+	// The entity ID of the IdP found in the metadata document.
+	EntityId pulumi.StringOutput `pulumi:"entityId"`
+	// The name of the provider.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// The expiration date and time for the SAML provider in RFC1123 format.
+	ValidUntil pulumi.StringOutput `pulumi:"validUntil"`
+}
+
+// NewSAMLProvider registers a new resource with the given unique name, arguments, and options.
+func NewSAMLProvider(ctx *pulumi.Context,
+	name string, args *SAMLProviderArgs, opts ...pulumi.ResourceOption) (*SAMLProvider, error) {
+	if args == nil {
+		args = &SAMLProviderArgs{}
+	}
+
+	if args.CertificateExpiryWarningDays == nil {
+		args.CertificateExpiryWarningDays = pulumi.IntPtr(30)
+	}
+	var resource SAMLProvider
+	err := ctx.RegisterRemoteComponentResource("aws-iam:index:SAMLProvider", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type samlproviderArgs struct {
+	// Number of days before a signing certificate expires at which a warning is reported. `0` disables the warning.
+	CertificateExpiryWarningDays *int `pulumi:"certificateExpiryWarningDays"`
+	// The name of the SAML provider. Defaults to the resource name.
+	Name *string `pulumi:"name"`
+	// The IdP metadata XML document.
+	SamlMetadataDocument *string `pulumi:"samlMetadataDocument"`
+	// Path to a local file containing the IdP metadata XML document. Used when `samlMetadataDocument`
+	// is not provided.
+	SamlMetadataFile *string `pulumi:"samlMetadataFile"`
+	// A map of tags to add.
+	Tags map[string]string `pulumi:"tags"`
+}
+
+// The set of arguments for constructing a SAMLProvider resource.
+type SAMLProviderArgs struct {
+	// Number of days before a signing certificate expires at which a warning is reported. `0` disables the warning.
+	CertificateExpiryWarningDays pulumi.IntPtrInput
+	// The name of the SAML provider. Defaults to the resource name.
+	Name pulumi.StringPtrInput
+	// The IdP metadata XML document.
+	SamlMetadataDocument pulumi.StringPtrInput
+	// Path to a local file containing the IdP metadata XML document. Used when `samlMetadataDocument`
+	// is not provided.
+	SamlMetadataFile pulumi.StringPtrInput
+	// A map of tags to add.
+	Tags pulumi.StringMapInput
+}
+
+func (SAMLProviderArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*samlproviderArgs)(nil)).Elem()
+}
+
+type SAMLProviderInput interface {
+	pulumi.Input
+
+	ToSAMLProviderOutput() SAMLProviderOutput
+	ToSAMLProviderOutputWithContext(ctx context.Context) SAMLProviderOutput
+}
+
+func (*SAMLProvider) ElementType() reflect.Type {
+	return reflect.TypeOf((**SAMLProvider)(nil)).Elem()
+}
+
+func (i *SAMLProvider) ToSAMLProviderOutput() SAMLProviderOutput {
+	return i.ToSAMLProviderOutputWithContext(context.Background())
+}
+
+func (i *SAMLProvider) ToSAMLProviderOutputWithContext(ctx context.Context) SAMLProviderOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SAMLProviderOutput)
+}
+
+// SAMLProviderArrayInput is an input type that accepts SAMLProviderArray and SAMLProviderArrayOutput values.
+// You can construct a concrete instance of `SAMLProviderArrayInput` via:
+//
+//	SAMLProviderArray{ SAMLProviderArgs{...} }
+type SAMLProviderArrayInput interface {
+	pulumi.Input
+
+	ToSAMLProviderArrayOutput() SAMLProviderArrayOutput
+	ToSAMLProviderArrayOutputWithContext(context.Context) SAMLProviderArrayOutput
+}
+
+type SAMLProviderArray []SAMLProviderInput
+
+func (SAMLProviderArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*SAMLProvider)(nil)).Elem()
+}
+
+func (i SAMLProviderArray) ToSAMLProviderArrayOutput() SAMLProviderArrayOutput {
+	return i.ToSAMLProviderArrayOutputWithContext(context.Background())
+}
+
+func (i SAMLProviderArray) ToSAMLProviderArrayOutputWithContext(ctx context.Context) SAMLProviderArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SAMLProviderArrayOutput)
+}
+
+// SAMLProviderMapInput is an input type that accepts SAMLProviderMap and SAMLProviderMapOutput values.
+// You can construct a concrete instance of `SAMLProviderMapInput` via:
+//
+//	SAMLProviderMap{ "key": SAMLProviderArgs{...} }
+type SAMLProviderMapInput interface {
+	pulumi.Input
+
+	ToSAMLProviderMapOutput() SAMLProviderMapOutput
+	ToSAMLProviderMapOutputWithContext(context.Context) SAMLProviderMapOutput
+}
+
+type SAMLProviderMap map[string]SAMLProviderInput
+
+func (SAMLProviderMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*SAMLProvider)(nil)).Elem()
+}
+
+func (i SAMLProviderMap) ToSAMLProviderMapOutput() SAMLProviderMapOutput {
+	return i.ToSAMLProviderMapOutputWithContext(context.Background())
+}
+
+func (i SAMLProviderMap) ToSAMLProviderMapOutputWithContext(ctx context.Context) SAMLProviderMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SAMLProviderMapOutput)
+}
+
+type SAMLProviderOutput struct{ *pulumi.OutputState }
+
+func (SAMLProviderOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SAMLProvider)(nil)).Elem()
+}
+
+func (o SAMLProviderOutput) ToSAMLProviderOutput() SAMLProviderOutput {
+	return o
+}
+
+func (o SAMLProviderOutput) ToSAMLProviderOutputWithContext(ctx context.Context) SAMLProviderOutput {
+	return o
+}
+
+// The ARN assigned by AWS for this provider.
+func (o SAMLProviderOutput) Arn() pulumi.StringOutput {
+	return o.ApplyT(func(v *SAMLProvider) pulumi.StringOutput { return v.Arn }).(pulumi.StringOutput)
+}
+
+// The expiration date of the valid signing certificate that expires first, in RFC3339 format.
+func (o SAMLProviderOutput) CertificateExpiry() pulumi.StringOutput {
+	return o.ApplyT(func(v *SAMLProvider) pulumi.StringOutput { return v.CertificateExpiry }).(pulumi.StringOutput)
+}
+
+// The number of days left until the valid signing certificate that expires first is no longer valid.
+func (o SAMLProviderOutput) DaysUntilCertificateExpiry() pulumi.IntOutput {
+	return o.ApplyT(func(v *SAMLProvider) pulumi.IntOutput { return v.DaysUntilCertificateExpiry }).(pulumi.IntOutput)
+}
+
+// The entity ID of the IdP found in the metadata document.
+func (o SAMLProviderOutput) EntityId() pulumi.StringOutput {
+	return o.ApplyT(func(v *SAMLProvider) pulumi.StringOutput { return v.EntityId }).(pulumi.StringOutput)
+}
+
+// The name of the provider.
+func (o SAMLProviderOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *SAMLProvider) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// The expiration date and time for the SAML provider in RFC1123 format.
+func (o SAMLProviderOutput) ValidUntil() pulumi.StringOutput {
+	return o.ApplyT(func(v *SAMLProvider) pulumi.StringOutput { return v.ValidUntil }).(pulumi.StringOutput)
+}
+
+type SAMLProviderArrayOutput struct{ *pulumi.OutputState }
+
+func (SAMLProviderArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*SAMLProvider)(nil)).Elem()
+}
+
+func (o SAMLProviderArrayOutput) ToSAMLProviderArrayOutput() SAMLProviderArrayOutput {
+	return o
+}
+
+func (o SAMLProviderArrayOutput) ToSAMLProviderArrayOutputWithContext(ctx context.Context) SAMLProviderArrayOutput {
+	return o
+}
+
+func (o SAMLProviderArrayOutput) Index(i pulumi.IntInput) SAMLProviderOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *SAMLProvider {
+		return vs[0].([]*SAMLProvider)[vs[1].(int)]
+	}).(SAMLProviderOutput)
+}
+
+type SAMLProviderMapOutput struct{ *pulumi.OutputState }
+
+func (SAMLProviderMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*SAMLProvider)(nil)).Elem()
+}
+
+func (o SAMLProviderMapOutput) ToSAMLProviderMapOutput() SAMLProviderMapOutput {
+	return o
+}
+
+func (o SAMLProviderMapOutput) ToSAMLProviderMapOutputWithContext(ctx context.Context) SAMLProviderMapOutput {
+	return o
+}
+
+func (o SAMLProviderMapOutput) MapIndex(k pulumi.StringInput) SAMLProviderOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *SAMLProvider {
+		return vs[0].(map[string]*SAMLProvider)[vs[1].(string)]
+	}).(SAMLProviderOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*SAMLProviderInput)(nil)).Elem(), &SAMLProvider{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SAMLProviderArrayInput)(nil)).Elem(), SAMLProviderArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SAMLProviderMapInput)(nil)).Elem(), SAMLProviderMap{})
+	pulumi.RegisterOutputType(SAMLProviderOutput{})
+	pulumi.RegisterOutputType(SAMLProviderArrayOutput{})
+	pulumi.RegisterOutputType(SAMLProviderMapOutput{})
+}
@@ -69,6 +69,9 @@ func NewUser(ctx *pulumi.Context,
 	if args.Name == nil {
 		return nil, errors.New("invalid value for required argument 'Name'")
 	}
+	if args.ExclusivePolicyAttachments == nil {
+		args.ExclusivePolicyAttachments = pulumi.BoolPtr(false)
+	}
 	if args.Path == nil {
 		args.Path = pulumi.StringPtr("/")
 	}
@@ -84,6 +87,9 @@ func NewUser(ctx *pulumi.Context,
 }
 
 type userArgs struct {
+	// Whether managed policies not declared for the user are detached from it. Not supported for users
+	// by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
+	ExclusivePolicyAttachments *bool `pulumi:"exclusivePolicyAttachments"`
 	// When destroying this user, destroy even if it has non-Pulumi-managed IAM access keys, login profile or MFA devices. Without forceDestroy a user with non-Pulumi-managed access keys and login profile will fail to be destroyed.
 	ForceDestroy *bool `pulumi:"forceDestroy"`
 	// Desired name for the IAM user.
@@ -110,6 +116,9 @@ type userArgs struct {
 
 // The set of arguments for constructing a User resource.
 type UserArgs struct {
+	// Whether managed policies not declared for the user are detached from it. Not supported for users
+	// by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
+	ExclusivePolicyAttachments pulumi.BoolPtrInput
 	// When destroying this user, destroy even if it has non-Pulumi-managed IAM access keys, login profile or MFA devices. Without forceDestroy a user with non-Pulumi-managed access keys and login profile will fail to be destroyed.
 	ForceDestroy pulumi.BoolPtrInput
 	// Desired name for the IAM user.
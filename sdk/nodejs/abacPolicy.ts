@@ -0,0 +1,146 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * This resource helps you create an attribute-based access control (ABAC) IAM policy. Actions are only allowed
+ * on resources whose tags match the tags of the principal (`aws:ResourceTag` against `aws:PrincipalTag`),
+ * resources can only be created with matching tags (`aws:RequestTag`) and principals are prevented from
+ * changing or removing the tags used for access control.
+ *
+ * ## Example Usage
+ * ## ABAC Policy
+ *
+ * ```typescript
+ * import * as iam from "@pulumi/aws-iam";
+ *
+ * export const abacPolicy = new iam.AbacPolicy("aws-iam-example-abac-policy", {
+ *     name: "team-abac",
+ *     tagKeys: [ "team", "project" ],
+ *     actionGroups: [{
+ *         service: "ec2",
+ *         actions: [ "StartInstances", "StopInstances", "RebootInstances" ],
+ *         createActions: [ "RunInstances" ],
+ *     }],
+ * });
+ * ```
+ * {{ /example }}
+ */
+export class AbacPolicy extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'aws-iam:index:AbacPolicy';
+
+    /**
+     * Returns true if the given object is an instance of AbacPolicy.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is AbacPolicy {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === AbacPolicy.__pulumiType;
+    }
+
+    /**
+     * The ARN assigned by AWS to this policy.
+     */
+    public /*out*/ readonly arn!: pulumi.Output<string>;
+    /**
+     * The description of the policy.
+     */
+    public readonly description!: pulumi.Output<string>;
+    /**
+     * The policy's ID.
+     */
+    public /*out*/ readonly id!: pulumi.Output<string>;
+    /**
+     * The name of the policy.
+     */
+    public readonly name!: pulumi.Output<string>;
+    /**
+     * The path of the policy in IAM.
+     */
+    public readonly path!: pulumi.Output<string>;
+    /**
+     * Policy document as json.
+     */
+    public /*out*/ readonly policyJson!: pulumi.Output<string>;
+
+    /**
+     * Create a AbacPolicy resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: AbacPolicyArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.actionGroups === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'actionGroups'");
+            }
+            if ((!args || args.tagKeys === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'tagKeys'");
+            }
+            resourceInputs["actionGroups"] = args ? args.actionGroups : undefined;
+            resourceInputs["allowTagModification"] = (args ? args.allowTagModification : undefined) ?? false;
+            resourceInputs["description"] = (args ? args.description : undefined) ?? "Attribute-based access control policy";
+            resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["path"] = (args ? args.path : undefined) ?? "/";
+            resourceInputs["tagKeys"] = args ? args.tagKeys : undefined;
+            resourceInputs["tags"] = args ? args.tags : undefined;
+            resourceInputs["arn"] = undefined /*out*/;
+            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["policyJson"] = undefined /*out*/;
+        } else {
+            resourceInputs["arn"] = undefined /*out*/;
+            resourceInputs["description"] = undefined /*out*/;
+            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["policyJson"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(AbacPolicy.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a AbacPolicy resource.
+ */
+export interface AbacPolicyArgs {
+    /**
+     * Groups of actions to allow for each service.
+     */
+    actionGroups: pulumi.Input<pulumi.Input<inputs.AbacActionGroupArgs>[]>;
+    /**
+     * Whether to leave out the statements which prevent principals from changing or removing the tags
+     * used for access control.
+     */
+    allowTagModification?: pulumi.Input<boolean>;
+    /**
+     * The description of the policy.
+     */
+    description?: pulumi.Input<string>;
+    /**
+     * The name of the policy.
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * The path of the policy in IAM.
+     */
+    path?: pulumi.Input<string>;
+    /**
+     * Tag keys that must match between the principal and the resources.
+     */
+    tagKeys: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * A map of tags to add.
+     */
+    tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
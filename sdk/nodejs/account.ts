@@ -7,10 +7,12 @@ import * as outputs from "./types/output";
 import * as utilities from "./utilities";
 
 /**
- * This resource helps you manage an Iam Account's Alias and Password Policy. If your IAM Account Alias was previously
- * set (either via the AWS console or when AWS created your Account) you will see an error like the below:
+ * This resource helps you manage an Iam Account's Alias and Password Policy. Both are optional, only the ones
+ * provided will be managed. If your IAM Account Alias was previously set (either via the AWS console or when
+ * AWS created your Account) you will see an error like the below:
  *
- * If you want to manage you Alias using Pulumi you will need to import this resource.
+ * If you want to manage you Alias using Pulumi you will need to import this resource, which can be done by
+ * setting `importAccountAlias`. An existing password policy can be adopted the same way with `importPasswordPolicy`.
  *
  * ## Example Usage
  * ## Account
@@ -73,18 +75,15 @@ export class Account extends pulumi.ComponentResource {
      * @param args The arguments to use to populate this resource's properties.
      * @param opts A bag of options that control this resource's behavior.
      */
-    constructor(name: string, args: AccountArgs, opts?: pulumi.ComponentResourceOptions) {
+    constructor(name: string, args?: AccountArgs, opts?: pulumi.ComponentResourceOptions) {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
-            if ((!args || args.accountAlias === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'accountAlias'");
-            }
-            if ((!args || args.passwordPolicy === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'passwordPolicy'");
-            }
             resourceInputs["accountAlias"] = args ? args.accountAlias : undefined;
+            resourceInputs["importAccountAlias"] = (args ? args.importAccountAlias : undefined) ?? false;
+            resourceInputs["importPasswordPolicy"] = (args ? args.importPasswordPolicy : undefined) ?? false;
             resourceInputs["passwordPolicy"] = args ? args.passwordPolicy : undefined;
+            resourceInputs["passwordPolicyPreset"] = args ? args.passwordPolicyPreset : undefined;
             resourceInputs["arn"] = undefined /*out*/;
             resourceInputs["id"] = undefined /*out*/;
             resourceInputs["passwordPolicyExpirePasswords"] = undefined /*out*/;
@@ -105,12 +104,27 @@ export class Account extends pulumi.ComponentResource {
  */
 export interface AccountArgs {
     /**
-     * AWS IAM account alias for this account.
+     * AWS IAM account alias for this account. If not set, the account alias is not managed.
      */
-    accountAlias: pulumi.Input<string>;
+    accountAlias?: pulumi.Input<string>;
+    /**
+     * Whether to adopt an account alias that already exists with the same name instead of creating it.
+     */
+    importAccountAlias?: pulumi.Input<boolean>;
+    /**
+     * Whether to adopt the password policy that already exists in the account instead of creating it. The
+     * provided settings must match the existing policy for the import to succeed.
+     */
+    importPasswordPolicy?: pulumi.Input<boolean>;
     /**
      * Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords. If
      * left empty the default AWS password policy will be applied.
      */
-    passwordPolicy: pulumi.Input<inputs.AccountPasswordPolicyArgs>;
+    passwordPolicy?: pulumi.Input<inputs.AccountPasswordPolicyArgs>;
+    /**
+     * Name of a predefined password policy to apply. Valid values are `cis`, which follows the CIS AWS Foundations
+     * Benchmark (minimum length of 14, 24 passwords remembered, passwords expire after 90 days). Settings
+     * provided in `passwordPolicy` take precedence over the preset, e.g. `requireSymbols: false`.
+     */
+    passwordPolicyPreset?: pulumi.Input<string>;
 }
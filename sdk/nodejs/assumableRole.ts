@@ -42,8 +42,20 @@ export class AssumableRole extends pulumi.ComponentResource {
         return obj['__pulumiType'] === AssumableRole.__pulumiType;
     }
 
-    public /*out*/ readonly instanceProfile!: pulumi.Output<{[key: string]: string}>;
+    /**
+     * AWS CLI config with a profile per role, in INI format.
+     */
+    public /*out*/ readonly awsConfig!: pulumi.Output<string>;
+    /**
+     * AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+     */
+    public /*out*/ readonly awsVaultConfig!: pulumi.Output<string>;
+    public readonly instanceProfile!: pulumi.Output<{[key: string]: string}>;
     public readonly role!: pulumi.Output<{[key: string]: string}>;
+    /**
+     * Session policy and AWS CLI / SDK snippets assuming the role.
+     */
+    public readonly session!: pulumi.Output<outputs.RoleSessionHelpers>;
 
     /**
      * Create a AssumableRole resource with the given unique name, arguments, and options.
@@ -56,23 +68,34 @@ export class AssumableRole extends pulumi.ComponentResource {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
+            resourceInputs["allowSessionTags"] = (args ? args.allowSessionTags : undefined) ?? false;
             resourceInputs["attachAdminPolicy"] = (args ? args.attachAdminPolicy : undefined) ?? false;
             resourceInputs["attachPoweruserPolicy"] = (args ? args.attachPoweruserPolicy : undefined) ?? false;
             resourceInputs["attachReadonlyPolicy"] = (args ? args.attachReadonlyPolicy : undefined) ?? false;
+            resourceInputs["awsConfigSource"] = args ? args.awsConfigSource : undefined;
+            resourceInputs["createInstanceProfile"] = (args ? args.createInstanceProfile : undefined) ?? true;
             resourceInputs["customRoleTrustPolicy"] = (args ? args.customRoleTrustPolicy : undefined) ?? "";
             resourceInputs["forceDetachPolicies"] = (args ? args.forceDetachPolicies : undefined) ?? false;
+            resourceInputs["instanceProfile"] = args ? args.instanceProfile : undefined;
             resourceInputs["maxSessionDuration"] = (args ? args.maxSessionDuration : undefined) ?? 3600;
             resourceInputs["mfaAge"] = (args ? args.mfaAge : undefined) ?? 86400;
-            resourceInputs["role"] = args ? args.role : undefined;
+            resourceInputs["role"] = args ? (args.role ? pulumi.output(args.role).apply(inputs.roleWithMFAArgsProvideDefaults) : undefined) : undefined;
             resourceInputs["roleStsExternalIds"] = args ? args.roleStsExternalIds : undefined;
+            resourceInputs["session"] = args ? (args.session ? pulumi.output(args.session).apply(inputs.roleSessionArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["sessionTagKeys"] = args ? args.sessionTagKeys : undefined;
             resourceInputs["tags"] = args ? args.tags : undefined;
+            resourceInputs["trustConditions"] = args ? args.trustConditions : undefined;
             resourceInputs["trustedRoleActions"] = args ? args.trustedRoleActions : undefined;
             resourceInputs["trustedRoleArns"] = args ? args.trustedRoleArns : undefined;
             resourceInputs["trustedRoleServices"] = args ? args.trustedRoleServices : undefined;
-            resourceInputs["instanceProfile"] = undefined /*out*/;
+            resourceInputs["awsConfig"] = undefined /*out*/;
+            resourceInputs["awsVaultConfig"] = undefined /*out*/;
         } else {
+            resourceInputs["awsConfig"] = undefined /*out*/;
+            resourceInputs["awsVaultConfig"] = undefined /*out*/;
             resourceInputs["instanceProfile"] = undefined /*out*/;
             resourceInputs["role"] = undefined /*out*/;
+            resourceInputs["session"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         super(AssumableRole.__pulumiType, name, resourceInputs, opts, true /*remote*/);
@@ -83,6 +106,10 @@ export class AssumableRole extends pulumi.ComponentResource {
  * The set of arguments for constructing a AssumableRole resource.
  */
 export interface AssumableRoleArgs {
+    /**
+     * Whether trusted entities are allowed to pass session tags (`sts:TagSession`) when assuming the role.
+     */
+    allowSessionTags?: pulumi.Input<boolean>;
     /**
      * Whether to attach an admin policy to a role.
      */
@@ -95,6 +122,14 @@ export interface AssumableRoleArgs {
      * Whether to attach a readonly policy to a role.
      */
     attachReadonlyPolicy?: pulumi.Input<boolean>;
+    /**
+     * Credentials assuming the roles in the rendered AWS CLI config.
+     */
+    awsConfigSource?: pulumi.Input<inputs.AWSConfigSourceArgs>;
+    /**
+     * Whether to create an IAM instance profile for the role.
+     */
+    createInstanceProfile?: pulumi.Input<boolean>;
     /**
      * A custom role trust policy.
      */
@@ -103,6 +138,10 @@ export interface AssumableRoleArgs {
      * Whether policies should be detached from this role when destroying.
      */
     forceDetachPolicies?: pulumi.Input<boolean>;
+    /**
+     * IAM instance profile created when `createInstanceProfile` is set.
+     */
+    instanceProfile?: pulumi.Input<inputs.InstanceProfileSettingsArgs>;
     /**
      * Maximum CLI/API session duration in seconds between 3600 and 43200.
      */
@@ -116,13 +155,25 @@ export interface AssumableRoleArgs {
      */
     role?: pulumi.Input<inputs.RoleWithMFAArgs>;
     /**
-     * STS ExternalId condition values to use with a role (when MFA is not required).
+     * STS ExternalId condition values to use with a role. Combined with the MFA conditions when MFA is required.
      */
     roleStsExternalIds?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Settings of the session helpers rendered for the role.
+     */
+    session?: pulumi.Input<inputs.RoleSessionArgs>;
+    /**
+     * Tag keys that trusted entities are allowed to pass as session tags.
+     */
+    sessionTagKeys?: pulumi.Input<pulumi.Input<string>[]>;
     /**
      * A map of tags to add.
      */
     tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Additional conditions to add to the role trust policy.
+     */
+    trustConditions?: pulumi.Input<pulumi.Input<inputs.PolicyConditionArgs>[]>;
     /**
      * Actions of STS.
      */
@@ -132,7 +183,7 @@ export interface AssumableRoleArgs {
      */
     trustedRoleArns?: pulumi.Input<pulumi.Input<string>[]>;
     /**
-     * AWS Services that can assume these roles.
+     * AWS Services that can assume these roles. They are trusted without the MFA and ExternalId conditions.
      */
     trustedRoleServices?: pulumi.Input<pulumi.Input<string>[]>;
 }
@@ -56,6 +56,10 @@ export class AssumableRoleWithOIDC extends pulumi.ComponentResource {
      * Path of IAM role.
      */
     public /*out*/ readonly path!: pulumi.Output<string>;
+    /**
+     * Session policy and AWS CLI / SDK snippets assuming the role.
+     */
+    public readonly session!: pulumi.Output<outputs.RoleSessionHelpers>;
     /**
      * Unique ID of IAM role.
      */
@@ -72,6 +76,7 @@ export class AssumableRoleWithOIDC extends pulumi.ComponentResource {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
+            resourceInputs["allowSessionTags"] = (args ? args.allowSessionTags : undefined) ?? false;
             resourceInputs["awsAccountId"] = (args ? args.awsAccountId : undefined) ?? "";
             resourceInputs["forceDetachPolicies"] = (args ? args.forceDetachPolicies : undefined) ?? false;
             resourceInputs["maxSessionDuration"] = (args ? args.maxSessionDuration : undefined) ?? 3600;
@@ -79,7 +84,10 @@ export class AssumableRoleWithOIDC extends pulumi.ComponentResource {
             resourceInputs["oidcFullyQualifiedSubjects"] = args ? args.oidcFullyQualifiedSubjects : undefined;
             resourceInputs["oidcSubjectsWithWildcards"] = args ? args.oidcSubjectsWithWildcards : undefined;
             resourceInputs["providerUrls"] = args ? args.providerUrls : undefined;
-            resourceInputs["role"] = args ? args.role : undefined;
+            resourceInputs["providers"] = args ? args.providers : undefined;
+            resourceInputs["role"] = args ? (args.role ? pulumi.output(args.role).apply(inputs.roleArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["session"] = args ? (args.session ? pulumi.output(args.session).apply(inputs.roleSessionArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["sessionTagKeys"] = args ? args.sessionTagKeys : undefined;
             resourceInputs["tags"] = args ? args.tags : undefined;
             resourceInputs["arn"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
@@ -89,6 +97,7 @@ export class AssumableRoleWithOIDC extends pulumi.ComponentResource {
             resourceInputs["arn"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
             resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["session"] = undefined /*out*/;
             resourceInputs["uniqueId"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
@@ -100,6 +109,10 @@ export class AssumableRoleWithOIDC extends pulumi.ComponentResource {
  * The set of arguments for constructing a AssumableRoleWithOIDC resource.
  */
 export interface AssumableRoleWithOIDCArgs {
+    /**
+     * Whether the OIDC provider is allowed to pass session tags (`sts:TagSession`).
+     */
+    allowSessionTags?: pulumi.Input<boolean>;
     /**
      * The AWS account ID where the OIDC provider lives, leave empty to use the account for the AWS provider.
      */
@@ -128,10 +141,23 @@ export interface AssumableRoleWithOIDCArgs {
      * List of URLs of the OIDC Providers.
      */
     providerUrls?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * OIDC Providers with their own account, subjects and audiences. Used together with `providerUrls`,
+     * all providers become statements of the same trust policy.
+     */
+    providers?: pulumi.Input<pulumi.Input<inputs.AssumableRoleWithOIDCProviderArgs>[]>;
     /**
      * The IAM role.
      */
     role?: pulumi.Input<inputs.RoleArgs>;
+    /**
+     * Settings of the session helpers rendered for the role.
+     */
+    session?: pulumi.Input<inputs.RoleSessionArgs>;
+    /**
+     * Tag keys that the OIDC provider is allowed to pass as session tags.
+     */
+    sessionTagKeys?: pulumi.Input<pulumi.Input<string>[]>;
     /**
      * A map of tags to add.
      */
@@ -72,12 +72,21 @@ export class AssumableRoleWithSAML extends pulumi.ComponentResource {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
+            resourceInputs["allowSessionTags"] = (args ? args.allowSessionTags : undefined) ?? false;
+            resourceInputs["allowSourceIdentity"] = (args ? args.allowSourceIdentity : undefined) ?? false;
             resourceInputs["awsSamlEndpoint"] = (args ? args.awsSamlEndpoint : undefined) ?? "https://signin.aws.amazon.com/saml";
+            resourceInputs["awsSamlEndpoints"] = args ? args.awsSamlEndpoints : undefined;
             resourceInputs["forceDetachPolicies"] = (args ? args.forceDetachPolicies : undefined) ?? false;
             resourceInputs["maxSessionDuration"] = (args ? args.maxSessionDuration : undefined) ?? 3600;
+            resourceInputs["principalTags"] = args ? args.principalTags : undefined;
             resourceInputs["providerIds"] = args ? args.providerIds : undefined;
-            resourceInputs["role"] = args ? args.role : undefined;
+            resourceInputs["role"] = args ? (args.role ? pulumi.output(args.role).apply(inputs.roleArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["samlAttributes"] = args ? args.samlAttributes : undefined;
+            resourceInputs["samlIssuers"] = args ? args.samlIssuers : undefined;
+            resourceInputs["samlSubjects"] = args ? args.samlSubjects : undefined;
+            resourceInputs["sessionTagKeys"] = args ? args.sessionTagKeys : undefined;
             resourceInputs["tags"] = args ? args.tags : undefined;
+            resourceInputs["trustConditions"] = args ? args.trustConditions : undefined;
             resourceInputs["roleArn"] = undefined /*out*/;
             resourceInputs["roleName"] = undefined /*out*/;
             resourceInputs["rolePath"] = undefined /*out*/;
@@ -97,10 +106,23 @@ export class AssumableRoleWithSAML extends pulumi.ComponentResource {
  * The set of arguments for constructing a AssumableRoleWithSAML resource.
  */
 export interface AssumableRoleWithSAMLArgs {
+    /**
+     * Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+     */
+    allowSessionTags?: pulumi.Input<boolean>;
+    /**
+     * Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
+     */
+    allowSourceIdentity?: pulumi.Input<boolean>;
     /**
      * AWS SAML Endpoint.
      */
     awsSamlEndpoint?: pulumi.Input<string>;
+    /**
+     * List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+     * `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
+     */
+    awsSamlEndpoints?: pulumi.Input<pulumi.Input<string>[]>;
     /**
      * Whether policies should be detached from this role when destroying.
      */
@@ -109,13 +131,39 @@ export interface AssumableRoleWithSAMLArgs {
      * Maximum CLI/API session duration in seconds between 3600 and 43200.
      */
     maxSessionDuration?: pulumi.Input<number>;
+    /**
+     * Map of session tag keys passed by the IdP to the values allowed to assume the role, matched as
+     * `aws:RequestTag/<key>`. Implies `allowSessionTags`.
+     */
+    principalTags?: pulumi.Input<{[key: string]: pulumi.Input<pulumi.Input<string>[]>}>;
     /**
      * List of SAML Provider IDs.
      */
     providerIds?: pulumi.Input<pulumi.Input<string>[]>;
     role?: pulumi.Input<inputs.RoleArgs>;
+    /**
+     * Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the role.
+     * Attributes can be multivalued, a match on any of the values is enough.
+     */
+    samlAttributes?: pulumi.Input<{[key: string]: pulumi.Input<pulumi.Input<string>[]>}>;
+    /**
+     * Values of `SAML:iss` allowed to assume the role.
+     */
+    samlIssuers?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Values of `SAML:sub` allowed to assume the role.
+     */
+    samlSubjects?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Tag keys that the IdP is allowed to pass as session tags.
+     */
+    sessionTagKeys?: pulumi.Input<pulumi.Input<string>[]>;
     /**
      * A map of tags to add.
      */
     tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Additional conditions to add to the trust policy.
+     */
+    trustConditions?: pulumi.Input<pulumi.Input<inputs.PolicyConditionArgs>[]>;
 }
@@ -46,6 +46,18 @@ export class AssumableRoles extends pulumi.ComponentResource {
     }
 
     public readonly admin!: pulumi.Output<{[key: string]: string}>;
+    /**
+     * The `mapRoles` entries of the aws-auth ConfigMap granting the roles access to EKS clusters, as YAML.
+     */
+    public /*out*/ readonly awsAuthMapRoles!: pulumi.Output<string>;
+    /**
+     * AWS CLI config with a profile per role, in INI format.
+     */
+    public /*out*/ readonly awsConfig!: pulumi.Output<string>;
+    /**
+     * AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+     */
+    public /*out*/ readonly awsVaultConfig!: pulumi.Output<string>;
     public readonly poweruser!: pulumi.Output<{[key: string]: string} | undefined>;
     public readonly readonly!: pulumi.Output<{[key: string]: string} | undefined>;
 
@@ -63,16 +75,24 @@ export class AssumableRoles extends pulumi.ComponentResource {
             if ((!args || args.admin === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'admin'");
             }
-            resourceInputs["admin"] = args ? args.admin : undefined;
+            resourceInputs["admin"] = args ? (args.admin ? pulumi.output(args.admin).apply(inputs.adminRoleWithMFAArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["awsAuth"] = args ? args.awsAuth : undefined;
+            resourceInputs["awsConfigSource"] = args ? args.awsConfigSource : undefined;
             resourceInputs["forceDetachPolicies"] = (args ? args.forceDetachPolicies : undefined) ?? false;
             resourceInputs["maxSessionDuration"] = (args ? args.maxSessionDuration : undefined) ?? 3600;
             resourceInputs["mfaAge"] = (args ? args.mfaAge : undefined) ?? 86400;
-            resourceInputs["poweruser"] = args ? args.poweruser : undefined;
-            resourceInputs["readonly"] = args ? args.readonly : undefined;
+            resourceInputs["poweruser"] = args ? (args.poweruser ? pulumi.output(args.poweruser).apply(inputs.poweruserRoleWithMFAArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["readonly"] = args ? (args.readonly ? pulumi.output(args.readonly).apply(inputs.readonlyRoleWithMFAArgsProvideDefaults) : undefined) : undefined;
             resourceInputs["trustedRoleArns"] = args ? args.trustedRoleArns : undefined;
             resourceInputs["trustedRoleServices"] = args ? args.trustedRoleServices : undefined;
+            resourceInputs["awsAuthMapRoles"] = undefined /*out*/;
+            resourceInputs["awsConfig"] = undefined /*out*/;
+            resourceInputs["awsVaultConfig"] = undefined /*out*/;
         } else {
             resourceInputs["admin"] = undefined /*out*/;
+            resourceInputs["awsAuthMapRoles"] = undefined /*out*/;
+            resourceInputs["awsConfig"] = undefined /*out*/;
+            resourceInputs["awsVaultConfig"] = undefined /*out*/;
             resourceInputs["poweruser"] = undefined /*out*/;
             resourceInputs["readonly"] = undefined /*out*/;
         }
@@ -86,6 +106,14 @@ export class AssumableRoles extends pulumi.ComponentResource {
  */
 export interface AssumableRolesArgs {
     admin: pulumi.Input<inputs.AdminRoleWithMFAArgs>;
+    /**
+     * Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+     */
+    awsAuth?: pulumi.Input<inputs.AWSAuthArgs>;
+    /**
+     * Credentials assuming the roles in the rendered AWS CLI config.
+     */
+    awsConfigSource?: pulumi.Input<inputs.AWSConfigSourceArgs>;
     /**
      * Whether policies should be detached from this role when destroying.
      */
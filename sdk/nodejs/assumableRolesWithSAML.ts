@@ -43,6 +43,18 @@ export class AssumableRolesWithSAML extends pulumi.ComponentResource {
     }
 
     public readonly admin!: pulumi.Output<{[key: string]: string}>;
+    /**
+     * The `mapRoles` entries of the aws-auth ConfigMap granting the roles access to EKS clusters, as YAML.
+     */
+    public /*out*/ readonly awsAuthMapRoles!: pulumi.Output<string>;
+    /**
+     * AWS CLI config with a profile per role, in INI format.
+     */
+    public /*out*/ readonly awsConfig!: pulumi.Output<string>;
+    /**
+     * AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+     */
+    public /*out*/ readonly awsVaultConfig!: pulumi.Output<string>;
     public readonly poweruser!: pulumi.Output<{[key: string]: string} | undefined>;
     public readonly readonly!: pulumi.Output<{[key: string]: string} | undefined>;
 
@@ -58,14 +70,31 @@ export class AssumableRolesWithSAML extends pulumi.ComponentResource {
         opts = opts || {};
         if (!opts.id) {
             resourceInputs["admin"] = args ? (args.admin ? pulumi.output(args.admin).apply(inputs.adminRoleArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["allowSessionTags"] = (args ? args.allowSessionTags : undefined) ?? false;
+            resourceInputs["allowSourceIdentity"] = (args ? args.allowSourceIdentity : undefined) ?? false;
+            resourceInputs["awsAuth"] = args ? args.awsAuth : undefined;
+            resourceInputs["awsConfigSource"] = args ? args.awsConfigSource : undefined;
             resourceInputs["awsSamlEndpoint"] = (args ? args.awsSamlEndpoint : undefined) ?? "https://signin.aws.amazon.com/saml";
+            resourceInputs["awsSamlEndpoints"] = args ? args.awsSamlEndpoints : undefined;
             resourceInputs["forceDetachPolicies"] = (args ? args.forceDetachPolicies : undefined) ?? false;
             resourceInputs["maxSessionDuration"] = (args ? args.maxSessionDuration : undefined) ?? 3600;
-            resourceInputs["poweruser"] = args ? args.poweruser : undefined;
+            resourceInputs["poweruser"] = args ? (args.poweruser ? pulumi.output(args.poweruser).apply(inputs.poweruserRoleArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["principalTags"] = args ? args.principalTags : undefined;
             resourceInputs["providerIds"] = args ? args.providerIds : undefined;
-            resourceInputs["readonly"] = args ? args.readonly : undefined;
+            resourceInputs["readonly"] = args ? (args.readonly ? pulumi.output(args.readonly).apply(inputs.readonlyRoleArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["samlAttributes"] = args ? args.samlAttributes : undefined;
+            resourceInputs["samlIssuers"] = args ? args.samlIssuers : undefined;
+            resourceInputs["samlSubjects"] = args ? args.samlSubjects : undefined;
+            resourceInputs["sessionTagKeys"] = args ? args.sessionTagKeys : undefined;
+            resourceInputs["trustConditions"] = args ? args.trustConditions : undefined;
+            resourceInputs["awsAuthMapRoles"] = undefined /*out*/;
+            resourceInputs["awsConfig"] = undefined /*out*/;
+            resourceInputs["awsVaultConfig"] = undefined /*out*/;
         } else {
             resourceInputs["admin"] = undefined /*out*/;
+            resourceInputs["awsAuthMapRoles"] = undefined /*out*/;
+            resourceInputs["awsConfig"] = undefined /*out*/;
+            resourceInputs["awsVaultConfig"] = undefined /*out*/;
             resourceInputs["poweruser"] = undefined /*out*/;
             resourceInputs["readonly"] = undefined /*out*/;
         }
@@ -79,10 +108,32 @@ export class AssumableRolesWithSAML extends pulumi.ComponentResource {
  */
 export interface AssumableRolesWithSAMLArgs {
     admin?: pulumi.Input<inputs.AdminRoleArgs>;
+    /**
+     * Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+     */
+    allowSessionTags?: pulumi.Input<boolean>;
+    /**
+     * Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
+     */
+    allowSourceIdentity?: pulumi.Input<boolean>;
+    /**
+     * Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+     */
+    awsAuth?: pulumi.Input<inputs.AWSAuthArgs>;
+    /**
+     * Credentials assuming the roles in the rendered AWS CLI config. Only a `credentialProcess` with a `{role_arn}`
+     * placeholder can sign in to the roles, the AWS CLI config is empty without it.
+     */
+    awsConfigSource?: pulumi.Input<inputs.AWSConfigSourceArgs>;
     /**
      * AWS SAML Endpoint.
      */
     awsSamlEndpoint?: pulumi.Input<string>;
+    /**
+     * List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+     * `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
+     */
+    awsSamlEndpoints?: pulumi.Input<pulumi.Input<string>[]>;
     /**
      * Whether policies should be detached from this role when destroying.
      */
@@ -92,9 +143,35 @@ export interface AssumableRolesWithSAMLArgs {
      */
     maxSessionDuration?: pulumi.Input<number>;
     poweruser?: pulumi.Input<inputs.PoweruserRoleArgs>;
+    /**
+     * Map of session tag keys passed by the IdP to the values allowed to assume the roles, matched as
+     * `aws:RequestTag/<key>`. Implies `allowSessionTags`.
+     */
+    principalTags?: pulumi.Input<{[key: string]: pulumi.Input<pulumi.Input<string>[]>}>;
     /**
      * List of SAML Provider IDs.
      */
     providerIds?: pulumi.Input<pulumi.Input<string>[]>;
     readonly?: pulumi.Input<inputs.ReadonlyRoleArgs>;
+    /**
+     * Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the roles.
+     * Attributes can be multivalued, a match on any of the values is enough.
+     */
+    samlAttributes?: pulumi.Input<{[key: string]: pulumi.Input<pulumi.Input<string>[]>}>;
+    /**
+     * Values of `SAML:iss` allowed to assume the roles.
+     */
+    samlIssuers?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Values of `SAML:sub` allowed to assume the roles.
+     */
+    samlSubjects?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Tag keys that the IdP is allowed to pass as session tags.
+     */
+    sessionTagKeys?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Additional conditions to add to the trust policy.
+     */
+    trustConditions?: pulumi.Input<pulumi.Input<inputs.PolicyConditionArgs>[]>;
 }
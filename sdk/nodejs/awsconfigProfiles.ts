@@ -0,0 +1,102 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * This resource renders an AWS CLI config with a profile per IAM role, e.g. the roles of `AssumableRoles` or
+ * `AssumableRolesWithSAML`, ready to be published to engineers. The roles are assumed with the credentials
+ * of a source profile, a credential process or an IAM Identity Center session. A second variant of the
+ * config uses the legacy IAM Identity Center settings supported by granted and aws-vault.
+ *
+ * ## Example Usage
+ * ## AWS Config Profiles
+ *
+ * ```typescript
+ * import * as iam from "@pulumi/aws-iam";
+ *
+ * export const profiles = new iam.AWSConfigProfiles("aws-iam-example-aws-config-profiles", {
+ *     source: {
+ *         profilePrefix: "acme-",
+ *         sourceProfile: "acme-identity",
+ *         mfaSerial: "arn:aws:iam::111111111111:mfa/jane",
+ *     },
+ *     roles: [
+ *         {
+ *             profileName: "admin",
+ *             roleArn: "arn:aws:iam::222222222222:role/admin",
+ *             requiresMfa: true,
+ *             durationSeconds: 3600,
+ *         },
+ *     ],
+ * });
+ * ```
+ * {{ /example }}
+ */
+export class AWSConfigProfiles extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'aws-iam:index:AWSConfigProfiles';
+
+    /**
+     * Returns true if the given object is an instance of AWSConfigProfiles.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is AWSConfigProfiles {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === AWSConfigProfiles.__pulumiType;
+    }
+
+    /**
+     * AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+     */
+    public /*out*/ readonly awsVaultConfig!: pulumi.Output<string>;
+    /**
+     * AWS CLI config with a profile per role, in INI format.
+     */
+    public /*out*/ readonly config!: pulumi.Output<string>;
+
+    /**
+     * Create a AWSConfigProfiles resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: AWSConfigProfilesArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.roles === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'roles'");
+            }
+            resourceInputs["roles"] = args ? args.roles : undefined;
+            resourceInputs["source"] = args ? args.source : undefined;
+            resourceInputs["awsVaultConfig"] = undefined /*out*/;
+            resourceInputs["config"] = undefined /*out*/;
+        } else {
+            resourceInputs["awsVaultConfig"] = undefined /*out*/;
+            resourceInputs["config"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(AWSConfigProfiles.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a AWSConfigProfiles resource.
+ */
+export interface AWSConfigProfilesArgs {
+    /**
+     * Roles to render a profile for.
+     */
+    roles: pulumi.Input<pulumi.Input<inputs.AWSConfigRoleArgs>[]>;
+    /**
+     * Credentials assuming the roles.
+     */
+    source?: pulumi.Input<inputs.AWSConfigSourceArgs>;
+}
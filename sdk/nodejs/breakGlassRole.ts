@@ -0,0 +1,147 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * This resource helps you create a break-glass role for emergency access. The role has AdministratorAccess,
+ * can only be assumed by the trusted principals with a recent MFA sign-in and optionally from specific source
+ * IPs, and is tagged with `BreakGlass: true`. An EventBridge rule sends an alert to an SNS topic whenever
+ * the role is assumed, so its usage is always noticed.
+ *
+ * The rule matches the AssumeRole events CloudTrail delivers to EventBridge in the region the resource is
+ * deployed to. AssumeRole calls made to the global STS endpoint, including console role switches, are
+ * delivered in us-east-1. Outside of us-east-1 a second rule forwards them from us-east-1 to the default
+ * event bus of the region. It is created with the `aws` provider for us-east-1 passed as
+ * `globalEventsProvider`, which is required outside of us-east-1.
+ *
+ * ## Example Usage
+ * ## Break Glass Role
+ *
+ * ```typescript
+ * import * as iam from "@pulumi/aws-iam";
+ *
+ * export const breakGlassRole = new iam.BreakGlassRole("aws-iam-example-break-glass-role", {
+ *     trustedRoleArns: [ "arn:aws:iam::111111111111:user/oncall" ],
+ *     sourceIps: [ "203.0.113.0/24" ],
+ *     alertEmails: [ "security@example.com" ],
+ * });
+ * ```
+ * {{ /example }}
+ */
+export class BreakGlassRole extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'aws-iam:index:BreakGlassRole';
+
+    /**
+     * Returns true if the given object is an instance of BreakGlassRole.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is BreakGlassRole {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === BreakGlassRole.__pulumiType;
+    }
+
+    /**
+     * ARN of the SNS topic receiving the alerts.
+     */
+    public readonly alertTopicArn!: pulumi.Output<string>;
+    /**
+     * EventBridge event pattern matching AssumeRole calls into the role.
+     */
+    public /*out*/ readonly eventPattern!: pulumi.Output<string>;
+    /**
+     * ARN of the EventBridge rule alerting on AssumeRole calls into the role. Empty when only the event pattern is output.
+     * Outside of us-east-1 a second rule forwards the calls to the global STS endpoint from us-east-1 to this rule.
+     */
+    public /*out*/ readonly eventRuleArn!: pulumi.Output<string>;
+    public readonly role!: pulumi.Output<{[key: string]: string}>;
+
+    /**
+     * Create a BreakGlassRole resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: BreakGlassRoleArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.trustedRoleArns === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'trustedRoleArns'");
+            }
+            resourceInputs["alertEmails"] = args ? args.alertEmails : undefined;
+            resourceInputs["alertTopicArn"] = args ? args.alertTopicArn : undefined;
+            resourceInputs["eventPatternOnly"] = (args ? args.eventPatternOnly : undefined) ?? false;
+            resourceInputs["globalEventsProvider"] = args ? args.globalEventsProvider : undefined;
+            resourceInputs["maxSessionDuration"] = (args ? args.maxSessionDuration : undefined) ?? 3600;
+            resourceInputs["mfaAge"] = (args ? args.mfaAge : undefined) ?? 900;
+            resourceInputs["role"] = args ? (args.role ? pulumi.output(args.role).apply(inputs.roleArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["sourceIps"] = args ? args.sourceIps : undefined;
+            resourceInputs["tags"] = args ? args.tags : undefined;
+            resourceInputs["trustedRoleArns"] = args ? args.trustedRoleArns : undefined;
+            resourceInputs["eventPattern"] = undefined /*out*/;
+            resourceInputs["eventRuleArn"] = undefined /*out*/;
+        } else {
+            resourceInputs["alertTopicArn"] = undefined /*out*/;
+            resourceInputs["eventPattern"] = undefined /*out*/;
+            resourceInputs["eventRuleArn"] = undefined /*out*/;
+            resourceInputs["role"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(BreakGlassRole.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a BreakGlassRole resource.
+ */
+export interface BreakGlassRoleArgs {
+    /**
+     * Email addresses subscribed to the alert topic.
+     */
+    alertEmails?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * ARN of an existing SNS topic to send alerts to. A topic is created when not set.
+     */
+    alertTopicArn?: pulumi.Input<string>;
+    /**
+     * Whether to only output the EventBridge event pattern instead of creating the rule and SNS topic.
+     */
+    eventPatternOnly?: pulumi.Input<boolean>;
+    /**
+     * Explicit `aws` provider for us-east-1, used to forward the calls to the global STS endpoint to the
+     * region of the role. Required outside of us-east-1, unless only the event pattern is output.
+     */
+    globalEventsProvider?: any;
+    /**
+     * Maximum CLI/API session duration in seconds between 3600 and 43200.
+     */
+    maxSessionDuration?: pulumi.Input<number>;
+    /**
+     * Max age of valid MFA (in seconds) when assuming the role.
+     */
+    mfaAge?: pulumi.Input<number>;
+    /**
+     * The IAM role. Its name defaults to `break-glass`.
+     */
+    role?: pulumi.Input<inputs.RoleArgs>;
+    /**
+     * Source IP ranges the role can be assumed from. Any source IP is allowed when empty.
+     */
+    sourceIps?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * A map of tags to add. The role is also tagged with `BreakGlass` set to `true`.
+     */
+    tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * ARNs of the principals allowed to assume the role.
+     */
+    trustedRoleArns: pulumi.Input<pulumi.Input<string>[]>;
+}
@@ -0,0 +1,137 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * This resource helps you create the IAM policy of a single EKS add-on, the same policy `RoleForServiceAccountsEks`
+ * attaches for it, without creating a role. The policy can then be attached to node roles, EKS Pod Identity
+ * roles or roles managed elsewhere.
+ *
+ * ## Example Usage
+ * ## EKS Add-on Policy
+ *
+ * ```typescript
+ * import * as iam from "@pulumi/aws-iam";
+ *
+ * export const veleroPolicy = new iam.EKSAddonPolicy("aws-iam-example-velero-policy", {
+ *     addon: "velero",
+ *     policies: {
+ *         velero: {
+ *             s3BucketArns: [ "arn:aws:s3:::velero-backups" ],
+ *         },
+ *     },
+ * });
+ * ```
+ * {{ /example }}
+ */
+export class EKSAddonPolicy extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'aws-iam:index:EKSAddonPolicy';
+
+    /**
+     * Returns true if the given object is an instance of EKSAddonPolicy.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is EKSAddonPolicy {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === EKSAddonPolicy.__pulumiType;
+    }
+
+    /**
+     * The ARN assigned by AWS to this policy.
+     */
+    public /*out*/ readonly arn!: pulumi.Output<string>;
+    /**
+     * The description of the policy.
+     */
+    public /*out*/ readonly description!: pulumi.Output<string>;
+    /**
+     * The policy's ID.
+     */
+    public /*out*/ readonly id!: pulumi.Output<string>;
+    /**
+     * The name of the policy.
+     */
+    public /*out*/ readonly name!: pulumi.Output<string>;
+    /**
+     * The path of the policy in IAM.
+     */
+    public readonly path!: pulumi.Output<string>;
+    /**
+     * Policy document as json.
+     */
+    public /*out*/ readonly policyJson!: pulumi.Output<string>;
+
+    /**
+     * Create a EKSAddonPolicy resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: EKSAddonPolicyArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.addon === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'addon'");
+            }
+            if ((!args || args.policies === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'policies'");
+            }
+            resourceInputs["addon"] = args ? args.addon : undefined;
+            resourceInputs["path"] = (args ? args.path : undefined) ?? "/";
+            resourceInputs["policies"] = args ? (args.policies ? pulumi.output(args.policies).apply(inputs.eksrolePoliciesArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["policyNamePrefix"] = (args ? args.policyNamePrefix : undefined) ?? "AmazonEKS_";
+            resourceInputs["tags"] = args ? args.tags : undefined;
+            resourceInputs["arn"] = undefined /*out*/;
+            resourceInputs["description"] = undefined /*out*/;
+            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["policyJson"] = undefined /*out*/;
+        } else {
+            resourceInputs["arn"] = undefined /*out*/;
+            resourceInputs["description"] = undefined /*out*/;
+            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["policyJson"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(EKSAddonPolicy.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a EKSAddonPolicy resource.
+ */
+export interface EKSAddonPolicyArgs {
+    /**
+     * Name of the add-on to create the policy for, matching its key in `policies`, e.g. `velero` or
+     * `karpenterController`.
+     */
+    addon: pulumi.Input<string>;
+    /**
+     * The path of the policy in IAM.
+     */
+    path?: pulumi.Input<string>;
+    /**
+     * Arguments of the add-ons. Only the arguments of the selected add-on are used, its `attach` flag is
+     * implied. Add-ons with several policies, e.g. `loadBalancer`, must select exactly one of them.
+     */
+    policies: pulumi.Input<inputs.EKSRolePoliciesArgs>;
+    /**
+     * IAM policy name prefix.
+     */
+    policyNamePrefix?: pulumi.Input<string>;
+    /**
+     * A map of tags to add.
+     */
+    tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
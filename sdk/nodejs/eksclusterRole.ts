@@ -0,0 +1,119 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * This resource helps you create the IAM role an EKS cluster uses to manage AWS resources on your behalf.
+ * The role trusts `eks.amazonaws.com` and has the `AmazonEKSClusterPolicy` attached, optionally together
+ * with the `AmazonEKSVPCResourceController` policy and the policies needed by EKS Auto Mode.
+ *
+ * ## Example Usage
+ * ## EKS Cluster Role
+ *
+ * ```typescript
+ * import * as iam from "@pulumi/aws-iam";
+ *
+ * export const clusterRole = new iam.EKSClusterRole("aws-iam-example-eks-cluster-role", {
+ *     role: {
+ *         name: "eks-cluster",
+ *     },
+ *     attachVpcResourceControllerPolicy: true,
+ *     enableAutoMode: true,
+ * });
+ * ```
+ * {{ /example }}
+ */
+export class EKSClusterRole extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'aws-iam:index:EKSClusterRole';
+
+    /**
+     * Returns true if the given object is an instance of EKSClusterRole.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is EKSClusterRole {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === EKSClusterRole.__pulumiType;
+    }
+
+    /**
+     * ARN of IAM role.
+     */
+    public /*out*/ readonly arn!: pulumi.Output<string>;
+    /**
+     * Name of IAM role.
+     */
+    public /*out*/ readonly name!: pulumi.Output<string>;
+    /**
+     * Path of IAM role.
+     */
+    public /*out*/ readonly path!: pulumi.Output<string>;
+    /**
+     * Unique ID of IAM role.
+     */
+    public /*out*/ readonly uniqueId!: pulumi.Output<string>;
+
+    /**
+     * Create a EKSClusterRole resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: EKSClusterRoleArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            resourceInputs["attachVpcResourceControllerPolicy"] = (args ? args.attachVpcResourceControllerPolicy : undefined) ?? false;
+            resourceInputs["enableAutoMode"] = (args ? args.enableAutoMode : undefined) ?? false;
+            resourceInputs["forceDetachPolicies"] = (args ? args.forceDetachPolicies : undefined) ?? false;
+            resourceInputs["maxSessionDuration"] = (args ? args.maxSessionDuration : undefined) ?? 3600;
+            resourceInputs["role"] = args ? (args.role ? pulumi.output(args.role).apply(inputs.roleArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["tags"] = args ? args.tags : undefined;
+            resourceInputs["arn"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["uniqueId"] = undefined /*out*/;
+        } else {
+            resourceInputs["arn"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["uniqueId"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(EKSClusterRole.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a EKSClusterRole resource.
+ */
+export interface EKSClusterRoleArgs {
+    /**
+     * Whether to attach the AmazonEKSVPCResourceController policy, needed for security groups for pods.
+     */
+    attachVpcResourceControllerPolicy?: pulumi.Input<boolean>;
+    /**
+     * Whether to attach the policies needed by EKS Auto Mode and allow EKS to tag its sessions.
+     */
+    enableAutoMode?: pulumi.Input<boolean>;
+    /**
+     * Whether policies should be detached from this role when destroying.
+     */
+    forceDetachPolicies?: pulumi.Input<boolean>;
+    /**
+     * Maximum CLI/API session duration in seconds between 3600 and 43200.
+     */
+    maxSessionDuration?: pulumi.Input<number>;
+    role?: pulumi.Input<inputs.RoleArgs>;
+    /**
+     * A map of tags to add.
+     */
+    tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
@@ -0,0 +1,138 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * This resource helps you create the IAM role of EKS nodes. Depending on `type` the role is for:
+ *
+ * - `node`: managed and self-managed node groups. The role trusts EC2, has the worker node, CNI and ECR
+ *   read only policies attached and gets an instance profile.
+ * - `karpenter`: nodes launched by Karpenter. Same as `node` plus the SSM policy, without an instance
+ *   profile since Karpenter creates those itself.
+ * - `fargate`: the pod execution role of Fargate profiles, optionally restricted to the profiles of one cluster.
+ *
+ * ## Example Usage
+ * ## EKS Node Role
+ *
+ * ```typescript
+ * import * as iam from "@pulumi/aws-iam";
+ *
+ * export const nodeRole = new iam.EKSNodeRole("aws-iam-example-eks-node-role", {
+ *     role: {
+ *         name: "eks-node",
+ *     },
+ *     attachSsmPolicy: true,
+ * });
+ * ```
+ * {{ /example }}
+ */
+export class EKSNodeRole extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'aws-iam:index:EKSNodeRole';
+
+    /**
+     * Returns true if the given object is an instance of EKSNodeRole.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is EKSNodeRole {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === EKSNodeRole.__pulumiType;
+    }
+
+    /**
+     * ARN of IAM role.
+     */
+    public /*out*/ readonly arn!: pulumi.Output<string>;
+    /**
+     * IAM instance profile, only created for `node` roles.
+     */
+    public /*out*/ readonly instanceProfile!: pulumi.Output<{[key: string]: string}>;
+    /**
+     * Name of IAM role.
+     */
+    public /*out*/ readonly name!: pulumi.Output<string>;
+    /**
+     * Path of IAM role.
+     */
+    public /*out*/ readonly path!: pulumi.Output<string>;
+    /**
+     * Unique ID of IAM role.
+     */
+    public /*out*/ readonly uniqueId!: pulumi.Output<string>;
+
+    /**
+     * Create a EKSNodeRole resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: EKSNodeRoleArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            resourceInputs["attachSsmPolicy"] = (args ? args.attachSsmPolicy : undefined) ?? false;
+            resourceInputs["clusterName"] = args ? args.clusterName : undefined;
+            resourceInputs["excludeCniPolicy"] = (args ? args.excludeCniPolicy : undefined) ?? false;
+            resourceInputs["forceDetachPolicies"] = (args ? args.forceDetachPolicies : undefined) ?? false;
+            resourceInputs["maxSessionDuration"] = (args ? args.maxSessionDuration : undefined) ?? 3600;
+            resourceInputs["role"] = args ? (args.role ? pulumi.output(args.role).apply(inputs.roleArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["tags"] = args ? args.tags : undefined;
+            resourceInputs["type"] = (args ? args.type : undefined) ?? "node";
+            resourceInputs["arn"] = undefined /*out*/;
+            resourceInputs["instanceProfile"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["uniqueId"] = undefined /*out*/;
+        } else {
+            resourceInputs["arn"] = undefined /*out*/;
+            resourceInputs["instanceProfile"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["uniqueId"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(EKSNodeRole.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a EKSNodeRole resource.
+ */
+export interface EKSNodeRoleArgs {
+    /**
+     * Whether to attach the AmazonSSMManagedInstanceCore policy. Always attached for `karpenter` roles.
+     */
+    attachSsmPolicy?: pulumi.Input<boolean>;
+    /**
+     * Name of the cluster whose Fargate profiles can assume a `fargate` role. Defaults to all clusters of the account.
+     */
+    clusterName?: pulumi.Input<string>;
+    /**
+     * Whether to leave out the AmazonEKS_CNI_Policy, e.g. when the VPC CNI uses its own IRSA role.
+     */
+    excludeCniPolicy?: pulumi.Input<boolean>;
+    /**
+     * Whether policies should be detached from this role when destroying.
+     */
+    forceDetachPolicies?: pulumi.Input<boolean>;
+    /**
+     * Maximum CLI/API session duration in seconds between 3600 and 43200.
+     */
+    maxSessionDuration?: pulumi.Input<number>;
+    role?: pulumi.Input<inputs.RoleArgs>;
+    /**
+     * A map of tags to add.
+     */
+    tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Type of the role, one of `node`, `karpenter` or `fargate`.
+     */
+    type?: pulumi.Input<string>;
+}
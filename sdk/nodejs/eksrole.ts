@@ -73,6 +73,10 @@ export class EKSRole extends pulumi.ComponentResource {
      * Path of IAM role.
      */
     public /*out*/ readonly path!: pulumi.Output<string>;
+    /**
+     * Annotations and ServiceAccount manifests binding each ServiceAccount to the role.
+     */
+    public /*out*/ readonly serviceAccounts!: pulumi.Output<outputs.EKSServiceAccountManifest[]>;
     /**
      * Unique ID of IAM role.
      */
@@ -89,21 +93,25 @@ export class EKSRole extends pulumi.ComponentResource {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
+            resourceInputs["allowAllNamespaces"] = (args ? args.allowAllNamespaces : undefined) ?? false;
             resourceInputs["clusterServiceAccounts"] = args ? args.clusterServiceAccounts : undefined;
             resourceInputs["forceDetachPolicies"] = (args ? args.forceDetachPolicies : undefined) ?? false;
             resourceInputs["maxSessionDuration"] = (args ? args.maxSessionDuration : undefined) ?? 3600;
             resourceInputs["providerUrlSaPairs"] = args ? args.providerUrlSaPairs : undefined;
-            resourceInputs["role"] = args ? args.role : undefined;
+            resourceInputs["role"] = args ? (args.role ? pulumi.output(args.role).apply(inputs.roleArgsProvideDefaults) : undefined) : undefined;
             resourceInputs["rolePolicyArns"] = args ? args.rolePolicyArns : undefined;
+            resourceInputs["serviceAccountManifest"] = args ? (args.serviceAccountManifest ? pulumi.output(args.serviceAccountManifest).apply(inputs.eksserviceAccountManifestOptionsArgsProvideDefaults) : undefined) : undefined;
             resourceInputs["tags"] = args ? args.tags : undefined;
             resourceInputs["arn"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
             resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["serviceAccounts"] = undefined /*out*/;
             resourceInputs["uniqueId"] = undefined /*out*/;
         } else {
             resourceInputs["arn"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
             resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["serviceAccounts"] = undefined /*out*/;
             resourceInputs["uniqueId"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
@@ -115,6 +123,10 @@ export class EKSRole extends pulumi.ComponentResource {
  * The set of arguments for constructing a EKSRole resource.
  */
 export interface EKSRoleArgs {
+    /**
+     * Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+     */
+    allowAllNamespaces?: pulumi.Input<boolean>;
     /**
      * EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount. See README for details
      */
@@ -136,6 +148,10 @@ export interface EKSRoleArgs {
      * ARNs of any policies to attach to the IAM role.
      */
     rolePolicyArns?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Annotations added to the rendered ServiceAccount manifests.
+     */
+    serviceAccountManifest?: pulumi.Input<inputs.EKSServiceAccountManifestOptionsArgs>;
     /**
      * A map of tags to add.
      */
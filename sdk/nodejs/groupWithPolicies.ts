@@ -80,6 +80,7 @@ export class GroupWithPolicies extends pulumi.ComponentResource {
             resourceInputs["awsAccountId"] = (args ? args.awsAccountId : undefined) ?? "";
             resourceInputs["customGroupPolicies"] = args ? args.customGroupPolicies : undefined;
             resourceInputs["customGroupPolicyArns"] = args ? args.customGroupPolicyArns : undefined;
+            resourceInputs["exclusivePolicyAttachments"] = (args ? args.exclusivePolicyAttachments : undefined) ?? false;
             resourceInputs["groupUsers"] = args ? args.groupUsers : undefined;
             resourceInputs["iamSelfManagementPolicyNamePrefix"] = (args ? args.iamSelfManagementPolicyNamePrefix : undefined) ?? "IAMSelfManagement-";
             resourceInputs["name"] = (args ? args.name : undefined) ?? "";
@@ -117,6 +118,11 @@ export interface GroupWithPoliciesArgs {
      * List of IAM policies ARNs to attach to IAM group.
      */
     customGroupPolicyArns?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Whether managed policies not declared for the group are detached from it. Not supported for groups
+     * by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
+     */
+    exclusivePolicyAttachments?: pulumi.Input<boolean>;
     /**
      * List of IAM users to have in an IAM group which can assume the role.
      */
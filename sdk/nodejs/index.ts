@@ -5,6 +5,11 @@ import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
 // Export members:
+export { AbacPolicyArgs } from "./abacPolicy";
+export type AbacPolicy = import("./abacPolicy").AbacPolicy;
+export const AbacPolicy: typeof import("./abacPolicy").AbacPolicy = null as any;
+utilities.lazyLoad(exports, ["AbacPolicy"], () => require("./abacPolicy"));
+
 export { AccountArgs } from "./account";
 export type Account = import("./account").Account;
 export const Account: typeof import("./account").Account = null as any;
@@ -35,6 +40,31 @@ export type AssumableRolesWithSAML = import("./assumableRolesWithSAML").Assumabl
 export const AssumableRolesWithSAML: typeof import("./assumableRolesWithSAML").AssumableRolesWithSAML = null as any;
 utilities.lazyLoad(exports, ["AssumableRolesWithSAML"], () => require("./assumableRolesWithSAML"));
 
+export { AWSConfigProfilesArgs } from "./awsconfigProfiles";
+export type AWSConfigProfiles = import("./awsconfigProfiles").AWSConfigProfiles;
+export const AWSConfigProfiles: typeof import("./awsconfigProfiles").AWSConfigProfiles = null as any;
+utilities.lazyLoad(exports, ["AWSConfigProfiles"], () => require("./awsconfigProfiles"));
+
+export { BreakGlassRoleArgs } from "./breakGlassRole";
+export type BreakGlassRole = import("./breakGlassRole").BreakGlassRole;
+export const BreakGlassRole: typeof import("./breakGlassRole").BreakGlassRole = null as any;
+utilities.lazyLoad(exports, ["BreakGlassRole"], () => require("./breakGlassRole"));
+
+export { EKSAddonPolicyArgs } from "./eksaddonPolicy";
+export type EKSAddonPolicy = import("./eksaddonPolicy").EKSAddonPolicy;
+export const EKSAddonPolicy: typeof import("./eksaddonPolicy").EKSAddonPolicy = null as any;
+utilities.lazyLoad(exports, ["EKSAddonPolicy"], () => require("./eksaddonPolicy"));
+
+export { EKSClusterRoleArgs } from "./eksclusterRole";
+export type EKSClusterRole = import("./eksclusterRole").EKSClusterRole;
+export const EKSClusterRole: typeof import("./eksclusterRole").EKSClusterRole = null as any;
+utilities.lazyLoad(exports, ["EKSClusterRole"], () => require("./eksclusterRole"));
+
+export { EKSNodeRoleArgs } from "./eksnodeRole";
+export type EKSNodeRole = import("./eksnodeRole").EKSNodeRole;
+export const EKSNodeRole: typeof import("./eksnodeRole").EKSNodeRole = null as any;
+utilities.lazyLoad(exports, ["EKSNodeRole"], () => require("./eksnodeRole"));
+
 export { EKSRoleArgs } from "./eksrole";
 export type EKSRole = import("./eksrole").EKSRole;
 export const EKSRole: typeof import("./eksrole").EKSRole = null as any;
@@ -50,6 +80,11 @@ export type GroupWithPolicies = import("./groupWithPolicies").GroupWithPolicies;
 export const GroupWithPolicies: typeof import("./groupWithPolicies").GroupWithPolicies = null as any;
 utilities.lazyLoad(exports, ["GroupWithPolicies"], () => require("./groupWithPolicies"));
 
+export { InstanceProfileArgs } from "./instanceProfile";
+export type InstanceProfile = import("./instanceProfile").InstanceProfile;
+export const InstanceProfile: typeof import("./instanceProfile").InstanceProfile = null as any;
+utilities.lazyLoad(exports, ["InstanceProfile"], () => require("./instanceProfile"));
+
 export { PolicyArgs } from "./policy";
 export type Policy = import("./policy").Policy;
 export const Policy: typeof import("./policy").Policy = null as any;
@@ -70,6 +105,11 @@ export type RoleForServiceAccountsEks = import("./roleForServiceAccountsEks").Ro
 export const RoleForServiceAccountsEks: typeof import("./roleForServiceAccountsEks").RoleForServiceAccountsEks = null as any;
 utilities.lazyLoad(exports, ["RoleForServiceAccountsEks"], () => require("./roleForServiceAccountsEks"));
 
+export { SAMLProviderArgs } from "./samlprovider";
+export type SAMLProvider = import("./samlprovider").SAMLProvider;
+export const SAMLProvider: typeof import("./samlprovider").SAMLProvider = null as any;
+utilities.lazyLoad(exports, ["SAMLProvider"], () => require("./samlprovider"));
+
 export { UserArgs } from "./user";
 export type User = import("./user").User;
 export const User: typeof import("./user").User = null as any;
@@ -87,6 +127,10 @@ const _module = {
     version: utilities.getVersion(),
     construct: (name: string, type: string, urn: string): pulumi.Resource => {
         switch (type) {
+            case "aws-iam:index:AWSConfigProfiles":
+                return new AWSConfigProfiles(name, <any>undefined, { urn })
+            case "aws-iam:index:AbacPolicy":
+                return new AbacPolicy(name, <any>undefined, { urn })
             case "aws-iam:index:Account":
                 return new Account(name, <any>undefined, { urn })
             case "aws-iam:index:AssumableRole":
@@ -99,18 +143,30 @@ const _module = {
                 return new AssumableRoles(name, <any>undefined, { urn })
             case "aws-iam:index:AssumableRolesWithSAML":
                 return new AssumableRolesWithSAML(name, <any>undefined, { urn })
+            case "aws-iam:index:BreakGlassRole":
+                return new BreakGlassRole(name, <any>undefined, { urn })
+            case "aws-iam:index:EKSAddonPolicy":
+                return new EKSAddonPolicy(name, <any>undefined, { urn })
+            case "aws-iam:index:EKSClusterRole":
+                return new EKSClusterRole(name, <any>undefined, { urn })
+            case "aws-iam:index:EKSNodeRole":
+                return new EKSNodeRole(name, <any>undefined, { urn })
             case "aws-iam:index:EKSRole":
                 return new EKSRole(name, <any>undefined, { urn })
             case "aws-iam:index:GroupWithAssumableRolesPolicy":
                 return new GroupWithAssumableRolesPolicy(name, <any>undefined, { urn })
             case "aws-iam:index:GroupWithPolicies":
                 return new GroupWithPolicies(name, <any>undefined, { urn })
+            case "aws-iam:index:InstanceProfile":
+                return new InstanceProfile(name, <any>undefined, { urn })
             case "aws-iam:index:Policy":
                 return new Policy(name, <any>undefined, { urn })
             case "aws-iam:index:ReadOnlyPolicy":
                 return new ReadOnlyPolicy(name, <any>undefined, { urn })
             case "aws-iam:index:RoleForServiceAccountsEks":
                 return new RoleForServiceAccountsEks(name, <any>undefined, { urn })
+            case "aws-iam:index:SAMLProvider":
+                return new SAMLProvider(name, <any>undefined, { urn })
             case "aws-iam:index:User":
                 return new User(name, <any>undefined, { urn })
             default:
@@ -0,0 +1,96 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * This resource helps you create an IAM instance profile for an existing IAM role, e.g. a role created by
+ * `AssumableRole` with `createInstanceProfile` turned off or by `EKSNodeRole`. The role can be given by
+ * name or ARN.
+ *
+ * ## Example Usage
+ * ## Instance Profile
+ *
+ * ```typescript
+ * import * as iam from "@pulumi/aws-iam";
+ *
+ * export const instanceProfile = new iam.InstanceProfile("aws-iam-example-instance-profile", {
+ *     role: "arn:aws:iam::111111111111:role/app/web",
+ *     name: "web",
+ *     path: "/app/",
+ * });
+ * ```
+ * {{ /example }}
+ */
+export class InstanceProfile extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'aws-iam:index:InstanceProfile';
+
+    /**
+     * Returns true if the given object is an instance of InstanceProfile.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is InstanceProfile {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === InstanceProfile.__pulumiType;
+    }
+
+    public /*out*/ readonly instanceProfile!: pulumi.Output<{[key: string]: string}>;
+    /**
+     * Name of the IAM role in the instance profile.
+     */
+    public /*out*/ readonly roleName!: pulumi.Output<string>;
+
+    /**
+     * Create a InstanceProfile resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: InstanceProfileArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.role === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'role'");
+            }
+            resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["path"] = (args ? args.path : undefined) ?? "/";
+            resourceInputs["role"] = args ? args.role : undefined;
+            resourceInputs["tags"] = args ? args.tags : undefined;
+            resourceInputs["instanceProfile"] = undefined /*out*/;
+            resourceInputs["roleName"] = undefined /*out*/;
+        } else {
+            resourceInputs["instanceProfile"] = undefined /*out*/;
+            resourceInputs["roleName"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(InstanceProfile.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a InstanceProfile resource.
+ */
+export interface InstanceProfileArgs {
+    /**
+     * Name of IAM instance profile.
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * Path of IAM instance profile.
+     */
+    path?: pulumi.Input<string>;
+    /**
+     * Name or ARN of the IAM role to add to the instance profile.
+     */
+    role: pulumi.Input<string>;
+    /**
+     * A map of tags to add.
+     */
+    tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
@@ -43,6 +43,10 @@ export class ReadOnlyPolicy extends pulumi.ComponentResource {
      * The ARN assigned by AWS to this policy.
      */
     public /*out*/ readonly arn!: pulumi.Output<string>;
+    /**
+     * Version of the action catalog used to expand actions when `metadataOnly` is set.
+     */
+    public /*out*/ readonly catalogVersion!: pulumi.Output<string>;
     /**
      * The description of the policy.
      */
@@ -88,16 +92,19 @@ export class ReadOnlyPolicy extends pulumi.ComponentResource {
             resourceInputs["allowWebConsoleServices"] = (args ? args.allowWebConsoleServices : undefined) ?? true;
             resourceInputs["allowedServices"] = args ? args.allowedServices : undefined;
             resourceInputs["description"] = (args ? args.description : undefined) ?? "IAM Policy";
+            resourceInputs["metadataOnly"] = (args ? args.metadataOnly : undefined) ?? false;
             resourceInputs["name"] = args ? args.name : undefined;
             resourceInputs["path"] = (args ? args.path : undefined) ?? "/";
             resourceInputs["tags"] = args ? args.tags : undefined;
             resourceInputs["webConsoleServices"] = args ? args.webConsoleServices : undefined;
             resourceInputs["arn"] = undefined /*out*/;
+            resourceInputs["catalogVersion"] = undefined /*out*/;
             resourceInputs["id"] = undefined /*out*/;
             resourceInputs["policy"] = undefined /*out*/;
             resourceInputs["policyJson"] = undefined /*out*/;
         } else {
             resourceInputs["arn"] = undefined /*out*/;
+            resourceInputs["catalogVersion"] = undefined /*out*/;
             resourceInputs["description"] = undefined /*out*/;
             resourceInputs["id"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
@@ -138,6 +145,10 @@ export interface ReadOnlyPolicyArgs {
      * The description of the policy.
      */
     description?: pulumi.Input<string>;
+    /**
+     * Allow only List, Describe and metadata Read actions instead of the List*, Get*, Describe* and View* wildcards, leaving out reads of data and secrets such as s3:GetObject or secretsmanager:GetSecretValue. Since the embedded action catalog is a curated subset, services it does not list completely keep List* and Describe* next to their other cataloged metadata actions, and services missing from it fall back to List* and Describe*.
+     */
+    metadataOnly?: pulumi.Input<boolean>;
     /**
      * The name of the policy.
      */
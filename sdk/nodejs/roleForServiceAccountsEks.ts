@@ -72,6 +72,14 @@ export class RoleForServiceAccountsEks extends pulumi.ComponentResource {
     }
 
     public readonly role!: pulumi.Output<{[key: string]: string}>;
+    /**
+     * Annotations and ServiceAccount manifests binding each ServiceAccount to the role.
+     */
+    public /*out*/ readonly serviceAccounts!: pulumi.Output<outputs.EKSServiceAccountManifest[]>;
+    /**
+     * Session policy and AWS CLI / SDK snippets assuming the role.
+     */
+    public readonly session!: pulumi.Output<outputs.RoleSessionHelpers>;
 
     /**
      * Create a RoleForServiceAccountsEks resource with the given unique name, arguments, and options.
@@ -84,16 +92,22 @@ export class RoleForServiceAccountsEks extends pulumi.ComponentResource {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
+            resourceInputs["allowAllNamespaces"] = (args ? args.allowAllNamespaces : undefined) ?? false;
             resourceInputs["assumeRoleConditionTest"] = (args ? args.assumeRoleConditionTest : undefined) ?? "StringEquals";
             resourceInputs["forceDetachPolicies"] = (args ? args.forceDetachPolicies : undefined) ?? false;
             resourceInputs["maxSessionDuration"] = (args ? args.maxSessionDuration : undefined) ?? 3600;
             resourceInputs["oidcProviders"] = args ? args.oidcProviders : undefined;
             resourceInputs["policies"] = args ? (args.policies ? pulumi.output(args.policies).apply(inputs.eksrolePoliciesArgsProvideDefaults) : undefined) : undefined;
             resourceInputs["policyNamePrefix"] = (args ? args.policyNamePrefix : undefined) ?? "AmazonEKS_";
-            resourceInputs["role"] = args ? args.role : undefined;
+            resourceInputs["role"] = args ? (args.role ? pulumi.output(args.role).apply(inputs.eksserviceAccountRoleArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["serviceAccountManifest"] = args ? (args.serviceAccountManifest ? pulumi.output(args.serviceAccountManifest).apply(inputs.eksserviceAccountManifestOptionsArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["session"] = args ? (args.session ? pulumi.output(args.session).apply(inputs.roleSessionArgsProvideDefaults) : undefined) : undefined;
             resourceInputs["tags"] = args ? args.tags : undefined;
+            resourceInputs["serviceAccounts"] = undefined /*out*/;
         } else {
             resourceInputs["role"] = undefined /*out*/;
+            resourceInputs["serviceAccounts"] = undefined /*out*/;
+            resourceInputs["session"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         super(RoleForServiceAccountsEks.__pulumiType, name, resourceInputs, opts, true /*remote*/);
@@ -105,7 +119,12 @@ export class RoleForServiceAccountsEks extends pulumi.ComponentResource {
  */
 export interface RoleForServiceAccountsEksArgs {
     /**
-     * Name of the IAM condition operator to evaluate when assuming the role.
+     * Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+     */
+    allowAllNamespaces?: pulumi.Input<boolean>;
+    /**
+     * Name of the IAM condition operator to evaluate when assuming the role. ServiceAccounts with wildcards
+     * are matched with StringLike in a statement of their own.
      */
     assumeRoleConditionTest?: pulumi.Input<string>;
     /**
@@ -126,6 +145,15 @@ export interface RoleForServiceAccountsEksArgs {
      */
     policyNamePrefix?: pulumi.Input<string>;
     role?: pulumi.Input<inputs.EKSServiceAccountRoleArgs>;
+    /**
+     * Annotations added to the rendered ServiceAccount manifests.
+     */
+    serviceAccountManifest?: pulumi.Input<inputs.EKSServiceAccountManifestOptionsArgs>;
+    /**
+     * Settings of the session helpers rendered for the role. The web identity token file defaults to the
+     * token EKS mounts into pods.
+     */
+    session?: pulumi.Input<inputs.RoleSessionArgs>;
     /**
      * A map of tags to add.
      */
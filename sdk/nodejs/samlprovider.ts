@@ -0,0 +1,126 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * This resource helps you create an IAM SAML identity provider from the metadata document of your IdP. The
+ * metadata is validated before the provider is created: it must contain an entity ID and at least one
+ * signing certificate that has not expired. A warning is reported when a signing certificate is close to
+ * its expiry date so that the metadata can be updated before the IdP rotates its certificate.
+ *
+ * ## Example Usage
+ * ## SAML Provider
+ *
+ * ```typescript
+ * import * as iam from "@pulumi/aws-iam";
+ *
+ * export const samlProvider = new iam.SAMLProvider("aws-iam-example-saml-provider", {
+ *     name: "okta",
+ *     samlMetadataFile: "./okta-metadata.xml",
+ *     certificateExpiryWarningDays: 45,
+ * });
+ * ```
+ * {{ /example }}
+ */
+export class SAMLProvider extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'aws-iam:index:SAMLProvider';
+
+    /**
+     * Returns true if the given object is an instance of SAMLProvider.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is SAMLProvider {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === SAMLProvider.__pulumiType;
+    }
+
+    /**
+     * The ARN assigned by AWS for this provider.
+     */
+    public /*out*/ readonly arn!: pulumi.Output<string>;
+    /**
+     * The expiration date of the valid signing certificate that expires first, in RFC3339 format.
+     */
+    public /*out*/ readonly certificateExpiry!: pulumi.Output<string>;
+    /**
+     * The number of days left until the valid signing certificate that expires first is no longer valid.
+     */
+    public /*out*/ readonly daysUntilCertificateExpiry!: pulumi.Output<number>;
+    /**
+     * The entity ID of the IdP found in the metadata document.
+     */
+    public /*out*/ readonly entityId!: pulumi.Output<string>;
+    /**
+     * The name of the provider.
+     */
+    public readonly name!: pulumi.Output<string>;
+    /**
+     * The expiration date and time for the SAML provider in RFC1123 format.
+     */
+    public /*out*/ readonly validUntil!: pulumi.Output<string>;
+
+    /**
+     * Create a SAMLProvider resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: SAMLProviderArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            resourceInputs["certificateExpiryWarningDays"] = (args ? args.certificateExpiryWarningDays : undefined) ?? 30;
+            resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["samlMetadataDocument"] = args ? args.samlMetadataDocument : undefined;
+            resourceInputs["samlMetadataFile"] = args ? args.samlMetadataFile : undefined;
+            resourceInputs["tags"] = args ? args.tags : undefined;
+            resourceInputs["arn"] = undefined /*out*/;
+            resourceInputs["certificateExpiry"] = undefined /*out*/;
+            resourceInputs["daysUntilCertificateExpiry"] = undefined /*out*/;
+            resourceInputs["entityId"] = undefined /*out*/;
+            resourceInputs["validUntil"] = undefined /*out*/;
+        } else {
+            resourceInputs["arn"] = undefined /*out*/;
+            resourceInputs["certificateExpiry"] = undefined /*out*/;
+            resourceInputs["daysUntilCertificateExpiry"] = undefined /*out*/;
+            resourceInputs["entityId"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["validUntil"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(SAMLProvider.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a SAMLProvider resource.
+ */
+export interface SAMLProviderArgs {
+    /**
+     * Number of days before a signing certificate expires at which a warning is reported. `0` disables the warning.
+     */
+    certificateExpiryWarningDays?: pulumi.Input<number>;
+    /**
+     * The name of the SAML provider. Defaults to the resource name.
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * The IdP metadata XML document.
+     */
+    samlMetadataDocument?: pulumi.Input<string>;
+    /**
+     * Path to a local file containing the IdP metadata XML document. Used when `samlMetadataDocument`
+     * is not provided.
+     */
+    samlMetadataFile?: pulumi.Input<string>;
+    /**
+     * A map of tags to add.
+     */
+    tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
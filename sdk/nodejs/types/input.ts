@@ -7,6 +7,135 @@ import * as outputs from "../types/output";
 
 import * as utilities from "../utilities";
 
+export interface AWSAuthArgs {
+    /**
+     * Mapping of the admin role.
+     */
+    admin?: pulumi.Input<inputs.AWSAuthRoleArgs>;
+    /**
+     * Mapping of the poweruser role.
+     */
+    poweruser?: pulumi.Input<inputs.AWSAuthRoleArgs>;
+    /**
+     * Mapping of the readonly role.
+     */
+    readonly?: pulumi.Input<inputs.AWSAuthRoleArgs>;
+}
+
+export interface AWSAuthRoleArgs {
+    /**
+     * Kubernetes groups of the role, e.g. `system:masters`.
+     */
+    groups?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Kubernetes username of the role. Defaults to `<role name>:{{SessionName}}`.
+     */
+    username?: pulumi.Input<string>;
+}
+
+export interface AWSConfigRoleArgs {
+    /**
+     * Session duration in seconds.
+     */
+    durationSeconds?: pulumi.Input<number>;
+    /**
+     * STS ExternalId to pass when assuming the role.
+     */
+    externalId?: pulumi.Input<string>;
+    /**
+     * Name of the profile, without the profile prefix.
+     */
+    profileName: pulumi.Input<string>;
+    /**
+     * Whether the role requires MFA.
+     */
+    requiresMfa?: pulumi.Input<boolean>;
+    /**
+     * ARN of the IAM role.
+     */
+    roleArn: pulumi.Input<string>;
+}
+/**
+ * awsconfigRoleArgsProvideDefaults sets the appropriate defaults for AWSConfigRoleArgs
+ */
+export function awsconfigRoleArgsProvideDefaults(val: AWSConfigRoleArgs): AWSConfigRoleArgs {
+    return {
+        ...val,
+        requiresMfa: (val.requiresMfa) ?? false,
+    };
+}
+
+export interface AWSConfigSourceArgs {
+    /**
+     * Command printing the credentials which assume the roles. When it contains `{role_arn}` it is run for
+     * each role with its ARN instead, e.g. to sign in to SAML roles with saml2aws.
+     */
+    credentialProcess?: pulumi.Input<string>;
+    /**
+     * ARN of the MFA device used for roles requiring MFA.
+     */
+    mfaSerial?: pulumi.Input<string>;
+    /**
+     * Prefix added to the name of each role profile.
+     */
+    profilePrefix?: pulumi.Input<string>;
+    /**
+     * Default region of the profiles.
+     */
+    region?: pulumi.Input<string>;
+    /**
+     * Profile whose credentials assume the roles. Defaults to `default` when no other source is set.
+     */
+    sourceProfile?: pulumi.Input<string>;
+    /**
+     * Account ID of the IAM Identity Center permission set assuming the roles.
+     */
+    ssoAccountId?: pulumi.Input<string>;
+    /**
+     * Region of the IAM Identity Center session.
+     */
+    ssoRegion?: pulumi.Input<string>;
+    /**
+     * Name of the IAM Identity Center permission set assuming the roles.
+     */
+    ssoRoleName?: pulumi.Input<string>;
+    /**
+     * Name of the IAM Identity Center session whose credentials assume the roles.
+     */
+    ssoSession?: pulumi.Input<string>;
+    /**
+     * Start URL of the IAM Identity Center session.
+     */
+    ssoStartUrl?: pulumi.Input<string>;
+}
+
+/**
+ * A group of actions of a service to allow based on matching tags.
+ */
+export interface AbacActionGroupArgs {
+    /**
+     * Actions allowed on resources whose tags match the principal tags. Actions without a service
+     * prefix are prefixed with the service.
+     */
+    actions?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Actions creating resources, only allowed when the request tags match the principal tags.
+     */
+    createActions?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Name of the group, used as the statement ID and unique across the groups. Defaults to the service, suffixed with the position of the group when the service is used by more than one unnamed group.
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * Resources the actions apply to. Defaults to `*`.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * IAM prefix of the service, e.g. `ec2`.
+     */
+    service: pulumi.Input<string>;
+}
+
 /**
  * Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords.
  */
@@ -14,12 +143,12 @@ export interface AccountPasswordPolicyArgs {
     /**
      * Whether to allow users to change their own password.
      */
-    allowUsersToChange: pulumi.Input<boolean>;
+    allowUsersToChange?: pulumi.Input<boolean>;
     /**
      * Whether users are prevented from setting a new password after their password has
      * expired (i.e. require administrator reset).
      */
-    hardExpiry: pulumi.Input<boolean>;
+    hardExpiry?: pulumi.Input<boolean>;
     /**
      * The number of days that an user password is valid. If not set or a value of `0` is provided, then
      * passwords will not expire.
@@ -33,19 +162,19 @@ export interface AccountPasswordPolicyArgs {
     /**
      * Whether to require lowercase characters for user passwords.
      */
-    requireLowercaseCharacters: pulumi.Input<boolean>;
+    requireLowercaseCharacters?: pulumi.Input<boolean>;
     /**
      * Whether to require numbers for user passwords.
      */
-    requireNumbers: pulumi.Input<boolean>;
+    requireNumbers?: pulumi.Input<boolean>;
     /**
      * Whether to require symbols for user passwords.
      */
-    requireSymbols: pulumi.Input<boolean>;
+    requireSymbols?: pulumi.Input<boolean>;
     /**
      * Whether to require uppercase characters for user passwords.
      */
-    requireUppercaseCharacters: pulumi.Input<boolean>;
+    requireUppercaseCharacters?: pulumi.Input<boolean>;
     /**
      * The number of previous passwords that users are prevented from reusing. If not set or a
      * value of `0` is provided, no reuse prevention policy will be used.
@@ -57,6 +186,21 @@ export interface AccountPasswordPolicyArgs {
  * The admin role.
  */
 export interface AdminRoleArgs {
+    /**
+     * Whether inline policies not declared in `inlinePolicies` are removed from the role.
+     */
+    exclusiveInlinePolicies?: pulumi.Input<boolean>;
+    /**
+     * Whether managed policies not declared for the role are detached from it. The role then manages its
+     * policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+     * attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+     * policies. Previews of existing roles warn that undeclared policies are detached.
+     */
+    exclusivePolicyAttachments?: pulumi.Input<boolean>;
+    /**
+     * Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+     */
+    inlinePolicies?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
     /**
      * IAM role with admin access.
      */
@@ -84,6 +228,8 @@ export interface AdminRoleArgs {
 export function adminRoleArgsProvideDefaults(val: AdminRoleArgs): AdminRoleArgs {
     return {
         ...val,
+        exclusiveInlinePolicies: (val.exclusiveInlinePolicies) ?? false,
+        exclusivePolicyAttachments: (val.exclusivePolicyAttachments) ?? false,
         name: (val.name) ?? "admin",
     };
 }
@@ -92,6 +238,21 @@ export function adminRoleArgsProvideDefaults(val: AdminRoleArgs): AdminRoleArgs
  * The admin role.
  */
 export interface AdminRoleWithMFAArgs {
+    /**
+     * Whether inline policies not declared in `inlinePolicies` are removed from the role.
+     */
+    exclusiveInlinePolicies?: pulumi.Input<boolean>;
+    /**
+     * Whether managed policies not declared for the role are detached from it. The role then manages its
+     * policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+     * attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+     * policies. Previews of existing roles warn that undeclared policies are detached.
+     */
+    exclusivePolicyAttachments?: pulumi.Input<boolean>;
+    /**
+     * Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+     */
+    inlinePolicies?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
     /**
      * IAM role with admin access.
      */
@@ -117,6 +278,66 @@ export interface AdminRoleWithMFAArgs {
      */
     tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
 }
+/**
+ * adminRoleWithMFAArgsProvideDefaults sets the appropriate defaults for AdminRoleWithMFAArgs
+ */
+export function adminRoleWithMFAArgsProvideDefaults(val: AdminRoleWithMFAArgs): AdminRoleWithMFAArgs {
+    return {
+        ...val,
+        exclusiveInlinePolicies: (val.exclusiveInlinePolicies) ?? false,
+        exclusivePolicyAttachments: (val.exclusivePolicyAttachments) ?? false,
+    };
+}
+
+export interface AssumableRoleWithOIDCProviderArgs {
+    /**
+     * The AWS account ID where the OIDC provider lives, defaults to `awsAccountId`.
+     */
+    awsAccountId?: pulumi.Input<string>;
+    /**
+     * The audiences of this provider, replacing `oidcFullyQualifiedAudiences` when set.
+     */
+    oidcFullyQualifiedAudiences?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The fully qualified OIDC subjects of this provider, replacing `oidcFullyQualifiedSubjects` when set.
+     */
+    oidcFullyQualifiedSubjects?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The OIDC subjects using wildcards of this provider, replacing `oidcSubjectsWithWildcards` when set.
+     */
+    oidcSubjectsWithWildcards?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * URL of the OIDC Provider.
+     */
+    url: pulumi.Input<string>;
+}
+
+/**
+ * The AWS Distro for OpenTelemetry IAM policy to the role.
+ */
+export interface EKSADOTPolicyArgs {
+    /**
+     * List of AMP Workspace ARNs to write metrics to. If not provided, a default ARN of "*"
+     * will be provided.
+     */
+    ampWorkspaceArns?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Determines whether to attach the AWS Distro for OpenTelemetry IAM policy to the role.
+     */
+    attach?: pulumi.Input<boolean>;
+    /**
+     * Allows exporting metrics to Amazon Managed Service for Prometheus.
+     */
+    enableAmp?: pulumi.Input<boolean>;
+    /**
+     * Allows exporting metrics and logs to CloudWatch.
+     */
+    enableCloudwatch?: pulumi.Input<boolean>;
+    /**
+     * Allows exporting traces to AWS X-Ray.
+     */
+    enableXray?: pulumi.Input<boolean>;
+}
 
 /**
  * The Amazon Managed Service for Prometheus IAM policy to the role.
@@ -125,7 +346,7 @@ export interface EKSAmazonManagedServicePrometheusPolicyArgs {
     /**
      * Determines whether to attach the Amazon Managed Service for Prometheus IAM policy to the role.
      */
-    attach: pulumi.Input<boolean>;
+    attach?: pulumi.Input<boolean>;
     /**
      * List of AMP Workspace ARNs to read and write metrics. If not provided, a default ARN of "*"
      * will be provided.
@@ -154,7 +375,7 @@ export interface EKSCertManagerPolicyArgs {
     /**
      * Determines whether to attach the Cert Manager IAM policy to the role.
      */
-    attach: pulumi.Input<boolean>;
+    attach?: pulumi.Input<boolean>;
     /**
      * Route53 hosted zone ARNs to allow Cert manager to manage records. If not provided,
      * the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
@@ -162,6 +383,16 @@ export interface EKSCertManagerPolicyArgs {
     hostedZoneArns?: pulumi.Input<pulumi.Input<string>[]>;
 }
 
+/**
+ * The CloudWatch Observability IAM policy to the role.
+ */
+export interface EKSCloudWatchObservabilityPolicyArgs {
+    /**
+     * Determines whether to attach the CloudWatch Observability IAM policy to the role.
+     */
+    attach?: pulumi.Input<boolean>;
+}
+
 /**
  * The Cluster Autoscaler IAM policy to the role.
  */
@@ -169,11 +400,29 @@ export interface EKSClusterAutoscalerPolicyArgs {
     /**
      * Determines whether to attach the Cluster Autoscaler IAM policy to the role.
      */
-    attach: pulumi.Input<boolean>;
+    attach?: pulumi.Input<boolean>;
+    /**
+     * List of Auto Scaling group ARNs the Cluster Autoscaler can scale, e.g. the groups of specific node groups.
+     */
+    autoScalingGroupArns?: pulumi.Input<pulumi.Input<string>[]>;
     /**
      * List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
      */
-    clusterIds: pulumi.Input<pulumi.Input<string>[]>;
+    clusterIds?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Allows eks:DescribeNodegroup, used to discover the labels and taints of managed node groups scaled from zero.
+     */
+    enableDescribeNodegroup?: pulumi.Input<boolean>;
+    /**
+     * Only grants the read actions, e.g. for dashboards showing the autoscaler status. Without `clusterIds`,
+     * `autoScalingGroupArns` or `tagKeys` the policy is read only as well, with a warning.
+     */
+    readOnly?: pulumi.Input<boolean>;
+    /**
+     * List of tag keys which must all be present on the Auto Scaling groups the Cluster Autoscaler can scale,
+     * e.g. `k8s.io/cluster-autoscaler/enabled`. Combined with `clusterIds` and `autoScalingGroupArns` when given.
+     */
+    tagKeys?: pulumi.Input<pulumi.Input<string>[]>;
 }
 
 /**
@@ -183,7 +432,7 @@ export interface EKSEBSCSIPolicyArgs {
     /**
      * Determines whether to attach the EBS CSI IAM policy to the role.
      */
-    attach: pulumi.Input<boolean>;
+    attach?: pulumi.Input<boolean>;
     /**
      * KMS CMK IDs to allow EBS CSI to manage encrypted volumes.
      */
@@ -197,7 +446,7 @@ export interface EKSEFSCSIPolicyArgs {
     /**
      * Determines whether to attach the EFS CSI IAM policy to the role.
      */
-    attach: pulumi.Input<boolean>;
+    attach?: pulumi.Input<boolean>;
 }
 
 /**
@@ -207,7 +456,7 @@ export interface EKSExternalDNSPolicyArgs {
     /**
      * Determines whether to attach the External DNS IAM policy to the role.
      */
-    attach: pulumi.Input<boolean>;
+    attach?: pulumi.Input<boolean>;
     /**
      * Route53 hosted zone ARNs to allow External DNS to manage records. If not provided,
      * the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
@@ -222,7 +471,7 @@ export interface EKSExternalSecretsPolicyArgs {
     /**
      * Determines whether to attach the External Secrets policy to the role.
      */
-    attach: pulumi.Input<boolean>;
+    attach?: pulumi.Input<boolean>;
     /**
      * List of Secrets Manager ARNs that contain secrets to mount using External Secrets. If not provided, the default ARN "arn:aws:secretsmanager:*:*:secret:*" will be applied.
      */
@@ -234,6 +483,34 @@ export interface EKSExternalSecretsPolicyArgs {
     ssmParameterArns?: pulumi.Input<pulumi.Input<string>[]>;
 }
 
+/**
+ * The VPC Lattice Gateway API Controller IAM policy to the role.
+ */
+export interface EKSGatewayAPIControllerPolicyArgs {
+    /**
+     * Determines whether to attach the VPC Lattice Gateway API Controller IAM policy to the role.
+     */
+    attach?: pulumi.Input<boolean>;
+}
+
+/**
+ * The KEDA IAM policy to the role.
+ */
+export interface EKSKEDAPolicyArgs {
+    /**
+     * Determines whether to attach the KEDA IAM policy to the role.
+     */
+    attach?: pulumi.Input<boolean>;
+    /**
+     * List of DynamoDB table ARNs queried by the aws-dynamodb scaler.
+     */
+    dynamodbTableArns?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * List of SQS queue ARNs read by the aws-sqs-queue scaler.
+     */
+    sqsQueueArns?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
 /**
  * The Karpenter Controller policy to the role.
  */
@@ -241,16 +518,24 @@ export interface EKSKarpenterControllerPolicyArgs {
     /**
      * Determines whether to attach the Karpenter Controller policy to the role.
      */
-    attach: pulumi.Input<boolean>;
+    attach?: pulumi.Input<boolean>;
     /**
      * Cluster ID where the Karpenter controller is provisioned/managing.
      */
     clusterId?: pulumi.Input<string>;
+    /**
+     * ARN of the SQS queue Karpenter reads interruption events from. Only used by the `v1` policy.
+     */
+    interruptionQueueArn?: pulumi.Input<string>;
     /**
      * List of node IAM role ARNs Karpenter can use to launch nodes. If not provided,
-     * the default ARN "*" will be applied.
+     * the default ARN "*" will be applied. Required by the `v1` policy.
      */
     nodeIamRoleArns?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Region of the cluster. Only used by the `v1` policy, defaults to the region of the provider.
+     */
+    region?: pulumi.Input<string>;
     /**
      * List of SSM Parameter ARNs that contain AMI IDs launched by Karpenter. If not provided,
      * the default ARN "arn:aws:ssm:*:*:parameter/aws/service/*" will be applied.
@@ -264,6 +549,12 @@ export interface EKSKarpenterControllerPolicyArgs {
      * Tag key (`{key = value}`) applied to resources launched by Karpenter through the Karpenter provisioner.
      */
     tagKey?: pulumi.Input<string>;
+    /**
+     * Version of the Karpenter controller policy. `v0` is the policy of Karpenter releases before v1, using a single
+     * discovery tag. `v1` matches the permissions of the Karpenter v1 controller, scoped by the
+     * `kubernetes.io/cluster/<clusterId>` and `karpenter.sh/nodepool` tags, and requires `clusterId` to be the cluster name.
+     */
+    version?: pulumi.Input<string>;
 }
 /**
  * ekskarpenterControllerPolicyArgsProvideDefaults sets the appropriate defaults for EKSKarpenterControllerPolicyArgs
@@ -273,6 +564,7 @@ export function ekskarpenterControllerPolicyArgsProvideDefaults(val: EKSKarpente
         ...val,
         clusterId: (val.clusterId) ?? "*",
         tagKey: (val.tagKey) ?? "karpenter.sh/discovery",
+        version: (val.version) ?? "v0",
     };
 }
 
@@ -280,15 +572,69 @@ export function ekskarpenterControllerPolicyArgsProvideDefaults(val: EKSKarpente
  * The Load Balancer policy.
  */
 export interface EKSLoadBalancerPolicyArgs {
+    /**
+     * Name of the cluster. When set, the controller can only manage resources whose cluster tag has this value.
+     */
+    clusterName?: pulumi.Input<string>;
+    /**
+     * Tag key the controller adds to the resources it manages.
+     */
+    clusterTagKey?: pulumi.Input<string>;
     /**
      * Determines whether to attach the Load Balancer Controller policy to the role.
      */
     controller?: pulumi.Input<boolean>;
+    /**
+     * Version of the Load Balancer Controller the policy is for, e.g. `v2.7`. Permissions added by later controller
+     * releases are only granted to the versions needing them.
+     */
+    controllerVersion?: pulumi.Input<string>;
+    /**
+     * Leaves out the WAF, WAFv2 and Shield permissions, for controllers running with `--enable-waf=false`,
+     * `--enable-wafv2=false` and `--enable-shield=false`.
+     */
+    restrictWafShield?: pulumi.Input<boolean>;
+    /**
+     * List of security group ARNs the controller can change the ingress rules of. Defaults to all security groups.
+     */
+    restrictedSecurityGroupArns?: pulumi.Input<pulumi.Input<string>[]>;
     /**
      * Determines whether to attach the Load Balancer Controller policy for the TargetGroupBinding only.
      */
     targetGroupBindingOnly?: pulumi.Input<boolean>;
 }
+/**
+ * eksloadBalancerPolicyArgsProvideDefaults sets the appropriate defaults for EKSLoadBalancerPolicyArgs
+ */
+export function eksloadBalancerPolicyArgsProvideDefaults(val: EKSLoadBalancerPolicyArgs): EKSLoadBalancerPolicyArgs {
+    return {
+        ...val,
+        clusterTagKey: (val.clusterTagKey) ?? "elbv2.k8s.aws/cluster",
+        controllerVersion: (val.controllerVersion) ?? "v2.4",
+    };
+}
+
+/**
+ * The Mountpoint for Amazon S3 CSI IAM policy to the role.
+ */
+export interface EKSMountpointS3CSIPolicyArgs {
+    /**
+     * Determines whether to attach the Mountpoint for Amazon S3 CSI IAM policy to the role.
+     */
+    attach?: pulumi.Input<boolean>;
+    /**
+     * List of KMS key ARNs used to encrypt the objects in the buckets.
+     */
+    kmsKeyArns?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * List of S3 Bucket ARNs the driver is allowed to mount. At least one is required.
+     */
+    s3BucketArns?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * List of key prefixes within the buckets the driver is allowed to access. Defaults to the whole bucket.
+     */
+    s3PathPrefixes?: pulumi.Input<pulumi.Input<string>[]>;
+}
 
 /**
  * The Node Termination Handler policy to the role.
@@ -297,7 +643,7 @@ export interface EKSNodeTerminationHandlerPolicyArgs {
     /**
      * Determines whether to attach the Node Termination Handler policy to the role.
      */
-    attach: pulumi.Input<boolean>;
+    attach?: pulumi.Input<boolean>;
     /**
      * List of SQS ARNs that contain node termination events. If not provided, then a default
      * ARN of "*" will be provided.
@@ -309,6 +655,10 @@ export interface EKSNodeTerminationHandlerPolicyArgs {
  * The different policies to attach to the role.
  */
 export interface EKSRolePoliciesArgs {
+    /**
+     * The AWS Distro for OpenTelemetry IAM policy.
+     */
+    adot?: pulumi.Input<inputs.EKSADOTPolicyArgs>;
     /**
      * The Amazon Managed Service for Prometheus IAM policy.
      */
@@ -321,10 +671,19 @@ export interface EKSRolePoliciesArgs {
      * The Cert Manager IAM policy.
      */
     certManager?: pulumi.Input<inputs.EKSCertManagerPolicyArgs>;
+    /**
+     * The CloudWatch Observability IAM policy.
+     */
+    cloudwatchObservability?: pulumi.Input<inputs.EKSCloudWatchObservabilityPolicyArgs>;
     /**
      * The Cluster Autoscaler IAM policy.
      */
     clusterAutoScaling?: pulumi.Input<inputs.EKSClusterAutoscalerPolicyArgs>;
+    /**
+     * Arguments of organization specific add-ons, keyed by the name they are registered with in
+     * the provider's add-on registry. Unknown add-on names are rejected.
+     */
+    custom?: pulumi.Input<{[key: string]: any}>;
     /**
      * The EBS CSI IAM policy.
      */
@@ -345,18 +704,34 @@ export interface EKSRolePoliciesArgs {
      * The FSx for Lustre CSI Driver IAM policy.
      */
     fsxLustreCsi?: pulumi.Input<inputs.FSxLustreCSIPolicyArgs>;
+    /**
+     * The VPC Lattice Gateway API Controller IAM policy.
+     */
+    gatewayApiController?: pulumi.Input<inputs.EKSGatewayAPIControllerPolicyArgs>;
     /**
      * The Karpenter Controller policy.
      */
     karpenterController?: pulumi.Input<inputs.EKSKarpenterControllerPolicyArgs>;
+    /**
+     * The KEDA IAM policy.
+     */
+    keda?: pulumi.Input<inputs.EKSKEDAPolicyArgs>;
     /**
      * The Load Balancer policy.
      */
     loadBalancer?: pulumi.Input<inputs.EKSLoadBalancerPolicyArgs>;
+    /**
+     * The Mountpoint for Amazon S3 CSI IAM policy.
+     */
+    mountpointS3Csi?: pulumi.Input<inputs.EKSMountpointS3CSIPolicyArgs>;
     /**
      * The Node Termination Handler policy to the role.
      */
     nodeTerminationHandler?: pulumi.Input<inputs.EKSNodeTerminationHandlerPolicyArgs>;
+    /**
+     * The Secrets Store CSI driver IAM policy.
+     */
+    secretsStoreCsi?: pulumi.Input<inputs.EKSSecretsStoreCSIPolicyArgs>;
     /**
      * The Velero IAM policy.
      */
@@ -373,9 +748,30 @@ export function eksrolePoliciesArgsProvideDefaults(val: EKSRolePoliciesArgs): EK
     return {
         ...val,
         karpenterController: (val.karpenterController ? pulumi.output(val.karpenterController).apply(inputs.ekskarpenterControllerPolicyArgsProvideDefaults) : undefined),
+        loadBalancer: (val.loadBalancer ? pulumi.output(val.loadBalancer).apply(inputs.eksloadBalancerPolicyArgsProvideDefaults) : undefined),
     };
 }
 
+/**
+ * The Secrets Store CSI driver IAM policy to the role.
+ */
+export interface EKSSecretsStoreCSIPolicyArgs {
+    /**
+     * Determines whether to attach the Secrets Store CSI driver IAM policy to the role.
+     */
+    attach?: pulumi.Input<boolean>;
+    /**
+     * List of Secrets Manager ARNs that contain secrets to mount using the Secrets Store CSI driver.
+     * If not provided, a default ARN of "arn:aws:secretsmanager:*:*:secret:*" will be provided.
+     */
+    secretsManagerArns?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * List of Systems Manager Parameter ARNs that contain secrets to mount using the Secrets Store CSI driver.
+     * If not provided, a default ARN of "arn:aws:ssm:*:*:parameter/*" will be provided.
+     */
+    ssmParameterArns?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
 /**
  * EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount.
  */
@@ -385,16 +781,51 @@ export interface EKSServiceAccountArgs {
      */
     name?: pulumi.Input<string>;
     /**
-     * Service accounts to pair with the cluster.
+     * Service accounts to pair with the cluster, as `namespace:name`. Wildcards such as `team-a:*` are matched with StringLike.
      */
     serviceAccounts?: pulumi.Input<pulumi.Input<string>[]>;
 }
 
+export interface EKSServiceAccountManifestOptionsArgs {
+    /**
+     * Whether to annotate the ServiceAccounts to use the regional STS endpoint.
+     */
+    stsRegionalEndpoints?: pulumi.Input<boolean>;
+    /**
+     * Expiration of the projected ServiceAccount token in seconds. The annotation is left out when 0.
+     */
+    tokenExpiration?: pulumi.Input<number>;
+}
+/**
+ * eksserviceAccountManifestOptionsArgsProvideDefaults sets the appropriate defaults for EKSServiceAccountManifestOptionsArgs
+ */
+export function eksserviceAccountManifestOptionsArgsProvideDefaults(val: EKSServiceAccountManifestOptionsArgs): EKSServiceAccountManifestOptionsArgs {
+    return {
+        ...val,
+        stsRegionalEndpoints: (val.stsRegionalEndpoints) ?? false,
+    };
+}
+
 export interface EKSServiceAccountRoleArgs {
     /**
      * IAM Role description.
      */
     description?: pulumi.Input<string>;
+    /**
+     * Whether inline policies not declared in `inlinePolicies` are removed from the role.
+     */
+    exclusiveInlinePolicies?: pulumi.Input<boolean>;
+    /**
+     * Whether managed policies not declared for the role are detached from it. The role then manages its
+     * policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+     * attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+     * policies. Previews of existing roles warn that undeclared policies are detached.
+     */
+    exclusivePolicyAttachments?: pulumi.Input<boolean>;
+    /**
+     * Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+     */
+    inlinePolicies?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
     /**
      * IAM role name.
      */
@@ -416,6 +847,16 @@ export interface EKSServiceAccountRoleArgs {
      */
     policyArns?: pulumi.Input<pulumi.Input<string>[]>;
 }
+/**
+ * eksserviceAccountRoleArgsProvideDefaults sets the appropriate defaults for EKSServiceAccountRoleArgs
+ */
+export function eksserviceAccountRoleArgsProvideDefaults(val: EKSServiceAccountRoleArgs): EKSServiceAccountRoleArgs {
+    return {
+        ...val,
+        exclusiveInlinePolicies: (val.exclusiveInlinePolicies) ?? false,
+        exclusivePolicyAttachments: (val.exclusivePolicyAttachments) ?? false,
+    };
+}
 
 /**
  * The VPC CNI IAM policy to the role.
@@ -424,7 +865,7 @@ export interface EKSVPNCNIPolicyArgs {
     /**
      * Determines whether to attach the VPC CNI IAM policy to the role.
      */
-    attach: pulumi.Input<boolean>;
+    attach?: pulumi.Input<boolean>;
     /**
      * Determines whether to enable IPv4 permissions for VPC CNI policy.
      */
@@ -442,7 +883,7 @@ export interface EKSVeleroPolicyArgs {
     /**
      * Determines whether to attach the Velero IAM policy to the role.
      */
-    attach: pulumi.Input<boolean>;
+    attach?: pulumi.Input<boolean>;
     /**
      * List of S3 Bucket ARNs that Velero needs access to in order to backup and restore cluster resources.
      * If not provided, a default ARN of "*" will be provided.
@@ -457,7 +898,7 @@ export interface FSxLustreCSIPolicyArgs {
     /**
      * Determines whether to attach the FSx for Lustre CSI Driver IAM policy to the role.
      */
-    attach: pulumi.Input<boolean>;
+    attach?: pulumi.Input<boolean>;
     /**
      * Service role ARNs to allow FSx for Lustre CSI create and manage FSX for Lustre service linked roles. If not provided,
      * the default ARN "arn:aws:iam::*:role/aws-service-role/s3.data-source.lustre.fsx.amazonaws.com/*" will be applied.
@@ -465,15 +906,78 @@ export interface FSxLustreCSIPolicyArgs {
     serviceRoleArns?: pulumi.Input<pulumi.Input<string>[]>;
 }
 
+export interface InstanceProfileSettingsArgs {
+    /**
+     * Name of IAM instance profile. Defaults to the name of the role.
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * Path of IAM instance profile. Defaults to the path of the role.
+     */
+    path?: pulumi.Input<string>;
+    /**
+     * A map of tags to add to the instance profile. Defaults to the tags of the role.
+     */
+    tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
+
 export interface OIDCProviderArgs {
+    /**
+     * The AWS account ID where the IAM OIDC provider of `clusterName` or `issuerUrl` lives, defaults to the current account.
+     */
+    awsAccountId?: pulumi.Input<string>;
+    /**
+     * Name of an EKS cluster in the current region whose OIDC issuer is looked up. Used when `providerArn` is not set.
+     */
+    clusterName?: pulumi.Input<string>;
+    /**
+     * URL of the OIDC issuer. Used when neither `providerArn` nor `clusterName` is set.
+     */
+    issuerUrl?: pulumi.Input<string>;
     namespaceServiceAccounts?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * ARN of the IAM OIDC provider.
+     */
     providerArn?: pulumi.Input<string>;
 }
 
+/**
+ * A condition block of an IAM policy statement.
+ */
+export interface PolicyConditionArgs {
+    /**
+     * Name of the IAM condition operator to evaluate, e.g. `StringEquals`.
+     */
+    test: pulumi.Input<string>;
+    /**
+     * Values to evaluate the condition against.
+     */
+    values: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Name of a Context Variable to apply the condition to, e.g. `aws:PrincipalTag/team`.
+     */
+    variable: pulumi.Input<string>;
+}
+
 /**
  * The poweruser role.
  */
 export interface PoweruserRoleArgs {
+    /**
+     * Whether inline policies not declared in `inlinePolicies` are removed from the role.
+     */
+    exclusiveInlinePolicies?: pulumi.Input<boolean>;
+    /**
+     * Whether managed policies not declared for the role are detached from it. The role then manages its
+     * policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+     * attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+     * policies. Previews of existing roles warn that undeclared policies are detached.
+     */
+    exclusivePolicyAttachments?: pulumi.Input<boolean>;
+    /**
+     * Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+     */
+    inlinePolicies?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
     /**
      * IAM role with poweruser access.
      */
@@ -495,11 +999,36 @@ export interface PoweruserRoleArgs {
      */
     tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
 }
+/**
+ * poweruserRoleArgsProvideDefaults sets the appropriate defaults for PoweruserRoleArgs
+ */
+export function poweruserRoleArgsProvideDefaults(val: PoweruserRoleArgs): PoweruserRoleArgs {
+    return {
+        ...val,
+        exclusiveInlinePolicies: (val.exclusiveInlinePolicies) ?? false,
+        exclusivePolicyAttachments: (val.exclusivePolicyAttachments) ?? false,
+    };
+}
 
 /**
  * The poweruser role.
  */
 export interface PoweruserRoleWithMFAArgs {
+    /**
+     * Whether inline policies not declared in `inlinePolicies` are removed from the role.
+     */
+    exclusiveInlinePolicies?: pulumi.Input<boolean>;
+    /**
+     * Whether managed policies not declared for the role are detached from it. The role then manages its
+     * policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+     * attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+     * policies. Previews of existing roles warn that undeclared policies are detached.
+     */
+    exclusivePolicyAttachments?: pulumi.Input<boolean>;
+    /**
+     * Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+     */
+    inlinePolicies?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
     /**
      * IAM role with poweruser access.
      */
@@ -525,11 +1054,36 @@ export interface PoweruserRoleWithMFAArgs {
      */
     tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
 }
+/**
+ * poweruserRoleWithMFAArgsProvideDefaults sets the appropriate defaults for PoweruserRoleWithMFAArgs
+ */
+export function poweruserRoleWithMFAArgsProvideDefaults(val: PoweruserRoleWithMFAArgs): PoweruserRoleWithMFAArgs {
+    return {
+        ...val,
+        exclusiveInlinePolicies: (val.exclusiveInlinePolicies) ?? false,
+        exclusivePolicyAttachments: (val.exclusivePolicyAttachments) ?? false,
+    };
+}
 
 /**
  * The readonly role.
  */
 export interface ReadonlyRoleArgs {
+    /**
+     * Whether inline policies not declared in `inlinePolicies` are removed from the role.
+     */
+    exclusiveInlinePolicies?: pulumi.Input<boolean>;
+    /**
+     * Whether managed policies not declared for the role are detached from it. The role then manages its
+     * policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+     * attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+     * policies. Previews of existing roles warn that undeclared policies are detached.
+     */
+    exclusivePolicyAttachments?: pulumi.Input<boolean>;
+    /**
+     * Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+     */
+    inlinePolicies?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
     /**
      * IAM role with readonly access.
      */
@@ -551,11 +1105,36 @@ export interface ReadonlyRoleArgs {
      */
     tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
 }
+/**
+ * readonlyRoleArgsProvideDefaults sets the appropriate defaults for ReadonlyRoleArgs
+ */
+export function readonlyRoleArgsProvideDefaults(val: ReadonlyRoleArgs): ReadonlyRoleArgs {
+    return {
+        ...val,
+        exclusiveInlinePolicies: (val.exclusiveInlinePolicies) ?? false,
+        exclusivePolicyAttachments: (val.exclusivePolicyAttachments) ?? false,
+    };
+}
 
 /**
  * The readonly role.
  */
 export interface ReadonlyRoleWithMFAArgs {
+    /**
+     * Whether inline policies not declared in `inlinePolicies` are removed from the role.
+     */
+    exclusiveInlinePolicies?: pulumi.Input<boolean>;
+    /**
+     * Whether managed policies not declared for the role are detached from it. The role then manages its
+     * policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+     * attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+     * policies. Previews of existing roles warn that undeclared policies are detached.
+     */
+    exclusivePolicyAttachments?: pulumi.Input<boolean>;
+    /**
+     * Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+     */
+    inlinePolicies?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
     /**
      * IAM role with readonly access.
      */
@@ -581,11 +1160,36 @@ export interface ReadonlyRoleWithMFAArgs {
      */
     tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
 }
+/**
+ * readonlyRoleWithMFAArgsProvideDefaults sets the appropriate defaults for ReadonlyRoleWithMFAArgs
+ */
+export function readonlyRoleWithMFAArgsProvideDefaults(val: ReadonlyRoleWithMFAArgs): ReadonlyRoleWithMFAArgs {
+    return {
+        ...val,
+        exclusiveInlinePolicies: (val.exclusiveInlinePolicies) ?? false,
+        exclusivePolicyAttachments: (val.exclusivePolicyAttachments) ?? false,
+    };
+}
 
 /**
  * An IAM role.
  */
 export interface RoleArgs {
+    /**
+     * Whether inline policies not declared in `inlinePolicies` are removed from the role.
+     */
+    exclusiveInlinePolicies?: pulumi.Input<boolean>;
+    /**
+     * Whether managed policies not declared for the role are detached from it. The role then manages its
+     * policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+     * attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+     * policies. Previews of existing roles warn that undeclared policies are detached.
+     */
+    exclusivePolicyAttachments?: pulumi.Input<boolean>;
+    /**
+     * Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+     */
+    inlinePolicies?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
     /**
      * IAM role name.
      */
@@ -607,11 +1211,94 @@ export interface RoleArgs {
      */
     policyArns?: pulumi.Input<pulumi.Input<string>[]>;
 }
+/**
+ * roleArgsProvideDefaults sets the appropriate defaults for RoleArgs
+ */
+export function roleArgsProvideDefaults(val: RoleArgs): RoleArgs {
+    return {
+        ...val,
+        exclusiveInlinePolicies: (val.exclusiveInlinePolicies) ?? false,
+        exclusivePolicyAttachments: (val.exclusivePolicyAttachments) ?? false,
+    };
+}
+
+export interface RoleSessionArgs {
+    /**
+     * Requested session duration in seconds. Capped by the maximum session duration of the role and defaults to it.
+     */
+    durationSeconds?: pulumi.Input<number>;
+    /**
+     * Actions the session is limited to by a session policy. No session policy is rendered when empty.
+     */
+    policyActions?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Resources the session policy limits the actions to. Defaults to all resources.
+     */
+    policyResources?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Name of the AWS CLI profile. Defaults to the role name.
+     */
+    profileName?: pulumi.Input<string>;
+    /**
+     * Whether the role is assumed from another role session, which STS limits to one hour.
+     */
+    roleChaining?: pulumi.Input<boolean>;
+    /**
+     * Name of the role session. Defaults to the profile name.
+     */
+    sessionName?: pulumi.Input<string>;
+    /**
+     * Session tags to pass when assuming the role.
+     */
+    sessionTags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Source identity to set on the session, e.g. the name of the CI pipeline.
+     */
+    sourceIdentity?: pulumi.Input<string>;
+    /**
+     * Name of the AWS CLI profile whose credentials assume the role.
+     */
+    sourceProfile?: pulumi.Input<string>;
+    /**
+     * Session tag keys which persist when the session assumes another role.
+     */
+    transitiveTagKeys?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Path of the web identity token for roles assumed with AssumeRoleWithWebIdentity. Required for the
+     * AWS CLI config profile of these roles.
+     */
+    webIdentityTokenFile?: pulumi.Input<string>;
+}
+/**
+ * roleSessionArgsProvideDefaults sets the appropriate defaults for RoleSessionArgs
+ */
+export function roleSessionArgsProvideDefaults(val: RoleSessionArgs): RoleSessionArgs {
+    return {
+        ...val,
+        roleChaining: (val.roleChaining) ?? false,
+        sourceProfile: (val.sourceProfile) ?? "default",
+    };
+}
 
 /**
  * An IAM role that requires MFA.
  */
 export interface RoleWithMFAArgs {
+    /**
+     * Whether inline policies not declared in `inlinePolicies` are removed from the role.
+     */
+    exclusiveInlinePolicies?: pulumi.Input<boolean>;
+    /**
+     * Whether managed policies not declared for the role are detached from it. The role then manages its
+     * policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+     * attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+     * policies. Previews of existing roles warn that undeclared policies are detached.
+     */
+    exclusivePolicyAttachments?: pulumi.Input<boolean>;
+    /**
+     * Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+     */
+    inlinePolicies?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
     /**
      * IAM role with the access. Defaults to 'admin'.
      */
@@ -637,4 +1324,14 @@ export interface RoleWithMFAArgs {
      */
     tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
 }
+/**
+ * roleWithMFAArgsProvideDefaults sets the appropriate defaults for RoleWithMFAArgs
+ */
+export function roleWithMFAArgsProvideDefaults(val: RoleWithMFAArgs): RoleWithMFAArgs {
+    return {
+        ...val,
+        exclusiveInlinePolicies: (val.exclusiveInlinePolicies) ?? false,
+        exclusivePolicyAttachments: (val.exclusivePolicyAttachments) ?? false,
+    };
+}
 
@@ -37,6 +37,29 @@ export interface AccessKeyOutput {
     status?: string;
 }
 
+export interface EKSServiceAccountManifest {
+    /**
+     * Annotations binding the ServiceAccount to the IAM role.
+     */
+    annotations: {[key: string]: string};
+    /**
+     * ServiceAccount manifest as JSON.
+     */
+    json: string;
+    /**
+     * Name of the ServiceAccount.
+     */
+    name: string;
+    /**
+     * Namespace of the ServiceAccount.
+     */
+    namespace: string;
+    /**
+     * ServiceAccount manifest as YAML.
+     */
+    yaml: string;
+}
+
 export interface KeybaseOutput {
     /**
      * Decrypt user password command.
@@ -56,6 +79,29 @@ export interface KeybaseOutput {
     secretKeyPgpMessage?: string;
 }
 
+export interface RoleSessionHelpers {
+    /**
+     * AWS CLI command assuming the role with the source identity, session tags and session policy.
+     */
+    cliCommand: string;
+    /**
+     * AWS CLI config profile assuming the role. Empty for web identity roles without a web identity token file.
+     */
+    cliConfig: string;
+    /**
+     * Session duration in seconds, capped by the maximum session duration of the role.
+     */
+    durationSeconds: number;
+    /**
+     * Parameters of the STS request assuming the role as JSON, usable with any AWS SDK.
+     */
+    sdkParameters: string;
+    /**
+     * Session policy limiting what the session can do, as JSON. Empty when no policy actions are given.
+     */
+    sessionPolicy: string;
+}
+
 /**
  * The IAM user.
  */
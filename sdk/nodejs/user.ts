@@ -68,6 +68,7 @@ export class User extends pulumi.ComponentResource {
             if ((!args || args.name === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'name'");
             }
+            resourceInputs["exclusivePolicyAttachments"] = (args ? args.exclusivePolicyAttachments : undefined) ?? false;
             resourceInputs["forceDestroy"] = args ? args.forceDestroy : undefined;
             resourceInputs["name"] = args ? args.name : undefined;
             resourceInputs["passwordLength"] = args ? args.passwordLength : undefined;
@@ -97,6 +98,11 @@ export class User extends pulumi.ComponentResource {
  * The set of arguments for constructing a User resource.
  */
 export interface UserArgs {
+    /**
+     * Whether managed policies not declared for the user are detached from it. Not supported for users
+     * by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
+     */
+    exclusivePolicyAttachments?: pulumi.Input<boolean>;
     /**
      * When destroying this user, destroy even if it has non-Pulumi-managed IAM access keys, login profile or MFA devices. Without forceDestroy a user with non-Pulumi-managed access keys and login profile will fail to be destroyed.
      */
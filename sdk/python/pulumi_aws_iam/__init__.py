@@ -5,19 +5,27 @@
 from . import _utilities
 import typing
 # Export this package's modules as members:
+from .abac_policy import *
 from .account import *
 from .assumable_role import *
 from .assumable_role_with_oidc import *
 from .assumable_role_with_saml import *
 from .assumable_roles import *
 from .assumable_roles_with_saml import *
+from .aws_config_profiles import *
+from .break_glass_role import *
+from .eks_addon_policy import *
+from .eks_cluster_role import *
+from .eks_node_role import *
 from .eks_role import *
 from .group_with_assumable_roles_policy import *
 from .group_with_policies import *
+from .instance_profile import *
 from .policy import *
 from .provider import *
 from .read_only_policy import *
 from .role_for_service_accounts_eks import *
+from .saml_provider import *
 from .user import *
 from ._inputs import *
 from . import outputs
@@ -29,18 +37,26 @@ _utilities.register(
   "mod": "index",
   "fqn": "pulumi_aws_iam",
   "classes": {
+   "aws-iam:index:AWSConfigProfiles": "AWSConfigProfiles",
+   "aws-iam:index:AbacPolicy": "AbacPolicy",
    "aws-iam:index:Account": "Account",
    "aws-iam:index:AssumableRole": "AssumableRole",
    "aws-iam:index:AssumableRoleWithOIDC": "AssumableRoleWithOIDC",
    "aws-iam:index:AssumableRoleWithSAML": "AssumableRoleWithSAML",
    "aws-iam:index:AssumableRoles": "AssumableRoles",
    "aws-iam:index:AssumableRolesWithSAML": "AssumableRolesWithSAML",
+   "aws-iam:index:BreakGlassRole": "BreakGlassRole",
+   "aws-iam:index:EKSAddonPolicy": "EKSAddonPolicy",
+   "aws-iam:index:EKSClusterRole": "EKSClusterRole",
+   "aws-iam:index:EKSNodeRole": "EKSNodeRole",
    "aws-iam:index:EKSRole": "EKSRole",
    "aws-iam:index:GroupWithAssumableRolesPolicy": "GroupWithAssumableRolesPolicy",
    "aws-iam:index:GroupWithPolicies": "GroupWithPolicies",
+   "aws-iam:index:InstanceProfile": "InstanceProfile",
    "aws-iam:index:Policy": "Policy",
    "aws-iam:index:ReadOnlyPolicy": "ReadOnlyPolicy",
    "aws-iam:index:RoleForServiceAccountsEks": "RoleForServiceAccountsEks",
+   "aws-iam:index:SAMLProvider": "SAMLProvider",
    "aws-iam:index:User": "User"
   }
  }
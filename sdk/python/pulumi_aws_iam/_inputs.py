@@ -10,91 +10,552 @@ from typing import Any, Mapping, Optional, Sequence, Union, overload
 from . import _utilities
 
 __all__ = [
+    'AWSAuthRoleArgs',
+    'AWSAuthArgs',
+    'AWSConfigRoleArgs',
+    'AWSConfigSourceArgs',
+    'AbacActionGroupArgs',
     'AccountPasswordPolicyArgs',
     'AdminRoleWithMFAArgs',
     'AdminRoleArgs',
+    'AssumableRoleWithOIDCProviderArgs',
+    'EKSADOTPolicyArgs',
     'EKSAmazonManagedServicePrometheusPolicyArgs',
     'EKSAppmeshPolicyArgs',
     'EKSCertManagerPolicyArgs',
+    'EKSCloudWatchObservabilityPolicyArgs',
     'EKSClusterAutoscalerPolicyArgs',
     'EKSEBSCSIPolicyArgs',
     'EKSEFSCSIPolicyArgs',
     'EKSExternalDNSPolicyArgs',
     'EKSExternalSecretsPolicyArgs',
+    'EKSGatewayAPIControllerPolicyArgs',
+    'EKSKEDAPolicyArgs',
     'EKSKarpenterControllerPolicyArgs',
     'EKSLoadBalancerPolicyArgs',
+    'EKSMountpointS3CSIPolicyArgs',
     'EKSNodeTerminationHandlerPolicyArgs',
     'EKSRolePoliciesArgs',
+    'EKSSecretsStoreCSIPolicyArgs',
+    'EKSServiceAccountManifestOptionsArgs',
     'EKSServiceAccountRoleArgs',
     'EKSServiceAccountArgs',
     'EKSVPNCNIPolicyArgs',
     'EKSVeleroPolicyArgs',
     'FSxLustreCSIPolicyArgs',
+    'InstanceProfileSettingsArgs',
     'OIDCProviderArgs',
+    'PolicyConditionArgs',
     'PoweruserRoleWithMFAArgs',
     'PoweruserRoleArgs',
     'ReadonlyRoleWithMFAArgs',
     'ReadonlyRoleArgs',
+    'RoleSessionArgs',
     'RoleWithMFAArgs',
     'RoleArgs',
 ]
 
+@pulumi.input_type
+class AWSAuthRoleArgs:
+    def __init__(__self__, *,
+                 groups: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 username: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] groups: Kubernetes groups of the role, e.g. `system:masters`.
+        :param pulumi.Input[str] username: Kubernetes username of the role. Defaults to `<role name>:{{SessionName}}`.
+        """
+        if groups is not None:
+            pulumi.set(__self__, "groups", groups)
+        if username is not None:
+            pulumi.set(__self__, "username", username)
+
+    @property
+    @pulumi.getter
+    def groups(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Kubernetes groups of the role, e.g. `system:masters`.
+        """
+        return pulumi.get(self, "groups")
+
+    @groups.setter
+    def groups(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "groups", value)
+
+    @property
+    @pulumi.getter
+    def username(self) -> Optional[pulumi.Input[str]]:
+        """
+        Kubernetes username of the role. Defaults to `<role name>:{{SessionName}}`.
+        """
+        return pulumi.get(self, "username")
+
+    @username.setter
+    def username(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "username", value)
+
+
+@pulumi.input_type
+class AWSAuthArgs:
+    def __init__(__self__, *,
+                 admin: Optional[pulumi.Input['AWSAuthRoleArgs']] = None,
+                 poweruser: Optional[pulumi.Input['AWSAuthRoleArgs']] = None,
+                 readonly: Optional[pulumi.Input['AWSAuthRoleArgs']] = None):
+        """
+        :param pulumi.Input['AWSAuthRoleArgs'] admin: Mapping of the admin role.
+        :param pulumi.Input['AWSAuthRoleArgs'] poweruser: Mapping of the poweruser role.
+        :param pulumi.Input['AWSAuthRoleArgs'] readonly: Mapping of the readonly role.
+        """
+        if admin is not None:
+            pulumi.set(__self__, "admin", admin)
+        if poweruser is not None:
+            pulumi.set(__self__, "poweruser", poweruser)
+        if readonly is not None:
+            pulumi.set(__self__, "readonly", readonly)
+
+    @property
+    @pulumi.getter
+    def admin(self) -> Optional[pulumi.Input['AWSAuthRoleArgs']]:
+        """
+        Mapping of the admin role.
+        """
+        return pulumi.get(self, "admin")
+
+    @admin.setter
+    def admin(self, value: Optional[pulumi.Input['AWSAuthRoleArgs']]):
+        pulumi.set(self, "admin", value)
+
+    @property
+    @pulumi.getter
+    def poweruser(self) -> Optional[pulumi.Input['AWSAuthRoleArgs']]:
+        """
+        Mapping of the poweruser role.
+        """
+        return pulumi.get(self, "poweruser")
+
+    @poweruser.setter
+    def poweruser(self, value: Optional[pulumi.Input['AWSAuthRoleArgs']]):
+        pulumi.set(self, "poweruser", value)
+
+    @property
+    @pulumi.getter
+    def readonly(self) -> Optional[pulumi.Input['AWSAuthRoleArgs']]:
+        """
+        Mapping of the readonly role.
+        """
+        return pulumi.get(self, "readonly")
+
+    @readonly.setter
+    def readonly(self, value: Optional[pulumi.Input['AWSAuthRoleArgs']]):
+        pulumi.set(self, "readonly", value)
+
+
+@pulumi.input_type
+class AWSConfigRoleArgs:
+    def __init__(__self__, *,
+                 profile_name: pulumi.Input[str],
+                 role_arn: pulumi.Input[str],
+                 duration_seconds: Optional[pulumi.Input[int]] = None,
+                 external_id: Optional[pulumi.Input[str]] = None,
+                 requires_mfa: Optional[pulumi.Input[bool]] = None):
+        """
+        :param pulumi.Input[str] profile_name: Name of the profile, without the profile prefix.
+        :param pulumi.Input[str] role_arn: ARN of the IAM role.
+        :param pulumi.Input[int] duration_seconds: Session duration in seconds.
+        :param pulumi.Input[str] external_id: STS ExternalId to pass when assuming the role.
+        :param pulumi.Input[bool] requires_mfa: Whether the role requires MFA.
+        """
+        pulumi.set(__self__, "profile_name", profile_name)
+        pulumi.set(__self__, "role_arn", role_arn)
+        if duration_seconds is not None:
+            pulumi.set(__self__, "duration_seconds", duration_seconds)
+        if external_id is not None:
+            pulumi.set(__self__, "external_id", external_id)
+        if requires_mfa is None:
+            requires_mfa = False
+        if requires_mfa is not None:
+            pulumi.set(__self__, "requires_mfa", requires_mfa)
+
+    @property
+    @pulumi.getter(name="profileName")
+    def profile_name(self) -> pulumi.Input[str]:
+        """
+        Name of the profile, without the profile prefix.
+        """
+        return pulumi.get(self, "profile_name")
+
+    @profile_name.setter
+    def profile_name(self, value: pulumi.Input[str]):
+        pulumi.set(self, "profile_name", value)
+
+    @property
+    @pulumi.getter(name="roleArn")
+    def role_arn(self) -> pulumi.Input[str]:
+        """
+        ARN of the IAM role.
+        """
+        return pulumi.get(self, "role_arn")
+
+    @role_arn.setter
+    def role_arn(self, value: pulumi.Input[str]):
+        pulumi.set(self, "role_arn", value)
+
+    @property
+    @pulumi.getter(name="durationSeconds")
+    def duration_seconds(self) -> Optional[pulumi.Input[int]]:
+        """
+        Session duration in seconds.
+        """
+        return pulumi.get(self, "duration_seconds")
+
+    @duration_seconds.setter
+    def duration_seconds(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "duration_seconds", value)
+
+    @property
+    @pulumi.getter(name="externalId")
+    def external_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        STS ExternalId to pass when assuming the role.
+        """
+        return pulumi.get(self, "external_id")
+
+    @external_id.setter
+    def external_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "external_id", value)
+
+    @property
+    @pulumi.getter(name="requiresMfa")
+    def requires_mfa(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the role requires MFA.
+        """
+        return pulumi.get(self, "requires_mfa")
+
+    @requires_mfa.setter
+    def requires_mfa(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "requires_mfa", value)
+
+
+@pulumi.input_type
+class AWSConfigSourceArgs:
+    def __init__(__self__, *,
+                 credential_process: Optional[pulumi.Input[str]] = None,
+                 mfa_serial: Optional[pulumi.Input[str]] = None,
+                 profile_prefix: Optional[pulumi.Input[str]] = None,
+                 region: Optional[pulumi.Input[str]] = None,
+                 source_profile: Optional[pulumi.Input[str]] = None,
+                 sso_account_id: Optional[pulumi.Input[str]] = None,
+                 sso_region: Optional[pulumi.Input[str]] = None,
+                 sso_role_name: Optional[pulumi.Input[str]] = None,
+                 sso_session: Optional[pulumi.Input[str]] = None,
+                 sso_start_url: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] credential_process: Command printing the credentials which assume the roles. When it contains `{role_arn}` it is run for
+               each role with its ARN instead, e.g. to sign in to SAML roles with saml2aws.
+        :param pulumi.Input[str] mfa_serial: ARN of the MFA device used for roles requiring MFA.
+        :param pulumi.Input[str] profile_prefix: Prefix added to the name of each role profile.
+        :param pulumi.Input[str] region: Default region of the profiles.
+        :param pulumi.Input[str] source_profile: Profile whose credentials assume the roles. Defaults to `default` when no other source is set.
+        :param pulumi.Input[str] sso_account_id: Account ID of the IAM Identity Center permission set assuming the roles.
+        :param pulumi.Input[str] sso_region: Region of the IAM Identity Center session.
+        :param pulumi.Input[str] sso_role_name: Name of the IAM Identity Center permission set assuming the roles.
+        :param pulumi.Input[str] sso_session: Name of the IAM Identity Center session whose credentials assume the roles.
+        :param pulumi.Input[str] sso_start_url: Start URL of the IAM Identity Center session.
+        """
+        if credential_process is not None:
+            pulumi.set(__self__, "credential_process", credential_process)
+        if mfa_serial is not None:
+            pulumi.set(__self__, "mfa_serial", mfa_serial)
+        if profile_prefix is not None:
+            pulumi.set(__self__, "profile_prefix", profile_prefix)
+        if region is not None:
+            pulumi.set(__self__, "region", region)
+        if source_profile is not None:
+            pulumi.set(__self__, "source_profile", source_profile)
+        if sso_account_id is not None:
+            pulumi.set(__self__, "sso_account_id", sso_account_id)
+        if sso_region is not None:
+            pulumi.set(__self__, "sso_region", sso_region)
+        if sso_role_name is not None:
+            pulumi.set(__self__, "sso_role_name", sso_role_name)
+        if sso_session is not None:
+            pulumi.set(__self__, "sso_session", sso_session)
+        if sso_start_url is not None:
+            pulumi.set(__self__, "sso_start_url", sso_start_url)
+
+    @property
+    @pulumi.getter(name="credentialProcess")
+    def credential_process(self) -> Optional[pulumi.Input[str]]:
+        """
+        Command printing the credentials which assume the roles. When it contains `{role_arn}` it is run for
+        each role with its ARN instead, e.g. to sign in to SAML roles with saml2aws.
+        """
+        return pulumi.get(self, "credential_process")
+
+    @credential_process.setter
+    def credential_process(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "credential_process", value)
+
+    @property
+    @pulumi.getter(name="mfaSerial")
+    def mfa_serial(self) -> Optional[pulumi.Input[str]]:
+        """
+        ARN of the MFA device used for roles requiring MFA.
+        """
+        return pulumi.get(self, "mfa_serial")
+
+    @mfa_serial.setter
+    def mfa_serial(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "mfa_serial", value)
+
+    @property
+    @pulumi.getter(name="profilePrefix")
+    def profile_prefix(self) -> Optional[pulumi.Input[str]]:
+        """
+        Prefix added to the name of each role profile.
+        """
+        return pulumi.get(self, "profile_prefix")
+
+    @profile_prefix.setter
+    def profile_prefix(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "profile_prefix", value)
+
+    @property
+    @pulumi.getter
+    def region(self) -> Optional[pulumi.Input[str]]:
+        """
+        Default region of the profiles.
+        """
+        return pulumi.get(self, "region")
+
+    @region.setter
+    def region(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "region", value)
+
+    @property
+    @pulumi.getter(name="sourceProfile")
+    def source_profile(self) -> Optional[pulumi.Input[str]]:
+        """
+        Profile whose credentials assume the roles. Defaults to `default` when no other source is set.
+        """
+        return pulumi.get(self, "source_profile")
+
+    @source_profile.setter
+    def source_profile(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "source_profile", value)
+
+    @property
+    @pulumi.getter(name="ssoAccountId")
+    def sso_account_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        Account ID of the IAM Identity Center permission set assuming the roles.
+        """
+        return pulumi.get(self, "sso_account_id")
+
+    @sso_account_id.setter
+    def sso_account_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "sso_account_id", value)
+
+    @property
+    @pulumi.getter(name="ssoRegion")
+    def sso_region(self) -> Optional[pulumi.Input[str]]:
+        """
+        Region of the IAM Identity Center session.
+        """
+        return pulumi.get(self, "sso_region")
+
+    @sso_region.setter
+    def sso_region(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "sso_region", value)
+
+    @property
+    @pulumi.getter(name="ssoRoleName")
+    def sso_role_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the IAM Identity Center permission set assuming the roles.
+        """
+        return pulumi.get(self, "sso_role_name")
+
+    @sso_role_name.setter
+    def sso_role_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "sso_role_name", value)
+
+    @property
+    @pulumi.getter(name="ssoSession")
+    def sso_session(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the IAM Identity Center session whose credentials assume the roles.
+        """
+        return pulumi.get(self, "sso_session")
+
+    @sso_session.setter
+    def sso_session(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "sso_session", value)
+
+    @property
+    @pulumi.getter(name="ssoStartUrl")
+    def sso_start_url(self) -> Optional[pulumi.Input[str]]:
+        """
+        Start URL of the IAM Identity Center session.
+        """
+        return pulumi.get(self, "sso_start_url")
+
+    @sso_start_url.setter
+    def sso_start_url(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "sso_start_url", value)
+
+
+@pulumi.input_type
+class AbacActionGroupArgs:
+    def __init__(__self__, *,
+                 service: pulumi.Input[str],
+                 actions: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 create_actions: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 resources: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
+        """
+        A group of actions of a service to allow based on matching tags.
+        :param pulumi.Input[str] service: IAM prefix of the service, e.g. `ec2`.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] actions: Actions allowed on resources whose tags match the principal tags. Actions without a service
+               prefix are prefixed with the service.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] create_actions: Actions creating resources, only allowed when the request tags match the principal tags.
+        :param pulumi.Input[str] name: Name of the group, used as the statement ID and unique across the groups. Defaults to the service, suffixed with the position of the group when the service is used by more than one unnamed group.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] resources: Resources the actions apply to. Defaults to `*`.
+        """
+        pulumi.set(__self__, "service", service)
+        if actions is not None:
+            pulumi.set(__self__, "actions", actions)
+        if create_actions is not None:
+            pulumi.set(__self__, "create_actions", create_actions)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if resources is not None:
+            pulumi.set(__self__, "resources", resources)
+
+    @property
+    @pulumi.getter
+    def service(self) -> pulumi.Input[str]:
+        """
+        IAM prefix of the service, e.g. `ec2`.
+        """
+        return pulumi.get(self, "service")
+
+    @service.setter
+    def service(self, value: pulumi.Input[str]):
+        pulumi.set(self, "service", value)
+
+    @property
+    @pulumi.getter
+    def actions(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Actions allowed on resources whose tags match the principal tags. Actions without a service
+        prefix are prefixed with the service.
+        """
+        return pulumi.get(self, "actions")
+
+    @actions.setter
+    def actions(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "actions", value)
+
+    @property
+    @pulumi.getter(name="createActions")
+    def create_actions(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Actions creating resources, only allowed when the request tags match the principal tags.
+        """
+        return pulumi.get(self, "create_actions")
+
+    @create_actions.setter
+    def create_actions(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "create_actions", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the group, used as the statement ID and unique across the groups. Defaults to the service, suffixed with the position of the group when the service is used by more than one unnamed group.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def resources(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Resources the actions apply to. Defaults to `*`.
+        """
+        return pulumi.get(self, "resources")
+
+    @resources.setter
+    def resources(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "resources", value)
+
+
 @pulumi.input_type
 class AccountPasswordPolicyArgs:
     def __init__(__self__, *,
-                 allow_users_to_change: pulumi.Input[bool],
-                 hard_expiry: pulumi.Input[bool],
-                 require_lowercase_characters: pulumi.Input[bool],
-                 require_numbers: pulumi.Input[bool],
-                 require_symbols: pulumi.Input[bool],
-                 require_uppercase_characters: pulumi.Input[bool],
+                 allow_users_to_change: Optional[pulumi.Input[bool]] = None,
+                 hard_expiry: Optional[pulumi.Input[bool]] = None,
                  max_age: Optional[pulumi.Input[int]] = None,
                  minimum_length: Optional[pulumi.Input[int]] = None,
+                 require_lowercase_characters: Optional[pulumi.Input[bool]] = None,
+                 require_numbers: Optional[pulumi.Input[bool]] = None,
+                 require_symbols: Optional[pulumi.Input[bool]] = None,
+                 require_uppercase_characters: Optional[pulumi.Input[bool]] = None,
                  reuse_prevention: Optional[pulumi.Input[int]] = None):
         """
         Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords.
         :param pulumi.Input[bool] allow_users_to_change: Whether to allow users to change their own password.
         :param pulumi.Input[bool] hard_expiry: Whether users are prevented from setting a new password after their password has
                expired (i.e. require administrator reset).
-        :param pulumi.Input[bool] require_lowercase_characters: Whether to require lowercase characters for user passwords.
-        :param pulumi.Input[bool] require_numbers: Whether to require numbers for user passwords.
-        :param pulumi.Input[bool] require_symbols: Whether to require symbols for user passwords.
-        :param pulumi.Input[bool] require_uppercase_characters: Whether to require uppercase characters for user passwords.
         :param pulumi.Input[int] max_age: The number of days that an user password is valid. If not set or a value of `0` is provided, then
                passwords will not expire.
         :param pulumi.Input[int] minimum_length: Minimum length to require for user passwords. Defaults to `8` if not set or
                the provided value is invalid. Valid values are between 6 and 128.
+        :param pulumi.Input[bool] require_lowercase_characters: Whether to require lowercase characters for user passwords.
+        :param pulumi.Input[bool] require_numbers: Whether to require numbers for user passwords.
+        :param pulumi.Input[bool] require_symbols: Whether to require symbols for user passwords.
+        :param pulumi.Input[bool] require_uppercase_characters: Whether to require uppercase characters for user passwords.
         :param pulumi.Input[int] reuse_prevention: The number of previous passwords that users are prevented from reusing. If not set or a
                value of `0` is provided, no reuse prevention policy will be used.
         """
-        pulumi.set(__self__, "allow_users_to_change", allow_users_to_change)
-        pulumi.set(__self__, "hard_expiry", hard_expiry)
-        pulumi.set(__self__, "require_lowercase_characters", require_lowercase_characters)
-        pulumi.set(__self__, "require_numbers", require_numbers)
-        pulumi.set(__self__, "require_symbols", require_symbols)
-        pulumi.set(__self__, "require_uppercase_characters", require_uppercase_characters)
+        if allow_users_to_change is not None:
+            pulumi.set(__self__, "allow_users_to_change", allow_users_to_change)
+        if hard_expiry is not None:
+            pulumi.set(__self__, "hard_expiry", hard_expiry)
         if max_age is not None:
             pulumi.set(__self__, "max_age", max_age)
         if minimum_length is not None:
             pulumi.set(__self__, "minimum_length", minimum_length)
+        if require_lowercase_characters is not None:
+            pulumi.set(__self__, "require_lowercase_characters", require_lowercase_characters)
+        if require_numbers is not None:
+            pulumi.set(__self__, "require_numbers", require_numbers)
+        if require_symbols is not None:
+            pulumi.set(__self__, "require_symbols", require_symbols)
+        if require_uppercase_characters is not None:
+            pulumi.set(__self__, "require_uppercase_characters", require_uppercase_characters)
         if reuse_prevention is not None:
             pulumi.set(__self__, "reuse_prevention", reuse_prevention)
 
     @property
     @pulumi.getter(name="allowUsersToChange")
-    def allow_users_to_change(self) -> pulumi.Input[bool]:
+    def allow_users_to_change(self) -> Optional[pulumi.Input[bool]]:
         """
         Whether to allow users to change their own password.
         """
         return pulumi.get(self, "allow_users_to_change")
 
     @allow_users_to_change.setter
-    def allow_users_to_change(self, value: pulumi.Input[bool]):
+    def allow_users_to_change(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "allow_users_to_change", value)
 
     @property
     @pulumi.getter(name="hardExpiry")
-    def hard_expiry(self) -> pulumi.Input[bool]:
+    def hard_expiry(self) -> Optional[pulumi.Input[bool]]:
         """
         Whether users are prevented from setting a new password after their password has
         expired (i.e. require administrator reset).
@@ -102,83 +563,83 @@ class AccountPasswordPolicyArgs:
         return pulumi.get(self, "hard_expiry")
 
     @hard_expiry.setter
-    def hard_expiry(self, value: pulumi.Input[bool]):
+    def hard_expiry(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "hard_expiry", value)
 
+    @property
+    @pulumi.getter(name="maxAge")
+    def max_age(self) -> Optional[pulumi.Input[int]]:
+        """
+        The number of days that an user password is valid. If not set or a value of `0` is provided, then
+        passwords will not expire.
+        """
+        return pulumi.get(self, "max_age")
+
+    @max_age.setter
+    def max_age(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "max_age", value)
+
+    @property
+    @pulumi.getter(name="minimumLength")
+    def minimum_length(self) -> Optional[pulumi.Input[int]]:
+        """
+        Minimum length to require for user passwords. Defaults to `8` if not set or
+        the provided value is invalid. Valid values are between 6 and 128.
+        """
+        return pulumi.get(self, "minimum_length")
+
+    @minimum_length.setter
+    def minimum_length(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "minimum_length", value)
+
     @property
     @pulumi.getter(name="requireLowercaseCharacters")
-    def require_lowercase_characters(self) -> pulumi.Input[bool]:
+    def require_lowercase_characters(self) -> Optional[pulumi.Input[bool]]:
         """
         Whether to require lowercase characters for user passwords.
         """
         return pulumi.get(self, "require_lowercase_characters")
 
     @require_lowercase_characters.setter
-    def require_lowercase_characters(self, value: pulumi.Input[bool]):
+    def require_lowercase_characters(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "require_lowercase_characters", value)
 
     @property
     @pulumi.getter(name="requireNumbers")
-    def require_numbers(self) -> pulumi.Input[bool]:
+    def require_numbers(self) -> Optional[pulumi.Input[bool]]:
         """
         Whether to require numbers for user passwords.
         """
         return pulumi.get(self, "require_numbers")
 
     @require_numbers.setter
-    def require_numbers(self, value: pulumi.Input[bool]):
+    def require_numbers(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "require_numbers", value)
 
     @property
     @pulumi.getter(name="requireSymbols")
-    def require_symbols(self) -> pulumi.Input[bool]:
+    def require_symbols(self) -> Optional[pulumi.Input[bool]]:
         """
         Whether to require symbols for user passwords.
         """
         return pulumi.get(self, "require_symbols")
 
     @require_symbols.setter
-    def require_symbols(self, value: pulumi.Input[bool]):
+    def require_symbols(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "require_symbols", value)
 
     @property
     @pulumi.getter(name="requireUppercaseCharacters")
-    def require_uppercase_characters(self) -> pulumi.Input[bool]:
+    def require_uppercase_characters(self) -> Optional[pulumi.Input[bool]]:
         """
         Whether to require uppercase characters for user passwords.
         """
         return pulumi.get(self, "require_uppercase_characters")
 
     @require_uppercase_characters.setter
-    def require_uppercase_characters(self, value: pulumi.Input[bool]):
+    def require_uppercase_characters(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "require_uppercase_characters", value)
 
-    @property
-    @pulumi.getter(name="maxAge")
-    def max_age(self) -> Optional[pulumi.Input[int]]:
-        """
-        The number of days that an user password is valid. If not set or a value of `0` is provided, then
-        passwords will not expire.
-        """
-        return pulumi.get(self, "max_age")
-
-    @max_age.setter
-    def max_age(self, value: Optional[pulumi.Input[int]]):
-        pulumi.set(self, "max_age", value)
-
-    @property
-    @pulumi.getter(name="minimumLength")
-    def minimum_length(self) -> Optional[pulumi.Input[int]]:
-        """
-        Minimum length to require for user passwords. Defaults to `8` if not set or
-        the provided value is invalid. Valid values are between 6 and 128.
-        """
-        return pulumi.get(self, "minimum_length")
-
-    @minimum_length.setter
-    def minimum_length(self, value: Optional[pulumi.Input[int]]):
-        pulumi.set(self, "minimum_length", value)
-
     @property
     @pulumi.getter(name="reusePrevention")
     def reuse_prevention(self) -> Optional[pulumi.Input[int]]:
@@ -196,6 +657,9 @@ class AccountPasswordPolicyArgs:
 @pulumi.input_type
 class AdminRoleWithMFAArgs:
     def __init__(__self__, *,
+                 exclusive_inline_policies: Optional[pulumi.Input[bool]] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
+                 inline_policies: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  path: Optional[pulumi.Input[str]] = None,
                  permissions_boundary_arn: Optional[pulumi.Input[str]] = None,
@@ -204,6 +668,12 @@ class AdminRoleWithMFAArgs:
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
         """
         The admin role.
+        :param pulumi.Input[bool] exclusive_inline_policies: Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the role are detached from it. The role then manages its
+               policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+               attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+               policies. Previews of existing roles warn that undeclared policies are detached.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] inline_policies: Map of inline policy names to policy documents, lists of statements or single statements in JSON.
         :param pulumi.Input[str] name: IAM role with admin access.
         :param pulumi.Input[str] path: Path of admin IAM role.
         :param pulumi.Input[str] permissions_boundary_arn: Permissions boundary ARN to use for admin role.
@@ -211,6 +681,16 @@ class AdminRoleWithMFAArgs:
         :param pulumi.Input[bool] requires_mfa: Whether admin role requires MFA.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
+        if exclusive_inline_policies is None:
+            exclusive_inline_policies = False
+        if exclusive_inline_policies is not None:
+            pulumi.set(__self__, "exclusive_inline_policies", exclusive_inline_policies)
+        if exclusive_policy_attachments is None:
+            exclusive_policy_attachments = False
+        if exclusive_policy_attachments is not None:
+            pulumi.set(__self__, "exclusive_policy_attachments", exclusive_policy_attachments)
+        if inline_policies is not None:
+            pulumi.set(__self__, "inline_policies", inline_policies)
         if name is not None:
             pulumi.set(__self__, "name", name)
         if path is not None:
@@ -224,6 +704,45 @@ class AdminRoleWithMFAArgs:
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
 
+    @property
+    @pulumi.getter(name="exclusiveInlinePolicies")
+    def exclusive_inline_policies(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        """
+        return pulumi.get(self, "exclusive_inline_policies")
+
+    @exclusive_inline_policies.setter
+    def exclusive_inline_policies(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_inline_policies", value)
+
+    @property
+    @pulumi.getter(name="exclusivePolicyAttachments")
+    def exclusive_policy_attachments(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether managed policies not declared for the role are detached from it. The role then manages its
+        policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+        attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+        policies. Previews of existing roles warn that undeclared policies are detached.
+        """
+        return pulumi.get(self, "exclusive_policy_attachments")
+
+    @exclusive_policy_attachments.setter
+    def exclusive_policy_attachments(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_policy_attachments", value)
+
+    @property
+    @pulumi.getter(name="inlinePolicies")
+    def inline_policies(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+        """
+        return pulumi.get(self, "inline_policies")
+
+    @inline_policies.setter
+    def inline_policies(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "inline_policies", value)
+
     @property
     @pulumi.getter
     def name(self) -> Optional[pulumi.Input[str]]:
@@ -300,6 +819,9 @@ class AdminRoleWithMFAArgs:
 @pulumi.input_type
 class AdminRoleArgs:
     def __init__(__self__, *,
+                 exclusive_inline_policies: Optional[pulumi.Input[bool]] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
+                 inline_policies: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  path: Optional[pulumi.Input[str]] = None,
                  permissions_boundary_arn: Optional[pulumi.Input[str]] = None,
@@ -307,12 +829,28 @@ class AdminRoleArgs:
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
         """
         The admin role.
+        :param pulumi.Input[bool] exclusive_inline_policies: Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the role are detached from it. The role then manages its
+               policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+               attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+               policies. Previews of existing roles warn that undeclared policies are detached.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] inline_policies: Map of inline policy names to policy documents, lists of statements or single statements in JSON.
         :param pulumi.Input[str] name: IAM role with admin access.
         :param pulumi.Input[str] path: Path of admin IAM role. Defaults to '/'
         :param pulumi.Input[str] permissions_boundary_arn: Permissions boundary ARN to use for admin role.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] policy_arns: List of policy ARNs to use for admin role.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
+        if exclusive_inline_policies is None:
+            exclusive_inline_policies = False
+        if exclusive_inline_policies is not None:
+            pulumi.set(__self__, "exclusive_inline_policies", exclusive_inline_policies)
+        if exclusive_policy_attachments is None:
+            exclusive_policy_attachments = False
+        if exclusive_policy_attachments is not None:
+            pulumi.set(__self__, "exclusive_policy_attachments", exclusive_policy_attachments)
+        if inline_policies is not None:
+            pulumi.set(__self__, "inline_policies", inline_policies)
         if name is None:
             name = 'admin'
         if name is not None:
@@ -326,6 +864,45 @@ class AdminRoleArgs:
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
 
+    @property
+    @pulumi.getter(name="exclusiveInlinePolicies")
+    def exclusive_inline_policies(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        """
+        return pulumi.get(self, "exclusive_inline_policies")
+
+    @exclusive_inline_policies.setter
+    def exclusive_inline_policies(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_inline_policies", value)
+
+    @property
+    @pulumi.getter(name="exclusivePolicyAttachments")
+    def exclusive_policy_attachments(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether managed policies not declared for the role are detached from it. The role then manages its
+        policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+        attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+        policies. Previews of existing roles warn that undeclared policies are detached.
+        """
+        return pulumi.get(self, "exclusive_policy_attachments")
+
+    @exclusive_policy_attachments.setter
+    def exclusive_policy_attachments(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_policy_attachments", value)
+
+    @property
+    @pulumi.getter(name="inlinePolicies")
+    def inline_policies(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+        """
+        return pulumi.get(self, "inline_policies")
+
+    @inline_policies.setter
+    def inline_policies(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "inline_policies", value)
+
     @property
     @pulumi.getter
     def name(self) -> Optional[pulumi.Input[str]]:
@@ -387,10 +964,186 @@ class AdminRoleArgs:
         pulumi.set(self, "tags", value)
 
 
+@pulumi.input_type
+class AssumableRoleWithOIDCProviderArgs:
+    def __init__(__self__, *,
+                 url: pulumi.Input[str],
+                 aws_account_id: Optional[pulumi.Input[str]] = None,
+                 oidc_fully_qualified_audiences: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 oidc_fully_qualified_subjects: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 oidc_subjects_with_wildcards: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
+        """
+        :param pulumi.Input[str] url: URL of the OIDC Provider.
+        :param pulumi.Input[str] aws_account_id: The AWS account ID where the OIDC provider lives, defaults to `awsAccountId`.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] oidc_fully_qualified_audiences: The audiences of this provider, replacing `oidcFullyQualifiedAudiences` when set.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] oidc_fully_qualified_subjects: The fully qualified OIDC subjects of this provider, replacing `oidcFullyQualifiedSubjects` when set.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] oidc_subjects_with_wildcards: The OIDC subjects using wildcards of this provider, replacing `oidcSubjectsWithWildcards` when set.
+        """
+        pulumi.set(__self__, "url", url)
+        if aws_account_id is not None:
+            pulumi.set(__self__, "aws_account_id", aws_account_id)
+        if oidc_fully_qualified_audiences is not None:
+            pulumi.set(__self__, "oidc_fully_qualified_audiences", oidc_fully_qualified_audiences)
+        if oidc_fully_qualified_subjects is not None:
+            pulumi.set(__self__, "oidc_fully_qualified_subjects", oidc_fully_qualified_subjects)
+        if oidc_subjects_with_wildcards is not None:
+            pulumi.set(__self__, "oidc_subjects_with_wildcards", oidc_subjects_with_wildcards)
+
+    @property
+    @pulumi.getter
+    def url(self) -> pulumi.Input[str]:
+        """
+        URL of the OIDC Provider.
+        """
+        return pulumi.get(self, "url")
+
+    @url.setter
+    def url(self, value: pulumi.Input[str]):
+        pulumi.set(self, "url", value)
+
+    @property
+    @pulumi.getter(name="awsAccountId")
+    def aws_account_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The AWS account ID where the OIDC provider lives, defaults to `awsAccountId`.
+        """
+        return pulumi.get(self, "aws_account_id")
+
+    @aws_account_id.setter
+    def aws_account_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "aws_account_id", value)
+
+    @property
+    @pulumi.getter(name="oidcFullyQualifiedAudiences")
+    def oidc_fully_qualified_audiences(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The audiences of this provider, replacing `oidcFullyQualifiedAudiences` when set.
+        """
+        return pulumi.get(self, "oidc_fully_qualified_audiences")
+
+    @oidc_fully_qualified_audiences.setter
+    def oidc_fully_qualified_audiences(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "oidc_fully_qualified_audiences", value)
+
+    @property
+    @pulumi.getter(name="oidcFullyQualifiedSubjects")
+    def oidc_fully_qualified_subjects(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The fully qualified OIDC subjects of this provider, replacing `oidcFullyQualifiedSubjects` when set.
+        """
+        return pulumi.get(self, "oidc_fully_qualified_subjects")
+
+    @oidc_fully_qualified_subjects.setter
+    def oidc_fully_qualified_subjects(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "oidc_fully_qualified_subjects", value)
+
+    @property
+    @pulumi.getter(name="oidcSubjectsWithWildcards")
+    def oidc_subjects_with_wildcards(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The OIDC subjects using wildcards of this provider, replacing `oidcSubjectsWithWildcards` when set.
+        """
+        return pulumi.get(self, "oidc_subjects_with_wildcards")
+
+    @oidc_subjects_with_wildcards.setter
+    def oidc_subjects_with_wildcards(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "oidc_subjects_with_wildcards", value)
+
+
+@pulumi.input_type
+class EKSADOTPolicyArgs:
+    def __init__(__self__, *,
+                 amp_workspace_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 attach: Optional[pulumi.Input[bool]] = None,
+                 enable_amp: Optional[pulumi.Input[bool]] = None,
+                 enable_cloudwatch: Optional[pulumi.Input[bool]] = None,
+                 enable_xray: Optional[pulumi.Input[bool]] = None):
+        """
+        The AWS Distro for OpenTelemetry IAM policy to the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] amp_workspace_arns: List of AMP Workspace ARNs to write metrics to. If not provided, a default ARN of "*"
+               will be provided.
+        :param pulumi.Input[bool] attach: Determines whether to attach the AWS Distro for OpenTelemetry IAM policy to the role.
+        :param pulumi.Input[bool] enable_amp: Allows exporting metrics to Amazon Managed Service for Prometheus.
+        :param pulumi.Input[bool] enable_cloudwatch: Allows exporting metrics and logs to CloudWatch.
+        :param pulumi.Input[bool] enable_xray: Allows exporting traces to AWS X-Ray.
+        """
+        if amp_workspace_arns is not None:
+            pulumi.set(__self__, "amp_workspace_arns", amp_workspace_arns)
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
+        if enable_amp is not None:
+            pulumi.set(__self__, "enable_amp", enable_amp)
+        if enable_cloudwatch is not None:
+            pulumi.set(__self__, "enable_cloudwatch", enable_cloudwatch)
+        if enable_xray is not None:
+            pulumi.set(__self__, "enable_xray", enable_xray)
+
+    @property
+    @pulumi.getter(name="ampWorkspaceArns")
+    def amp_workspace_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of AMP Workspace ARNs to write metrics to. If not provided, a default ARN of "*"
+        will be provided.
+        """
+        return pulumi.get(self, "amp_workspace_arns")
+
+    @amp_workspace_arns.setter
+    def amp_workspace_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "amp_workspace_arns", value)
+
+    @property
+    @pulumi.getter
+    def attach(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Determines whether to attach the AWS Distro for OpenTelemetry IAM policy to the role.
+        """
+        return pulumi.get(self, "attach")
+
+    @attach.setter
+    def attach(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "attach", value)
+
+    @property
+    @pulumi.getter(name="enableAmp")
+    def enable_amp(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Allows exporting metrics to Amazon Managed Service for Prometheus.
+        """
+        return pulumi.get(self, "enable_amp")
+
+    @enable_amp.setter
+    def enable_amp(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enable_amp", value)
+
+    @property
+    @pulumi.getter(name="enableCloudwatch")
+    def enable_cloudwatch(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Allows exporting metrics and logs to CloudWatch.
+        """
+        return pulumi.get(self, "enable_cloudwatch")
+
+    @enable_cloudwatch.setter
+    def enable_cloudwatch(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enable_cloudwatch", value)
+
+    @property
+    @pulumi.getter(name="enableXray")
+    def enable_xray(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Allows exporting traces to AWS X-Ray.
+        """
+        return pulumi.get(self, "enable_xray")
+
+    @enable_xray.setter
+    def enable_xray(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enable_xray", value)
+
+
 @pulumi.input_type
 class EKSAmazonManagedServicePrometheusPolicyArgs:
     def __init__(__self__, *,
-                 attach: pulumi.Input[bool],
+                 attach: Optional[pulumi.Input[bool]] = None,
                  workspace_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
         The Amazon Managed Service for Prometheus IAM policy to the role.
@@ -398,20 +1151,21 @@ class EKSAmazonManagedServicePrometheusPolicyArgs:
         :param pulumi.Input[Sequence[pulumi.Input[str]]] workspace_arns: List of AMP Workspace ARNs to read and write metrics. If not provided, a default ARN of "*"
                will be provided.
         """
-        pulumi.set(__self__, "attach", attach)
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
         if workspace_arns is not None:
             pulumi.set(__self__, "workspace_arns", workspace_arns)
 
     @property
     @pulumi.getter
-    def attach(self) -> pulumi.Input[bool]:
+    def attach(self) -> Optional[pulumi.Input[bool]]:
         """
         Determines whether to attach the Amazon Managed Service for Prometheus IAM policy to the role.
         """
         return pulumi.get(self, "attach")
 
     @attach.setter
-    def attach(self, value: pulumi.Input[bool]):
+    def attach(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "attach", value)
 
     @property
@@ -471,7 +1225,7 @@ class EKSAppmeshPolicyArgs:
 @pulumi.input_type
 class EKSCertManagerPolicyArgs:
     def __init__(__self__, *,
-                 attach: pulumi.Input[bool],
+                 attach: Optional[pulumi.Input[bool]] = None,
                  hosted_zone_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
         The Cert Manager IAM policy to attach to the role.
@@ -479,20 +1233,21 @@ class EKSCertManagerPolicyArgs:
         :param pulumi.Input[Sequence[pulumi.Input[str]]] hosted_zone_arns: Route53 hosted zone ARNs to allow Cert manager to manage records. If not provided,
                the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
         """
-        pulumi.set(__self__, "attach", attach)
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
         if hosted_zone_arns is not None:
             pulumi.set(__self__, "hosted_zone_arns", hosted_zone_arns)
 
     @property
     @pulumi.getter
-    def attach(self) -> pulumi.Input[bool]:
+    def attach(self) -> Optional[pulumi.Input[bool]]:
         """
         Determines whether to attach the Cert Manager IAM policy to the role.
         """
         return pulumi.get(self, "attach")
 
     @attach.setter
-    def attach(self, value: pulumi.Input[bool]):
+    def attach(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "attach", value)
 
     @property
@@ -509,68 +1264,151 @@ class EKSCertManagerPolicyArgs:
         pulumi.set(self, "hosted_zone_arns", value)
 
 
+@pulumi.input_type
+class EKSCloudWatchObservabilityPolicyArgs:
+    def __init__(__self__, *,
+                 attach: Optional[pulumi.Input[bool]] = None):
+        """
+        The CloudWatch Observability IAM policy to the role.
+        :param pulumi.Input[bool] attach: Determines whether to attach the CloudWatch Observability IAM policy to the role.
+        """
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
+
+    @property
+    @pulumi.getter
+    def attach(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Determines whether to attach the CloudWatch Observability IAM policy to the role.
+        """
+        return pulumi.get(self, "attach")
+
+    @attach.setter
+    def attach(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "attach", value)
+
+
 @pulumi.input_type
 class EKSClusterAutoscalerPolicyArgs:
     def __init__(__self__, *,
-                 attach: pulumi.Input[bool],
-                 cluster_ids: pulumi.Input[Sequence[pulumi.Input[str]]]):
+                 attach: Optional[pulumi.Input[bool]] = None,
+                 auto_scaling_group_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 cluster_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 enable_describe_nodegroup: Optional[pulumi.Input[bool]] = None,
+                 read_only: Optional[pulumi.Input[bool]] = None,
+                 tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
         The Cluster Autoscaler IAM policy to the role.
         :param pulumi.Input[bool] attach: Determines whether to attach the Cluster Autoscaler IAM policy to the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] auto_scaling_group_arns: List of Auto Scaling group ARNs the Cluster Autoscaler can scale, e.g. the groups of specific node groups.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] cluster_ids: List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
+        :param pulumi.Input[bool] enable_describe_nodegroup: Allows eks:DescribeNodegroup, used to discover the labels and taints of managed node groups scaled from zero.
+        :param pulumi.Input[bool] read_only: Only grants the read actions, e.g. for dashboards showing the autoscaler status. Without `clusterIds`,
+               `autoScalingGroupArns` or `tagKeys` the policy is read only as well, with a warning.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] tag_keys: List of tag keys which must all be present on the Auto Scaling groups the Cluster Autoscaler can scale,
+               e.g. `k8s.io/cluster-autoscaler/enabled`. Combined with `clusterIds` and `autoScalingGroupArns` when given.
+        """
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
+        if auto_scaling_group_arns is not None:
+            pulumi.set(__self__, "auto_scaling_group_arns", auto_scaling_group_arns)
+        if cluster_ids is not None:
+            pulumi.set(__self__, "cluster_ids", cluster_ids)
+        if enable_describe_nodegroup is not None:
+            pulumi.set(__self__, "enable_describe_nodegroup", enable_describe_nodegroup)
+        if read_only is not None:
+            pulumi.set(__self__, "read_only", read_only)
+        if tag_keys is not None:
+            pulumi.set(__self__, "tag_keys", tag_keys)
+
+    @property
+    @pulumi.getter
+    def attach(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Determines whether to attach the Cluster Autoscaler IAM policy to the role.
+        """
+        return pulumi.get(self, "attach")
+
+    @attach.setter
+    def attach(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "attach", value)
+
+    @property
+    @pulumi.getter(name="autoScalingGroupArns")
+    def auto_scaling_group_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of Auto Scaling group ARNs the Cluster Autoscaler can scale, e.g. the groups of specific node groups.
+        """
+        return pulumi.get(self, "auto_scaling_group_arns")
+
+    @auto_scaling_group_arns.setter
+    def auto_scaling_group_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "auto_scaling_group_arns", value)
+
+    @property
+    @pulumi.getter(name="clusterIds")
+    def cluster_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
+        """
+        return pulumi.get(self, "cluster_ids")
+
+    @cluster_ids.setter
+    def cluster_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "cluster_ids", value)
+
+    @property
+    @pulumi.getter(name="enableDescribeNodegroup")
+    def enable_describe_nodegroup(self) -> Optional[pulumi.Input[bool]]:
         """
-        pulumi.set(__self__, "attach", attach)
-        pulumi.set(__self__, "cluster_ids", cluster_ids)
+        Allows eks:DescribeNodegroup, used to discover the labels and taints of managed node groups scaled from zero.
+        """
+        return pulumi.get(self, "enable_describe_nodegroup")
+
+    @enable_describe_nodegroup.setter
+    def enable_describe_nodegroup(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enable_describe_nodegroup", value)
 
     @property
-    @pulumi.getter
-    def attach(self) -> pulumi.Input[bool]:
+    @pulumi.getter(name="readOnly")
+    def read_only(self) -> Optional[pulumi.Input[bool]]:
         """
-        Determines whether to attach the Cluster Autoscaler IAM policy to the role.
+        Only grants the read actions, e.g. for dashboards showing the autoscaler status. Without `clusterIds`,
+        `autoScalingGroupArns` or `tagKeys` the policy is read only as well, with a warning.
         """
-        return pulumi.get(self, "attach")
+        return pulumi.get(self, "read_only")
 
-    @attach.setter
-    def attach(self, value: pulumi.Input[bool]):
-        pulumi.set(self, "attach", value)
+    @read_only.setter
+    def read_only(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "read_only", value)
 
     @property
-    @pulumi.getter(name="clusterIds")
-    def cluster_ids(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+    @pulumi.getter(name="tagKeys")
+    def tag_keys(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
         """
-        List of cluster IDs to appropriately scope permissions within the Cluster Autoscaler IAM policy.
+        List of tag keys which must all be present on the Auto Scaling groups the Cluster Autoscaler can scale,
+        e.g. `k8s.io/cluster-autoscaler/enabled`. Combined with `clusterIds` and `autoScalingGroupArns` when given.
         """
-        return pulumi.get(self, "cluster_ids")
+        return pulumi.get(self, "tag_keys")
 
-    @cluster_ids.setter
-    def cluster_ids(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
-        pulumi.set(self, "cluster_ids", value)
+    @tag_keys.setter
+    def tag_keys(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "tag_keys", value)
 
 
 @pulumi.input_type
 class EKSEBSCSIPolicyArgs:
     def __init__(__self__, *,
-                 attach: pulumi.Input[bool],
-                 kms_cmk_ids: pulumi.Input[Sequence[pulumi.Input[str]]]):
+                 kms_cmk_ids: pulumi.Input[Sequence[pulumi.Input[str]]],
+                 attach: Optional[pulumi.Input[bool]] = None):
         """
         The EBS CSI IAM policy to the role.
-        :param pulumi.Input[bool] attach: Determines whether to attach the EBS CSI IAM policy to the role.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] kms_cmk_ids: KMS CMK IDs to allow EBS CSI to manage encrypted volumes.
+        :param pulumi.Input[bool] attach: Determines whether to attach the EBS CSI IAM policy to the role.
         """
-        pulumi.set(__self__, "attach", attach)
         pulumi.set(__self__, "kms_cmk_ids", kms_cmk_ids)
-
-    @property
-    @pulumi.getter
-    def attach(self) -> pulumi.Input[bool]:
-        """
-        Determines whether to attach the EBS CSI IAM policy to the role.
-        """
-        return pulumi.get(self, "attach")
-
-    @attach.setter
-    def attach(self, value: pulumi.Input[bool]):
-        pulumi.set(self, "attach", value)
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
 
     @property
     @pulumi.getter(name="kmsCmkIds")
@@ -584,34 +1422,47 @@ class EKSEBSCSIPolicyArgs:
     def kms_cmk_ids(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
         pulumi.set(self, "kms_cmk_ids", value)
 
+    @property
+    @pulumi.getter
+    def attach(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Determines whether to attach the EBS CSI IAM policy to the role.
+        """
+        return pulumi.get(self, "attach")
+
+    @attach.setter
+    def attach(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "attach", value)
+
 
 @pulumi.input_type
 class EKSEFSCSIPolicyArgs:
     def __init__(__self__, *,
-                 attach: pulumi.Input[bool]):
+                 attach: Optional[pulumi.Input[bool]] = None):
         """
         The EFS CSI IAM policy to the role.
         :param pulumi.Input[bool] attach: Determines whether to attach the EFS CSI IAM policy to the role.
         """
-        pulumi.set(__self__, "attach", attach)
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
 
     @property
     @pulumi.getter
-    def attach(self) -> pulumi.Input[bool]:
+    def attach(self) -> Optional[pulumi.Input[bool]]:
         """
         Determines whether to attach the EFS CSI IAM policy to the role.
         """
         return pulumi.get(self, "attach")
 
     @attach.setter
-    def attach(self, value: pulumi.Input[bool]):
+    def attach(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "attach", value)
 
 
 @pulumi.input_type
 class EKSExternalDNSPolicyArgs:
     def __init__(__self__, *,
-                 attach: pulumi.Input[bool],
+                 attach: Optional[pulumi.Input[bool]] = None,
                  hosted_zone_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
         The External DNS IAM policy to the role.
@@ -619,20 +1470,21 @@ class EKSExternalDNSPolicyArgs:
         :param pulumi.Input[Sequence[pulumi.Input[str]]] hosted_zone_arns: Route53 hosted zone ARNs to allow External DNS to manage records. If not provided,
                the default ARN "arn:aws:route53:::hostedzone/*" will be applied.
         """
-        pulumi.set(__self__, "attach", attach)
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
         if hosted_zone_arns is not None:
             pulumi.set(__self__, "hosted_zone_arns", hosted_zone_arns)
 
     @property
     @pulumi.getter
-    def attach(self) -> pulumi.Input[bool]:
+    def attach(self) -> Optional[pulumi.Input[bool]]:
         """
         Determines whether to attach the External DNS IAM policy to the role.
         """
         return pulumi.get(self, "attach")
 
     @attach.setter
-    def attach(self, value: pulumi.Input[bool]):
+    def attach(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "attach", value)
 
     @property
@@ -652,7 +1504,7 @@ class EKSExternalDNSPolicyArgs:
 @pulumi.input_type
 class EKSExternalSecretsPolicyArgs:
     def __init__(__self__, *,
-                 attach: pulumi.Input[bool],
+                 attach: Optional[pulumi.Input[bool]] = None,
                  secrets_manager_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  ssm_parameter_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
@@ -662,7 +1514,8 @@ class EKSExternalSecretsPolicyArgs:
         :param pulumi.Input[Sequence[pulumi.Input[str]]] ssm_parameter_arns: List of Systems Manager Parameter ARNs that contain secrets to mount using External Secrets. If not provided,
                the default ARN "arn:aws:ssm:*:*:parameter/*" will be applied.
         """
-        pulumi.set(__self__, "attach", attach)
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
         if secrets_manager_arns is not None:
             pulumi.set(__self__, "secrets_manager_arns", secrets_manager_arns)
         if ssm_parameter_arns is not None:
@@ -670,14 +1523,14 @@ class EKSExternalSecretsPolicyArgs:
 
     @property
     @pulumi.getter
-    def attach(self) -> pulumi.Input[bool]:
+    def attach(self) -> Optional[pulumi.Input[bool]]:
         """
         Determines whether to attach the External Secrets policy to the role.
         """
         return pulumi.get(self, "attach")
 
     @attach.setter
-    def attach(self, value: pulumi.Input[bool]):
+    def attach(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "attach", value)
 
     @property
@@ -706,33 +1559,126 @@ class EKSExternalSecretsPolicyArgs:
         pulumi.set(self, "ssm_parameter_arns", value)
 
 
+@pulumi.input_type
+class EKSGatewayAPIControllerPolicyArgs:
+    def __init__(__self__, *,
+                 attach: Optional[pulumi.Input[bool]] = None):
+        """
+        The VPC Lattice Gateway API Controller IAM policy to the role.
+        :param pulumi.Input[bool] attach: Determines whether to attach the VPC Lattice Gateway API Controller IAM policy to the role.
+        """
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
+
+    @property
+    @pulumi.getter
+    def attach(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Determines whether to attach the VPC Lattice Gateway API Controller IAM policy to the role.
+        """
+        return pulumi.get(self, "attach")
+
+    @attach.setter
+    def attach(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "attach", value)
+
+
+@pulumi.input_type
+class EKSKEDAPolicyArgs:
+    def __init__(__self__, *,
+                 attach: Optional[pulumi.Input[bool]] = None,
+                 dynamodb_table_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 sqs_queue_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
+        """
+        The KEDA IAM policy to the role.
+        :param pulumi.Input[bool] attach: Determines whether to attach the KEDA IAM policy to the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] dynamodb_table_arns: List of DynamoDB table ARNs queried by the aws-dynamodb scaler.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] sqs_queue_arns: List of SQS queue ARNs read by the aws-sqs-queue scaler.
+        """
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
+        if dynamodb_table_arns is not None:
+            pulumi.set(__self__, "dynamodb_table_arns", dynamodb_table_arns)
+        if sqs_queue_arns is not None:
+            pulumi.set(__self__, "sqs_queue_arns", sqs_queue_arns)
+
+    @property
+    @pulumi.getter
+    def attach(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Determines whether to attach the KEDA IAM policy to the role.
+        """
+        return pulumi.get(self, "attach")
+
+    @attach.setter
+    def attach(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "attach", value)
+
+    @property
+    @pulumi.getter(name="dynamodbTableArns")
+    def dynamodb_table_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of DynamoDB table ARNs queried by the aws-dynamodb scaler.
+        """
+        return pulumi.get(self, "dynamodb_table_arns")
+
+    @dynamodb_table_arns.setter
+    def dynamodb_table_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "dynamodb_table_arns", value)
+
+    @property
+    @pulumi.getter(name="sqsQueueArns")
+    def sqs_queue_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of SQS queue ARNs read by the aws-sqs-queue scaler.
+        """
+        return pulumi.get(self, "sqs_queue_arns")
+
+    @sqs_queue_arns.setter
+    def sqs_queue_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "sqs_queue_arns", value)
+
+
 @pulumi.input_type
 class EKSKarpenterControllerPolicyArgs:
     def __init__(__self__, *,
-                 attach: pulumi.Input[bool],
+                 attach: Optional[pulumi.Input[bool]] = None,
                  cluster_id: Optional[pulumi.Input[str]] = None,
+                 interruption_queue_arn: Optional[pulumi.Input[str]] = None,
                  node_iam_role_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 region: Optional[pulumi.Input[str]] = None,
                  ssm_parameter_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  subnet_account_id: Optional[pulumi.Input[str]] = None,
-                 tag_key: Optional[pulumi.Input[str]] = None):
+                 tag_key: Optional[pulumi.Input[str]] = None,
+                 version: Optional[pulumi.Input[str]] = None):
         """
         The Karpenter Controller policy to the role.
         :param pulumi.Input[bool] attach: Determines whether to attach the Karpenter Controller policy to the role.
         :param pulumi.Input[str] cluster_id: Cluster ID where the Karpenter controller is provisioned/managing.
+        :param pulumi.Input[str] interruption_queue_arn: ARN of the SQS queue Karpenter reads interruption events from. Only used by the `v1` policy.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] node_iam_role_arns: List of node IAM role ARNs Karpenter can use to launch nodes. If not provided,
-               the default ARN "*" will be applied.
+               the default ARN "*" will be applied. Required by the `v1` policy.
+        :param pulumi.Input[str] region: Region of the cluster. Only used by the `v1` policy, defaults to the region of the provider.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] ssm_parameter_arns: List of SSM Parameter ARNs that contain AMI IDs launched by Karpenter. If not provided,
                the default ARN "arn:aws:ssm:*:*:parameter/aws/service/*" will be applied.
         :param pulumi.Input[str] subnet_account_id: Account ID of where the subnets Karpenter will utilize resides. Used when subnets are shared from another account.
         :param pulumi.Input[str] tag_key: Tag key (`{key = value}`) applied to resources launched by Karpenter through the Karpenter provisioner.
+        :param pulumi.Input[str] version: Version of the Karpenter controller policy. `v0` is the policy of Karpenter releases before v1, using a single
+               discovery tag. `v1` matches the permissions of the Karpenter v1 controller, scoped by the
+               `kubernetes.io/cluster/<clusterId>` and `karpenter.sh/nodepool` tags, and requires `clusterId` to be the cluster name.
         """
-        pulumi.set(__self__, "attach", attach)
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
         if cluster_id is None:
             cluster_id = '*'
         if cluster_id is not None:
             pulumi.set(__self__, "cluster_id", cluster_id)
+        if interruption_queue_arn is not None:
+            pulumi.set(__self__, "interruption_queue_arn", interruption_queue_arn)
         if node_iam_role_arns is not None:
             pulumi.set(__self__, "node_iam_role_arns", node_iam_role_arns)
+        if region is not None:
+            pulumi.set(__self__, "region", region)
         if ssm_parameter_arns is not None:
             pulumi.set(__self__, "ssm_parameter_arns", ssm_parameter_arns)
         if subnet_account_id is not None:
@@ -741,17 +1687,21 @@ class EKSKarpenterControllerPolicyArgs:
             tag_key = 'karpenter.sh/discovery'
         if tag_key is not None:
             pulumi.set(__self__, "tag_key", tag_key)
+        if version is None:
+            version = 'v0'
+        if version is not None:
+            pulumi.set(__self__, "version", version)
 
     @property
     @pulumi.getter
-    def attach(self) -> pulumi.Input[bool]:
+    def attach(self) -> Optional[pulumi.Input[bool]]:
         """
         Determines whether to attach the Karpenter Controller policy to the role.
         """
         return pulumi.get(self, "attach")
 
     @attach.setter
-    def attach(self, value: pulumi.Input[bool]):
+    def attach(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "attach", value)
 
     @property
@@ -766,12 +1716,24 @@ class EKSKarpenterControllerPolicyArgs:
     def cluster_id(self, value: Optional[pulumi.Input[str]]):
         pulumi.set(self, "cluster_id", value)
 
+    @property
+    @pulumi.getter(name="interruptionQueueArn")
+    def interruption_queue_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        ARN of the SQS queue Karpenter reads interruption events from. Only used by the `v1` policy.
+        """
+        return pulumi.get(self, "interruption_queue_arn")
+
+    @interruption_queue_arn.setter
+    def interruption_queue_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "interruption_queue_arn", value)
+
     @property
     @pulumi.getter(name="nodeIamRoleArns")
     def node_iam_role_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
         """
         List of node IAM role ARNs Karpenter can use to launch nodes. If not provided,
-        the default ARN "*" will be applied.
+        the default ARN "*" will be applied. Required by the `v1` policy.
         """
         return pulumi.get(self, "node_iam_role_arns")
 
@@ -779,6 +1741,18 @@ class EKSKarpenterControllerPolicyArgs:
     def node_iam_role_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
         pulumi.set(self, "node_iam_role_arns", value)
 
+    @property
+    @pulumi.getter
+    def region(self) -> Optional[pulumi.Input[str]]:
+        """
+        Region of the cluster. Only used by the `v1` policy, defaults to the region of the provider.
+        """
+        return pulumi.get(self, "region")
+
+    @region.setter
+    def region(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "region", value)
+
     @property
     @pulumi.getter(name="ssmParameterArns")
     def ssm_parameter_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
@@ -816,22 +1790,86 @@ class EKSKarpenterControllerPolicyArgs:
     def tag_key(self, value: Optional[pulumi.Input[str]]):
         pulumi.set(self, "tag_key", value)
 
+    @property
+    @pulumi.getter
+    def version(self) -> Optional[pulumi.Input[str]]:
+        """
+        Version of the Karpenter controller policy. `v0` is the policy of Karpenter releases before v1, using a single
+        discovery tag. `v1` matches the permissions of the Karpenter v1 controller, scoped by the
+        `kubernetes.io/cluster/<clusterId>` and `karpenter.sh/nodepool` tags, and requires `clusterId` to be the cluster name.
+        """
+        return pulumi.get(self, "version")
+
+    @version.setter
+    def version(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "version", value)
+
 
 @pulumi.input_type
 class EKSLoadBalancerPolicyArgs:
     def __init__(__self__, *,
+                 cluster_name: Optional[pulumi.Input[str]] = None,
+                 cluster_tag_key: Optional[pulumi.Input[str]] = None,
                  controller: Optional[pulumi.Input[bool]] = None,
+                 controller_version: Optional[pulumi.Input[str]] = None,
+                 restrict_waf_shield: Optional[pulumi.Input[bool]] = None,
+                 restricted_security_group_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  target_group_binding_only: Optional[pulumi.Input[bool]] = None):
         """
         The Load Balancer policy.
+        :param pulumi.Input[str] cluster_name: Name of the cluster. When set, the controller can only manage resources whose cluster tag has this value.
+        :param pulumi.Input[str] cluster_tag_key: Tag key the controller adds to the resources it manages.
         :param pulumi.Input[bool] controller: Determines whether to attach the Load Balancer Controller policy to the role.
+        :param pulumi.Input[str] controller_version: Version of the Load Balancer Controller the policy is for, e.g. `v2.7`. Permissions added by later controller
+               releases are only granted to the versions needing them.
+        :param pulumi.Input[bool] restrict_waf_shield: Leaves out the WAF, WAFv2 and Shield permissions, for controllers running with `--enable-waf=false`,
+               `--enable-wafv2=false` and `--enable-shield=false`.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] restricted_security_group_arns: List of security group ARNs the controller can change the ingress rules of. Defaults to all security groups.
         :param pulumi.Input[bool] target_group_binding_only: Determines whether to attach the Load Balancer Controller policy for the TargetGroupBinding only.
         """
+        if cluster_name is not None:
+            pulumi.set(__self__, "cluster_name", cluster_name)
+        if cluster_tag_key is None:
+            cluster_tag_key = 'elbv2.k8s.aws/cluster'
+        if cluster_tag_key is not None:
+            pulumi.set(__self__, "cluster_tag_key", cluster_tag_key)
         if controller is not None:
             pulumi.set(__self__, "controller", controller)
+        if controller_version is None:
+            controller_version = 'v2.4'
+        if controller_version is not None:
+            pulumi.set(__self__, "controller_version", controller_version)
+        if restrict_waf_shield is not None:
+            pulumi.set(__self__, "restrict_waf_shield", restrict_waf_shield)
+        if restricted_security_group_arns is not None:
+            pulumi.set(__self__, "restricted_security_group_arns", restricted_security_group_arns)
         if target_group_binding_only is not None:
             pulumi.set(__self__, "target_group_binding_only", target_group_binding_only)
 
+    @property
+    @pulumi.getter(name="clusterName")
+    def cluster_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the cluster. When set, the controller can only manage resources whose cluster tag has this value.
+        """
+        return pulumi.get(self, "cluster_name")
+
+    @cluster_name.setter
+    def cluster_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "cluster_name", value)
+
+    @property
+    @pulumi.getter(name="clusterTagKey")
+    def cluster_tag_key(self) -> Optional[pulumi.Input[str]]:
+        """
+        Tag key the controller adds to the resources it manages.
+        """
+        return pulumi.get(self, "cluster_tag_key")
+
+    @cluster_tag_key.setter
+    def cluster_tag_key(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "cluster_tag_key", value)
+
     @property
     @pulumi.getter
     def controller(self) -> Optional[pulumi.Input[bool]]:
@@ -844,6 +1882,44 @@ class EKSLoadBalancerPolicyArgs:
     def controller(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "controller", value)
 
+    @property
+    @pulumi.getter(name="controllerVersion")
+    def controller_version(self) -> Optional[pulumi.Input[str]]:
+        """
+        Version of the Load Balancer Controller the policy is for, e.g. `v2.7`. Permissions added by later controller
+        releases are only granted to the versions needing them.
+        """
+        return pulumi.get(self, "controller_version")
+
+    @controller_version.setter
+    def controller_version(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "controller_version", value)
+
+    @property
+    @pulumi.getter(name="restrictWafShield")
+    def restrict_waf_shield(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Leaves out the WAF, WAFv2 and Shield permissions, for controllers running with `--enable-waf=false`,
+        `--enable-wafv2=false` and `--enable-shield=false`.
+        """
+        return pulumi.get(self, "restrict_waf_shield")
+
+    @restrict_waf_shield.setter
+    def restrict_waf_shield(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "restrict_waf_shield", value)
+
+    @property
+    @pulumi.getter(name="restrictedSecurityGroupArns")
+    def restricted_security_group_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of security group ARNs the controller can change the ingress rules of. Defaults to all security groups.
+        """
+        return pulumi.get(self, "restricted_security_group_arns")
+
+    @restricted_security_group_arns.setter
+    def restricted_security_group_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "restricted_security_group_arns", value)
+
     @property
     @pulumi.getter(name="targetGroupBindingOnly")
     def target_group_binding_only(self) -> Optional[pulumi.Input[bool]]:
@@ -857,10 +1933,82 @@ class EKSLoadBalancerPolicyArgs:
         pulumi.set(self, "target_group_binding_only", value)
 
 
+@pulumi.input_type
+class EKSMountpointS3CSIPolicyArgs:
+    def __init__(__self__, *,
+                 attach: Optional[pulumi.Input[bool]] = None,
+                 kms_key_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 s3_bucket_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 s3_path_prefixes: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
+        """
+        The Mountpoint for Amazon S3 CSI IAM policy to the role.
+        :param pulumi.Input[bool] attach: Determines whether to attach the Mountpoint for Amazon S3 CSI IAM policy to the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] kms_key_arns: List of KMS key ARNs used to encrypt the objects in the buckets.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] s3_bucket_arns: List of S3 Bucket ARNs the driver is allowed to mount. At least one is required.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] s3_path_prefixes: List of key prefixes within the buckets the driver is allowed to access. Defaults to the whole bucket.
+        """
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
+        if kms_key_arns is not None:
+            pulumi.set(__self__, "kms_key_arns", kms_key_arns)
+        if s3_bucket_arns is not None:
+            pulumi.set(__self__, "s3_bucket_arns", s3_bucket_arns)
+        if s3_path_prefixes is not None:
+            pulumi.set(__self__, "s3_path_prefixes", s3_path_prefixes)
+
+    @property
+    @pulumi.getter
+    def attach(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Determines whether to attach the Mountpoint for Amazon S3 CSI IAM policy to the role.
+        """
+        return pulumi.get(self, "attach")
+
+    @attach.setter
+    def attach(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "attach", value)
+
+    @property
+    @pulumi.getter(name="kmsKeyArns")
+    def kms_key_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of KMS key ARNs used to encrypt the objects in the buckets.
+        """
+        return pulumi.get(self, "kms_key_arns")
+
+    @kms_key_arns.setter
+    def kms_key_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "kms_key_arns", value)
+
+    @property
+    @pulumi.getter(name="s3BucketArns")
+    def s3_bucket_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of S3 Bucket ARNs the driver is allowed to mount. At least one is required.
+        """
+        return pulumi.get(self, "s3_bucket_arns")
+
+    @s3_bucket_arns.setter
+    def s3_bucket_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "s3_bucket_arns", value)
+
+    @property
+    @pulumi.getter(name="s3PathPrefixes")
+    def s3_path_prefixes(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of key prefixes within the buckets the driver is allowed to access. Defaults to the whole bucket.
+        """
+        return pulumi.get(self, "s3_path_prefixes")
+
+    @s3_path_prefixes.setter
+    def s3_path_prefixes(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "s3_path_prefixes", value)
+
+
 @pulumi.input_type
 class EKSNodeTerminationHandlerPolicyArgs:
     def __init__(__self__, *,
-                 attach: pulumi.Input[bool],
+                 attach: Optional[pulumi.Input[bool]] = None,
                  sqs_queue_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
         The Node Termination Handler policy to the role.
@@ -868,20 +2016,21 @@ class EKSNodeTerminationHandlerPolicyArgs:
         :param pulumi.Input[Sequence[pulumi.Input[str]]] sqs_queue_arns: List of SQS ARNs that contain node termination events. If not provided, then a default
                ARN of "*" will be provided.
         """
-        pulumi.set(__self__, "attach", attach)
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
         if sqs_queue_arns is not None:
             pulumi.set(__self__, "sqs_queue_arns", sqs_queue_arns)
 
     @property
     @pulumi.getter
-    def attach(self) -> pulumi.Input[bool]:
+    def attach(self) -> Optional[pulumi.Input[bool]]:
         """
         Determines whether to attach the Node Termination Handler policy to the role.
         """
         return pulumi.get(self, "attach")
 
     @attach.setter
-    def attach(self, value: pulumi.Input[bool]):
+    def attach(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "attach", value)
 
     @property
@@ -901,45 +2050,66 @@ class EKSNodeTerminationHandlerPolicyArgs:
 @pulumi.input_type
 class EKSRolePoliciesArgs:
     def __init__(__self__, *,
+                 adot: Optional[pulumi.Input['EKSADOTPolicyArgs']] = None,
                  amazon_managed_service_prometheus: Optional[pulumi.Input['EKSAmazonManagedServicePrometheusPolicyArgs']] = None,
                  appmesh: Optional[pulumi.Input['EKSAppmeshPolicyArgs']] = None,
                  cert_manager: Optional[pulumi.Input['EKSCertManagerPolicyArgs']] = None,
+                 cloudwatch_observability: Optional[pulumi.Input['EKSCloudWatchObservabilityPolicyArgs']] = None,
                  cluster_auto_scaling: Optional[pulumi.Input['EKSClusterAutoscalerPolicyArgs']] = None,
+                 custom: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  ebs_csi: Optional[pulumi.Input['EKSEBSCSIPolicyArgs']] = None,
                  efs_csi: Optional[pulumi.Input['EKSEFSCSIPolicyArgs']] = None,
                  external_dns: Optional[pulumi.Input['EKSExternalDNSPolicyArgs']] = None,
                  external_secrets: Optional[pulumi.Input['EKSExternalSecretsPolicyArgs']] = None,
                  fsx_lustre_csi: Optional[pulumi.Input['FSxLustreCSIPolicyArgs']] = None,
+                 gateway_api_controller: Optional[pulumi.Input['EKSGatewayAPIControllerPolicyArgs']] = None,
                  karpenter_controller: Optional[pulumi.Input['EKSKarpenterControllerPolicyArgs']] = None,
+                 keda: Optional[pulumi.Input['EKSKEDAPolicyArgs']] = None,
                  load_balancer: Optional[pulumi.Input['EKSLoadBalancerPolicyArgs']] = None,
+                 mountpoint_s3_csi: Optional[pulumi.Input['EKSMountpointS3CSIPolicyArgs']] = None,
                  node_termination_handler: Optional[pulumi.Input['EKSNodeTerminationHandlerPolicyArgs']] = None,
+                 secrets_store_csi: Optional[pulumi.Input['EKSSecretsStoreCSIPolicyArgs']] = None,
                  velero: Optional[pulumi.Input['EKSVeleroPolicyArgs']] = None,
                  vpn_cni: Optional[pulumi.Input['EKSVPNCNIPolicyArgs']] = None):
         """
         The different policies to attach to the role.
+        :param pulumi.Input['EKSADOTPolicyArgs'] adot: The AWS Distro for OpenTelemetry IAM policy.
         :param pulumi.Input['EKSAmazonManagedServicePrometheusPolicyArgs'] amazon_managed_service_prometheus: The Amazon Managed Service for Prometheus IAM policy.
         :param pulumi.Input['EKSAppmeshPolicyArgs'] appmesh: The Appmesh policies.
         :param pulumi.Input['EKSCertManagerPolicyArgs'] cert_manager: The Cert Manager IAM policy.
+        :param pulumi.Input['EKSCloudWatchObservabilityPolicyArgs'] cloudwatch_observability: The CloudWatch Observability IAM policy.
         :param pulumi.Input['EKSClusterAutoscalerPolicyArgs'] cluster_auto_scaling: The Cluster Autoscaler IAM policy.
+        :param pulumi.Input[Mapping[str, Any]] custom: Arguments of organization specific add-ons, keyed by the name they are registered with in
+               the provider's add-on registry. Unknown add-on names are rejected.
         :param pulumi.Input['EKSEBSCSIPolicyArgs'] ebs_csi: The EBS CSI IAM policy.
         :param pulumi.Input['EKSEFSCSIPolicyArgs'] efs_csi: The EFS CSI IAM policy.
         :param pulumi.Input['EKSExternalDNSPolicyArgs'] external_dns: The External DNS IAM policy.
         :param pulumi.Input['EKSExternalSecretsPolicyArgs'] external_secrets: The External Secrets policy.
         :param pulumi.Input['FSxLustreCSIPolicyArgs'] fsx_lustre_csi: The FSx for Lustre CSI Driver IAM policy.
+        :param pulumi.Input['EKSGatewayAPIControllerPolicyArgs'] gateway_api_controller: The VPC Lattice Gateway API Controller IAM policy.
         :param pulumi.Input['EKSKarpenterControllerPolicyArgs'] karpenter_controller: The Karpenter Controller policy.
+        :param pulumi.Input['EKSKEDAPolicyArgs'] keda: The KEDA IAM policy.
         :param pulumi.Input['EKSLoadBalancerPolicyArgs'] load_balancer: The Load Balancer policy.
+        :param pulumi.Input['EKSMountpointS3CSIPolicyArgs'] mountpoint_s3_csi: The Mountpoint for Amazon S3 CSI IAM policy.
         :param pulumi.Input['EKSNodeTerminationHandlerPolicyArgs'] node_termination_handler: The Node Termination Handler policy to the role.
+        :param pulumi.Input['EKSSecretsStoreCSIPolicyArgs'] secrets_store_csi: The Secrets Store CSI driver IAM policy.
         :param pulumi.Input['EKSVeleroPolicyArgs'] velero: The Velero IAM policy.
         :param pulumi.Input['EKSVPNCNIPolicyArgs'] vpn_cni: The VPC CNI IAM policy to the role.
         """
+        if adot is not None:
+            pulumi.set(__self__, "adot", adot)
         if amazon_managed_service_prometheus is not None:
             pulumi.set(__self__, "amazon_managed_service_prometheus", amazon_managed_service_prometheus)
         if appmesh is not None:
             pulumi.set(__self__, "appmesh", appmesh)
         if cert_manager is not None:
             pulumi.set(__self__, "cert_manager", cert_manager)
+        if cloudwatch_observability is not None:
+            pulumi.set(__self__, "cloudwatch_observability", cloudwatch_observability)
         if cluster_auto_scaling is not None:
             pulumi.set(__self__, "cluster_auto_scaling", cluster_auto_scaling)
+        if custom is not None:
+            pulumi.set(__self__, "custom", custom)
         if ebs_csi is not None:
             pulumi.set(__self__, "ebs_csi", ebs_csi)
         if efs_csi is not None:
@@ -950,17 +2120,37 @@ class EKSRolePoliciesArgs:
             pulumi.set(__self__, "external_secrets", external_secrets)
         if fsx_lustre_csi is not None:
             pulumi.set(__self__, "fsx_lustre_csi", fsx_lustre_csi)
+        if gateway_api_controller is not None:
+            pulumi.set(__self__, "gateway_api_controller", gateway_api_controller)
         if karpenter_controller is not None:
             pulumi.set(__self__, "karpenter_controller", karpenter_controller)
+        if keda is not None:
+            pulumi.set(__self__, "keda", keda)
         if load_balancer is not None:
             pulumi.set(__self__, "load_balancer", load_balancer)
+        if mountpoint_s3_csi is not None:
+            pulumi.set(__self__, "mountpoint_s3_csi", mountpoint_s3_csi)
         if node_termination_handler is not None:
             pulumi.set(__self__, "node_termination_handler", node_termination_handler)
+        if secrets_store_csi is not None:
+            pulumi.set(__self__, "secrets_store_csi", secrets_store_csi)
         if velero is not None:
             pulumi.set(__self__, "velero", velero)
         if vpn_cni is not None:
             pulumi.set(__self__, "vpn_cni", vpn_cni)
 
+    @property
+    @pulumi.getter
+    def adot(self) -> Optional[pulumi.Input['EKSADOTPolicyArgs']]:
+        """
+        The AWS Distro for OpenTelemetry IAM policy.
+        """
+        return pulumi.get(self, "adot")
+
+    @adot.setter
+    def adot(self, value: Optional[pulumi.Input['EKSADOTPolicyArgs']]):
+        pulumi.set(self, "adot", value)
+
     @property
     @pulumi.getter(name="amazonManagedServicePrometheus")
     def amazon_managed_service_prometheus(self) -> Optional[pulumi.Input['EKSAmazonManagedServicePrometheusPolicyArgs']]:
@@ -997,6 +2187,18 @@ class EKSRolePoliciesArgs:
     def cert_manager(self, value: Optional[pulumi.Input['EKSCertManagerPolicyArgs']]):
         pulumi.set(self, "cert_manager", value)
 
+    @property
+    @pulumi.getter(name="cloudwatchObservability")
+    def cloudwatch_observability(self) -> Optional[pulumi.Input['EKSCloudWatchObservabilityPolicyArgs']]:
+        """
+        The CloudWatch Observability IAM policy.
+        """
+        return pulumi.get(self, "cloudwatch_observability")
+
+    @cloudwatch_observability.setter
+    def cloudwatch_observability(self, value: Optional[pulumi.Input['EKSCloudWatchObservabilityPolicyArgs']]):
+        pulumi.set(self, "cloudwatch_observability", value)
+
     @property
     @pulumi.getter(name="clusterAutoScaling")
     def cluster_auto_scaling(self) -> Optional[pulumi.Input['EKSClusterAutoscalerPolicyArgs']]:
@@ -1009,6 +2211,19 @@ class EKSRolePoliciesArgs:
     def cluster_auto_scaling(self, value: Optional[pulumi.Input['EKSClusterAutoscalerPolicyArgs']]):
         pulumi.set(self, "cluster_auto_scaling", value)
 
+    @property
+    @pulumi.getter
+    def custom(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Arguments of organization specific add-ons, keyed by the name they are registered with in
+        the provider's add-on registry. Unknown add-on names are rejected.
+        """
+        return pulumi.get(self, "custom")
+
+    @custom.setter
+    def custom(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "custom", value)
+
     @property
     @pulumi.getter(name="ebsCsi")
     def ebs_csi(self) -> Optional[pulumi.Input['EKSEBSCSIPolicyArgs']]:
@@ -1069,6 +2284,18 @@ class EKSRolePoliciesArgs:
     def fsx_lustre_csi(self, value: Optional[pulumi.Input['FSxLustreCSIPolicyArgs']]):
         pulumi.set(self, "fsx_lustre_csi", value)
 
+    @property
+    @pulumi.getter(name="gatewayApiController")
+    def gateway_api_controller(self) -> Optional[pulumi.Input['EKSGatewayAPIControllerPolicyArgs']]:
+        """
+        The VPC Lattice Gateway API Controller IAM policy.
+        """
+        return pulumi.get(self, "gateway_api_controller")
+
+    @gateway_api_controller.setter
+    def gateway_api_controller(self, value: Optional[pulumi.Input['EKSGatewayAPIControllerPolicyArgs']]):
+        pulumi.set(self, "gateway_api_controller", value)
+
     @property
     @pulumi.getter(name="karpenterController")
     def karpenter_controller(self) -> Optional[pulumi.Input['EKSKarpenterControllerPolicyArgs']]:
@@ -1081,6 +2308,18 @@ class EKSRolePoliciesArgs:
     def karpenter_controller(self, value: Optional[pulumi.Input['EKSKarpenterControllerPolicyArgs']]):
         pulumi.set(self, "karpenter_controller", value)
 
+    @property
+    @pulumi.getter
+    def keda(self) -> Optional[pulumi.Input['EKSKEDAPolicyArgs']]:
+        """
+        The KEDA IAM policy.
+        """
+        return pulumi.get(self, "keda")
+
+    @keda.setter
+    def keda(self, value: Optional[pulumi.Input['EKSKEDAPolicyArgs']]):
+        pulumi.set(self, "keda", value)
+
     @property
     @pulumi.getter(name="loadBalancer")
     def load_balancer(self) -> Optional[pulumi.Input['EKSLoadBalancerPolicyArgs']]:
@@ -1093,6 +2332,18 @@ class EKSRolePoliciesArgs:
     def load_balancer(self, value: Optional[pulumi.Input['EKSLoadBalancerPolicyArgs']]):
         pulumi.set(self, "load_balancer", value)
 
+    @property
+    @pulumi.getter(name="mountpointS3Csi")
+    def mountpoint_s3_csi(self) -> Optional[pulumi.Input['EKSMountpointS3CSIPolicyArgs']]:
+        """
+        The Mountpoint for Amazon S3 CSI IAM policy.
+        """
+        return pulumi.get(self, "mountpoint_s3_csi")
+
+    @mountpoint_s3_csi.setter
+    def mountpoint_s3_csi(self, value: Optional[pulumi.Input['EKSMountpointS3CSIPolicyArgs']]):
+        pulumi.set(self, "mountpoint_s3_csi", value)
+
     @property
     @pulumi.getter(name="nodeTerminationHandler")
     def node_termination_handler(self) -> Optional[pulumi.Input['EKSNodeTerminationHandlerPolicyArgs']]:
@@ -1105,6 +2356,18 @@ class EKSRolePoliciesArgs:
     def node_termination_handler(self, value: Optional[pulumi.Input['EKSNodeTerminationHandlerPolicyArgs']]):
         pulumi.set(self, "node_termination_handler", value)
 
+    @property
+    @pulumi.getter(name="secretsStoreCsi")
+    def secrets_store_csi(self) -> Optional[pulumi.Input['EKSSecretsStoreCSIPolicyArgs']]:
+        """
+        The Secrets Store CSI driver IAM policy.
+        """
+        return pulumi.get(self, "secrets_store_csi")
+
+    @secrets_store_csi.setter
+    def secrets_store_csi(self, value: Optional[pulumi.Input['EKSSecretsStoreCSIPolicyArgs']]):
+        pulumi.set(self, "secrets_store_csi", value)
+
     @property
     @pulumi.getter
     def velero(self) -> Optional[pulumi.Input['EKSVeleroPolicyArgs']]:
@@ -1130,10 +2393,114 @@ class EKSRolePoliciesArgs:
         pulumi.set(self, "vpn_cni", value)
 
 
+@pulumi.input_type
+class EKSSecretsStoreCSIPolicyArgs:
+    def __init__(__self__, *,
+                 attach: Optional[pulumi.Input[bool]] = None,
+                 secrets_manager_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 ssm_parameter_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
+        """
+        The Secrets Store CSI driver IAM policy to the role.
+        :param pulumi.Input[bool] attach: Determines whether to attach the Secrets Store CSI driver IAM policy to the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] secrets_manager_arns: List of Secrets Manager ARNs that contain secrets to mount using the Secrets Store CSI driver.
+               If not provided, a default ARN of "arn:aws:secretsmanager:*:*:secret:*" will be provided.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] ssm_parameter_arns: List of Systems Manager Parameter ARNs that contain secrets to mount using the Secrets Store CSI driver.
+               If not provided, a default ARN of "arn:aws:ssm:*:*:parameter/*" will be provided.
+        """
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
+        if secrets_manager_arns is not None:
+            pulumi.set(__self__, "secrets_manager_arns", secrets_manager_arns)
+        if ssm_parameter_arns is not None:
+            pulumi.set(__self__, "ssm_parameter_arns", ssm_parameter_arns)
+
+    @property
+    @pulumi.getter
+    def attach(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Determines whether to attach the Secrets Store CSI driver IAM policy to the role.
+        """
+        return pulumi.get(self, "attach")
+
+    @attach.setter
+    def attach(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "attach", value)
+
+    @property
+    @pulumi.getter(name="secretsManagerArns")
+    def secrets_manager_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of Secrets Manager ARNs that contain secrets to mount using the Secrets Store CSI driver.
+        If not provided, a default ARN of "arn:aws:secretsmanager:*:*:secret:*" will be provided.
+        """
+        return pulumi.get(self, "secrets_manager_arns")
+
+    @secrets_manager_arns.setter
+    def secrets_manager_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "secrets_manager_arns", value)
+
+    @property
+    @pulumi.getter(name="ssmParameterArns")
+    def ssm_parameter_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of Systems Manager Parameter ARNs that contain secrets to mount using the Secrets Store CSI driver.
+        If not provided, a default ARN of "arn:aws:ssm:*:*:parameter/*" will be provided.
+        """
+        return pulumi.get(self, "ssm_parameter_arns")
+
+    @ssm_parameter_arns.setter
+    def ssm_parameter_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "ssm_parameter_arns", value)
+
+
+@pulumi.input_type
+class EKSServiceAccountManifestOptionsArgs:
+    def __init__(__self__, *,
+                 sts_regional_endpoints: Optional[pulumi.Input[bool]] = None,
+                 token_expiration: Optional[pulumi.Input[int]] = None):
+        """
+        :param pulumi.Input[bool] sts_regional_endpoints: Whether to annotate the ServiceAccounts to use the regional STS endpoint.
+        :param pulumi.Input[int] token_expiration: Expiration of the projected ServiceAccount token in seconds. The annotation is left out when 0.
+        """
+        if sts_regional_endpoints is None:
+            sts_regional_endpoints = False
+        if sts_regional_endpoints is not None:
+            pulumi.set(__self__, "sts_regional_endpoints", sts_regional_endpoints)
+        if token_expiration is not None:
+            pulumi.set(__self__, "token_expiration", token_expiration)
+
+    @property
+    @pulumi.getter(name="stsRegionalEndpoints")
+    def sts_regional_endpoints(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to annotate the ServiceAccounts to use the regional STS endpoint.
+        """
+        return pulumi.get(self, "sts_regional_endpoints")
+
+    @sts_regional_endpoints.setter
+    def sts_regional_endpoints(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "sts_regional_endpoints", value)
+
+    @property
+    @pulumi.getter(name="tokenExpiration")
+    def token_expiration(self) -> Optional[pulumi.Input[int]]:
+        """
+        Expiration of the projected ServiceAccount token in seconds. The annotation is left out when 0.
+        """
+        return pulumi.get(self, "token_expiration")
+
+    @token_expiration.setter
+    def token_expiration(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "token_expiration", value)
+
+
 @pulumi.input_type
 class EKSServiceAccountRoleArgs:
     def __init__(__self__, *,
                  description: Optional[pulumi.Input[str]] = None,
+                 exclusive_inline_policies: Optional[pulumi.Input[bool]] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
+                 inline_policies: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  name_prefix: Optional[pulumi.Input[str]] = None,
                  path: Optional[pulumi.Input[str]] = None,
@@ -1141,6 +2508,12 @@ class EKSServiceAccountRoleArgs:
                  policy_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
         :param pulumi.Input[str] description: IAM Role description.
+        :param pulumi.Input[bool] exclusive_inline_policies: Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the role are detached from it. The role then manages its
+               policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+               attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+               policies. Previews of existing roles warn that undeclared policies are detached.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] inline_policies: Map of inline policy names to policy documents, lists of statements or single statements in JSON.
         :param pulumi.Input[str] name: IAM role name.
         :param pulumi.Input[str] name_prefix: IAM role name prefix.
         :param pulumi.Input[str] path: Path of admin IAM role.
@@ -1149,6 +2522,16 @@ class EKSServiceAccountRoleArgs:
         """
         if description is not None:
             pulumi.set(__self__, "description", description)
+        if exclusive_inline_policies is None:
+            exclusive_inline_policies = False
+        if exclusive_inline_policies is not None:
+            pulumi.set(__self__, "exclusive_inline_policies", exclusive_inline_policies)
+        if exclusive_policy_attachments is None:
+            exclusive_policy_attachments = False
+        if exclusive_policy_attachments is not None:
+            pulumi.set(__self__, "exclusive_policy_attachments", exclusive_policy_attachments)
+        if inline_policies is not None:
+            pulumi.set(__self__, "inline_policies", inline_policies)
         if name is not None:
             pulumi.set(__self__, "name", name)
         if name_prefix is not None:
@@ -1172,6 +2555,45 @@ class EKSServiceAccountRoleArgs:
     def description(self, value: Optional[pulumi.Input[str]]):
         pulumi.set(self, "description", value)
 
+    @property
+    @pulumi.getter(name="exclusiveInlinePolicies")
+    def exclusive_inline_policies(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        """
+        return pulumi.get(self, "exclusive_inline_policies")
+
+    @exclusive_inline_policies.setter
+    def exclusive_inline_policies(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_inline_policies", value)
+
+    @property
+    @pulumi.getter(name="exclusivePolicyAttachments")
+    def exclusive_policy_attachments(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether managed policies not declared for the role are detached from it. The role then manages its
+        policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+        attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+        policies. Previews of existing roles warn that undeclared policies are detached.
+        """
+        return pulumi.get(self, "exclusive_policy_attachments")
+
+    @exclusive_policy_attachments.setter
+    def exclusive_policy_attachments(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_policy_attachments", value)
+
+    @property
+    @pulumi.getter(name="inlinePolicies")
+    def inline_policies(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+        """
+        return pulumi.get(self, "inline_policies")
+
+    @inline_policies.setter
+    def inline_policies(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "inline_policies", value)
+
     @property
     @pulumi.getter
     def name(self) -> Optional[pulumi.Input[str]]:
@@ -1241,7 +2663,7 @@ class EKSServiceAccountArgs:
         """
         EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount.
         :param pulumi.Input[str] name: Name of the EKS cluster.
-        :param pulumi.Input[Sequence[pulumi.Input[str]]] service_accounts: Service accounts to pair with the cluster.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] service_accounts: Service accounts to pair with the cluster, as `namespace:name`. Wildcards such as `team-a:*` are matched with StringLike.
         """
         if name is not None:
             pulumi.set(__self__, "name", name)
@@ -1264,7 +2686,7 @@ class EKSServiceAccountArgs:
     @pulumi.getter(name="serviceAccounts")
     def service_accounts(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
         """
-        Service accounts to pair with the cluster.
+        Service accounts to pair with the cluster, as `namespace:name`. Wildcards such as `team-a:*` are matched with StringLike.
         """
         return pulumi.get(self, "service_accounts")
 
@@ -1276,7 +2698,7 @@ class EKSServiceAccountArgs:
 @pulumi.input_type
 class EKSVPNCNIPolicyArgs:
     def __init__(__self__, *,
-                 attach: pulumi.Input[bool],
+                 attach: Optional[pulumi.Input[bool]] = None,
                  enable_ipv4: Optional[pulumi.Input[bool]] = None,
                  enable_ipv6: Optional[pulumi.Input[bool]] = None):
         """
@@ -1285,7 +2707,8 @@ class EKSVPNCNIPolicyArgs:
         :param pulumi.Input[bool] enable_ipv4: Determines whether to enable IPv4 permissions for VPC CNI policy.
         :param pulumi.Input[bool] enable_ipv6: Determines whether to enable IPv6 permissions for VPC CNI policy.
         """
-        pulumi.set(__self__, "attach", attach)
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
         if enable_ipv4 is not None:
             pulumi.set(__self__, "enable_ipv4", enable_ipv4)
         if enable_ipv6 is not None:
@@ -1293,14 +2716,14 @@ class EKSVPNCNIPolicyArgs:
 
     @property
     @pulumi.getter
-    def attach(self) -> pulumi.Input[bool]:
+    def attach(self) -> Optional[pulumi.Input[bool]]:
         """
         Determines whether to attach the VPC CNI IAM policy to the role.
         """
         return pulumi.get(self, "attach")
 
     @attach.setter
-    def attach(self, value: pulumi.Input[bool]):
+    def attach(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "attach", value)
 
     @property
@@ -1331,7 +2754,7 @@ class EKSVPNCNIPolicyArgs:
 @pulumi.input_type
 class EKSVeleroPolicyArgs:
     def __init__(__self__, *,
-                 attach: pulumi.Input[bool],
+                 attach: Optional[pulumi.Input[bool]] = None,
                  s3_bucket_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
         The Velero IAM policy to the role.
@@ -1339,20 +2762,21 @@ class EKSVeleroPolicyArgs:
         :param pulumi.Input[Sequence[pulumi.Input[str]]] s3_bucket_arns: List of S3 Bucket ARNs that Velero needs access to in order to backup and restore cluster resources.
                If not provided, a default ARN of "*" will be provided.
         """
-        pulumi.set(__self__, "attach", attach)
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
         if s3_bucket_arns is not None:
             pulumi.set(__self__, "s3_bucket_arns", s3_bucket_arns)
 
     @property
     @pulumi.getter
-    def attach(self) -> pulumi.Input[bool]:
+    def attach(self) -> Optional[pulumi.Input[bool]]:
         """
         Determines whether to attach the Velero IAM policy to the role.
         """
         return pulumi.get(self, "attach")
 
     @attach.setter
-    def attach(self, value: pulumi.Input[bool]):
+    def attach(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "attach", value)
 
     @property
@@ -1372,7 +2796,7 @@ class EKSVeleroPolicyArgs:
 @pulumi.input_type
 class FSxLustreCSIPolicyArgs:
     def __init__(__self__, *,
-                 attach: pulumi.Input[bool],
+                 attach: Optional[pulumi.Input[bool]] = None,
                  service_role_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
         The FSx for Lustre CSI Driver IAM policy to the role.
@@ -1380,20 +2804,21 @@ class FSxLustreCSIPolicyArgs:
         :param pulumi.Input[Sequence[pulumi.Input[str]]] service_role_arns: Service role ARNs to allow FSx for Lustre CSI create and manage FSX for Lustre service linked roles. If not provided,
                the default ARN "arn:aws:iam::*:role/aws-service-role/s3.data-source.lustre.fsx.amazonaws.com/*" will be applied.
         """
-        pulumi.set(__self__, "attach", attach)
+        if attach is not None:
+            pulumi.set(__self__, "attach", attach)
         if service_role_arns is not None:
             pulumi.set(__self__, "service_role_arns", service_role_arns)
 
     @property
     @pulumi.getter
-    def attach(self) -> pulumi.Input[bool]:
+    def attach(self) -> Optional[pulumi.Input[bool]]:
         """
         Determines whether to attach the FSx for Lustre CSI Driver IAM policy to the role.
         """
         return pulumi.get(self, "attach")
 
     @attach.setter
-    def attach(self, value: pulumi.Input[bool]):
+    def attach(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "attach", value)
 
     @property
@@ -1410,16 +2835,122 @@ class FSxLustreCSIPolicyArgs:
         pulumi.set(self, "service_role_arns", value)
 
 
+@pulumi.input_type
+class InstanceProfileSettingsArgs:
+    def __init__(__self__, *,
+                 name: Optional[pulumi.Input[str]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+        """
+        :param pulumi.Input[str] name: Name of IAM instance profile. Defaults to the name of the role.
+        :param pulumi.Input[str] path: Path of IAM instance profile. Defaults to the path of the role.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add to the instance profile. Defaults to the tags of the role.
+        """
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of IAM instance profile. Defaults to the name of the role.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> Optional[pulumi.Input[str]]:
+        """
+        Path of IAM instance profile. Defaults to the path of the role.
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        A map of tags to add to the instance profile. Defaults to the tags of the role.
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "tags", value)
+
+
 @pulumi.input_type
 class OIDCProviderArgs:
     def __init__(__self__, *,
+                 aws_account_id: Optional[pulumi.Input[str]] = None,
+                 cluster_name: Optional[pulumi.Input[str]] = None,
+                 issuer_url: Optional[pulumi.Input[str]] = None,
                  namespace_service_accounts: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  provider_arn: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] aws_account_id: The AWS account ID where the IAM OIDC provider of `clusterName` or `issuerUrl` lives, defaults to the current account.
+        :param pulumi.Input[str] cluster_name: Name of an EKS cluster in the current region whose OIDC issuer is looked up. Used when `providerArn` is not set.
+        :param pulumi.Input[str] issuer_url: URL of the OIDC issuer. Used when neither `providerArn` nor `clusterName` is set.
+        :param pulumi.Input[str] provider_arn: ARN of the IAM OIDC provider.
+        """
+        if aws_account_id is not None:
+            pulumi.set(__self__, "aws_account_id", aws_account_id)
+        if cluster_name is not None:
+            pulumi.set(__self__, "cluster_name", cluster_name)
+        if issuer_url is not None:
+            pulumi.set(__self__, "issuer_url", issuer_url)
         if namespace_service_accounts is not None:
             pulumi.set(__self__, "namespace_service_accounts", namespace_service_accounts)
         if provider_arn is not None:
             pulumi.set(__self__, "provider_arn", provider_arn)
 
+    @property
+    @pulumi.getter(name="awsAccountId")
+    def aws_account_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The AWS account ID where the IAM OIDC provider of `clusterName` or `issuerUrl` lives, defaults to the current account.
+        """
+        return pulumi.get(self, "aws_account_id")
+
+    @aws_account_id.setter
+    def aws_account_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "aws_account_id", value)
+
+    @property
+    @pulumi.getter(name="clusterName")
+    def cluster_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of an EKS cluster in the current region whose OIDC issuer is looked up. Used when `providerArn` is not set.
+        """
+        return pulumi.get(self, "cluster_name")
+
+    @cluster_name.setter
+    def cluster_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "cluster_name", value)
+
+    @property
+    @pulumi.getter(name="issuerUrl")
+    def issuer_url(self) -> Optional[pulumi.Input[str]]:
+        """
+        URL of the OIDC issuer. Used when neither `providerArn` nor `clusterName` is set.
+        """
+        return pulumi.get(self, "issuer_url")
+
+    @issuer_url.setter
+    def issuer_url(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "issuer_url", value)
+
     @property
     @pulumi.getter(name="namespaceServiceAccounts")
     def namespace_service_accounts(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
@@ -1432,6 +2963,9 @@ class OIDCProviderArgs:
     @property
     @pulumi.getter(name="providerArn")
     def provider_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        ARN of the IAM OIDC provider.
+        """
         return pulumi.get(self, "provider_arn")
 
     @provider_arn.setter
@@ -1439,9 +2973,65 @@ class OIDCProviderArgs:
         pulumi.set(self, "provider_arn", value)
 
 
+@pulumi.input_type
+class PolicyConditionArgs:
+    def __init__(__self__, *,
+                 test: pulumi.Input[str],
+                 values: pulumi.Input[Sequence[pulumi.Input[str]]],
+                 variable: pulumi.Input[str]):
+        """
+        A condition block of an IAM policy statement.
+        :param pulumi.Input[str] test: Name of the IAM condition operator to evaluate, e.g. `StringEquals`.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] values: Values to evaluate the condition against.
+        :param pulumi.Input[str] variable: Name of a Context Variable to apply the condition to, e.g. `aws:PrincipalTag/team`.
+        """
+        pulumi.set(__self__, "test", test)
+        pulumi.set(__self__, "values", values)
+        pulumi.set(__self__, "variable", variable)
+
+    @property
+    @pulumi.getter
+    def test(self) -> pulumi.Input[str]:
+        """
+        Name of the IAM condition operator to evaluate, e.g. `StringEquals`.
+        """
+        return pulumi.get(self, "test")
+
+    @test.setter
+    def test(self, value: pulumi.Input[str]):
+        pulumi.set(self, "test", value)
+
+    @property
+    @pulumi.getter
+    def values(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+        """
+        Values to evaluate the condition against.
+        """
+        return pulumi.get(self, "values")
+
+    @values.setter
+    def values(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "values", value)
+
+    @property
+    @pulumi.getter
+    def variable(self) -> pulumi.Input[str]:
+        """
+        Name of a Context Variable to apply the condition to, e.g. `aws:PrincipalTag/team`.
+        """
+        return pulumi.get(self, "variable")
+
+    @variable.setter
+    def variable(self, value: pulumi.Input[str]):
+        pulumi.set(self, "variable", value)
+
+
 @pulumi.input_type
 class PoweruserRoleWithMFAArgs:
     def __init__(__self__, *,
+                 exclusive_inline_policies: Optional[pulumi.Input[bool]] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
+                 inline_policies: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  path: Optional[pulumi.Input[str]] = None,
                  permissions_boundary_arn: Optional[pulumi.Input[str]] = None,
@@ -1450,6 +3040,12 @@ class PoweruserRoleWithMFAArgs:
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
         """
         The poweruser role.
+        :param pulumi.Input[bool] exclusive_inline_policies: Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the role are detached from it. The role then manages its
+               policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+               attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+               policies. Previews of existing roles warn that undeclared policies are detached.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] inline_policies: Map of inline policy names to policy documents, lists of statements or single statements in JSON.
         :param pulumi.Input[str] name: IAM role with poweruser access.
         :param pulumi.Input[str] path: Path of poweruser IAM role.
         :param pulumi.Input[str] permissions_boundary_arn: Permissions boundary ARN to use for poweruser role.
@@ -1457,6 +3053,16 @@ class PoweruserRoleWithMFAArgs:
         :param pulumi.Input[bool] requires_mfa: Whether admin role requires MFA.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
+        if exclusive_inline_policies is None:
+            exclusive_inline_policies = False
+        if exclusive_inline_policies is not None:
+            pulumi.set(__self__, "exclusive_inline_policies", exclusive_inline_policies)
+        if exclusive_policy_attachments is None:
+            exclusive_policy_attachments = False
+        if exclusive_policy_attachments is not None:
+            pulumi.set(__self__, "exclusive_policy_attachments", exclusive_policy_attachments)
+        if inline_policies is not None:
+            pulumi.set(__self__, "inline_policies", inline_policies)
         if name is not None:
             pulumi.set(__self__, "name", name)
         if path is not None:
@@ -1470,6 +3076,45 @@ class PoweruserRoleWithMFAArgs:
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
 
+    @property
+    @pulumi.getter(name="exclusiveInlinePolicies")
+    def exclusive_inline_policies(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        """
+        return pulumi.get(self, "exclusive_inline_policies")
+
+    @exclusive_inline_policies.setter
+    def exclusive_inline_policies(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_inline_policies", value)
+
+    @property
+    @pulumi.getter(name="exclusivePolicyAttachments")
+    def exclusive_policy_attachments(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether managed policies not declared for the role are detached from it. The role then manages its
+        policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+        attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+        policies. Previews of existing roles warn that undeclared policies are detached.
+        """
+        return pulumi.get(self, "exclusive_policy_attachments")
+
+    @exclusive_policy_attachments.setter
+    def exclusive_policy_attachments(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_policy_attachments", value)
+
+    @property
+    @pulumi.getter(name="inlinePolicies")
+    def inline_policies(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+        """
+        return pulumi.get(self, "inline_policies")
+
+    @inline_policies.setter
+    def inline_policies(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "inline_policies", value)
+
     @property
     @pulumi.getter
     def name(self) -> Optional[pulumi.Input[str]]:
@@ -1546,6 +3191,9 @@ class PoweruserRoleWithMFAArgs:
 @pulumi.input_type
 class PoweruserRoleArgs:
     def __init__(__self__, *,
+                 exclusive_inline_policies: Optional[pulumi.Input[bool]] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
+                 inline_policies: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  path: Optional[pulumi.Input[str]] = None,
                  permissions_boundary_arn: Optional[pulumi.Input[str]] = None,
@@ -1553,12 +3201,28 @@ class PoweruserRoleArgs:
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
         """
         The poweruser role.
+        :param pulumi.Input[bool] exclusive_inline_policies: Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the role are detached from it. The role then manages its
+               policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+               attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+               policies. Previews of existing roles warn that undeclared policies are detached.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] inline_policies: Map of inline policy names to policy documents, lists of statements or single statements in JSON.
         :param pulumi.Input[str] name: IAM role with poweruser access.
         :param pulumi.Input[str] path: Path of poweruser IAM role.
         :param pulumi.Input[str] permissions_boundary_arn: Permissions boundary ARN to use for poweruser role.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] policy_arns: List of policy ARNs to use for poweruser role.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
+        if exclusive_inline_policies is None:
+            exclusive_inline_policies = False
+        if exclusive_inline_policies is not None:
+            pulumi.set(__self__, "exclusive_inline_policies", exclusive_inline_policies)
+        if exclusive_policy_attachments is None:
+            exclusive_policy_attachments = False
+        if exclusive_policy_attachments is not None:
+            pulumi.set(__self__, "exclusive_policy_attachments", exclusive_policy_attachments)
+        if inline_policies is not None:
+            pulumi.set(__self__, "inline_policies", inline_policies)
         if name is not None:
             pulumi.set(__self__, "name", name)
         if path is not None:
@@ -1570,6 +3234,45 @@ class PoweruserRoleArgs:
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
 
+    @property
+    @pulumi.getter(name="exclusiveInlinePolicies")
+    def exclusive_inline_policies(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        """
+        return pulumi.get(self, "exclusive_inline_policies")
+
+    @exclusive_inline_policies.setter
+    def exclusive_inline_policies(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_inline_policies", value)
+
+    @property
+    @pulumi.getter(name="exclusivePolicyAttachments")
+    def exclusive_policy_attachments(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether managed policies not declared for the role are detached from it. The role then manages its
+        policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+        attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+        policies. Previews of existing roles warn that undeclared policies are detached.
+        """
+        return pulumi.get(self, "exclusive_policy_attachments")
+
+    @exclusive_policy_attachments.setter
+    def exclusive_policy_attachments(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_policy_attachments", value)
+
+    @property
+    @pulumi.getter(name="inlinePolicies")
+    def inline_policies(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+        """
+        return pulumi.get(self, "inline_policies")
+
+    @inline_policies.setter
+    def inline_policies(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "inline_policies", value)
+
     @property
     @pulumi.getter
     def name(self) -> Optional[pulumi.Input[str]]:
@@ -1634,6 +3337,9 @@ class PoweruserRoleArgs:
 @pulumi.input_type
 class ReadonlyRoleWithMFAArgs:
     def __init__(__self__, *,
+                 exclusive_inline_policies: Optional[pulumi.Input[bool]] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
+                 inline_policies: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  path: Optional[pulumi.Input[str]] = None,
                  permissions_boundary_arn: Optional[pulumi.Input[str]] = None,
@@ -1642,6 +3348,12 @@ class ReadonlyRoleWithMFAArgs:
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
         """
         The readonly role.
+        :param pulumi.Input[bool] exclusive_inline_policies: Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the role are detached from it. The role then manages its
+               policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+               attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+               policies. Previews of existing roles warn that undeclared policies are detached.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] inline_policies: Map of inline policy names to policy documents, lists of statements or single statements in JSON.
         :param pulumi.Input[str] name: IAM role with readonly access.
         :param pulumi.Input[str] path: Path of readonly IAM role. Defaults to '/'.
         :param pulumi.Input[str] permissions_boundary_arn: Permissions boundary ARN to use for readonly role.
@@ -1649,6 +3361,16 @@ class ReadonlyRoleWithMFAArgs:
         :param pulumi.Input[bool] requires_mfa: Whether admin role requires MFA.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
+        if exclusive_inline_policies is None:
+            exclusive_inline_policies = False
+        if exclusive_inline_policies is not None:
+            pulumi.set(__self__, "exclusive_inline_policies", exclusive_inline_policies)
+        if exclusive_policy_attachments is None:
+            exclusive_policy_attachments = False
+        if exclusive_policy_attachments is not None:
+            pulumi.set(__self__, "exclusive_policy_attachments", exclusive_policy_attachments)
+        if inline_policies is not None:
+            pulumi.set(__self__, "inline_policies", inline_policies)
         if name is not None:
             pulumi.set(__self__, "name", name)
         if path is not None:
@@ -1662,6 +3384,45 @@ class ReadonlyRoleWithMFAArgs:
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
 
+    @property
+    @pulumi.getter(name="exclusiveInlinePolicies")
+    def exclusive_inline_policies(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        """
+        return pulumi.get(self, "exclusive_inline_policies")
+
+    @exclusive_inline_policies.setter
+    def exclusive_inline_policies(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_inline_policies", value)
+
+    @property
+    @pulumi.getter(name="exclusivePolicyAttachments")
+    def exclusive_policy_attachments(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether managed policies not declared for the role are detached from it. The role then manages its
+        policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+        attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+        policies. Previews of existing roles warn that undeclared policies are detached.
+        """
+        return pulumi.get(self, "exclusive_policy_attachments")
+
+    @exclusive_policy_attachments.setter
+    def exclusive_policy_attachments(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_policy_attachments", value)
+
+    @property
+    @pulumi.getter(name="inlinePolicies")
+    def inline_policies(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+        """
+        return pulumi.get(self, "inline_policies")
+
+    @inline_policies.setter
+    def inline_policies(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "inline_policies", value)
+
     @property
     @pulumi.getter
     def name(self) -> Optional[pulumi.Input[str]]:
@@ -1738,6 +3499,9 @@ class ReadonlyRoleWithMFAArgs:
 @pulumi.input_type
 class ReadonlyRoleArgs:
     def __init__(__self__, *,
+                 exclusive_inline_policies: Optional[pulumi.Input[bool]] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
+                 inline_policies: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  path: Optional[pulumi.Input[str]] = None,
                  permissions_boundary_arn: Optional[pulumi.Input[str]] = None,
@@ -1745,12 +3509,28 @@ class ReadonlyRoleArgs:
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
         """
         The readonly role.
+        :param pulumi.Input[bool] exclusive_inline_policies: Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the role are detached from it. The role then manages its
+               policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+               attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+               policies. Previews of existing roles warn that undeclared policies are detached.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] inline_policies: Map of inline policy names to policy documents, lists of statements or single statements in JSON.
         :param pulumi.Input[str] name: IAM role with readonly access.
         :param pulumi.Input[str] path: Path of readonly IAM role. Defaults to '/'.
         :param pulumi.Input[str] permissions_boundary_arn: Permissions boundary ARN to use for readonly role.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] policy_arns: List of policy ARNs to use for readonly role.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
+        if exclusive_inline_policies is None:
+            exclusive_inline_policies = False
+        if exclusive_inline_policies is not None:
+            pulumi.set(__self__, "exclusive_inline_policies", exclusive_inline_policies)
+        if exclusive_policy_attachments is None:
+            exclusive_policy_attachments = False
+        if exclusive_policy_attachments is not None:
+            pulumi.set(__self__, "exclusive_policy_attachments", exclusive_policy_attachments)
+        if inline_policies is not None:
+            pulumi.set(__self__, "inline_policies", inline_policies)
         if name is not None:
             pulumi.set(__self__, "name", name)
         if path is not None:
@@ -1762,6 +3542,45 @@ class ReadonlyRoleArgs:
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
 
+    @property
+    @pulumi.getter(name="exclusiveInlinePolicies")
+    def exclusive_inline_policies(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        """
+        return pulumi.get(self, "exclusive_inline_policies")
+
+    @exclusive_inline_policies.setter
+    def exclusive_inline_policies(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_inline_policies", value)
+
+    @property
+    @pulumi.getter(name="exclusivePolicyAttachments")
+    def exclusive_policy_attachments(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether managed policies not declared for the role are detached from it. The role then manages its
+        policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+        attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+        policies. Previews of existing roles warn that undeclared policies are detached.
+        """
+        return pulumi.get(self, "exclusive_policy_attachments")
+
+    @exclusive_policy_attachments.setter
+    def exclusive_policy_attachments(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_policy_attachments", value)
+
+    @property
+    @pulumi.getter(name="inlinePolicies")
+    def inline_policies(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+        """
+        return pulumi.get(self, "inline_policies")
+
+    @inline_policies.setter
+    def inline_policies(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "inline_policies", value)
+
     @property
     @pulumi.getter
     def name(self) -> Optional[pulumi.Input[str]]:
@@ -1823,9 +3642,201 @@ class ReadonlyRoleArgs:
         pulumi.set(self, "tags", value)
 
 
+@pulumi.input_type
+class RoleSessionArgs:
+    def __init__(__self__, *,
+                 duration_seconds: Optional[pulumi.Input[int]] = None,
+                 policy_actions: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 policy_resources: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 profile_name: Optional[pulumi.Input[str]] = None,
+                 role_chaining: Optional[pulumi.Input[bool]] = None,
+                 session_name: Optional[pulumi.Input[str]] = None,
+                 session_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 source_identity: Optional[pulumi.Input[str]] = None,
+                 source_profile: Optional[pulumi.Input[str]] = None,
+                 transitive_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 web_identity_token_file: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[int] duration_seconds: Requested session duration in seconds. Capped by the maximum session duration of the role and defaults to it.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] policy_actions: Actions the session is limited to by a session policy. No session policy is rendered when empty.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] policy_resources: Resources the session policy limits the actions to. Defaults to all resources.
+        :param pulumi.Input[str] profile_name: Name of the AWS CLI profile. Defaults to the role name.
+        :param pulumi.Input[bool] role_chaining: Whether the role is assumed from another role session, which STS limits to one hour.
+        :param pulumi.Input[str] session_name: Name of the role session. Defaults to the profile name.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] session_tags: Session tags to pass when assuming the role.
+        :param pulumi.Input[str] source_identity: Source identity to set on the session, e.g. the name of the CI pipeline.
+        :param pulumi.Input[str] source_profile: Name of the AWS CLI profile whose credentials assume the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] transitive_tag_keys: Session tag keys which persist when the session assumes another role.
+        :param pulumi.Input[str] web_identity_token_file: Path of the web identity token for roles assumed with AssumeRoleWithWebIdentity. Required for the
+               AWS CLI config profile of these roles.
+        """
+        if duration_seconds is not None:
+            pulumi.set(__self__, "duration_seconds", duration_seconds)
+        if policy_actions is not None:
+            pulumi.set(__self__, "policy_actions", policy_actions)
+        if policy_resources is not None:
+            pulumi.set(__self__, "policy_resources", policy_resources)
+        if profile_name is not None:
+            pulumi.set(__self__, "profile_name", profile_name)
+        if role_chaining is None:
+            role_chaining = False
+        if role_chaining is not None:
+            pulumi.set(__self__, "role_chaining", role_chaining)
+        if session_name is not None:
+            pulumi.set(__self__, "session_name", session_name)
+        if session_tags is not None:
+            pulumi.set(__self__, "session_tags", session_tags)
+        if source_identity is not None:
+            pulumi.set(__self__, "source_identity", source_identity)
+        if source_profile is None:
+            source_profile = 'default'
+        if source_profile is not None:
+            pulumi.set(__self__, "source_profile", source_profile)
+        if transitive_tag_keys is not None:
+            pulumi.set(__self__, "transitive_tag_keys", transitive_tag_keys)
+        if web_identity_token_file is not None:
+            pulumi.set(__self__, "web_identity_token_file", web_identity_token_file)
+
+    @property
+    @pulumi.getter(name="durationSeconds")
+    def duration_seconds(self) -> Optional[pulumi.Input[int]]:
+        """
+        Requested session duration in seconds. Capped by the maximum session duration of the role and defaults to it.
+        """
+        return pulumi.get(self, "duration_seconds")
+
+    @duration_seconds.setter
+    def duration_seconds(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "duration_seconds", value)
+
+    @property
+    @pulumi.getter(name="policyActions")
+    def policy_actions(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Actions the session is limited to by a session policy. No session policy is rendered when empty.
+        """
+        return pulumi.get(self, "policy_actions")
+
+    @policy_actions.setter
+    def policy_actions(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "policy_actions", value)
+
+    @property
+    @pulumi.getter(name="policyResources")
+    def policy_resources(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Resources the session policy limits the actions to. Defaults to all resources.
+        """
+        return pulumi.get(self, "policy_resources")
+
+    @policy_resources.setter
+    def policy_resources(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "policy_resources", value)
+
+    @property
+    @pulumi.getter(name="profileName")
+    def profile_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the AWS CLI profile. Defaults to the role name.
+        """
+        return pulumi.get(self, "profile_name")
+
+    @profile_name.setter
+    def profile_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "profile_name", value)
+
+    @property
+    @pulumi.getter(name="roleChaining")
+    def role_chaining(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the role is assumed from another role session, which STS limits to one hour.
+        """
+        return pulumi.get(self, "role_chaining")
+
+    @role_chaining.setter
+    def role_chaining(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "role_chaining", value)
+
+    @property
+    @pulumi.getter(name="sessionName")
+    def session_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the role session. Defaults to the profile name.
+        """
+        return pulumi.get(self, "session_name")
+
+    @session_name.setter
+    def session_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "session_name", value)
+
+    @property
+    @pulumi.getter(name="sessionTags")
+    def session_tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Session tags to pass when assuming the role.
+        """
+        return pulumi.get(self, "session_tags")
+
+    @session_tags.setter
+    def session_tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "session_tags", value)
+
+    @property
+    @pulumi.getter(name="sourceIdentity")
+    def source_identity(self) -> Optional[pulumi.Input[str]]:
+        """
+        Source identity to set on the session, e.g. the name of the CI pipeline.
+        """
+        return pulumi.get(self, "source_identity")
+
+    @source_identity.setter
+    def source_identity(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "source_identity", value)
+
+    @property
+    @pulumi.getter(name="sourceProfile")
+    def source_profile(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the AWS CLI profile whose credentials assume the role.
+        """
+        return pulumi.get(self, "source_profile")
+
+    @source_profile.setter
+    def source_profile(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "source_profile", value)
+
+    @property
+    @pulumi.getter(name="transitiveTagKeys")
+    def transitive_tag_keys(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Session tag keys which persist when the session assumes another role.
+        """
+        return pulumi.get(self, "transitive_tag_keys")
+
+    @transitive_tag_keys.setter
+    def transitive_tag_keys(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "transitive_tag_keys", value)
+
+    @property
+    @pulumi.getter(name="webIdentityTokenFile")
+    def web_identity_token_file(self) -> Optional[pulumi.Input[str]]:
+        """
+        Path of the web identity token for roles assumed with AssumeRoleWithWebIdentity. Required for the
+        AWS CLI config profile of these roles.
+        """
+        return pulumi.get(self, "web_identity_token_file")
+
+    @web_identity_token_file.setter
+    def web_identity_token_file(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "web_identity_token_file", value)
+
+
 @pulumi.input_type
 class RoleWithMFAArgs:
     def __init__(__self__, *,
+                 exclusive_inline_policies: Optional[pulumi.Input[bool]] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
+                 inline_policies: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  path: Optional[pulumi.Input[str]] = None,
                  permissions_boundary_arn: Optional[pulumi.Input[str]] = None,
@@ -1834,6 +3845,12 @@ class RoleWithMFAArgs:
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
         """
         An IAM role that requires MFA.
+        :param pulumi.Input[bool] exclusive_inline_policies: Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the role are detached from it. The role then manages its
+               policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+               attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+               policies. Previews of existing roles warn that undeclared policies are detached.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] inline_policies: Map of inline policy names to policy documents, lists of statements or single statements in JSON.
         :param pulumi.Input[str] name: IAM role with the access. Defaults to 'admin'.
         :param pulumi.Input[str] path: Path of the IAM role. Defaults to '/'.
         :param pulumi.Input[str] permissions_boundary_arn: Permissions boundary ARN to use for the role.
@@ -1841,6 +3858,16 @@ class RoleWithMFAArgs:
         :param pulumi.Input[bool] requires_mfa: Whether the role requires MFA.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
+        if exclusive_inline_policies is None:
+            exclusive_inline_policies = False
+        if exclusive_inline_policies is not None:
+            pulumi.set(__self__, "exclusive_inline_policies", exclusive_inline_policies)
+        if exclusive_policy_attachments is None:
+            exclusive_policy_attachments = False
+        if exclusive_policy_attachments is not None:
+            pulumi.set(__self__, "exclusive_policy_attachments", exclusive_policy_attachments)
+        if inline_policies is not None:
+            pulumi.set(__self__, "inline_policies", inline_policies)
         if name is not None:
             pulumi.set(__self__, "name", name)
         if path is not None:
@@ -1854,6 +3881,45 @@ class RoleWithMFAArgs:
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
 
+    @property
+    @pulumi.getter(name="exclusiveInlinePolicies")
+    def exclusive_inline_policies(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        """
+        return pulumi.get(self, "exclusive_inline_policies")
+
+    @exclusive_inline_policies.setter
+    def exclusive_inline_policies(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_inline_policies", value)
+
+    @property
+    @pulumi.getter(name="exclusivePolicyAttachments")
+    def exclusive_policy_attachments(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether managed policies not declared for the role are detached from it. The role then manages its
+        policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+        attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+        policies. Previews of existing roles warn that undeclared policies are detached.
+        """
+        return pulumi.get(self, "exclusive_policy_attachments")
+
+    @exclusive_policy_attachments.setter
+    def exclusive_policy_attachments(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_policy_attachments", value)
+
+    @property
+    @pulumi.getter(name="inlinePolicies")
+    def inline_policies(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+        """
+        return pulumi.get(self, "inline_policies")
+
+    @inline_policies.setter
+    def inline_policies(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "inline_policies", value)
+
     @property
     @pulumi.getter
     def name(self) -> Optional[pulumi.Input[str]]:
@@ -1930,6 +3996,9 @@ class RoleWithMFAArgs:
 @pulumi.input_type
 class RoleArgs:
     def __init__(__self__, *,
+                 exclusive_inline_policies: Optional[pulumi.Input[bool]] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
+                 inline_policies: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  name_prefix: Optional[pulumi.Input[str]] = None,
                  path: Optional[pulumi.Input[str]] = None,
@@ -1937,12 +4006,28 @@ class RoleArgs:
                  policy_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
         An IAM role.
+        :param pulumi.Input[bool] exclusive_inline_policies: Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the role are detached from it. The role then manages its
+               policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+               attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+               policies. Previews of existing roles warn that undeclared policies are detached.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] inline_policies: Map of inline policy names to policy documents, lists of statements or single statements in JSON.
         :param pulumi.Input[str] name: IAM role name.
         :param pulumi.Input[str] name_prefix: IAM role name prefix.
         :param pulumi.Input[str] path: Path of admin IAM role. Defaults to '/'.
         :param pulumi.Input[str] permissions_boundary_arn: Permissions boundary ARN to use for the role.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] policy_arns: List of policy ARNs to use for the role.
         """
+        if exclusive_inline_policies is None:
+            exclusive_inline_policies = False
+        if exclusive_inline_policies is not None:
+            pulumi.set(__self__, "exclusive_inline_policies", exclusive_inline_policies)
+        if exclusive_policy_attachments is None:
+            exclusive_policy_attachments = False
+        if exclusive_policy_attachments is not None:
+            pulumi.set(__self__, "exclusive_policy_attachments", exclusive_policy_attachments)
+        if inline_policies is not None:
+            pulumi.set(__self__, "inline_policies", inline_policies)
         if name is not None:
             pulumi.set(__self__, "name", name)
         if name_prefix is not None:
@@ -1954,6 +4039,45 @@ class RoleArgs:
         if policy_arns is not None:
             pulumi.set(__self__, "policy_arns", policy_arns)
 
+    @property
+    @pulumi.getter(name="exclusiveInlinePolicies")
+    def exclusive_inline_policies(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether inline policies not declared in `inlinePolicies` are removed from the role.
+        """
+        return pulumi.get(self, "exclusive_inline_policies")
+
+    @exclusive_inline_policies.setter
+    def exclusive_inline_policies(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_inline_policies", value)
+
+    @property
+    @pulumi.getter(name="exclusivePolicyAttachments")
+    def exclusive_policy_attachments(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether managed policies not declared for the role are detached from it. The role then manages its
+        policies instead of policy attachments. Before enabling it for an existing role, remove the policy
+        attachments of the role from the state with `pulumi state delete`, as deleting them detaches the
+        policies. Previews of existing roles warn that undeclared policies are detached.
+        """
+        return pulumi.get(self, "exclusive_policy_attachments")
+
+    @exclusive_policy_attachments.setter
+    def exclusive_policy_attachments(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_policy_attachments", value)
+
+    @property
+    @pulumi.getter(name="inlinePolicies")
+    def inline_policies(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Map of inline policy names to policy documents, lists of statements or single statements in JSON.
+        """
+        return pulumi.get(self, "inline_policies")
+
+    @inline_policies.setter
+    def inline_policies(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "inline_policies", value)
+
     @property
     @pulumi.getter
     def name(self) -> Optional[pulumi.Input[str]]:
@@ -0,0 +1,334 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['AbacPolicyArgs', 'AbacPolicy']
+
+@pulumi.input_type
+class AbacPolicyArgs:
+    def __init__(__self__, *,
+                 action_groups: pulumi.Input[Sequence[pulumi.Input['AbacActionGroupArgs']]],
+                 tag_keys: pulumi.Input[Sequence[pulumi.Input[str]]],
+                 allow_tag_modification: Optional[pulumi.Input[bool]] = None,
+                 description: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+        """
+        The set of arguments for constructing a AbacPolicy resource.
+        :param pulumi.Input[Sequence[pulumi.Input['AbacActionGroupArgs']]] action_groups: Groups of actions to allow for each service.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] tag_keys: Tag keys that must match between the principal and the resources.
+        :param pulumi.Input[bool] allow_tag_modification: Whether to leave out the statements which prevent principals from changing or removing the tags
+               used for access control.
+        :param pulumi.Input[str] description: The description of the policy.
+        :param pulumi.Input[str] name: The name of the policy.
+        :param pulumi.Input[str] path: The path of the policy in IAM.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        """
+        pulumi.set(__self__, "action_groups", action_groups)
+        pulumi.set(__self__, "tag_keys", tag_keys)
+        if allow_tag_modification is None:
+            allow_tag_modification = False
+        if allow_tag_modification is not None:
+            pulumi.set(__self__, "allow_tag_modification", allow_tag_modification)
+        if description is None:
+            description = 'Attribute-based access control policy'
+        if description is not None:
+            pulumi.set(__self__, "description", description)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if path is None:
+            path = '/'
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter(name="actionGroups")
+    def action_groups(self) -> pulumi.Input[Sequence[pulumi.Input['AbacActionGroupArgs']]]:
+        """
+        Groups of actions to allow for each service.
+        """
+        return pulumi.get(self, "action_groups")
+
+    @action_groups.setter
+    def action_groups(self, value: pulumi.Input[Sequence[pulumi.Input['AbacActionGroupArgs']]]):
+        pulumi.set(self, "action_groups", value)
+
+    @property
+    @pulumi.getter(name="tagKeys")
+    def tag_keys(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+        """
+        Tag keys that must match between the principal and the resources.
+        """
+        return pulumi.get(self, "tag_keys")
+
+    @tag_keys.setter
+    def tag_keys(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "tag_keys", value)
+
+    @property
+    @pulumi.getter(name="allowTagModification")
+    def allow_tag_modification(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to leave out the statements which prevent principals from changing or removing the tags
+        used for access control.
+        """
+        return pulumi.get(self, "allow_tag_modification")
+
+    @allow_tag_modification.setter
+    def allow_tag_modification(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "allow_tag_modification", value)
+
+    @property
+    @pulumi.getter
+    def description(self) -> Optional[pulumi.Input[str]]:
+        """
+        The description of the policy.
+        """
+        return pulumi.get(self, "description")
+
+    @description.setter
+    def description(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "description", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the policy.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> Optional[pulumi.Input[str]]:
+        """
+        The path of the policy in IAM.
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        A map of tags to add.
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "tags", value)
+
+
+class AbacPolicy(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 action_groups: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AbacActionGroupArgs']]]]] = None,
+                 allow_tag_modification: Optional[pulumi.Input[bool]] = None,
+                 description: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        """
+        This resource helps you create an attribute-based access control (ABAC) IAM policy. Actions are only allowed
+        on resources whose tags match the tags of the principal (`aws:ResourceTag` against `aws:PrincipalTag`),
+        resources can only be created with matching tags (`aws:RequestTag`) and principals are prevented from
+        changing or removing the tags used for access control.
+
+        ## Example Usage
+        ## ABAC Policy
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        abac_policy = iam.AbacPolicy(
+            'abac_policy',
+            name='team-abac',
+            tag_keys=['team', 'project'],
+            action_groups=[iam.AbacActionGroupArgs(
+                service='ec2',
+                actions=['StartInstances', 'StopInstances', 'RebootInstances'],
+                create_actions=['RunInstances'],
+            )],
+        )
+
+        pulumi.export('abac_policy', abac_policy)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AbacActionGroupArgs']]]] action_groups: Groups of actions to allow for each service.
+        :param pulumi.Input[bool] allow_tag_modification: Whether to leave out the statements which prevent principals from changing or removing the tags
+               used for access control.
+        :param pulumi.Input[str] description: The description of the policy.
+        :param pulumi.Input[str] name: The name of the policy.
+        :param pulumi.Input[str] path: The path of the policy in IAM.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] tag_keys: Tag keys that must match between the principal and the resources.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: AbacPolicyArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        This resource helps you create an attribute-based access control (ABAC) IAM policy. Actions are only allowed
+        on resources whose tags match the tags of the principal (`aws:ResourceTag` against `aws:PrincipalTag`),
+        resources can only be created with matching tags (`aws:RequestTag`) and principals are prevented from
+        changing or removing the tags used for access control.
+
+        ## Example Usage
+        ## ABAC Policy
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        abac_policy = iam.AbacPolicy(
+            'abac_policy',
+            name='team-abac',
+            tag_keys=['team', 'project'],
+            action_groups=[iam.AbacActionGroupArgs(
+                service='ec2',
+                actions=['StartInstances', 'StopInstances', 'RebootInstances'],
+                create_actions=['RunInstances'],
+            )],
+        )
+
+        pulumi.export('abac_policy', abac_policy)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param AbacPolicyArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(AbacPolicyArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 action_groups: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AbacActionGroupArgs']]]]] = None,
+                 allow_tag_modification: Optional[pulumi.Input[bool]] = None,
+                 description: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = AbacPolicyArgs.__new__(AbacPolicyArgs)
+
+            if action_groups is None and not opts.urn:
+                raise TypeError("Missing required property 'action_groups'")
+            __props__.__dict__["action_groups"] = action_groups
+            if allow_tag_modification is None:
+                allow_tag_modification = False
+            __props__.__dict__["allow_tag_modification"] = allow_tag_modification
+            if description is None:
+                description = 'Attribute-based access control policy'
+            __props__.__dict__["description"] = description
+            __props__.__dict__["name"] = name
+            if path is None:
+                path = '/'
+            __props__.__dict__["path"] = path
+            if tag_keys is None and not opts.urn:
+                raise TypeError("Missing required property 'tag_keys'")
+            __props__.__dict__["tag_keys"] = tag_keys
+            __props__.__dict__["tags"] = tags
+            __props__.__dict__["arn"] = None
+            __props__.__dict__["id"] = None
+            __props__.__dict__["policy_json"] = None
+        super(AbacPolicy, __self__).__init__(
+            'aws-iam:index:AbacPolicy',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter
+    def arn(self) -> pulumi.Output[str]:
+        """
+        The ARN assigned by AWS to this policy.
+        """
+        return pulumi.get(self, "arn")
+
+    @property
+    @pulumi.getter
+    def description(self) -> pulumi.Output[str]:
+        """
+        The description of the policy.
+        """
+        return pulumi.get(self, "description")
+
+    @property
+    @pulumi.getter
+    def id(self) -> pulumi.Output[str]:
+        """
+        The policy's ID.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        """
+        The name of the policy.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Output[str]:
+        """
+        The path of the policy in IAM.
+        """
+        return pulumi.get(self, "path")
+
+    @property
+    @pulumi.getter(name="policyJson")
+    def policy_json(self) -> pulumi.Output[str]:
+        """
+        Policy document as json.
+        """
+        return pulumi.get(self, "policy_json")
+
@@ -15,32 +15,78 @@ __all__ = ['AccountArgs', 'Account']
 @pulumi.input_type
 class AccountArgs:
     def __init__(__self__, *,
-                 account_alias: pulumi.Input[str],
-                 password_policy: pulumi.Input['AccountPasswordPolicyArgs']):
+                 account_alias: Optional[pulumi.Input[str]] = None,
+                 import_account_alias: Optional[pulumi.Input[bool]] = None,
+                 import_password_policy: Optional[pulumi.Input[bool]] = None,
+                 password_policy: Optional[pulumi.Input['AccountPasswordPolicyArgs']] = None,
+                 password_policy_preset: Optional[pulumi.Input[str]] = None):
         """
         The set of arguments for constructing a Account resource.
-        :param pulumi.Input[str] account_alias: AWS IAM account alias for this account.
+        :param pulumi.Input[str] account_alias: AWS IAM account alias for this account. If not set, the account alias is not managed.
+        :param pulumi.Input[bool] import_account_alias: Whether to adopt an account alias that already exists with the same name instead of creating it.
+        :param pulumi.Input[bool] import_password_policy: Whether to adopt the password policy that already exists in the account instead of creating it. The
+               provided settings must match the existing policy for the import to succeed.
         :param pulumi.Input['AccountPasswordPolicyArgs'] password_policy: Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords. If
                left empty the default AWS password policy will be applied.
+        :param pulumi.Input[str] password_policy_preset: Name of a predefined password policy to apply. Valid values are `cis`, which follows the CIS AWS Foundations
+               Benchmark (minimum length of 14, 24 passwords remembered, passwords expire after 90 days). Settings
+               provided in `passwordPolicy` take precedence over the preset, e.g. `requireSymbols: false`.
         """
-        pulumi.set(__self__, "account_alias", account_alias)
-        pulumi.set(__self__, "password_policy", password_policy)
+        if account_alias is not None:
+            pulumi.set(__self__, "account_alias", account_alias)
+        if import_account_alias is None:
+            import_account_alias = False
+        if import_account_alias is not None:
+            pulumi.set(__self__, "import_account_alias", import_account_alias)
+        if import_password_policy is None:
+            import_password_policy = False
+        if import_password_policy is not None:
+            pulumi.set(__self__, "import_password_policy", import_password_policy)
+        if password_policy is not None:
+            pulumi.set(__self__, "password_policy", password_policy)
+        if password_policy_preset is not None:
+            pulumi.set(__self__, "password_policy_preset", password_policy_preset)
 
     @property
     @pulumi.getter(name="accountAlias")
-    def account_alias(self) -> pulumi.Input[str]:
+    def account_alias(self) -> Optional[pulumi.Input[str]]:
         """
-        AWS IAM account alias for this account.
+        AWS IAM account alias for this account. If not set, the account alias is not managed.
         """
         return pulumi.get(self, "account_alias")
 
     @account_alias.setter
-    def account_alias(self, value: pulumi.Input[str]):
+    def account_alias(self, value: Optional[pulumi.Input[str]]):
         pulumi.set(self, "account_alias", value)
 
+    @property
+    @pulumi.getter(name="importAccountAlias")
+    def import_account_alias(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to adopt an account alias that already exists with the same name instead of creating it.
+        """
+        return pulumi.get(self, "import_account_alias")
+
+    @import_account_alias.setter
+    def import_account_alias(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "import_account_alias", value)
+
+    @property
+    @pulumi.getter(name="importPasswordPolicy")
+    def import_password_policy(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to adopt the password policy that already exists in the account instead of creating it. The
+        provided settings must match the existing policy for the import to succeed.
+        """
+        return pulumi.get(self, "import_password_policy")
+
+    @import_password_policy.setter
+    def import_password_policy(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "import_password_policy", value)
+
     @property
     @pulumi.getter(name="passwordPolicy")
-    def password_policy(self) -> pulumi.Input['AccountPasswordPolicyArgs']:
+    def password_policy(self) -> Optional[pulumi.Input['AccountPasswordPolicyArgs']]:
         """
         Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords. If
         left empty the default AWS password policy will be applied.
@@ -48,9 +94,23 @@ class AccountArgs:
         return pulumi.get(self, "password_policy")
 
     @password_policy.setter
-    def password_policy(self, value: pulumi.Input['AccountPasswordPolicyArgs']):
+    def password_policy(self, value: Optional[pulumi.Input['AccountPasswordPolicyArgs']]):
         pulumi.set(self, "password_policy", value)
 
+    @property
+    @pulumi.getter(name="passwordPolicyPreset")
+    def password_policy_preset(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of a predefined password policy to apply. Valid values are `cis`, which follows the CIS AWS Foundations
+        Benchmark (minimum length of 14, 24 passwords remembered, passwords expire after 90 days). Settings
+        provided in `passwordPolicy` take precedence over the preset, e.g. `requireSymbols: false`.
+        """
+        return pulumi.get(self, "password_policy_preset")
+
+    @password_policy_preset.setter
+    def password_policy_preset(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "password_policy_preset", value)
+
 
 class Account(pulumi.ComponentResource):
     @overload
@@ -58,13 +118,18 @@ class Account(pulumi.ComponentResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  account_alias: Optional[pulumi.Input[str]] = None,
+                 import_account_alias: Optional[pulumi.Input[bool]] = None,
+                 import_password_policy: Optional[pulumi.Input[bool]] = None,
                  password_policy: Optional[pulumi.Input[pulumi.InputType['AccountPasswordPolicyArgs']]] = None,
+                 password_policy_preset: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         """
-        This resource helps you manage an Iam Account's Alias and Password Policy. If your IAM Account Alias was previously
-        set (either via the AWS console or when AWS created your Account) you will see an error like the below:
+        This resource helps you manage an Iam Account's Alias and Password Policy. Both are optional, only the ones
+        provided will be managed. If your IAM Account Alias was previously set (either via the AWS console or when
+        AWS created your Account) you will see an error like the below:
 
-        If you want to manage you Alias using Pulumi you will need to import this resource.
+        If you want to manage you Alias using Pulumi you will need to import this resource, which can be done by
+        setting `importAccountAlias`. An existing password policy can be adopted the same way with `importPasswordPolicy`.
 
         ## Example Usage
         ## Account
@@ -93,21 +158,29 @@ class Account(pulumi.ComponentResource):
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
-        :param pulumi.Input[str] account_alias: AWS IAM account alias for this account.
+        :param pulumi.Input[str] account_alias: AWS IAM account alias for this account. If not set, the account alias is not managed.
+        :param pulumi.Input[bool] import_account_alias: Whether to adopt an account alias that already exists with the same name instead of creating it.
+        :param pulumi.Input[bool] import_password_policy: Whether to adopt the password policy that already exists in the account instead of creating it. The
+               provided settings must match the existing policy for the import to succeed.
         :param pulumi.Input[pulumi.InputType['AccountPasswordPolicyArgs']] password_policy: Options to specify complexity requirements and mandatory rotation periods for your IAM users' passwords. If
                left empty the default AWS password policy will be applied.
+        :param pulumi.Input[str] password_policy_preset: Name of a predefined password policy to apply. Valid values are `cis`, which follows the CIS AWS Foundations
+               Benchmark (minimum length of 14, 24 passwords remembered, passwords expire after 90 days). Settings
+               provided in `passwordPolicy` take precedence over the preset, e.g. `requireSymbols: false`.
         """
         ...
     @overload
     def __init__(__self__,
                  resource_name: str,
-                 args: AccountArgs,
+                 args: Optional[AccountArgs] = None,
                  opts: Optional[pulumi.ResourceOptions] = None):
         """
-        This resource helps you manage an Iam Account's Alias and Password Policy. If your IAM Account Alias was previously
-        set (either via the AWS console or when AWS created your Account) you will see an error like the below:
+        This resource helps you manage an Iam Account's Alias and Password Policy. Both are optional, only the ones
+        provided will be managed. If your IAM Account Alias was previously set (either via the AWS console or when
+        AWS created your Account) you will see an error like the below:
 
-        If you want to manage you Alias using Pulumi you will need to import this resource.
+        If you want to manage you Alias using Pulumi you will need to import this resource, which can be done by
+        setting `importAccountAlias`. An existing password policy can be adopted the same way with `importPasswordPolicy`.
 
         ## Example Usage
         ## Account
@@ -150,7 +223,10 @@ class Account(pulumi.ComponentResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  account_alias: Optional[pulumi.Input[str]] = None,
+                 import_account_alias: Optional[pulumi.Input[bool]] = None,
+                 import_password_policy: Optional[pulumi.Input[bool]] = None,
                  password_policy: Optional[pulumi.Input[pulumi.InputType['AccountPasswordPolicyArgs']]] = None,
+                 password_policy_preset: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -162,12 +238,15 @@ class Account(pulumi.ComponentResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = AccountArgs.__new__(AccountArgs)
 
-            if account_alias is None and not opts.urn:
-                raise TypeError("Missing required property 'account_alias'")
             __props__.__dict__["account_alias"] = account_alias
-            if password_policy is None and not opts.urn:
-                raise TypeError("Missing required property 'password_policy'")
+            if import_account_alias is None:
+                import_account_alias = False
+            __props__.__dict__["import_account_alias"] = import_account_alias
+            if import_password_policy is None:
+                import_password_policy = False
+            __props__.__dict__["import_password_policy"] = import_password_policy
             __props__.__dict__["password_policy"] = password_policy
+            __props__.__dict__["password_policy_preset"] = password_policy_preset
             __props__.__dict__["arn"] = None
             __props__.__dict__["id"] = None
             __props__.__dict__["password_policy_expire_passwords"] = None
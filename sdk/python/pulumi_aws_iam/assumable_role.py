@@ -8,6 +8,7 @@ import pulumi
 import pulumi.runtime
 from typing import Any, Mapping, Optional, Sequence, Union, overload
 from . import _utilities
+from . import outputs
 from ._inputs import *
 
 __all__ = ['AssumableRoleArgs', 'AssumableRole']
@@ -15,35 +16,53 @@ __all__ = ['AssumableRoleArgs', 'AssumableRole']
 @pulumi.input_type
 class AssumableRoleArgs:
     def __init__(__self__, *,
+                 allow_session_tags: Optional[pulumi.Input[bool]] = None,
                  attach_admin_policy: Optional[pulumi.Input[bool]] = None,
                  attach_poweruser_policy: Optional[pulumi.Input[bool]] = None,
                  attach_readonly_policy: Optional[pulumi.Input[bool]] = None,
+                 aws_config_source: Optional[pulumi.Input['AWSConfigSourceArgs']] = None,
+                 create_instance_profile: Optional[pulumi.Input[bool]] = None,
                  custom_role_trust_policy: Optional[pulumi.Input[str]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
+                 instance_profile: Optional[pulumi.Input['InstanceProfileSettingsArgs']] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
                  mfa_age: Optional[pulumi.Input[int]] = None,
                  role: Optional[pulumi.Input['RoleWithMFAArgs']] = None,
                  role_sts_external_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 session: Optional[pulumi.Input['RoleSessionArgs']] = None,
+                 session_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 trust_conditions: Optional[pulumi.Input[Sequence[pulumi.Input['PolicyConditionArgs']]]] = None,
                  trusted_role_actions: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  trusted_role_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  trusted_role_services: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
         The set of arguments for constructing a AssumableRole resource.
+        :param pulumi.Input[bool] allow_session_tags: Whether trusted entities are allowed to pass session tags (`sts:TagSession`) when assuming the role.
         :param pulumi.Input[bool] attach_admin_policy: Whether to attach an admin policy to a role.
         :param pulumi.Input[bool] attach_poweruser_policy: Whether to attach a poweruser policy to a role.
         :param pulumi.Input[bool] attach_readonly_policy: Whether to attach a readonly policy to a role.
+        :param pulumi.Input['AWSConfigSourceArgs'] aws_config_source: Credentials assuming the roles in the rendered AWS CLI config.
+        :param pulumi.Input[bool] create_instance_profile: Whether to create an IAM instance profile for the role.
         :param pulumi.Input[str] custom_role_trust_policy: A custom role trust policy.
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
+        :param pulumi.Input['InstanceProfileSettingsArgs'] instance_profile: IAM instance profile created when `createInstanceProfile` is set.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
         :param pulumi.Input[int] mfa_age: Max age of valid MFA (in seconds) for roles which require MFA.
         :param pulumi.Input['RoleWithMFAArgs'] role: An IAM role that requires MFA.
-        :param pulumi.Input[Sequence[pulumi.Input[str]]] role_sts_external_ids: STS ExternalId condition values to use with a role (when MFA is not required).
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] role_sts_external_ids: STS ExternalId condition values to use with a role. Combined with the MFA conditions when MFA is required.
+        :param pulumi.Input['RoleSessionArgs'] session: Settings of the session helpers rendered for the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] session_tag_keys: Tag keys that trusted entities are allowed to pass as session tags.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        :param pulumi.Input[Sequence[pulumi.Input['PolicyConditionArgs']]] trust_conditions: Additional conditions to add to the role trust policy.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] trusted_role_actions: Actions of STS.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] trusted_role_arns: ARNs of AWS entities who can assume these roles.
-        :param pulumi.Input[Sequence[pulumi.Input[str]]] trusted_role_services: AWS Services that can assume these roles.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] trusted_role_services: AWS Services that can assume these roles. They are trusted without the MFA and ExternalId conditions.
         """
+        if allow_session_tags is None:
+            allow_session_tags = False
+        if allow_session_tags is not None:
+            pulumi.set(__self__, "allow_session_tags", allow_session_tags)
         if attach_admin_policy is None:
             attach_admin_policy = False
         if attach_admin_policy is not None:
@@ -56,6 +75,12 @@ class AssumableRoleArgs:
             attach_readonly_policy = False
         if attach_readonly_policy is not None:
             pulumi.set(__self__, "attach_readonly_policy", attach_readonly_policy)
+        if aws_config_source is not None:
+            pulumi.set(__self__, "aws_config_source", aws_config_source)
+        if create_instance_profile is None:
+            create_instance_profile = True
+        if create_instance_profile is not None:
+            pulumi.set(__self__, "create_instance_profile", create_instance_profile)
         if custom_role_trust_policy is None:
             custom_role_trust_policy = ''
         if custom_role_trust_policy is not None:
@@ -64,6 +89,8 @@ class AssumableRoleArgs:
             force_detach_policies = False
         if force_detach_policies is not None:
             pulumi.set(__self__, "force_detach_policies", force_detach_policies)
+        if instance_profile is not None:
+            pulumi.set(__self__, "instance_profile", instance_profile)
         if max_session_duration is None:
             max_session_duration = 3600
         if max_session_duration is not None:
@@ -76,8 +103,14 @@ class AssumableRoleArgs:
             pulumi.set(__self__, "role", role)
         if role_sts_external_ids is not None:
             pulumi.set(__self__, "role_sts_external_ids", role_sts_external_ids)
+        if session is not None:
+            pulumi.set(__self__, "session", session)
+        if session_tag_keys is not None:
+            pulumi.set(__self__, "session_tag_keys", session_tag_keys)
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
+        if trust_conditions is not None:
+            pulumi.set(__self__, "trust_conditions", trust_conditions)
         if trusted_role_actions is not None:
             pulumi.set(__self__, "trusted_role_actions", trusted_role_actions)
         if trusted_role_arns is not None:
@@ -85,6 +118,18 @@ class AssumableRoleArgs:
         if trusted_role_services is not None:
             pulumi.set(__self__, "trusted_role_services", trusted_role_services)
 
+    @property
+    @pulumi.getter(name="allowSessionTags")
+    def allow_session_tags(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether trusted entities are allowed to pass session tags (`sts:TagSession`) when assuming the role.
+        """
+        return pulumi.get(self, "allow_session_tags")
+
+    @allow_session_tags.setter
+    def allow_session_tags(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "allow_session_tags", value)
+
     @property
     @pulumi.getter(name="attachAdminPolicy")
     def attach_admin_policy(self) -> Optional[pulumi.Input[bool]]:
@@ -121,6 +166,30 @@ class AssumableRoleArgs:
     def attach_readonly_policy(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "attach_readonly_policy", value)
 
+    @property
+    @pulumi.getter(name="awsConfigSource")
+    def aws_config_source(self) -> Optional[pulumi.Input['AWSConfigSourceArgs']]:
+        """
+        Credentials assuming the roles in the rendered AWS CLI config.
+        """
+        return pulumi.get(self, "aws_config_source")
+
+    @aws_config_source.setter
+    def aws_config_source(self, value: Optional[pulumi.Input['AWSConfigSourceArgs']]):
+        pulumi.set(self, "aws_config_source", value)
+
+    @property
+    @pulumi.getter(name="createInstanceProfile")
+    def create_instance_profile(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to create an IAM instance profile for the role.
+        """
+        return pulumi.get(self, "create_instance_profile")
+
+    @create_instance_profile.setter
+    def create_instance_profile(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "create_instance_profile", value)
+
     @property
     @pulumi.getter(name="customRoleTrustPolicy")
     def custom_role_trust_policy(self) -> Optional[pulumi.Input[str]]:
@@ -145,6 +214,18 @@ class AssumableRoleArgs:
     def force_detach_policies(self, value: Optional[pulumi.Input[bool]]):
         pulumi.set(self, "force_detach_policies", value)
 
+    @property
+    @pulumi.getter(name="instanceProfile")
+    def instance_profile(self) -> Optional[pulumi.Input['InstanceProfileSettingsArgs']]:
+        """
+        IAM instance profile created when `createInstanceProfile` is set.
+        """
+        return pulumi.get(self, "instance_profile")
+
+    @instance_profile.setter
+    def instance_profile(self, value: Optional[pulumi.Input['InstanceProfileSettingsArgs']]):
+        pulumi.set(self, "instance_profile", value)
+
     @property
     @pulumi.getter(name="maxSessionDuration")
     def max_session_duration(self) -> Optional[pulumi.Input[int]]:
@@ -185,7 +266,7 @@ class AssumableRoleArgs:
     @pulumi.getter(name="roleStsExternalIds")
     def role_sts_external_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
         """
-        STS ExternalId condition values to use with a role (when MFA is not required).
+        STS ExternalId condition values to use with a role. Combined with the MFA conditions when MFA is required.
         """
         return pulumi.get(self, "role_sts_external_ids")
 
@@ -193,6 +274,30 @@ class AssumableRoleArgs:
     def role_sts_external_ids(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
         pulumi.set(self, "role_sts_external_ids", value)
 
+    @property
+    @pulumi.getter
+    def session(self) -> Optional[pulumi.Input['RoleSessionArgs']]:
+        """
+        Settings of the session helpers rendered for the role.
+        """
+        return pulumi.get(self, "session")
+
+    @session.setter
+    def session(self, value: Optional[pulumi.Input['RoleSessionArgs']]):
+        pulumi.set(self, "session", value)
+
+    @property
+    @pulumi.getter(name="sessionTagKeys")
+    def session_tag_keys(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Tag keys that trusted entities are allowed to pass as session tags.
+        """
+        return pulumi.get(self, "session_tag_keys")
+
+    @session_tag_keys.setter
+    def session_tag_keys(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "session_tag_keys", value)
+
     @property
     @pulumi.getter
     def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
@@ -205,6 +310,18 @@ class AssumableRoleArgs:
     def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
         pulumi.set(self, "tags", value)
 
+    @property
+    @pulumi.getter(name="trustConditions")
+    def trust_conditions(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['PolicyConditionArgs']]]]:
+        """
+        Additional conditions to add to the role trust policy.
+        """
+        return pulumi.get(self, "trust_conditions")
+
+    @trust_conditions.setter
+    def trust_conditions(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['PolicyConditionArgs']]]]):
+        pulumi.set(self, "trust_conditions", value)
+
     @property
     @pulumi.getter(name="trustedRoleActions")
     def trusted_role_actions(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
@@ -233,7 +350,7 @@ class AssumableRoleArgs:
     @pulumi.getter(name="trustedRoleServices")
     def trusted_role_services(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
         """
-        AWS Services that can assume these roles.
+        AWS Services that can assume these roles. They are trusted without the MFA and ExternalId conditions.
         """
         return pulumi.get(self, "trusted_role_services")
 
@@ -247,16 +364,23 @@ class AssumableRole(pulumi.ComponentResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_session_tags: Optional[pulumi.Input[bool]] = None,
                  attach_admin_policy: Optional[pulumi.Input[bool]] = None,
                  attach_poweruser_policy: Optional[pulumi.Input[bool]] = None,
                  attach_readonly_policy: Optional[pulumi.Input[bool]] = None,
+                 aws_config_source: Optional[pulumi.Input[pulumi.InputType['AWSConfigSourceArgs']]] = None,
+                 create_instance_profile: Optional[pulumi.Input[bool]] = None,
                  custom_role_trust_policy: Optional[pulumi.Input[str]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
+                 instance_profile: Optional[pulumi.Input[pulumi.InputType['InstanceProfileSettingsArgs']]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
                  mfa_age: Optional[pulumi.Input[int]] = None,
                  role: Optional[pulumi.Input[pulumi.InputType['RoleWithMFAArgs']]] = None,
                  role_sts_external_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 session: Optional[pulumi.Input[pulumi.InputType['RoleSessionArgs']]] = None,
+                 session_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 trust_conditions: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['PolicyConditionArgs']]]]] = None,
                  trusted_role_actions: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  trusted_role_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  trusted_role_services: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
@@ -288,19 +412,26 @@ class AssumableRole(pulumi.ComponentResource):
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] allow_session_tags: Whether trusted entities are allowed to pass session tags (`sts:TagSession`) when assuming the role.
         :param pulumi.Input[bool] attach_admin_policy: Whether to attach an admin policy to a role.
         :param pulumi.Input[bool] attach_poweruser_policy: Whether to attach a poweruser policy to a role.
         :param pulumi.Input[bool] attach_readonly_policy: Whether to attach a readonly policy to a role.
+        :param pulumi.Input[pulumi.InputType['AWSConfigSourceArgs']] aws_config_source: Credentials assuming the roles in the rendered AWS CLI config.
+        :param pulumi.Input[bool] create_instance_profile: Whether to create an IAM instance profile for the role.
         :param pulumi.Input[str] custom_role_trust_policy: A custom role trust policy.
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
+        :param pulumi.Input[pulumi.InputType['InstanceProfileSettingsArgs']] instance_profile: IAM instance profile created when `createInstanceProfile` is set.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
         :param pulumi.Input[int] mfa_age: Max age of valid MFA (in seconds) for roles which require MFA.
         :param pulumi.Input[pulumi.InputType['RoleWithMFAArgs']] role: An IAM role that requires MFA.
-        :param pulumi.Input[Sequence[pulumi.Input[str]]] role_sts_external_ids: STS ExternalId condition values to use with a role (when MFA is not required).
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] role_sts_external_ids: STS ExternalId condition values to use with a role. Combined with the MFA conditions when MFA is required.
+        :param pulumi.Input[pulumi.InputType['RoleSessionArgs']] session: Settings of the session helpers rendered for the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] session_tag_keys: Tag keys that trusted entities are allowed to pass as session tags.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['PolicyConditionArgs']]]] trust_conditions: Additional conditions to add to the role trust policy.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] trusted_role_actions: Actions of STS.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] trusted_role_arns: ARNs of AWS entities who can assume these roles.
-        :param pulumi.Input[Sequence[pulumi.Input[str]]] trusted_role_services: AWS Services that can assume these roles.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] trusted_role_services: AWS Services that can assume these roles. They are trusted without the MFA and ExternalId conditions.
         """
         ...
     @overload
@@ -348,16 +479,23 @@ class AssumableRole(pulumi.ComponentResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_session_tags: Optional[pulumi.Input[bool]] = None,
                  attach_admin_policy: Optional[pulumi.Input[bool]] = None,
                  attach_poweruser_policy: Optional[pulumi.Input[bool]] = None,
                  attach_readonly_policy: Optional[pulumi.Input[bool]] = None,
+                 aws_config_source: Optional[pulumi.Input[pulumi.InputType['AWSConfigSourceArgs']]] = None,
+                 create_instance_profile: Optional[pulumi.Input[bool]] = None,
                  custom_role_trust_policy: Optional[pulumi.Input[str]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
+                 instance_profile: Optional[pulumi.Input[pulumi.InputType['InstanceProfileSettingsArgs']]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
                  mfa_age: Optional[pulumi.Input[int]] = None,
                  role: Optional[pulumi.Input[pulumi.InputType['RoleWithMFAArgs']]] = None,
                  role_sts_external_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 session: Optional[pulumi.Input[pulumi.InputType['RoleSessionArgs']]] = None,
+                 session_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 trust_conditions: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['PolicyConditionArgs']]]]] = None,
                  trusted_role_actions: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  trusted_role_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  trusted_role_services: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
@@ -372,6 +510,9 @@ class AssumableRole(pulumi.ComponentResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = AssumableRoleArgs.__new__(AssumableRoleArgs)
 
+            if allow_session_tags is None:
+                allow_session_tags = False
+            __props__.__dict__["allow_session_tags"] = allow_session_tags
             if attach_admin_policy is None:
                 attach_admin_policy = False
             __props__.__dict__["attach_admin_policy"] = attach_admin_policy
@@ -381,12 +522,17 @@ class AssumableRole(pulumi.ComponentResource):
             if attach_readonly_policy is None:
                 attach_readonly_policy = False
             __props__.__dict__["attach_readonly_policy"] = attach_readonly_policy
+            __props__.__dict__["aws_config_source"] = aws_config_source
+            if create_instance_profile is None:
+                create_instance_profile = True
+            __props__.__dict__["create_instance_profile"] = create_instance_profile
             if custom_role_trust_policy is None:
                 custom_role_trust_policy = ''
             __props__.__dict__["custom_role_trust_policy"] = custom_role_trust_policy
             if force_detach_policies is None:
                 force_detach_policies = False
             __props__.__dict__["force_detach_policies"] = force_detach_policies
+            __props__.__dict__["instance_profile"] = instance_profile
             if max_session_duration is None:
                 max_session_duration = 3600
             __props__.__dict__["max_session_duration"] = max_session_duration
@@ -395,11 +541,15 @@ class AssumableRole(pulumi.ComponentResource):
             __props__.__dict__["mfa_age"] = mfa_age
             __props__.__dict__["role"] = role
             __props__.__dict__["role_sts_external_ids"] = role_sts_external_ids
+            __props__.__dict__["session"] = session
+            __props__.__dict__["session_tag_keys"] = session_tag_keys
             __props__.__dict__["tags"] = tags
+            __props__.__dict__["trust_conditions"] = trust_conditions
             __props__.__dict__["trusted_role_actions"] = trusted_role_actions
             __props__.__dict__["trusted_role_arns"] = trusted_role_arns
             __props__.__dict__["trusted_role_services"] = trusted_role_services
-            __props__.__dict__["instance_profile"] = None
+            __props__.__dict__["aws_config"] = None
+            __props__.__dict__["aws_vault_config"] = None
         super(AssumableRole, __self__).__init__(
             'aws-iam:index:AssumableRole',
             resource_name,
@@ -407,6 +557,22 @@ class AssumableRole(pulumi.ComponentResource):
             opts,
             remote=True)
 
+    @property
+    @pulumi.getter(name="awsConfig")
+    def aws_config(self) -> pulumi.Output[str]:
+        """
+        AWS CLI config with a profile per role, in INI format.
+        """
+        return pulumi.get(self, "aws_config")
+
+    @property
+    @pulumi.getter(name="awsVaultConfig")
+    def aws_vault_config(self) -> pulumi.Output[str]:
+        """
+        AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+        """
+        return pulumi.get(self, "aws_vault_config")
+
     @property
     @pulumi.getter(name="instanceProfile")
     def instance_profile(self) -> pulumi.Output[Mapping[str, str]]:
@@ -417,3 +583,11 @@ class AssumableRole(pulumi.ComponentResource):
     def role(self) -> pulumi.Output[Mapping[str, str]]:
         return pulumi.get(self, "role")
 
+    @property
+    @pulumi.getter
+    def session(self) -> pulumi.Output['outputs.RoleSessionHelpers']:
+        """
+        Session policy and AWS CLI / SDK snippets assuming the role.
+        """
+        return pulumi.get(self, "session")
+
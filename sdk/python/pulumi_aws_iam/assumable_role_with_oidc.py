@@ -8,6 +8,7 @@ import pulumi
 import pulumi.runtime
 from typing import Any, Mapping, Optional, Sequence, Union, overload
 from . import _utilities
+from . import outputs
 from ._inputs import *
 
 __all__ = ['AssumableRoleWithOIDCArgs', 'AssumableRoleWithOIDC']
@@ -15,6 +16,7 @@ __all__ = ['AssumableRoleWithOIDCArgs', 'AssumableRoleWithOIDC']
 @pulumi.input_type
 class AssumableRoleWithOIDCArgs:
     def __init__(__self__, *,
+                 allow_session_tags: Optional[pulumi.Input[bool]] = None,
                  aws_account_id: Optional[pulumi.Input[str]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
@@ -22,10 +24,14 @@ class AssumableRoleWithOIDCArgs:
                  oidc_fully_qualified_subjects: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  oidc_subjects_with_wildcards: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  provider_urls: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 providers: Optional[pulumi.Input[Sequence[pulumi.Input['AssumableRoleWithOIDCProviderArgs']]]] = None,
                  role: Optional[pulumi.Input['RoleArgs']] = None,
+                 session: Optional[pulumi.Input['RoleSessionArgs']] = None,
+                 session_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
         """
         The set of arguments for constructing a AssumableRoleWithOIDC resource.
+        :param pulumi.Input[bool] allow_session_tags: Whether the OIDC provider is allowed to pass session tags (`sts:TagSession`).
         :param pulumi.Input[str] aws_account_id: The AWS account ID where the OIDC provider lives, leave empty to use the account for the AWS provider.
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
@@ -33,9 +39,17 @@ class AssumableRoleWithOIDCArgs:
         :param pulumi.Input[Sequence[pulumi.Input[str]]] oidc_fully_qualified_subjects: The fully qualified OIDC subjects to be added to the role policy.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] oidc_subjects_with_wildcards: The OIDC subject using wildcards to be added to the role policy.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] provider_urls: List of URLs of the OIDC Providers.
+        :param pulumi.Input[Sequence[pulumi.Input['AssumableRoleWithOIDCProviderArgs']]] providers: OIDC Providers with their own account, subjects and audiences. Used together with `providerUrls`,
+               all providers become statements of the same trust policy.
         :param pulumi.Input['RoleArgs'] role: The IAM role.
+        :param pulumi.Input['RoleSessionArgs'] session: Settings of the session helpers rendered for the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] session_tag_keys: Tag keys that the OIDC provider is allowed to pass as session tags.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
+        if allow_session_tags is None:
+            allow_session_tags = False
+        if allow_session_tags is not None:
+            pulumi.set(__self__, "allow_session_tags", allow_session_tags)
         if aws_account_id is None:
             aws_account_id = ''
         if aws_account_id is not None:
@@ -56,11 +70,29 @@ class AssumableRoleWithOIDCArgs:
             pulumi.set(__self__, "oidc_subjects_with_wildcards", oidc_subjects_with_wildcards)
         if provider_urls is not None:
             pulumi.set(__self__, "provider_urls", provider_urls)
+        if providers is not None:
+            pulumi.set(__self__, "providers", providers)
         if role is not None:
             pulumi.set(__self__, "role", role)
+        if session is not None:
+            pulumi.set(__self__, "session", session)
+        if session_tag_keys is not None:
+            pulumi.set(__self__, "session_tag_keys", session_tag_keys)
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
 
+    @property
+    @pulumi.getter(name="allowSessionTags")
+    def allow_session_tags(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the OIDC provider is allowed to pass session tags (`sts:TagSession`).
+        """
+        return pulumi.get(self, "allow_session_tags")
+
+    @allow_session_tags.setter
+    def allow_session_tags(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "allow_session_tags", value)
+
     @property
     @pulumi.getter(name="awsAccountId")
     def aws_account_id(self) -> Optional[pulumi.Input[str]]:
@@ -145,6 +177,19 @@ class AssumableRoleWithOIDCArgs:
     def provider_urls(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
         pulumi.set(self, "provider_urls", value)
 
+    @property
+    @pulumi.getter
+    def providers(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['AssumableRoleWithOIDCProviderArgs']]]]:
+        """
+        OIDC Providers with their own account, subjects and audiences. Used together with `providerUrls`,
+        all providers become statements of the same trust policy.
+        """
+        return pulumi.get(self, "providers")
+
+    @providers.setter
+    def providers(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['AssumableRoleWithOIDCProviderArgs']]]]):
+        pulumi.set(self, "providers", value)
+
     @property
     @pulumi.getter
     def role(self) -> Optional[pulumi.Input['RoleArgs']]:
@@ -157,6 +202,30 @@ class AssumableRoleWithOIDCArgs:
     def role(self, value: Optional[pulumi.Input['RoleArgs']]):
         pulumi.set(self, "role", value)
 
+    @property
+    @pulumi.getter
+    def session(self) -> Optional[pulumi.Input['RoleSessionArgs']]:
+        """
+        Settings of the session helpers rendered for the role.
+        """
+        return pulumi.get(self, "session")
+
+    @session.setter
+    def session(self, value: Optional[pulumi.Input['RoleSessionArgs']]):
+        pulumi.set(self, "session", value)
+
+    @property
+    @pulumi.getter(name="sessionTagKeys")
+    def session_tag_keys(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Tag keys that the OIDC provider is allowed to pass as session tags.
+        """
+        return pulumi.get(self, "session_tag_keys")
+
+    @session_tag_keys.setter
+    def session_tag_keys(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "session_tag_keys", value)
+
     @property
     @pulumi.getter
     def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
@@ -175,6 +244,7 @@ class AssumableRoleWithOIDC(pulumi.ComponentResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_session_tags: Optional[pulumi.Input[bool]] = None,
                  aws_account_id: Optional[pulumi.Input[str]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
@@ -182,7 +252,10 @@ class AssumableRoleWithOIDC(pulumi.ComponentResource):
                  oidc_fully_qualified_subjects: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  oidc_subjects_with_wildcards: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  provider_urls: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 providers: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AssumableRoleWithOIDCProviderArgs']]]]] = None,
                  role: Optional[pulumi.Input[pulumi.InputType['RoleArgs']]] = None,
+                 session: Optional[pulumi.Input[pulumi.InputType['RoleSessionArgs']]] = None,
+                 session_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  __props__=None):
         """
@@ -214,6 +287,7 @@ class AssumableRoleWithOIDC(pulumi.ComponentResource):
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] allow_session_tags: Whether the OIDC provider is allowed to pass session tags (`sts:TagSession`).
         :param pulumi.Input[str] aws_account_id: The AWS account ID where the OIDC provider lives, leave empty to use the account for the AWS provider.
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
@@ -221,7 +295,11 @@ class AssumableRoleWithOIDC(pulumi.ComponentResource):
         :param pulumi.Input[Sequence[pulumi.Input[str]]] oidc_fully_qualified_subjects: The fully qualified OIDC subjects to be added to the role policy.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] oidc_subjects_with_wildcards: The OIDC subject using wildcards to be added to the role policy.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] provider_urls: List of URLs of the OIDC Providers.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AssumableRoleWithOIDCProviderArgs']]]] providers: OIDC Providers with their own account, subjects and audiences. Used together with `providerUrls`,
+               all providers become statements of the same trust policy.
         :param pulumi.Input[pulumi.InputType['RoleArgs']] role: The IAM role.
+        :param pulumi.Input[pulumi.InputType['RoleSessionArgs']] session: Settings of the session helpers rendered for the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] session_tag_keys: Tag keys that the OIDC provider is allowed to pass as session tags.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
         ...
@@ -272,6 +350,7 @@ class AssumableRoleWithOIDC(pulumi.ComponentResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_session_tags: Optional[pulumi.Input[bool]] = None,
                  aws_account_id: Optional[pulumi.Input[str]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
@@ -279,7 +358,10 @@ class AssumableRoleWithOIDC(pulumi.ComponentResource):
                  oidc_fully_qualified_subjects: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  oidc_subjects_with_wildcards: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  provider_urls: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 providers: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AssumableRoleWithOIDCProviderArgs']]]]] = None,
                  role: Optional[pulumi.Input[pulumi.InputType['RoleArgs']]] = None,
+                 session: Optional[pulumi.Input[pulumi.InputType['RoleSessionArgs']]] = None,
+                 session_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
@@ -292,6 +374,9 @@ class AssumableRoleWithOIDC(pulumi.ComponentResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = AssumableRoleWithOIDCArgs.__new__(AssumableRoleWithOIDCArgs)
 
+            if allow_session_tags is None:
+                allow_session_tags = False
+            __props__.__dict__["allow_session_tags"] = allow_session_tags
             if aws_account_id is None:
                 aws_account_id = ''
             __props__.__dict__["aws_account_id"] = aws_account_id
@@ -305,7 +390,10 @@ class AssumableRoleWithOIDC(pulumi.ComponentResource):
             __props__.__dict__["oidc_fully_qualified_subjects"] = oidc_fully_qualified_subjects
             __props__.__dict__["oidc_subjects_with_wildcards"] = oidc_subjects_with_wildcards
             __props__.__dict__["provider_urls"] = provider_urls
+            __props__.__dict__["providers"] = providers
             __props__.__dict__["role"] = role
+            __props__.__dict__["session"] = session
+            __props__.__dict__["session_tag_keys"] = session_tag_keys
             __props__.__dict__["tags"] = tags
             __props__.__dict__["arn"] = None
             __props__.__dict__["name"] = None
@@ -342,6 +430,14 @@ class AssumableRoleWithOIDC(pulumi.ComponentResource):
         """
         return pulumi.get(self, "path")
 
+    @property
+    @pulumi.getter
+    def session(self) -> pulumi.Output['outputs.RoleSessionHelpers']:
+        """
+        Session policy and AWS CLI / SDK snippets assuming the role.
+        """
+        return pulumi.get(self, "session")
+
     @property
     @pulumi.getter(name="uniqueId")
     def unique_id(self) -> pulumi.Output[str]:
@@ -15,24 +15,55 @@ __all__ = ['AssumableRoleWithSAMLArgs', 'AssumableRoleWithSAML']
 @pulumi.input_type
 class AssumableRoleWithSAMLArgs:
     def __init__(__self__, *,
+                 allow_session_tags: Optional[pulumi.Input[bool]] = None,
+                 allow_source_identity: Optional[pulumi.Input[bool]] = None,
                  aws_saml_endpoint: Optional[pulumi.Input[str]] = None,
+                 aws_saml_endpoints: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
+                 principal_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
                  provider_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  role: Optional[pulumi.Input['RoleArgs']] = None,
-                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+                 saml_attributes: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
+                 saml_issuers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 saml_subjects: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 session_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 trust_conditions: Optional[pulumi.Input[Sequence[pulumi.Input['PolicyConditionArgs']]]] = None):
         """
         The set of arguments for constructing a AssumableRoleWithSAML resource.
+        :param pulumi.Input[bool] allow_session_tags: Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+        :param pulumi.Input[bool] allow_source_identity: Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
         :param pulumi.Input[str] aws_saml_endpoint: AWS SAML Endpoint.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] aws_saml_endpoints: List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+               `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
+        :param pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]] principal_tags: Map of session tag keys passed by the IdP to the values allowed to assume the role, matched as
+               `aws:RequestTag/<key>`. Implies `allowSessionTags`.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] provider_ids: List of SAML Provider IDs.
+        :param pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]] saml_attributes: Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the role.
+               Attributes can be multivalued, a match on any of the values is enough.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] saml_issuers: Values of `SAML:iss` allowed to assume the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] saml_subjects: Values of `SAML:sub` allowed to assume the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] session_tag_keys: Tag keys that the IdP is allowed to pass as session tags.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        :param pulumi.Input[Sequence[pulumi.Input['PolicyConditionArgs']]] trust_conditions: Additional conditions to add to the trust policy.
         """
+        if allow_session_tags is None:
+            allow_session_tags = False
+        if allow_session_tags is not None:
+            pulumi.set(__self__, "allow_session_tags", allow_session_tags)
+        if allow_source_identity is None:
+            allow_source_identity = False
+        if allow_source_identity is not None:
+            pulumi.set(__self__, "allow_source_identity", allow_source_identity)
         if aws_saml_endpoint is None:
             aws_saml_endpoint = 'https://signin.aws.amazon.com/saml'
         if aws_saml_endpoint is not None:
             pulumi.set(__self__, "aws_saml_endpoint", aws_saml_endpoint)
+        if aws_saml_endpoints is not None:
+            pulumi.set(__self__, "aws_saml_endpoints", aws_saml_endpoints)
         if force_detach_policies is None:
             force_detach_policies = False
         if force_detach_policies is not None:
@@ -41,12 +72,48 @@ class AssumableRoleWithSAMLArgs:
             max_session_duration = 3600
         if max_session_duration is not None:
             pulumi.set(__self__, "max_session_duration", max_session_duration)
+        if principal_tags is not None:
+            pulumi.set(__self__, "principal_tags", principal_tags)
         if provider_ids is not None:
             pulumi.set(__self__, "provider_ids", provider_ids)
         if role is not None:
             pulumi.set(__self__, "role", role)
+        if saml_attributes is not None:
+            pulumi.set(__self__, "saml_attributes", saml_attributes)
+        if saml_issuers is not None:
+            pulumi.set(__self__, "saml_issuers", saml_issuers)
+        if saml_subjects is not None:
+            pulumi.set(__self__, "saml_subjects", saml_subjects)
+        if session_tag_keys is not None:
+            pulumi.set(__self__, "session_tag_keys", session_tag_keys)
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
+        if trust_conditions is not None:
+            pulumi.set(__self__, "trust_conditions", trust_conditions)
+
+    @property
+    @pulumi.getter(name="allowSessionTags")
+    def allow_session_tags(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+        """
+        return pulumi.get(self, "allow_session_tags")
+
+    @allow_session_tags.setter
+    def allow_session_tags(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "allow_session_tags", value)
+
+    @property
+    @pulumi.getter(name="allowSourceIdentity")
+    def allow_source_identity(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
+        """
+        return pulumi.get(self, "allow_source_identity")
+
+    @allow_source_identity.setter
+    def allow_source_identity(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "allow_source_identity", value)
 
     @property
     @pulumi.getter(name="awsSamlEndpoint")
@@ -60,6 +127,19 @@ class AssumableRoleWithSAMLArgs:
     def aws_saml_endpoint(self, value: Optional[pulumi.Input[str]]):
         pulumi.set(self, "aws_saml_endpoint", value)
 
+    @property
+    @pulumi.getter(name="awsSamlEndpoints")
+    def aws_saml_endpoints(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+        `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
+        """
+        return pulumi.get(self, "aws_saml_endpoints")
+
+    @aws_saml_endpoints.setter
+    def aws_saml_endpoints(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "aws_saml_endpoints", value)
+
     @property
     @pulumi.getter(name="forceDetachPolicies")
     def force_detach_policies(self) -> Optional[pulumi.Input[bool]]:
@@ -84,6 +164,19 @@ class AssumableRoleWithSAMLArgs:
     def max_session_duration(self, value: Optional[pulumi.Input[int]]):
         pulumi.set(self, "max_session_duration", value)
 
+    @property
+    @pulumi.getter(name="principalTags")
+    def principal_tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]]:
+        """
+        Map of session tag keys passed by the IdP to the values allowed to assume the role, matched as
+        `aws:RequestTag/<key>`. Implies `allowSessionTags`.
+        """
+        return pulumi.get(self, "principal_tags")
+
+    @principal_tags.setter
+    def principal_tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]]):
+        pulumi.set(self, "principal_tags", value)
+
     @property
     @pulumi.getter(name="providerIds")
     def provider_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
@@ -105,6 +198,55 @@ class AssumableRoleWithSAMLArgs:
     def role(self, value: Optional[pulumi.Input['RoleArgs']]):
         pulumi.set(self, "role", value)
 
+    @property
+    @pulumi.getter(name="samlAttributes")
+    def saml_attributes(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]]:
+        """
+        Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the role.
+        Attributes can be multivalued, a match on any of the values is enough.
+        """
+        return pulumi.get(self, "saml_attributes")
+
+    @saml_attributes.setter
+    def saml_attributes(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]]):
+        pulumi.set(self, "saml_attributes", value)
+
+    @property
+    @pulumi.getter(name="samlIssuers")
+    def saml_issuers(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Values of `SAML:iss` allowed to assume the role.
+        """
+        return pulumi.get(self, "saml_issuers")
+
+    @saml_issuers.setter
+    def saml_issuers(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "saml_issuers", value)
+
+    @property
+    @pulumi.getter(name="samlSubjects")
+    def saml_subjects(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Values of `SAML:sub` allowed to assume the role.
+        """
+        return pulumi.get(self, "saml_subjects")
+
+    @saml_subjects.setter
+    def saml_subjects(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "saml_subjects", value)
+
+    @property
+    @pulumi.getter(name="sessionTagKeys")
+    def session_tag_keys(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Tag keys that the IdP is allowed to pass as session tags.
+        """
+        return pulumi.get(self, "session_tag_keys")
+
+    @session_tag_keys.setter
+    def session_tag_keys(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "session_tag_keys", value)
+
     @property
     @pulumi.getter
     def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
@@ -117,18 +259,39 @@ class AssumableRoleWithSAMLArgs:
     def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
         pulumi.set(self, "tags", value)
 
+    @property
+    @pulumi.getter(name="trustConditions")
+    def trust_conditions(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['PolicyConditionArgs']]]]:
+        """
+        Additional conditions to add to the trust policy.
+        """
+        return pulumi.get(self, "trust_conditions")
+
+    @trust_conditions.setter
+    def trust_conditions(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['PolicyConditionArgs']]]]):
+        pulumi.set(self, "trust_conditions", value)
+
 
 class AssumableRoleWithSAML(pulumi.ComponentResource):
     @overload
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_session_tags: Optional[pulumi.Input[bool]] = None,
+                 allow_source_identity: Optional[pulumi.Input[bool]] = None,
                  aws_saml_endpoint: Optional[pulumi.Input[str]] = None,
+                 aws_saml_endpoints: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
+                 principal_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
                  provider_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  role: Optional[pulumi.Input[pulumi.InputType['RoleArgs']]] = None,
+                 saml_attributes: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
+                 saml_issuers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 saml_subjects: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 session_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 trust_conditions: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['PolicyConditionArgs']]]]] = None,
                  __props__=None):
         """
         This resource helps you create a single IAM Role which can be assumed by trusted
@@ -159,11 +322,23 @@ class AssumableRoleWithSAML(pulumi.ComponentResource):
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] allow_session_tags: Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+        :param pulumi.Input[bool] allow_source_identity: Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
         :param pulumi.Input[str] aws_saml_endpoint: AWS SAML Endpoint.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] aws_saml_endpoints: List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+               `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
+        :param pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]] principal_tags: Map of session tag keys passed by the IdP to the values allowed to assume the role, matched as
+               `aws:RequestTag/<key>`. Implies `allowSessionTags`.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] provider_ids: List of SAML Provider IDs.
+        :param pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]] saml_attributes: Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the role.
+               Attributes can be multivalued, a match on any of the values is enough.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] saml_issuers: Values of `SAML:iss` allowed to assume the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] saml_subjects: Values of `SAML:sub` allowed to assume the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] session_tag_keys: Tag keys that the IdP is allowed to pass as session tags.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['PolicyConditionArgs']]]] trust_conditions: Additional conditions to add to the trust policy.
         """
         ...
     @overload
@@ -213,12 +388,21 @@ class AssumableRoleWithSAML(pulumi.ComponentResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_session_tags: Optional[pulumi.Input[bool]] = None,
+                 allow_source_identity: Optional[pulumi.Input[bool]] = None,
                  aws_saml_endpoint: Optional[pulumi.Input[str]] = None,
+                 aws_saml_endpoints: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
+                 principal_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
                  provider_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  role: Optional[pulumi.Input[pulumi.InputType['RoleArgs']]] = None,
+                 saml_attributes: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
+                 saml_issuers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 saml_subjects: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 session_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 trust_conditions: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['PolicyConditionArgs']]]]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -230,18 +414,31 @@ class AssumableRoleWithSAML(pulumi.ComponentResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = AssumableRoleWithSAMLArgs.__new__(AssumableRoleWithSAMLArgs)
 
+            if allow_session_tags is None:
+                allow_session_tags = False
+            __props__.__dict__["allow_session_tags"] = allow_session_tags
+            if allow_source_identity is None:
+                allow_source_identity = False
+            __props__.__dict__["allow_source_identity"] = allow_source_identity
             if aws_saml_endpoint is None:
                 aws_saml_endpoint = 'https://signin.aws.amazon.com/saml'
             __props__.__dict__["aws_saml_endpoint"] = aws_saml_endpoint
+            __props__.__dict__["aws_saml_endpoints"] = aws_saml_endpoints
             if force_detach_policies is None:
                 force_detach_policies = False
             __props__.__dict__["force_detach_policies"] = force_detach_policies
             if max_session_duration is None:
                 max_session_duration = 3600
             __props__.__dict__["max_session_duration"] = max_session_duration
+            __props__.__dict__["principal_tags"] = principal_tags
             __props__.__dict__["provider_ids"] = provider_ids
             __props__.__dict__["role"] = role
+            __props__.__dict__["saml_attributes"] = saml_attributes
+            __props__.__dict__["saml_issuers"] = saml_issuers
+            __props__.__dict__["saml_subjects"] = saml_subjects
+            __props__.__dict__["session_tag_keys"] = session_tag_keys
             __props__.__dict__["tags"] = tags
+            __props__.__dict__["trust_conditions"] = trust_conditions
             __props__.__dict__["role_arn"] = None
             __props__.__dict__["role_name"] = None
             __props__.__dict__["role_path"] = None
@@ -16,6 +16,8 @@ __all__ = ['AssumableRolesArgs', 'AssumableRoles']
 class AssumableRolesArgs:
     def __init__(__self__, *,
                  admin: pulumi.Input['AdminRoleWithMFAArgs'],
+                 aws_auth: Optional[pulumi.Input['AWSAuthArgs']] = None,
+                 aws_config_source: Optional[pulumi.Input['AWSConfigSourceArgs']] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
                  mfa_age: Optional[pulumi.Input[int]] = None,
@@ -25,6 +27,8 @@ class AssumableRolesArgs:
                  trusted_role_services: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
         The set of arguments for constructing a AssumableRoles resource.
+        :param pulumi.Input['AWSAuthArgs'] aws_auth: Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+        :param pulumi.Input['AWSConfigSourceArgs'] aws_config_source: Credentials assuming the roles in the rendered AWS CLI config.
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
         :param pulumi.Input[int] mfa_age: Max age of valid MFA (in seconds) for roles which require MFA.
@@ -32,6 +36,10 @@ class AssumableRolesArgs:
         :param pulumi.Input[Sequence[pulumi.Input[str]]] trusted_role_services: AWS Services that can assume these roles.
         """
         pulumi.set(__self__, "admin", admin)
+        if aws_auth is not None:
+            pulumi.set(__self__, "aws_auth", aws_auth)
+        if aws_config_source is not None:
+            pulumi.set(__self__, "aws_config_source", aws_config_source)
         if force_detach_policies is None:
             force_detach_policies = False
         if force_detach_policies is not None:
@@ -62,6 +70,30 @@ class AssumableRolesArgs:
     def admin(self, value: pulumi.Input['AdminRoleWithMFAArgs']):
         pulumi.set(self, "admin", value)
 
+    @property
+    @pulumi.getter(name="awsAuth")
+    def aws_auth(self) -> Optional[pulumi.Input['AWSAuthArgs']]:
+        """
+        Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+        """
+        return pulumi.get(self, "aws_auth")
+
+    @aws_auth.setter
+    def aws_auth(self, value: Optional[pulumi.Input['AWSAuthArgs']]):
+        pulumi.set(self, "aws_auth", value)
+
+    @property
+    @pulumi.getter(name="awsConfigSource")
+    def aws_config_source(self) -> Optional[pulumi.Input['AWSConfigSourceArgs']]:
+        """
+        Credentials assuming the roles in the rendered AWS CLI config.
+        """
+        return pulumi.get(self, "aws_config_source")
+
+    @aws_config_source.setter
+    def aws_config_source(self, value: Optional[pulumi.Input['AWSConfigSourceArgs']]):
+        pulumi.set(self, "aws_config_source", value)
+
     @property
     @pulumi.getter(name="forceDetachPolicies")
     def force_detach_policies(self) -> Optional[pulumi.Input[bool]]:
@@ -147,6 +179,8 @@ class AssumableRoles(pulumi.ComponentResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  admin: Optional[pulumi.Input[pulumi.InputType['AdminRoleWithMFAArgs']]] = None,
+                 aws_auth: Optional[pulumi.Input[pulumi.InputType['AWSAuthArgs']]] = None,
+                 aws_config_source: Optional[pulumi.Input[pulumi.InputType['AWSConfigSourceArgs']]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
                  mfa_age: Optional[pulumi.Input[int]] = None,
@@ -185,6 +219,8 @@ class AssumableRoles(pulumi.ComponentResource):
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[pulumi.InputType['AWSAuthArgs']] aws_auth: Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+        :param pulumi.Input[pulumi.InputType['AWSConfigSourceArgs']] aws_config_source: Credentials assuming the roles in the rendered AWS CLI config.
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
         :param pulumi.Input[int] mfa_age: Max age of valid MFA (in seconds) for roles which require MFA.
@@ -241,6 +277,8 @@ class AssumableRoles(pulumi.ComponentResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  admin: Optional[pulumi.Input[pulumi.InputType['AdminRoleWithMFAArgs']]] = None,
+                 aws_auth: Optional[pulumi.Input[pulumi.InputType['AWSAuthArgs']]] = None,
+                 aws_config_source: Optional[pulumi.Input[pulumi.InputType['AWSConfigSourceArgs']]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
                  mfa_age: Optional[pulumi.Input[int]] = None,
@@ -262,6 +300,8 @@ class AssumableRoles(pulumi.ComponentResource):
             if admin is None and not opts.urn:
                 raise TypeError("Missing required property 'admin'")
             __props__.__dict__["admin"] = admin
+            __props__.__dict__["aws_auth"] = aws_auth
+            __props__.__dict__["aws_config_source"] = aws_config_source
             if force_detach_policies is None:
                 force_detach_policies = False
             __props__.__dict__["force_detach_policies"] = force_detach_policies
@@ -275,6 +315,9 @@ class AssumableRoles(pulumi.ComponentResource):
             __props__.__dict__["readonly"] = readonly
             __props__.__dict__["trusted_role_arns"] = trusted_role_arns
             __props__.__dict__["trusted_role_services"] = trusted_role_services
+            __props__.__dict__["aws_auth_map_roles"] = None
+            __props__.__dict__["aws_config"] = None
+            __props__.__dict__["aws_vault_config"] = None
         super(AssumableRoles, __self__).__init__(
             'aws-iam:index:AssumableRoles',
             resource_name,
@@ -287,6 +330,30 @@ class AssumableRoles(pulumi.ComponentResource):
     def admin(self) -> pulumi.Output[Mapping[str, str]]:
         return pulumi.get(self, "admin")
 
+    @property
+    @pulumi.getter(name="awsAuthMapRoles")
+    def aws_auth_map_roles(self) -> pulumi.Output[str]:
+        """
+        The `mapRoles` entries of the aws-auth ConfigMap granting the roles access to EKS clusters, as YAML.
+        """
+        return pulumi.get(self, "aws_auth_map_roles")
+
+    @property
+    @pulumi.getter(name="awsConfig")
+    def aws_config(self) -> pulumi.Output[str]:
+        """
+        AWS CLI config with a profile per role, in INI format.
+        """
+        return pulumi.get(self, "aws_config")
+
+    @property
+    @pulumi.getter(name="awsVaultConfig")
+    def aws_vault_config(self) -> pulumi.Output[str]:
+        """
+        AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+        """
+        return pulumi.get(self, "aws_vault_config")
+
     @property
     @pulumi.getter
     def poweruser(self) -> pulumi.Output[Optional[Mapping[str, str]]]:
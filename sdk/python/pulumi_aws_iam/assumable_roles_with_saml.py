@@ -16,25 +16,65 @@ __all__ = ['AssumableRolesWithSAMLArgs', 'AssumableRolesWithSAML']
 class AssumableRolesWithSAMLArgs:
     def __init__(__self__, *,
                  admin: Optional[pulumi.Input['AdminRoleArgs']] = None,
+                 allow_session_tags: Optional[pulumi.Input[bool]] = None,
+                 allow_source_identity: Optional[pulumi.Input[bool]] = None,
+                 aws_auth: Optional[pulumi.Input['AWSAuthArgs']] = None,
+                 aws_config_source: Optional[pulumi.Input['AWSConfigSourceArgs']] = None,
                  aws_saml_endpoint: Optional[pulumi.Input[str]] = None,
+                 aws_saml_endpoints: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
                  poweruser: Optional[pulumi.Input['PoweruserRoleArgs']] = None,
+                 principal_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
                  provider_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
-                 readonly: Optional[pulumi.Input['ReadonlyRoleArgs']] = None):
+                 readonly: Optional[pulumi.Input['ReadonlyRoleArgs']] = None,
+                 saml_attributes: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
+                 saml_issuers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 saml_subjects: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 session_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 trust_conditions: Optional[pulumi.Input[Sequence[pulumi.Input['PolicyConditionArgs']]]] = None):
         """
         The set of arguments for constructing a AssumableRolesWithSAML resource.
+        :param pulumi.Input[bool] allow_session_tags: Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+        :param pulumi.Input[bool] allow_source_identity: Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
+        :param pulumi.Input['AWSAuthArgs'] aws_auth: Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+        :param pulumi.Input['AWSConfigSourceArgs'] aws_config_source: Credentials assuming the roles in the rendered AWS CLI config. Only a `credentialProcess` with a `{role_arn}`
+               placeholder can sign in to the roles, the AWS CLI config is empty without it.
         :param pulumi.Input[str] aws_saml_endpoint: AWS SAML Endpoint.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] aws_saml_endpoints: List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+               `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
+        :param pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]] principal_tags: Map of session tag keys passed by the IdP to the values allowed to assume the roles, matched as
+               `aws:RequestTag/<key>`. Implies `allowSessionTags`.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] provider_ids: List of SAML Provider IDs.
+        :param pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]] saml_attributes: Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the roles.
+               Attributes can be multivalued, a match on any of the values is enough.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] saml_issuers: Values of `SAML:iss` allowed to assume the roles.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] saml_subjects: Values of `SAML:sub` allowed to assume the roles.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] session_tag_keys: Tag keys that the IdP is allowed to pass as session tags.
+        :param pulumi.Input[Sequence[pulumi.Input['PolicyConditionArgs']]] trust_conditions: Additional conditions to add to the trust policy.
         """
         if admin is not None:
             pulumi.set(__self__, "admin", admin)
+        if allow_session_tags is None:
+            allow_session_tags = False
+        if allow_session_tags is not None:
+            pulumi.set(__self__, "allow_session_tags", allow_session_tags)
+        if allow_source_identity is None:
+            allow_source_identity = False
+        if allow_source_identity is not None:
+            pulumi.set(__self__, "allow_source_identity", allow_source_identity)
+        if aws_auth is not None:
+            pulumi.set(__self__, "aws_auth", aws_auth)
+        if aws_config_source is not None:
+            pulumi.set(__self__, "aws_config_source", aws_config_source)
         if aws_saml_endpoint is None:
             aws_saml_endpoint = 'https://signin.aws.amazon.com/saml'
         if aws_saml_endpoint is not None:
             pulumi.set(__self__, "aws_saml_endpoint", aws_saml_endpoint)
+        if aws_saml_endpoints is not None:
+            pulumi.set(__self__, "aws_saml_endpoints", aws_saml_endpoints)
         if force_detach_policies is None:
             force_detach_policies = False
         if force_detach_policies is not None:
@@ -45,10 +85,22 @@ class AssumableRolesWithSAMLArgs:
             pulumi.set(__self__, "max_session_duration", max_session_duration)
         if poweruser is not None:
             pulumi.set(__self__, "poweruser", poweruser)
+        if principal_tags is not None:
+            pulumi.set(__self__, "principal_tags", principal_tags)
         if provider_ids is not None:
             pulumi.set(__self__, "provider_ids", provider_ids)
         if readonly is not None:
             pulumi.set(__self__, "readonly", readonly)
+        if saml_attributes is not None:
+            pulumi.set(__self__, "saml_attributes", saml_attributes)
+        if saml_issuers is not None:
+            pulumi.set(__self__, "saml_issuers", saml_issuers)
+        if saml_subjects is not None:
+            pulumi.set(__self__, "saml_subjects", saml_subjects)
+        if session_tag_keys is not None:
+            pulumi.set(__self__, "session_tag_keys", session_tag_keys)
+        if trust_conditions is not None:
+            pulumi.set(__self__, "trust_conditions", trust_conditions)
 
     @property
     @pulumi.getter
@@ -59,6 +111,55 @@ class AssumableRolesWithSAMLArgs:
     def admin(self, value: Optional[pulumi.Input['AdminRoleArgs']]):
         pulumi.set(self, "admin", value)
 
+    @property
+    @pulumi.getter(name="allowSessionTags")
+    def allow_session_tags(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+        """
+        return pulumi.get(self, "allow_session_tags")
+
+    @allow_session_tags.setter
+    def allow_session_tags(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "allow_session_tags", value)
+
+    @property
+    @pulumi.getter(name="allowSourceIdentity")
+    def allow_source_identity(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
+        """
+        return pulumi.get(self, "allow_source_identity")
+
+    @allow_source_identity.setter
+    def allow_source_identity(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "allow_source_identity", value)
+
+    @property
+    @pulumi.getter(name="awsAuth")
+    def aws_auth(self) -> Optional[pulumi.Input['AWSAuthArgs']]:
+        """
+        Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+        """
+        return pulumi.get(self, "aws_auth")
+
+    @aws_auth.setter
+    def aws_auth(self, value: Optional[pulumi.Input['AWSAuthArgs']]):
+        pulumi.set(self, "aws_auth", value)
+
+    @property
+    @pulumi.getter(name="awsConfigSource")
+    def aws_config_source(self) -> Optional[pulumi.Input['AWSConfigSourceArgs']]:
+        """
+        Credentials assuming the roles in the rendered AWS CLI config. Only a `credentialProcess` with a `{role_arn}`
+        placeholder can sign in to the roles, the AWS CLI config is empty without it.
+        """
+        return pulumi.get(self, "aws_config_source")
+
+    @aws_config_source.setter
+    def aws_config_source(self, value: Optional[pulumi.Input['AWSConfigSourceArgs']]):
+        pulumi.set(self, "aws_config_source", value)
+
     @property
     @pulumi.getter(name="awsSamlEndpoint")
     def aws_saml_endpoint(self) -> Optional[pulumi.Input[str]]:
@@ -71,6 +172,19 @@ class AssumableRolesWithSAMLArgs:
     def aws_saml_endpoint(self, value: Optional[pulumi.Input[str]]):
         pulumi.set(self, "aws_saml_endpoint", value)
 
+    @property
+    @pulumi.getter(name="awsSamlEndpoints")
+    def aws_saml_endpoints(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+        `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
+        """
+        return pulumi.get(self, "aws_saml_endpoints")
+
+    @aws_saml_endpoints.setter
+    def aws_saml_endpoints(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "aws_saml_endpoints", value)
+
     @property
     @pulumi.getter(name="forceDetachPolicies")
     def force_detach_policies(self) -> Optional[pulumi.Input[bool]]:
@@ -104,6 +218,19 @@ class AssumableRolesWithSAMLArgs:
     def poweruser(self, value: Optional[pulumi.Input['PoweruserRoleArgs']]):
         pulumi.set(self, "poweruser", value)
 
+    @property
+    @pulumi.getter(name="principalTags")
+    def principal_tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]]:
+        """
+        Map of session tag keys passed by the IdP to the values allowed to assume the roles, matched as
+        `aws:RequestTag/<key>`. Implies `allowSessionTags`.
+        """
+        return pulumi.get(self, "principal_tags")
+
+    @principal_tags.setter
+    def principal_tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]]):
+        pulumi.set(self, "principal_tags", value)
+
     @property
     @pulumi.getter(name="providerIds")
     def provider_ids(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
@@ -125,6 +252,67 @@ class AssumableRolesWithSAMLArgs:
     def readonly(self, value: Optional[pulumi.Input['ReadonlyRoleArgs']]):
         pulumi.set(self, "readonly", value)
 
+    @property
+    @pulumi.getter(name="samlAttributes")
+    def saml_attributes(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]]:
+        """
+        Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the roles.
+        Attributes can be multivalued, a match on any of the values is enough.
+        """
+        return pulumi.get(self, "saml_attributes")
+
+    @saml_attributes.setter
+    def saml_attributes(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]]):
+        pulumi.set(self, "saml_attributes", value)
+
+    @property
+    @pulumi.getter(name="samlIssuers")
+    def saml_issuers(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Values of `SAML:iss` allowed to assume the roles.
+        """
+        return pulumi.get(self, "saml_issuers")
+
+    @saml_issuers.setter
+    def saml_issuers(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "saml_issuers", value)
+
+    @property
+    @pulumi.getter(name="samlSubjects")
+    def saml_subjects(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Values of `SAML:sub` allowed to assume the roles.
+        """
+        return pulumi.get(self, "saml_subjects")
+
+    @saml_subjects.setter
+    def saml_subjects(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "saml_subjects", value)
+
+    @property
+    @pulumi.getter(name="sessionTagKeys")
+    def session_tag_keys(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Tag keys that the IdP is allowed to pass as session tags.
+        """
+        return pulumi.get(self, "session_tag_keys")
+
+    @session_tag_keys.setter
+    def session_tag_keys(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "session_tag_keys", value)
+
+    @property
+    @pulumi.getter(name="trustConditions")
+    def trust_conditions(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['PolicyConditionArgs']]]]:
+        """
+        Additional conditions to add to the trust policy.
+        """
+        return pulumi.get(self, "trust_conditions")
+
+    @trust_conditions.setter
+    def trust_conditions(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['PolicyConditionArgs']]]]):
+        pulumi.set(self, "trust_conditions", value)
+
 
 class AssumableRolesWithSAML(pulumi.ComponentResource):
     @overload
@@ -132,12 +320,23 @@ class AssumableRolesWithSAML(pulumi.ComponentResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  admin: Optional[pulumi.Input[pulumi.InputType['AdminRoleArgs']]] = None,
+                 allow_session_tags: Optional[pulumi.Input[bool]] = None,
+                 allow_source_identity: Optional[pulumi.Input[bool]] = None,
+                 aws_auth: Optional[pulumi.Input[pulumi.InputType['AWSAuthArgs']]] = None,
+                 aws_config_source: Optional[pulumi.Input[pulumi.InputType['AWSConfigSourceArgs']]] = None,
                  aws_saml_endpoint: Optional[pulumi.Input[str]] = None,
+                 aws_saml_endpoints: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
                  poweruser: Optional[pulumi.Input[pulumi.InputType['PoweruserRoleArgs']]] = None,
+                 principal_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
                  provider_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  readonly: Optional[pulumi.Input[pulumi.InputType['ReadonlyRoleArgs']]] = None,
+                 saml_attributes: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
+                 saml_issuers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 saml_subjects: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 session_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 trust_conditions: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['PolicyConditionArgs']]]]] = None,
                  __props__=None):
         """
         This resource helps you create predefined IAM roles (`admin`, `poweruser`, and `readonly`) which can be assumed
@@ -166,10 +365,25 @@ class AssumableRolesWithSAML(pulumi.ComponentResource):
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] allow_session_tags: Whether the IdP is allowed to pass session tags (`sts:TagSession`).
+        :param pulumi.Input[bool] allow_source_identity: Whether the IdP is allowed to set a source identity (`sts:SetSourceIdentity`).
+        :param pulumi.Input[pulumi.InputType['AWSAuthArgs']] aws_auth: Kubernetes users and groups of the roles in the rendered aws-auth `mapRoles` entries.
+        :param pulumi.Input[pulumi.InputType['AWSConfigSourceArgs']] aws_config_source: Credentials assuming the roles in the rendered AWS CLI config. Only a `credentialProcess` with a `{role_arn}`
+               placeholder can sign in to the roles, the AWS CLI config is empty without it.
         :param pulumi.Input[str] aws_saml_endpoint: AWS SAML Endpoint.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] aws_saml_endpoints: List of AWS SAML Endpoints allowed as audience, e.g. regional sign-in endpoints such as
+               `https://us-east-1.signin.aws.amazon.com/saml`. Takes precedence over `awsSamlEndpoint`.
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
+        :param pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]] principal_tags: Map of session tag keys passed by the IdP to the values allowed to assume the roles, matched as
+               `aws:RequestTag/<key>`. Implies `allowSessionTags`.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] provider_ids: List of SAML Provider IDs.
+        :param pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]] saml_attributes: Map of SAML attribute names (e.g. `edupersonaffiliation`) to the values allowed to assume the roles.
+               Attributes can be multivalued, a match on any of the values is enough.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] saml_issuers: Values of `SAML:iss` allowed to assume the roles.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] saml_subjects: Values of `SAML:sub` allowed to assume the roles.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] session_tag_keys: Tag keys that the IdP is allowed to pass as session tags.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['PolicyConditionArgs']]]] trust_conditions: Additional conditions to add to the trust policy.
         """
         ...
     @overload
@@ -218,12 +432,23 @@ class AssumableRolesWithSAML(pulumi.ComponentResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  admin: Optional[pulumi.Input[pulumi.InputType['AdminRoleArgs']]] = None,
+                 allow_session_tags: Optional[pulumi.Input[bool]] = None,
+                 allow_source_identity: Optional[pulumi.Input[bool]] = None,
+                 aws_auth: Optional[pulumi.Input[pulumi.InputType['AWSAuthArgs']]] = None,
+                 aws_config_source: Optional[pulumi.Input[pulumi.InputType['AWSConfigSourceArgs']]] = None,
                  aws_saml_endpoint: Optional[pulumi.Input[str]] = None,
+                 aws_saml_endpoints: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
                  poweruser: Optional[pulumi.Input[pulumi.InputType['PoweruserRoleArgs']]] = None,
+                 principal_tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
                  provider_ids: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  readonly: Optional[pulumi.Input[pulumi.InputType['ReadonlyRoleArgs']]] = None,
+                 saml_attributes: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
+                 saml_issuers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 saml_subjects: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 session_tag_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 trust_conditions: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['PolicyConditionArgs']]]]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -236,9 +461,18 @@ class AssumableRolesWithSAML(pulumi.ComponentResource):
             __props__ = AssumableRolesWithSAMLArgs.__new__(AssumableRolesWithSAMLArgs)
 
             __props__.__dict__["admin"] = admin
+            if allow_session_tags is None:
+                allow_session_tags = False
+            __props__.__dict__["allow_session_tags"] = allow_session_tags
+            if allow_source_identity is None:
+                allow_source_identity = False
+            __props__.__dict__["allow_source_identity"] = allow_source_identity
+            __props__.__dict__["aws_auth"] = aws_auth
+            __props__.__dict__["aws_config_source"] = aws_config_source
             if aws_saml_endpoint is None:
                 aws_saml_endpoint = 'https://signin.aws.amazon.com/saml'
             __props__.__dict__["aws_saml_endpoint"] = aws_saml_endpoint
+            __props__.__dict__["aws_saml_endpoints"] = aws_saml_endpoints
             if force_detach_policies is None:
                 force_detach_policies = False
             __props__.__dict__["force_detach_policies"] = force_detach_policies
@@ -246,8 +480,17 @@ class AssumableRolesWithSAML(pulumi.ComponentResource):
                 max_session_duration = 3600
             __props__.__dict__["max_session_duration"] = max_session_duration
             __props__.__dict__["poweruser"] = poweruser
+            __props__.__dict__["principal_tags"] = principal_tags
             __props__.__dict__["provider_ids"] = provider_ids
             __props__.__dict__["readonly"] = readonly
+            __props__.__dict__["saml_attributes"] = saml_attributes
+            __props__.__dict__["saml_issuers"] = saml_issuers
+            __props__.__dict__["saml_subjects"] = saml_subjects
+            __props__.__dict__["session_tag_keys"] = session_tag_keys
+            __props__.__dict__["trust_conditions"] = trust_conditions
+            __props__.__dict__["aws_auth_map_roles"] = None
+            __props__.__dict__["aws_config"] = None
+            __props__.__dict__["aws_vault_config"] = None
         super(AssumableRolesWithSAML, __self__).__init__(
             'aws-iam:index:AssumableRolesWithSAML',
             resource_name,
@@ -260,6 +503,30 @@ class AssumableRolesWithSAML(pulumi.ComponentResource):
     def admin(self) -> pulumi.Output[Mapping[str, str]]:
         return pulumi.get(self, "admin")
 
+    @property
+    @pulumi.getter(name="awsAuthMapRoles")
+    def aws_auth_map_roles(self) -> pulumi.Output[str]:
+        """
+        The `mapRoles` entries of the aws-auth ConfigMap granting the roles access to EKS clusters, as YAML.
+        """
+        return pulumi.get(self, "aws_auth_map_roles")
+
+    @property
+    @pulumi.getter(name="awsConfig")
+    def aws_config(self) -> pulumi.Output[str]:
+        """
+        AWS CLI config with a profile per role, in INI format.
+        """
+        return pulumi.get(self, "aws_config")
+
+    @property
+    @pulumi.getter(name="awsVaultConfig")
+    def aws_vault_config(self) -> pulumi.Output[str]:
+        """
+        AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+        """
+        return pulumi.get(self, "aws_vault_config")
+
     @property
     @pulumi.getter
     def poweruser(self) -> pulumi.Output[Optional[Mapping[str, str]]]:
@@ -0,0 +1,197 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['AWSConfigProfilesArgs', 'AWSConfigProfiles']
+
+@pulumi.input_type
+class AWSConfigProfilesArgs:
+    def __init__(__self__, *,
+                 roles: pulumi.Input[Sequence[pulumi.Input['AWSConfigRoleArgs']]],
+                 source: Optional[pulumi.Input['AWSConfigSourceArgs']] = None):
+        """
+        The set of arguments for constructing a AWSConfigProfiles resource.
+        :param pulumi.Input[Sequence[pulumi.Input['AWSConfigRoleArgs']]] roles: Roles to render a profile for.
+        :param pulumi.Input['AWSConfigSourceArgs'] source: Credentials assuming the roles.
+        """
+        pulumi.set(__self__, "roles", roles)
+        if source is not None:
+            pulumi.set(__self__, "source", source)
+
+    @property
+    @pulumi.getter
+    def roles(self) -> pulumi.Input[Sequence[pulumi.Input['AWSConfigRoleArgs']]]:
+        """
+        Roles to render a profile for.
+        """
+        return pulumi.get(self, "roles")
+
+    @roles.setter
+    def roles(self, value: pulumi.Input[Sequence[pulumi.Input['AWSConfigRoleArgs']]]):
+        pulumi.set(self, "roles", value)
+
+    @property
+    @pulumi.getter
+    def source(self) -> Optional[pulumi.Input['AWSConfigSourceArgs']]:
+        """
+        Credentials assuming the roles.
+        """
+        return pulumi.get(self, "source")
+
+    @source.setter
+    def source(self, value: Optional[pulumi.Input['AWSConfigSourceArgs']]):
+        pulumi.set(self, "source", value)
+
+
+class AWSConfigProfiles(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 roles: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AWSConfigRoleArgs']]]]] = None,
+                 source: Optional[pulumi.Input[pulumi.InputType['AWSConfigSourceArgs']]] = None,
+                 __props__=None):
+        """
+        This resource renders an AWS CLI config with a profile per IAM role, e.g. the roles of `AssumableRoles` or
+        `AssumableRolesWithSAML`, ready to be published to engineers. The roles are assumed with the credentials
+        of a source profile, a credential process or an IAM Identity Center session. A second variant of the
+        config uses the legacy IAM Identity Center settings supported by granted and aws-vault.
+
+        ## Example Usage
+        ## AWS Config Profiles
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        profiles = iam.AWSConfigProfiles(
+            'profiles',
+            source=iam.AWSConfigSourceArgs(
+                profile_prefix='acme-',
+                source_profile='acme-identity',
+                mfa_serial='arn:aws:iam::111111111111:mfa/jane',
+            ),
+            roles=[
+                iam.AWSConfigRoleArgs(
+                    profile_name='admin',
+                    role_arn='arn:aws:iam::222222222222:role/admin',
+                    requires_mfa=True,
+                    duration_seconds=3600,
+                ),
+            ],
+        )
+
+        pulumi.export('profiles', profiles)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AWSConfigRoleArgs']]]] roles: Roles to render a profile for.
+        :param pulumi.Input[pulumi.InputType['AWSConfigSourceArgs']] source: Credentials assuming the roles.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: AWSConfigProfilesArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        This resource renders an AWS CLI config with a profile per IAM role, e.g. the roles of `AssumableRoles` or
+        `AssumableRolesWithSAML`, ready to be published to engineers. The roles are assumed with the credentials
+        of a source profile, a credential process or an IAM Identity Center session. A second variant of the
+        config uses the legacy IAM Identity Center settings supported by granted and aws-vault.
+
+        ## Example Usage
+        ## AWS Config Profiles
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        profiles = iam.AWSConfigProfiles(
+            'profiles',
+            source=iam.AWSConfigSourceArgs(
+                profile_prefix='acme-',
+                source_profile='acme-identity',
+                mfa_serial='arn:aws:iam::111111111111:mfa/jane',
+            ),
+            roles=[
+                iam.AWSConfigRoleArgs(
+                    profile_name='admin',
+                    role_arn='arn:aws:iam::222222222222:role/admin',
+                    requires_mfa=True,
+                    duration_seconds=3600,
+                ),
+            ],
+        )
+
+        pulumi.export('profiles', profiles)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param AWSConfigProfilesArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(AWSConfigProfilesArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 roles: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AWSConfigRoleArgs']]]]] = None,
+                 source: Optional[pulumi.Input[pulumi.InputType['AWSConfigSourceArgs']]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = AWSConfigProfilesArgs.__new__(AWSConfigProfilesArgs)
+
+            if roles is None and not opts.urn:
+                raise TypeError("Missing required property 'roles'")
+            __props__.__dict__["roles"] = roles
+            __props__.__dict__["source"] = source
+            __props__.__dict__["aws_vault_config"] = None
+            __props__.__dict__["config"] = None
+        super(AWSConfigProfiles, __self__).__init__(
+            'aws-iam:index:AWSConfigProfiles',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter(name="awsVaultConfig")
+    def aws_vault_config(self) -> pulumi.Output[str]:
+        """
+        AWS CLI config for granted and aws-vault, using the legacy IAM Identity Center settings they support.
+        """
+        return pulumi.get(self, "aws_vault_config")
+
+    @property
+    @pulumi.getter
+    def config(self) -> pulumi.Output[str]:
+        """
+        AWS CLI config with a profile per role, in INI format.
+        """
+        return pulumi.get(self, "config")
+
@@ -0,0 +1,378 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['BreakGlassRoleArgs', 'BreakGlassRole']
+
+@pulumi.input_type
+class BreakGlassRoleArgs:
+    def __init__(__self__, *,
+                 trusted_role_arns: pulumi.Input[Sequence[pulumi.Input[str]]],
+                 alert_emails: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 alert_topic_arn: Optional[pulumi.Input[str]] = None,
+                 event_pattern_only: Optional[pulumi.Input[bool]] = None,
+                 global_events_provider: Optional[Any] = None,
+                 max_session_duration: Optional[pulumi.Input[int]] = None,
+                 mfa_age: Optional[pulumi.Input[int]] = None,
+                 role: Optional[pulumi.Input['RoleArgs']] = None,
+                 source_ips: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+        """
+        The set of arguments for constructing a BreakGlassRole resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] trusted_role_arns: ARNs of the principals allowed to assume the role.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] alert_emails: Email addresses subscribed to the alert topic.
+        :param pulumi.Input[str] alert_topic_arn: ARN of an existing SNS topic to send alerts to. A topic is created when not set.
+        :param pulumi.Input[bool] event_pattern_only: Whether to only output the EventBridge event pattern instead of creating the rule and SNS topic.
+        :param Any global_events_provider: Explicit `aws` provider for us-east-1, used to forward the calls to the global STS endpoint to the
+               region of the role. Required outside of us-east-1, unless only the event pattern is output.
+        :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
+        :param pulumi.Input[int] mfa_age: Max age of valid MFA (in seconds) when assuming the role.
+        :param pulumi.Input['RoleArgs'] role: The IAM role. Its name defaults to `break-glass`.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] source_ips: Source IP ranges the role can be assumed from. Any source IP is allowed when empty.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add. The role is also tagged with `BreakGlass` set to `true`.
+        """
+        pulumi.set(__self__, "trusted_role_arns", trusted_role_arns)
+        if alert_emails is not None:
+            pulumi.set(__self__, "alert_emails", alert_emails)
+        if alert_topic_arn is not None:
+            pulumi.set(__self__, "alert_topic_arn", alert_topic_arn)
+        if event_pattern_only is None:
+            event_pattern_only = False
+        if event_pattern_only is not None:
+            pulumi.set(__self__, "event_pattern_only", event_pattern_only)
+        if global_events_provider is not None:
+            pulumi.set(__self__, "global_events_provider", global_events_provider)
+        if max_session_duration is None:
+            max_session_duration = 3600
+        if max_session_duration is not None:
+            pulumi.set(__self__, "max_session_duration", max_session_duration)
+        if mfa_age is None:
+            mfa_age = 900
+        if mfa_age is not None:
+            pulumi.set(__self__, "mfa_age", mfa_age)
+        if role is not None:
+            pulumi.set(__self__, "role", role)
+        if source_ips is not None:
+            pulumi.set(__self__, "source_ips", source_ips)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter(name="trustedRoleArns")
+    def trusted_role_arns(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+        """
+        ARNs of the principals allowed to assume the role.
+        """
+        return pulumi.get(self, "trusted_role_arns")
+
+    @trusted_role_arns.setter
+    def trusted_role_arns(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "trusted_role_arns", value)
+
+    @property
+    @pulumi.getter(name="alertEmails")
+    def alert_emails(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Email addresses subscribed to the alert topic.
+        """
+        return pulumi.get(self, "alert_emails")
+
+    @alert_emails.setter
+    def alert_emails(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "alert_emails", value)
+
+    @property
+    @pulumi.getter(name="alertTopicArn")
+    def alert_topic_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        ARN of an existing SNS topic to send alerts to. A topic is created when not set.
+        """
+        return pulumi.get(self, "alert_topic_arn")
+
+    @alert_topic_arn.setter
+    def alert_topic_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "alert_topic_arn", value)
+
+    @property
+    @pulumi.getter(name="eventPatternOnly")
+    def event_pattern_only(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to only output the EventBridge event pattern instead of creating the rule and SNS topic.
+        """
+        return pulumi.get(self, "event_pattern_only")
+
+    @event_pattern_only.setter
+    def event_pattern_only(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "event_pattern_only", value)
+
+    @property
+    @pulumi.getter(name="globalEventsProvider")
+    def global_events_provider(self) -> Optional[Any]:
+        """
+        Explicit `aws` provider for us-east-1, used to forward the calls to the global STS endpoint to the
+        region of the role. Required outside of us-east-1, unless only the event pattern is output.
+        """
+        return pulumi.get(self, "global_events_provider")
+
+    @global_events_provider.setter
+    def global_events_provider(self, value: Optional[Any]):
+        pulumi.set(self, "global_events_provider", value)
+
+    @property
+    @pulumi.getter(name="maxSessionDuration")
+    def max_session_duration(self) -> Optional[pulumi.Input[int]]:
+        """
+        Maximum CLI/API session duration in seconds between 3600 and 43200.
+        """
+        return pulumi.get(self, "max_session_duration")
+
+    @max_session_duration.setter
+    def max_session_duration(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "max_session_duration", value)
+
+    @property
+    @pulumi.getter(name="mfaAge")
+    def mfa_age(self) -> Optional[pulumi.Input[int]]:
+        """
+        Max age of valid MFA (in seconds) when assuming the role.
+        """
+        return pulumi.get(self, "mfa_age")
+
+    @mfa_age.setter
+    def mfa_age(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "mfa_age", value)
+
+    @property
+    @pulumi.getter
+    def role(self) -> Optional[pulumi.Input['RoleArgs']]:
+        """
+        The IAM role. Its name defaults to `break-glass`.
+        """
+        return pulumi.get(self, "role")
+
+    @role.setter
+    def role(self, value: Optional[pulumi.Input['RoleArgs']]):
+        pulumi.set(self, "role", value)
+
+    @property
+    @pulumi.getter(name="sourceIps")
+    def source_ips(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Source IP ranges the role can be assumed from. Any source IP is allowed when empty.
+        """
+        return pulumi.get(self, "source_ips")
+
+    @source_ips.setter
+    def source_ips(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "source_ips", value)
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        A map of tags to add. The role is also tagged with `BreakGlass` set to `true`.
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "tags", value)
+
+
+class BreakGlassRole(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 alert_emails: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 alert_topic_arn: Optional[pulumi.Input[str]] = None,
+                 event_pattern_only: Optional[pulumi.Input[bool]] = None,
+                 global_events_provider: Optional[Any] = None,
+                 max_session_duration: Optional[pulumi.Input[int]] = None,
+                 mfa_age: Optional[pulumi.Input[int]] = None,
+                 role: Optional[pulumi.Input[pulumi.InputType['RoleArgs']]] = None,
+                 source_ips: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 trusted_role_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 __props__=None):
+        """
+        This resource helps you create a break-glass role for emergency access. The role has AdministratorAccess,
+        can only be assumed by the trusted principals with a recent MFA sign-in and optionally from specific source
+        IPs, and is tagged with `BreakGlass: true`. An EventBridge rule sends an alert to an SNS topic whenever
+        the role is assumed, so its usage is always noticed.
+
+        The rule matches the AssumeRole events CloudTrail delivers to EventBridge in the region the resource is
+        deployed to. AssumeRole calls made to the global STS endpoint, including console role switches, are
+        delivered in us-east-1. Outside of us-east-1 a second rule forwards them from us-east-1 to the default
+        event bus of the region. It is created with the `aws` provider for us-east-1 passed as
+        `globalEventsProvider`, which is required outside of us-east-1.
+
+        ## Example Usage
+        ## Break Glass Role
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        break_glass_role = iam.BreakGlassRole(
+            'break_glass_role',
+            trusted_role_arns=['arn:aws:iam::111111111111:user/oncall'],
+            source_ips=['203.0.113.0/24'],
+            alert_emails=['security@example.com'],
+        )
+
+        pulumi.export('break_glass_role', break_glass_role)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] alert_emails: Email addresses subscribed to the alert topic.
+        :param pulumi.Input[str] alert_topic_arn: ARN of an existing SNS topic to send alerts to. A topic is created when not set.
+        :param pulumi.Input[bool] event_pattern_only: Whether to only output the EventBridge event pattern instead of creating the rule and SNS topic.
+        :param Any global_events_provider: Explicit `aws` provider for us-east-1, used to forward the calls to the global STS endpoint to the
+               region of the role. Required outside of us-east-1, unless only the event pattern is output.
+        :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
+        :param pulumi.Input[int] mfa_age: Max age of valid MFA (in seconds) when assuming the role.
+        :param pulumi.Input[pulumi.InputType['RoleArgs']] role: The IAM role. Its name defaults to `break-glass`.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] source_ips: Source IP ranges the role can be assumed from. Any source IP is allowed when empty.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add. The role is also tagged with `BreakGlass` set to `true`.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] trusted_role_arns: ARNs of the principals allowed to assume the role.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: BreakGlassRoleArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        This resource helps you create a break-glass role for emergency access. The role has AdministratorAccess,
+        can only be assumed by the trusted principals with a recent MFA sign-in and optionally from specific source
+        IPs, and is tagged with `BreakGlass: true`. An EventBridge rule sends an alert to an SNS topic whenever
+        the role is assumed, so its usage is always noticed.
+
+        The rule matches the AssumeRole events CloudTrail delivers to EventBridge in the region the resource is
+        deployed to. AssumeRole calls made to the global STS endpoint, including console role switches, are
+        delivered in us-east-1. Outside of us-east-1 a second rule forwards them from us-east-1 to the default
+        event bus of the region. It is created with the `aws` provider for us-east-1 passed as
+        `globalEventsProvider`, which is required outside of us-east-1.
+
+        ## Example Usage
+        ## Break Glass Role
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        break_glass_role = iam.BreakGlassRole(
+            'break_glass_role',
+            trusted_role_arns=['arn:aws:iam::111111111111:user/oncall'],
+            source_ips=['203.0.113.0/24'],
+            alert_emails=['security@example.com'],
+        )
+
+        pulumi.export('break_glass_role', break_glass_role)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param BreakGlassRoleArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(BreakGlassRoleArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 alert_emails: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 alert_topic_arn: Optional[pulumi.Input[str]] = None,
+                 event_pattern_only: Optional[pulumi.Input[bool]] = None,
+                 global_events_provider: Optional[Any] = None,
+                 max_session_duration: Optional[pulumi.Input[int]] = None,
+                 mfa_age: Optional[pulumi.Input[int]] = None,
+                 role: Optional[pulumi.Input[pulumi.InputType['RoleArgs']]] = None,
+                 source_ips: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 trusted_role_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = BreakGlassRoleArgs.__new__(BreakGlassRoleArgs)
+
+            __props__.__dict__["alert_emails"] = alert_emails
+            __props__.__dict__["alert_topic_arn"] = alert_topic_arn
+            if event_pattern_only is None:
+                event_pattern_only = False
+            __props__.__dict__["event_pattern_only"] = event_pattern_only
+            __props__.__dict__["global_events_provider"] = global_events_provider
+            if max_session_duration is None:
+                max_session_duration = 3600
+            __props__.__dict__["max_session_duration"] = max_session_duration
+            if mfa_age is None:
+                mfa_age = 900
+            __props__.__dict__["mfa_age"] = mfa_age
+            __props__.__dict__["role"] = role
+            __props__.__dict__["source_ips"] = source_ips
+            __props__.__dict__["tags"] = tags
+            if trusted_role_arns is None and not opts.urn:
+                raise TypeError("Missing required property 'trusted_role_arns'")
+            __props__.__dict__["trusted_role_arns"] = trusted_role_arns
+            __props__.__dict__["event_pattern"] = None
+            __props__.__dict__["event_rule_arn"] = None
+        super(BreakGlassRole, __self__).__init__(
+            'aws-iam:index:BreakGlassRole',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter(name="alertTopicArn")
+    def alert_topic_arn(self) -> pulumi.Output[str]:
+        """
+        ARN of the SNS topic receiving the alerts.
+        """
+        return pulumi.get(self, "alert_topic_arn")
+
+    @property
+    @pulumi.getter(name="eventPattern")
+    def event_pattern(self) -> pulumi.Output[str]:
+        """
+        EventBridge event pattern matching AssumeRole calls into the role.
+        """
+        return pulumi.get(self, "event_pattern")
+
+    @property
+    @pulumi.getter(name="eventRuleArn")
+    def event_rule_arn(self) -> pulumi.Output[str]:
+        """
+        ARN of the EventBridge rule alerting on AssumeRole calls into the role. Empty when only the event pattern is output.
+        Outside of us-east-1 a second rule forwards the calls to the global STS endpoint from us-east-1 to this rule.
+        """
+        return pulumi.get(self, "event_rule_arn")
+
+    @property
+    @pulumi.getter
+    def role(self) -> pulumi.Output[Mapping[str, str]]:
+        return pulumi.get(self, "role")
+
@@ -0,0 +1,291 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['EKSAddonPolicyArgs', 'EKSAddonPolicy']
+
+@pulumi.input_type
+class EKSAddonPolicyArgs:
+    def __init__(__self__, *,
+                 addon: pulumi.Input[str],
+                 policies: pulumi.Input['EKSRolePoliciesArgs'],
+                 path: Optional[pulumi.Input[str]] = None,
+                 policy_name_prefix: Optional[pulumi.Input[str]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+        """
+        The set of arguments for constructing a EKSAddonPolicy resource.
+        :param pulumi.Input[str] addon: Name of the add-on to create the policy for, matching its key in `policies`, e.g. `velero` or
+               `karpenterController`.
+        :param pulumi.Input['EKSRolePoliciesArgs'] policies: Arguments of the add-ons. Only the arguments of the selected add-on are used, its `attach` flag is
+               implied. Add-ons with several policies, e.g. `loadBalancer`, must select exactly one of them.
+        :param pulumi.Input[str] path: The path of the policy in IAM.
+        :param pulumi.Input[str] policy_name_prefix: IAM policy name prefix.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        """
+        pulumi.set(__self__, "addon", addon)
+        pulumi.set(__self__, "policies", policies)
+        if path is None:
+            path = '/'
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+        if policy_name_prefix is None:
+            policy_name_prefix = 'AmazonEKS_'
+        if policy_name_prefix is not None:
+            pulumi.set(__self__, "policy_name_prefix", policy_name_prefix)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter
+    def addon(self) -> pulumi.Input[str]:
+        """
+        Name of the add-on to create the policy for, matching its key in `policies`, e.g. `velero` or
+        `karpenterController`.
+        """
+        return pulumi.get(self, "addon")
+
+    @addon.setter
+    def addon(self, value: pulumi.Input[str]):
+        pulumi.set(self, "addon", value)
+
+    @property
+    @pulumi.getter
+    def policies(self) -> pulumi.Input['EKSRolePoliciesArgs']:
+        """
+        Arguments of the add-ons. Only the arguments of the selected add-on are used, its `attach` flag is
+        implied. Add-ons with several policies, e.g. `loadBalancer`, must select exactly one of them.
+        """
+        return pulumi.get(self, "policies")
+
+    @policies.setter
+    def policies(self, value: pulumi.Input['EKSRolePoliciesArgs']):
+        pulumi.set(self, "policies", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> Optional[pulumi.Input[str]]:
+        """
+        The path of the policy in IAM.
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter(name="policyNamePrefix")
+    def policy_name_prefix(self) -> Optional[pulumi.Input[str]]:
+        """
+        IAM policy name prefix.
+        """
+        return pulumi.get(self, "policy_name_prefix")
+
+    @policy_name_prefix.setter
+    def policy_name_prefix(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "policy_name_prefix", value)
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        A map of tags to add.
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "tags", value)
+
+
+class EKSAddonPolicy(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 addon: Optional[pulumi.Input[str]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 policies: Optional[pulumi.Input[pulumi.InputType['EKSRolePoliciesArgs']]] = None,
+                 policy_name_prefix: Optional[pulumi.Input[str]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        """
+        This resource helps you create the IAM policy of a single EKS add-on, the same policy `RoleForServiceAccountsEks`
+        attaches for it, without creating a role. The policy can then be attached to node roles, EKS Pod Identity
+        roles or roles managed elsewhere.
+
+        ## Example Usage
+        ## EKS Add-on Policy
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        velero_policy = iam.EKSAddonPolicy(
+            'velero_policy',
+            addon='velero',
+            policies=iam.EKSRolePoliciesArgs(
+                velero=iam.EKSVeleroPolicyArgs(
+                    s3_bucket_arns=['arn:aws:s3:::velero-backups'],
+                ),
+            ),
+        )
+
+        pulumi.export('velero_policy', velero_policy)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] addon: Name of the add-on to create the policy for, matching its key in `policies`, e.g. `velero` or
+               `karpenterController`.
+        :param pulumi.Input[str] path: The path of the policy in IAM.
+        :param pulumi.Input[pulumi.InputType['EKSRolePoliciesArgs']] policies: Arguments of the add-ons. Only the arguments of the selected add-on are used, its `attach` flag is
+               implied. Add-ons with several policies, e.g. `loadBalancer`, must select exactly one of them.
+        :param pulumi.Input[str] policy_name_prefix: IAM policy name prefix.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: EKSAddonPolicyArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        This resource helps you create the IAM policy of a single EKS add-on, the same policy `RoleForServiceAccountsEks`
+        attaches for it, without creating a role. The policy can then be attached to node roles, EKS Pod Identity
+        roles or roles managed elsewhere.
+
+        ## Example Usage
+        ## EKS Add-on Policy
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        velero_policy = iam.EKSAddonPolicy(
+            'velero_policy',
+            addon='velero',
+            policies=iam.EKSRolePoliciesArgs(
+                velero=iam.EKSVeleroPolicyArgs(
+                    s3_bucket_arns=['arn:aws:s3:::velero-backups'],
+                ),
+            ),
+        )
+
+        pulumi.export('velero_policy', velero_policy)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param EKSAddonPolicyArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(EKSAddonPolicyArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 addon: Optional[pulumi.Input[str]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 policies: Optional[pulumi.Input[pulumi.InputType['EKSRolePoliciesArgs']]] = None,
+                 policy_name_prefix: Optional[pulumi.Input[str]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = EKSAddonPolicyArgs.__new__(EKSAddonPolicyArgs)
+
+            if addon is None and not opts.urn:
+                raise TypeError("Missing required property 'addon'")
+            __props__.__dict__["addon"] = addon
+            if path is None:
+                path = '/'
+            __props__.__dict__["path"] = path
+            if policies is None and not opts.urn:
+                raise TypeError("Missing required property 'policies'")
+            __props__.__dict__["policies"] = policies
+            if policy_name_prefix is None:
+                policy_name_prefix = 'AmazonEKS_'
+            __props__.__dict__["policy_name_prefix"] = policy_name_prefix
+            __props__.__dict__["tags"] = tags
+            __props__.__dict__["arn"] = None
+            __props__.__dict__["description"] = None
+            __props__.__dict__["id"] = None
+            __props__.__dict__["name"] = None
+            __props__.__dict__["policy_json"] = None
+        super(EKSAddonPolicy, __self__).__init__(
+            'aws-iam:index:EKSAddonPolicy',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter
+    def arn(self) -> pulumi.Output[str]:
+        """
+        The ARN assigned by AWS to this policy.
+        """
+        return pulumi.get(self, "arn")
+
+    @property
+    @pulumi.getter
+    def description(self) -> pulumi.Output[str]:
+        """
+        The description of the policy.
+        """
+        return pulumi.get(self, "description")
+
+    @property
+    @pulumi.getter
+    def id(self) -> pulumi.Output[str]:
+        """
+        The policy's ID.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        """
+        The name of the policy.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Output[str]:
+        """
+        The path of the policy in IAM.
+        """
+        return pulumi.get(self, "path")
+
+    @property
+    @pulumi.getter(name="policyJson")
+    def policy_json(self) -> pulumi.Output[str]:
+        """
+        Policy document as json.
+        """
+        return pulumi.get(self, "policy_json")
+
@@ -0,0 +1,287 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['EKSClusterRoleArgs', 'EKSClusterRole']
+
+@pulumi.input_type
+class EKSClusterRoleArgs:
+    def __init__(__self__, *,
+                 attach_vpc_resource_controller_policy: Optional[pulumi.Input[bool]] = None,
+                 enable_auto_mode: Optional[pulumi.Input[bool]] = None,
+                 force_detach_policies: Optional[pulumi.Input[bool]] = None,
+                 max_session_duration: Optional[pulumi.Input[int]] = None,
+                 role: Optional[pulumi.Input['RoleArgs']] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+        """
+        The set of arguments for constructing a EKSClusterRole resource.
+        :param pulumi.Input[bool] attach_vpc_resource_controller_policy: Whether to attach the AmazonEKSVPCResourceController policy, needed for security groups for pods.
+        :param pulumi.Input[bool] enable_auto_mode: Whether to attach the policies needed by EKS Auto Mode and allow EKS to tag its sessions.
+        :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
+        :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        """
+        if attach_vpc_resource_controller_policy is None:
+            attach_vpc_resource_controller_policy = False
+        if attach_vpc_resource_controller_policy is not None:
+            pulumi.set(__self__, "attach_vpc_resource_controller_policy", attach_vpc_resource_controller_policy)
+        if enable_auto_mode is None:
+            enable_auto_mode = False
+        if enable_auto_mode is not None:
+            pulumi.set(__self__, "enable_auto_mode", enable_auto_mode)
+        if force_detach_policies is None:
+            force_detach_policies = False
+        if force_detach_policies is not None:
+            pulumi.set(__self__, "force_detach_policies", force_detach_policies)
+        if max_session_duration is None:
+            max_session_duration = 3600
+        if max_session_duration is not None:
+            pulumi.set(__self__, "max_session_duration", max_session_duration)
+        if role is not None:
+            pulumi.set(__self__, "role", role)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter(name="attachVpcResourceControllerPolicy")
+    def attach_vpc_resource_controller_policy(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to attach the AmazonEKSVPCResourceController policy, needed for security groups for pods.
+        """
+        return pulumi.get(self, "attach_vpc_resource_controller_policy")
+
+    @attach_vpc_resource_controller_policy.setter
+    def attach_vpc_resource_controller_policy(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "attach_vpc_resource_controller_policy", value)
+
+    @property
+    @pulumi.getter(name="enableAutoMode")
+    def enable_auto_mode(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to attach the policies needed by EKS Auto Mode and allow EKS to tag its sessions.
+        """
+        return pulumi.get(self, "enable_auto_mode")
+
+    @enable_auto_mode.setter
+    def enable_auto_mode(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enable_auto_mode", value)
+
+    @property
+    @pulumi.getter(name="forceDetachPolicies")
+    def force_detach_policies(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether policies should be detached from this role when destroying.
+        """
+        return pulumi.get(self, "force_detach_policies")
+
+    @force_detach_policies.setter
+    def force_detach_policies(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "force_detach_policies", value)
+
+    @property
+    @pulumi.getter(name="maxSessionDuration")
+    def max_session_duration(self) -> Optional[pulumi.Input[int]]:
+        """
+        Maximum CLI/API session duration in seconds between 3600 and 43200.
+        """
+        return pulumi.get(self, "max_session_duration")
+
+    @max_session_duration.setter
+    def max_session_duration(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "max_session_duration", value)
+
+    @property
+    @pulumi.getter
+    def role(self) -> Optional[pulumi.Input['RoleArgs']]:
+        return pulumi.get(self, "role")
+
+    @role.setter
+    def role(self, value: Optional[pulumi.Input['RoleArgs']]):
+        pulumi.set(self, "role", value)
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        A map of tags to add.
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "tags", value)
+
+
+class EKSClusterRole(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 attach_vpc_resource_controller_policy: Optional[pulumi.Input[bool]] = None,
+                 enable_auto_mode: Optional[pulumi.Input[bool]] = None,
+                 force_detach_policies: Optional[pulumi.Input[bool]] = None,
+                 max_session_duration: Optional[pulumi.Input[int]] = None,
+                 role: Optional[pulumi.Input[pulumi.InputType['RoleArgs']]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        """
+        This resource helps you create the IAM role an EKS cluster uses to manage AWS resources on your behalf.
+        The role trusts `eks.amazonaws.com` and has the `AmazonEKSClusterPolicy` attached, optionally together
+        with the `AmazonEKSVPCResourceController` policy and the policies needed by EKS Auto Mode.
+
+        ## Example Usage
+        ## EKS Cluster Role
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        cluster_role = iam.EKSClusterRole(
+            'cluster_role',
+            role=iam.RoleArgs(
+                name='eks-cluster',
+            ),
+            attach_vpc_resource_controller_policy=True,
+            enable_auto_mode=True,
+        )
+
+        pulumi.export('cluster_role', cluster_role)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] attach_vpc_resource_controller_policy: Whether to attach the AmazonEKSVPCResourceController policy, needed for security groups for pods.
+        :param pulumi.Input[bool] enable_auto_mode: Whether to attach the policies needed by EKS Auto Mode and allow EKS to tag its sessions.
+        :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
+        :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[EKSClusterRoleArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        This resource helps you create the IAM role an EKS cluster uses to manage AWS resources on your behalf.
+        The role trusts `eks.amazonaws.com` and has the `AmazonEKSClusterPolicy` attached, optionally together
+        with the `AmazonEKSVPCResourceController` policy and the policies needed by EKS Auto Mode.
+
+        ## Example Usage
+        ## EKS Cluster Role
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        cluster_role = iam.EKSClusterRole(
+            'cluster_role',
+            role=iam.RoleArgs(
+                name='eks-cluster',
+            ),
+            attach_vpc_resource_controller_policy=True,
+            enable_auto_mode=True,
+        )
+
+        pulumi.export('cluster_role', cluster_role)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param EKSClusterRoleArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(EKSClusterRoleArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 attach_vpc_resource_controller_policy: Optional[pulumi.Input[bool]] = None,
+                 enable_auto_mode: Optional[pulumi.Input[bool]] = None,
+                 force_detach_policies: Optional[pulumi.Input[bool]] = None,
+                 max_session_duration: Optional[pulumi.Input[int]] = None,
+                 role: Optional[pulumi.Input[pulumi.InputType['RoleArgs']]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = EKSClusterRoleArgs.__new__(EKSClusterRoleArgs)
+
+            if attach_vpc_resource_controller_policy is None:
+                attach_vpc_resource_controller_policy = False
+            __props__.__dict__["attach_vpc_resource_controller_policy"] = attach_vpc_resource_controller_policy
+            if enable_auto_mode is None:
+                enable_auto_mode = False
+            __props__.__dict__["enable_auto_mode"] = enable_auto_mode
+            if force_detach_policies is None:
+                force_detach_policies = False
+            __props__.__dict__["force_detach_policies"] = force_detach_policies
+            if max_session_duration is None:
+                max_session_duration = 3600
+            __props__.__dict__["max_session_duration"] = max_session_duration
+            __props__.__dict__["role"] = role
+            __props__.__dict__["tags"] = tags
+            __props__.__dict__["arn"] = None
+            __props__.__dict__["name"] = None
+            __props__.__dict__["path"] = None
+            __props__.__dict__["unique_id"] = None
+        super(EKSClusterRole, __self__).__init__(
+            'aws-iam:index:EKSClusterRole',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter
+    def arn(self) -> pulumi.Output[str]:
+        """
+        ARN of IAM role.
+        """
+        return pulumi.get(self, "arn")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        """
+        Name of IAM role.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Output[str]:
+        """
+        Path of IAM role.
+        """
+        return pulumi.get(self, "path")
+
+    @property
+    @pulumi.getter(name="uniqueId")
+    def unique_id(self) -> pulumi.Output[str]:
+        """
+        Unique ID of IAM role.
+        """
+        return pulumi.get(self, "unique_id")
+
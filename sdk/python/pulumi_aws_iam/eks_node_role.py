@@ -0,0 +1,346 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['EKSNodeRoleArgs', 'EKSNodeRole']
+
+@pulumi.input_type
+class EKSNodeRoleArgs:
+    def __init__(__self__, *,
+                 attach_ssm_policy: Optional[pulumi.Input[bool]] = None,
+                 cluster_name: Optional[pulumi.Input[str]] = None,
+                 exclude_cni_policy: Optional[pulumi.Input[bool]] = None,
+                 force_detach_policies: Optional[pulumi.Input[bool]] = None,
+                 max_session_duration: Optional[pulumi.Input[int]] = None,
+                 role: Optional[pulumi.Input['RoleArgs']] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 type: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a EKSNodeRole resource.
+        :param pulumi.Input[bool] attach_ssm_policy: Whether to attach the AmazonSSMManagedInstanceCore policy. Always attached for `karpenter` roles.
+        :param pulumi.Input[str] cluster_name: Name of the cluster whose Fargate profiles can assume a `fargate` role. Defaults to all clusters of the account.
+        :param pulumi.Input[bool] exclude_cni_policy: Whether to leave out the AmazonEKS_CNI_Policy, e.g. when the VPC CNI uses its own IRSA role.
+        :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
+        :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        :param pulumi.Input[str] type: Type of the role, one of `node`, `karpenter` or `fargate`.
+        """
+        if attach_ssm_policy is None:
+            attach_ssm_policy = False
+        if attach_ssm_policy is not None:
+            pulumi.set(__self__, "attach_ssm_policy", attach_ssm_policy)
+        if cluster_name is not None:
+            pulumi.set(__self__, "cluster_name", cluster_name)
+        if exclude_cni_policy is None:
+            exclude_cni_policy = False
+        if exclude_cni_policy is not None:
+            pulumi.set(__self__, "exclude_cni_policy", exclude_cni_policy)
+        if force_detach_policies is None:
+            force_detach_policies = False
+        if force_detach_policies is not None:
+            pulumi.set(__self__, "force_detach_policies", force_detach_policies)
+        if max_session_duration is None:
+            max_session_duration = 3600
+        if max_session_duration is not None:
+            pulumi.set(__self__, "max_session_duration", max_session_duration)
+        if role is not None:
+            pulumi.set(__self__, "role", role)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+        if type is None:
+            type = 'node'
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter(name="attachSsmPolicy")
+    def attach_ssm_policy(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to attach the AmazonSSMManagedInstanceCore policy. Always attached for `karpenter` roles.
+        """
+        return pulumi.get(self, "attach_ssm_policy")
+
+    @attach_ssm_policy.setter
+    def attach_ssm_policy(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "attach_ssm_policy", value)
+
+    @property
+    @pulumi.getter(name="clusterName")
+    def cluster_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of the cluster whose Fargate profiles can assume a `fargate` role. Defaults to all clusters of the account.
+        """
+        return pulumi.get(self, "cluster_name")
+
+    @cluster_name.setter
+    def cluster_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "cluster_name", value)
+
+    @property
+    @pulumi.getter(name="excludeCniPolicy")
+    def exclude_cni_policy(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to leave out the AmazonEKS_CNI_Policy, e.g. when the VPC CNI uses its own IRSA role.
+        """
+        return pulumi.get(self, "exclude_cni_policy")
+
+    @exclude_cni_policy.setter
+    def exclude_cni_policy(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclude_cni_policy", value)
+
+    @property
+    @pulumi.getter(name="forceDetachPolicies")
+    def force_detach_policies(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether policies should be detached from this role when destroying.
+        """
+        return pulumi.get(self, "force_detach_policies")
+
+    @force_detach_policies.setter
+    def force_detach_policies(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "force_detach_policies", value)
+
+    @property
+    @pulumi.getter(name="maxSessionDuration")
+    def max_session_duration(self) -> Optional[pulumi.Input[int]]:
+        """
+        Maximum CLI/API session duration in seconds between 3600 and 43200.
+        """
+        return pulumi.get(self, "max_session_duration")
+
+    @max_session_duration.setter
+    def max_session_duration(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "max_session_duration", value)
+
+    @property
+    @pulumi.getter
+    def role(self) -> Optional[pulumi.Input['RoleArgs']]:
+        return pulumi.get(self, "role")
+
+    @role.setter
+    def role(self, value: Optional[pulumi.Input['RoleArgs']]):
+        pulumi.set(self, "role", value)
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        A map of tags to add.
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "tags", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[pulumi.Input[str]]:
+        """
+        Type of the role, one of `node`, `karpenter` or `fargate`.
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "type", value)
+
+
+class EKSNodeRole(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 attach_ssm_policy: Optional[pulumi.Input[bool]] = None,
+                 cluster_name: Optional[pulumi.Input[str]] = None,
+                 exclude_cni_policy: Optional[pulumi.Input[bool]] = None,
+                 force_detach_policies: Optional[pulumi.Input[bool]] = None,
+                 max_session_duration: Optional[pulumi.Input[int]] = None,
+                 role: Optional[pulumi.Input[pulumi.InputType['RoleArgs']]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 type: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        This resource helps you create the IAM role of EKS nodes. Depending on `type` the role is for:
+
+        - `node`: managed and self-managed node groups. The role trusts EC2, has the worker node, CNI and ECR
+          read only policies attached and gets an instance profile.
+        - `karpenter`: nodes launched by Karpenter. Same as `node` plus the SSM policy, without an instance
+          profile since Karpenter creates those itself.
+        - `fargate`: the pod execution role of Fargate profiles, optionally restricted to the profiles of one cluster.
+
+        ## Example Usage
+        ## EKS Node Role
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        node_role = iam.EKSNodeRole(
+            'node_role',
+            role=iam.RoleArgs(
+                name='eks-node',
+            ),
+            attach_ssm_policy=True,
+        )
+
+        pulumi.export('node_role', node_role)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] attach_ssm_policy: Whether to attach the AmazonSSMManagedInstanceCore policy. Always attached for `karpenter` roles.
+        :param pulumi.Input[str] cluster_name: Name of the cluster whose Fargate profiles can assume a `fargate` role. Defaults to all clusters of the account.
+        :param pulumi.Input[bool] exclude_cni_policy: Whether to leave out the AmazonEKS_CNI_Policy, e.g. when the VPC CNI uses its own IRSA role.
+        :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
+        :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        :param pulumi.Input[str] type: Type of the role, one of `node`, `karpenter` or `fargate`.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[EKSNodeRoleArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        This resource helps you create the IAM role of EKS nodes. Depending on `type` the role is for:
+
+        - `node`: managed and self-managed node groups. The role trusts EC2, has the worker node, CNI and ECR
+          read only policies attached and gets an instance profile.
+        - `karpenter`: nodes launched by Karpenter. Same as `node` plus the SSM policy, without an instance
+          profile since Karpenter creates those itself.
+        - `fargate`: the pod execution role of Fargate profiles, optionally restricted to the profiles of one cluster.
+
+        ## Example Usage
+        ## EKS Node Role
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        node_role = iam.EKSNodeRole(
+            'node_role',
+            role=iam.RoleArgs(
+                name='eks-node',
+            ),
+            attach_ssm_policy=True,
+        )
+
+        pulumi.export('node_role', node_role)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param EKSNodeRoleArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(EKSNodeRoleArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 attach_ssm_policy: Optional[pulumi.Input[bool]] = None,
+                 cluster_name: Optional[pulumi.Input[str]] = None,
+                 exclude_cni_policy: Optional[pulumi.Input[bool]] = None,
+                 force_detach_policies: Optional[pulumi.Input[bool]] = None,
+                 max_session_duration: Optional[pulumi.Input[int]] = None,
+                 role: Optional[pulumi.Input[pulumi.InputType['RoleArgs']]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 type: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = EKSNodeRoleArgs.__new__(EKSNodeRoleArgs)
+
+            if attach_ssm_policy is None:
+                attach_ssm_policy = False
+            __props__.__dict__["attach_ssm_policy"] = attach_ssm_policy
+            __props__.__dict__["cluster_name"] = cluster_name
+            if exclude_cni_policy is None:
+                exclude_cni_policy = False
+            __props__.__dict__["exclude_cni_policy"] = exclude_cni_policy
+            if force_detach_policies is None:
+                force_detach_policies = False
+            __props__.__dict__["force_detach_policies"] = force_detach_policies
+            if max_session_duration is None:
+                max_session_duration = 3600
+            __props__.__dict__["max_session_duration"] = max_session_duration
+            __props__.__dict__["role"] = role
+            __props__.__dict__["tags"] = tags
+            if type is None:
+                type = 'node'
+            __props__.__dict__["type"] = type
+            __props__.__dict__["arn"] = None
+            __props__.__dict__["instance_profile"] = None
+            __props__.__dict__["name"] = None
+            __props__.__dict__["path"] = None
+            __props__.__dict__["unique_id"] = None
+        super(EKSNodeRole, __self__).__init__(
+            'aws-iam:index:EKSNodeRole',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter
+    def arn(self) -> pulumi.Output[str]:
+        """
+        ARN of IAM role.
+        """
+        return pulumi.get(self, "arn")
+
+    @property
+    @pulumi.getter(name="instanceProfile")
+    def instance_profile(self) -> pulumi.Output[Mapping[str, str]]:
+        """
+        IAM instance profile, only created for `node` roles.
+        """
+        return pulumi.get(self, "instance_profile")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        """
+        Name of IAM role.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def path(self) -> pulumi.Output[str]:
+        """
+        Path of IAM role.
+        """
+        return pulumi.get(self, "path")
+
+    @property
+    @pulumi.getter(name="uniqueId")
+    def unique_id(self) -> pulumi.Output[str]:
+        """
+        Unique ID of IAM role.
+        """
+        return pulumi.get(self, "unique_id")
+
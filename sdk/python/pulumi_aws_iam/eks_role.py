@@ -8,6 +8,7 @@ import pulumi
 import pulumi.runtime
 from typing import Any, Mapping, Optional, Sequence, Union, overload
 from . import _utilities
+from . import outputs
 from ._inputs import *
 
 __all__ = ['EKSRoleArgs', 'EKSRole']
@@ -15,22 +16,30 @@ __all__ = ['EKSRoleArgs', 'EKSRole']
 @pulumi.input_type
 class EKSRoleArgs:
     def __init__(__self__, *,
+                 allow_all_namespaces: Optional[pulumi.Input[bool]] = None,
                  cluster_service_accounts: Optional[pulumi.Input[Sequence[pulumi.Input['EKSServiceAccountArgs']]]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
                  provider_url_sa_pairs: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
                  role: Optional[pulumi.Input['RoleArgs']] = None,
                  role_policy_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 service_account_manifest: Optional[pulumi.Input['EKSServiceAccountManifestOptionsArgs']] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
         """
         The set of arguments for constructing a EKSRole resource.
+        :param pulumi.Input[bool] allow_all_namespaces: Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
         :param pulumi.Input[Sequence[pulumi.Input['EKSServiceAccountArgs']]] cluster_service_accounts: EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount. See README for details
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
         :param pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]] provider_url_sa_pairs: OIDC provider URL and k8s ServiceAccount pairs. If the assume role policy requires a mix of EKS clusters and other OIDC providers then this can be used
         :param pulumi.Input[Sequence[pulumi.Input[str]]] role_policy_arns: ARNs of any policies to attach to the IAM role.
+        :param pulumi.Input['EKSServiceAccountManifestOptionsArgs'] service_account_manifest: Annotations added to the rendered ServiceAccount manifests.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
+        if allow_all_namespaces is None:
+            allow_all_namespaces = False
+        if allow_all_namespaces is not None:
+            pulumi.set(__self__, "allow_all_namespaces", allow_all_namespaces)
         if cluster_service_accounts is not None:
             pulumi.set(__self__, "cluster_service_accounts", cluster_service_accounts)
         if force_detach_policies is None:
@@ -47,9 +56,23 @@ class EKSRoleArgs:
             pulumi.set(__self__, "role", role)
         if role_policy_arns is not None:
             pulumi.set(__self__, "role_policy_arns", role_policy_arns)
+        if service_account_manifest is not None:
+            pulumi.set(__self__, "service_account_manifest", service_account_manifest)
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
 
+    @property
+    @pulumi.getter(name="allowAllNamespaces")
+    def allow_all_namespaces(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+        """
+        return pulumi.get(self, "allow_all_namespaces")
+
+    @allow_all_namespaces.setter
+    def allow_all_namespaces(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "allow_all_namespaces", value)
+
     @property
     @pulumi.getter(name="clusterServiceAccounts")
     def cluster_service_accounts(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['EKSServiceAccountArgs']]]]:
@@ -119,6 +142,18 @@ class EKSRoleArgs:
     def role_policy_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
         pulumi.set(self, "role_policy_arns", value)
 
+    @property
+    @pulumi.getter(name="serviceAccountManifest")
+    def service_account_manifest(self) -> Optional[pulumi.Input['EKSServiceAccountManifestOptionsArgs']]:
+        """
+        Annotations added to the rendered ServiceAccount manifests.
+        """
+        return pulumi.get(self, "service_account_manifest")
+
+    @service_account_manifest.setter
+    def service_account_manifest(self, value: Optional[pulumi.Input['EKSServiceAccountManifestOptionsArgs']]):
+        pulumi.set(self, "service_account_manifest", value)
+
     @property
     @pulumi.getter
     def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
@@ -137,12 +172,14 @@ class EKSRole(pulumi.ComponentResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_all_namespaces: Optional[pulumi.Input[bool]] = None,
                  cluster_service_accounts: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['EKSServiceAccountArgs']]]]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
                  provider_url_sa_pairs: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
                  role: Optional[pulumi.Input[pulumi.InputType['RoleArgs']]] = None,
                  role_policy_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 service_account_manifest: Optional[pulumi.Input[pulumi.InputType['EKSServiceAccountManifestOptionsArgs']]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  __props__=None):
         """
@@ -189,11 +226,13 @@ class EKSRole(pulumi.ComponentResource):
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] allow_all_namespaces: Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
         :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['EKSServiceAccountArgs']]]] cluster_service_accounts: EKS cluster and k8s ServiceAccount pairs. Each EKS cluster can have multiple k8s ServiceAccount. See README for details
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
         :param pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]] provider_url_sa_pairs: OIDC provider URL and k8s ServiceAccount pairs. If the assume role policy requires a mix of EKS clusters and other OIDC providers then this can be used
         :param pulumi.Input[Sequence[pulumi.Input[str]]] role_policy_arns: ARNs of any policies to attach to the IAM role.
+        :param pulumi.Input[pulumi.InputType['EKSServiceAccountManifestOptionsArgs']] service_account_manifest: Annotations added to the rendered ServiceAccount manifests.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
         ...
@@ -259,12 +298,14 @@ class EKSRole(pulumi.ComponentResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_all_namespaces: Optional[pulumi.Input[bool]] = None,
                  cluster_service_accounts: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['EKSServiceAccountArgs']]]]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
                  provider_url_sa_pairs: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
                  role: Optional[pulumi.Input[pulumi.InputType['RoleArgs']]] = None,
                  role_policy_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 service_account_manifest: Optional[pulumi.Input[pulumi.InputType['EKSServiceAccountManifestOptionsArgs']]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
@@ -277,6 +318,9 @@ class EKSRole(pulumi.ComponentResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = EKSRoleArgs.__new__(EKSRoleArgs)
 
+            if allow_all_namespaces is None:
+                allow_all_namespaces = False
+            __props__.__dict__["allow_all_namespaces"] = allow_all_namespaces
             __props__.__dict__["cluster_service_accounts"] = cluster_service_accounts
             if force_detach_policies is None:
                 force_detach_policies = False
@@ -287,10 +331,12 @@ class EKSRole(pulumi.ComponentResource):
             __props__.__dict__["provider_url_sa_pairs"] = provider_url_sa_pairs
             __props__.__dict__["role"] = role
             __props__.__dict__["role_policy_arns"] = role_policy_arns
+            __props__.__dict__["service_account_manifest"] = service_account_manifest
             __props__.__dict__["tags"] = tags
             __props__.__dict__["arn"] = None
             __props__.__dict__["name"] = None
             __props__.__dict__["path"] = None
+            __props__.__dict__["service_accounts"] = None
             __props__.__dict__["unique_id"] = None
         super(EKSRole, __self__).__init__(
             'aws-iam:index:EKSRole',
@@ -323,6 +369,14 @@ class EKSRole(pulumi.ComponentResource):
         """
         return pulumi.get(self, "path")
 
+    @property
+    @pulumi.getter(name="serviceAccounts")
+    def service_accounts(self) -> pulumi.Output[Sequence['outputs.EKSServiceAccountManifest']]:
+        """
+        Annotations and ServiceAccount manifests binding each ServiceAccount to the role.
+        """
+        return pulumi.get(self, "service_accounts")
+
     @property
     @pulumi.getter(name="uniqueId")
     def unique_id(self) -> pulumi.Output[str]:
@@ -20,6 +20,7 @@ class GroupWithPoliciesArgs:
                  aws_account_id: Optional[pulumi.Input[str]] = None,
                  custom_group_policies: Optional[pulumi.Input[Sequence[pulumi.Input[Mapping[str, pulumi.Input[str]]]]]] = None,
                  custom_group_policy_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
                  iam_self_management_policy_name_prefix: Optional[pulumi.Input[str]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
         """
@@ -30,6 +31,8 @@ class GroupWithPoliciesArgs:
         :param pulumi.Input[str] aws_account_id: AWS account id to use inside IAM policies. If empty, current AWS account ID will be used.
         :param pulumi.Input[Sequence[pulumi.Input[Mapping[str, pulumi.Input[str]]]]] custom_group_policies: List of maps of inline IAM policies to attach to IAM group. Should have `name` and `policy` keys in each element.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] custom_group_policy_arns: List of IAM policies ARNs to attach to IAM group.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the group are detached from it. Not supported for groups
+               by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
         :param pulumi.Input[str] iam_self_management_policy_name_prefix: Name prefix for IAM policy to create with IAM self-management permissions.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
@@ -49,6 +52,10 @@ class GroupWithPoliciesArgs:
             pulumi.set(__self__, "custom_group_policies", custom_group_policies)
         if custom_group_policy_arns is not None:
             pulumi.set(__self__, "custom_group_policy_arns", custom_group_policy_arns)
+        if exclusive_policy_attachments is None:
+            exclusive_policy_attachments = False
+        if exclusive_policy_attachments is not None:
+            pulumi.set(__self__, "exclusive_policy_attachments", exclusive_policy_attachments)
         if iam_self_management_policy_name_prefix is None:
             iam_self_management_policy_name_prefix = 'IAMSelfManagement-'
         if iam_self_management_policy_name_prefix is not None:
@@ -128,6 +135,19 @@ class GroupWithPoliciesArgs:
     def custom_group_policy_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
         pulumi.set(self, "custom_group_policy_arns", value)
 
+    @property
+    @pulumi.getter(name="exclusivePolicyAttachments")
+    def exclusive_policy_attachments(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether managed policies not declared for the group are detached from it. Not supported for groups
+        by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
+        """
+        return pulumi.get(self, "exclusive_policy_attachments")
+
+    @exclusive_policy_attachments.setter
+    def exclusive_policy_attachments(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_policy_attachments", value)
+
     @property
     @pulumi.getter(name="iamSelfManagementPolicyNamePrefix")
     def iam_self_management_policy_name_prefix(self) -> Optional[pulumi.Input[str]]:
@@ -162,6 +182,7 @@ class GroupWithPolicies(pulumi.ComponentResource):
                  aws_account_id: Optional[pulumi.Input[str]] = None,
                  custom_group_policies: Optional[pulumi.Input[Sequence[pulumi.Input[Mapping[str, pulumi.Input[str]]]]]] = None,
                  custom_group_policy_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
                  group_users: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  iam_self_management_policy_name_prefix: Optional[pulumi.Input[str]] = None,
                  name: Optional[pulumi.Input[str]] = None,
@@ -200,6 +221,8 @@ class GroupWithPolicies(pulumi.ComponentResource):
         :param pulumi.Input[str] aws_account_id: AWS account id to use inside IAM policies. If empty, current AWS account ID will be used.
         :param pulumi.Input[Sequence[pulumi.Input[Mapping[str, pulumi.Input[str]]]]] custom_group_policies: List of maps of inline IAM policies to attach to IAM group. Should have `name` and `policy` keys in each element.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] custom_group_policy_arns: List of IAM policies ARNs to attach to IAM group.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the group are detached from it. Not supported for groups
+               by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] group_users: List of IAM users to have in an IAM group which can assume the role.
         :param pulumi.Input[str] iam_self_management_policy_name_prefix: Name prefix for IAM policy to create with IAM self-management permissions.
         :param pulumi.Input[str] name: Name of IAM group.
@@ -257,6 +280,7 @@ class GroupWithPolicies(pulumi.ComponentResource):
                  aws_account_id: Optional[pulumi.Input[str]] = None,
                  custom_group_policies: Optional[pulumi.Input[Sequence[pulumi.Input[Mapping[str, pulumi.Input[str]]]]]] = None,
                  custom_group_policy_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
                  group_users: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  iam_self_management_policy_name_prefix: Optional[pulumi.Input[str]] = None,
                  name: Optional[pulumi.Input[str]] = None,
@@ -280,6 +304,9 @@ class GroupWithPolicies(pulumi.ComponentResource):
             __props__.__dict__["aws_account_id"] = aws_account_id
             __props__.__dict__["custom_group_policies"] = custom_group_policies
             __props__.__dict__["custom_group_policy_arns"] = custom_group_policy_arns
+            if exclusive_policy_attachments is None:
+                exclusive_policy_attachments = False
+            __props__.__dict__["exclusive_policy_attachments"] = exclusive_policy_attachments
             if group_users is None and not opts.urn:
                 raise TypeError("Missing required property 'group_users'")
             __props__.__dict__["group_users"] = group_users
@@ -0,0 +1,215 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['InstanceProfileArgs', 'InstanceProfile']
+
+@pulumi.input_type
+class InstanceProfileArgs:
+    def __init__(__self__, *,
+                 role: pulumi.Input[str],
+                 name: Optional[pulumi.Input[str]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+        """
+        The set of arguments for constructing a InstanceProfile resource.
+        :param pulumi.Input[str] role: Name or ARN of the IAM role to add to the instance profile.
+        :param pulumi.Input[str] name: Name of IAM instance profile.
+        :param pulumi.Input[str] path: Path of IAM instance profile.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        """
+        pulumi.set(__self__, "role", role)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if path is None:
+            path = '/'
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter
+    def role(self) -> pulumi.Input[str]:
+        """
+        Name or ARN of the IAM role to add to the instance profile.
+        """
+        return pulumi.get(self, "role")
+
+    @role.setter
+    def role(self, value: pulumi.Input[str]):
+        pulumi.set(self, "role", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name of IAM instance profile.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> Optional[pulumi.Input[str]]:
+        """
+        Path of IAM instance profile.
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        A map of tags to add.
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "tags", value)
+
+
+class InstanceProfile(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 role: Optional[pulumi.Input[str]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        """
+        This resource helps you create an IAM instance profile for an existing IAM role, e.g. a role created by
+        `AssumableRole` with `createInstanceProfile` turned off or by `EKSNodeRole`. The role can be given by
+        name or ARN.
+
+        ## Example Usage
+        ## Instance Profile
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        instance_profile = iam.InstanceProfile(
+            'instance_profile',
+            role='arn:aws:iam::111111111111:role/app/web',
+            name='web',
+            path='/app/',
+        )
+
+        pulumi.export('instance_profile', instance_profile)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] name: Name of IAM instance profile.
+        :param pulumi.Input[str] path: Path of IAM instance profile.
+        :param pulumi.Input[str] role: Name or ARN of the IAM role to add to the instance profile.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: InstanceProfileArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        This resource helps you create an IAM instance profile for an existing IAM role, e.g. a role created by
+        `AssumableRole` with `createInstanceProfile` turned off or by `EKSNodeRole`. The role can be given by
+        name or ARN.
+
+        ## Example Usage
+        ## Instance Profile
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        instance_profile = iam.InstanceProfile(
+            'instance_profile',
+            role='arn:aws:iam::111111111111:role/app/web',
+            name='web',
+            path='/app/',
+        )
+
+        pulumi.export('instance_profile', instance_profile)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param InstanceProfileArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(InstanceProfileArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 path: Optional[pulumi.Input[str]] = None,
+                 role: Optional[pulumi.Input[str]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = InstanceProfileArgs.__new__(InstanceProfileArgs)
+
+            __props__.__dict__["name"] = name
+            if path is None:
+                path = '/'
+            __props__.__dict__["path"] = path
+            if role is None and not opts.urn:
+                raise TypeError("Missing required property 'role'")
+            __props__.__dict__["role"] = role
+            __props__.__dict__["tags"] = tags
+            __props__.__dict__["instance_profile"] = None
+            __props__.__dict__["role_name"] = None
+        super(InstanceProfile, __self__).__init__(
+            'aws-iam:index:InstanceProfile',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter(name="instanceProfile")
+    def instance_profile(self) -> pulumi.Output[Mapping[str, str]]:
+        return pulumi.get(self, "instance_profile")
+
+    @property
+    @pulumi.getter(name="roleName")
+    def role_name(self) -> pulumi.Output[str]:
+        """
+        Name of the IAM role in the instance profile.
+        """
+        return pulumi.get(self, "role_name")
+
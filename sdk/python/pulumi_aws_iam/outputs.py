@@ -11,7 +11,9 @@ from . import _utilities
 
 __all__ = [
     'AccessKeyOutput',
+    'EKSServiceAccountManifest',
     'KeybaseOutput',
+    'RoleSessionHelpers',
     'UserOutput',
 ]
 
@@ -119,6 +121,68 @@ class AccessKeyOutput(dict):
         return pulumi.get(self, "status")
 
 
+@pulumi.output_type
+class EKSServiceAccountManifest(dict):
+    def __init__(__self__, *,
+                 annotations: Mapping[str, str],
+                 json: str,
+                 name: str,
+                 namespace: str,
+                 yaml: str):
+        """
+        :param Mapping[str, str] annotations: Annotations binding the ServiceAccount to the IAM role.
+        :param str json: ServiceAccount manifest as JSON.
+        :param str name: Name of the ServiceAccount.
+        :param str namespace: Namespace of the ServiceAccount.
+        :param str yaml: ServiceAccount manifest as YAML.
+        """
+        pulumi.set(__self__, "annotations", annotations)
+        pulumi.set(__self__, "json", json)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "namespace", namespace)
+        pulumi.set(__self__, "yaml", yaml)
+
+    @property
+    @pulumi.getter
+    def annotations(self) -> Mapping[str, str]:
+        """
+        Annotations binding the ServiceAccount to the IAM role.
+        """
+        return pulumi.get(self, "annotations")
+
+    @property
+    @pulumi.getter
+    def json(self) -> str:
+        """
+        ServiceAccount manifest as JSON.
+        """
+        return pulumi.get(self, "json")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        Name of the ServiceAccount.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def namespace(self) -> str:
+        """
+        Namespace of the ServiceAccount.
+        """
+        return pulumi.get(self, "namespace")
+
+    @property
+    @pulumi.getter
+    def yaml(self) -> str:
+        """
+        ServiceAccount manifest as YAML.
+        """
+        return pulumi.get(self, "yaml")
+
+
 @pulumi.output_type
 class KeybaseOutput(dict):
     @staticmethod
@@ -197,6 +261,93 @@ class KeybaseOutput(dict):
         return pulumi.get(self, "secret_key_pgp_message")
 
 
+@pulumi.output_type
+class RoleSessionHelpers(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "cliCommand":
+            suggest = "cli_command"
+        elif key == "cliConfig":
+            suggest = "cli_config"
+        elif key == "durationSeconds":
+            suggest = "duration_seconds"
+        elif key == "sdkParameters":
+            suggest = "sdk_parameters"
+        elif key == "sessionPolicy":
+            suggest = "session_policy"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in RoleSessionHelpers. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        RoleSessionHelpers.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        RoleSessionHelpers.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 cli_command: str,
+                 cli_config: str,
+                 duration_seconds: int,
+                 sdk_parameters: str,
+                 session_policy: str):
+        """
+        :param str cli_command: AWS CLI command assuming the role with the source identity, session tags and session policy.
+        :param str cli_config: AWS CLI config profile assuming the role. Empty for web identity roles without a web identity token file.
+        :param int duration_seconds: Session duration in seconds, capped by the maximum session duration of the role.
+        :param str sdk_parameters: Parameters of the STS request assuming the role as JSON, usable with any AWS SDK.
+        :param str session_policy: Session policy limiting what the session can do, as JSON. Empty when no policy actions are given.
+        """
+        pulumi.set(__self__, "cli_command", cli_command)
+        pulumi.set(__self__, "cli_config", cli_config)
+        pulumi.set(__self__, "duration_seconds", duration_seconds)
+        pulumi.set(__self__, "sdk_parameters", sdk_parameters)
+        pulumi.set(__self__, "session_policy", session_policy)
+
+    @property
+    @pulumi.getter(name="cliCommand")
+    def cli_command(self) -> str:
+        """
+        AWS CLI command assuming the role with the source identity, session tags and session policy.
+        """
+        return pulumi.get(self, "cli_command")
+
+    @property
+    @pulumi.getter(name="cliConfig")
+    def cli_config(self) -> str:
+        """
+        AWS CLI config profile assuming the role. Empty for web identity roles without a web identity token file.
+        """
+        return pulumi.get(self, "cli_config")
+
+    @property
+    @pulumi.getter(name="durationSeconds")
+    def duration_seconds(self) -> int:
+        """
+        Session duration in seconds, capped by the maximum session duration of the role.
+        """
+        return pulumi.get(self, "duration_seconds")
+
+    @property
+    @pulumi.getter(name="sdkParameters")
+    def sdk_parameters(self) -> str:
+        """
+        Parameters of the STS request assuming the role as JSON, usable with any AWS SDK.
+        """
+        return pulumi.get(self, "sdk_parameters")
+
+    @property
+    @pulumi.getter(name="sessionPolicy")
+    def session_policy(self) -> str:
+        """
+        Session policy limiting what the session can do, as JSON. Empty when no policy actions are given.
+        """
+        return pulumi.get(self, "session_policy")
+
+
 @pulumi.output_type
 class UserOutput(dict):
     """
@@ -21,6 +21,7 @@ class ReadOnlyPolicyArgs:
                  allow_web_console_services: Optional[pulumi.Input[bool]] = None,
                  allowed_services: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  description: Optional[pulumi.Input[str]] = None,
+                 metadata_only: Optional[pulumi.Input[bool]] = None,
                  path: Optional[pulumi.Input[str]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  web_console_services: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
@@ -33,6 +34,7 @@ class ReadOnlyPolicyArgs:
         :param pulumi.Input[bool] allow_web_console_services: Allows List/Get/Describe/View actions for services used when browsing AWS console (e.g. resource-groups, tag, health services).
         :param pulumi.Input[Sequence[pulumi.Input[str]]] allowed_services: List of services to allow Get/List/Describe/View options. Service name should be the same as corresponding service IAM prefix. See what it is for each service here https://docs.aws.amazon.com/service-authorization/latest/reference/reference_policies_actions-resources-contextkeys.html.
         :param pulumi.Input[str] description: The description of the policy.
+        :param pulumi.Input[bool] metadata_only: Allow only List, Describe and metadata Read actions instead of the List*, Get*, Describe* and View* wildcards, leaving out reads of data and secrets such as s3:GetObject or secretsmanager:GetSecretValue. Since the embedded action catalog is a curated subset, services it does not list completely keep List* and Describe* next to their other cataloged metadata actions, and services missing from it fall back to List* and Describe*.
         :param pulumi.Input[str] path: The path of the policy in IAM.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         :param pulumi.Input[Sequence[pulumi.Input[str]]] web_console_services: List of web console services to allow.
@@ -60,6 +62,10 @@ class ReadOnlyPolicyArgs:
             description = 'IAM Policy'
         if description is not None:
             pulumi.set(__self__, "description", description)
+        if metadata_only is None:
+            metadata_only = False
+        if metadata_only is not None:
+            pulumi.set(__self__, "metadata_only", metadata_only)
         if path is None:
             path = '/'
         if path is not None:
@@ -153,6 +159,18 @@ class ReadOnlyPolicyArgs:
     def description(self, value: Optional[pulumi.Input[str]]):
         pulumi.set(self, "description", value)
 
+    @property
+    @pulumi.getter(name="metadataOnly")
+    def metadata_only(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Allow only List, Describe and metadata Read actions instead of the List*, Get*, Describe* and View* wildcards, leaving out reads of data and secrets such as s3:GetObject or secretsmanager:GetSecretValue. Since the embedded action catalog is a curated subset, services it does not list completely keep List* and Describe* next to their other cataloged metadata actions, and services missing from it fall back to List* and Describe*.
+        """
+        return pulumi.get(self, "metadata_only")
+
+    @metadata_only.setter
+    def metadata_only(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "metadata_only", value)
+
     @property
     @pulumi.getter
     def path(self) -> Optional[pulumi.Input[str]]:
@@ -201,6 +219,7 @@ class ReadOnlyPolicy(pulumi.ComponentResource):
                  allow_web_console_services: Optional[pulumi.Input[bool]] = None,
                  allowed_services: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  description: Optional[pulumi.Input[str]] = None,
+                 metadata_only: Optional[pulumi.Input[bool]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  path: Optional[pulumi.Input[str]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
@@ -238,6 +257,7 @@ class ReadOnlyPolicy(pulumi.ComponentResource):
         :param pulumi.Input[bool] allow_web_console_services: Allows List/Get/Describe/View actions for services used when browsing AWS console (e.g. resource-groups, tag, health services).
         :param pulumi.Input[Sequence[pulumi.Input[str]]] allowed_services: List of services to allow Get/List/Describe/View options. Service name should be the same as corresponding service IAM prefix. See what it is for each service here https://docs.aws.amazon.com/service-authorization/latest/reference/reference_policies_actions-resources-contextkeys.html.
         :param pulumi.Input[str] description: The description of the policy.
+        :param pulumi.Input[bool] metadata_only: Allow only List, Describe and metadata Read actions instead of the List*, Get*, Describe* and View* wildcards, leaving out reads of data and secrets such as s3:GetObject or secretsmanager:GetSecretValue. Since the embedded action catalog is a curated subset, services it does not list completely keep List* and Describe* next to their other cataloged metadata actions, and services missing from it fall back to List* and Describe*.
         :param pulumi.Input[str] name: The name of the policy.
         :param pulumi.Input[str] path: The path of the policy in IAM.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
@@ -294,6 +314,7 @@ class ReadOnlyPolicy(pulumi.ComponentResource):
                  allow_web_console_services: Optional[pulumi.Input[bool]] = None,
                  allowed_services: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  description: Optional[pulumi.Input[str]] = None,
+                 metadata_only: Optional[pulumi.Input[bool]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  path: Optional[pulumi.Input[str]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
@@ -325,6 +346,9 @@ class ReadOnlyPolicy(pulumi.ComponentResource):
             if description is None:
                 description = 'IAM Policy'
             __props__.__dict__["description"] = description
+            if metadata_only is None:
+                metadata_only = False
+            __props__.__dict__["metadata_only"] = metadata_only
             if name is None and not opts.urn:
                 raise TypeError("Missing required property 'name'")
             __props__.__dict__["name"] = name
@@ -334,6 +358,7 @@ class ReadOnlyPolicy(pulumi.ComponentResource):
             __props__.__dict__["tags"] = tags
             __props__.__dict__["web_console_services"] = web_console_services
             __props__.__dict__["arn"] = None
+            __props__.__dict__["catalog_version"] = None
             __props__.__dict__["id"] = None
             __props__.__dict__["policy"] = None
             __props__.__dict__["policy_json"] = None
@@ -352,6 +377,14 @@ class ReadOnlyPolicy(pulumi.ComponentResource):
         """
         return pulumi.get(self, "arn")
 
+    @property
+    @pulumi.getter(name="catalogVersion")
+    def catalog_version(self) -> pulumi.Output[str]:
+        """
+        Version of the action catalog used to expand actions when `metadataOnly` is set.
+        """
+        return pulumi.get(self, "catalog_version")
+
     @property
     @pulumi.getter
     def description(self) -> pulumi.Output[str]:
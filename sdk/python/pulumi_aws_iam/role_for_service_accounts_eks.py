@@ -8,6 +8,7 @@ import pulumi
 import pulumi.runtime
 from typing import Any, Mapping, Optional, Sequence, Union, overload
 from . import _utilities
+from . import outputs
 from ._inputs import *
 
 __all__ = ['RoleForServiceAccountsEksArgs', 'RoleForServiceAccountsEks']
@@ -15,6 +16,7 @@ __all__ = ['RoleForServiceAccountsEksArgs', 'RoleForServiceAccountsEks']
 @pulumi.input_type
 class RoleForServiceAccountsEksArgs:
     def __init__(__self__, *,
+                 allow_all_namespaces: Optional[pulumi.Input[bool]] = None,
                  assume_role_condition_test: Optional[pulumi.Input[str]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
@@ -22,16 +24,27 @@ class RoleForServiceAccountsEksArgs:
                  policies: Optional[pulumi.Input['EKSRolePoliciesArgs']] = None,
                  policy_name_prefix: Optional[pulumi.Input[str]] = None,
                  role: Optional[pulumi.Input['EKSServiceAccountRoleArgs']] = None,
+                 service_account_manifest: Optional[pulumi.Input['EKSServiceAccountManifestOptionsArgs']] = None,
+                 session: Optional[pulumi.Input['RoleSessionArgs']] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
         """
         The set of arguments for constructing a RoleForServiceAccountsEks resource.
-        :param pulumi.Input[str] assume_role_condition_test: Name of the IAM condition operator to evaluate when assuming the role.
+        :param pulumi.Input[bool] allow_all_namespaces: Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+        :param pulumi.Input[str] assume_role_condition_test: Name of the IAM condition operator to evaluate when assuming the role. ServiceAccounts with wildcards
+               are matched with StringLike in a statement of their own.
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
         :param pulumi.Input[Mapping[str, pulumi.Input['OIDCProviderArgs']]] oidc_providers: Map of OIDC providers.
         :param pulumi.Input[str] policy_name_prefix: IAM policy name prefix.
+        :param pulumi.Input['EKSServiceAccountManifestOptionsArgs'] service_account_manifest: Annotations added to the rendered ServiceAccount manifests.
+        :param pulumi.Input['RoleSessionArgs'] session: Settings of the session helpers rendered for the role. The web identity token file defaults to the
+               token EKS mounts into pods.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
+        if allow_all_namespaces is None:
+            allow_all_namespaces = False
+        if allow_all_namespaces is not None:
+            pulumi.set(__self__, "allow_all_namespaces", allow_all_namespaces)
         if assume_role_condition_test is None:
             assume_role_condition_test = 'StringEquals'
         if assume_role_condition_test is not None:
@@ -54,14 +67,31 @@ class RoleForServiceAccountsEksArgs:
             pulumi.set(__self__, "policy_name_prefix", policy_name_prefix)
         if role is not None:
             pulumi.set(__self__, "role", role)
+        if service_account_manifest is not None:
+            pulumi.set(__self__, "service_account_manifest", service_account_manifest)
+        if session is not None:
+            pulumi.set(__self__, "session", session)
         if tags is not None:
             pulumi.set(__self__, "tags", tags)
 
+    @property
+    @pulumi.getter(name="allowAllNamespaces")
+    def allow_all_namespaces(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+        """
+        return pulumi.get(self, "allow_all_namespaces")
+
+    @allow_all_namespaces.setter
+    def allow_all_namespaces(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "allow_all_namespaces", value)
+
     @property
     @pulumi.getter(name="assumeRoleConditionTest")
     def assume_role_condition_test(self) -> Optional[pulumi.Input[str]]:
         """
-        Name of the IAM condition operator to evaluate when assuming the role.
+        Name of the IAM condition operator to evaluate when assuming the role. ServiceAccounts with wildcards
+        are matched with StringLike in a statement of their own.
         """
         return pulumi.get(self, "assume_role_condition_test")
 
@@ -135,6 +165,31 @@ class RoleForServiceAccountsEksArgs:
     def role(self, value: Optional[pulumi.Input['EKSServiceAccountRoleArgs']]):
         pulumi.set(self, "role", value)
 
+    @property
+    @pulumi.getter(name="serviceAccountManifest")
+    def service_account_manifest(self) -> Optional[pulumi.Input['EKSServiceAccountManifestOptionsArgs']]:
+        """
+        Annotations added to the rendered ServiceAccount manifests.
+        """
+        return pulumi.get(self, "service_account_manifest")
+
+    @service_account_manifest.setter
+    def service_account_manifest(self, value: Optional[pulumi.Input['EKSServiceAccountManifestOptionsArgs']]):
+        pulumi.set(self, "service_account_manifest", value)
+
+    @property
+    @pulumi.getter
+    def session(self) -> Optional[pulumi.Input['RoleSessionArgs']]:
+        """
+        Settings of the session helpers rendered for the role. The web identity token file defaults to the
+        token EKS mounts into pods.
+        """
+        return pulumi.get(self, "session")
+
+    @session.setter
+    def session(self, value: Optional[pulumi.Input['RoleSessionArgs']]):
+        pulumi.set(self, "session", value)
+
     @property
     @pulumi.getter
     def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
@@ -153,6 +208,7 @@ class RoleForServiceAccountsEks(pulumi.ComponentResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_all_namespaces: Optional[pulumi.Input[bool]] = None,
                  assume_role_condition_test: Optional[pulumi.Input[str]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
@@ -160,6 +216,8 @@ class RoleForServiceAccountsEks(pulumi.ComponentResource):
                  policies: Optional[pulumi.Input[pulumi.InputType['EKSRolePoliciesArgs']]] = None,
                  policy_name_prefix: Optional[pulumi.Input[str]] = None,
                  role: Optional[pulumi.Input[pulumi.InputType['EKSServiceAccountRoleArgs']]] = None,
+                 service_account_manifest: Optional[pulumi.Input[pulumi.InputType['EKSServiceAccountManifestOptionsArgs']]] = None,
+                 session: Optional[pulumi.Input[pulumi.InputType['RoleSessionArgs']]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  __props__=None):
         """
@@ -218,11 +276,16 @@ class RoleForServiceAccountsEks(pulumi.ComponentResource):
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
-        :param pulumi.Input[str] assume_role_condition_test: Name of the IAM condition operator to evaluate when assuming the role.
+        :param pulumi.Input[bool] allow_all_namespaces: Whether ServiceAccount patterns may use wildcards in the namespace, matching all or several namespaces, e.g. `*:my-app` or `team-*:my-app`.
+        :param pulumi.Input[str] assume_role_condition_test: Name of the IAM condition operator to evaluate when assuming the role. ServiceAccounts with wildcards
+               are matched with StringLike in a statement of their own.
         :param pulumi.Input[bool] force_detach_policies: Whether policies should be detached from this role when destroying.
         :param pulumi.Input[int] max_session_duration: Maximum CLI/API session duration in seconds between 3600 and 43200.
         :param pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['OIDCProviderArgs']]]] oidc_providers: Map of OIDC providers.
         :param pulumi.Input[str] policy_name_prefix: IAM policy name prefix.
+        :param pulumi.Input[pulumi.InputType['EKSServiceAccountManifestOptionsArgs']] service_account_manifest: Annotations added to the rendered ServiceAccount manifests.
+        :param pulumi.Input[pulumi.InputType['RoleSessionArgs']] session: Settings of the session helpers rendered for the role. The web identity token file defaults to the
+               token EKS mounts into pods.
         :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
         """
         ...
@@ -300,6 +363,7 @@ class RoleForServiceAccountsEks(pulumi.ComponentResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_all_namespaces: Optional[pulumi.Input[bool]] = None,
                  assume_role_condition_test: Optional[pulumi.Input[str]] = None,
                  force_detach_policies: Optional[pulumi.Input[bool]] = None,
                  max_session_duration: Optional[pulumi.Input[int]] = None,
@@ -307,6 +371,8 @@ class RoleForServiceAccountsEks(pulumi.ComponentResource):
                  policies: Optional[pulumi.Input[pulumi.InputType['EKSRolePoliciesArgs']]] = None,
                  policy_name_prefix: Optional[pulumi.Input[str]] = None,
                  role: Optional[pulumi.Input[pulumi.InputType['EKSServiceAccountRoleArgs']]] = None,
+                 service_account_manifest: Optional[pulumi.Input[pulumi.InputType['EKSServiceAccountManifestOptionsArgs']]] = None,
+                 session: Optional[pulumi.Input[pulumi.InputType['RoleSessionArgs']]] = None,
                  tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
@@ -319,6 +385,9 @@ class RoleForServiceAccountsEks(pulumi.ComponentResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = RoleForServiceAccountsEksArgs.__new__(RoleForServiceAccountsEksArgs)
 
+            if allow_all_namespaces is None:
+                allow_all_namespaces = False
+            __props__.__dict__["allow_all_namespaces"] = allow_all_namespaces
             if assume_role_condition_test is None:
                 assume_role_condition_test = 'StringEquals'
             __props__.__dict__["assume_role_condition_test"] = assume_role_condition_test
@@ -334,7 +403,10 @@ class RoleForServiceAccountsEks(pulumi.ComponentResource):
                 policy_name_prefix = 'AmazonEKS_'
             __props__.__dict__["policy_name_prefix"] = policy_name_prefix
             __props__.__dict__["role"] = role
+            __props__.__dict__["service_account_manifest"] = service_account_manifest
+            __props__.__dict__["session"] = session
             __props__.__dict__["tags"] = tags
+            __props__.__dict__["service_accounts"] = None
         super(RoleForServiceAccountsEks, __self__).__init__(
             'aws-iam:index:RoleForServiceAccountsEks',
             resource_name,
@@ -347,3 +419,19 @@ class RoleForServiceAccountsEks(pulumi.ComponentResource):
     def role(self) -> pulumi.Output[Mapping[str, str]]:
         return pulumi.get(self, "role")
 
+    @property
+    @pulumi.getter(name="serviceAccounts")
+    def service_accounts(self) -> pulumi.Output[Sequence['outputs.EKSServiceAccountManifest']]:
+        """
+        Annotations and ServiceAccount manifests binding each ServiceAccount to the role.
+        """
+        return pulumi.get(self, "service_accounts")
+
+    @property
+    @pulumi.getter
+    def session(self) -> pulumi.Output['outputs.RoleSessionHelpers']:
+        """
+        Session policy and AWS CLI / SDK snippets assuming the role.
+        """
+        return pulumi.get(self, "session")
+
@@ -0,0 +1,277 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['SAMLProviderArgs', 'SAMLProvider']
+
+@pulumi.input_type
+class SAMLProviderArgs:
+    def __init__(__self__, *,
+                 certificate_expiry_warning_days: Optional[pulumi.Input[int]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 saml_metadata_document: Optional[pulumi.Input[str]] = None,
+                 saml_metadata_file: Optional[pulumi.Input[str]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+        """
+        The set of arguments for constructing a SAMLProvider resource.
+        :param pulumi.Input[int] certificate_expiry_warning_days: Number of days before a signing certificate expires at which a warning is reported. `0` disables the warning.
+        :param pulumi.Input[str] name: The name of the SAML provider. Defaults to the resource name.
+        :param pulumi.Input[str] saml_metadata_document: The IdP metadata XML document.
+        :param pulumi.Input[str] saml_metadata_file: Path to a local file containing the IdP metadata XML document. Used when `samlMetadataDocument`
+               is not provided.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        """
+        if certificate_expiry_warning_days is None:
+            certificate_expiry_warning_days = 30
+        if certificate_expiry_warning_days is not None:
+            pulumi.set(__self__, "certificate_expiry_warning_days", certificate_expiry_warning_days)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if saml_metadata_document is not None:
+            pulumi.set(__self__, "saml_metadata_document", saml_metadata_document)
+        if saml_metadata_file is not None:
+            pulumi.set(__self__, "saml_metadata_file", saml_metadata_file)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter(name="certificateExpiryWarningDays")
+    def certificate_expiry_warning_days(self) -> Optional[pulumi.Input[int]]:
+        """
+        Number of days before a signing certificate expires at which a warning is reported. `0` disables the warning.
+        """
+        return pulumi.get(self, "certificate_expiry_warning_days")
+
+    @certificate_expiry_warning_days.setter
+    def certificate_expiry_warning_days(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "certificate_expiry_warning_days", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the SAML provider. Defaults to the resource name.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="samlMetadataDocument")
+    def saml_metadata_document(self) -> Optional[pulumi.Input[str]]:
+        """
+        The IdP metadata XML document.
+        """
+        return pulumi.get(self, "saml_metadata_document")
+
+    @saml_metadata_document.setter
+    def saml_metadata_document(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "saml_metadata_document", value)
+
+    @property
+    @pulumi.getter(name="samlMetadataFile")
+    def saml_metadata_file(self) -> Optional[pulumi.Input[str]]:
+        """
+        Path to a local file containing the IdP metadata XML document. Used when `samlMetadataDocument`
+        is not provided.
+        """
+        return pulumi.get(self, "saml_metadata_file")
+
+    @saml_metadata_file.setter
+    def saml_metadata_file(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "saml_metadata_file", value)
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        A map of tags to add.
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "tags", value)
+
+
+class SAMLProvider(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 certificate_expiry_warning_days: Optional[pulumi.Input[int]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 saml_metadata_document: Optional[pulumi.Input[str]] = None,
+                 saml_metadata_file: Optional[pulumi.Input[str]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        """
+        This resource helps you create an IAM SAML identity provider from the metadata document of your IdP. The
+        metadata is validated before the provider is created: it must contain an entity ID and at least one
+        signing certificate that has not expired. A warning is reported when a signing certificate is close to
+        its expiry date so that the metadata can be updated before the IdP rotates its certificate.
+
+        ## Example Usage
+        ## SAML Provider
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        saml_provider = iam.SAMLProvider(
+            'saml_provider',
+            name='okta',
+            saml_metadata_file='./okta-metadata.xml',
+            certificate_expiry_warning_days=45,
+        )
+
+        pulumi.export('saml_provider', saml_provider)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[int] certificate_expiry_warning_days: Number of days before a signing certificate expires at which a warning is reported. `0` disables the warning.
+        :param pulumi.Input[str] name: The name of the SAML provider. Defaults to the resource name.
+        :param pulumi.Input[str] saml_metadata_document: The IdP metadata XML document.
+        :param pulumi.Input[str] saml_metadata_file: Path to a local file containing the IdP metadata XML document. Used when `samlMetadataDocument`
+               is not provided.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: A map of tags to add.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[SAMLProviderArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        This resource helps you create an IAM SAML identity provider from the metadata document of your IdP. The
+        metadata is validated before the provider is created: it must contain an entity ID and at least one
+        signing certificate that has not expired. A warning is reported when a signing certificate is close to
+        its expiry date so that the metadata can be updated before the IdP rotates its certificate.
+
+        ## Example Usage
+        ## SAML Provider
+
+        ```python
+        import pulumi
+        import pulumi_aws_iam as iam
+
+        saml_provider = iam.SAMLProvider(
+            'saml_provider',
+            name='okta',
+            saml_metadata_file='./okta-metadata.xml',
+            certificate_expiry_warning_days=45,
+        )
+
+        pulumi.export('saml_provider', saml_provider)
+        ```
+        {{ /example }}
+
+        :param str resource_name: The name of the resource.
+        :param SAMLProviderArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(SAMLProviderArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 certificate_expiry_warning_days: Optional[pulumi.Input[int]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 saml_metadata_document: Optional[pulumi.Input[str]] = None,
+                 saml_metadata_file: Optional[pulumi.Input[str]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = SAMLProviderArgs.__new__(SAMLProviderArgs)
+
+            if certificate_expiry_warning_days is None:
+                certificate_expiry_warning_days = 30
+            __props__.__dict__["certificate_expiry_warning_days"] = certificate_expiry_warning_days
+            __props__.__dict__["name"] = name
+            __props__.__dict__["saml_metadata_document"] = saml_metadata_document
+            __props__.__dict__["saml_metadata_file"] = saml_metadata_file
+            __props__.__dict__["tags"] = tags
+            __props__.__dict__["arn"] = None
+            __props__.__dict__["certificate_expiry"] = None
+            __props__.__dict__["days_until_certificate_expiry"] = None
+            __props__.__dict__["entity_id"] = None
+            __props__.__dict__["valid_until"] = None
+        super(SAMLProvider, __self__).__init__(
+            'aws-iam:index:SAMLProvider',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter
+    def arn(self) -> pulumi.Output[str]:
+        """
+        The ARN assigned by AWS for this provider.
+        """
+        return pulumi.get(self, "arn")
+
+    @property
+    @pulumi.getter(name="certificateExpiry")
+    def certificate_expiry(self) -> pulumi.Output[str]:
+        """
+        The expiration date of the valid signing certificate that expires first, in RFC3339 format.
+        """
+        return pulumi.get(self, "certificate_expiry")
+
+    @property
+    @pulumi.getter(name="daysUntilCertificateExpiry")
+    def days_until_certificate_expiry(self) -> pulumi.Output[int]:
+        """
+        The number of days left until the valid signing certificate that expires first is no longer valid.
+        """
+        return pulumi.get(self, "days_until_certificate_expiry")
+
+    @property
+    @pulumi.getter(name="entityId")
+    def entity_id(self) -> pulumi.Output[str]:
+        """
+        The entity ID of the IdP found in the metadata document.
+        """
+        return pulumi.get(self, "entity_id")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        """
+        The name of the provider.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="validUntil")
+    def valid_until(self) -> pulumi.Output[str]:
+        """
+        The expiration date and time for the SAML provider in RFC1123 format.
+        """
+        return pulumi.get(self, "valid_until")
+
@@ -16,6 +16,7 @@ __all__ = ['UserArgs', 'User']
 class UserArgs:
     def __init__(__self__, *,
                  name: pulumi.Input[str],
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
                  force_destroy: Optional[pulumi.Input[bool]] = None,
                  password_length: Optional[pulumi.Input[int]] = None,
                  password_reset_required: Optional[pulumi.Input[bool]] = None,
@@ -29,6 +30,8 @@ class UserArgs:
         """
         The set of arguments for constructing a User resource.
         :param pulumi.Input[str] name: Desired name for the IAM user.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the user are detached from it. Not supported for users
+               by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
         :param pulumi.Input[bool] force_destroy: When destroying this user, destroy even if it has non-Pulumi-managed IAM access keys, login profile or MFA devices. Without forceDestroy a user with non-Pulumi-managed access keys and login profile will fail to be destroyed.
         :param pulumi.Input[int] password_length: The length of the generated password
         :param pulumi.Input[bool] password_reset_required: Whether the user should be forced to reset the generated password on first login.
@@ -41,6 +44,10 @@ class UserArgs:
         :param pulumi.Input[bool] upload_iam_user_ssh_key: Whether to upload a public ssh key to the IAM user.
         """
         pulumi.set(__self__, "name", name)
+        if exclusive_policy_attachments is None:
+            exclusive_policy_attachments = False
+        if exclusive_policy_attachments is not None:
+            pulumi.set(__self__, "exclusive_policy_attachments", exclusive_policy_attachments)
         if force_destroy is not None:
             pulumi.set(__self__, "force_destroy", force_destroy)
         if password_length is not None:
@@ -78,6 +85,19 @@ class UserArgs:
     def name(self, value: pulumi.Input[str]):
         pulumi.set(self, "name", value)
 
+    @property
+    @pulumi.getter(name="exclusivePolicyAttachments")
+    def exclusive_policy_attachments(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether managed policies not declared for the user are detached from it. Not supported for users
+        by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
+        """
+        return pulumi.get(self, "exclusive_policy_attachments")
+
+    @exclusive_policy_attachments.setter
+    def exclusive_policy_attachments(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "exclusive_policy_attachments", value)
+
     @property
     @pulumi.getter(name="forceDestroy")
     def force_destroy(self) -> Optional[pulumi.Input[bool]]:
@@ -204,6 +224,7 @@ class User(pulumi.ComponentResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
                  force_destroy: Optional[pulumi.Input[bool]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  password_length: Optional[pulumi.Input[int]] = None,
@@ -241,6 +262,8 @@ class User(pulumi.ComponentResource):
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] exclusive_policy_attachments: Whether managed policies not declared for the user are detached from it. Not supported for users
+               by the AWS provider v5, which has no exclusive policy attachments for them, setting it fails.
         :param pulumi.Input[bool] force_destroy: When destroying this user, destroy even if it has non-Pulumi-managed IAM access keys, login profile or MFA devices. Without forceDestroy a user with non-Pulumi-managed access keys and login profile will fail to be destroyed.
         :param pulumi.Input[str] name: Desired name for the IAM user.
         :param pulumi.Input[int] password_length: The length of the generated password
@@ -297,6 +320,7 @@ class User(pulumi.ComponentResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 exclusive_policy_attachments: Optional[pulumi.Input[bool]] = None,
                  force_destroy: Optional[pulumi.Input[bool]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  password_length: Optional[pulumi.Input[int]] = None,
@@ -319,6 +343,9 @@ class User(pulumi.ComponentResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = UserArgs.__new__(UserArgs)
 
+            if exclusive_policy_attachments is None:
+                exclusive_policy_attachments = False
+            __props__.__dict__["exclusive_policy_attachments"] = exclusive_policy_attachments
             __props__.__dict__["force_destroy"] = force_destroy
             if name is None and not opts.urn:
                 raise TypeError("Missing required property 'name'")